@@ -298,6 +298,7 @@ const defaultServerConfig: AdminConfig = {
         EnablePublicLink: false,
         ExtractContent: true,
         ArchiveRecursion: false,
+        EnableWebPPreviews: false,
         PublicLinkSalt: '',
         InitialFont: 'nunito-bold.ttf',
         AmazonS3AccessKeyId: '',
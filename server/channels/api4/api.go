@@ -59,6 +59,9 @@ type Routes struct {
 	PostsForUser    *mux.Router // 'api/v4/users/{user_id:[A-Za-z0-9]+}/posts'
 	PostForUser     *mux.Router // 'api/v4/users/{user_id:[A-Za-z0-9]+}/posts/{post_id:[A-Za-z0-9]+}'
 
+	Polls *mux.Router // 'api/v4/polls'
+	Poll  *mux.Router // 'api/v4/polls/{poll_id:[A-Za-z0-9]+}'
+
 	Files *mux.Router // 'api/v4/files'
 	File  *mux.Router // 'api/v4/files/{file_id:[A-Za-z0-9]+}'
 
@@ -79,6 +82,9 @@ type Routes struct {
 	OutgoingHooks *mux.Router // 'api/v4/hooks/outgoing'
 	OutgoingHook  *mux.Router // 'api/v4/hooks/outgoing/{hook_id:[A-Za-z0-9]+}'
 
+	EventSubscriptions *mux.Router // 'api/v4/hooks/events'
+	EventSubscription  *mux.Router // 'api/v4/hooks/events/{subscription_id:[A-Za-z0-9]+}'
+
 	OAuth     *mux.Router // 'api/v4/oauth'
 	OAuthApps *mux.Router // 'api/v4/oauth/apps'
 	OAuthApp  *mux.Router // 'api/v4/oauth/apps/{app_id:[A-Za-z0-9]+}'
@@ -97,6 +103,9 @@ type Routes struct {
 
 	Brand *mux.Router // 'api/v4/brand'
 
+	EmailTemplates *mux.Router // 'api/v4/email_templates'
+	EmailTemplate  *mux.Router // 'api/v4/email_templates/{template_name:[a-z0-9_]+}'
+
 	System *mux.Router // 'api/v4/system'
 
 	Jobs *mux.Router // 'api/v4/jobs'
@@ -218,6 +227,9 @@ func Init(srv *app.Server) (*API, error) {
 	api.BaseRoutes.PostsForUser = api.BaseRoutes.User.PathPrefix("/posts").Subrouter()
 	api.BaseRoutes.PostForUser = api.BaseRoutes.PostsForUser.PathPrefix("/{post_id:[A-Za-z0-9]+}").Subrouter()
 
+	api.BaseRoutes.Polls = api.BaseRoutes.APIRoot.PathPrefix("/polls").Subrouter()
+	api.BaseRoutes.Poll = api.BaseRoutes.Polls.PathPrefix("/{poll_id:[A-Za-z0-9]+}").Subrouter()
+
 	api.BaseRoutes.Files = api.BaseRoutes.APIRoot.PathPrefix("/files").Subrouter()
 	api.BaseRoutes.File = api.BaseRoutes.Files.PathPrefix("/{file_id:[A-Za-z0-9]+}").Subrouter()
 	api.BaseRoutes.PublicFile = api.BaseRoutes.Root.PathPrefix("/files/{file_id:[A-Za-z0-9]+}/public").Subrouter()
@@ -236,6 +248,8 @@ func Init(srv *app.Server) (*API, error) {
 	api.BaseRoutes.IncomingHook = api.BaseRoutes.IncomingHooks.PathPrefix("/{hook_id:[A-Za-z0-9]+}").Subrouter()
 	api.BaseRoutes.OutgoingHooks = api.BaseRoutes.Hooks.PathPrefix("/outgoing").Subrouter()
 	api.BaseRoutes.OutgoingHook = api.BaseRoutes.OutgoingHooks.PathPrefix("/{hook_id:[A-Za-z0-9]+}").Subrouter()
+	api.BaseRoutes.EventSubscriptions = api.BaseRoutes.Hooks.PathPrefix("/events").Subrouter()
+	api.BaseRoutes.EventSubscription = api.BaseRoutes.EventSubscriptions.PathPrefix("/{subscription_id:[A-Za-z0-9]+}").Subrouter()
 
 	api.BaseRoutes.SAML = api.BaseRoutes.APIRoot.PathPrefix("/saml").Subrouter()
 
@@ -247,6 +261,8 @@ func Init(srv *app.Server) (*API, error) {
 	api.BaseRoutes.Cluster = api.BaseRoutes.APIRoot.PathPrefix("/cluster").Subrouter()
 	api.BaseRoutes.LDAP = api.BaseRoutes.APIRoot.PathPrefix("/ldap").Subrouter()
 	api.BaseRoutes.Brand = api.BaseRoutes.APIRoot.PathPrefix("/brand").Subrouter()
+	api.BaseRoutes.EmailTemplates = api.BaseRoutes.APIRoot.PathPrefix("/email_templates").Subrouter()
+	api.BaseRoutes.EmailTemplate = api.BaseRoutes.EmailTemplates.PathPrefix("/{template_name:[a-z0-9_]+}").Subrouter()
 	api.BaseRoutes.System = api.BaseRoutes.APIRoot.PathPrefix("/system").Subrouter()
 	api.BaseRoutes.Preferences = api.BaseRoutes.User.PathPrefix("/preferences").Subrouter()
 	api.BaseRoutes.License = api.BaseRoutes.APIRoot.PathPrefix("/license").Subrouter()
@@ -322,6 +338,7 @@ func Init(srv *app.Server) (*API, error) {
 	api.InitLicense()
 	api.InitConfig()
 	api.InitWebhook()
+	api.InitEventSubscription()
 	api.InitPreference()
 	api.InitSaml()
 	api.InitCompliance()
@@ -330,6 +347,7 @@ func Init(srv *app.Server) (*API, error) {
 	api.InitElasticsearch()
 	api.InitDataRetention()
 	api.InitBrand()
+	api.InitEmailTemplate()
 	api.InitJob()
 	api.InitCommand()
 	api.InitStatus()
@@ -360,6 +378,7 @@ func Init(srv *app.Server) (*API, error) {
 	api.InitOutgoingOAuthConnection()
 	api.InitClientPerformanceMetrics()
 	api.InitScheduledPost()
+	api.InitPoll()
 	api.InitCustomProfileAttributes()
 	api.InitAuditLogging()
 	api.InitAccessControlPolicy()
@@ -421,6 +440,12 @@ func InitLocal(srv *app.Server) *API {
 	api.BaseRoutes.IncomingHook = api.BaseRoutes.IncomingHooks.PathPrefix("/{hook_id:[A-Za-z0-9]+}").Subrouter()
 	api.BaseRoutes.OutgoingHooks = api.BaseRoutes.Hooks.PathPrefix("/outgoing").Subrouter()
 	api.BaseRoutes.OutgoingHook = api.BaseRoutes.OutgoingHooks.PathPrefix("/{hook_id:[A-Za-z0-9]+}").Subrouter()
+	api.BaseRoutes.EventSubscriptions = api.BaseRoutes.Hooks.PathPrefix("/events").Subrouter()
+	api.BaseRoutes.EventSubscription = api.BaseRoutes.EventSubscriptions.PathPrefix("/{subscription_id:[A-Za-z0-9]+}").Subrouter()
+
+	api.BaseRoutes.EmailTemplates = api.BaseRoutes.APIRoot.PathPrefix("/email_templates").Subrouter()
+	api.BaseRoutes.EmailTemplate = api.BaseRoutes.EmailTemplates.PathPrefix("/{template_name:[a-z0-9_]+}").Subrouter()
+	api.BaseRoutes.AuditLogs = api.BaseRoutes.APIRoot.PathPrefix("/audit_logs").Subrouter()
 
 	api.BaseRoutes.License = api.BaseRoutes.APIRoot.PathPrefix("/license").Subrouter()
 
@@ -460,6 +485,9 @@ func InitLocal(srv *app.Server) *API {
 	api.InitChannelLocal()
 	api.InitConfigLocal()
 	api.InitWebhookLocal()
+	api.InitEventSubscriptionLocal()
+	api.InitEmailTemplateLocal()
+	api.InitAuditLoggingLocal()
 	api.InitPluginLocal()
 	api.InitCommandLocal()
 	api.InitLicenseLocal()
@@ -350,6 +350,11 @@ func SetupConfigWithStoreMock(tb testing.TB, updateConfig func(cfg *model.Config
 	emptyMockStore := mocks.Store{}
 	emptyMockStore.On("Close").Return(nil)
 	emptyMockStore.On("Status").Return(&statusMock)
+	configRevisionMock := mocks.ConfigRevisionStore{}
+	configRevisionMock.On("GetAll", mock.Anything, mock.Anything).Return([]*model.ConfigRevision{}, nil)
+	configRevisionMock.On("Save", mock.AnythingOfType("*model.ConfigRevision")).Return(&model.ConfigRevision{}, nil)
+	configRevisionMock.On("PermanentDeleteAllButLatest", mock.Anything).Return(nil)
+	emptyMockStore.On("ConfigRevision").Return(&configRevisionMock).Maybe()
 	th.App.Srv().SetStore(&emptyMockStore)
 	return th
 }
@@ -364,6 +369,11 @@ func SetupWithStoreMock(tb testing.TB) *TestHelper {
 	emptyMockStore := mocks.Store{}
 	emptyMockStore.On("Close").Return(nil)
 	emptyMockStore.On("Status").Return(&statusMock)
+	configRevisionMock := mocks.ConfigRevisionStore{}
+	configRevisionMock.On("GetAll", mock.Anything, mock.Anything).Return([]*model.ConfigRevision{}, nil)
+	configRevisionMock.On("Save", mock.AnythingOfType("*model.ConfigRevision")).Return(&model.ConfigRevision{}, nil)
+	configRevisionMock.On("PermanentDeleteAllButLatest", mock.Anything).Return(nil)
+	emptyMockStore.On("ConfigRevision").Return(&configRevisionMock).Maybe()
 	th.App.Srv().SetStore(&emptyMockStore)
 	return th
 }
@@ -383,6 +393,11 @@ func SetupEnterpriseWithStoreMock(tb testing.TB, options ...app.Option) *TestHel
 	emptyMockStore := mocks.Store{}
 	emptyMockStore.On("Close").Return(nil)
 	emptyMockStore.On("Status").Return(&statusMock)
+	configRevisionMock := mocks.ConfigRevisionStore{}
+	configRevisionMock.On("GetAll", mock.Anything, mock.Anything).Return([]*model.ConfigRevision{}, nil)
+	configRevisionMock.On("Save", mock.AnythingOfType("*model.ConfigRevision")).Return(&model.ConfigRevision{}, nil)
+	configRevisionMock.On("PermanentDeleteAllButLatest", mock.Anything).Return(nil)
+	emptyMockStore.On("ConfigRevision").Return(&configRevisionMock).Maybe()
 	th.App.Srv().SetStore(&emptyMockStore)
 	return th
 }
@@ -4,15 +4,25 @@
 package api4
 
 import (
+	"encoding/json"
 	"mime/multipart"
 	"net/http"
+	"strconv"
 
 	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
 )
 
 func (api *API) InitAuditLogging() {
 	api.BaseRoutes.AuditLogs.Handle("/certificate", api.APISessionRequired(addAuditLogCertificate)).Methods(http.MethodPost)
 	api.BaseRoutes.AuditLogs.Handle("/certificate", api.APISessionRequired(removeAuditLogCertificate)).Methods(http.MethodDelete)
+	api.BaseRoutes.AuditLogs.Handle("", api.APISessionRequired(searchAuditLogs)).Methods(http.MethodGet)
+	api.BaseRoutes.AuditLogs.Handle("/verify", api.APISessionRequired(verifyAuditLogs)).Methods(http.MethodGet)
+}
+
+func (api *API) InitAuditLoggingLocal() {
+	api.BaseRoutes.AuditLogs.Handle("", api.APILocal(searchAuditLogs)).Methods(http.MethodGet)
+	api.BaseRoutes.AuditLogs.Handle("/verify", api.APILocal(verifyAuditLogs)).Methods(http.MethodGet)
 }
 
 func parseAuditLogCertificateRequest(r *http.Request, maxFileSize int64) (*multipart.FileHeader, *model.AppError) {
@@ -81,3 +91,76 @@ func removeAuditLogCertificate(c *Context, w http.ResponseWriter, r *http.Reques
 	auditRec.Success()
 	ReturnStatusOK(w)
 }
+
+func searchAuditLogs(c *Context, w http.ResponseWriter, r *http.Request) {
+	auditRec := c.MakeAuditRecord(model.AuditEventSearchAuditLogs, model.AuditStatusFail)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionReadAudits) {
+		c.SetPermissionError(model.PermissionReadAudits)
+		return
+	}
+
+	query := r.URL.Query()
+	search := &model.AuditLogSearch{
+		UserId:    query.Get("user_id"),
+		EventName: query.Get("event_name"),
+		ObjectId:  query.Get("object_id"),
+		Page:      c.Params.Page,
+		PerPage:   c.Params.PerPage,
+	}
+
+	for param, value := range map[string]*int64{"since": &search.Since, "until": &search.Until} {
+		if query.Get(param) == "" {
+			continue
+		}
+		parsed, err := strconv.ParseInt(query.Get(param), 10, 64)
+		if err != nil || parsed < 0 {
+			c.SetInvalidURLParam(param)
+			return
+		}
+		*value = parsed
+	}
+
+	model.AddEventParameterToAuditRec(auditRec, "user_id", search.UserId)
+	model.AddEventParameterToAuditRec(auditRec, "event_name", search.EventName)
+	model.AddEventParameterToAuditRec(auditRec, "object_id", search.ObjectId)
+	model.AddEventParameterToAuditRec(auditRec, "since", search.Since)
+	model.AddEventParameterToAuditRec(auditRec, "until", search.Until)
+
+	auditLogs, appErr := c.App.SearchAuditLogs(search)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(auditLogs); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func verifyAuditLogs(c *Context, w http.ResponseWriter, r *http.Request) {
+	auditRec := c.MakeAuditRecord(model.AuditEventVerifyAuditLogs, model.AuditStatusFail)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionReadAudits) {
+		c.SetPermissionError(model.PermissionReadAudits)
+		return
+	}
+
+	verification, appErr := c.App.VerifyAuditLogs()
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	model.AddEventParameterToAuditRec(auditRec, "valid", verification.Valid)
+	model.AddEventParameterToAuditRec(auditRec, "checked", verification.Checked)
+
+	if err := json.NewEncoder(w).Encode(verification); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestAuditLogs(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ExperimentalAuditSettings.StoreEnabled = true
+		*cfg.ExperimentalAuditSettings.StoreSigningKey = "audit-log-signing-key-for-the-tests"
+	})
+	_, err := th.SystemAdminClient.RemoveUserFromChannel(context.Background(), th.BasicChannel.Id, th.BasicUser2.Id)
+	require.NoError(t, err)
+	// Disabling the store writes the queued audit records first.
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ExperimentalAuditSettings.StoreEnabled = false })
+
+	t.Run("regular users can't read the audit logs", func(t *testing.T) {
+		_, resp, err := th.Client.SearchAuditLogs(context.Background(), &model.AuditLogSearch{PerPage: 10})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.VerifyAuditLogs(context.Background())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	th.TestForSystemAdminAndLocal(t, func(t *testing.T, client *model.Client4) {
+		auditLogs, _, err := client.SearchAuditLogs(context.Background(), &model.AuditLogSearch{
+			EventName: model.AuditEventRemoveChannelMember,
+			ObjectId:  th.BasicChannel.Id,
+			PerPage:   10,
+		})
+		require.NoError(t, err)
+		require.Len(t, auditLogs, 1)
+		assert.Equal(t, th.SystemAdminUser.Id, auditLogs[0].UserId)
+		assert.Contains(t, []string(auditLogs[0].ObjectIds), th.BasicUser2.Id)
+
+		auditLogs, _, err = client.SearchAuditLogs(context.Background(), &model.AuditLogSearch{
+			ObjectId: th.BasicChannel.Id,
+			Until:    auditLogs[0].CreateAt - 1,
+			PerPage:  10,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, auditLogs)
+
+		_, resp, err := client.SearchAuditLogs(context.Background(), &model.AuditLogSearch{Since: 2000, Until: 1000, PerPage: 10})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		verification, _, err := client.VerifyAuditLogs(context.Background())
+		require.NoError(t, err)
+		assert.True(t, verification.Valid)
+		assert.GreaterOrEqual(t, verification.Checked, int64(1))
+	}, "search and verify the audit logs")
+}
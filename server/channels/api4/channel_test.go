@@ -614,7 +614,7 @@ func TestPatchChannel(t *testing.T) {
 		th.LinkUserToTeam(groupUser, th.BasicTeam)
 
 		// Create a group member
-		_, appErr := th.App.UpsertGroupMember(group.Id, groupUser.Id)
+		_, appErr := th.App.UpsertGroupMember(th.Context, group.Id, groupUser.Id)
 		require.Nil(t, appErr)
 
 		// Associate the group with the channel
@@ -679,7 +679,7 @@ func TestPatchChannel(t *testing.T) {
 		th.LinkUserToTeam(groupUser, th.BasicTeam)
 
 		// Create a group member
-		_, appErr := th.App.UpsertGroupMember(group.Id, groupUser.Id)
+		_, appErr := th.App.UpsertGroupMember(th.Context, group.Id, groupUser.Id)
 		require.Nil(t, appErr)
 
 		// Associate the group with the channel
@@ -4363,7 +4363,7 @@ func TestAddChannelMember(t *testing.T) {
 	require.Nil(t, appErr)
 
 	// Add user to group
-	_, appErr = th.App.UpsertGroupMember(th.Group.Id, user.Id)
+	_, appErr = th.App.UpsertGroupMember(th.Context, th.Group.Id, user.Id)
 	require.Nil(t, appErr)
 
 	th.TestForSystemAdminAndLocal(t, func(t *testing.T, client *model.Client4) {
@@ -5359,9 +5359,9 @@ func TestChannelMembersMinusGroupMembers(t *testing.T) {
 	group1 := th.CreateGroup()
 	group2 := th.CreateGroup()
 
-	_, appErr = th.App.UpsertGroupMember(group1.Id, user1.Id)
+	_, appErr = th.App.UpsertGroupMember(th.Context, group1.Id, user1.Id)
 	require.Nil(t, appErr)
-	_, appErr = th.App.UpsertGroupMember(group2.Id, user2.Id)
+	_, appErr = th.App.UpsertGroupMember(th.Context, group2.Id, user2.Id)
 	require.Nil(t, appErr)
 
 	// No permissions
@@ -5477,7 +5477,7 @@ func TestGetChannelModerations(t *testing.T) {
 	t.Run("Returns value false and enabled false for permissions that are not present in higher scoped scheme when no channel scheme present", func(t *testing.T) {
 		scheme := th.SetupTeamScheme()
 		team.SchemeId = &scheme.Id
-		_, appErr := th.App.UpdateTeamScheme(team)
+		_, appErr := th.App.UpdateTeamScheme(th.Context, team)
 		require.Nil(t, appErr)
 
 		th.RemovePermissionFromRole(model.PermissionCreatePost.Id, scheme.DefaultChannelGuestRole)
@@ -5519,7 +5519,7 @@ func TestGetChannelModerations(t *testing.T) {
 	t.Run("Returns value false and enabled false for permissions that are not present in channel & team scheme", func(t *testing.T) {
 		teamScheme := th.SetupTeamScheme()
 		team.SchemeId = &teamScheme.Id
-		_, appErr := th.App.UpdateTeamScheme(team)
+		_, appErr := th.App.UpdateTeamScheme(th.Context, team)
 		require.Nil(t, appErr)
 
 		scheme := th.SetupChannelScheme()
@@ -5548,7 +5548,7 @@ func TestGetChannelModerations(t *testing.T) {
 	t.Run("Returns the correct value for manage_members depending on whether the channel is public or private", func(t *testing.T) {
 		scheme := th.SetupTeamScheme()
 		team.SchemeId = &scheme.Id
-		_, appErr := th.App.UpdateTeamScheme(team)
+		_, appErr := th.App.UpdateTeamScheme(th.Context, team)
 		require.Nil(t, appErr)
 
 		th.RemovePermissionFromRole(model.PermissionManagePublicChannelMembers.Id, scheme.DefaultChannelUserRole)
@@ -5576,7 +5576,7 @@ func TestGetChannelModerations(t *testing.T) {
 	t.Run("Returns the correct value for manage_bookmarks depending on whether the channel is public or private", func(t *testing.T) {
 		scheme := th.SetupTeamScheme()
 		team.SchemeId = &scheme.Id
-		_, appErr := th.App.UpdateTeamScheme(team)
+		_, appErr := th.App.UpdateTeamScheme(th.Context, team)
 		require.Nil(t, appErr)
 
 		bookmarkPublicPermissions := []string{
@@ -5642,7 +5642,7 @@ func TestGetChannelModerations(t *testing.T) {
 		th.App.Srv().SetStore(&mockStore)
 
 		team.SchemeId = &scheme.Id
-		_, appErr := th.App.UpdateTeamScheme(team)
+		_, appErr := th.App.UpdateTeamScheme(th.Context, team)
 		require.Nil(t, appErr)
 
 		_, _, err := th.SystemAdminClient.GetChannelModerations(context.Background(), channel.Id, "")
@@ -5801,7 +5801,7 @@ func TestPatchChannelModerations(t *testing.T) {
 		th.App.Srv().SetStore(&mockStore)
 
 		team.SchemeId = &scheme.Id
-		_, appErr := th.App.UpdateTeamScheme(team)
+		_, appErr := th.App.UpdateTeamScheme(th.Context, team)
 		require.Nil(t, appErr)
 
 		moderations, _, err := th.SystemAdminClient.PatchChannelModerations(context.Background(), channel.Id, emptyPatch)
@@ -5870,14 +5870,14 @@ func TestGetChannelMemberCountsByGroup(t *testing.T) {
 	user := th.BasicUser
 	user.Timezone["useAutomaticTimezone"] = "false"
 	user.Timezone["manualTimezone"] = "XOXO/BLABLA"
-	_, appErr := th.App.UpsertGroupMember(th.Group.Id, user.Id)
+	_, appErr := th.App.UpsertGroupMember(th.Context, th.Group.Id, user.Id)
 	require.Nil(t, appErr)
 	_, _, err := th.SystemAdminClient.UpdateUser(context.Background(), user)
 	require.NoError(t, err)
 
 	user2 := th.BasicUser2
 	user2.Timezone["automaticTimezone"] = "NoWhere/Island"
-	_, appErr = th.App.UpsertGroupMember(th.Group.Id, user2.Id)
+	_, appErr = th.App.UpsertGroupMember(th.Context, th.Group.Id, user2.Id)
 	require.Nil(t, appErr)
 	_, _, err = th.SystemAdminClient.UpdateUser(context.Background(), user2)
 	require.NoError(t, err)
@@ -5918,7 +5918,7 @@ func TestGetChannelMemberCountsByGroup(t *testing.T) {
 
 	_, appErr = th.App.CreateGroup(group)
 	require.Nil(t, appErr)
-	_, appErr = th.App.UpsertGroupMember(group.Id, user.Id)
+	_, appErr = th.App.UpsertGroupMember(th.Context, group.Id, user.Id)
 	require.Nil(t, appErr)
 
 	t.Run("Returns multiple groups with users in group with timezones", func(t *testing.T) {
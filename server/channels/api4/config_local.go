@@ -22,6 +22,9 @@ func (api *API) InitConfigLocal() {
 	api.BaseRoutes.APIRoot.Handle("/config/reload", api.APILocal(configReload)).Methods(http.MethodPost)
 	api.BaseRoutes.APIRoot.Handle("/config/migrate", api.APILocal(localMigrateConfig)).Methods(http.MethodPost)
 	api.BaseRoutes.APIRoot.Handle("/config/client", api.APILocal(localGetClientConfig)).Methods(http.MethodGet)
+	api.BaseRoutes.APIRoot.Handle("/config/revisions", api.APILocal(getConfigRevisions)).Methods(http.MethodGet)
+	api.BaseRoutes.APIRoot.Handle("/config/revisions/{revision_id:[A-Za-z0-9]+}/diff", api.APILocal(getConfigRevisionDiff)).Methods(http.MethodGet)
+	api.BaseRoutes.APIRoot.Handle("/config/revisions/{revision_id:[A-Za-z0-9]+}/rollback", api.APILocal(rollbackConfig)).Methods(http.MethodPost)
 }
 
 func localGetConfig(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -72,7 +75,7 @@ func localUpdateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	oldCfg, newCfg, appErr := c.App.SaveConfig(cfg, true)
+	oldCfg, newCfg, appErr := c.App.SaveConfigWithRevision(c.AppContext, cfg, true)
 	if appErr != nil {
 		c.Err = appErr
 		return
@@ -131,7 +134,7 @@ func localPatchConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	oldCfg, newCfg, appErr := c.App.SaveConfig(updatedCfg, true)
+	oldCfg, newCfg, appErr := c.App.SaveConfigWithRevision(c.AppContext, updatedCfg, true)
 	if appErr != nil {
 		c.Err = appErr
 		return
@@ -935,3 +935,97 @@ func TestMigrateConfig(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestConfigRevisions(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	cfg, _, err := th.SystemAdminClient.GetConfig(context.Background())
+	require.NoError(t, err)
+	originalSiteName := *cfg.TeamSettings.SiteName
+
+	cfg.TeamSettings.SiteName = model.NewPointer("Revised")
+	_, _, err = th.SystemAdminClient.UpdateConfig(context.Background(), cfg)
+	require.NoError(t, err)
+
+	t.Run("requires the manage system permission", func(t *testing.T) {
+		th.LoginBasic()
+
+		_, resp, err := th.Client.GetConfigRevisions(context.Background(), 0, 10)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.RollbackConfig(context.Background(), model.NewId())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	revisions, _, err := th.SystemAdminClient.GetConfigRevisions(context.Background(), 0, 10)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(revisions), 2)
+	assert.Equal(t, th.SystemAdminUser.Id, revisions[0].UserId)
+
+	t.Run("diff", func(t *testing.T) {
+		changes, _, err := th.SystemAdminClient.GetConfigRevisionDiff(context.Background(), revisions[0].Id, "")
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		assert.Equal(t, "TeamSettings.SiteName", changes[0].Path)
+		assert.Equal(t, "Revised", changes[0].ActualVal)
+
+		_, resp, err := th.SystemAdminClient.GetConfigRevisionDiff(context.Background(), revisions[0].Id, "invalid")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.SystemAdminClient.GetConfigRevisionDiff(context.Background(), model.NewId(), "")
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		cfg, _, err := th.SystemAdminClient.RollbackConfig(context.Background(), revisions[1].Id)
+		require.NoError(t, err)
+		assert.Equal(t, originalSiteName, *cfg.TeamSettings.SiteName)
+		assert.Equal(t, originalSiteName, *th.App.Config().TeamSettings.SiteName)
+	})
+
+	t.Run("rollback keeps the current secrets", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			cfg.EmailSettings.SMTPPassword = model.NewPointer("smtp-secret")
+		})
+
+		_, _, err := th.SystemAdminClient.RollbackConfig(context.Background(), revisions[0].Id)
+		require.NoError(t, err)
+		assert.Equal(t, "Revised", *th.App.Config().TeamSettings.SiteName)
+		assert.Equal(t, "smtp-secret", *th.App.Config().EmailSettings.SMTPPassword)
+	})
+
+	t.Run("rollback keeps the settings restricted to the system admins", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			cfg.ExperimentalSettings.RestrictSystemAdmin = model.NewPointer(true)
+			cfg.TeamSettings.SiteName = model.NewPointer("Restricted")
+		})
+		defer th.App.UpdateConfig(func(cfg *model.Config) {
+			cfg.ExperimentalSettings.RestrictSystemAdmin = model.NewPointer(false)
+		})
+
+		_, _, err := th.SystemAdminClient.RollbackConfig(context.Background(), revisions[1].Id)
+		require.NoError(t, err)
+		assert.Equal(t, originalSiteName, *th.App.Config().TeamSettings.SiteName)
+		assert.True(t, *th.App.Config().ExperimentalSettings.RestrictSystemAdmin)
+	})
+}
+
+func TestUpdateConfigSecretReferences(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	th.TestForSystemAdminAndLocal(t, func(t *testing.T, client *model.Client4) {
+		_, resp, err := client.PatchConfig(context.Background(), &model.Config{
+			EmailSettings: model.EmailSettings{SMTPPassword: model.NewPointer("file:///etc/passwd")},
+		})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+		CheckErrorID(t, err, "app.config.secret_reference.app_error")
+		assert.NotEqual(t, "file:///etc/passwd", *th.App.Config().EmailSettings.SMTPPassword)
+	})
+}
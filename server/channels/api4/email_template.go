@@ -0,0 +1,206 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func (api *API) InitEmailTemplate() {
+	api.BaseRoutes.EmailTemplates.Handle("", api.APISessionRequired(getEmailTemplates)).Methods(http.MethodGet)
+	api.BaseRoutes.EmailTemplate.Handle("", api.APISessionRequired(getEmailTemplate)).Methods(http.MethodGet)
+	api.BaseRoutes.EmailTemplate.Handle("", api.APISessionRequired(updateEmailTemplate)).Methods(http.MethodPut)
+	api.BaseRoutes.EmailTemplate.Handle("", api.APISessionRequired(deleteEmailTemplate)).Methods(http.MethodDelete)
+	api.BaseRoutes.EmailTemplate.Handle("/preview", api.APISessionRequired(previewEmailTemplate)).Methods(http.MethodPost)
+}
+
+func (api *API) InitEmailTemplateLocal() {
+	api.BaseRoutes.EmailTemplates.Handle("", api.APILocal(getEmailTemplates)).Methods(http.MethodGet)
+	api.BaseRoutes.EmailTemplate.Handle("", api.APILocal(getEmailTemplate)).Methods(http.MethodGet)
+	api.BaseRoutes.EmailTemplate.Handle("", api.APILocal(updateEmailTemplate)).Methods(http.MethodPut)
+	api.BaseRoutes.EmailTemplate.Handle("", api.APILocal(deleteEmailTemplate)).Methods(http.MethodDelete)
+	api.BaseRoutes.EmailTemplate.Handle("/preview", api.APILocal(previewEmailTemplate)).Methods(http.MethodPost)
+}
+
+// emailTemplateLocale returns the locale of the templates the request is about,
+// which defaults to the one of the server.
+func emailTemplateLocale(c *Context, r *http.Request) string {
+	if locale := r.URL.Query().Get("locale"); locale != "" {
+		return locale
+	}
+	return *c.App.Config().LocalizationSettings.DefaultServerLocale
+}
+
+func getEmailTemplates(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadSiteCustomization) {
+		c.SetPermissionError(model.PermissionSysconsoleReadSiteCustomization)
+		return
+	}
+
+	emailTemplates, err := c.App.GetEmailTemplates(emailTemplateLocale(c, r))
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(emailTemplates); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getEmailTemplate(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTemplateName()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadSiteCustomization) {
+		c.SetPermissionError(model.PermissionSysconsoleReadSiteCustomization)
+		return
+	}
+
+	emailTemplate, err := c.App.GetEmailTemplate(c.Params.TemplateName, emailTemplateLocale(c, r))
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(emailTemplate); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func updateEmailTemplate(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTemplateName()
+	if c.Err != nil {
+		return
+	}
+
+	var emailTemplate model.EmailTemplate
+	if jsonErr := json.NewDecoder(r.Body).Decode(&emailTemplate); jsonErr != nil {
+		c.SetInvalidParamWithErr("email_template", jsonErr)
+		return
+	}
+	emailTemplate.Name = c.Params.TemplateName
+	emailTemplate.Locale = emailTemplateLocale(c, r)
+
+	auditRec := c.MakeAuditRecord(model.AuditEventUpdateEmailTemplate, model.AuditStatusFail)
+	defer c.LogAuditRec(auditRec)
+	model.AddEventParameterAuditableToAuditRec(auditRec, "email_template", &emailTemplate)
+	c.LogAudit("attempt")
+
+	// The templates render the emails resetting passwords and verifying email addresses,
+	// so only the system admins may override them.
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	remailTemplate, err := c.App.SaveEmailTemplate(&emailTemplate)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(remailTemplate)
+	auditRec.AddEventObjectType("email_template")
+	c.LogAudit("success")
+
+	if err := json.NewEncoder(w).Encode(remailTemplate); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteEmailTemplate(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTemplateName()
+	if c.Err != nil {
+		return
+	}
+
+	name := c.Params.TemplateName
+	locale := emailTemplateLocale(c, r)
+
+	auditRec := c.MakeAuditRecord(model.AuditEventDeleteEmailTemplate, model.AuditStatusFail)
+	defer c.LogAuditRec(auditRec)
+	model.AddEventParameterToAuditRec(auditRec, "template_name", name)
+	model.AddEventParameterToAuditRec(auditRec, "locale", locale)
+	c.LogAudit("attempt")
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteSiteCustomization) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteSiteCustomization)
+		return
+	}
+
+	emailTemplate, err := c.App.DeleteEmailTemplate(name, locale)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(emailTemplate)
+	auditRec.AddEventObjectType("email_template")
+	c.LogAudit("success")
+
+	if err := json.NewEncoder(w).Encode(emailTemplate); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+// previewEmailTemplate sends the template in the body of the request, or the
+// current one when the body has no content, to the email address of the user,
+// or to the one given in local mode.
+func previewEmailTemplate(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTemplateName()
+	if c.Err != nil {
+		return
+	}
+
+	var emailTemplate model.EmailTemplate
+	if jsonErr := json.NewDecoder(r.Body).Decode(&emailTemplate); jsonErr != nil {
+		c.SetInvalidParamWithErr("email_template", jsonErr)
+		return
+	}
+	emailTemplate.Name = c.Params.TemplateName
+	emailTemplate.Locale = emailTemplateLocale(c, r)
+
+	auditRec := c.MakeAuditRecord(model.AuditEventPreviewEmailTemplate, model.AuditStatusFail)
+	defer c.LogAuditRec(auditRec)
+	model.AddEventParameterAuditableToAuditRec(auditRec, "email_template", &emailTemplate)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	// The local mode has no user to send the preview to.
+	to := r.URL.Query().Get("email")
+	if c.AppContext.Session().IsUnrestricted() {
+		if !model.IsValidEmail(to) {
+			c.SetInvalidParam("email")
+			return
+		}
+	} else {
+		user, err := c.App.GetUser(c.AppContext.Session().UserId)
+		if err != nil {
+			c.Err = err
+			return
+		}
+		to = user.Email
+	}
+	model.AddEventParameterToAuditRec(auditRec, "email", to)
+
+	if err := c.App.SendEmailTemplatePreview(&emailTemplate, to); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
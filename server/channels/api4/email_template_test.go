@@ -0,0 +1,142 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestEmailTemplates(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	newTemplate := func() *model.EmailTemplate {
+		return &model.EmailTemplate{
+			Name:    "email_footer",
+			Locale:  "vi",
+			Content: "<p>Công ty {{.Props.Organization}}</p>",
+		}
+	}
+
+	t.Run("regular users can't manage email templates", func(t *testing.T) {
+		_, resp, err := th.Client.GetEmailTemplates(context.Background(), "vi")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetEmailTemplate(context.Background(), "email_footer", "vi")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.UpdateEmailTemplate(context.Background(), newTemplate())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.DeleteEmailTemplate(context.Background(), "email_footer", "vi")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = th.Client.PreviewEmailTemplate(context.Background(), newTemplate(), "")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	th.TestForSystemAdminAndLocal(t, func(t *testing.T, client *model.Client4) {
+		emailTemplate, _, err := client.GetEmailTemplate(context.Background(), "email_footer", "vi")
+		require.NoError(t, err)
+		assert.False(t, emailTemplate.Overridden)
+		assert.Contains(t, emailTemplate.Content, "{{.Props.Footer}}")
+
+		updated, _, err := client.UpdateEmailTemplate(context.Background(), newTemplate())
+		require.NoError(t, err)
+		assert.True(t, updated.Overridden)
+		assert.Equal(t, newTemplate().Content, updated.Content)
+
+		emailTemplates, _, err := client.GetEmailTemplates(context.Background(), "vi")
+		require.NoError(t, err)
+		require.Len(t, emailTemplates, len(model.EmailTemplateNames))
+		for _, listed := range emailTemplates {
+			assert.Empty(t, listed.Content)
+			assert.Equal(t, listed.Name == "email_footer", listed.Overridden, listed.Name)
+		}
+
+		// The templates of the other locales are left as they were.
+		emailTemplate, _, err = client.GetEmailTemplate(context.Background(), "email_footer", "en")
+		require.NoError(t, err)
+		assert.False(t, emailTemplate.Overridden)
+
+		restored, _, err := client.DeleteEmailTemplate(context.Background(), "email_footer", "vi")
+		require.NoError(t, err)
+		assert.False(t, restored.Overridden)
+		assert.Contains(t, restored.Content, "{{.Props.Footer}}")
+	}, "manage email templates")
+
+	th.TestForSystemAdminAndLocal(t, func(t *testing.T, client *model.Client4) {
+		invalid := newTemplate()
+		invalid.Content = "{{.Props.Organization"
+		_, resp, err := client.UpdateEmailTemplate(context.Background(), invalid)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		invalid = newTemplate()
+		invalid.Content = `{{template "unknown_template" .}}`
+		_, resp, err = client.UpdateEmailTemplate(context.Background(), invalid)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		invalid = newTemplate()
+		invalid.Name = "unknown_template"
+		_, resp, err = client.UpdateEmailTemplate(context.Background(), invalid)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		invalid = newTemplate()
+		invalid.Locale = "xx"
+		_, resp, err = client.UpdateEmailTemplate(context.Background(), invalid)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	}, "invalid templates are rejected")
+
+	t.Run("invalid templates can't be previewed", func(t *testing.T) {
+		invalid := newTemplate()
+		invalid.Content = "{{.Props.Organization"
+		resp, err := th.SystemAdminClient.PreviewEmailTemplate(context.Background(), invalid, "")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		resp, err = th.LocalClient.PreviewEmailTemplate(context.Background(), invalid, "admin@example.com")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("local mode previews need an email address", func(t *testing.T) {
+		resp, err := th.LocalClient.PreviewEmailTemplate(context.Background(), newTemplate(), "")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("the site customization permission doesn't allow overriding templates", func(t *testing.T) {
+		th.AddPermissionToRole(model.PermissionSysconsoleReadSiteCustomization.Id, model.SystemUserRoleId)
+		th.AddPermissionToRole(model.PermissionSysconsoleWriteSiteCustomization.Id, model.SystemUserRoleId)
+		defer th.RemovePermissionFromRole(model.PermissionSysconsoleReadSiteCustomization.Id, model.SystemUserRoleId)
+		defer th.RemovePermissionFromRole(model.PermissionSysconsoleWriteSiteCustomization.Id, model.SystemUserRoleId)
+
+		_, _, err := th.Client.GetEmailTemplate(context.Background(), "reset_body", "vi")
+		require.NoError(t, err)
+
+		_, resp, err := th.Client.UpdateEmailTemplate(context.Background(), newTemplate())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = th.Client.PreviewEmailTemplate(context.Background(), newTemplate(), "")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func (api *API) InitEventSubscription() {
+	api.BaseRoutes.EventSubscriptions.Handle("", api.APISessionRequired(createEventSubscription)).Methods(http.MethodPost)
+	api.BaseRoutes.EventSubscriptions.Handle("", api.APISessionRequired(getEventSubscriptions)).Methods(http.MethodGet)
+	api.BaseRoutes.EventSubscription.Handle("", api.APISessionRequired(getEventSubscription)).Methods(http.MethodGet)
+	api.BaseRoutes.EventSubscription.Handle("/patch", api.APISessionRequired(patchEventSubscription)).Methods(http.MethodPut)
+	api.BaseRoutes.EventSubscription.Handle("", api.APISessionRequired(deleteEventSubscription)).Methods(http.MethodDelete)
+	api.BaseRoutes.EventSubscription.Handle("/regen_secret", api.APISessionRequired(regenEventSubscriptionSecret)).Methods(http.MethodPost)
+}
+
+func (api *API) InitEventSubscriptionLocal() {
+	api.BaseRoutes.EventSubscriptions.Handle("", api.APILocal(createEventSubscription)).Methods(http.MethodPost)
+	api.BaseRoutes.EventSubscriptions.Handle("", api.APILocal(getEventSubscriptions)).Methods(http.MethodGet)
+	api.BaseRoutes.EventSubscription.Handle("", api.APILocal(getEventSubscription)).Methods(http.MethodGet)
+	api.BaseRoutes.EventSubscription.Handle("/patch", api.APILocal(patchEventSubscription)).Methods(http.MethodPut)
+	api.BaseRoutes.EventSubscription.Handle("", api.APILocal(deleteEventSubscription)).Methods(http.MethodDelete)
+	api.BaseRoutes.EventSubscription.Handle("/regen_secret", api.APILocal(regenEventSubscriptionSecret)).Methods(http.MethodPost)
+}
+
+// requireEventSubscription checks the session can manage event subscriptions
+// and returns the one the request is about.
+func requireEventSubscription(c *Context, r *http.Request, permission *model.Permission) *model.EventSubscription {
+	subscriptionID := mux.Vars(r)["subscription_id"]
+	if !model.IsValidId(subscriptionID) {
+		c.SetInvalidURLParam("subscription_id")
+		return nil
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), permission) {
+		c.SetPermissionError(permission)
+		return nil
+	}
+
+	subscription, err := c.App.GetEventSubscription(subscriptionID)
+	if err != nil {
+		c.Err = err
+		return nil
+	}
+
+	return subscription
+}
+
+func createEventSubscription(c *Context, w http.ResponseWriter, r *http.Request) {
+	var subscription model.EventSubscription
+	if jsonErr := json.NewDecoder(r.Body).Decode(&subscription); jsonErr != nil {
+		c.SetInvalidParamWithErr("event_subscription", jsonErr)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord(model.AuditEventCreateEventSubscription, model.AuditStatusFail)
+	defer c.LogAuditRec(auditRec)
+	model.AddEventParameterAuditableToAuditRec(auditRec, "event_subscription", &subscription)
+	c.LogAudit("attempt")
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteIntegrations) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteIntegrations)
+		return
+	}
+
+	subscription.Id = ""
+	subscription.Secret = ""
+	subscription.DeleteAt = 0
+	subscription.CreatorId = c.AppContext.Session().UserId
+
+	rsubscription, err := c.App.CreateEventSubscription(c.AppContext, &subscription)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(rsubscription)
+	auditRec.AddEventObjectType("event_subscription")
+	c.LogAudit("success")
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(rsubscription); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getEventSubscriptions(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadIntegrations) {
+		c.SetPermissionError(model.PermissionSysconsoleReadIntegrations)
+		return
+	}
+
+	subscriptions, err := c.App.GetEventSubscriptions(c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(subscriptions); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getEventSubscription(c *Context, w http.ResponseWriter, r *http.Request) {
+	subscription := requireEventSubscription(c, r, model.PermissionSysconsoleReadIntegrations)
+	if c.Err != nil {
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(subscription); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func patchEventSubscription(c *Context, w http.ResponseWriter, r *http.Request) {
+	var patch model.EventSubscriptionPatch
+	if jsonErr := json.NewDecoder(r.Body).Decode(&patch); jsonErr != nil {
+		c.SetInvalidParamWithErr("event_subscription", jsonErr)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord(model.AuditEventPatchEventSubscription, model.AuditStatusFail)
+	defer c.LogAuditRec(auditRec)
+	model.AddEventParameterToAuditRec(auditRec, "subscription_id", mux.Vars(r)["subscription_id"])
+	c.LogAudit("attempt")
+
+	subscription := requireEventSubscription(c, r, model.PermissionSysconsoleWriteIntegrations)
+	if c.Err != nil {
+		return
+	}
+	auditRec.AddEventPriorState(subscription)
+
+	rsubscription, err := c.App.PatchEventSubscription(c.AppContext, subscription, &patch)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(rsubscription)
+	auditRec.AddEventObjectType("event_subscription")
+	c.LogAudit("success")
+
+	if err := json.NewEncoder(w).Encode(rsubscription); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func regenEventSubscriptionSecret(c *Context, w http.ResponseWriter, r *http.Request) {
+	auditRec := c.MakeAuditRecord(model.AuditEventRegenEventSubscriptionSecret, model.AuditStatusFail)
+	defer c.LogAuditRec(auditRec)
+	model.AddEventParameterToAuditRec(auditRec, "subscription_id", mux.Vars(r)["subscription_id"])
+	c.LogAudit("attempt")
+
+	subscription := requireEventSubscription(c, r, model.PermissionSysconsoleWriteIntegrations)
+	if c.Err != nil {
+		return
+	}
+
+	rsubscription, err := c.App.RegenEventSubscriptionSecret(subscription)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(rsubscription)
+	auditRec.AddEventObjectType("event_subscription")
+	c.LogAudit("success")
+
+	if err := json.NewEncoder(w).Encode(rsubscription); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteEventSubscription(c *Context, w http.ResponseWriter, r *http.Request) {
+	auditRec := c.MakeAuditRecord(model.AuditEventDeleteEventSubscription, model.AuditStatusFail)
+	defer c.LogAuditRec(auditRec)
+	model.AddEventParameterToAuditRec(auditRec, "subscription_id", mux.Vars(r)["subscription_id"])
+	c.LogAudit("attempt")
+
+	subscription := requireEventSubscription(c, r, model.PermissionSysconsoleWriteIntegrations)
+	if c.Err != nil {
+		return
+	}
+	auditRec.AddEventPriorState(subscription)
+
+	if err := c.App.DeleteEventSubscription(subscription.Id); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventObjectType("event_subscription")
+	c.LogAudit("success")
+
+	ReturnStatusOK(w)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestEventSubscriptions(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableOutgoingWebhooks = true })
+
+	newSubscription := func() *model.EventSubscription {
+		return &model.EventSubscription{
+			DisplayName: "Channel joins",
+			URL:         "http://nowhere.com/events",
+			EventTypes:  model.StringArray{model.EventTypeUserHasJoinedChannel},
+			TeamId:      th.BasicTeam.Id,
+		}
+	}
+
+	t.Run("regular users can't manage event subscriptions", func(t *testing.T) {
+		_, resp, err := th.Client.CreateEventSubscription(context.Background(), newSubscription())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetEventSubscriptions(context.Background(), 0, 10)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		subscription, _, err := th.SystemAdminClient.CreateEventSubscription(context.Background(), newSubscription())
+		require.NoError(t, err)
+
+		_, resp, err = th.Client.GetEventSubscription(context.Background(), subscription.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = th.Client.DeleteEventSubscription(context.Background(), subscription.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	th.TestForSystemAdminAndLocal(t, func(t *testing.T, client *model.Client4) {
+		subscription, resp, err := client.CreateEventSubscription(context.Background(), newSubscription())
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		require.NotEmpty(t, subscription.Id)
+		require.NotEmpty(t, subscription.Secret)
+
+		fetched, _, err := client.GetEventSubscription(context.Background(), subscription.Id)
+		require.NoError(t, err)
+		assert.Equal(t, subscription, fetched)
+
+		subscriptions, _, err := client.GetEventSubscriptions(context.Background(), 0, 200)
+		require.NoError(t, err)
+		assert.Contains(t, subscriptions, subscription)
+
+		patched, _, err := client.PatchEventSubscription(context.Background(), subscription.Id, &model.EventSubscriptionPatch{
+			EventTypes: &model.StringArray{model.EventTypeUserHasLeftChannel},
+			ChannelId:  model.NewPointer(th.BasicChannel.Id),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, model.StringArray{model.EventTypeUserHasLeftChannel}, patched.EventTypes)
+		assert.Equal(t, th.BasicChannel.Id, patched.ChannelId)
+		assert.Equal(t, subscription.Secret, patched.Secret)
+
+		regenerated, _, err := client.RegenEventSubscriptionSecret(context.Background(), subscription.Id)
+		require.NoError(t, err)
+		assert.NotEqual(t, subscription.Secret, regenerated.Secret)
+
+		_, err = client.DeleteEventSubscription(context.Background(), subscription.Id)
+		require.NoError(t, err)
+
+		_, resp, err = client.GetEventSubscription(context.Background(), subscription.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	th.TestForSystemAdminAndLocal(t, func(t *testing.T, client *model.Client4) {
+		subscription := newSubscription()
+		subscription.EventTypes = model.StringArray{"Unknown"}
+		_, resp, err := client.CreateEventSubscription(context.Background(), subscription)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		subscription = newSubscription()
+		subscription.ChannelId = th.CreateChannelWithClientAndTeam(th.SystemAdminClient, model.ChannelTypeOpen, th.CreateTeam().Id).Id
+		_, resp, err = client.CreateEventSubscription(context.Background(), subscription)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	}, "invalid subscriptions")
+
+	t.Run("disabled outgoing webhooks", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableOutgoingWebhooks = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableOutgoingWebhooks = true })
+
+		_, resp, err := th.SystemAdminClient.CreateEventSubscription(context.Background(), newSubscription())
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+}
@@ -11,7 +11,9 @@ import (
 	"mime"
 	"mime/multipart"
 	"net/http"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/mattermost/mattermost/server/public/model"
@@ -26,20 +28,21 @@ const (
 
 	PreviewImageType   = "image/jpeg"
 	ThumbnailImageType = "image/jpeg"
+	WebPImageType      = "image/webp"
 )
 
 const maxMultipartFormDataBytes = 10 * 1024 // 10Kb
 
 func (api *API) InitFile() {
-	api.BaseRoutes.Files.Handle("", api.APISessionRequired(uploadFileStream, handlerParamFileAPI)).Methods(http.MethodPost)
+	api.BaseRoutes.Files.Handle("", api.RateLimitedClassHandler(app.RateLimitClassFileUpload, api.APISessionRequired(uploadFileStream, handlerParamFileAPI))).Methods(http.MethodPost)
 	api.BaseRoutes.File.Handle("", api.APISessionRequiredTrustRequester(getFile)).Methods(http.MethodGet)
 	api.BaseRoutes.File.Handle("/thumbnail", api.APISessionRequiredTrustRequester(getFileThumbnail)).Methods(http.MethodGet)
 	api.BaseRoutes.File.Handle("/link", api.APISessionRequired(getFileLink)).Methods(http.MethodGet)
 	api.BaseRoutes.File.Handle("/preview", api.APISessionRequiredTrustRequester(getFilePreview)).Methods(http.MethodGet)
 	api.BaseRoutes.File.Handle("/info", api.APISessionRequired(getFileInfo)).Methods(http.MethodGet)
 
-	api.BaseRoutes.Team.Handle("/files/search", api.APISessionRequiredDisableWhenBusy(searchFilesInTeam)).Methods(http.MethodPost)
-	api.BaseRoutes.Files.Handle("/search", api.APISessionRequiredDisableWhenBusy(searchFilesInAllTeams)).Methods(http.MethodPost)
+	api.BaseRoutes.Team.Handle("/files/search", api.RateLimitedClassHandler(app.RateLimitClassSearch, api.APISessionRequiredDisableWhenBusy(searchFilesInTeam))).Methods(http.MethodPost)
+	api.BaseRoutes.Files.Handle("/search", api.RateLimitedClassHandler(app.RateLimitClassSearch, api.APISessionRequiredDisableWhenBusy(searchFilesInAllTeams))).Methods(http.MethodPost)
 
 	api.BaseRoutes.PublicFile.Handle("", api.APIHandler(getPublicFile)).Methods(http.MethodGet, http.MethodHead)
 }
@@ -547,6 +550,10 @@ func getFileThumbnail(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if writeWebPVariant(c, w, r, info, info.ThumbnailPath, forceDownload) {
+		return
+	}
+
 	fileReader, err := c.App.FileReader(info.ThumbnailPath)
 	if err != nil {
 		c.Err = err
@@ -647,6 +654,10 @@ func getFilePreview(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if writeWebPVariant(c, w, r, info, info.PreviewPath, forceDownload) {
+		return
+	}
+
 	fileReader, err := c.App.FileReader(info.PreviewPath)
 	if err != nil {
 		c.Err = err
@@ -815,6 +826,53 @@ func searchFiles(c *Context, w http.ResponseWriter, r *http.Request, teamID stri
 	}
 }
 
+// acceptsWebP reports whether the client listed image/webp in its Accept
+// header without explicitly refusing it.
+func acceptsWebP(r *http.Request) bool {
+	for _, accept := range r.Header.Values("Accept") {
+		for mediaRange := range strings.SplitSeq(accept, ",") {
+			mediaType, params, _ := strings.Cut(mediaRange, ";")
+			if !strings.EqualFold(strings.TrimSpace(mediaType), WebPImageType) {
+				continue
+			}
+			for param := range strings.SplitSeq(params, ";") {
+				if q, ok := strings.CutPrefix(strings.TrimSpace(param), "q="); ok {
+					if v, err := strconv.ParseFloat(q, 64); err == nil && v == 0 {
+						return false
+					}
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// writeWebPVariant serves a WebP copy of the given preview or thumbnail
+// image when enabled and accepted by the client. It returns false when the
+// original image should be served instead.
+func writeWebPVariant(c *Context, w http.ResponseWriter, r *http.Request, info *model.FileInfo, path string, forceDownload bool) bool {
+	if !*c.App.Config().FileSettings.EnableWebPPreviews || info.MimeType == "image/gif" {
+		return false
+	}
+
+	w.Header().Add("Vary", "Accept")
+	if !acceptsWebP(r) {
+		return false
+	}
+
+	fileReader, err := c.App.GetWebPVariantReader(path)
+	if err != nil {
+		c.Logger.Warn("Unable to get WebP variant, falling back to the original image", mlog.String("file_id", info.Id), mlog.Err(err))
+		return false
+	}
+	defer fileReader.Close()
+
+	name := strings.TrimSuffix(info.Name, filepath.Ext(info.Name)) + ".webp"
+	web.WriteFileResponse(name, WebPImageType, 0, time.Unix(0, info.UpdateAt*int64(1000*1000)), *c.App.Config().ServiceSettings.WebserverMode, fileReader, forceDownload, w, r)
+	return true
+}
+
 func setInaccessibleFileHeader(w http.ResponseWriter, appErr *model.AppError) {
 	// File is inaccessible due to cloud plan's limit.
 	if appErr.Id == "app.file.cloud.get.app_error" {
@@ -1549,3 +1549,87 @@ func TestSearchFilesAcrossTeams(t *testing.T) {
 	require.Len(t, fileInfos.Order, 1, "wrong search")
 	require.Equal(t, fileInfos.FileInfos[fileInfos.Order[0]].ChannelId, channels[0].Id, "wrong search")
 }
+
+func TestAcceptsWebP(t *testing.T) {
+	for name, tc := range map[string]struct {
+		accept   string
+		expected bool
+	}{
+		"no header":      {"", false},
+		"browser":        {"image/avif,image/webp,image/apng,image/svg+xml,image/*,*/*;q=0.8", true},
+		"wildcard only":  {"image/*", false},
+		"case":           {"Image/WebP", true},
+		"with quality":   {"image/webp;q=0.5, image/jpeg", true},
+		"refused":        {"image/webp;q=0, image/jpeg", false},
+		"other formats":  {"image/png, image/jpeg", false},
+		"extra spacing":  {"image/png ,  image/webp ", true},
+		"other q values": {"image/webp; q=1.0", true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			r, err := http.NewRequest(http.MethodGet, "/", nil)
+			require.NoError(t, err)
+			if tc.accept != "" {
+				r.Header.Set("Accept", tc.accept)
+			}
+			require.Equal(t, tc.expected, acceptsWebP(r))
+		})
+	}
+}
+
+func TestGetFileThumbnailWebP(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	if *th.App.Config().FileSettings.DriverName == "" {
+		t.Skip("skipping because no file driver is enabled")
+	}
+
+	sent, err := testutils.ReadTestFile("test.png")
+	require.NoError(t, err)
+
+	fileResp, _, err := client.UploadFile(context.Background(), sent, th.BasicChannel.Id, "test.png")
+	require.NoError(t, err)
+	fileID := fileResp.FileInfos[0].Id
+
+	getWithAccept := func(t *testing.T, route, accept string) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, client.APIURL+"/files/"+fileID+route, nil)
+		require.NoError(t, err)
+		req.Header.Set(model.HeaderAuth, model.HeaderBearer+" "+client.AuthToken)
+		req.Header.Set("Accept", accept)
+		resp, err := client.HTTPClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.FileSettings.EnableWebPPreviews = false })
+
+		resp := getWithAccept(t, "/thumbnail", "image/webp,*/*")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.NotEqual(t, "image/webp", resp.Header.Get("Content-Type"))
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.FileSettings.EnableWebPPreviews = true })
+
+	for _, route := range []string{"/thumbnail", "/preview"} {
+		t.Run("webp accepted "+route, func(t *testing.T) {
+			resp := getWithAccept(t, route, "image/webp,*/*")
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.Equal(t, "image/webp", resp.Header.Get("Content-Type"))
+			require.Contains(t, resp.Header.Values("Vary"), "Accept")
+
+			data, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, "WEBP", string(data[8:12]))
+		})
+
+		t.Run("webp not accepted "+route, func(t *testing.T) {
+			resp := getWithAccept(t, route, "image/png,image/jpeg")
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.NotEqual(t, "image/webp", resp.Header.Get("Content-Type"))
+		})
+	}
+}
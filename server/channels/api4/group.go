@@ -1389,7 +1389,7 @@ func addGroupMembers(c *Context, w http.ResponseWriter, r *http.Request) {
 	defer c.LogAuditRec(auditRec)
 	model.AddEventParameterToAuditRec(auditRec, "addGroupMembers_userids", newMembers.UserIds)
 
-	members, appErr := c.App.UpsertGroupMembers(c.Params.GroupId, newMembers.UserIds)
+	members, appErr := c.App.UpsertGroupMembers(c.AppContext, c.Params.GroupId, newMembers.UserIds)
 	if appErr != nil {
 		c.Err = appErr
 		return
@@ -1457,7 +1457,7 @@ func deleteGroupMembers(c *Context, w http.ResponseWriter, r *http.Request) {
 	defer c.LogAuditRec(auditRec)
 	model.AddEventParameterToAuditRec(auditRec, "deleteGroupMembers_userids", deleteBody.UserIds)
 
-	members, appErr := c.App.DeleteGroupMembers(c.Params.GroupId, deleteBody.UserIds)
+	members, appErr := c.App.DeleteGroupMembers(c.AppContext, c.Params.GroupId, deleteBody.UserIds)
 	if appErr != nil {
 		c.Err = appErr
 		return
@@ -854,7 +854,7 @@ func TestUnlinkGroupChannel(t *testing.T) {
 		th.LinkUserToTeam(groupUser, th.BasicTeam)
 
 		// Create a group member
-		_, appErr := th.App.UpsertGroupMember(group.Id, groupUser.Id)
+		_, appErr := th.App.UpsertGroupMember(th.Context, group.Id, groupUser.Id)
 		require.Nil(t, appErr)
 
 		// Associate the group with the channel
@@ -938,7 +938,7 @@ func TestUnlinkGroupChannel(t *testing.T) {
 		th.LinkUserToTeam(groupUser, th.BasicTeam)
 
 		// Create a group member
-		_, appErr := th.App.UpsertGroupMember(group.Id, groupUser.Id)
+		_, appErr := th.App.UpsertGroupMember(th.Context, group.Id, groupUser.Id)
 		require.Nil(t, appErr)
 
 		// Associate the group with the channel
@@ -2167,7 +2167,7 @@ func TestGetGroups(t *testing.T) {
 		assert.Equal(t, *groups[0].MemberCount, int(0))
 		assert.Equal(t, *groups[0].ChannelMemberCount, int(0))
 
-		_, appErr = th.App.UpsertGroupMember(group2.Id, th.BasicUser.Id)
+		_, appErr = th.App.UpsertGroupMember(th.Context, group2.Id, th.BasicUser.Id)
 		require.Nil(t, appErr)
 
 		groups, resp, err = th.SystemAdminClient.GetGroups(context.Background(), opts)
@@ -2405,7 +2405,7 @@ func TestGetGroupsByUserId(t *testing.T) {
 	user1, appErr := th.App.CreateUser(th.Context, &model.User{Email: th.GenerateTestEmail(), Nickname: "test user1", Password: "test-password-1", Username: "test-user-1", Roles: model.SystemUserRoleId})
 	assert.Nil(t, appErr)
 	user1.Password = "test-password-1"
-	_, appErr = th.App.UpsertGroupMember(group1.Id, user1.Id)
+	_, appErr = th.App.UpsertGroupMember(th.Context, group1.Id, user1.Id)
 	assert.Nil(t, appErr)
 
 	id = model.NewId()
@@ -2419,7 +2419,7 @@ func TestGetGroupsByUserId(t *testing.T) {
 	})
 	assert.Nil(t, appErr)
 
-	_, appErr = th.App.UpsertGroupMember(group2.Id, user1.Id)
+	_, appErr = th.App.UpsertGroupMember(th.Context, group2.Id, user1.Id)
 	assert.Nil(t, appErr)
 
 	th.App.Srv().SetLicense(nil)
@@ -2480,7 +2480,7 @@ func TestGetGroupMembers(t *testing.T) {
 	user2, appErr := th.App.CreateUser(th.Context, &model.User{Email: th.GenerateTestEmail(), Nickname: "test user2", Password: "test-password-2", Username: "test-user-2", Roles: model.SystemUserRoleId})
 	assert.Nil(t, appErr)
 
-	_, appErr = th.App.UpsertGroupMembers(group.Id, []string{user1.Id, user2.Id})
+	_, appErr = th.App.UpsertGroupMembers(th.Context, group.Id, []string{user1.Id, user2.Id})
 	require.Nil(t, appErr)
 
 	t.Run("Requires ldap license", func(t *testing.T) {
@@ -2566,7 +2566,7 @@ func TestGetGroupStats(t *testing.T) {
 
 	user1, err := th.App.CreateUser(th.Context, &model.User{Email: th.GenerateTestEmail(), Nickname: "test user1", Password: "test-password-1", Username: "test-user-1", Roles: model.SystemUserRoleId})
 	assert.Nil(t, err)
-	_, appErr = th.App.UpsertGroupMember(group.Id, user1.Id)
+	_, appErr = th.App.UpsertGroupMember(th.Context, group.Id, user1.Id)
 	assert.Nil(t, appErr)
 
 	t.Run("Returns stats for a group with members", func(t *testing.T) {
@@ -2618,7 +2618,7 @@ func TestGetGroupsGroupConstrainedParentTeam(t *testing.T) {
 	require.Contains(t, apiGroups, groups[2])
 
 	team.GroupConstrained = model.NewPointer(true)
-	team, appErr = th.App.UpdateTeam(team)
+	team, appErr = th.App.UpdateTeam(th.Context, team)
 	require.Nil(t, appErr)
 
 	// team is group-constrained but has no associated groups
@@ -230,6 +230,17 @@ func (api *API) RateLimitedHandler(apiHandler http.Handler, settings model.RateL
 	return rateLimiter.RateLimitHandler(apiHandler)
 }
 
+// RateLimitedClassHandler applies the quota of the given class on top of the default rate
+// limit, when rate limiting is enabled.
+func (api *API) RateLimitedClassHandler(class string, apiHandler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rateLimiter := api.srv.RateLimiter; rateLimiter != nil && rateLimiter.RateLimitClass(class, r, w) {
+			return
+		}
+		apiHandler.ServeHTTP(w, r)
+	})
+}
+
 func requireLicense(c *Context) *model.AppError {
 	if c.App.Channels().License() == nil {
 		err := model.NewAppError("", "api.license_error", nil, "", http.StatusNotImplemented)
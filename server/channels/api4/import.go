@@ -6,14 +6,17 @@ package api4
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/app/imports"
 )
 
 func (api *API) InitImport() {
 	api.BaseRoutes.Imports.Handle("", api.APISessionRequired(listImports)).Methods(http.MethodGet)
 	api.BaseRoutes.Import.Handle("", api.APISessionRequired(deleteImport)).Methods(http.MethodDelete)
+	api.BaseRoutes.Import.Handle("/error_report", api.APISessionRequired(downloadImportErrorReport)).Methods(http.MethodGet)
 }
 
 func listImports(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -52,3 +55,20 @@ func deleteImport(c *Context, w http.ResponseWriter, r *http.Request) {
 	auditRec.Success()
 	ReturnStatusOK(w)
 }
+
+func downloadImportErrorReport(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.IsSystemAdmin() {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	file, appErr := c.App.ImportErrorReportReader(c.Params.ImportName)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	defer file.Close()
+
+	w.Header().Set("Content-Type", "application/jsonl")
+	http.ServeContent(w, r, imports.ErrorReportFileName(c.Params.ImportName), time.Time{}, file)
+}
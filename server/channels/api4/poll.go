@@ -0,0 +1,145 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func (api *API) InitPoll() {
+	api.BaseRoutes.Polls.Handle("", api.APISessionRequired(createPoll)).Methods(http.MethodPost)
+	api.BaseRoutes.Poll.Handle("", api.APISessionRequired(getPoll)).Methods(http.MethodGet)
+	api.BaseRoutes.Poll.Handle("/results", api.APISessionRequired(getPollResults)).Methods(http.MethodGet)
+	api.BaseRoutes.Poll.Handle("/close", api.APISessionRequired(closePoll)).Methods(http.MethodPost)
+}
+
+// requirePoll returns the poll of the request if the user of the session can
+// read its channel.
+func requirePoll(c *Context) *model.Poll {
+	c.RequirePollId()
+	if c.Err != nil {
+		return nil
+	}
+
+	poll, appErr := c.App.GetPoll(c.Params.PollId)
+	if appErr != nil {
+		c.Err = appErr
+		return nil
+	}
+
+	channel, appErr := c.App.GetChannel(c.AppContext, poll.ChannelId)
+	if appErr != nil {
+		c.Err = appErr
+		return nil
+	}
+
+	if !c.App.SessionHasPermissionToReadChannel(c.AppContext, *c.AppContext.Session(), channel) {
+		c.SetPermissionError(model.PermissionReadChannelContent)
+		return nil
+	}
+
+	return poll
+}
+
+func createPoll(c *Context, w http.ResponseWriter, r *http.Request) {
+	var poll model.Poll
+	if jsonErr := json.NewDecoder(r.Body).Decode(&poll); jsonErr != nil {
+		c.SetInvalidParamWithErr("poll", jsonErr)
+		return
+	}
+	poll.Id = ""
+	poll.CreatorId = c.AppContext.Session().UserId
+
+	auditRec := c.MakeAuditRecord(model.AuditEventCreatePoll, model.AuditStatusFail)
+	defer c.LogAuditRec(auditRec)
+	model.AddEventParameterAuditableToAuditRec(auditRec, "poll", &poll)
+
+	if !model.IsValidId(poll.ChannelId) {
+		c.SetInvalidParam("channel_id")
+		return
+	}
+
+	userCreatePostPermissionCheckWithContext(c, poll.ChannelId)
+	if c.Err != nil {
+		return
+	}
+
+	rpoll, appErr := c.App.CreatePoll(c.AppContext, &poll)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(rpoll)
+	auditRec.AddEventObjectType("poll")
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(rpoll); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getPoll(c *Context, w http.ResponseWriter, r *http.Request) {
+	poll := requirePoll(c)
+	if c.Err != nil {
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(poll); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getPollResults(c *Context, w http.ResponseWriter, r *http.Request) {
+	poll := requirePoll(c)
+	if c.Err != nil {
+		return
+	}
+
+	results, appErr := c.App.GetPollResults(poll)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(results); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func closePoll(c *Context, w http.ResponseWriter, r *http.Request) {
+	auditRec := c.MakeAuditRecord(model.AuditEventClosePoll, model.AuditStatusFail)
+	defer c.LogAuditRec(auditRec)
+	model.AddEventParameterToAuditRec(auditRec, "poll_id", c.Params.PollId)
+
+	poll := requirePoll(c)
+	if c.Err != nil {
+		return
+	}
+	auditRec.AddEventPriorState(poll)
+
+	if !c.App.CanClosePoll(c.AppContext, poll, c.AppContext.Session().UserId) {
+		c.SetPermissionError(model.PermissionEditOthersPosts)
+		return
+	}
+
+	rpoll, appErr := c.App.ClosePoll(c.AppContext, poll)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(rpoll)
+	auditRec.AddEventObjectType("poll")
+
+	if err := json.NewEncoder(w).Encode(rpoll); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
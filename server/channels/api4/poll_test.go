@@ -0,0 +1,94 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestPolls(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	newPoll := func(channelID string) *model.Poll {
+		return &model.Poll{
+			ChannelId: channelID,
+			Question:  "Where do we go for lunch?",
+			Options:   model.PollOptions{{Text: "Pizza"}, {Text: "Sushi"}},
+		}
+	}
+
+	t.Run("create, get and close", func(t *testing.T) {
+		poll, resp, err := th.Client.CreatePoll(context.Background(), newPoll(th.BasicChannel.Id))
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		assert.Equal(t, th.BasicUser.Id, poll.CreatorId)
+		require.NotEmpty(t, poll.PostId)
+
+		fetched, _, err := th.Client.GetPoll(context.Background(), poll.Id)
+		require.NoError(t, err)
+		assert.Equal(t, poll.Question, fetched.Question)
+
+		results, _, err := th.Client.GetPollResults(context.Background(), poll.Id)
+		require.NoError(t, err)
+		assert.Equal(t, 0, results.TotalVoters)
+		assert.Len(t, results.Counts, 2)
+
+		closed, _, err := th.Client.ClosePoll(context.Background(), poll.Id)
+		require.NoError(t, err)
+		assert.NotZero(t, closed.ClosedAt)
+	})
+
+	t.Run("only the creator can close a poll", func(t *testing.T) {
+		poll, _, err := th.SystemAdminClient.CreatePoll(context.Background(), newPoll(th.BasicChannel.Id))
+		require.NoError(t, err)
+
+		_, resp, err := th.Client.ClosePoll(context.Background(), poll.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, _, err = th.SystemAdminClient.ClosePoll(context.Background(), poll.Id)
+		require.NoError(t, err)
+	})
+
+	t.Run("channel permissions", func(t *testing.T) {
+		privateChannel := th.CreatePrivateChannel()
+		poll, _, err := th.Client.CreatePoll(context.Background(), newPoll(privateChannel.Id))
+		require.NoError(t, err)
+
+		th.LoginBasic2()
+		defer th.LoginBasic()
+
+		_, resp, err := th.Client.CreatePoll(context.Background(), newPoll(privateChannel.Id))
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetPoll(context.Background(), poll.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetPollResults(context.Background(), poll.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid poll", func(t *testing.T) {
+		poll := newPoll(th.BasicChannel.Id)
+		poll.Options = poll.Options[:1]
+		_, resp, err := th.Client.CreatePoll(context.Background(), poll)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.Client.GetPoll(context.Background(), model.NewId())
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
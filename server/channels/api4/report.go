@@ -18,6 +18,7 @@ func (api *API) InitReports() {
 	api.BaseRoutes.Reports.Handle("/users", api.APISessionRequired(getUsersForReporting)).Methods(http.MethodGet)
 	api.BaseRoutes.Reports.Handle("/users/count", api.APISessionRequired(getUserCountForReporting)).Methods(http.MethodGet)
 	api.BaseRoutes.Reports.Handle("/users/export", api.APISessionRequired(startUsersBatchExport)).Methods(http.MethodPost)
+	api.BaseRoutes.Reports.Handle("/users/password_hashes", api.APISessionRequired(getPasswordHashReport)).Methods(http.MethodGet)
 }
 
 func getUsersForReporting(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -75,6 +76,23 @@ func getUserCountForReporting(c *Context, w http.ResponseWriter, r *http.Request
 	}
 }
 
+func getPasswordHashReport(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadAuthenticationPassword) {
+		c.SetPermissionError(model.PermissionSysconsoleReadAuthenticationPassword)
+		return
+	}
+
+	report, err := c.App.GetPasswordHashReport()
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if jsonErr := json.NewEncoder(w).Encode(report); jsonErr != nil {
+		c.Logger.Warn("Error writing response", mlog.Err(jsonErr))
+	}
+}
+
 func startUsersBatchExport(c *Context, w http.ResponseWriter, r *http.Request) {
 	if !(c.IsSystemAdmin()) {
 		c.SetPermissionError(model.PermissionManageSystem)
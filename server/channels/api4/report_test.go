@@ -59,6 +59,30 @@ func TestGetUsersForReporting(t *testing.T) {
 	})
 }
 
+func TestGetPasswordHashReport(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("should return forbidden error when user lacks permission", func(t *testing.T) {
+		_, resp, err := th.Client.GetPasswordHashReport(context.Background())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("should count the users by algorithm", func(t *testing.T) {
+		report, resp, err := th.SystemAdminClient.GetPasswordHashReport(context.Background())
+		require.NoError(t, err)
+		CheckOKStatus(t, resp)
+
+		var total int64
+		for _, line := range report {
+			total += line.Count
+		}
+		require.GreaterOrEqual(t, total, int64(2))
+	})
+}
+
 func TestFillReportingBaseOptions(t *testing.T) {
 	mainHelper.Parallel(t)
 	t.Run("default values", func(t *testing.T) {
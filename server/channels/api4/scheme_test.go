@@ -904,7 +904,7 @@ func TestUpdateTeamSchemeWithTeamMembers(t *testing.T) {
 		require.NoError(t, err)
 
 		team.SchemeId = &teamScheme.Id
-		team, appErr = th.App.UpdateTeamScheme(team)
+		team, appErr = th.App.UpdateTeamScheme(th.Context, team)
 		require.Nil(t, appErr)
 
 		_, _, err = th.Client.CreateChannel(context.Background(), &model.Channel{DisplayName: "Test API Name", Name: GenerateTestChannelName(), Type: model.ChannelTypeOpen, TeamId: team.Id})
@@ -222,7 +222,7 @@ func updateTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	updatedTeam, err := c.App.UpdateTeam(&team)
+	updatedTeam, err := c.App.UpdateTeam(c.AppContext, &team)
 	if err != nil {
 		c.Err = err
 		return
@@ -271,7 +271,7 @@ func patchTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 		auditRec.AddEventObjectType("team")
 	}
 
-	patchedTeam, err := c.App.PatchTeam(c.Params.TeamId, &team)
+	patchedTeam, err := c.App.PatchTeam(c.AppContext, c.Params.TeamId, &team)
 	if err != nil {
 		c.Err = err
 		return
@@ -397,7 +397,7 @@ func updateTeamPrivacy(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := c.App.UpdateTeamPrivacy(c.Params.TeamId, privacy, openInvite); err != nil {
+	if err := c.App.UpdateTeamPrivacy(c.AppContext, c.Params.TeamId, privacy, openInvite); err != nil {
 		c.Err = err
 		return
 	}
@@ -1850,7 +1850,7 @@ func updateTeamScheme(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	team.SchemeId = schemeID
 
-	team, err = c.App.UpdateTeamScheme(team)
+	team, err = c.App.UpdateTeamScheme(c.AppContext, team)
 	if err != nil {
 		c.Err = err
 		return
@@ -766,7 +766,7 @@ func TestPatchTeam(t *testing.T) {
 		th.LinkUserToTeam(groupUser, th.BasicTeam)
 
 		// Create a group member
-		_, appErr = th.App.UpsertGroupMember(group.Id, groupUser.Id)
+		_, appErr = th.App.UpsertGroupMember(th.Context, group.Id, groupUser.Id)
 		require.Nil(t, appErr)
 
 		// Associate the group with the team
@@ -831,7 +831,7 @@ func TestPatchTeam(t *testing.T) {
 		th.LinkUserToTeam(groupUser, th.BasicTeam)
 
 		// Create a group member
-		_, appErr = th.App.UpsertGroupMember(group.Id, groupUser.Id)
+		_, appErr = th.App.UpsertGroupMember(th.Context, group.Id, groupUser.Id)
 		require.Nil(t, appErr)
 
 		// Associate the group with the team
@@ -1841,7 +1841,7 @@ func TestSearchAllTeams(t *testing.T) {
 	oTeam := th.BasicTeam
 	oTeam.AllowOpenInvite = true
 
-	updatedTeam, appErr := th.App.UpdateTeam(oTeam)
+	updatedTeam, appErr := th.App.UpdateTeam(th.Context, oTeam)
 	require.Nil(t, appErr)
 	oTeam.UpdateAt = updatedTeam.UpdateAt
 
@@ -2687,7 +2687,7 @@ func TestAddTeamMember(t *testing.T) {
 
 	// Set a team to group-constrained
 	team.GroupConstrained = model.NewPointer(true)
-	_, appErr = th.App.UpdateTeam(team)
+	_, appErr = th.App.UpdateTeam(th.Context, team)
 	require.Nil(t, appErr)
 
 	// Attempt to use a token on a group-constrained team
@@ -2718,7 +2718,7 @@ func TestAddTeamMember(t *testing.T) {
 	require.Nil(t, appErr)
 
 	// Add user to group
-	_, appErr = th.App.UpsertGroupMember(th.Group.Id, otherUser.Id)
+	_, appErr = th.App.UpsertGroupMember(th.Context, th.Group.Id, otherUser.Id)
 	require.Nil(t, appErr)
 
 	th.TestForSystemAdminAndLocal(t, func(t *testing.T, client *model.Client4) {
@@ -2835,7 +2835,7 @@ func TestAddTeamMemberMyself(t *testing.T) {
 		t.Run(tc.Name, func(t *testing.T) {
 			team := th.CreateTeam()
 			team.AllowOpenInvite = tc.Public
-			_, appErr := th.App.UpdateTeam(team)
+			_, appErr := th.App.UpdateTeam(th.Context, team)
 			require.Nil(t, appErr)
 			if tc.PublicPermission {
 				th.AddPermissionToRole(model.PermissionJoinPublicTeams.Id, model.SystemUserRoleId)
@@ -3052,7 +3052,7 @@ func TestAddTeamMembers(t *testing.T) {
 
 	// Set a team to group-constrained
 	team.GroupConstrained = model.NewPointer(true)
-	_, appErr = th.App.UpdateTeam(team)
+	_, appErr = th.App.UpdateTeam(th.Context, team)
 	require.Nil(t, appErr)
 
 	// User is not in associated groups so shouldn't be allowed
@@ -3072,7 +3072,7 @@ func TestAddTeamMembers(t *testing.T) {
 	require.Nil(t, appErr)
 
 	// Add user to group
-	_, appErr = th.App.UpsertGroupMember(th.Group.Id, userList[0])
+	_, appErr = th.App.UpsertGroupMember(th.Context, th.Group.Id, userList[0])
 	require.Nil(t, appErr)
 
 	_, _, err = client.AddTeamMembers(context.Background(), team.Id, userList)
@@ -3165,7 +3165,7 @@ func TestRemoveTeamMember(t *testing.T) {
 
 	// If the team is group-constrained the user cannot be removed
 	th.BasicTeam.GroupConstrained = model.NewPointer(true)
-	_, appErr := th.App.UpdateTeam(th.BasicTeam)
+	_, appErr := th.App.UpdateTeam(th.Context, th.BasicTeam)
 	require.Nil(t, appErr)
 	th.TestForSystemAdminAndLocal(t, func(t *testing.T, client *model.Client4) {
 		_, err2 := client.RemoveTeamMember(context.Background(), th.BasicTeam.Id, th.BasicUser.Id)
@@ -3522,20 +3522,20 @@ func TestTeamExists(t *testing.T) {
 	defer th.TearDown()
 	client := th.Client
 	public_member_team := th.BasicTeam
-	err := th.App.UpdateTeamPrivacy(public_member_team.Id, model.TeamOpen, true)
+	err := th.App.UpdateTeamPrivacy(th.Context, public_member_team.Id, model.TeamOpen, true)
 	require.Nil(t, err)
 
 	public_not_member_team := th.CreateTeamWithClient(th.SystemAdminClient)
-	err = th.App.UpdateTeamPrivacy(public_not_member_team.Id, model.TeamOpen, true)
+	err = th.App.UpdateTeamPrivacy(th.Context, public_not_member_team.Id, model.TeamOpen, true)
 	require.Nil(t, err)
 
 	private_member_team := th.CreateTeamWithClient(th.SystemAdminClient)
 	th.LinkUserToTeam(th.BasicUser, private_member_team)
-	err = th.App.UpdateTeamPrivacy(private_member_team.Id, model.TeamInvite, false)
+	err = th.App.UpdateTeamPrivacy(th.Context, private_member_team.Id, model.TeamInvite, false)
 	require.Nil(t, err)
 
 	private_not_member_team := th.CreateTeamWithClient(th.SystemAdminClient)
-	err = th.App.UpdateTeamPrivacy(private_not_member_team.Id, model.TeamInvite, false)
+	err = th.App.UpdateTeamPrivacy(th.Context, private_not_member_team.Id, model.TeamInvite, false)
 	require.Nil(t, err)
 
 	// Check the appropriate permissions are enforced.
@@ -3860,11 +3860,11 @@ func TestInviteUsersToTeam(t *testing.T) {
 
 	th.TestForAllClients(t, func(t *testing.T, client *model.Client4) {
 		th.BasicTeam.AllowedDomains = "invalid.com,common.com"
-		_, appErr := th.App.UpdateTeam(th.BasicTeam)
+		_, appErr := th.App.UpdateTeam(th.Context, th.BasicTeam)
 		require.NotNil(t, appErr, "Should not update the team")
 
 		th.BasicTeam.AllowedDomains = "common.com"
-		_, appErr = th.App.UpdateTeam(th.BasicTeam)
+		_, appErr = th.App.UpdateTeam(th.Context, th.BasicTeam)
 		require.Nilf(t, appErr, "%v, Should update the team", appErr)
 
 		_, err := client.InviteUsersToTeam(context.Background(), th.BasicTeam.Id, []string{"test@global.com"})
@@ -3885,7 +3885,7 @@ func TestInviteUsersToTeam(t *testing.T) {
 
 	th.TestForAllClients(t, func(t *testing.T, client *model.Client4) {
 		th.BasicTeam.AllowedDomains = "common.com"
-		_, appErr := th.App.UpdateTeam(th.BasicTeam)
+		_, appErr := th.App.UpdateTeam(th.Context, th.BasicTeam)
 		require.Nilf(t, appErr, "%v, Should update the team", appErr)
 
 		emailList := make([]string, 22)
@@ -4026,7 +4026,7 @@ func TestInviteGuestsToTeam(t *testing.T) {
 	t.Run("rate limit", func(t *testing.T) {
 		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.GuestAccountsSettings.RestrictCreationToDomains = "@guest.com" })
 
-		_, err := th.App.UpdateTeam(th.BasicTeam)
+		_, err := th.App.UpdateTeam(th.Context, th.BasicTeam)
 		require.Nilf(t, err, "%v, Should update the team", err)
 
 		emailList := make([]string, 22)
@@ -4333,7 +4333,7 @@ func TestTeamMembersMinusGroupMembers(t *testing.T) {
 
 	team := th.CreateTeam()
 	team.GroupConstrained = model.NewPointer(true)
-	team, appErr := th.App.UpdateTeam(team)
+	team, appErr := th.App.UpdateTeam(th.Context, team)
 	require.Nil(t, appErr)
 
 	_, appErr = th.App.AddTeamMember(th.Context, team.Id, user1.Id)
@@ -4344,9 +4344,9 @@ func TestTeamMembersMinusGroupMembers(t *testing.T) {
 	group1 := th.CreateGroup()
 	group2 := th.CreateGroup()
 
-	_, appErr = th.App.UpsertGroupMember(group1.Id, user1.Id)
+	_, appErr = th.App.UpsertGroupMember(th.Context, group1.Id, user1.Id)
 	require.Nil(t, appErr)
-	_, appErr = th.App.UpsertGroupMember(group2.Id, user2.Id)
+	_, appErr = th.App.UpsertGroupMember(th.Context, group2.Id, user2.Id)
 	require.Nil(t, appErr)
 
 	// No permissions
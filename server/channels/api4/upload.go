@@ -19,7 +19,7 @@ import (
 func (api *API) InitUpload() {
 	api.BaseRoutes.Uploads.Handle("", api.APISessionRequired(createUpload, handlerParamFileAPI)).Methods(http.MethodPost)
 	api.BaseRoutes.Upload.Handle("", api.APISessionRequired(getUpload)).Methods(http.MethodGet)
-	api.BaseRoutes.Upload.Handle("", api.APISessionRequired(uploadData, handlerParamFileAPI)).Methods(http.MethodPost)
+	api.BaseRoutes.Upload.Handle("", api.RateLimitedClassHandler(app.RateLimitClassFileUpload, api.APISessionRequired(uploadData, handlerParamFileAPI))).Methods(http.MethodPost)
 }
 
 func createUpload(c *Context, w http.ResponseWriter, r *http.Request) {
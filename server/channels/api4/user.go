@@ -29,7 +29,7 @@ func (api *API) InitUser() {
 	api.BaseRoutes.Users.Handle("/ids", api.APISessionRequired(getUsersByIds)).Methods(http.MethodPost)
 	api.BaseRoutes.Users.Handle("/usernames", api.APISessionRequired(getUsersByNames)).Methods(http.MethodPost)
 	api.BaseRoutes.Users.Handle("/known", api.APISessionRequired(getKnownUsers)).Methods(http.MethodGet)
-	api.BaseRoutes.Users.Handle("/search", api.APISessionRequiredDisableWhenBusy(searchUsers)).Methods(http.MethodPost)
+	api.BaseRoutes.Users.Handle("/search", api.RateLimitedClassHandler(app.RateLimitClassSearch, api.APISessionRequiredDisableWhenBusy(searchUsers))).Methods(http.MethodPost)
 	api.BaseRoutes.Users.Handle("/autocomplete", api.APISessionRequired(autocompleteUsers)).Methods(http.MethodGet)
 	api.BaseRoutes.Users.Handle("/stats", api.APISessionRequired(getTotalUsersStats)).Methods(http.MethodGet)
 	api.BaseRoutes.Users.Handle("/stats/filtered", api.APISessionRequired(getFilteredUsersStats)).Methods(http.MethodGet)
@@ -63,9 +63,16 @@ func (api *API) InitUser() {
 	api.BaseRoutes.User.Handle("/mfa", api.APISessionRequiredMfa(updateUserMfa)).Methods(http.MethodPut)
 	api.BaseRoutes.User.Handle("/mfa/generate", api.APISessionRequiredMfa(generateMfaSecret)).Methods(http.MethodPost)
 
-	api.BaseRoutes.Users.Handle("/login", api.APIHandler(login)).Methods(http.MethodPost)
+	api.BaseRoutes.User.Handle("/webauthn/register/begin", api.APISessionRequiredMfa(startWebAuthnRegistration)).Methods(http.MethodPost)
+	api.BaseRoutes.User.Handle("/webauthn/register/finish", api.APISessionRequiredMfa(finishWebAuthnRegistration)).Methods(http.MethodPost)
+	api.BaseRoutes.User.Handle("/webauthn/credentials", api.APISessionRequiredMfa(getWebAuthnCredentials)).Methods(http.MethodGet)
+	api.BaseRoutes.User.Handle("/webauthn/credentials/{credential_id:[A-Za-z0-9]+}", api.APISessionRequiredMfa(deleteWebAuthnCredential)).Methods(http.MethodDelete)
+
+	api.BaseRoutes.Users.Handle("/login", api.RateLimitedClassHandler(app.RateLimitClassLogin, api.APIHandler(login))).Methods(http.MethodPost)
 	api.BaseRoutes.Users.Handle("/login/desktop_token", api.RateLimitedHandler(api.APIHandler(loginWithDesktopToken), model.RateLimitSettings{PerSec: model.NewPointer(2), MaxBurst: model.NewPointer(1)})).Methods(http.MethodPost)
 	api.BaseRoutes.Users.Handle("/login/switch", api.APIHandler(switchAccountType)).Methods(http.MethodPost)
+	api.BaseRoutes.Users.Handle("/login/webauthn/begin", api.RateLimitedHandler(api.APIHandler(startWebAuthnLogin), model.RateLimitSettings{PerSec: model.NewPointer(2), MaxBurst: model.NewPointer(5)})).Methods(http.MethodPost)
+	api.BaseRoutes.Users.Handle("/login/webauthn", api.RateLimitedClassHandler(app.RateLimitClassLogin, api.APIHandler(loginWithWebAuthn))).Methods(http.MethodPost)
 	api.BaseRoutes.Users.Handle("/login/cws", api.APIHandlerTrustRequester(loginCWS)).Methods(http.MethodPost)
 	api.BaseRoutes.Users.Handle("/logout", api.APIHandler(logout)).Methods(http.MethodPost)
 
@@ -73,8 +80,10 @@ func (api *API) InitUser() {
 	api.BaseRoutes.UserByEmail.Handle("", api.APISessionRequired(getUserByEmail)).Methods(http.MethodGet)
 
 	api.BaseRoutes.User.Handle("/sessions", api.APISessionRequired(getSessions)).Methods(http.MethodGet)
+	api.BaseRoutes.User.Handle("/sessions/devices", api.APISessionRequired(getSessionDevices)).Methods(http.MethodGet)
 	api.BaseRoutes.User.Handle("/sessions/revoke", api.APISessionRequired(revokeSession)).Methods(http.MethodPost)
 	api.BaseRoutes.User.Handle("/sessions/revoke/all", api.APISessionRequired(revokeAllSessionsForUser)).Methods(http.MethodPost)
+	api.BaseRoutes.User.Handle("/sessions/revoke/others", api.APISessionRequired(revokeOtherSessionsForUser)).Methods(http.MethodPost)
 	api.BaseRoutes.Users.Handle("/sessions/revoke/all", api.APISessionRequired(revokeAllSessionsAllUsers)).Methods(http.MethodPost)
 	api.BaseRoutes.Users.Handle("/sessions/device", api.APISessionRequired(handleDeviceProps)).Methods(http.MethodPut)
 	api.BaseRoutes.User.Handle("/audits", api.APISessionRequired(getUserAudits)).Methods(http.MethodGet)
@@ -1713,6 +1722,211 @@ func generateMfaSecret(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func startWebAuthnRegistration(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	// The ceremony runs on the authenticator of the user, which nobody else can do for them.
+	if c.AppContext.Session().IsOAuth || c.AppContext.Session().UserId != c.Params.UserId {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	options, appErr := c.App.StartWebAuthnRegistration(c.AppContext, c.Params.UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-cache")
+	if err := json.NewEncoder(w).Encode(options); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func finishWebAuthnRegistration(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	var request model.WebAuthnRegistrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.Credential == nil {
+		c.SetInvalidParamWithErr("credential", err)
+		return
+	}
+
+	if request.Password == "" {
+		c.SetInvalidParam("password")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord(model.AuditEventRegisterWebAuthnCredential, model.AuditStatusFail)
+	defer c.LogAuditRec(auditRec)
+	model.AddEventParameterToAuditRec(auditRec, "user_id", c.Params.UserId)
+
+	if c.AppContext.Session().IsOAuth || c.AppContext.Session().UserId != c.Params.UserId {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	credential, appErr := c.App.FinishWebAuthnRegistration(c.AppContext, c.Params.UserId, &request)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(credential)
+	c.LogAudit("success - webauthn credential registered")
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(credential); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getWebAuthnCredentials(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	credentials, appErr := c.App.GetWebAuthnCredentials(c.Params.UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(credentials); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteWebAuthnCredential(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId().RequireCredentialId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord(model.AuditEventDeleteWebAuthnCredential, model.AuditStatusFail)
+	defer c.LogAuditRec(auditRec)
+	model.AddEventParameterToAuditRec(auditRec, "user_id", c.Params.UserId)
+	model.AddEventParameterToAuditRec(auditRec, "credential_id", c.Params.CredentialId)
+
+	if c.AppContext.Session().IsOAuth {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		c.Err.DetailedError += ", attempted access by oauth app"
+		return
+	}
+
+	// Admins can remove the passkeys of users who lost their authenticator.
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	if appErr := c.App.DeleteWebAuthnCredential(c.AppContext, c.Params.UserId, c.Params.CredentialId); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	c.LogAudit("success - webauthn credential deleted")
+
+	ReturnStatusOK(w)
+}
+
+func startWebAuthnLogin(c *Context, w http.ResponseWriter, r *http.Request) {
+	props := model.MapFromJSON(r.Body)
+
+	// A login id asks for the second factor of a user, which isn't looked up so that the
+	// response doesn't tell who has an account or a passkey.
+	options, appErr := c.App.StartWebAuthnLogin(c.AppContext, props["login_id"] != "")
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-cache")
+	if err := json.NewEncoder(w).Encode(options); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func loginWithWebAuthn(c *Context, w http.ResponseWriter, r *http.Request) {
+	var request model.WebAuthnLoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.Credential == nil {
+		c.SetInvalidParamWithErr("credential", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord(model.AuditEventLoginWithWebAuthn, model.AuditStatusFail)
+	defer c.LogAuditRec(auditRec)
+	model.AddEventParameterToAuditRec(auditRec, "device_id", request.DeviceId)
+
+	user, appErr := c.App.AuthenticateUserWithWebAuthn(c.AppContext, request.Credential)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	auditRec.AddEventResultState(user)
+
+	if user.IsGuest() {
+		if c.App.Channels().License() == nil {
+			c.Err = model.NewAppError("loginWithWebAuthn", "api.user.login.guest_accounts.license.error", nil, "", http.StatusUnauthorized)
+			return
+		}
+		if !*c.App.Config().GuestAccountsSettings.Enable {
+			c.Err = model.NewAppError("loginWithWebAuthn", "api.user.login.guest_accounts.disabled.error", nil, "", http.StatusUnauthorized)
+			return
+		}
+	}
+
+	if user.IsRemote() {
+		c.Err = model.NewAppError("loginWithWebAuthn", "api.user.login.remote_users.login.error", nil, "", http.StatusUnauthorized)
+		return
+	}
+
+	c.LogAuditWithUserId(user.Id, "authenticated with passkey")
+
+	session, appErr := c.App.DoLogin(c.AppContext, w, r, user, request.DeviceId, utils.IsMobileRequest(r), false, false)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	c.AppContext = c.AppContext.WithSession(session)
+
+	if r.Header.Get(model.HeaderRequestedWith) == model.HeaderRequestedWithXML {
+		c.App.AttachSessionCookies(c.AppContext, w, r)
+	}
+
+	userTermsOfService, appErr := c.App.GetUserTermsOfService(user.Id)
+	if appErr != nil && appErr.StatusCode != http.StatusNotFound {
+		c.Err = appErr
+		return
+	}
+
+	if userTermsOfService != nil {
+		user.TermsOfServiceId = userTermsOfService.TermsOfServiceId
+		user.TermsOfServiceCreateAt = userTermsOfService.CreateAt
+	}
+
+	user.Sanitize(map[string]bool{})
+
+	auditRec.Success()
+	if err := json.NewEncoder(w).Encode(user); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func updatePassword(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireUserId()
 	if c.Err != nil {
@@ -1846,6 +2060,8 @@ func login(c *Context, w http.ResponseWriter, r *http.Request) {
 		unmaskedErrors := []string{
 			"mfa.validate_token.authenticate.app_error",
 			"api.user.check_user_mfa.bad_code.app_error",
+			"api.user.webauthn.authentication_failed.app_error",
+			"api.user.webauthn.invalid_challenge.app_error",
 			"api.user.login.blank_pwd.app_error",
 			"api.user.login.bot_login_forbidden.app_error",
 			"api.user.login.remote_users.login.error",
@@ -2307,6 +2523,34 @@ func getSessions(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func getSessionDevices(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	devices, appErr := c.App.GetSessionDevices(c.AppContext, c.Params.UserId, c.AppContext.Session().Id)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	js, err := json.Marshal(devices)
+	if err != nil {
+		c.Err = model.NewAppError("getSessionDevices", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return
+	}
+
+	if _, err := w.Write(js); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func revokeSession(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireUserId()
 	if c.Err != nil {
@@ -2380,6 +2624,32 @@ func revokeAllSessionsForUser(c *Context, w http.ResponseWriter, r *http.Request
 	ReturnStatusOK(w)
 }
 
+func revokeOtherSessionsForUser(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord(model.AuditEventRevokeOtherSessionsForUser, model.AuditStatusFail)
+	defer c.LogAuditRec(auditRec)
+	model.AddEventParameterToAuditRec(auditRec, "user_id", c.Params.UserId)
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	if err := c.App.RevokeOtherSessions(c.AppContext, c.Params.UserId, c.AppContext.Session().Id); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	c.LogAudit("")
+
+	ReturnStatusOK(w)
+}
+
 func revokeAllSessionsAllUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
 		c.SetPermissionError(model.PermissionManageSystem)
@@ -852,12 +852,12 @@ func TestCreateUserWithInviteId(t *testing.T) {
 		user := model.User{Email: th.GenerateTestEmail(), Nickname: "", Password: "hello1", Username: GenerateTestUsername(), Roles: model.SystemAdminRoleId + " " + model.SystemUserRoleId}
 
 		th.BasicTeam.GroupConstrained = model.NewPointer(true)
-		team, appErr := th.App.UpdateTeam(th.BasicTeam)
+		team, appErr := th.App.UpdateTeam(th.Context, th.BasicTeam)
 		require.Nil(t, appErr)
 
 		defer func() {
 			th.BasicTeam.GroupConstrained = model.NewPointer(false)
-			_, appErr = th.App.UpdateTeam(th.BasicTeam)
+			_, appErr = th.App.UpdateTeam(th.Context, th.BasicTeam)
 			require.Nil(t, appErr)
 		}()
 
@@ -871,12 +871,12 @@ func TestCreateUserWithInviteId(t *testing.T) {
 		user := model.User{Email: th.GenerateTestEmail(), Nickname: "", Password: "hello1", Username: GenerateTestUsername(), Roles: model.SystemAdminRoleId + " " + model.SystemUserRoleId}
 
 		th.BasicTeam.GroupConstrained = model.NewPointer(true)
-		team, appErr := th.App.UpdateTeam(th.BasicTeam)
+		team, appErr := th.App.UpdateTeam(th.Context, th.BasicTeam)
 		require.Nil(t, appErr)
 
 		defer func() {
 			th.BasicTeam.GroupConstrained = model.NewPointer(false)
-			_, appErr = th.App.UpdateTeam(th.BasicTeam)
+			_, appErr = th.App.UpdateTeam(th.Context, th.BasicTeam)
 			require.Nil(t, appErr)
 		}()
 
@@ -1589,7 +1589,7 @@ func TestSearchUsers(t *testing.T) {
 		require.Empty(t, users)
 	})
 
-	_, appErr = th.App.UpsertGroupMember(group.Id, th.BasicUser.Id)
+	_, appErr = th.App.UpsertGroupMember(th.Context, group.Id, th.BasicUser.Id)
 	assert.Nil(t, appErr)
 
 	t.Run("Returns user in group user found in group", func(t *testing.T) {
@@ -1617,7 +1617,7 @@ func TestSearchUsers(t *testing.T) {
 		require.Equal(t, users[0].Id, th.BasicUser.Id)
 	})
 
-	_, appErr = th.App.UpsertGroupMember(group.Id, th.BasicUser.Id)
+	_, appErr = th.App.UpsertGroupMember(th.Context, group.Id, th.BasicUser.Id)
 	assert.Nil(t, appErr)
 
 	t.Run("Returns empty list for not in group", func(t *testing.T) {
@@ -3429,7 +3429,7 @@ func TestGetUsersInGroup(t *testing.T) {
 		CheckForbiddenStatus(t, response)
 	})
 
-	_, err = th.App.UpsertGroupMember(group.Id, user1.Id)
+	_, err = th.App.UpsertGroupMember(th.Context, group.Id, user1.Id)
 	assert.Nil(t, err)
 
 	t.Run("Returns users in group when called by system admin", func(t *testing.T) {
@@ -3444,7 +3444,7 @@ func TestGetUsersInGroup(t *testing.T) {
 		assert.Empty(t, users)
 	})
 
-	_, err = th.App.UpsertGroupMember(customGroup.Id, user1.Id)
+	_, err = th.App.UpsertGroupMember(th.Context, customGroup.Id, user1.Id)
 	assert.Nil(t, err)
 
 	t.Run("Returns users in custom group when called by regular user", func(t *testing.T) {
@@ -3488,9 +3488,9 @@ func TestGetUsersInGroupByDisplayName(t *testing.T) {
 	user2, err := th.App.CreateUser(th.Context, &model.User{Email: th.GenerateTestEmail(), Password: "test-password-2", Username: "bbb", Roles: model.SystemUserRoleId})
 	assert.Nil(t, err)
 
-	_, err = th.App.UpsertGroupMember(group.Id, user1.Id)
+	_, err = th.App.UpsertGroupMember(th.Context, group.Id, user1.Id)
 	assert.Nil(t, err)
-	_, err = th.App.UpsertGroupMember(group.Id, user2.Id)
+	_, err = th.App.UpsertGroupMember(th.Context, group.Id, user2.Id)
 	assert.Nil(t, err)
 
 	th.App.Srv().SetLicense(model.NewTestLicenseSKU(model.LicenseShortSkuProfessional))
@@ -4002,6 +4002,63 @@ func TestRevokeAllSessions(t *testing.T) {
 	CheckUnauthorizedStatus(t, resp)
 }
 
+func TestGetSessionDevices(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	user := th.BasicUser
+	_, _, err := th.Client.Login(context.Background(), user.Email, user.Password)
+	require.NoError(t, err)
+
+	devices, _, err := th.Client.GetSessionDevices(context.Background(), user.Id)
+	require.NoError(t, err)
+	require.NotEmpty(t, devices)
+
+	current := 0
+	for _, device := range devices {
+		if device.IsCurrent {
+			current++
+		}
+		require.NotZero(t, device.FirstSeenAt)
+	}
+	require.Equal(t, 1, current, "the session of the client should be the current one")
+
+	_, resp, err := th.Client.GetSessionDevices(context.Background(), th.BasicUser2.Id)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	_, _, err = th.SystemAdminClient.GetSessionDevices(context.Background(), user.Id)
+	require.NoError(t, err)
+}
+
+func TestRevokeOtherSessions(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	user := th.BasicUser
+	otherClient := th.CreateClient()
+	_, _, err := otherClient.Login(context.Background(), user.Email, user.Password)
+	require.NoError(t, err)
+	_, _, err = th.Client.Login(context.Background(), user.Email, user.Password)
+	require.NoError(t, err)
+
+	resp, err := th.Client.RevokeOtherSessions(context.Background(), th.BasicUser2.Id)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	_, err = th.Client.RevokeOtherSessions(context.Background(), user.Id)
+	require.NoError(t, err)
+
+	_, _, err = th.Client.GetMe(context.Background(), "")
+	require.NoError(t, err, "the current session should be kept")
+
+	_, resp, err = otherClient.GetMe(context.Background(), "")
+	require.Error(t, err)
+	CheckUnauthorizedStatus(t, resp)
+}
+
 func TestRevokeSessionsFromAllUsers(t *testing.T) {
 	mainHelper.Parallel(t)
 
@@ -5984,6 +6041,72 @@ func TestUserAccessTokenDisableConfig(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestUserAccessTokenScopes(t *testing.T) {
+	mainHelper.Parallel(t)
+
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableUserAccessTokens = true })
+
+	_, appErr := th.App.UpdateUserRoles(th.Context, th.BasicUser.Id, model.SystemUserRoleId+" "+model.SystemUserAccessTokenRoleId, false)
+	require.Nil(t, appErr)
+
+	t.Run("rejects invalid scopes", func(t *testing.T) {
+		_, resp, err := th.Client.CreateUserAccessTokenWithOptions(context.Background(), th.BasicUser.Id, &model.UserAccessToken{
+			Description: "invalid",
+			Scopes:      model.StringArray{"delete:everything"},
+		})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	token, _, err := th.Client.CreateUserAccessTokenWithOptions(context.Background(), th.BasicUser.Id, &model.UserAccessToken{
+		Description: "scoped",
+		ExpiresAt:   model.GetMillis() + 60*60*1000,
+		Scopes:      model.StringArray{"write:posts:" + th.BasicChannel.Id},
+	})
+	require.NoError(t, err)
+	require.Equal(t, model.StringArray{"write:posts:" + th.BasicChannel.Id}, token.Scopes)
+
+	client := th.CreateClient()
+	client.AuthToken = token.Token
+
+	_, _, err = client.CreatePost(context.Background(), &model.Post{ChannelId: th.BasicChannel.Id, Message: "allowed"})
+	require.NoError(t, err)
+
+	_, resp, err := client.CreatePost(context.Background(), &model.Post{ChannelId: th.BasicChannel2.Id, Message: "not allowed"})
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	_, resp, err = client.CreateUserAccessToken(context.Background(), th.BasicUser.Id, "escalation")
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	t.Run("doesn't cover the user of the token", func(t *testing.T) {
+		_, resp, err := client.PatchUser(context.Background(), th.BasicUser.Id, &model.UserPatch{Nickname: model.NewPointer("scoped")})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client.GetSessions(context.Background(), th.BasicUser.Id, "")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = client.RevokeAllSessions(context.Background(), th.BasicUser.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client.GetUserAccessTokensForUser(context.Background(), th.BasicUser.Id, 0, 100)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	tokens, _, err := th.Client.GetUserAccessTokensForUser(context.Background(), th.BasicUser.Id, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	require.Equal(t, token.ExpiresAt, tokens[0].ExpiresAt)
+}
+
 func TestUserAccessTokenDisableConfigBotsExcluded(t *testing.T) {
 	mainHelper.Parallel(t)
 
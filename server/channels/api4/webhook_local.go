@@ -23,6 +23,10 @@ func (api *API) InitWebhookLocal() {
 	api.BaseRoutes.OutgoingHook.Handle("", api.APILocal(getOutgoingHook)).Methods(http.MethodGet)
 	api.BaseRoutes.OutgoingHook.Handle("", api.APILocal(updateOutgoingHook)).Methods(http.MethodPut)
 	api.BaseRoutes.OutgoingHook.Handle("", api.APILocal(deleteOutgoingHook)).Methods(http.MethodDelete)
+	api.BaseRoutes.OutgoingHook.Handle("/regen_secret", api.APILocal(regenOutgoingHookSecret)).Methods(http.MethodPost)
+	api.BaseRoutes.OutgoingHook.Handle("/deliveries", api.APILocal(getOutgoingHookDeliveries)).Methods(http.MethodGet)
+	api.BaseRoutes.OutgoingHook.Handle("/deliveries/{delivery_id:[A-Za-z0-9]+}", api.APILocal(getOutgoingHookDelivery)).Methods(http.MethodGet)
+	api.BaseRoutes.OutgoingHook.Handle("/deliveries/{delivery_id:[A-Za-z0-9]+}/replay", api.APILocal(replayOutgoingHookDelivery)).Methods(http.MethodPost)
 }
 
 func localCreateIncomingHook(c *Context, w http.ResponseWriter, r *http.Request) {
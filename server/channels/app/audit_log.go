@@ -0,0 +1,218 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/audit"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+	"github.com/mattermost/mattermost/server/v8/config"
+)
+
+const (
+	auditLogVerifyBatchSize = 1000
+	auditLogDeleteBatchSize = 1000
+)
+
+// auditLogSink persists the audit records to the store, chaining them so that
+// tampering with them can be detected.
+type auditLogSink struct {
+	srv *Server
+}
+
+func (s *auditLogSink) Write(rec model.AuditRecord) error {
+	auditLog, err := model.NewAuditLog(&rec)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.srv.Store().AuditLog().Save(auditLog, auditLogSigningKey(s.srv.platform.Config()))
+	return err
+}
+
+func auditLogSigningKey(cfg *model.Config) []byte {
+	return []byte(*cfg.ExperimentalAuditSettings.StoreSigningKey)
+}
+
+// configureAuditSink persists the audit records to the store, or stops doing so,
+// according to the configuration.
+func (s *Server) configureAuditSink() {
+	if s.Audit == nil {
+		return
+	}
+
+	if *s.platform.Config().ExperimentalAuditSettings.StoreEnabled {
+		if config.IsDatabaseDSN(s.platform.DescribeConfig()) && !s.isAuditLogSigningKeySecretReference() {
+			mlog.Warn("The audit log signing key is kept in the database along with the audit logs, so they can be changed and signed again there. Set it from a file or an environment variable instead.")
+		}
+		s.Audit.SetSink(&auditLogSink{srv: s}, audit.DefMaxQueueSize)
+		return
+	}
+	s.Audit.SetSink(nil, 0)
+}
+
+// isAuditLogSigningKeySecretReference returns whether the audit log signing key
+// is read from a file or an environment variable, rather than from the config.
+func (s *Server) isAuditLogSigningKeySecretReference() bool {
+	refs, _ := s.platform.GetConfigStore().GetSecretReferences()["ExperimentalAuditSettings"].(map[string]any)
+	return refs["StoreSigningKey"] == true
+}
+
+func (a *App) getAuditLogRetentionCutoff() (*model.AuditLogRetentionCutoff, error) {
+	value, err := a.Srv().Store().System().GetByName(model.SystemAuditLogRetentionCutoffKey)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var cutoff *model.AuditLogRetentionCutoff
+	if err := json.Unmarshal([]byte(value.Value), &cutoff); err != nil {
+		return nil, err
+	}
+	return cutoff, nil
+}
+
+func (a *App) saveAuditLogRetentionCutoff(cutoff *model.AuditLogRetentionCutoff) error {
+	value, err := json.Marshal(cutoff)
+	if err != nil {
+		return err
+	}
+	return a.Srv().Store().System().SaveOrUpdate(&model.System{Name: model.SystemAuditLogRetentionCutoffKey, Value: string(value)})
+}
+
+func (a *App) SearchAuditLogs(search *model.AuditLogSearch) ([]*model.AuditLog, *model.AppError) {
+	if search.Until > 0 && search.Since > search.Until {
+		return nil, model.NewAppError("SearchAuditLogs", "app.audit_log.search.invalid_time_range.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	auditLogs, err := a.Srv().Store().AuditLog().Search(search)
+	if err != nil {
+		return nil, model.NewAppError("SearchAuditLogs", "app.audit_log.search.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return auditLogs, nil
+}
+
+// VerifyAuditLogs walks the chain of the audit logs from the retention cutoff,
+// and reports the first one which was changed or follows a removed one.
+func (a *App) VerifyAuditLogs() (*model.AuditLogVerification, *model.AppError) {
+	verification := &model.AuditLogVerification{Valid: true}
+	key := auditLogSigningKey(a.Config())
+
+	cutoff, err := a.getAuditLogRetentionCutoff()
+	if err != nil {
+		return nil, model.NewAppError("VerifyAuditLogs", "app.audit_log.get_retention_cutoff.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	// The chain starts from the last audit log deleted for retention, if any.
+	previous := &model.AuditLog{}
+	if cutoff != nil {
+		if !cutoff.Verify(key) {
+			verification.Valid = false
+			verification.InvalidSequence = cutoff.Sequence
+			verification.Reason = model.AuditLogVerificationCutoff
+			return verification, nil
+		}
+		previous = &model.AuditLog{Sequence: cutoff.Sequence, Hash: cutoff.Hash}
+	}
+
+	afterSequence := previous.Sequence
+	for {
+		auditLogs, err := a.Srv().Store().AuditLog().GetChain(afterSequence, auditLogVerifyBatchSize)
+		if err != nil {
+			return nil, model.NewAppError("VerifyAuditLogs", "app.audit_log.get_chain.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		for _, auditLog := range auditLogs {
+			if reason := auditLog.Verify(previous, key); reason != "" {
+				verification.Valid = false
+				verification.InvalidId = auditLog.Id
+				verification.InvalidSequence = auditLog.Sequence
+				verification.Reason = reason
+				return verification, nil
+			}
+
+			if verification.Checked == 0 {
+				verification.FirstSequence = auditLog.Sequence
+			}
+			verification.LastSequence = auditLog.Sequence
+			verification.Checked++
+			previous = auditLog
+		}
+
+		if len(auditLogs) < auditLogVerifyBatchSize {
+			return verification, nil
+		}
+		afterSequence = previous.Sequence
+	}
+}
+
+// DeleteExpiredAuditLogs deletes the audit logs older than the retention period
+// of the configuration. The latest audit log is always kept for the chain to go on.
+// The last audit log deleted is saved as the retention cutoff before deleting, for
+// the chain to be verified from it.
+func (a *App) DeleteExpiredAuditLogs(rctx request.CTX) *model.AppError {
+	retentionDays := *a.Config().ExperimentalAuditSettings.StoreRetentionDays
+	if retentionDays <= 0 {
+		return nil
+	}
+
+	before := model.GetMillisForTime(time.Now().AddDate(0, 0, -retentionDays))
+	key := auditLogSigningKey(a.Config())
+
+	cutoff, err := a.getAuditLogRetentionCutoff()
+	if err != nil {
+		return model.NewAppError("DeleteExpiredAuditLogs", "app.audit_log.get_retention_cutoff.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	var afterSequence int64
+	if cutoff != nil {
+		afterSequence = cutoff.Sequence
+	}
+
+	var total int64
+	for {
+		auditLogs, err := a.Srv().Store().AuditLog().GetChain(afterSequence, auditLogDeleteBatchSize)
+		if err != nil {
+			return model.NewAppError("DeleteExpiredAuditLogs", "app.audit_log.get_chain.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		// The last audit log of the chain is kept for the next one to be chained to it.
+		var last *model.AuditLog
+		for i, auditLog := range auditLogs {
+			if auditLog.CreateAt >= before || (i == len(auditLogs)-1 && len(auditLogs) < auditLogDeleteBatchSize) {
+				break
+			}
+			last = auditLog
+		}
+		if last == nil {
+			break
+		}
+
+		if err := a.saveAuditLogRetentionCutoff(model.NewAuditLogRetentionCutoff(last, key)); err != nil {
+			return model.NewAppError("DeleteExpiredAuditLogs", "app.audit_log.save_retention_cutoff.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		deleted, err := a.Srv().Store().AuditLog().PermanentDeleteUntil(last.Sequence)
+		if err != nil {
+			return model.NewAppError("DeleteExpiredAuditLogs", "app.audit_log.permanent_delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		total += deleted
+		afterSequence = last.Sequence
+	}
+
+	rctx.Logger().Info("Deleted the expired audit logs", mlog.Int("count", total), mlog.Int("retention_days", retentionDays))
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+const testAuditLogSigningKey = "audit-log-signing-key-for-the-tests"
+
+func TestAuditLogs(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	actorID := model.NewId()
+	channelID := model.NewId()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ExperimentalAuditSettings.StoreEnabled = true
+		*cfg.ExperimentalAuditSettings.StoreSigningKey = testAuditLogSigningKey
+	})
+	for _, eventName := range []string{model.AuditEventAddChannelMember, model.AuditEventRemoveChannelMember} {
+		rec := model.AuditRecord{
+			EventName: eventName,
+			Status:    model.AuditStatusSuccess,
+			Actor:     model.AuditEventActor{UserId: actorID},
+		}
+		model.AddEventParameterToAuditRec(&rec, "channel_id", channelID)
+		th.App.Srv().Audit.LogRecord(mlog.LvlAuditAPI, rec)
+	}
+	// Disabling the store writes the queued audit records first.
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ExperimentalAuditSettings.StoreEnabled = false })
+
+	t.Run("search", func(t *testing.T) {
+		auditLogs, appErr := th.App.SearchAuditLogs(&model.AuditLogSearch{ObjectId: channelID, PerPage: 10})
+		require.Nil(t, appErr)
+		require.Len(t, auditLogs, 2)
+		assert.Equal(t, model.AuditEventRemoveChannelMember, auditLogs[0].EventName)
+		assert.Equal(t, actorID, auditLogs[0].UserId)
+
+		_, appErr = th.App.SearchAuditLogs(&model.AuditLogSearch{Since: 2000, Until: 1000, PerPage: 10})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.audit_log.search.invalid_time_range.app_error", appErr.Id)
+	})
+
+	t.Run("verify", func(t *testing.T) {
+		verification, appErr := th.App.VerifyAuditLogs()
+		require.Nil(t, appErr)
+		assert.True(t, verification.Valid)
+		assert.GreaterOrEqual(t, verification.Checked, int64(2))
+
+		auditLogs, appErr := th.App.SearchAuditLogs(&model.AuditLogSearch{ObjectId: channelID, PerPage: 10})
+		require.Nil(t, appErr)
+		_, err := th.GetSqlStore().GetMaster().Exec("UPDATE AuditLogs SET UserId = ? WHERE Id = ?", model.NewId(), auditLogs[1].Id)
+		require.NoError(t, err)
+
+		verification, appErr = th.App.VerifyAuditLogs()
+		require.Nil(t, appErr)
+		assert.False(t, verification.Valid)
+		assert.Equal(t, auditLogs[1].Id, verification.InvalidId)
+		assert.Equal(t, model.AuditLogVerificationHash, verification.Reason)
+
+		// Hashing the changed audit log again requires the signing key.
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ExperimentalAuditSettings.StoreSigningKey = model.NewRandomString(32) })
+		verification, appErr = th.App.VerifyAuditLogs()
+		require.Nil(t, appErr)
+		assert.False(t, verification.Valid)
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ExperimentalAuditSettings.StoreSigningKey = testAuditLogSigningKey })
+	})
+
+	t.Run("delete expired", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ExperimentalAuditSettings.StoreRetentionDays = 1 })
+		_, err := th.GetSqlStore().GetMaster().Exec("UPDATE AuditLogs SET CreateAt = 0")
+		require.NoError(t, err)
+
+		require.Nil(t, th.App.DeleteExpiredAuditLogs(th.Context))
+
+		auditLogs, appErr := th.App.SearchAuditLogs(&model.AuditLogSearch{PerPage: 10})
+		require.Nil(t, appErr)
+		require.Len(t, auditLogs, 1, "the latest audit log must be kept")
+		assert.Equal(t, model.AuditEventRemoveChannelMember, auditLogs[0].EventName)
+
+		// The chain is verified from the last audit log deleted.
+		verification, appErr := th.App.VerifyAuditLogs()
+		require.Nil(t, appErr)
+		assert.True(t, verification.Valid)
+		assert.Equal(t, int64(1), verification.Checked)
+		assert.Equal(t, auditLogs[0].Sequence, verification.FirstSequence)
+
+		cutoff, err := th.App.getAuditLogRetentionCutoff()
+		require.NoError(t, err)
+		require.NotNil(t, cutoff)
+		assert.Equal(t, auditLogs[0].Sequence-1, cutoff.Sequence)
+		assert.Equal(t, auditLogs[0].PrevHash, cutoff.Hash)
+
+		t.Run("moved cutoff", func(t *testing.T) {
+			moved := *cutoff
+			moved.Sequence--
+			require.NoError(t, th.App.saveAuditLogRetentionCutoff(&moved))
+			defer func() { require.NoError(t, th.App.saveAuditLogRetentionCutoff(cutoff)) }()
+
+			verification, appErr := th.App.VerifyAuditLogs()
+			require.Nil(t, appErr)
+			assert.False(t, verification.Valid)
+			assert.Equal(t, model.AuditLogVerificationCutoff, verification.Reason)
+		})
+
+		t.Run("removed cutoff", func(t *testing.T) {
+			_, err := th.App.Srv().Store().System().PermanentDeleteByName(model.SystemAuditLogRetentionCutoffKey)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, th.App.saveAuditLogRetentionCutoff(cutoff)) }()
+
+			verification, appErr := th.App.VerifyAuditLogs()
+			require.Nil(t, appErr)
+			assert.False(t, verification.Valid)
+			assert.Equal(t, auditLogs[0].Id, verification.InvalidId)
+			assert.Equal(t, model.AuditLogVerificationSequence, verification.Reason)
+		})
+	})
+}
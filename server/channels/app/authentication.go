@@ -99,6 +99,19 @@ func (a *App) checkUserPassword(user *model.User, password string, invalidateCac
 	return nil
 }
 
+// configurePasswordHasher makes new passwords, and the ones migrated on login, be
+// hashed with the Argon2id parameters of the configuration.
+func configurePasswordHasher(logger mlog.LoggerIFace, cfg *model.Config) {
+	hasher, err := hashers.NewArgon2id(*cfg.PasswordSettings.Argon2idMemory, *cfg.PasswordSettings.Argon2idIterations, *cfg.PasswordSettings.Argon2idParallelism)
+	if err != nil {
+		// The configuration is validated before being saved, so this is unexpected
+		logger.Error("Invalid Argon2id parameters, keeping the current password hasher", mlog.Err(err))
+		return
+	}
+
+	hashers.SetLatestHasher(hasher)
+}
+
 // migratePassword updates the database with the user's password hashed with the
 // latest hashing method. It assumes that the password has been already validated.
 func (a *App) migratePassword(user *model.User, password string) *model.AppError {
@@ -303,8 +316,22 @@ func (a *App) CheckUserPostflightAuthenticationCriteria(rctx request.CTX, user *
 	return nil
 }
 
+// CheckUserMfa checks the second factor of a user, which is either a TOTP code or, for the users
+// with passkeys, the JSON encoded WebAuthn assertion of one of them.
 func (a *App) CheckUserMfa(rctx request.CTX, user *model.User, token string) *model.AppError {
+	hasWebAuthnCredentials, appErr := a.hasWebAuthnCredentials(user.Id)
+	if appErr != nil {
+		return appErr
+	}
+
+	if hasWebAuthnCredentials && isWebAuthnAssertion(token) {
+		return a.checkUserWebAuthn(rctx, user, token)
+	}
+
 	if !user.MfaActive || !*a.Config().ServiceSettings.EnableMultifactorAuthentication {
+		if hasWebAuthnCredentials {
+			return model.NewAppError("checkUserMfa", "api.user.check_user_mfa.bad_code.app_error", nil, "", http.StatusUnauthorized)
+		}
 		return nil
 	}
 
@@ -325,7 +352,9 @@ func (a *App) CheckUserMfa(rctx request.CTX, user *model.User, token string) *mo
 }
 
 func (a *App) MFARequired(rctx request.CTX) *model.AppError { // Open source license always has MFA enabled
-	if !*a.Config().ServiceSettings.EnableMultifactorAuthentication || !*a.Config().ServiceSettings.EnforceMultifactorAuthentication {
+	enforceMfa := *a.Config().ServiceSettings.EnableMultifactorAuthentication && *a.Config().ServiceSettings.EnforceMultifactorAuthentication
+	enforceWebAuthn := *a.Config().ServiceSettings.EnableWebAuthn && *a.Config().ServiceSettings.EnforceWebAuthn
+	if !enforceMfa && !enforceWebAuthn {
 		return nil
 	}
 
@@ -366,7 +395,20 @@ func (a *App) MFARequired(rctx request.CTX) *model.AppError { // Open source lic
 		return nil
 	}
 
-	if !user.MfaActive {
+	// Passkeys satisfy both policies, TOTP only the MFA one.
+	if user.MfaActive && !enforceWebAuthn {
+		return nil
+	}
+
+	hasWebAuthnCredentials, appErr := a.hasWebAuthnCredentials(user.Id)
+	if appErr != nil {
+		return appErr
+	}
+
+	if !hasWebAuthnCredentials {
+		if enforceWebAuthn {
+			return model.NewAppError("MfaRequired", "api.context.webauthn_required.app_error", nil, "", http.StatusForbidden)
+		}
 		return model.NewAppError("MfaRequired", "api.context.mfa_required.app_error", nil, "", http.StatusForbidden)
 	}
 
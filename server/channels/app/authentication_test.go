@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -527,7 +528,7 @@ func TestMigratePassword(t *testing.T) {
 		return updatedUser
 	}
 
-	t.Run("successful migration from BCrypt to PBKDF2", func(t *testing.T) {
+	t.Run("successful migration from BCrypt to Argon2id", func(t *testing.T) {
 		user := createUserWithHash(pwdBcrypt)
 
 		err := th.App.migratePassword(user, pwd)
@@ -536,10 +537,77 @@ func TestMigratePassword(t *testing.T) {
 		updatedUser, err := th.App.GetUser(user.Id)
 		require.Nil(t, err)
 		require.NotEqual(t, pwdBcrypt, updatedUser.Password)
-		require.Contains(t, updatedUser.Password, "$pbkdf2")
+		require.Contains(t, updatedUser.Password, "$argon2id")
 
 		// Re-check with updated password
 		err = th.App.checkUserPassword(user, pwd, false)
 		require.Nil(t, err)
 	})
+
+	t.Run("transparent migration from PBKDF2 to Argon2id on login", func(t *testing.T) {
+		pwdPBKDF2, err := hashers.DefaultPBKDF2().Hash(pwd)
+		require.NoError(t, err)
+		user := createUserWithHash(pwdPBKDF2)
+
+		appErr := th.App.checkUserPassword(user, pwd, false)
+		require.Nil(t, appErr)
+
+		updatedUser, appErr := th.App.GetUser(user.Id)
+		require.Nil(t, appErr)
+		require.True(t, strings.HasPrefix(updatedUser.Password, "$argon2id$v=19$m=19456,t=2,p=1$"))
+
+		appErr = th.App.checkUserPassword(updatedUser, pwd, false)
+		require.Nil(t, appErr)
+	})
+
+	t.Run("no migration on a wrong password", func(t *testing.T) {
+		user := createUserWithHash(pwdBcrypt)
+
+		appErr := th.App.checkUserPassword(user, "wrongPassword123$", false)
+		require.NotNil(t, appErr)
+
+		updatedUser, appErr := th.App.GetUser(user.Id)
+		require.Nil(t, appErr)
+		require.Equal(t, pwdBcrypt, updatedUser.Password)
+	})
+}
+
+func TestConfigurePasswordHasher(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	pwd := "testPassword123$"
+	user := th.CreateUser()
+	defer th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.PasswordSettings.Argon2idMemory = model.PasswordSettingsDefaultArgon2idMemory
+		*cfg.PasswordSettings.Argon2idIterations = model.PasswordSettingsDefaultArgon2idIterations
+	})
+
+	appErr := th.App.UpdatePassword(th.Context, user, pwd)
+	require.Nil(t, appErr)
+	user, appErr = th.App.GetUser(user.Id)
+	require.Nil(t, appErr)
+	require.True(t, strings.HasPrefix(user.Password, "$argon2id$v=19$m=19456,t=2,p=1$"))
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.PasswordSettings.Argon2idMemory = 32 * 1024
+		*cfg.PasswordSettings.Argon2idIterations = 3
+	})
+
+	// The password hashed with the previous parameters is rehashed on login
+	appErr = th.App.checkUserPassword(user, pwd, false)
+	require.Nil(t, appErr)
+	user, appErr = th.App.GetUser(user.Id)
+	require.Nil(t, appErr)
+	require.True(t, strings.HasPrefix(user.Password, "$argon2id$v=19$m=32768,t=3,p=1$"))
+
+	report, appErr := th.App.GetPasswordHashReport()
+	require.Nil(t, appErr)
+	require.NotEmpty(t, report)
+	require.Equal(t, hashers.Argon2idAlgorithm, report[0].Algorithm)
+	require.True(t, report[0].Latest)
+	require.GreaterOrEqual(t, report[0].Count, int64(1))
+	for _, line := range report[1:] {
+		require.False(t, line.Latest)
+	}
 }
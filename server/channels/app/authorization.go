@@ -6,6 +6,7 @@ package app
 import (
 	"database/sql"
 	"errors"
+	"maps"
 	"net/http"
 	"slices"
 	"strings"
@@ -15,10 +16,31 @@ import (
 	"github.com/mattermost/mattermost/server/public/shared/request"
 )
 
+// sessionScopesAllow checks that the scopes of a session authenticated by a personal access
+// token, if any, grant the permission, either everywhere or in the given channel.
+func sessionScopesAllow(session model.Session, channelID string, permission *model.Permission) bool {
+	scopes := session.GetUserAccessTokenScopes()
+	return len(scopes) == 0 || model.UserAccessTokenScopesAllow(scopes, channelID, permission)
+}
+
+// withoutUserAccessTokenScopes returns a copy of the session without its scopes, for the
+// checks falling back to the team or system roles once the scopes were checked for a channel.
+func withoutUserAccessTokenScopes(session model.Session) model.Session {
+	if _, ok := session.Props[model.SessionPropUserAccessTokenScopes]; !ok {
+		return session
+	}
+	session.Props = maps.Clone(session.Props)
+	delete(session.Props, model.SessionPropUserAccessTokenScopes)
+	return session
+}
+
 func (a *App) SessionHasPermissionTo(session model.Session, permission *model.Permission) bool {
 	if session.IsUnrestricted() {
 		return true
 	}
+	if !sessionScopesAllow(session, "", permission) {
+		return false
+	}
 	return a.RolesGrantPermission(session.GetUserRoles(), permission.Id)
 }
 
@@ -33,6 +55,10 @@ func (a *App) SessionHasPermissionToAndNotRestrictedAdmin(session model.Session,
 		return false
 	}
 
+	if !sessionScopesAllow(session, "", permission) {
+		return false
+	}
+
 	return a.RolesGrantPermission(session.GetUserRoles(), permission.Id)
 }
 
@@ -52,6 +78,9 @@ func (a *App) SessionHasPermissionToTeam(session model.Session, teamID string, p
 	if session.IsUnrestricted() {
 		return true
 	}
+	if !sessionScopesAllow(session, "", permission) {
+		return false
+	}
 
 	teamMember := session.GetTeamByTeamId(teamID)
 	if teamMember != nil {
@@ -73,6 +102,10 @@ func (a *App) SessionHasPermissionToTeams(rctx request.CTX, session model.Sessio
 		return false
 	}
 
+	if !session.IsUnrestricted() && !sessionScopesAllow(session, "", permission) {
+		return false
+	}
+
 	// Check session permission, if it allows access, no need to check teams.
 	if a.SessionHasPermissionTo(session, permission) {
 		return true
@@ -103,7 +136,15 @@ func (a *App) SessionHasPermissionToChannel(rctx request.CTX, session model.Sess
 		return false
 	}
 
-	if session.IsUnrestricted() || a.RolesGrantPermission(session.GetUserRoles(), model.PermissionManageSystem.Id) {
+	if session.IsUnrestricted() {
+		return true
+	}
+	if !sessionScopesAllow(session, channelID, permission) {
+		return false
+	}
+	session = withoutUserAccessTokenScopes(session)
+
+	if a.RolesGrantPermission(session.GetUserRoles(), model.PermissionManageSystem.Id) {
 		return true
 	}
 
@@ -131,7 +172,17 @@ func (a *App) SessionHasPermissionToChannels(rctx request.CTX, session model.Ses
 		return true
 	}
 
-	if session.IsUnrestricted() || a.RolesGrantPermission(session.GetUserRoles(), model.PermissionManageSystem.Id) {
+	if session.IsUnrestricted() {
+		return true
+	}
+	for _, channelID := range channelIDs {
+		if !sessionScopesAllow(session, channelID, permission) {
+			return false
+		}
+	}
+	session = withoutUserAccessTokenScopes(session)
+
+	if a.RolesGrantPermission(session.GetUserRoles(), model.PermissionManageSystem.Id) {
 		return true
 	}
 
@@ -172,6 +223,10 @@ func (a *App) SessionHasPermissionToChannels(rctx request.CTX, session model.Ses
 }
 
 func (a *App) SessionHasPermissionToGroup(session model.Session, groupID string, permission *model.Permission) bool {
+	if !sessionScopesAllow(session, "", permission) {
+		return false
+	}
+
 	groupMember, err := a.Srv().Store().Group().GetMember(groupID, session.UserId)
 	// don't reject immediately on ErrNoRows error because there's further authz logic below for non-groupmembers
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
@@ -195,6 +250,14 @@ func (a *App) SessionHasPermissionToChannelByPost(session model.Session, postID
 		return false
 	}
 
+	if len(session.GetUserAccessTokenScopes()) > 0 {
+		channel, err := a.Srv().Store().Channel().GetForPost(postID)
+		if err != nil || !sessionScopesAllow(session, channel.Id, permission) {
+			return false
+		}
+		session = withoutUserAccessTokenScopes(session)
+	}
+
 	if channelMember, err := a.Srv().Store().Channel().GetMemberForPost(postID, session.UserId); err == nil {
 		if a.RolesGrantPermission(channelMember.GetRoles(), permission.Id) {
 			return true
@@ -226,7 +289,9 @@ func (a *App) SessionHasPermissionToUser(session model.Session, userID string) b
 		return true
 	}
 
-	if session.UserId == userID {
+	// None of the scopes of a token cover its own user, so a scoped session has to be
+	// granted the access by its scopes like for any other user.
+	if session.UserId == userID && len(session.GetUserAccessTokenScopes()) == 0 {
 		return true
 	}
 
@@ -402,6 +467,9 @@ func (a *App) SessionHasPermissionToReadChannel(rctx request.CTX, session model.
 	if session.IsUnrestricted() {
 		return true
 	}
+	if !sessionScopesAllow(session, channel.Id, model.PermissionReadChannelContent) {
+		return false
+	}
 
 	return a.HasPermissionToReadChannel(rctx, session.UserId, channel)
 }
@@ -514,6 +514,15 @@ func TestSessionHasPermissionToUser(t *testing.T) {
 		assert.False(t, th.App.SessionHasPermissionToUser(session, th.BasicUser2.Id))
 	})
 
+	t.Run("test my user access with a scoped token", func(t *testing.T) {
+		session := model.Session{
+			UserId: th.BasicUser.Id,
+			Roles:  model.SystemUserRoleId,
+			Props:  model.StringMap{model.SessionPropUserAccessTokenScopes: "read:posts"},
+		}
+		assert.False(t, th.App.SessionHasPermissionToUser(session, th.BasicUser.Id))
+	})
+
 	t.Run("test user manager access", func(t *testing.T) {
 		session := model.Session{
 			UserId: th.BasicUser.Id,
@@ -683,10 +692,10 @@ func TestSessionHasPermissionToGroup(t *testing.T) {
 		}
 
 		if isGroupMember {
-			_, err := th.App.UpsertGroupMember(group.Id, th.BasicUser.Id)
+			_, err := th.App.UpsertGroupMember(th.Context, group.Id, th.BasicUser.Id)
 			require.Nil(t, err)
 		} else {
-			_, err := th.App.DeleteGroupMember(group.Id, th.BasicUser.Id)
+			_, err := th.App.DeleteGroupMember(th.Context, group.Id, th.BasicUser.Id)
 			if err != nil && err.Id != "app.group.no_rows" {
 				t.Error(err)
 			}
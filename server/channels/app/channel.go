@@ -181,20 +181,20 @@ func (a *App) CreateChannelWithUser(rctx request.CTX, channel *model.Channel, us
 		return nil, err
 	}
 
-	a.addChannelToDefaultCategory(rctx, userID, channel)
+	a.addChannelToDefaultCategory(rctx, userID, rchannel)
 
 	var user *model.User
 	if user, err = a.GetUser(userID); err != nil {
 		return nil, err
 	}
 
-	if err = a.postJoinChannelMessage(rctx, user, channel); err != nil {
+	if err = a.postJoinChannelMessage(rctx, user, rchannel); err != nil {
 		return nil, err
 	}
 
 	message := model.NewWebSocketEvent(model.WebsocketEventChannelCreated, "", "", userID, nil, "")
-	message.Add("channel_id", channel.Id)
-	message.Add("team_id", channel.TeamId)
+	message.Add("channel_id", rchannel.Id)
+	message.Add("team_id", rchannel.TeamId)
 	a.Publish(message)
 
 	return rchannel, nil
@@ -231,6 +231,12 @@ func (a *App) CreateChannel(rctx request.CTX, channel *model.Channel, addMember
 	a.handleChannelCategoryName(channel)
 
 	channel.DisplayName = strings.TrimSpace(channel.DisplayName)
+
+	channel, appErr := a.channelWillBeCreated(rctx, channel)
+	if appErr != nil {
+		return nil, appErr
+	}
+
 	sc, nErr := a.Srv().Store().Channel().Save(rctx, channel, *a.Config().TeamSettings.MaxChannelsPerTeam)
 	if nErr != nil {
 		var invErr *store.ErrInvalidInput
@@ -308,11 +314,47 @@ func (a *App) CreateChannel(rctx request.CTX, channel *model.Channel, addMember
 			hooks.ChannelHasBeenCreated(pluginContext, sc)
 			return true
 		}, plugin.ChannelHasBeenCreatedID)
+
+		a.sendEventToSubscriptions(rctx, &model.EventSubscriptionPayload{
+			Event:     model.EventTypeChannelHasBeenCreated,
+			TeamId:    sc.TeamId,
+			ChannelId: sc.Id,
+			UserId:    sc.CreatorId,
+			Data:      sc,
+		})
 	})
 
 	return sc, nil
 }
 
+// channelWillBeCreated runs the ChannelWillBeCreated hook of the plugins, returning the channel
+// as modified by them, or an error if one of them rejected it.
+func (a *App) channelWillBeCreated(rctx request.CTX, channel *model.Channel) (*model.Channel, *model.AppError) {
+	if channel.IsGroupOrDirect() {
+		return channel, nil
+	}
+
+	var rejectionError *model.AppError
+	pluginContext := pluginContext(rctx)
+	a.ch.RunMultiHook(func(hooks plugin.Hooks, _ *model.Manifest) bool {
+		replacementChannel, rejectionReason := hooks.ChannelWillBeCreated(pluginContext, channel)
+		if rejectionReason != "" {
+			rejectionError = model.NewAppError("CreateChannel", "app.channel.create_channel.rejected_by_plugin.app_error", map[string]any{"Reason": rejectionReason}, "", http.StatusBadRequest)
+			return false
+		}
+		if replacementChannel != nil {
+			channel = replacementChannel
+		}
+		return true
+	}, plugin.ChannelWillBeCreatedID)
+
+	if rejectionError != nil {
+		return nil, rejectionError
+	}
+
+	return channel, nil
+}
+
 func (a *App) GetOrCreateDirectChannel(rctx request.CTX, userID, otherUserID string, channelOptions ...model.ChannelOption) (*model.Channel, *model.AppError) {
 	channel, nErr := a.getDirectChannel(rctx, userID, otherUserID)
 	if nErr != nil {
@@ -392,6 +434,14 @@ func (a *App) handleCreationEvent(rctx request.CTX, userID, otherUserID string,
 			hooks.ChannelHasBeenCreated(pluginContext, channel)
 			return true
 		}, plugin.ChannelHasBeenCreatedID)
+
+		a.sendEventToSubscriptions(rctx, &model.EventSubscriptionPayload{
+			Event:     model.EventTypeChannelHasBeenCreated,
+			TeamId:    channel.TeamId,
+			ChannelId: channel.Id,
+			UserId:    channel.CreatorId,
+			Data:      channel,
+		})
 	})
 
 	message := model.NewWebSocketEvent(model.WebsocketEventDirectAdded, "", channel.Id, "", nil, "")
@@ -679,6 +729,14 @@ func (a *App) createGroupChannel(rctx request.CTX, userIDs []string, creatorID s
 			hooks.ChannelHasBeenCreated(pluginContext, channel)
 			return true
 		}, plugin.ChannelHasBeenCreatedID)
+
+		a.sendEventToSubscriptions(rctx, &model.EventSubscriptionPayload{
+			Event:     model.EventTypeChannelHasBeenCreated,
+			TeamId:    channel.TeamId,
+			ChannelId: channel.Id,
+			UserId:    channel.CreatorId,
+			Data:      channel,
+		})
 	})
 
 	return channel, nil
@@ -716,7 +774,18 @@ func (a *App) UpdateChannel(rctx request.CTX, channel *model.Channel) (*model.Ch
 		return nil, model.NewAppError("UpdateChannel", "api.channel.update_channel.not_allowed.app_error", nil, "", http.StatusForbidden)
 	}
 
-	_, err := a.Srv().Store().Channel().Update(rctx, channel)
+	oldChannel, err := a.Srv().Store().Channel().Get(channel.Id, true)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("UpdateChannel", "app.channel.get.existing.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("UpdateChannel", "app.channel.get.find.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	_, err = a.Srv().Store().Channel().Update(rctx, channel)
 	if err != nil {
 		var appErr *model.AppError
 		var uniqueConstraintErr *store.ErrUniqueConstraint
@@ -743,6 +812,15 @@ func (a *App) UpdateChannel(rctx request.CTX, channel *model.Channel) (*model.Ch
 	messageWs.Add("channel", string(channelJSON))
 	a.Publish(messageWs)
 
+	newChannel := channel.DeepCopy()
+	a.Srv().Go(func() {
+		pluginContext := pluginContext(rctx)
+		a.ch.RunMultiHook(func(hooks plugin.Hooks, _ *model.Manifest) bool {
+			hooks.ChannelHasBeenUpdated(pluginContext, newChannel, oldChannel)
+			return true
+		}, plugin.ChannelHasBeenUpdatedID)
+	})
+
 	return channel, nil
 }
 
@@ -928,6 +1006,15 @@ func (a *App) RestoreChannel(rctx request.CTX, channel *model.Channel, userID st
 		})
 	}
 
+	restoredChannel := channel.DeepCopy()
+	a.Srv().Go(func() {
+		pluginContext := pluginContext(rctx)
+		a.ch.RunMultiHook(func(hooks plugin.Hooks, _ *model.Manifest) bool {
+			hooks.ChannelHasBeenRestored(pluginContext, restoredChannel, user)
+			return true
+		}, plugin.ChannelHasBeenRestoredID)
+	})
+
 	return channel, nil
 }
 
@@ -1619,6 +1706,16 @@ func (a *App) DeleteChannel(rctx request.CTX, channel *model.Channel, userID str
 	message.Add("delete_at", deleteAt)
 	a.Publish(message)
 
+	archivedChannel := channel.DeepCopy()
+	archivedChannel.DeleteAt = deleteAt
+	a.Srv().Go(func() {
+		pluginContext := pluginContext(rctx)
+		a.ch.RunMultiHook(func(hooks plugin.Hooks, _ *model.Manifest) bool {
+			hooks.ChannelHasBeenArchived(pluginContext, archivedChannel, user)
+			return true
+		}, plugin.ChannelHasBeenArchivedID)
+	})
+
 	return nil
 }
 
@@ -1812,6 +1909,15 @@ func (a *App) AddChannelMember(rctx request.CTX, userID string, channel *model.C
 			hooks.UserHasJoinedChannel(pluginContext, cm, userRequestor)
 			return true
 		}, plugin.UserHasJoinedChannelID)
+
+		a.sendEventToSubscriptions(rctx, &model.EventSubscriptionPayload{
+			Event:     model.EventTypeUserHasJoinedChannel,
+			TeamId:    channel.TeamId,
+			ChannelId: channel.Id,
+			UserId:    cm.UserId,
+			ActorId:   opts.UserRequestorID,
+			Data:      cm,
+		})
 	})
 
 	if opts.UserRequestorID == "" || userID == opts.UserRequestorID {
@@ -2440,6 +2546,14 @@ func (a *App) JoinChannel(rctx request.CTX, channel *model.Channel, userID strin
 			hooks.UserHasJoinedChannel(pluginContext, cm, nil)
 			return true
 		}, plugin.UserHasJoinedChannelID)
+
+		a.sendEventToSubscriptions(rctx, &model.EventSubscriptionPayload{
+			Event:     model.EventTypeUserHasJoinedChannel,
+			TeamId:    channel.TeamId,
+			ChannelId: channel.Id,
+			UserId:    cm.UserId,
+			Data:      cm,
+		})
 	})
 
 	if err := a.postJoinChannelMessage(rctx, user, channel); err != nil {
@@ -2738,6 +2852,15 @@ func (a *App) removeUserFromChannel(rctx request.CTX, userIDToRemove string, rem
 			hooks.UserHasLeftChannel(pluginContext, cm, actorUser)
 			return true
 		}, plugin.UserHasLeftChannelID)
+
+		a.sendEventToSubscriptions(rctx, &model.EventSubscriptionPayload{
+			Event:     model.EventTypeUserHasLeftChannel,
+			TeamId:    channel.TeamId,
+			ChannelId: channel.Id,
+			UserId:    cm.UserId,
+			ActorId:   removerUserId,
+			Data:      cm,
+		})
 	})
 
 	message := model.NewWebSocketEvent(model.WebsocketEventUserRemoved, "", channel.Id, "", nil, "")
@@ -3268,6 +3391,15 @@ func (a *App) PermanentDeleteChannel(rctx request.CTX, channel *model.Channel) *
 	message.Add("delete_at", deleteAt)
 	a.Publish(message)
 
+	deletedChannel := channel.DeepCopy()
+	a.Srv().Go(func() {
+		pluginContext := pluginContext(rctx)
+		a.ch.RunMultiHook(func(hooks plugin.Hooks, _ *model.Manifest) bool {
+			hooks.ChannelHasBeenDeleted(pluginContext, deletedChannel)
+			return true
+		}, plugin.ChannelHasBeenDeletedID)
+	})
+
 	return nil
 }
 
@@ -1888,7 +1888,7 @@ func TestAddUserToChannel(t *testing.T) {
 
 	group := th.CreateGroup()
 
-	_, appErr = th.App.UpsertGroupMember(group.Id, user1.Id)
+	_, appErr = th.App.UpsertGroupMember(th.Context, group.Id, user1.Id)
 	require.Nil(t, appErr)
 
 	gs, appErr := th.App.UpsertGroupSyncable(&model.GroupSyncable{
@@ -1918,7 +1918,7 @@ func TestAddUserToChannel(t *testing.T) {
 	_, appErr = th.App.AddTeamMember(th.Context, th.BasicTeam.Id, ruser2.Id)
 	require.Nil(t, appErr)
 
-	_, appErr = th.App.UpsertGroupMember(group.Id, user2.Id)
+	_, appErr = th.App.UpsertGroupMember(th.Context, group.Id, user2.Id)
 	require.Nil(t, appErr)
 
 	gs.SchemeAdmin = true
@@ -1991,7 +1991,7 @@ func TestRemoveUserFromChannel(t *testing.T) {
 	require.Nil(t, appErr)
 
 	group := th.CreateGroup()
-	_, appErr = th.App.UpsertGroupMember(group.Id, ruser.Id)
+	_, appErr = th.App.UpsertGroupMember(th.Context, group.Id, ruser.Id)
 	require.Nil(t, appErr)
 
 	_, appErr = th.App.UpsertGroupSyncable(&model.GroupSyncable{
@@ -82,6 +82,9 @@ type Channels struct {
 	postReminderMut  sync.Mutex
 	postReminderTask *model.ScheduledTask
 
+	outgoingWebhookDeliveryMut  sync.Mutex
+	outgoingWebhookDeliveryTask *model.ScheduledTask
+
 	interruptQuitChan     chan struct{}
 	scheduledPostMut      sync.Mutex
 	scheduledPostTask     *model.ScheduledTask
@@ -221,7 +221,10 @@ func (a *App) GetSanitizedConfig() *model.Config {
 }
 
 // SanitizedConfig sanitizes a given configuration for a system admin without any secrets.
+// The settings read from a file or an environment variable are masked as well.
 func (a *App) SanitizedConfig(cfg *model.Config) {
+	a.Srv().Platform().GetConfigStore().MaskSecretReferences(cfg)
+
 	manifests, err := a.getPluginManifests()
 	if err != nil {
 		// GetPluginManifests might error, e.g. when plugins are disabled.
@@ -0,0 +1,212 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+	"github.com/mattermost/mattermost/server/v8/config"
+)
+
+// SaveConfigWithRevision saves the configuration submitted through the API like SaveConfig,
+// recording the user of the session as the author of its revision.
+func (a *App) SaveConfigWithRevision(rctx request.CTX, newCfg *model.Config, sendConfigChangeClusterMessage bool) (*model.Config, *model.Config, *model.AppError) {
+	// The secrets are only read from the files and environment variables referenced by
+	// the configuration file or the environment of the server, never by its users.
+	if settings := a.Srv().Platform().GetConfigStore().NewSecretReferences(newCfg); len(settings) > 0 {
+		return nil, nil, model.NewAppError("SaveConfigWithRevision", "app.config.secret_reference.app_error", map[string]any{"Settings": strings.Join(settings, ", ")}, "", http.StatusBadRequest)
+	}
+
+	// The revision is recorded by the config listener, which runs before SaveConfig returns.
+	a.Srv().configRevisionMut.Lock()
+	defer a.Srv().configRevisionMut.Unlock()
+
+	userID := rctx.Session().UserId
+	a.Srv().configRevisionUserId.Store(&userID)
+	defer a.Srv().configRevisionUserId.Store(nil)
+
+	return a.SaveConfig(newCfg, sendConfigChangeClusterMessage)
+}
+
+// recordConfigRevision records a change of the configuration in its history. It is called
+// by a config listener, so that the changes made through the API, in local mode, by the
+// plugins or by reloading the configuration are all recorded. Only the changes saved
+// through SaveConfigWithRevision have an author.
+//
+// The revisions hold the configuration with its secrets masked. A change of the secrets
+// alone isn't recorded, and rolling back keeps the current secrets.
+func (s *Server) recordConfigRevision(oldCfg, newCfg *model.Config) error {
+	newValue, err := s.configRevisionValue(newCfg)
+	if err != nil {
+		return err
+	}
+
+	latest, err := s.Store().ConfigRevision().GetAll(0, 1)
+	if err != nil {
+		return err
+	}
+
+	var latestCreateAt int64
+	if len(latest) > 0 {
+		// The other nodes of the cluster reload the change recorded by the node that
+		// made it.
+		if latest[0].Value == newValue {
+			return nil
+		}
+		latestCreateAt = latest[0].CreateAt
+	} else {
+		oldValue, err := s.configRevisionValue(oldCfg)
+		if err != nil {
+			return err
+		}
+		if oldValue == newValue {
+			return nil
+		}
+
+		// The configuration found when the history starts is recorded first, so that
+		// the first change can be compared with it and rolled back.
+		baseline, err := s.Store().ConfigRevision().Save(&model.ConfigRevision{Value: oldValue})
+		if err != nil {
+			return err
+		}
+		latestCreateAt = baseline.CreateAt
+	}
+
+	var userID string
+	if id := s.configRevisionUserId.Load(); id != nil {
+		userID = *id
+	}
+
+	// The revisions are ordered by their creation time, which must then differ
+	// even for changes made within the same millisecond.
+	if _, err := s.Store().ConfigRevision().Save(&model.ConfigRevision{
+		CreateAt: max(model.GetMillis(), latestCreateAt+1),
+		UserId:   userID,
+		Value:    newValue,
+	}); err != nil {
+		return err
+	}
+
+	return s.Store().ConfigRevision().PermanentDeleteAllButLatest(model.ConfigRevisionsMaxCount)
+}
+
+// configRevisionValue returns the JSON the configuration is recorded in its history with,
+// without the environment overrides and with the secrets masked. The feature flags are
+// left out since they aren't managed through the configuration.
+func (s *Server) configRevisionValue(cfg *model.Config) (string, error) {
+	cfg = s.platform.GetConfigStore().RemoveEnvironmentOverrides(cfg)
+	cfg.FeatureFlags = nil
+	New(ServerConnector(s.Channels())).SanitizedConfig(cfg)
+
+	value, err := json.Marshal(cfg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(value), nil
+}
+
+// GetConfigRevisions returns the revisions of the configuration, from the latest.
+func (a *App) GetConfigRevisions(page, perPage int) ([]*model.ConfigRevision, *model.AppError) {
+	revisions, err := a.Srv().Store().ConfigRevision().GetAll(page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetConfigRevisions", "app.config_revision.get_all.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return revisions, nil
+}
+
+func (a *App) getConfigRevision(revisionID string) (*model.ConfigRevision, *model.AppError) {
+	revision, err := a.Srv().Store().ConfigRevision().Get(revisionID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return nil, model.NewAppError("getConfigRevision", "app.config_revision.get.not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		}
+		return nil, model.NewAppError("getConfigRevision", "app.config_revision.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return revision, nil
+}
+
+func configRevisionConfig(revision *model.ConfigRevision) (*model.Config, *model.AppError) {
+	cfg, err := revision.Config()
+	if err != nil {
+		return nil, model.NewAppError("configRevisionConfig", "app.config_revision.invalid_value.app_error", nil, "id="+revision.Id, http.StatusInternalServerError).Wrap(err)
+	}
+
+	return cfg, nil
+}
+
+// GetConfigRevisionDiff returns the settings changed by a revision of the configuration,
+// with the secrets masked. The revision is compared with the one given as base, with the
+// current configuration for model.ConfigRevisionBaseCurrent, or else with the revision
+// preceding it.
+func (a *App) GetConfigRevisionDiff(revisionID, baseID string) (config.ConfigDiffs, *model.AppError) {
+	revision, appErr := a.getConfigRevision(revisionID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	actualCfg, appErr := configRevisionConfig(revision)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	var baseCfg *model.Config
+	switch baseID {
+	case model.ConfigRevisionBaseCurrent:
+		value, err := a.Srv().configRevisionValue(a.Config())
+		if err != nil {
+			return nil, model.NewAppError("GetConfigRevisionDiff", "app.config_revision.diff.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		if baseCfg, appErr = configRevisionConfig(&model.ConfigRevision{Value: value}); appErr != nil {
+			return nil, appErr
+		}
+	case "":
+		base, err := a.Srv().Store().ConfigRevision().GetPrevious(revision.CreateAt)
+		if err != nil {
+			var nfErr *store.ErrNotFound
+			if !errors.As(err, &nfErr) {
+				return nil, model.NewAppError("GetConfigRevisionDiff", "app.config_revision.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+			// The first revision of the history changes nothing.
+			return config.ConfigDiffs{}, nil
+		}
+		if baseCfg, appErr = configRevisionConfig(base); appErr != nil {
+			return nil, appErr
+		}
+	default:
+		base, appErr := a.getConfigRevision(baseID)
+		if appErr != nil {
+			return nil, appErr
+		}
+		if baseCfg, appErr = configRevisionConfig(base); appErr != nil {
+			return nil, appErr
+		}
+	}
+
+	diffs, err := config.Diff(baseCfg, actualCfg)
+	if err != nil {
+		return nil, model.NewAppError("GetConfigRevisionDiff", "app.config_revision.diff.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return diffs.Sanitize(), nil
+}
+
+// GetConfigRevisionConfig returns the configuration of a revision, with its secrets
+// masked. Rolling back to it is saved like any other change of the configuration.
+func (a *App) GetConfigRevisionConfig(revisionID string) (*model.Config, *model.AppError) {
+	revision, appErr := a.getConfigRevision(revisionID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	return configRevisionConfig(revision)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestConfigRevisions(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	rctx := th.Context.WithSession(&model.Session{UserId: model.NewId()})
+	originalSiteName := *th.App.Config().TeamSettings.SiteName
+
+	saveSiteName := func(t *testing.T, siteName string) {
+		t.Helper()
+
+		cfg := th.App.Config().Clone()
+		cfg.TeamSettings.SiteName = model.NewPointer(siteName)
+		_, _, appErr := th.App.SaveConfigWithRevision(rctx, cfg, false)
+		require.Nil(t, appErr)
+	}
+
+	saveSiteName(t, "First")
+	saveSiteName(t, "First")
+	saveSiteName(t, "Second")
+
+	revisions, appErr := th.App.GetConfigRevisions(0, 10)
+	require.Nil(t, appErr)
+	require.GreaterOrEqual(t, len(revisions), 3, "the configuration found first and the two changes should be recorded")
+	assert.Equal(t, rctx.Session().UserId, revisions[0].UserId)
+	assert.Equal(t, rctx.Session().UserId, revisions[1].UserId)
+	assert.Greater(t, revisions[0].CreateAt, revisions[1].CreateAt)
+	assert.Greater(t, revisions[1].CreateAt, revisions[2].CreateAt)
+
+	t.Run("diff with the previous revision", func(t *testing.T) {
+		diffs, appErr := th.App.GetConfigRevisionDiff(revisions[0].Id, "")
+		require.Nil(t, appErr)
+		require.Len(t, diffs, 1)
+		assert.Equal(t, "TeamSettings.SiteName", diffs[0].Path)
+		assert.Equal(t, "First", diffs[0].BaseVal)
+		assert.Equal(t, "Second", diffs[0].ActualVal)
+	})
+
+	t.Run("diff with the current configuration", func(t *testing.T) {
+		diffs, appErr := th.App.GetConfigRevisionDiff(revisions[2].Id, model.ConfigRevisionBaseCurrent)
+		require.Nil(t, appErr)
+		require.Len(t, diffs, 1)
+		assert.Equal(t, "Second", diffs[0].BaseVal)
+		assert.Equal(t, originalSiteName, diffs[0].ActualVal)
+	})
+
+	t.Run("diff of an unknown revision", func(t *testing.T) {
+		_, appErr := th.App.GetConfigRevisionDiff(model.NewId(), "")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.config_revision.get.not_found.app_error", appErr.Id)
+	})
+
+	t.Run("configuration of a revision", func(t *testing.T) {
+		cfg, appErr := th.App.GetConfigRevisionConfig(revisions[1].Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, "First", *cfg.TeamSettings.SiteName)
+	})
+
+	t.Run("secrets are masked", func(t *testing.T) {
+		cfg := th.App.Config().Clone()
+		cfg.EmailSettings.SMTPPassword = model.NewPointer("smtp-secret")
+		cfg.TeamSettings.SiteName = model.NewPointer("Third")
+		_, _, appErr := th.App.SaveConfigWithRevision(rctx, cfg, false)
+		require.Nil(t, appErr)
+
+		latest, appErr := th.App.GetConfigRevisions(0, 1)
+		require.Nil(t, appErr)
+		require.Len(t, latest, 1)
+		assert.NotContains(t, latest[0].Value, "smtp-secret")
+
+		cfg, appErr = th.App.GetConfigRevisionConfig(latest[0].Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.FakeSetting, *cfg.EmailSettings.SMTPPassword)
+	})
+
+	t.Run("changes saved without an author are recorded", func(t *testing.T) {
+		cfg := th.App.Config().Clone()
+		cfg.TeamSettings.SiteName = model.NewPointer("Fourth")
+		_, _, appErr := th.App.SaveConfig(cfg, false)
+		require.Nil(t, appErr)
+
+		latest, appErr := th.App.GetConfigRevisions(0, 1)
+		require.Nil(t, appErr)
+		require.Len(t, latest, 1)
+		assert.Empty(t, latest[0].UserId)
+
+		revisionCfg, appErr := th.App.GetConfigRevisionConfig(latest[0].Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, "Fourth", *revisionCfg.TeamSettings.SiteName)
+	})
+}
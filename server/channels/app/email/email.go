@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -47,7 +48,7 @@ func (es *Service) SendChangeUsernameEmail(newUsername, email, locale, siteURL s
 		map[string]any{"TeamDisplayName": es.config().TeamSettings.SiteName, "NewUsername": newUsername})
 	data.Props["Warning"] = T("api.templates.email_warning")
 
-	body, err := es.templatesContainer.RenderToString("email_change_body", data)
+	body, err := es.RenderTemplate("email_change_body", data)
 	if err != nil {
 		return err
 	}
@@ -80,7 +81,7 @@ func (es *Service) SendEmailChangeVerifyEmail(newUserEmail, locale, siteURL, tok
 	data.Props["SupportEmail"] = "feedback@mattermost.com"
 	data.Props["FooterV2"] = T("api.templates.email_footer_v2")
 
-	body, err := es.templatesContainer.RenderToString("email_change_verify_body", data)
+	body, err := es.RenderTemplate("email_change_verify_body", data)
 	if err != nil {
 		return err
 	}
@@ -106,7 +107,7 @@ func (es *Service) SendEmailChangeEmail(oldEmail, newEmail, locale, siteURL stri
 		map[string]any{"TeamDisplayName": es.config().TeamSettings.SiteName, "NewEmail": newEmail})
 	data.Props["Warning"] = T("api.templates.email_warning")
 
-	body, err := es.templatesContainer.RenderToString("email_change_body", data)
+	body, err := es.RenderTemplate("email_change_body", data)
 	if err != nil {
 		return err
 	}
@@ -144,7 +145,7 @@ func (es *Service) SendVerifyEmail(userEmail, locale, siteURL, token, redirect s
 	data.Props["QuestionTitle"] = T("api.templates.questions_footer.title")
 	data.Props["QuestionInfo"] = T("api.templates.questions_footer.info")
 
-	body, err := es.templatesContainer.RenderToString("verify_body", data)
+	body, err := es.RenderTemplate("verify_body", data)
 	if err != nil {
 		return err
 	}
@@ -169,7 +170,7 @@ func (es *Service) SendSignInChangeEmail(email, method, locale, siteURL string)
 		map[string]any{"SiteName": es.config().TeamSettings.SiteName, "Method": method})
 	data.Props["Warning"] = T("api.templates.email_warning")
 
-	body, err := es.templatesContainer.RenderToString("signin_change_body", data)
+	body, err := es.RenderTemplate("signin_change_body", data)
 	if err != nil {
 		return err
 	}
@@ -227,7 +228,7 @@ func (es *Service) SendWelcomeEmail(userID string, email string, verified bool,
 		data.Props["ButtonURL"] = link
 	}
 
-	body, err := es.templatesContainer.RenderToString("welcome_body", data)
+	body, err := es.RenderTemplate("welcome_body", data)
 	if err != nil {
 		return err
 	}
@@ -271,7 +272,7 @@ func (es *Service) SendCloudWelcomeEmail(userEmail, locale, teamInviteID, workSp
 	data.Props["Button"] = T("api.templates.cloud_welcome_email.button")
 	data.Props["GettingStartedQuestions"] = T("api.templates.cloud_welcome_email.start_questions")
 
-	body, err := es.templatesContainer.RenderToString("cloud_welcome_email", data)
+	body, err := es.RenderTemplate("cloud_welcome_email", data)
 	if err != nil {
 		return err
 	}
@@ -297,7 +298,7 @@ func (es *Service) SendPasswordChangeEmail(email, method, locale, siteURL string
 		map[string]any{"TeamDisplayName": es.config().TeamSettings.SiteName, "TeamURL": siteURL, "Method": method})
 	data.Props["Warning"] = T("api.templates.email_warning")
 
-	body, err := es.templatesContainer.RenderToString("password_change_body", data)
+	body, err := es.RenderTemplate("password_change_body", data)
 	if err != nil {
 		return err
 	}
@@ -322,7 +323,7 @@ func (es *Service) SendUserAccessTokenAddedEmail(email, locale, siteURL string)
 		map[string]any{"SiteName": es.config().TeamSettings.SiteName, "SiteURL": siteURL})
 	data.Props["Warning"] = T("api.templates.email_warning")
 
-	body, err := es.templatesContainer.RenderToString("password_change_body", data)
+	body, err := es.RenderTemplate("password_change_body", data)
 	if err != nil {
 		return err
 	}
@@ -334,6 +335,67 @@ func (es *Service) SendUserAccessTokenAddedEmail(email, locale, siteURL string)
 	return nil
 }
 
+func (es *Service) SendUserAccessTokenExpiringEmail(email, locale, siteURL, description string, expiresAt int64) error {
+	T := i18n.GetUserTranslations(locale)
+
+	subject := T("api.templates.user_access_token_expiring_subject",
+		map[string]any{"SiteName": es.config().TeamSettings.SiteName})
+
+	data := es.NewEmailTemplateData(locale)
+	data.Props["SiteURL"] = siteURL
+	data.Props["Title"] = T("api.templates.user_access_token_expiring_body.title")
+	data.Props["Info"] = T("api.templates.user_access_token_expiring_body.info",
+		map[string]any{
+			"Description": description,
+			"ExpiresAt":   time.UnixMilli(expiresAt).UTC().Format("January 2, 2006 15:04 MST"),
+			"SiteURL":     siteURL,
+		})
+	data.Props["Warning"] = T("api.templates.email_warning")
+
+	body, err := es.RenderTemplate("password_change_body", data)
+	if err != nil {
+		return err
+	}
+
+	if err := es.sendMail(email, subject, body, "UserAccessTokenExpiringEmail"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (es *Service) SendNewSignInEmail(email, locale, siteURL string, device *model.UserDevice) error {
+	T := i18n.GetUserTranslations(locale)
+
+	subject := T("api.templates.new_sign_in_subject",
+		map[string]any{"SiteName": es.config().TeamSettings.SiteName})
+
+	data := es.NewEmailTemplateData(locale)
+	data.Props["SiteURL"] = siteURL
+	data.Props["Title"] = T("api.templates.new_sign_in_body.title")
+	data.Props["Info"] = T("api.templates.new_sign_in_body.info",
+		map[string]any{
+			"Platform":  device.Platform,
+			"Os":        device.Os,
+			"Browser":   device.Browser,
+			"IPAddress": device.LastIPAddress,
+			"SignInAt":  time.UnixMilli(device.LastSeenAt).UTC().Format("January 2, 2006 15:04 MST"),
+			"SiteURL":   siteURL,
+		})
+	data.Props["Warning"] = T("api.templates.email_warning")
+
+	body, err := es.RenderTemplate("password_change_body", data)
+	if err != nil {
+		return err
+	}
+
+	if err := es.sendMail(email, subject, body, "NewSignInEmail"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (es *Service) SendPasswordResetEmail(email string, token *model.Token, locale, siteURL string) (bool, error) {
 	T := i18n.GetUserTranslations(locale)
 
@@ -352,7 +414,7 @@ func (es *Service) SendPasswordResetEmail(email string, token *model.Token, loca
 	data.Props["QuestionTitle"] = T("api.templates.questions_footer.title")
 	data.Props["QuestionInfo"] = T("api.templates.questions_footer.info")
 
-	body, err := es.templatesContainer.RenderToString("reset_body", data)
+	body, err := es.RenderTemplate("reset_body", data)
 	if err != nil {
 		return false, err
 	}
@@ -382,7 +444,7 @@ func (es *Service) SendMfaChangeEmail(email string, activated bool, locale, site
 	}
 	data.Props["Warning"] = T("api.templates.email_warning")
 
-	body, err := es.templatesContainer.RenderToString("mfa_change_body", data)
+	body, err := es.RenderTemplate("mfa_change_body", data)
 	if err != nil {
 		return err
 	}
@@ -468,7 +530,7 @@ func (es *Service) SendInviteEmails(
 			queryString.Add("sbr", es.GetTrackFlowStartedByRole(isFirstAdmin, isSystemAdmin))
 			data.Props["ButtonURL"] = fmt.Sprintf("%s/signup_user_complete/?%s", siteURL, queryString.Encode())
 
-			body, err := es.templatesContainer.RenderToString("invite_body", data)
+			body, err := es.RenderTemplate("invite_body", data)
 			if err != nil {
 				mlog.Error("Failed to send invite email successfully ", mlog.Err(err))
 			}
@@ -584,7 +646,7 @@ func (es *Service) SendGuestInviteEmails(
 
 			data.Props["Posts"] = []postData{pData}
 
-			body, err := es.templatesContainer.RenderToString("invite_body", data)
+			body, err := es.RenderTemplate("invite_body", data)
 			if err != nil {
 				mlog.Error("Failed to send invite email successfully", mlog.Err(err))
 			}
@@ -732,7 +794,7 @@ func (es *Service) SendInviteEmailsToTeamAndChannels(
 
 		data.Props["Posts"] = []postData{pData}
 
-		body, err := es.templatesContainer.RenderToString("invite_body", data)
+		body, err := es.RenderTemplate("invite_body", data)
 		if err != nil {
 			mlog.Error("Failed to send invite email successfully ", mlog.Err(err))
 		}
@@ -775,7 +837,8 @@ func (es *Service) NewEmailTemplateData(locale string) templates.Data {
 			"FooterV2":     localT("api.templates.email_footer_v2"),
 			"Organization": organization,
 		},
-		HTML: map[string]template.HTML{},
+		HTML:   map[string]template.HTML{},
+		Locale: locale,
 	}
 }
 
@@ -795,7 +858,7 @@ func (es *Service) SendDeactivateAccountEmail(email string, locale, siteURL stri
 		map[string]any{"SiteURL": siteURL})
 	data.Props["Warning"] = T("api.templates.deactivate_body.warning")
 
-	body, err := es.templatesContainer.RenderToString("deactivate_body", data)
+	body, err := es.RenderTemplate("deactivate_body", data)
 	if err != nil {
 		return err
 	}
@@ -926,7 +989,7 @@ func (es *Service) SendLicenseUpForRenewalEmail(email, name, locale, siteURL, ct
 	data.Props["SupportEmail"] = "feedback@mattermost.com"
 	data.Props["QuestionInfo"] = T("api.templates.questions_footer.info")
 
-	body, err := es.templatesContainer.RenderToString("license_up_for_renewal", data)
+	body, err := es.RenderTemplate("license_up_for_renewal", data)
 	if err != nil {
 		return err
 	}
@@ -951,7 +1014,7 @@ func (es *Service) SendRemoveExpiredLicenseEmail(ctaText, ctaLink, email, locale
 	data.Props["Link"] = ctaLink
 	data.Props["LinkButton"] = ctaText
 
-	body, err := es.templatesContainer.RenderToString("remove_expired_license", data)
+	body, err := es.RenderTemplate("remove_expired_license", data)
 	if err != nil {
 		return err
 	}
@@ -988,7 +1051,7 @@ func (es *Service) SendIPFiltersChangedEmail(email string, initiatingUser *model
 	data.Props["ContactSupport"] = T("api.templates.ip_filters_changed_footer.contact_support")
 	data.Props["SupportEmail"] = *es.config().SupportSettings.SupportEmail
 
-	body, err := es.templatesContainer.RenderToString("ip_filters_changed", data)
+	body, err := es.RenderTemplate("ip_filters_changed", data)
 	if err != nil {
 		return err
 	}
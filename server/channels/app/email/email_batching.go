@@ -368,7 +368,7 @@ func (es *Service) sendBatchedEmailNotification(userID string, notifications []*
 	data.Props["NotificationFooterInfoLogin"] = translateFunc("app.notification.footer.infoLogin")
 	data.Props["NotificationFooterInfo"] = translateFunc("app.notification.footer.info")
 
-	renderedPage, renderErr := es.templatesContainer.RenderToString("messages_notification", data)
+	renderedPage, renderErr := es.RenderTemplate("messages_notification", data)
 	if renderErr != nil {
 		mlog.Error("Unable to render email", mlog.Err(renderErr))
 	}
@@ -0,0 +1,109 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package email
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/i18n"
+)
+
+// EmailDigest summarizes the activity a user missed since their previous digest.
+type EmailDigest struct {
+	// Frequency is either model.PreferenceEmailDigestDaily or model.PreferenceEmailDigestWeekly.
+	Frequency string
+
+	// Mentions are the channels with unread mentions of the user.
+	Mentions []*EmailDigestItem
+	// Threads are the threads followed by the user with new replies.
+	Threads []*EmailDigestItem
+	// Channels are the most active channels the user belongs to.
+	Channels []*EmailDigestItem
+}
+
+// IsEmpty returns whether there is nothing to tell the user about.
+func (d *EmailDigest) IsEmpty() bool {
+	return len(d.Mentions) == 0 && len(d.Threads) == 0 && len(d.Channels) == 0
+}
+
+// EmailDigestItem is a channel or a thread listed in a digest, with a link to it.
+type EmailDigestItem struct {
+	// Title is the name of the channel, or of the author of the thread.
+	Title string
+	// Subtitle is the name of the team of the channel, or of the channel of the thread.
+	Subtitle string
+	// Count is the number of unread mentions, new replies or new messages.
+	Count int64
+	// Preview is the beginning of the root post of a thread.
+	Preview string
+	URL     string
+	// Photo is the image shown next to the item.
+	Photo []byte
+}
+
+// SendEmailDigest sends a digest to a user, rendered like the batched notifications.
+func (es *Service) SendEmailDigest(user *model.User, digest *EmailDigest) error {
+	T := i18n.GetUserTranslations(user.Locale)
+	siteURL := *es.config().ServiceSettings.SiteURL
+
+	postsData := make([]*postData, 0, len(digest.Mentions)+len(digest.Threads)+len(digest.Channels))
+	embeddedFiles := make(map[string]io.Reader)
+
+	addItems := func(items []*EmailDigestItem, messageID string) {
+		for _, item := range items {
+			message := template.HTMLEscapeString(T(messageID, map[string]any{"Count": item.Count}))
+			if item.Preview != "" {
+				message += "<br/>" + template.HTMLEscapeString(item.Preview)
+			}
+
+			photo := fmt.Sprintf("digest-item-%d.png", len(postsData))
+			if item.Photo != nil {
+				embeddedFiles[photo] = bytes.NewReader(item.Photo)
+			}
+
+			postsData = append(postsData, &postData{
+				SenderName:      truncateUserNames(item.Title, 22),
+				ChannelName:     item.Subtitle,
+				ShowChannelIcon: item.Subtitle != "",
+				Message:         template.HTML(message),
+				MessageURL:      item.URL,
+				SenderPhoto:     photo,
+			})
+		}
+	}
+	addItems(digest.Mentions, "api.templates.email_digest.mentions")
+	addItems(digest.Threads, "api.templates.email_digest.replies")
+	addItems(digest.Channels, "api.templates.email_digest.messages")
+
+	subject := T("api.templates.email_digest.subject."+digest.Frequency, map[string]any{
+		"SiteName": es.config().TeamSettings.SiteName,
+	})
+
+	data := es.NewEmailTemplateData(user.Locale)
+	data.Props["SiteURL"] = siteURL
+	data.Props["Title"] = T("api.templates.email_digest.title." + digest.Frequency)
+	data.Props["SubTitle"] = T("api.templates.email_digest.subtitle", map[string]any{
+		"Mentions": len(digest.Mentions),
+		"Threads":  len(digest.Threads),
+		"Channels": len(digest.Channels),
+	})
+	data.Props["Button"] = T("api.templates.email_digest.button")
+	data.Props["ButtonURL"] = siteURL
+	data.Props["Posts"] = postsData
+	data.Props["MessageButton"] = T("api.templates.email_digest.message_button")
+	data.Props["NotificationFooterTitle"] = T("app.notification.footer.title")
+	data.Props["NotificationFooterInfoLogin"] = T("app.notification.footer.infoLogin")
+	data.Props["NotificationFooterInfo"] = T("app.notification.footer.info")
+
+	body, err := es.RenderTemplate("messages_notification", data)
+	if err != nil {
+		return err
+	}
+
+	return es.SendMailWithEmbeddedFiles(user.Email, subject, body, embeddedFiles, "", "", "", "EmailDigest")
+}
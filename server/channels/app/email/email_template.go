@@ -0,0 +1,290 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package email
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/i18n"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/templates"
+)
+
+const (
+	emailTemplatesDirectory = "email_templates"
+
+	// The overrides saved by the other servers of a cluster are picked up once the
+	// templates of their locale expire.
+	emailTemplatesCacheTTL = time.Minute
+)
+
+var InvalidTemplateError = errors.New("the template is invalid")
+
+type localeTemplates struct {
+	container *templates.Container
+	expireAt  time.Time
+}
+
+// emailTemplatesCache holds the templates of each locale, with the overrides
+// saved for the locale in the file backend.
+type emailTemplatesCache struct {
+	mutex   sync.Mutex
+	locales map[string]*localeTemplates
+}
+
+func (c *emailTemplatesCache) get(locale string) *templates.Container {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if cached, ok := c.locales[locale]; ok && time.Now().Before(cached.expireAt) {
+		return cached.container
+	}
+	return nil
+}
+
+func (c *emailTemplatesCache) set(locale string, container *templates.Container) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.locales == nil {
+		c.locales = make(map[string]*localeTemplates)
+	}
+	c.locales[locale] = &localeTemplates{container: container, expireAt: time.Now().Add(emailTemplatesCacheTTL)}
+}
+
+func (c *emailTemplatesCache) invalidate(locale string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.locales, locale)
+}
+
+func emailTemplatePath(name, locale string) string {
+	return path.Join(emailTemplatesDirectory, locale, name+".html")
+}
+
+// RenderTemplate renders an email template with the overrides of the locale of
+// the data, or of the default server locale when the data has none.
+func (es *Service) RenderTemplate(name string, data templates.Data) (string, error) {
+	locale := data.Locale
+	if locale == "" {
+		locale = *es.config().LocalizationSettings.DefaultServerLocale
+	}
+
+	container, err := es.localeTemplates(locale)
+	if err != nil {
+		// The built-in templates still render the emails of the locale.
+		mlog.Warn("Failed to load the email templates of a locale", mlog.String("locale", locale), mlog.Err(err))
+		container = es.templatesContainer
+	}
+
+	return container.RenderToString(name, data)
+}
+
+func (es *Service) localeTemplates(locale string) (*templates.Container, error) {
+	if es.fileBackend == nil {
+		return es.templatesContainer, nil
+	}
+
+	if container := es.templatesCache.get(locale); container != nil {
+		return container, nil
+	}
+
+	overrides, err := es.emailTemplateOverrides(locale)
+	if err != nil {
+		return nil, err
+	}
+
+	container := es.templatesContainer
+	if len(overrides) > 0 {
+		if container, err = es.templatesContainer.WithOverrides(overrides); err != nil {
+			return nil, err
+		}
+	}
+
+	es.templatesCache.set(locale, container)
+	return container, nil
+}
+
+func (es *Service) emailTemplateOverrides(locale string) (map[string]string, error) {
+	paths, err := es.fileBackend().ListDirectory(path.Join(emailTemplatesDirectory, locale))
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := make(map[string]string, len(paths))
+	for _, filePath := range paths {
+		name := strings.TrimSuffix(path.Base(filePath), ".html")
+		if !model.IsValidEmailTemplateName(name) {
+			continue
+		}
+
+		content, err := es.fileBackend().ReadFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		overrides[name] = string(content)
+	}
+
+	return overrides, nil
+}
+
+// GetEmailTemplate returns the template of an email in a locale, either the one
+// saved for the locale or the built-in one.
+func (es *Service) GetEmailTemplate(name, locale string) (*model.EmailTemplate, error) {
+	emailTemplate := &model.EmailTemplate{Name: name, Locale: locale}
+
+	if es.fileBackend != nil {
+		filePath := emailTemplatePath(name, locale)
+		exists, err := es.fileBackend().FileExists(filePath)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			content, err := es.fileBackend().ReadFile(filePath)
+			if err != nil {
+				return nil, err
+			}
+			emailTemplate.Content = string(content)
+			emailTemplate.Overridden = true
+			return emailTemplate, nil
+		}
+	}
+
+	content, err := es.templatesContainer.Source(name)
+	if err != nil {
+		return nil, err
+	}
+	emailTemplate.Content = content
+
+	return emailTemplate, nil
+}
+
+// SaveEmailTemplate replaces the template of an email in a locale, once it was
+// checked to render with sample data.
+func (es *Service) SaveEmailTemplate(emailTemplate *model.EmailTemplate) error {
+	if es.fileBackend == nil {
+		return errors.New("no file backend to save the email templates to")
+	}
+
+	if _, err := es.renderEmailTemplatePreview(emailTemplate); err != nil {
+		return err
+	}
+
+	if _, err := es.fileBackend().WriteFile(strings.NewReader(emailTemplate.Content), emailTemplatePath(emailTemplate.Name, emailTemplate.Locale)); err != nil {
+		return err
+	}
+	es.templatesCache.invalidate(emailTemplate.Locale)
+
+	return nil
+}
+
+// DeleteEmailTemplate restores the built-in template of an email in a locale.
+func (es *Service) DeleteEmailTemplate(name, locale string) error {
+	if es.fileBackend == nil {
+		return nil
+	}
+
+	filePath := emailTemplatePath(name, locale)
+	exists, err := es.fileBackend().FileExists(filePath)
+	if err != nil || !exists {
+		return err
+	}
+
+	if err := es.fileBackend().RemoveFile(filePath); err != nil {
+		return err
+	}
+	es.templatesCache.invalidate(locale)
+
+	return nil
+}
+
+// SendEmailTemplatePreview renders a template with sample data and sends it to
+// the given address. An empty content previews the current template.
+func (es *Service) SendEmailTemplatePreview(emailTemplate *model.EmailTemplate, to string) error {
+	if emailTemplate.Content == "" {
+		current, err := es.GetEmailTemplate(emailTemplate.Name, emailTemplate.Locale)
+		if err != nil {
+			return err
+		}
+		emailTemplate = current
+	}
+
+	body, err := es.renderEmailTemplatePreview(emailTemplate)
+	if err != nil {
+		return err
+	}
+
+	T := i18n.GetUserTranslations(emailTemplate.Locale)
+	subject := T("api.templates.email_template_preview.subject", map[string]any{
+		"SiteName":     es.config().TeamSettings.SiteName,
+		"TemplateName": emailTemplate.Name,
+	})
+
+	return es.sendMail(to, subject, body, "EmailTemplatePreview")
+}
+
+// renderEmailTemplatePreview renders a template in place of the current one of
+// its locale, with data resembling the one of the emails using it.
+func (es *Service) renderEmailTemplatePreview(emailTemplate *model.EmailTemplate) (string, error) {
+	overrides, err := es.previewOverrides(emailTemplate.Locale)
+	if err != nil {
+		return "", err
+	}
+	overrides[emailTemplate.Name] = emailTemplate.Content
+
+	container, err := es.templatesContainer.WithOverrides(overrides)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", InvalidTemplateError, err.Error())
+	}
+
+	var body bytes.Buffer
+	if err := container.Render(&body, emailTemplate.Name, es.emailTemplateSampleData(emailTemplate.Locale)); err != nil {
+		return "", fmt.Errorf("%w: %s", InvalidTemplateError, err.Error())
+	}
+
+	return body.String(), nil
+}
+
+func (es *Service) previewOverrides(locale string) (map[string]string, error) {
+	if es.fileBackend == nil {
+		return map[string]string{}, nil
+	}
+	return es.emailTemplateOverrides(locale)
+}
+
+func (es *Service) emailTemplateSampleData(locale string) templates.Data {
+	T := i18n.GetUserTranslations(locale)
+	siteURL := *es.config().ServiceSettings.SiteURL
+
+	data := es.NewEmailTemplateData(locale)
+	data.Props["SiteURL"] = siteURL
+	data.Props["Title"] = T("api.templates.email_template_preview.title")
+	data.Props["SubTitle"] = T("api.templates.email_template_preview.subtitle")
+	data.Props["Info"] = T("api.templates.email_template_preview.info")
+	data.Props["Warning"] = T("api.templates.email_warning")
+	data.Props["Button"] = T("api.templates.email_template_preview.button", map[string]any{"SiteName": es.config().TeamSettings.SiteName})
+	data.Props["ButtonURL"] = siteURL
+	data.Props["MessageButton"] = data.Props["Button"]
+	data.Props["NotificationFooterTitle"] = T("app.notification.footer.title")
+	data.Props["NotificationFooterInfoLogin"] = T("app.notification.footer.infoLogin")
+	data.Props["NotificationFooterInfo"] = T("app.notification.footer.info")
+	data.Props["Posts"] = []*postData{{
+		SenderName:  "sample.user",
+		ChannelName: "Town Square",
+		Message:     template.HTML(template.HTMLEscapeString(T("api.templates.email_template_preview.info"))),
+		MessageURL:  siteURL,
+	}}
+
+	return data
+}
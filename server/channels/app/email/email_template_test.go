@@ -0,0 +1,87 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package email
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/filestore"
+)
+
+func TestEmailTemplates(t *testing.T) {
+	mainHelper.Parallel(t)
+
+	th := SetupWithStoreMock(t)
+	defer th.TearDown()
+
+	fileBackend, err := filestore.NewFileBackend(filestore.FileBackendSettings{
+		DriverName: model.ImageDriverLocal,
+		Directory:  filepath.Join(th.workspace, "data"),
+	})
+	require.NoError(t, err)
+	th.service.fileBackend = func() filestore.FileBackend { return fileBackend }
+
+	t.Run("built-in template", func(t *testing.T) {
+		emailTemplate, err := th.service.GetEmailTemplate("reset_body", "vi")
+		require.NoError(t, err)
+		assert.False(t, emailTemplate.Overridden)
+		assert.Contains(t, emailTemplate.Content, "{{.Props.Title}}")
+		assert.NotContains(t, emailTemplate.Content, `{{define "reset_body"}}`)
+	})
+
+	t.Run("override per locale", func(t *testing.T) {
+		require.NoError(t, th.service.SaveEmailTemplate(&model.EmailTemplate{
+			Name:    "email_footer",
+			Locale:  "vi",
+			Content: "<p>Công ty {{.Props.Organization}}</p>",
+		}))
+		defer th.service.DeleteEmailTemplate("email_footer", "vi")
+
+		emailTemplate, err := th.service.GetEmailTemplate("email_footer", "vi")
+		require.NoError(t, err)
+		assert.True(t, emailTemplate.Overridden)
+
+		data := th.service.NewEmailTemplateData("vi")
+		body, err := th.service.RenderTemplate("password_change_body", data)
+		require.NoError(t, err)
+		assert.Contains(t, body, "Công ty")
+
+		// The other locales keep the built-in footer.
+		data = th.service.NewEmailTemplateData("en")
+		body, err = th.service.RenderTemplate("password_change_body", data)
+		require.NoError(t, err)
+		assert.NotContains(t, body, "Công ty")
+
+		require.NoError(t, th.service.DeleteEmailTemplate("email_footer", "vi"))
+		data = th.service.NewEmailTemplateData("vi")
+		body, err = th.service.RenderTemplate("password_change_body", data)
+		require.NoError(t, err)
+		assert.NotContains(t, body, "Công ty")
+	})
+
+	t.Run("invalid templates aren't saved", func(t *testing.T) {
+		err := th.service.SaveEmailTemplate(&model.EmailTemplate{
+			Name:    "reset_body",
+			Locale:  "vi",
+			Content: "{{.Props.Title",
+		})
+		assert.ErrorIs(t, err, InvalidTemplateError)
+
+		err = th.service.SaveEmailTemplate(&model.EmailTemplate{
+			Name:    "reset_body",
+			Locale:  "vi",
+			Content: `{{template "unknown_template" .}}`,
+		})
+		assert.ErrorIs(t, err, InvalidTemplateError)
+
+		emailTemplate, err := th.service.GetEmailTemplate("reset_body", "vi")
+		require.NoError(t, err)
+		assert.False(t, emailTemplate.Overridden)
+	})
+}
@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	email "github.com/mattermost/mattermost/server/v8/channels/app/email"
+
 	io "io"
 
 	i18n "github.com/mattermost/mattermost/server/public/shared/i18n"
@@ -75,6 +77,24 @@ func (_m *ServiceInterface) CreateVerifyEmailToken(userID string, newEmail strin
 	return r0, r1
 }
 
+// DeleteEmailTemplate provides a mock function with given fields: name, locale
+func (_m *ServiceInterface) DeleteEmailTemplate(name string, locale string) error {
+	ret := _m.Called(name, locale)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEmailTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(name, locale)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GenerateHyperlinkForChannels provides a mock function with given fields: postMessage, teamName, teamURL
 func (_m *ServiceInterface) GenerateHyperlinkForChannels(postMessage string, teamName string, teamURL string) (string, error) {
 	ret := _m.Called(postMessage, teamName, teamURL)
@@ -103,6 +123,36 @@ func (_m *ServiceInterface) GenerateHyperlinkForChannels(postMessage string, tea
 	return r0, r1
 }
 
+// GetEmailTemplate provides a mock function with given fields: name, locale
+func (_m *ServiceInterface) GetEmailTemplate(name string, locale string) (*model.EmailTemplate, error) {
+	ret := _m.Called(name, locale)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEmailTemplate")
+	}
+
+	var r0 *model.EmailTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*model.EmailTemplate, error)); ok {
+		return rf(name, locale)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *model.EmailTemplate); ok {
+		r0 = rf(name, locale)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.EmailTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(name, locale)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMessageForNotification provides a mock function with given fields: post, teamName, siteUrl, translateFunc
 func (_m *ServiceInterface) GetMessageForNotification(post *model.Post, teamName string, siteUrl string, translateFunc i18n.TranslateFunc) string {
 	ret := _m.Called(post, teamName, siteUrl, translateFunc)
@@ -164,6 +214,52 @@ func (_m *ServiceInterface) NewEmailTemplateData(locale string) templates.Data {
 	return r0
 }
 
+// RenderTemplate provides a mock function with given fields: name, data
+func (_m *ServiceInterface) RenderTemplate(name string, data templates.Data) (string, error) {
+	ret := _m.Called(name, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenderTemplate")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, templates.Data) (string, error)); ok {
+		return rf(name, data)
+	}
+	if rf, ok := ret.Get(0).(func(string, templates.Data) string); ok {
+		r0 = rf(name, data)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, templates.Data) error); ok {
+		r1 = rf(name, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveEmailTemplate provides a mock function with given fields: emailTemplate
+func (_m *ServiceInterface) SaveEmailTemplate(emailTemplate *model.EmailTemplate) error {
+	ret := _m.Called(emailTemplate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveEmailTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.EmailTemplate) error); ok {
+		r0 = rf(emailTemplate)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendChangeUsernameEmail provides a mock function with given fields: newUsername, _a1, locale, siteURL
 func (_m *ServiceInterface) SendChangeUsernameEmail(newUsername string, _a1 string, locale string, siteURL string) error {
 	ret := _m.Called(newUsername, _a1, locale, siteURL)
@@ -218,6 +314,24 @@ func (_m *ServiceInterface) SendDeactivateAccountEmail(_a0 string, locale string
 	return r0
 }
 
+// SendEmailDigest provides a mock function with given fields: user, digest
+func (_m *ServiceInterface) SendEmailDigest(user *model.User, digest *email.EmailDigest) error {
+	ret := _m.Called(user, digest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendEmailDigest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.User, *email.EmailDigest) error); ok {
+		r0 = rf(user, digest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendEmailChangeEmail provides a mock function with given fields: oldEmail, newEmail, locale, siteURL
 func (_m *ServiceInterface) SendEmailChangeEmail(oldEmail string, newEmail string, locale string, siteURL string) error {
 	ret := _m.Called(oldEmail, newEmail, locale, siteURL)
@@ -254,6 +368,24 @@ func (_m *ServiceInterface) SendEmailChangeVerifyEmail(newUserEmail string, loca
 	return r0
 }
 
+// SendEmailTemplatePreview provides a mock function with given fields: emailTemplate, to
+func (_m *ServiceInterface) SendEmailTemplatePreview(emailTemplate *model.EmailTemplate, to string) error {
+	ret := _m.Called(emailTemplate, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendEmailTemplatePreview")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.EmailTemplate, string) error); ok {
+		r0 = rf(emailTemplate, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendGuestInviteEmails provides a mock function with given fields: team, channels, senderName, senderUserId, senderProfileImage, invites, siteURL, message, errorWhenNotSent, isSystemAdmin, isFirstAdmin
 func (_m *ServiceInterface) SendGuestInviteEmails(team *model.Team, channels []*model.Channel, senderName string, senderUserId string, senderProfileImage []byte, invites []string, siteURL string, message string, errorWhenNotSent bool, isSystemAdmin bool, isFirstAdmin bool) error {
 	ret := _m.Called(team, channels, senderName, senderUserId, senderProfileImage, invites, siteURL, message, errorWhenNotSent, isSystemAdmin, isFirstAdmin)
@@ -392,6 +524,24 @@ func (_m *ServiceInterface) SendMfaChangeEmail(_a0 string, activated bool, local
 	return r0
 }
 
+// SendNewSignInEmail provides a mock function with given fields: _a0, locale, siteURL, device
+func (_m *ServiceInterface) SendNewSignInEmail(_a0 string, locale string, siteURL string, device *model.UserDevice) error {
+	ret := _m.Called(_a0, locale, siteURL, device)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendNewSignInEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, *model.UserDevice) error); ok {
+		r0 = rf(_a0, locale, siteURL, device)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendNotificationMail provides a mock function with given fields: to, subject, htmlBody
 func (_m *ServiceInterface) SendNotificationMail(to string, subject string, htmlBody string) error {
 	ret := _m.Called(to, subject, htmlBody)
@@ -510,6 +660,24 @@ func (_m *ServiceInterface) SendUserAccessTokenAddedEmail(_a0 string, locale str
 	return r0
 }
 
+// SendUserAccessTokenExpiringEmail provides a mock function with given fields: _a0, locale, siteURL, description, expiresAt
+func (_m *ServiceInterface) SendUserAccessTokenExpiringEmail(_a0 string, locale string, siteURL string, description string, expiresAt int64) error {
+	ret := _m.Called(_a0, locale, siteURL, description, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendUserAccessTokenExpiringEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, int64) error); ok {
+		r0 = rf(_a0, locale, siteURL, description, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendVerifyEmail provides a mock function with given fields: userEmail, locale, siteURL, token, redirect
 func (_m *ServiceInterface) SendVerifyEmail(userEmail string, locale string, siteURL string, token string, redirect string) error {
 	ret := _m.Called(userEmail, locale, siteURL, token, redirect)
@@ -17,6 +17,7 @@ import (
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 	"github.com/mattermost/mattermost/server/v8/channels/app/users"
 	"github.com/mattermost/mattermost/server/v8/channels/store"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/filestore"
 	"github.com/mattermost/mattermost/server/v8/platform/shared/templates"
 )
 
@@ -47,8 +48,10 @@ type Service struct {
 
 	userService *users.UserService
 	store       store.Store
+	fileBackend func() filestore.FileBackend
 
 	templatesContainer      *templates.Container
+	templatesCache          emailTemplatesCache
 	perHourEmailRateLimiter *throttled.GCRARateLimiter
 	perDayEmailRateLimiter  *throttled.GCRARateLimiter
 	EmailBatching           *EmailBatchingJob
@@ -61,6 +64,10 @@ type ServiceConfig struct {
 	TemplatesContainer *templates.Container
 	UserService        *users.UserService
 	Store              store.Store
+
+	// FileBackendFn returns where the email templates customized by the admins
+	// are saved. Only the built-in templates are used without it.
+	FileBackendFn func() filestore.FileBackend
 }
 
 func NewService(config ServiceConfig) (*Service, error) {
@@ -73,6 +80,7 @@ func NewService(config ServiceConfig) (*Service, error) {
 		license:            config.LicenseFn,
 		store:              config.Store,
 		userService:        config.UserService,
+		fileBackend:        config.FileBackendFn,
 	}
 	if err := service.setUpRateLimiters(); err != nil {
 		return nil, err
@@ -137,12 +145,20 @@ type ServiceInterface interface {
 	SendCloudWelcomeEmail(userEmail, locale, teamInviteID, workSpaceName, dns, siteURL string) error
 	SendPasswordChangeEmail(email, method, locale, siteURL string) error
 	SendUserAccessTokenAddedEmail(email, locale, siteURL string) error
+	SendUserAccessTokenExpiringEmail(email, locale, siteURL, description string, expiresAt int64) error
+	SendNewSignInEmail(email, locale, siteURL string, device *model.UserDevice) error
 	SendPasswordResetEmail(email string, token *model.Token, locale, siteURL string) (bool, error)
 	SendMfaChangeEmail(email string, activated bool, locale, siteURL string) error
 	SendInviteEmails(team *model.Team, senderName string, senderUserId string, invites []string, siteURL string, reminderData *model.TeamInviteReminderData, errorWhenNotSent bool, isSystemAdmin bool, isFirstAdmin bool) error
 	SendGuestInviteEmails(team *model.Team, channels []*model.Channel, senderName string, senderUserId string, senderProfileImage []byte, invites []string, siteURL string, message string, errorWhenNotSent bool, isSystemAdmin bool, isFirstAdmin bool) error
 	SendInviteEmailsToTeamAndChannels(team *model.Team, channels []*model.Channel, senderName string, senderUserId string, senderProfileImage []byte, invites []string, siteURL string, reminderData *model.TeamInviteReminderData, message string, errorWhenNotSent bool, isSystemAdmin bool, isFirstAdmin bool) ([]*model.EmailInviteWithError, error)
 	SendDeactivateAccountEmail(email string, locale, siteURL string) error
+	RenderTemplate(name string, data templates.Data) (string, error)
+	GetEmailTemplate(name, locale string) (*model.EmailTemplate, error)
+	SaveEmailTemplate(emailTemplate *model.EmailTemplate) error
+	DeleteEmailTemplate(name, locale string) error
+	SendEmailTemplatePreview(emailTemplate *model.EmailTemplate, to string) error
+	SendEmailDigest(user *model.User, digest *EmailDigest) error
 	SendNotificationMail(to, subject, htmlBody string) error
 	SendMailWithEmbeddedFiles(to, subject, htmlBody string, embeddedFiles map[string]io.Reader, messageID string, inReplyTo string, references string, category string) error
 	SendLicenseUpForRenewalEmail(email, name, locale, siteURL, ctaTitle, ctaLink, ctaText string, daysToExpiration int) error
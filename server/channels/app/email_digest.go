@@ -0,0 +1,281 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/app/email"
+)
+
+const (
+	emailDigestMaxItems      = 5
+	emailDigestPreviewLength = 100
+)
+
+// SendDueEmailDigests sends the users opted in to email digests the digests that are
+// due. Each user is due at a minute of their chosen hour that depends on them, which
+// spreads the digests over the hour as the job runs.
+func (a *App) SendDueEmailDigests(rctx request.CTX) *model.AppError {
+	if !*a.Config().EmailSettings.SendEmailNotifications || !*a.Config().EmailSettings.EnableEmailDigests {
+		return nil
+	}
+
+	preferences, err := a.Srv().Store().Preference().GetCategoryAndName(model.PreferenceCategoryNotifications, model.PreferenceNameEmailDigest)
+	if err != nil {
+		return model.NewAppError("SendDueEmailDigests", "app.email_digest.get_preferences.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	now := time.Now()
+	for _, preference := range preferences {
+		if preference.Value != model.PreferenceEmailDigestDaily && preference.Value != model.PreferenceEmailDigestWeekly {
+			continue
+		}
+
+		if appErr := a.sendEmailDigestIfDue(rctx, preference.UserId, now); appErr != nil {
+			rctx.Logger().Warn("Failed to send an email digest", mlog.String("user_id", preference.UserId), mlog.Err(appErr))
+		}
+	}
+
+	return nil
+}
+
+func (a *App) sendEmailDigestIfDue(rctx request.CTX, userID string, now time.Time) *model.AppError {
+	preferences, appErr := a.GetPreferenceByCategoryForUser(rctx, userID, model.PreferenceCategoryNotifications)
+	if appErr != nil {
+		return appErr
+	}
+	schedule := model.NewEmailDigestSchedule(userID, preferences)
+	if schedule == nil {
+		return nil
+	}
+
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return appErr
+	}
+	if user.DeleteAt != 0 || user.IsBot || user.Email == "" {
+		return nil
+	}
+
+	var lastSentAt int64
+	for _, preference := range preferences {
+		if preference.Name == model.PreferenceNameEmailDigestLastSentAt {
+			lastSentAt, _ = strconv.ParseInt(preference.Value, 10, 64)
+		}
+	}
+
+	loc, err := time.LoadLocation(user.GetPreferredTimezone())
+	if err != nil {
+		loc = time.UTC
+	}
+
+	// The first digest of a user covers the activity since they opted in.
+	if lastSentAt != 0 && lastSentAt >= schedule.LastDueAt(now.In(loc)).UnixMilli() {
+		return nil
+	}
+
+	var digest *email.EmailDigest
+	if lastSentAt != 0 {
+		if digest, appErr = a.buildEmailDigest(rctx, user, schedule.Frequency, lastSentAt); appErr != nil {
+			return appErr
+		}
+	}
+
+	// The digest is marked as sent first, so that a failure to send it isn't retried
+	// on every run of the job.
+	if err := a.Srv().Store().Preference().Save(model.Preferences{{
+		UserId:   user.Id,
+		Category: model.PreferenceCategoryNotifications,
+		Name:     model.PreferenceNameEmailDigestLastSentAt,
+		Value:    strconv.FormatInt(now.UnixMilli(), 10),
+	}}); err != nil {
+		return model.NewAppError("sendEmailDigestIfDue", "app.preference.save.updating.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if digest == nil || digest.IsEmpty() {
+		return nil
+	}
+
+	if err := a.Srv().EmailService.SendEmailDigest(user, digest); err != nil {
+		return model.NewAppError("sendEmailDigestIfDue", "app.email_digest.send.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+type emailDigestChannel struct {
+	channel *model.Channel
+	count   int64
+}
+
+// buildEmailDigest summarizes the activity a user missed since the given time: their
+// unread mentions, the threads they follow with new replies, and the most active of
+// their channels.
+func (a *App) buildEmailDigest(rctx request.CTX, user *model.User, frequency string, since int64) (*email.EmailDigest, *model.AppError) {
+	digest := &email.EmailDigest{Frequency: frequency}
+
+	teams, appErr := a.GetTeamsForUser(user.Id)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if len(teams) == 0 {
+		return digest, nil
+	}
+	teamsByID := make(map[string]*model.Team, len(teams))
+	for _, team := range teams {
+		teamsByID[team.Id] = team
+	}
+
+	channels, err := a.Srv().Store().Channel().GetChannelsByUser(user.Id, false, 0, -1, "")
+	if err != nil {
+		return nil, model.NewAppError("buildEmailDigest", "app.channel.get_channels.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	channelIDs := make([]string, 0, len(channels))
+	channelsByID := make(map[string]*model.Channel, len(channels))
+	for _, channel := range channels {
+		channelIDs = append(channelIDs, channel.Id)
+		channelsByID[channel.Id] = channel
+	}
+
+	members, err := a.Srv().Store().Channel().GetMembersByChannelIds(channelIDs, user.Id)
+	if err != nil {
+		return nil, model.NewAppError("buildEmailDigest", "app.channel.get_members.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	var mentions, active []emailDigestChannel
+	for _, member := range members {
+		channel, ok := channelsByID[member.ChannelId]
+		if !ok {
+			continue
+		}
+
+		if member.MentionCount > 0 {
+			mentions = append(mentions, emailDigestChannel{channel, member.MentionCount})
+			continue
+		}
+		if unread := channel.TotalMsgCount - member.MsgCount; unread > 0 && channel.LastPostAt > since {
+			active = append(active, emailDigestChannel{channel, unread})
+		}
+	}
+
+	for _, list := range [][]emailDigestChannel{mentions, active} {
+		sort.SliceStable(list, func(i, j int) bool {
+			return list[i].count > list[j].count
+		})
+	}
+
+	for _, item := range mentions[:min(len(mentions), emailDigestMaxItems)] {
+		digest.Mentions = append(digest.Mentions, a.emailDigestChannelItem(rctx, user, item, teamsByID, teams[0]))
+	}
+	for _, item := range active[:min(len(active), emailDigestMaxItems)] {
+		digest.Channels = append(digest.Channels, a.emailDigestChannelItem(rctx, user, item, teamsByID, teams[0]))
+	}
+
+	threads := make(map[string]*model.ThreadResponse)
+	for _, team := range teams {
+		teamThreads, err := a.Srv().Store().Thread().GetThreadsForUser(user.Id, team.Id, model.GetUserThreadsOpts{
+			PageSize:    emailDigestMaxItems,
+			Since:       uint64(since),
+			Unread:      true,
+			ThreadsOnly: true,
+		})
+		if err != nil {
+			return nil, model.NewAppError("buildEmailDigest", "app.user.get_threads_for_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		// The threads of direct and group messages are listed for every team.
+		for _, thread := range teamThreads {
+			if thread.Post != nil && channelsByID[thread.Post.ChannelId] != nil {
+				threads[thread.PostId] = thread
+			}
+		}
+	}
+
+	sortedThreads := make([]*model.ThreadResponse, 0, len(threads))
+	for _, thread := range threads {
+		sortedThreads = append(sortedThreads, thread)
+	}
+	sort.Slice(sortedThreads, func(i, j int) bool {
+		return sortedThreads[i].LastReplyAt > sortedThreads[j].LastReplyAt
+	})
+
+	for _, thread := range sortedThreads[:min(len(sortedThreads), emailDigestMaxItems)] {
+		digest.Threads = append(digest.Threads, a.emailDigestThreadItem(rctx, user, thread, channelsByID[thread.Post.ChannelId], teamsByID, teams[0]))
+	}
+
+	return digest, nil
+}
+
+// emailDigestTeamName returns the name of the team in the links to a channel. The direct
+// and group messages are linked within the default team of the user.
+func emailDigestTeamName(channel *model.Channel, teamsByID map[string]*model.Team, defaultTeam *model.Team) (string, string) {
+	if team, ok := teamsByID[channel.TeamId]; ok {
+		return team.Name, team.DisplayName
+	}
+	return defaultTeam.Name, ""
+}
+
+func (a *App) emailDigestChannelItem(rctx request.CTX, user *model.User, item emailDigestChannel, teamsByID map[string]*model.Team, defaultTeam *model.Team) *email.EmailDigestItem {
+	teamName, teamDisplayName := emailDigestTeamName(item.channel, teamsByID, defaultTeam)
+
+	digestItem := &email.EmailDigestItem{
+		Title:    item.channel.DisplayName,
+		Subtitle: teamDisplayName,
+		Count:    item.count,
+		URL:      a.GetSiteURL() + "/" + teamName + "/channels/" + item.channel.Name,
+	}
+
+	if item.channel.Type == model.ChannelTypeDirect {
+		if otherUser, appErr := a.GetUser(item.channel.GetOtherUserIdForDM(user.Id)); appErr == nil {
+			digestItem.Title = otherUser.GetDisplayName(*a.Config().TeamSettings.TeammateNameDisplay)
+			digestItem.Photo = a.emailDigestUserPhoto(rctx, otherUser)
+			return digestItem
+		}
+	}
+
+	// The channels are pictured with their initial, like the users without a picture.
+	photo, err := a.Srv().userService.GetDefaultProfileImage(&model.User{Id: item.channel.Id, Username: item.channel.Name})
+	if err != nil {
+		rctx.Logger().Warn("Failed to create the picture of a channel for an email digest", mlog.String("channel_id", item.channel.Id), mlog.Err(err))
+	}
+	digestItem.Photo = photo
+
+	return digestItem
+}
+
+func (a *App) emailDigestThreadItem(rctx request.CTX, user *model.User, thread *model.ThreadResponse, channel *model.Channel, teamsByID map[string]*model.Team, defaultTeam *model.Team) *email.EmailDigestItem {
+	teamName, _ := emailDigestTeamName(channel, teamsByID, defaultTeam)
+
+	digestItem := &email.EmailDigestItem{
+		Subtitle: channel.DisplayName,
+		Count:    thread.UnreadReplies,
+		URL:      a.GetSiteURL() + "/" + teamName + "/pl/" + thread.PostId,
+	}
+
+	// The messages are left out of the emails sending generic notifications.
+	if *a.Config().EmailSettings.EmailNotificationContentsType == model.EmailNotificationContentsFull {
+		digestItem.Preview = truncateUserNames(thread.Post.Message, emailDigestPreviewLength)
+	}
+
+	if author, appErr := a.GetUser(thread.Post.UserId); appErr == nil {
+		digestItem.Title = author.GetDisplayName(*a.Config().TeamSettings.TeammateNameDisplay)
+		digestItem.Photo = a.emailDigestUserPhoto(rctx, author)
+	}
+
+	return digestItem
+}
+
+func (a *App) emailDigestUserPhoto(rctx request.CTX, user *model.User) []byte {
+	photo, _, appErr := a.GetProfileImage(user)
+	if appErr != nil {
+		rctx.Logger().Warn("Failed to get the profile image of a user for an email digest", mlog.String("user_id", user.Id), mlog.Err(appErr))
+	}
+	return photo
+}
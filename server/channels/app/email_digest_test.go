@@ -0,0 +1,155 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/app/email"
+	emailmocks "github.com/mattermost/mattermost/server/v8/channels/app/email/mocks"
+)
+
+func TestSendDueEmailDigests(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	optIn := func(t *testing.T, lastSentAt int64) {
+		t.Helper()
+		preferences := model.Preferences{{
+			UserId:   th.BasicUser.Id,
+			Category: model.PreferenceCategoryNotifications,
+			Name:     model.PreferenceNameEmailDigest,
+			Value:    model.PreferenceEmailDigestDaily,
+		}}
+		if lastSentAt != 0 {
+			preferences = append(preferences, model.Preference{
+				UserId:   th.BasicUser.Id,
+				Category: model.PreferenceCategoryNotifications,
+				Name:     model.PreferenceNameEmailDigestLastSentAt,
+				Value:    strconv.FormatInt(lastSentAt, 10),
+			})
+		}
+		require.NoError(t, th.App.Srv().Store().Preference().Save(preferences))
+	}
+
+	mockEmailService := func(t *testing.T) *emailmocks.ServiceInterface {
+		t.Helper()
+		emailServiceMock := &emailmocks.ServiceInterface{}
+		emailServiceMock.On("Stop").Maybe().Return()
+		originalEmailService := th.App.Srv().EmailService
+		th.App.Srv().EmailService = emailServiceMock
+		t.Cleanup(func() {
+			th.App.Srv().EmailService = originalEmailService
+		})
+		return emailServiceMock
+	}
+
+	t.Run("the first run only starts the digests", func(t *testing.T) {
+		optIn(t, 0)
+		emailServiceMock := mockEmailService(t)
+
+		require.Nil(t, th.App.SendDueEmailDigests(th.Context))
+		emailServiceMock.AssertNotCalled(t, "SendEmailDigest", mock.Anything, mock.Anything)
+
+		preference, err := th.App.Srv().Store().Preference().Get(th.BasicUser.Id, model.PreferenceCategoryNotifications, model.PreferenceNameEmailDigestLastSentAt)
+		require.NoError(t, err)
+		assert.NotEmpty(t, preference.Value)
+	})
+
+	t.Run("a due digest summarizes the mentions", func(t *testing.T) {
+		optIn(t, model.GetMillis()-2*24*time.Hour.Milliseconds())
+
+		_, appErr := th.App.CreatePost(th.Context, &model.Post{
+			UserId:    th.BasicUser2.Id,
+			ChannelId: th.BasicChannel.Id,
+			Message:   "hello @" + th.BasicUser.Username,
+		}, th.BasicChannel, model.CreatePostFlags{})
+		require.Nil(t, appErr)
+
+		emailServiceMock := mockEmailService(t)
+		emailServiceMock.On("SendEmailDigest", mock.MatchedBy(func(user *model.User) bool {
+			return user.Id == th.BasicUser.Id
+		}), mock.MatchedBy(func(digest *email.EmailDigest) bool {
+			return digest.Frequency == model.PreferenceEmailDigestDaily &&
+				len(digest.Mentions) == 1 &&
+				digest.Mentions[0].Title == th.BasicChannel.DisplayName &&
+				digest.Mentions[0].Count == 1
+		})).Once().Return(nil)
+
+		require.Nil(t, th.App.SendDueEmailDigests(th.Context))
+
+		// The digest is sent once per day.
+		require.Nil(t, th.App.SendDueEmailDigests(th.Context))
+		emailServiceMock.AssertExpectations(t)
+	})
+
+	t.Run("nothing is sent without activity", func(t *testing.T) {
+		channels, err := th.App.Srv().Store().Channel().GetChannelsByUser(th.BasicUser.Id, false, 0, -1, "")
+		require.NoError(t, err)
+		channelIDs := make([]string, 0, len(channels))
+		for _, channel := range channels {
+			channelIDs = append(channelIDs, channel.Id)
+		}
+		_, appErr := th.App.MarkChannelsAsViewed(th.Context, channelIDs, th.BasicUser.Id, "", false, false)
+		require.Nil(t, appErr)
+		optIn(t, model.GetMillis()-2*24*time.Hour.Milliseconds())
+		emailServiceMock := mockEmailService(t)
+
+		require.Nil(t, th.App.SendDueEmailDigests(th.Context))
+		emailServiceMock.AssertNotCalled(t, "SendEmailDigest", mock.Anything, mock.Anything)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.EmailSettings.EnableEmailDigests = false
+		})
+		defer th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.EmailSettings.EnableEmailDigests = true
+		})
+		optIn(t, model.GetMillis()-2*24*time.Hour.Milliseconds())
+		emailServiceMock := mockEmailService(t)
+
+		require.Nil(t, th.App.SendDueEmailDigests(th.Context))
+		emailServiceMock.AssertNotCalled(t, "SendEmailDigest", mock.Anything, mock.Anything)
+	})
+}
+
+func TestEmailDigestThreadItem(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	thread := &model.ThreadResponse{
+		PostId:        th.BasicPost.Id,
+		Post:          th.BasicPost,
+		UnreadReplies: 2,
+	}
+
+	t.Run("full contents preview the thread", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.EmailSettings.EmailNotificationContentsType = model.EmailNotificationContentsFull
+		})
+
+		item := th.App.emailDigestThreadItem(th.Context, th.BasicUser, thread, th.BasicChannel, nil, th.BasicTeam)
+		assert.Equal(t, th.BasicPost.Message, item.Preview)
+	})
+
+	t.Run("generic contents leave the message out", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.EmailSettings.EmailNotificationContentsType = model.EmailNotificationContentsGeneric
+		})
+
+		item := th.App.emailDigestThreadItem(th.Context, th.BasicUser, thread, th.BasicChannel, nil, th.BasicTeam)
+		assert.Empty(t, item.Preview)
+		assert.Equal(t, int64(2), item.Count)
+	})
+}
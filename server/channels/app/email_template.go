@@ -0,0 +1,115 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/i18n"
+	"github.com/mattermost/mattermost/server/v8/channels/app/email"
+)
+
+func checkEmailTemplate(where, name, locale string) *model.AppError {
+	if !model.IsValidEmailTemplateName(name) {
+		return model.NewAppError(where, "model.email_template.is_valid.name.app_error", nil, "name="+name, http.StatusBadRequest)
+	}
+
+	if _, ok := i18n.GetSupportedLocales()[locale]; !ok {
+		return model.NewAppError(where, "model.email_template.is_valid.locale.app_error", nil, "locale="+locale, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// GetEmailTemplates lists the templates of the emails in a locale, leaving out
+// their content.
+func (a *App) GetEmailTemplates(locale string) ([]*model.EmailTemplate, *model.AppError) {
+	emailTemplates := make([]*model.EmailTemplate, 0, len(model.EmailTemplateNames))
+	for _, name := range model.EmailTemplateNames {
+		emailTemplate, appErr := a.GetEmailTemplate(name, locale)
+		if appErr != nil {
+			return nil, appErr
+		}
+		emailTemplate.Content = ""
+		emailTemplates = append(emailTemplates, emailTemplate)
+	}
+
+	return emailTemplates, nil
+}
+
+func (a *App) GetEmailTemplate(name, locale string) (*model.EmailTemplate, *model.AppError) {
+	if appErr := checkEmailTemplate("GetEmailTemplate", name, locale); appErr != nil {
+		return nil, appErr
+	}
+
+	emailTemplate, err := a.Srv().EmailService.GetEmailTemplate(name, locale)
+	if err != nil {
+		return nil, model.NewAppError("GetEmailTemplate", "app.email_template.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return emailTemplate, nil
+}
+
+// SaveEmailTemplate replaces the template of an email in a locale. The template
+// is rejected when it doesn't render with sample data.
+func (a *App) SaveEmailTemplate(emailTemplate *model.EmailTemplate) (*model.EmailTemplate, *model.AppError) {
+	if appErr := emailTemplate.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+	if appErr := checkEmailTemplate("SaveEmailTemplate", emailTemplate.Name, emailTemplate.Locale); appErr != nil {
+		return nil, appErr
+	}
+
+	if err := a.Srv().EmailService.SaveEmailTemplate(emailTemplate); err != nil {
+		if errors.Is(err, email.InvalidTemplateError) {
+			return nil, model.NewAppError("SaveEmailTemplate", "app.email_template.invalid.app_error", map[string]any{"Error": err.Error()}, "", http.StatusBadRequest).Wrap(err)
+		}
+		return nil, model.NewAppError("SaveEmailTemplate", "app.email_template.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return a.GetEmailTemplate(emailTemplate.Name, emailTemplate.Locale)
+}
+
+// DeleteEmailTemplate restores the built-in template of an email in a locale,
+// and returns it.
+func (a *App) DeleteEmailTemplate(name, locale string) (*model.EmailTemplate, *model.AppError) {
+	if appErr := checkEmailTemplate("DeleteEmailTemplate", name, locale); appErr != nil {
+		return nil, appErr
+	}
+
+	if err := a.Srv().EmailService.DeleteEmailTemplate(name, locale); err != nil {
+		return nil, model.NewAppError("DeleteEmailTemplate", "app.email_template.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return a.GetEmailTemplate(name, locale)
+}
+
+// SendEmailTemplatePreview sends an email rendered from a template with sample
+// data through the configured SMTP server. Without content, the current template
+// of the locale is sent.
+func (a *App) SendEmailTemplatePreview(emailTemplate *model.EmailTemplate, to string) *model.AppError {
+	if appErr := checkEmailTemplate("SendEmailTemplatePreview", emailTemplate.Name, emailTemplate.Locale); appErr != nil {
+		return appErr
+	}
+	if emailTemplate.Content != "" {
+		if appErr := emailTemplate.IsValid(); appErr != nil {
+			return appErr
+		}
+	}
+
+	if *a.Config().EmailSettings.SMTPServer == "" {
+		return model.NewAppError("SendEmailTemplatePreview", "api.admin.test_email.missing_server", nil, i18n.T("api.context.invalid_param.app_error", map[string]any{"Name": "SMTPServer"}), http.StatusBadRequest)
+	}
+
+	if err := a.Srv().EmailService.SendEmailTemplatePreview(emailTemplate, to); err != nil {
+		if errors.Is(err, email.InvalidTemplateError) {
+			return model.NewAppError("SendEmailTemplatePreview", "app.email_template.invalid.app_error", map[string]any{"Error": err.Error()}, "", http.StatusBadRequest).Wrap(err)
+		}
+		return model.NewAppError("SendEmailTemplatePreview", "app.email_template.preview.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
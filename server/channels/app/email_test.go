@@ -20,7 +20,7 @@ func TestSendInviteEmailRateLimits(t *testing.T) {
 	defer th.TearDown()
 
 	th.BasicTeam.AllowedDomains = "common.com"
-	_, err := th.App.UpdateTeam(th.BasicTeam)
+	_, err := th.App.UpdateTeam(th.Context, th.BasicTeam)
 	require.Nilf(t, err, "%v, Should update the team", err)
 
 	th.App.UpdateConfig(func(cfg *model.Config) {
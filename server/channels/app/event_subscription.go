@@ -0,0 +1,231 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func (a *App) CreateEventSubscription(rctx request.CTX, subscription *model.EventSubscription) (*model.EventSubscription, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableOutgoingWebhooks {
+		return nil, model.NewAppError("CreateEventSubscription", "api.outgoing_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if appErr := a.checkEventSubscriptionFilters(rctx, subscription); appErr != nil {
+		return nil, appErr
+	}
+
+	subscription, err := a.Srv().Store().Webhook().SaveEventSubscription(subscription)
+	if err != nil {
+		var appErr *model.AppError
+		var invErr *store.ErrInvalidInput
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &invErr):
+			return nil, model.NewAppError("CreateEventSubscription", "app.event_subscription.save.existing.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+		default:
+			return nil, model.NewAppError("CreateEventSubscription", "app.event_subscription.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return subscription, nil
+}
+
+func (a *App) GetEventSubscription(id string) (*model.EventSubscription, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableOutgoingWebhooks {
+		return nil, model.NewAppError("GetEventSubscription", "api.outgoing_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	subscription, err := a.Srv().Store().Webhook().GetEventSubscription(id)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetEventSubscription", "app.event_subscription.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetEventSubscription", "app.event_subscription.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return subscription, nil
+}
+
+func (a *App) GetEventSubscriptions(page, perPage int) ([]*model.EventSubscription, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableOutgoingWebhooks {
+		return nil, model.NewAppError("GetEventSubscriptions", "api.outgoing_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	subscriptions, err := a.Srv().Store().Webhook().GetEventSubscriptions(page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetEventSubscriptions", "app.event_subscription.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return subscriptions, nil
+}
+
+func (a *App) PatchEventSubscription(rctx request.CTX, subscription *model.EventSubscription, patch *model.EventSubscriptionPatch) (*model.EventSubscription, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableOutgoingWebhooks {
+		return nil, model.NewAppError("PatchEventSubscription", "api.outgoing_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	patched := *subscription
+	patched.Patch(patch)
+
+	if appErr := a.checkEventSubscriptionFilters(rctx, &patched); appErr != nil {
+		return nil, appErr
+	}
+
+	return a.updateEventSubscription(&patched)
+}
+
+func (a *App) RegenEventSubscriptionSecret(subscription *model.EventSubscription) (*model.EventSubscription, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableOutgoingWebhooks {
+		return nil, model.NewAppError("RegenEventSubscriptionSecret", "api.outgoing_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	updated := *subscription
+	updated.Secret = model.NewRandomString(model.OutgoingWebhookSecretLength)
+
+	return a.updateEventSubscription(&updated)
+}
+
+func (a *App) updateEventSubscription(subscription *model.EventSubscription) (*model.EventSubscription, *model.AppError) {
+	subscription, err := a.Srv().Store().Webhook().UpdateEventSubscription(subscription)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("updateEventSubscription", "app.event_subscription.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return subscription, nil
+}
+
+func (a *App) DeleteEventSubscription(id string) *model.AppError {
+	if !*a.Config().ServiceSettings.EnableOutgoingWebhooks {
+		return model.NewAppError("DeleteEventSubscription", "api.outgoing_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if err := a.Srv().Store().Webhook().DeleteEventSubscription(id, model.GetMillis()); err != nil {
+		return model.NewAppError("DeleteEventSubscription", "app.event_subscription.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+// checkEventSubscriptionFilters makes sure the team and the channel the
+// subscription is filtered by exist, and that the channel belongs to the team.
+func (a *App) checkEventSubscriptionFilters(rctx request.CTX, subscription *model.EventSubscription) *model.AppError {
+	if subscription.TeamId != "" {
+		if _, appErr := a.GetTeam(subscription.TeamId); appErr != nil {
+			return appErr
+		}
+	}
+
+	if subscription.ChannelId != "" {
+		channel, appErr := a.GetChannel(rctx, subscription.ChannelId)
+		if appErr != nil {
+			return appErr
+		}
+
+		if subscription.TeamId != "" && channel.TeamId != subscription.TeamId {
+			return model.NewAppError("checkEventSubscriptionFilters", "app.event_subscription.channel_not_in_team.app_error", nil, "", http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
+
+// sendEventToSubscriptions sends the event to the URLs of the subscriptions
+// to its type, team and channel. It's meant to be called next to the plugin
+// hook fired for the same event.
+func (a *App) sendEventToSubscriptions(rctx request.CTX, event *model.EventSubscriptionPayload) {
+	if !*a.Config().ServiceSettings.EnableOutgoingWebhooks {
+		return
+	}
+
+	subscriptions, err := a.Srv().Store().Webhook().GetEventSubscriptionsForEvent(event.Event, true)
+	if err != nil {
+		rctx.Logger().Error("Failed to get the event subscriptions", mlog.String("event", event.Event), mlog.Err(err))
+		return
+	}
+
+	for _, subscription := range subscriptions {
+		if !subscription.Matches(event.Event, event.TeamId, event.ChannelId) {
+			continue
+		}
+
+		payload := *event
+		payload.Id = model.NewId()
+		payload.SubscriptionId = subscription.Id
+		payload.Timestamp = model.GetMillis()
+
+		a.Srv().Go(func() {
+			a.deliverEventToSubscription(rctx, subscription, &payload)
+		})
+	}
+}
+
+func (a *App) deliverEventToSubscription(rctx request.CTX, subscription *model.EventSubscription, payload *model.EventSubscriptionPayload) {
+	logger := rctx.Logger().With(
+		mlog.String("event_subscription_id", subscription.Id),
+		mlog.String("event", payload.Event),
+		mlog.String("delivery_id", payload.Id),
+	)
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		logger.Error("Failed to encode the event", mlog.Err(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(*a.Config().ServiceSettings.OutgoingIntegrationRequestsTimeout)*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		logger.Error("Failed to create the event request", mlog.Err(err))
+		return
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(model.EventSubscriptionEventHeader, payload.Event)
+	req.Header.Set(model.OutgoingWebhookDeliveryHeader, payload.Id)
+	req.Header.Set(model.OutgoingWebhookSignatureHeader, model.SignOutgoingWebhookPayload(subscription.Secret, time.Now().Unix(), body))
+
+	resp, err := a.Srv().outgoingWebhookClient.Do(req)
+	if err != nil {
+		logger.Warn("Failed to send the event to the subscription", mlog.Err(err))
+		return
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, MaxIntegrationResponseSize))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		logger.Warn("The event subscription URL returned an error status", mlog.Int("status_code", resp.StatusCode))
+	}
+}
+
+// eventSubscriptionUser returns a copy of the user without its credentials,
+// fit to be sent to event subscriptions.
+func eventSubscriptionUser(user *model.User) *model.User {
+	sanitized := user.DeepCopy()
+	sanitized.Sanitize(map[string]bool{"email": true, "fullname": true, "authservice": true})
+	return sanitized
+}
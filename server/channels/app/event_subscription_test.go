@@ -0,0 +1,131 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestSendEventToSubscriptions(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableOutgoingWebhooks = true
+		*cfg.ServiceSettings.AllowedUntrustedInternalConnections = "localhost,127.0.0.1"
+	})
+
+	type request struct {
+		header http.Header
+		body   []byte
+	}
+	received := make(chan request, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		received <- request{header: r.Header, body: body}
+	}))
+	defer server.Close()
+
+	subscription, appErr := th.App.CreateEventSubscription(th.Context, &model.EventSubscription{
+		CreatorId:  th.SystemAdminUser.Id,
+		URL:        server.URL,
+		EventTypes: model.StringArray{model.EventTypeUserHasJoinedChannel},
+		ChannelId:  th.BasicChannel.Id,
+	})
+	require.Nil(t, appErr)
+
+	t.Run("matching event", func(t *testing.T) {
+		require.Nil(t, th.App.JoinChannel(th.Context, th.BasicChannel, th.BasicUser2.Id))
+
+		var r request
+		select {
+		case r = <-received:
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "Timeout, event not received")
+		}
+
+		assert.Equal(t, model.EventTypeUserHasJoinedChannel, r.header.Get(model.EventSubscriptionEventHeader))
+
+		var timestamp int64
+		_, err := fmt.Sscanf(r.header.Get(model.OutgoingWebhookSignatureHeader), "t=%d,", &timestamp)
+		require.NoError(t, err)
+		assert.Equal(t, model.SignOutgoingWebhookPayload(subscription.Secret, timestamp, r.body), r.header.Get(model.OutgoingWebhookSignatureHeader))
+
+		var payload model.EventSubscriptionPayload
+		require.NoError(t, json.Unmarshal(r.body, &payload))
+		assert.Equal(t, r.header.Get(model.OutgoingWebhookDeliveryHeader), payload.Id)
+		assert.Equal(t, subscription.Id, payload.SubscriptionId)
+		assert.Equal(t, model.EventTypeUserHasJoinedChannel, payload.Event)
+		assert.Equal(t, th.BasicTeam.Id, payload.TeamId)
+		assert.Equal(t, th.BasicChannel.Id, payload.ChannelId)
+		assert.Equal(t, th.BasicUser2.Id, payload.UserId)
+	})
+
+	t.Run("filtered out events", func(t *testing.T) {
+		th.App.sendEventToSubscriptions(th.Context, &model.EventSubscriptionPayload{
+			Event:     model.EventTypeUserHasJoinedChannel,
+			TeamId:    th.BasicTeam.Id,
+			ChannelId: model.NewId(),
+		})
+		th.App.sendEventToSubscriptions(th.Context, &model.EventSubscriptionPayload{
+			Event:     model.EventTypeUserHasLeftChannel,
+			TeamId:    th.BasicTeam.Id,
+			ChannelId: th.BasicChannel.Id,
+		})
+
+		select {
+		case <-received:
+			require.Fail(t, "Filtered out event received")
+		case <-time.After(500 * time.Millisecond):
+		}
+	})
+
+	t.Run("deleted subscription", func(t *testing.T) {
+		require.Nil(t, th.App.DeleteEventSubscription(subscription.Id))
+
+		th.App.sendEventToSubscriptions(th.Context, &model.EventSubscriptionPayload{
+			Event:     model.EventTypeUserHasJoinedChannel,
+			TeamId:    th.BasicTeam.Id,
+			ChannelId: th.BasicChannel.Id,
+		})
+
+		select {
+		case <-received:
+			require.Fail(t, "Event of a deleted subscription received")
+		case <-time.After(500 * time.Millisecond):
+		}
+	})
+}
+
+func TestEventSubscriptionUser(t *testing.T) {
+	user := &model.User{
+		Id:          model.NewId(),
+		Email:       "user@example.com",
+		Password:    "hash",
+		MfaSecret:   "secret",
+		AuthData:    model.NewPointer("authdata"),
+		AuthService: model.UserAuthServiceGitlab,
+	}
+
+	sanitized := eventSubscriptionUser(user)
+	assert.Empty(t, sanitized.Password)
+	assert.Empty(t, sanitized.MfaSecret)
+	assert.Equal(t, "", *sanitized.AuthData)
+	assert.Equal(t, user.Email, sanitized.Email)
+	assert.Equal(t, model.UserAuthServiceGitlab, sanitized.AuthService)
+	assert.Equal(t, "hash", user.Password, "the user must not be modified")
+}
@@ -99,3 +99,58 @@ func (a *App) getSessionExpiredPushMessage(session *model.Session) string {
 
 	return T("api.push_notifications.session.expired", props)
 }
+
+// NotifyUserAccessTokensExpiring is called periodically from the job server to warn the owners
+// of the personal access tokens expiring soon, by email. The owners of the tokens of bots are
+// the owners of the bots.
+func (a *App) NotifyUserAccessTokensExpiring() error {
+	const batchSize = 100
+
+	for {
+		tokens, err := a.Srv().Store().UserAccessToken().GetExpiringForNotification(model.GetMillis()+model.UserAccessTokenExpiryNotifyMillis, batchSize)
+		if err != nil {
+			return model.NewAppError("NotifyUserAccessTokensExpiring", "app.user_access_token.get_expiring.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		for _, token := range tokens {
+			a.notifyUserAccessTokenExpiring(token)
+
+			if err := a.Srv().Store().UserAccessToken().MarkExpiryNotified(token.Id); err != nil {
+				return model.NewAppError("NotifyUserAccessTokensExpiring", "app.user_access_token.mark_expiry_notified.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+		}
+
+		if len(tokens) < batchSize {
+			return nil
+		}
+	}
+}
+
+func (a *App) notifyUserAccessTokenExpiring(token *model.UserAccessToken) {
+	rctx := request.EmptyContext(a.Log().With(
+		mlog.String("user_id", token.UserId),
+		mlog.String("token_id", token.Id),
+	))
+
+	user, appErr := a.GetUser(token.UserId)
+	if appErr != nil {
+		rctx.Logger().Warn("Failed to get the user of an expiring user access token", mlog.Err(appErr))
+		return
+	}
+
+	if user.IsBot {
+		bot, appErr := a.GetBot(rctx, user.Id, true)
+		if appErr != nil {
+			rctx.Logger().Warn("Failed to get the bot of an expiring user access token", mlog.Err(appErr))
+			return
+		}
+		// Bots owned by plugins have no one to notify.
+		if user, appErr = a.GetUser(bot.OwnerId); appErr != nil {
+			return
+		}
+	}
+
+	if err := a.Srv().EmailService.SendUserAccessTokenExpiringEmail(user.Email, user.Locale, a.GetSiteURL(), token.Description, token.ExpiresAt); err != nil {
+		rctx.Logger().Error("Unable to send user access token expiring email", mlog.Err(err))
+	}
+}
@@ -8,9 +8,11 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost/server/public/model"
+	emailmocks "github.com/mattermost/mattermost/server/v8/channels/app/email/mocks"
 )
 
 func TestNotifySessionsExpired(t *testing.T) {
@@ -79,3 +81,42 @@ func TestNotifySessionsExpired(t *testing.T) {
 		require.Contains(t, handler.notifications()[1].Message, "Session Expired")
 	})
 }
+
+func TestNotifyUserAccessTokensExpiring(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableUserAccessTokens = true
+	})
+
+	expiresAt := model.GetMillis() + 60*60*1000
+	token, appErr := th.App.CreateUserAccessToken(th.Context, &model.UserAccessToken{
+		UserId:      th.BasicUser.Id,
+		Description: "expiring",
+		ExpiresAt:   expiresAt,
+	})
+	require.Nil(t, appErr)
+	_, appErr = th.App.CreateUserAccessToken(th.Context, &model.UserAccessToken{
+		UserId:      th.BasicUser.Id,
+		Description: "lasting",
+		ExpiresAt:   model.GetMillis() + 30*24*60*60*1000,
+	})
+	require.Nil(t, appErr)
+
+	emailServiceMock := emailmocks.ServiceInterface{}
+	emailServiceMock.On("SendUserAccessTokenExpiringEmail", th.BasicUser.Email, th.BasicUser.Locale, mock.Anything, token.Description, expiresAt).Once().Return(nil)
+	emailServiceMock.On("Stop").Maybe().Return()
+	originalEmailService := th.App.Srv().EmailService
+	th.App.Srv().EmailService = &emailServiceMock
+	defer func() {
+		th.App.Srv().EmailService = originalEmailService
+	}()
+
+	require.NoError(t, th.App.NotifyUserAccessTokensExpiring())
+
+	// The owner is notified only once.
+	require.NoError(t, th.App.NotifyUserAccessTokensExpiring())
+	emailServiceMock.AssertExpectations(t)
+}
@@ -135,8 +135,19 @@ func (a *App) BulkExport(rctx request.CTX, writer io.Writer, outPath string, job
 		job.Data = make(model.StringMap)
 	}
 
+	// The watermark is taken before reading anything, so that the entities changed while
+	// exporting are exported again by the next incremental export.
+	watermark := model.GetMillis()
+	setJobData(rctx.Logger(), a.Srv().Store(), job, "export_watermark", strconv.FormatInt(watermark, 10))
+
+	var inc *incrementalExport
+	if opts.IncrementalSince > 0 {
+		rctx.Logger().Info("Bulk export: exporting incrementally", mlog.Int("since", opts.IncrementalSince))
+		inc = newIncrementalExport(opts.IncrementalSince, watermark)
+	}
+
 	rctx.Logger().Info("Bulk export: exporting version")
-	if err := a.exportVersion(writer); err != nil {
+	if err := a.exportVersion(writer, opts.IncrementalSince); err != nil {
 		return err
 	}
 
@@ -147,52 +158,80 @@ func (a *App) BulkExport(rctx request.CTX, writer io.Writer, outPath string, job
 	}
 
 	rctx.Logger().Info("Bulk export: exporting teams")
-	teamNames, appErr := a.exportAllTeams(rctx, job, writer)
+	teamNames, appErr := a.exportAllTeams(rctx, job, writer, inc)
 	if appErr != nil {
 		return appErr
 	}
 
 	rctx.Logger().Info("Bulk export: exporting channels")
-	if appErr = a.exportAllChannels(rctx, job, writer, teamNames, opts.IncludeArchivedChannels); appErr != nil {
+	if appErr = a.exportAllChannels(rctx, job, writer, teamNames, opts.IncludeArchivedChannels, inc); appErr != nil {
 		return appErr
 	}
 
 	rctx.Logger().Info("Bulk export: exporting users")
-	profilePictures, appErr := a.exportAllUsers(rctx, job, writer, opts.IncludeArchivedChannels, opts.IncludeProfilePictures)
+	profilePictures, appErr := a.exportAllUsers(rctx, job, writer, opts.IncludeArchivedChannels, opts.IncludeProfilePictures, inc)
 	if appErr != nil {
 		return appErr
 	}
 
 	rctx.Logger().Info("Bulk export: exporting bots")
-	botPPs, appErr := a.exportAllBots(rctx, job, writer, opts.IncludeProfilePictures)
+	botPPs, appErr := a.exportAllBots(rctx, job, writer, opts.IncludeProfilePictures, inc)
 	if appErr != nil {
 		return appErr
 	}
 	profilePictures = append(profilePictures, botPPs...)
 
+	if inc != nil {
+		rctx.Logger().Info("Bulk export: collecting changed posts")
+		if appErr = a.collectChangedPosts(rctx, inc); appErr != nil {
+			return appErr
+		}
+	}
+
 	rctx.Logger().Info("Bulk export: exporting posts")
-	attachments, appErr := a.exportAllPosts(rctx, job, writer, opts.IncludeAttachments, opts.IncludeArchivedChannels)
+	var attachments []imports.AttachmentImportData
+	if inc != nil {
+		attachments, appErr = a.exportChangedPosts(rctx, job, writer, inc, opts.IncludeAttachments, opts.IncludeArchivedChannels)
+	} else {
+		attachments, appErr = a.exportAllPosts(rctx, job, writer, opts.IncludeAttachments, opts.IncludeArchivedChannels)
+	}
 	if appErr != nil {
 		return appErr
 	}
 
 	rctx.Logger().Info("Bulk export: exporting emoji")
-	emojiPaths, appErr := a.exportCustomEmoji(rctx, job, writer, outPath, "exported_emoji", !opts.CreateArchive)
+	emojiPaths, appErr := a.exportCustomEmoji(rctx, job, writer, outPath, "exported_emoji", !opts.CreateArchive, inc)
 	if appErr != nil {
 		return appErr
 	}
 
 	rctx.Logger().Info("Bulk export: exporting direct channels")
-	if appErr = a.exportAllDirectChannels(rctx, job, writer, opts.IncludeArchivedChannels); appErr != nil {
+	if appErr = a.exportAllDirectChannels(rctx, job, writer, opts.IncludeArchivedChannels, inc); appErr != nil {
 		return appErr
 	}
 
 	rctx.Logger().Info("Bulk export: exporting direct posts")
-	directAttachments, appErr := a.exportAllDirectPosts(rctx, job, writer, opts.IncludeAttachments, opts.IncludeArchivedChannels)
+	var directAttachments []imports.AttachmentImportData
+	if inc != nil {
+		directAttachments, appErr = a.exportChangedDirectPosts(rctx, job, writer, inc, opts.IncludeAttachments, opts.IncludeArchivedChannels)
+	} else {
+		directAttachments, appErr = a.exportAllDirectPosts(rctx, job, writer, opts.IncludeAttachments, opts.IncludeArchivedChannels)
+	}
 	if appErr != nil {
 		return appErr
 	}
 
+	// The tombstones come last, once everything they could refer to is imported.
+	if inc != nil {
+		rctx.Logger().Info("Bulk export: exporting tombstones")
+		for _, line := range inc.tombstones {
+			if appErr = a.exportWriteLine(writer, line); appErr != nil {
+				return appErr
+			}
+		}
+		updateJobProgress(rctx.Logger(), a.Srv().Store(), job, "tombstones_exported", len(inc.tombstones))
+	}
+
 	if opts.IncludeAttachments {
 		rctx.Logger().Info("Bulk export: exporting file attachments")
 		warnings, appErr := a.exportAttachments(rctx, attachments, outPath, zipWr)
@@ -281,7 +320,7 @@ func (a *App) exportWriteLine(w io.Writer, line *imports.LineImportData) *model.
 	return nil
 }
 
-func (a *App) exportVersion(writer io.Writer) *model.AppError {
+func (a *App) exportVersion(writer io.Writer, incrementalSince int64) *model.AppError {
 	version := 1
 
 	info := &imports.VersionInfoImportData{
@@ -290,6 +329,14 @@ func (a *App) exportVersion(writer io.Writer) *model.AppError {
 		Created:   time.Now().Format(time.RFC3339Nano),
 	}
 
+	if incrementalSince > 0 {
+		additional, err := json.Marshal(map[string]int64{"incremental_since": incrementalSince})
+		if err != nil {
+			return model.NewAppError("BulkExport", "app.export.export_write_line.json_marshall.error", nil, "", http.StatusBadRequest).Wrap(err)
+		}
+		info.Additional = additional
+	}
+
 	versionLine := &imports.LineImportData{
 		Type:    "version",
 		Version: &version,
@@ -399,7 +446,7 @@ func (a *App) exportSchemes(rctx request.CTX, job *model.Job, writer io.Writer,
 	}
 }
 
-func (a *App) exportAllTeams(rctx request.CTX, job *model.Job, writer io.Writer) (map[string]bool, *model.AppError) {
+func (a *App) exportAllTeams(rctx request.CTX, job *model.Job, writer io.Writer, inc *incrementalExport) (map[string]bool, *model.AppError) {
 	afterId := strings.Repeat("0", 26)
 	teamNames := make(map[string]bool)
 	cnt := 0
@@ -420,10 +467,22 @@ func (a *App) exportAllTeams(rctx request.CTX, job *model.Job, writer io.Writer)
 
 			// Skip deleted.
 			if team.DeleteAt != 0 {
+				if inc.deletedSince(team.DeleteAt) {
+					inc.addTombstone(&imports.DeleteImportData{
+						Entity:   model.NewPointer(imports.DeleteEntityTeam),
+						Team:     model.NewPointer(team.Name),
+						DeleteAt: model.NewPointer(team.DeleteAt),
+					})
+				}
 				continue
 			}
 			teamNames[team.Name] = true
 
+			// Skip unchanged.
+			if !inc.includes(team.UpdateAt) {
+				continue
+			}
+
 			teamLine := importLineFromTeam(team)
 			if err := a.exportWriteLine(writer, teamLine); err != nil {
 				return nil, err
@@ -434,7 +493,7 @@ func (a *App) exportAllTeams(rctx request.CTX, job *model.Job, writer io.Writer)
 	return teamNames, nil
 }
 
-func (a *App) exportAllChannels(rctx request.CTX, job *model.Job, writer io.Writer, teamNames map[string]bool, withArchived bool) *model.AppError {
+func (a *App) exportAllChannels(rctx request.CTX, job *model.Job, writer io.Writer, teamNames map[string]bool, withArchived bool, inc *incrementalExport) *model.AppError {
 	afterId := strings.Repeat("0", 26)
 	cnt := 0
 	for {
@@ -452,12 +511,24 @@ func (a *App) exportAllChannels(rctx request.CTX, job *model.Job, writer io.Writ
 		for _, channel := range channels {
 			afterId = channel.Id
 
+			// Skip channels on deleted teams.
+			if ok := teamNames[channel.TeamName]; !ok {
+				continue
+			}
 			// Skip deleted.
 			if channel.DeleteAt != 0 && !withArchived {
+				if inc.deletedSince(channel.DeleteAt) {
+					inc.addTombstone(&imports.DeleteImportData{
+						Entity:   model.NewPointer(imports.DeleteEntityChannel),
+						Team:     model.NewPointer(channel.TeamName),
+						Channel:  model.NewPointer(channel.Name),
+						DeleteAt: model.NewPointer(channel.DeleteAt),
+					})
+				}
 				continue
 			}
-			// Skip channels on deleted teams.
-			if ok := teamNames[channel.TeamName]; !ok {
+			// Skip unchanged.
+			if !inc.includes(channel.UpdateAt) {
 				continue
 			}
 
@@ -471,10 +542,21 @@ func (a *App) exportAllChannels(rctx request.CTX, job *model.Job, writer io.Writ
 	return nil
 }
 
-func (a *App) exportAllUsers(rctx request.CTX, job *model.Job, writer io.Writer, includeArchivedChannels, includeProfilePictures bool) ([]string, *model.AppError) {
+func (a *App) exportAllUsers(rctx request.CTX, job *model.Job, writer io.Writer, includeArchivedChannels, includeProfilePictures bool, inc *incrementalExport) ([]string, *model.AppError) {
 	afterId := strings.Repeat("0", 26)
 	cnt := 0
 	profilePictures := []string{}
+
+	// Users are exported with their memberships, so the ones whose memberships changed are
+	// exported too.
+	var membershipChanges map[string]any
+	if inc != nil {
+		userIDs, err := a.Srv().Store().ChannelMemberHistory().GetUsersWithMembershipChangesSince(inc.since)
+		if err != nil {
+			return profilePictures, model.NewAppError("exportAllUsers", "app.channel_member_history.get_users_with_membership_changes.internal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		membershipChanges = model.SliceToMapKey(userIDs...)
+	}
 	for {
 		users, err := a.Srv().Store().User().GetAllAfter(1000, afterId)
 		if err != nil {
@@ -495,6 +577,17 @@ func (a *App) exportAllUsers(rctx request.CTX, job *model.Job, writer io.Writer,
 				continue
 			}
 
+			// Skip unchanged.
+			if _, ok := membershipChanges[user.Id]; !ok && !inc.includes(user.UpdateAt) {
+				continue
+			}
+
+			if inc != nil {
+				if err := a.buildMembershipTombstones(inc, user); err != nil {
+					return profilePictures, err
+				}
+			}
+
 			// Gathering here the exportable preferences to pass them on to importLineFromUser
 			exportedPrefs := make(map[string]*string)
 			allPrefs, err := a.GetPreferencesForUser(rctx, user.Id)
@@ -571,7 +664,7 @@ func (a *App) exportAllUsers(rctx request.CTX, job *model.Job, writer io.Writer,
 	return profilePictures, nil
 }
 
-func (a *App) exportAllBots(rctx request.CTX, job *model.Job, writer io.Writer, includeProfilePictures bool) ([]string, *model.AppError) {
+func (a *App) exportAllBots(rctx request.CTX, job *model.Job, writer io.Writer, includeProfilePictures bool, inc *incrementalExport) ([]string, *model.AppError) {
 	afterId := ""
 	cnt := 0
 	profilePictures := []string{}
@@ -590,6 +683,11 @@ func (a *App) exportAllBots(rctx request.CTX, job *model.Job, writer io.Writer,
 		for _, bot := range bots {
 			afterId = bot.UserId
 
+			// Skip unchanged.
+			if !inc.includes(bot.UpdateAt) {
+				continue
+			}
+
 			var ownerUsername string
 			owner, err := a.Srv().Store().User().Get(rctx.Context(), bot.OwnerId)
 			if err != nil {
@@ -737,52 +835,71 @@ func (a *App) exportAllPosts(rctx request.CTX, job *model.Job, writer io.Writer,
 				continue
 			}
 
-			postLine := importLineForPost(post)
-
-			replies, replyAttachments, err := a.buildPostReplies(rctx, post.Id, withAttachments)
+			postLine, postAttachments, err := a.buildPostLine(rctx, post, withAttachments)
 			if err != nil {
 				return nil, err
 			}
+			attachments = append(attachments, postAttachments...)
 
-			followers, err := a.buildThreadFollowers(rctx, post.Id)
-			if err != nil {
+			if err := a.exportWriteLine(writer, postLine); err != nil {
 				return nil, err
 			}
+		}
+	}
+}
 
-			if len(followers) > 0 {
-				postLine.Post.ThreadFollowers = &followers
-			}
+// buildPostLine returns the import line of a root post with its thread, and the attachments of
+// the thread when withAttachments is set.
+func (a *App) buildPostLine(rctx request.CTX, post *model.PostForExport, withAttachments bool) (*imports.LineImportData, []imports.AttachmentImportData, *model.AppError) {
+	var attachments []imports.AttachmentImportData
 
-			if withAttachments && len(replyAttachments) > 0 {
-				attachments = append(attachments, replyAttachments...)
-			}
+	postLine := importLineForPost(post)
 
-			postLine.Post.Replies = &replies
-			postLine.Post.Reactions = &[]imports.ReactionImportData{}
-			if post.HasReactions {
-				postLine.Post.Reactions, err = a.BuildPostReactions(rctx, post.Id)
-				if err != nil {
-					return nil, err
-				}
-			}
+	replies, replyAttachments, err := a.buildPostReplies(rctx, post.Id, withAttachments)
+	if err != nil {
+		return nil, nil, err
+	}
 
-			if len(post.FileIds) > 0 {
-				postAttachments, err := a.buildPostAttachments(post.Id)
-				if err != nil {
-					return nil, err
-				}
-				postLine.Post.Attachments = &postAttachments
+	followers, err := a.buildThreadFollowers(rctx, post.Id)
+	if err != nil {
+		return nil, nil, err
+	}
 
-				if withAttachments && len(postAttachments) > 0 {
-					attachments = append(attachments, postAttachments...)
-				}
-			}
+	if len(followers) > 0 {
+		postLine.Post.ThreadFollowers = &followers
+	}
 
-			if err := a.exportWriteLine(writer, postLine); err != nil {
-				return nil, err
-			}
+	if withAttachments && len(replyAttachments) > 0 {
+		attachments = append(attachments, replyAttachments...)
+	}
+
+	postLine.Post.Replies = &replies
+	postLine.Post.Reactions = &[]imports.ReactionImportData{}
+	if post.HasReactions {
+		postLine.Post.Reactions, err = a.BuildPostReactions(rctx, post.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(post.FileIds) > 0 {
+		postAttachments, err := a.buildPostAttachments(post.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		postLine.Post.Attachments = &postAttachments
+
+		if withAttachments && len(postAttachments) > 0 {
+			attachments = append(attachments, postAttachments...)
 		}
 	}
+
+	postLine.Post.Poll, err = a.buildPostPoll(rctx, &post.Post)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return postLine, attachments, nil
 }
 
 func (a *App) buildPostReplies(rctx request.CTX, postID string, withAttachments bool) ([]imports.ReplyImportData, []imports.AttachmentImportData, *model.AppError) {
@@ -859,6 +976,71 @@ func (a *App) BuildPostReactions(rctx request.CTX, postID string) (*[]ReactionIm
 	return &reactionsOfPost, nil
 }
 
+// buildPostPoll returns the poll of a post and the usernames of its voters,
+// or nil if the post isn't a poll.
+func (a *App) buildPostPoll(rctx request.CTX, post *model.Post) (*imports.PollImportData, *model.AppError) {
+	pollID, _ := post.GetProp(model.PostPropsPollId).(string)
+	if post.Type != model.PostTypePoll || pollID == "" {
+		return nil, nil
+	}
+
+	poll, err := a.Srv().Store().Poll().Get(pollID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) { // the poll of the post might've been deleted by now
+			rctx.Logger().Info("Skipping poll of post since the entity doesn't exist anymore", mlog.String("post_id", post.Id), mlog.String("poll_id", pollID))
+			return nil, nil
+		}
+		return nil, model.NewAppError("buildPostPoll", "app.poll.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	votes, err := a.Srv().Store().Poll().GetVotes(poll.Id)
+	if err != nil {
+		return nil, model.NewAppError("buildPostPoll", "app.poll.get_votes.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	usernames := map[string]string{}
+	voters := map[string][]string{}
+	for _, vote := range votes {
+		username, ok := usernames[vote.UserId]
+		if !ok {
+			user, err := a.Srv().Store().User().Get(context.Background(), vote.UserId)
+			if err != nil {
+				var nfErr *store.ErrNotFound
+				if errors.As(err, &nfErr) { // the user that voted might've been deleted by now
+					rctx.Logger().Info("Skipping poll votes by user since the entity doesn't exist anymore", mlog.String("user_id", vote.UserId))
+					usernames[vote.UserId] = ""
+					continue
+				}
+				return nil, model.NewAppError("buildPostPoll", "app.user.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+			username = user.Username
+			usernames[vote.UserId] = username
+		}
+		if username != "" {
+			voters[vote.OptionId] = append(voters[vote.OptionId], username)
+		}
+	}
+
+	options := make([]imports.PollOptionImportData, 0, len(poll.Options))
+	for _, option := range poll.Options {
+		optionData := imports.PollOptionImportData{Text: model.NewPointer(option.Text)}
+		if optionVoters := voters[option.Id]; len(optionVoters) > 0 {
+			optionData.Voters = &optionVoters
+		}
+		options = append(options, optionData)
+	}
+
+	return &imports.PollImportData{
+		Question:       model.NewPointer(poll.Question),
+		Options:        &options,
+		Anonymous:      model.NewPointer(poll.Anonymous),
+		MultipleChoice: model.NewPointer(poll.MultipleChoice),
+		CloseAt:        model.NewPointer(poll.CloseAt),
+		ClosedAt:       model.NewPointer(poll.ClosedAt),
+	}, nil
+}
+
 func (a *App) buildPostAttachments(postID string) ([]imports.AttachmentImportData, *model.AppError) {
 	infos, nErr := a.Srv().Store().FileInfo().GetForPost(postID, false, false, false)
 	if nErr != nil {
@@ -873,7 +1055,7 @@ func (a *App) buildPostAttachments(postID string) ([]imports.AttachmentImportDat
 	return attachments, nil
 }
 
-func (a *App) exportCustomEmoji(rctx request.CTX, job *model.Job, writer io.Writer, outPath, exportDir string, exportFiles bool) ([]string, *model.AppError) {
+func (a *App) exportCustomEmoji(rctx request.CTX, job *model.Job, writer io.Writer, outPath, exportDir string, exportFiles bool, inc *incrementalExport) ([]string, *model.AppError) {
 	var emojiPaths []string
 	pageNumber := 0
 	cnt := 0
@@ -902,6 +1084,11 @@ func (a *App) exportCustomEmoji(rctx request.CTX, job *model.Job, writer io.Writ
 			}
 
 			for _, emoji := range customEmojiList {
+				// Skip unchanged. Deleted emojis aren't listed, so they get no tombstone.
+				if !inc.includes(emoji.UpdateAt) {
+					continue
+				}
+
 				emojiImagePath := filepath.Join(emojiPath, emoji.Id, "image")
 				filePath := filepath.Join(exportDir, emoji.Id, "image")
 				if exportFiles {
@@ -965,7 +1152,7 @@ func (a *App) copyEmojiImages(rctx request.CTX, emojiId string, emojiImagePath s
 	return nil
 }
 
-func (a *App) exportAllDirectChannels(rctx request.CTX, job *model.Job, writer io.Writer, includeArchivedChannels bool) *model.AppError {
+func (a *App) exportAllDirectChannels(rctx request.CTX, job *model.Job, writer io.Writer, includeArchivedChannels bool, inc *incrementalExport) *model.AppError {
 	afterId := strings.Repeat("0", 26)
 	cnt := 0
 	for {
@@ -993,6 +1180,11 @@ func (a *App) exportAllDirectChannels(rctx request.CTX, job *model.Job, writer i
 				continue
 			}
 
+			// Skip unchanged.
+			if !inc.includes(channel.UpdateAt) {
+				continue
+			}
+
 			// Skip if the channel member structure is not intact
 			switch channel.Type {
 			case model.ChannelTypeGroup:
@@ -1147,44 +1339,11 @@ func (a *App) exportAllDirectPosts(rctx request.CTX, job *model.Job, writer io.W
 				continue
 			}
 
-			// Handle attachments.
-			var postAttachments []imports.AttachmentImportData
-			var err *model.AppError
-			if len(post.FileIds) > 0 {
-				postAttachments, err = a.buildPostAttachments(post.Id)
-				if err != nil {
-					return nil, err
-				}
-
-				if withAttachments && len(postAttachments) > 0 {
-					attachments = append(attachments, postAttachments...)
-				}
-			}
-
-			// Do the Replies.
-			replies, replyAttachments, err := a.buildPostReplies(rctx, post.Id, withAttachments)
-			if err != nil {
-				return nil, err
-			}
-
-			if withAttachments && len(replyAttachments) > 0 {
-				attachments = append(attachments, replyAttachments...)
-			}
-
-			postLine := importLineForDirectPost(post)
-			postLine.DirectPost.Replies = &replies
-			if len(postAttachments) > 0 {
-				postLine.DirectPost.Attachments = &postAttachments
-			}
-
-			followers, err := a.buildThreadFollowers(rctx, post.Id)
+			postLine, postAttachments, err := a.buildDirectPostLine(rctx, post, withAttachments)
 			if err != nil {
 				return nil, err
 			}
-
-			if len(followers) > 0 {
-				postLine.DirectPost.ThreadFollowers = &followers
-			}
+			attachments = append(attachments, postAttachments...)
 
 			if err := a.exportWriteLine(writer, postLine); err != nil {
 				return nil, err
@@ -1194,6 +1353,58 @@ func (a *App) exportAllDirectPosts(rctx request.CTX, job *model.Job, writer io.W
 	return attachments, nil
 }
 
+// buildDirectPostLine returns the import line of a root post of a direct or group channel with
+// its thread, and the attachments of the thread when withAttachments is set.
+func (a *App) buildDirectPostLine(rctx request.CTX, post *model.DirectPostForExport, withAttachments bool) (*imports.LineImportData, []imports.AttachmentImportData, *model.AppError) {
+	var attachments []imports.AttachmentImportData
+
+	// Handle attachments.
+	var postAttachments []imports.AttachmentImportData
+	var err *model.AppError
+	if len(post.FileIds) > 0 {
+		postAttachments, err = a.buildPostAttachments(post.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if withAttachments && len(postAttachments) > 0 {
+			attachments = append(attachments, postAttachments...)
+		}
+	}
+
+	// Do the Replies.
+	replies, replyAttachments, err := a.buildPostReplies(rctx, post.Id, withAttachments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if withAttachments && len(replyAttachments) > 0 {
+		attachments = append(attachments, replyAttachments...)
+	}
+
+	postLine := importLineForDirectPost(post)
+	postLine.DirectPost.Replies = &replies
+	if len(postAttachments) > 0 {
+		postLine.DirectPost.Attachments = &postAttachments
+	}
+
+	followers, err := a.buildThreadFollowers(rctx, post.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(followers) > 0 {
+		postLine.DirectPost.ThreadFollowers = &followers
+	}
+
+	postLine.DirectPost.Poll, err = a.buildPostPoll(rctx, &post.Post)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return postLine, attachments, nil
+}
+
 func (a *App) exportFile(rctx request.CTX, outPath, filePath string, zipWr *zip.Writer) *model.AppError {
 	rd, appErr := a.FileReader(filePath)
 	if appErr != nil {
@@ -1308,8 +1519,12 @@ func (a *App) DeleteExport(name string) *model.AppError {
 }
 
 func updateJobProgress(logger mlog.LoggerIFace, store store.Store, job *model.Job, key string, value int) {
+	setJobData(logger, store, job, key, strconv.Itoa(value))
+}
+
+func setJobData(logger mlog.LoggerIFace, store store.Store, job *model.Job, key, value string) {
 	if job != nil {
-		job.Data[key] = strconv.Itoa(value)
+		job.Data[key] = value
 		if _, err2 := store.Job().UpdateOptimistically(job, model.JobStatusInProgress); err2 != nil {
 			logger.Warn("Failed to update job status", mlog.Err(err2))
 		}
@@ -0,0 +1,373 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/app/imports"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+const incrementalExportBatchSize = 1000
+
+// incrementalExport holds the state of an incremental bulk export, which only exports the
+// entities created, updated or deleted since a previous export. The deleted ones are written
+// as tombstone lines at the end of the export.
+//
+// Full exports use a nil incrementalExport, which includes every entity.
+type incrementalExport struct {
+	since      int64
+	watermark  int64
+	tombstones []*imports.LineImportData
+
+	// The threads with a post created, updated or deleted since the previous export, in the
+	// channels of teams and in direct and group channels.
+	rootIds       []string
+	directRootIds []string
+
+	channels  map[string]*model.Channel
+	teamNames map[string]string
+	usernames map[string]string
+}
+
+func newIncrementalExport(since, watermark int64) *incrementalExport {
+	return &incrementalExport{
+		since:     since,
+		watermark: watermark,
+		channels:  map[string]*model.Channel{},
+		teamNames: map[string]string{},
+		usernames: map[string]string{},
+	}
+}
+
+// includes returns whether an entity last updated at updateAt has to be exported.
+func (e *incrementalExport) includes(updateAt int64) bool {
+	return e == nil || updateAt >= e.since
+}
+
+// deletedSince returns whether an entity deleted at deleteAt needs a tombstone.
+func (e *incrementalExport) deletedSince(deleteAt int64) bool {
+	return e != nil && deleteAt != 0 && deleteAt >= e.since
+}
+
+func (e *incrementalExport) addTombstone(data *imports.DeleteImportData) {
+	e.tombstones = append(e.tombstones, &imports.LineImportData{
+		Type:   "delete",
+		Delete: data,
+	})
+}
+
+// getChannelForExport returns a channel by id, or nil if it doesn't exist anymore.
+func (a *App) getChannelForExport(e *incrementalExport, channelID string) (*model.Channel, *model.AppError) {
+	if channel, ok := e.channels[channelID]; ok {
+		return channel, nil
+	}
+
+	channel, err := a.Srv().Store().Channel().Get(channelID, true)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			return nil, model.NewAppError("getChannelForExport", "app.channel.get.find.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		channel = nil
+	}
+
+	e.channels[channelID] = channel
+	return channel, nil
+}
+
+// getTeamNameForExport returns the name of a team by id, or an empty string if it doesn't exist
+// anymore.
+func (a *App) getTeamNameForExport(e *incrementalExport, teamID string) (string, *model.AppError) {
+	if name, ok := e.teamNames[teamID]; ok {
+		return name, nil
+	}
+
+	var name string
+	team, err := a.Srv().Store().Team().Get(teamID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			return "", model.NewAppError("getTeamNameForExport", "app.team.get.find.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	} else {
+		name = team.Name
+	}
+
+	e.teamNames[teamID] = name
+	return name, nil
+}
+
+// getUsernameForExport returns the username of a user by id, or an empty string if the user was
+// permanently deleted.
+func (a *App) getUsernameForExport(e *incrementalExport, userID string) (string, *model.AppError) {
+	if username, ok := e.usernames[userID]; ok {
+		return username, nil
+	}
+
+	var username string
+	user, err := a.Srv().Store().User().Get(context.Background(), userID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			return "", model.NewAppError("getUsernameForExport", "app.user.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	} else {
+		username = user.Username
+	}
+
+	e.usernames[userID] = username
+	return username, nil
+}
+
+// buildMembershipTombstones adds the tombstones of the teams and channels a user left since the
+// previous export.
+func (a *App) buildMembershipTombstones(e *incrementalExport, user *model.User) *model.AppError {
+	teamMembers, err := a.Srv().Store().Team().GetTeamMembersForExport(user.Id)
+	if err != nil {
+		return model.NewAppError("buildMembershipTombstones", "app.team.get_members.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	for _, member := range teamMembers {
+		if e.deletedSince(member.DeleteAt) {
+			e.addTombstone(&imports.DeleteImportData{
+				Entity:   model.NewPointer(imports.DeleteEntityTeamMember),
+				Team:     model.NewPointer(member.TeamName),
+				User:     model.NewPointer(user.Username),
+				DeleteAt: model.NewPointer(member.DeleteAt),
+			})
+		}
+	}
+
+	channelIDs, err := a.Srv().Store().ChannelMemberHistory().GetChannelsLeftSince(user.Id, e.since)
+	if err != nil {
+		return model.NewAppError("buildMembershipTombstones", "app.channel_member_history.get_channels_left_since.internal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	for _, channelID := range channelIDs {
+		channel, appErr := a.getChannelForExport(e, channelID)
+		if appErr != nil {
+			return appErr
+		}
+		// Members of direct and group channels don't change.
+		if channel == nil || channel.IsGroupOrDirect() {
+			continue
+		}
+
+		// The history can miss the user rejoining the channel, so it's checked against the
+		// current memberships.
+		_, err := a.Srv().Store().Channel().GetMember(context.Background(), channelID, user.Id)
+		if err == nil {
+			continue
+		}
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			return model.NewAppError("buildMembershipTombstones", "app.channel.get_member.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		teamName, appErr := a.getTeamNameForExport(e, channel.TeamId)
+		if appErr != nil {
+			return appErr
+		}
+		if teamName == "" {
+			continue
+		}
+
+		e.addTombstone(&imports.DeleteImportData{
+			Entity:  model.NewPointer(imports.DeleteEntityChannelMember),
+			Team:    model.NewPointer(teamName),
+			Channel: model.NewPointer(channel.Name),
+			User:    model.NewPointer(user.Username),
+			// The history doesn't tell when the user left, only that it was since the
+			// previous export.
+			DeleteAt: model.NewPointer(e.watermark),
+		})
+	}
+
+	return nil
+}
+
+// collectChangedPosts goes through the posts created, updated or deleted since the previous
+// export, gathering the threads to export again and the tombstones of the deleted posts.
+func (a *App) collectChangedPosts(rctx request.CTX, e *incrementalExport) *model.AppError {
+	seen := map[string]bool{}
+	cursor := model.GetPostsSinceForSyncCursor{LastPostUpdateAt: e.since - 1}
+	for {
+		var posts []*model.Post
+		var err error
+		posts, cursor, err = a.Srv().Store().Post().GetPostsSinceForSync(model.GetPostsSinceForSyncOptions{IncludeDeleted: true}, cursor, incrementalExportBatchSize)
+		if err != nil {
+			return model.NewAppError("collectChangedPosts", "app.post.get_posts_since.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		for _, post := range posts {
+			channel, appErr := a.getChannelForExport(e, post.ChannelId)
+			if appErr != nil {
+				return appErr
+			}
+			if channel == nil {
+				continue
+			}
+
+			if post.DeleteAt != 0 {
+				if e.deletedSince(post.DeleteAt) {
+					if appErr := a.addPostTombstone(rctx, e, post, channel); appErr != nil {
+						return appErr
+					}
+				}
+				continue
+			}
+
+			rootID := post.RootId
+			if rootID == "" {
+				rootID = post.Id
+			}
+			if seen[rootID] {
+				continue
+			}
+			seen[rootID] = true
+
+			if channel.IsGroupOrDirect() {
+				e.directRootIds = append(e.directRootIds, rootID)
+			} else {
+				e.rootIds = append(e.rootIds, rootID)
+			}
+		}
+
+		if len(posts) < incrementalExportBatchSize {
+			return nil
+		}
+	}
+}
+
+func (a *App) addPostTombstone(rctx request.CTX, e *incrementalExport, post *model.Post, channel *model.Channel) *model.AppError {
+	username, appErr := a.getUsernameForExport(e, post.UserId)
+	if appErr != nil || username == "" {
+		return appErr
+	}
+
+	data := &imports.DeleteImportData{
+		User:     model.NewPointer(username),
+		CreateAt: model.NewPointer(post.CreateAt),
+		DeleteAt: model.NewPointer(post.DeleteAt),
+	}
+
+	if channel.IsGroupOrDirect() {
+		memberIDs, err := a.Srv().Store().Channel().GetAllChannelMemberIdsByChannelId(channel.Id)
+		if err != nil {
+			return model.NewAppError("addPostTombstone", "app.channel.get_members.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		members := make([]string, 0, len(memberIDs))
+		for _, memberID := range memberIDs {
+			member, appErr := a.getUsernameForExport(e, memberID)
+			if appErr != nil {
+				return appErr
+			}
+			if member == "" {
+				// The channel can't be found by its members anymore.
+				rctx.Logger().Warn("Bulk export: skipping the tombstone of a direct post as a member of its channel was permanently deleted",
+					mlog.String("post_id", post.Id),
+					mlog.String("channel_id", channel.Id),
+					mlog.String("user_id", memberID),
+				)
+				return nil
+			}
+			members = append(members, member)
+		}
+
+		data.Entity = model.NewPointer(imports.DeleteEntityDirectPost)
+		data.ChannelMembers = &members
+	} else {
+		teamName, appErr := a.getTeamNameForExport(e, channel.TeamId)
+		if appErr != nil || teamName == "" {
+			return appErr
+		}
+
+		data.Entity = model.NewPointer(imports.DeleteEntityPost)
+		data.Team = model.NewPointer(teamName)
+		data.Channel = model.NewPointer(channel.Name)
+	}
+
+	e.addTombstone(data)
+	return nil
+}
+
+// nextExportBatch removes and returns the next batch of ids to export.
+func nextExportBatch(ids *[]string) []string {
+	n := min(len(*ids), incrementalExportBatchSize)
+	batch := (*ids)[:n]
+	*ids = (*ids)[n:]
+	return batch
+}
+
+func (a *App) exportChangedPosts(rctx request.CTX, job *model.Job, writer io.Writer, e *incrementalExport, withAttachments, includeArchivedChannels bool) ([]imports.AttachmentImportData, *model.AppError) {
+	var attachments []imports.AttachmentImportData
+	cnt := 0
+	for len(e.rootIds) > 0 {
+		posts, err := a.Srv().Store().Post().GetParentsForExportByIds(nextExportBatch(&e.rootIds), includeArchivedChannels)
+		if err != nil {
+			return nil, model.NewAppError("exportChangedPosts", "app.post.get_posts.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		for _, post := range posts {
+			postLine, postAttachments, appErr := a.buildPostLine(rctx, post, withAttachments)
+			if appErr != nil {
+				return nil, appErr
+			}
+			attachments = append(attachments, postAttachments...)
+
+			if appErr := a.exportWriteLine(writer, postLine); appErr != nil {
+				return nil, appErr
+			}
+		}
+
+		cnt += len(posts)
+		updateJobProgress(rctx.Logger(), a.Srv().Store(), job, "posts_exported", cnt)
+	}
+
+	return attachments, nil
+}
+
+func (a *App) exportChangedDirectPosts(rctx request.CTX, job *model.Job, writer io.Writer, e *incrementalExport, withAttachments, includeArchivedChannels bool) ([]imports.AttachmentImportData, *model.AppError) {
+	var attachments []imports.AttachmentImportData
+	cnt := 0
+	channelsToSkip := model.SliceToMapKey(strings.Split(job.Data["skipped_direct_channels"], ",")...)
+	for len(e.directRootIds) > 0 {
+		posts, err := a.Srv().Store().Post().GetDirectPostParentsForExportByIds(nextExportBatch(&e.directRootIds), includeArchivedChannels)
+		if err != nil {
+			return nil, model.NewAppError("exportChangedDirectPosts", "app.post.get_direct_posts.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		for _, post := range posts {
+			if _, ok := channelsToSkip[post.ChannelId]; ok {
+				continue
+			}
+
+			postLine, postAttachments, appErr := a.buildDirectPostLine(rctx, post, withAttachments)
+			if appErr != nil {
+				return nil, appErr
+			}
+			attachments = append(attachments, postAttachments...)
+
+			if appErr := a.exportWriteLine(writer, postLine); appErr != nil {
+				return nil, appErr
+			}
+		}
+
+		cnt += len(posts)
+		updateJobProgress(rctx.Logger(), a.Srv().Store(), job, "direct_posts_exported", cnt)
+	}
+
+	return attachments, nil
+}
@@ -192,7 +192,7 @@ func TestExportCustomEmoji(t *testing.T) {
 	outPath, err := filepath.Abs(filePath)
 	require.NoError(t, err)
 
-	_, appErr := th.App.exportCustomEmoji(th.Context, nil, fileWriter, outPath, dirNameToExportEmoji, false)
+	_, appErr := th.App.exportCustomEmoji(th.Context, nil, fileWriter, outPath, dirNameToExportEmoji, false, nil)
 	require.Nil(t, appErr, "should not have failed")
 }
 
@@ -1176,6 +1176,134 @@ func TestExportDeletedTeams(t *testing.T) {
 	}
 }
 
+func TestIncrementalBulkExport(t *testing.T) {
+	mainHelper.Parallel(t)
+	th1 := Setup(t).InitBasic()
+	defer th1.TearDown()
+
+	editedPost := th1.CreatePost(th1.BasicChannel)
+	deletedPost := th1.CreatePost(th1.BasicChannel)
+	archivedChannel := th1.CreateChannel(th1.Context, th1.BasicTeam)
+	rejoinedChannel := th1.CreateChannel(th1.Context, th1.BasicTeam)
+
+	var full bytes.Buffer
+	appErr := th1.App.BulkExport(th1.Context, &full, "somePath", nil, model.BulkExportOpts{})
+	require.Nil(t, appErr)
+
+	since := model.GetMillis()
+	time.Sleep(10 * time.Millisecond)
+
+	newChannel := th1.CreateChannel(th1.Context, th1.BasicTeam)
+	newPost := th1.CreatePost(newChannel)
+
+	editedPost = editedPost.Clone()
+	editedPost.Message = "edited message"
+	_, appErr = th1.App.UpdatePost(th1.Context, editedPost, nil)
+	require.Nil(t, appErr)
+
+	_, appErr = th1.App.DeletePost(th1.Context, deletedPost.Id, th1.BasicUser.Id)
+	require.Nil(t, appErr)
+
+	appErr = th1.App.RemoveUserFromChannel(th1.Context, th1.BasicUser2.Id, th1.SystemAdminUser.Id, th1.BasicChannel)
+	require.Nil(t, appErr)
+
+	appErr = th1.App.DeleteChannel(th1.Context, archivedChannel, th1.SystemAdminUser.Id)
+	require.Nil(t, appErr)
+
+	// Rejoining without going through the app leaves no trace in the member history.
+	appErr = th1.App.RemoveUserFromChannel(th1.Context, th1.BasicUser.Id, th1.SystemAdminUser.Id, rejoinedChannel)
+	require.Nil(t, appErr)
+	_, err := th1.App.Srv().Store().Channel().SaveMember(th1.Context, &model.ChannelMember{
+		ChannelId:   rejoinedChannel.Id,
+		UserId:      th1.BasicUser.Id,
+		NotifyProps: model.GetDefaultChannelNotifyProps(),
+		SchemeUser:  true,
+	})
+	require.NoError(t, err)
+
+	var incremental bytes.Buffer
+	appErr = th1.App.BulkExport(th1.Context, &incremental, "somePath", nil, model.BulkExportOpts{IncrementalSince: since})
+	require.Nil(t, appErr)
+
+	t.Run("only the changes and tombstones are exported", func(t *testing.T) {
+		var channels, posts, leftChannels []string
+		tombstones := map[string]*imports.DeleteImportData{}
+		scanner := bufio.NewScanner(bytes.NewReader(incremental.Bytes()))
+		for scanner.Scan() {
+			var line imports.LineImportData
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+			switch line.Type {
+			case "channel":
+				channels = append(channels, *line.Channel.Name)
+			case "post":
+				posts = append(posts, *line.Post.Message)
+			case "delete":
+				tombstones[*line.Delete.Entity] = line.Delete
+				if *line.Delete.Entity == imports.DeleteEntityChannelMember {
+					leftChannels = append(leftChannels, *line.Delete.Channel)
+				}
+			}
+		}
+
+		assert.Contains(t, channels, newChannel.Name)
+		assert.NotContains(t, channels, th1.BasicChannel.Name)
+		assert.Contains(t, posts, newPost.Message)
+		assert.Contains(t, posts, editedPost.Message)
+		assert.NotContains(t, posts, deletedPost.Message)
+
+		require.Contains(t, tombstones, imports.DeleteEntityPost)
+		assert.Equal(t, deletedPost.CreateAt, *tombstones[imports.DeleteEntityPost].CreateAt)
+		require.Contains(t, tombstones, imports.DeleteEntityChannel)
+		assert.Equal(t, archivedChannel.Name, *tombstones[imports.DeleteEntityChannel].Channel)
+		require.Contains(t, tombstones, imports.DeleteEntityChannelMember)
+		assert.Equal(t, th1.BasicUser2.Username, *tombstones[imports.DeleteEntityChannelMember].User)
+		assert.Equal(t, []string{th1.BasicChannel.Name}, leftChannels)
+	})
+
+	t.Run("the incremental export replays on top of the full one", func(t *testing.T) {
+		th2 := Setup(t)
+		defer th2.TearDown()
+
+		i, appErr := th2.App.BulkImport(th2.Context, &full, nil, false, 5)
+		require.Nil(t, appErr)
+		require.Equal(t, 0, i)
+
+		// Importing twice is harmless.
+		for range 2 {
+			i, appErr = th2.App.BulkImport(th2.Context, bytes.NewReader(incremental.Bytes()), nil, false, 5)
+			require.Nil(t, appErr)
+			require.Equal(t, 0, i)
+		}
+
+		team, appErr := th2.App.GetTeamByName(th1.BasicTeam.Name)
+		require.Nil(t, appErr)
+
+		_, appErr = th2.App.GetChannelByName(th2.Context, newChannel.Name, team.Id, false)
+		require.Nil(t, appErr)
+
+		archived, appErr := th2.App.GetChannelByName(th2.Context, archivedChannel.Name, team.Id, true)
+		require.Nil(t, appErr)
+		assert.NotZero(t, archived.DeleteAt)
+
+		channel, appErr := th2.App.GetChannelByName(th2.Context, th1.BasicChannel.Name, team.Id, false)
+		require.Nil(t, appErr)
+		user2, appErr := th2.App.GetUserByUsername(th1.BasicUser2.Username)
+		require.Nil(t, appErr)
+		_, appErr = th2.App.GetChannelMember(th2.Context, channel.Id, user2.Id)
+		require.NotNil(t, appErr)
+
+		posts, err := th2.App.Srv().Store().Post().GetPostsCreatedAt(channel.Id, editedPost.CreateAt)
+		require.NoError(t, err)
+		require.Len(t, posts, 1)
+		assert.Equal(t, editedPost.Message, posts[0].Message)
+
+		posts, err = th2.App.Srv().Store().Post().GetPostsCreatedAt(channel.Id, deletedPost.CreateAt)
+		require.NoError(t, err)
+		require.Len(t, posts, 1)
+		assert.NotZero(t, posts[0].DeleteAt)
+	})
+}
+
 func TestExportArchivedChannels(t *testing.T) {
 	mainHelper.Parallel(t)
 	th1 := Setup(t).InitBasic()
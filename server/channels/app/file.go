@@ -1650,13 +1650,70 @@ func (a *App) RemoveFilesFromFileStore(rctx request.CTX, fileInfos []*model.File
 		a.RemoveFileFromFileStore(rctx, info.Path)
 		if info.PreviewPath != "" {
 			a.RemoveFileFromFileStore(rctx, info.PreviewPath)
+			a.removeWebPVariant(rctx, info.PreviewPath)
 		}
 		if info.ThumbnailPath != "" {
 			a.RemoveFileFromFileStore(rctx, info.ThumbnailPath)
+			a.removeWebPVariant(rctx, info.ThumbnailPath)
 		}
 	}
 }
 
+// webPVariantPath returns the path of the WebP copy of the preview or
+// thumbnail image stored at path.
+func webPVariantPath(path string) string {
+	return strings.TrimSuffix(path, filepath.Ext(path)) + ".webp"
+}
+
+// GetWebPVariantReader returns a reader for a WebP encoded copy of the
+// preview or thumbnail image stored at path. The copy is generated and
+// stored next to the original on first access.
+func (a *App) GetWebPVariantReader(path string) (filestore.ReadCloseSeeker, *model.AppError) {
+	webPPath := webPVariantPath(path)
+
+	exists, appErr := a.FileExists(webPPath)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if exists {
+		return a.FileReader(webPPath)
+	}
+
+	file, appErr := a.FileReader(path)
+	if appErr != nil {
+		return nil, appErr
+	}
+	defer file.Close()
+
+	img, _, err := a.ch.imgDecoder.Decode(file)
+	if err != nil {
+		return nil, model.NewAppError("GetWebPVariantReader", "app.file.webp_variant.decode.app_error", nil, "path="+path, http.StatusInternalServerError).Wrap(err)
+	}
+
+	var buf bytes.Buffer
+	if err := a.ch.imgEncoder.EncodeWebP(&buf, img, jpegEncQuality); err != nil {
+		return nil, model.NewAppError("GetWebPVariantReader", "app.file.webp_variant.encode.app_error", nil, "path="+path, http.StatusInternalServerError).Wrap(err)
+	}
+
+	if _, appErr := a.WriteFile(&buf, webPPath); appErr != nil {
+		return nil, appErr
+	}
+
+	return a.FileReader(webPPath)
+}
+
+func (a *App) removeWebPVariant(rctx request.CTX, path string) {
+	webPPath := webPVariantPath(path)
+	// WebP variants are only generated on demand, so a missing file is
+	// not worth a warning.
+	if exists, appErr := a.FileExists(webPPath); appErr != nil || !exists {
+		return
+	}
+	if appErr := a.RemoveFile(webPPath); appErr != nil {
+		rctx.Logger().Warn("Unable to remove file", mlog.String("path", webPPath), mlog.Err(appErr))
+	}
+}
+
 func (a *App) RemoveFileFromFileStore(rctx request.CTX, path string) {
 	res, appErr := a.FileExists(path)
 	if appErr != nil {
@@ -9,6 +9,8 @@ import (
 	"net/http"
 
 	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin"
+	"github.com/mattermost/mattermost/server/public/shared/request"
 	"github.com/mattermost/mattermost/server/v8/channels/store"
 )
 
@@ -326,7 +328,7 @@ func (a *App) GetUsersNotInGroupPage(groupID string, page int, perPage int, view
 	return a.sanitizeProfiles(members, false), nil
 }
 
-func (a *App) UpsertGroupMember(groupID string, userID string) (*model.GroupMember, *model.AppError) {
+func (a *App) UpsertGroupMember(rctx request.CTX, groupID string, userID string) (*model.GroupMember, *model.AppError) {
 	groupMember, err := a.Srv().Store().Group().UpsertMember(groupID, userID)
 	if err != nil {
 		var invErr *store.ErrInvalidInput
@@ -348,10 +350,12 @@ func (a *App) UpsertGroupMember(groupID string, userID string) (*model.GroupMemb
 		return nil, appErr
 	}
 
+	a.runGroupMemberHooks(rctx, []*model.GroupMember{groupMember}, true)
+
 	return groupMember, nil
 }
 
-func (a *App) DeleteGroupMember(groupID string, userID string) (*model.GroupMember, *model.AppError) {
+func (a *App) DeleteGroupMember(rctx request.CTX, groupID string, userID string) (*model.GroupMember, *model.AppError) {
 	groupMember, err := a.Srv().Store().Group().DeleteMember(groupID, userID)
 	if err != nil {
 		var nfErr *store.ErrNotFound
@@ -367,6 +371,8 @@ func (a *App) DeleteGroupMember(groupID string, userID string) (*model.GroupMemb
 		return nil, appErr
 	}
 
+	a.runGroupMemberHooks(rctx, []*model.GroupMember{groupMember}, false)
+
 	return groupMember, nil
 }
 
@@ -817,7 +823,7 @@ func (a *App) UserIsInAdminRoleGroup(userID, syncableID string, syncableType mod
 	return true, nil
 }
 
-func (a *App) UpsertGroupMembers(groupID string, userIDs []string) ([]*model.GroupMember, *model.AppError) {
+func (a *App) UpsertGroupMembers(rctx request.CTX, groupID string, userIDs []string) ([]*model.GroupMember, *model.AppError) {
 	members, err := a.Srv().Store().Group().UpsertMembers(groupID, userIDs)
 	if err != nil {
 		var invErr *store.ErrInvalidInput
@@ -841,10 +847,12 @@ func (a *App) UpsertGroupMembers(groupID string, userIDs []string) ([]*model.Gro
 		}
 	}
 
+	a.runGroupMemberHooks(rctx, members, true)
+
 	return members, nil
 }
 
-func (a *App) DeleteGroupMembers(groupID string, userIDs []string) ([]*model.GroupMember, *model.AppError) {
+func (a *App) DeleteGroupMembers(rctx request.CTX, groupID string, userIDs []string) ([]*model.GroupMember, *model.AppError) {
 	members, err := a.Srv().Store().Group().DeleteMembers(groupID, userIDs)
 	if err != nil {
 		var invErr *store.ErrInvalidInput
@@ -869,9 +877,41 @@ func (a *App) DeleteGroupMembers(groupID string, userIDs []string) ([]*model.Gro
 		}
 	}
 
+	a.runGroupMemberHooks(rctx, members, false)
+
 	return members, nil
 }
 
+// runGroupMemberHooks runs the UserHasJoinedGroup or UserHasLeftGroup hook of the plugins in the
+// background for each of the given memberships. The user of the session, if any, is the actor.
+func (a *App) runGroupMemberHooks(rctx request.CTX, members []*model.GroupMember, joined bool) {
+	if len(members) == 0 {
+		return
+	}
+
+	a.Srv().Go(func() {
+		var actor *model.User
+		if actorID := rctx.Session().UserId; actorID != "" {
+			actor, _ = a.GetUser(actorID)
+		}
+
+		pluginContext := pluginContext(rctx)
+		for _, member := range members {
+			if joined {
+				a.ch.RunMultiHook(func(hooks plugin.Hooks, _ *model.Manifest) bool {
+					hooks.UserHasJoinedGroup(pluginContext, member, actor)
+					return true
+				}, plugin.UserHasJoinedGroupID)
+			} else {
+				a.ch.RunMultiHook(func(hooks plugin.Hooks, _ *model.Manifest) bool {
+					hooks.UserHasLeftGroup(pluginContext, member, actor)
+					return true
+				}, plugin.UserHasLeftGroupID)
+			}
+		}
+	})
+}
+
 func (a *App) publishGroupMemberEvent(eventName model.WebsocketEventType, groupMember *model.GroupMember) *model.AppError {
 	messageWs := model.NewWebSocketEvent(eventName, "", "", groupMember.UserId, nil, "")
 	groupMemberJSON, jsonErr := json.Marshal(groupMember)
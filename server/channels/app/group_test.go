@@ -161,11 +161,11 @@ func TestCreateOrRestoreGroupMember(t *testing.T) {
 	defer th.TearDown()
 	group := th.CreateGroup()
 
-	g, err := th.App.UpsertGroupMember(group.Id, th.BasicUser.Id)
+	g, err := th.App.UpsertGroupMember(th.Context, group.Id, th.BasicUser.Id)
 	require.Nil(t, err)
 	require.NotNil(t, g)
 
-	g, err = th.App.UpsertGroupMember(group.Id, th.BasicUser.Id)
+	g, err = th.App.UpsertGroupMember(th.Context, group.Id, th.BasicUser.Id)
 	require.Nil(t, err)
 	require.NotNil(t, g)
 }
@@ -175,15 +175,15 @@ func TestDeleteGroupMember(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
 	group := th.CreateGroup()
-	groupMember, err := th.App.UpsertGroupMember(group.Id, th.BasicUser.Id)
+	groupMember, err := th.App.UpsertGroupMember(th.Context, group.Id, th.BasicUser.Id)
 	require.Nil(t, err)
 	require.NotNil(t, groupMember)
 
-	groupMember, err = th.App.DeleteGroupMember(groupMember.GroupId, groupMember.UserId)
+	groupMember, err = th.App.DeleteGroupMember(th.Context, groupMember.GroupId, groupMember.UserId)
 	require.Nil(t, err)
 	require.NotNil(t, groupMember)
 
-	groupMember, err = th.App.DeleteGroupMember(groupMember.GroupId, groupMember.UserId)
+	groupMember, err = th.App.DeleteGroupMember(th.Context, groupMember.GroupId, groupMember.UserId)
 	require.NotNil(t, err)
 	require.Nil(t, groupMember)
 }
@@ -225,7 +225,7 @@ func TestUpsertGroupSyncableTeamGroupConstrained(t *testing.T) {
 
 	team := th.CreateTeam()
 	team.GroupConstrained = model.NewPointer(true)
-	team, err := th.App.UpdateTeam(team)
+	team, err := th.App.UpdateTeam(th.Context, team)
 	require.Nil(t, err)
 	_, err = th.App.UpsertGroupSyncable(model.NewGroupTeam(group1.Id, team.Id, false))
 	require.Nil(t, err)
@@ -420,11 +420,11 @@ func TestUserIsInAdminRoleGroup(t *testing.T) {
 	group1 := th.CreateGroup()
 	group2 := th.CreateGroup()
 
-	g, err := th.App.UpsertGroupMember(group1.Id, th.BasicUser.Id)
+	g, err := th.App.UpsertGroupMember(th.Context, group1.Id, th.BasicUser.Id)
 	require.Nil(t, err)
 	require.NotNil(t, g)
 
-	g, err = th.App.UpsertGroupMember(group2.Id, th.BasicUser.Id)
+	g, err = th.App.UpsertGroupMember(th.Context, group2.Id, th.BasicUser.Id)
 	require.Nil(t, err)
 	require.NotNil(t, g)
 
@@ -263,6 +263,11 @@ func SetupWithStoreMock(tb testing.TB) *TestHelper {
 	emptyMockStore := mocks.Store{}
 	emptyMockStore.On("Close").Return(nil)
 	emptyMockStore.On("Status").Return(&statusMock)
+	configRevisionMock := mocks.ConfigRevisionStore{}
+	configRevisionMock.On("GetAll", mock.Anything, mock.Anything).Return([]*model.ConfigRevision{}, nil)
+	configRevisionMock.On("Save", mock.AnythingOfType("*model.ConfigRevision")).Return(&model.ConfigRevision{}, nil)
+	configRevisionMock.On("PermanentDeleteAllButLatest", mock.Anything).Return(nil)
+	emptyMockStore.On("ConfigRevision").Return(&configRevisionMock).Maybe()
 	emptyMockStore.On("Plugin").Return(&pluginMock).Maybe()
 	th.App.Srv().SetStore(&emptyMockStore)
 
@@ -280,6 +285,11 @@ func SetupEnterpriseWithStoreMock(tb testing.TB) *TestHelper {
 	emptyMockStore := mocks.Store{}
 	emptyMockStore.On("Close").Return(nil)
 	emptyMockStore.On("Status").Return(&statusMock)
+	configRevisionMock := mocks.ConfigRevisionStore{}
+	configRevisionMock.On("GetAll", mock.Anything, mock.Anything).Return([]*model.ConfigRevision{}, nil)
+	configRevisionMock.On("Save", mock.AnythingOfType("*model.ConfigRevision")).Return(&model.ConfigRevision{}, nil)
+	configRevisionMock.On("PermanentDeleteAllButLatest", mock.Anything).Return(nil)
+	emptyMockStore.On("ConfigRevision").Return(&configRevisionMock).Maybe()
 	th.App.Srv().SetStore(&emptyMockStore)
 	return th
 }
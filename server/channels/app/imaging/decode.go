@@ -60,7 +60,7 @@ func (d *Decoder) Decode(rd io.Reader) (img image.Image, format string, err erro
 		defer func() { <-d.sem }()
 	}
 
-	img, format, err = image.Decode(rd)
+	img, format, err = decodeImage(rd)
 	if err != nil {
 		return nil, "", fmt.Errorf("imaging: failed to decode image: %w", err)
 	}
@@ -81,7 +81,7 @@ func (d *Decoder) DecodeMemBounded(rd io.Reader) (img image.Image, format string
 		}()
 	}
 
-	img, format, err = image.Decode(rd)
+	img, format, err = decodeImage(rd)
 	if err != nil {
 		return nil, "", nil, fmt.Errorf("imaging: failed to decode image: %w", err)
 	}
@@ -104,7 +104,7 @@ func (d *Decoder) DecodeMemBounded(rd io.Reader) (img image.Image, format string
 
 // DecodeConfig returns the image config for the given data.
 func (d *Decoder) DecodeConfig(rd io.Reader) (image.Config, string, error) {
-	img, format, err := image.DecodeConfig(rd)
+	img, format, err := decodeImageConfig(rd)
 	if err != nil {
 		return image.Config{}, "", fmt.Errorf("imaging: failed to decode image config: %w", err)
 	}
@@ -113,7 +113,7 @@ func (d *Decoder) DecodeConfig(rd io.Reader) (image.Config, string, error) {
 
 // GetDimensions returns the dimensions for the given encoded image data.
 func GetDimensions(imageData io.Reader) (width int, height int, err error) {
-	cfg, _, err := image.DecodeConfig(imageData)
+	cfg, _, err := decodeImageConfig(imageData)
 	width, height = cfg.Width, cfg.Height
 	if seeker, ok := imageData.(io.Seeker); ok {
 		_, err2 := seeker.Seek(0, 0)
@@ -86,3 +86,20 @@ func (e *Encoder) EncodePNG(wr io.Writer, img image.Image) error {
 
 	return nil
 }
+
+// EncodeWebP encodes the given image in lossy WebP format and writes the
+// data to the passed writer.
+func (e *Encoder) EncodeWebP(wr io.Writer, img image.Image, quality int) error {
+	if e.opts.ConcurrencyLevel > 0 {
+		e.sem <- struct{}{}
+		defer func() {
+			<-e.sem
+		}()
+	}
+
+	if err := encodeWebP(wr, img, quality); err != nil {
+		return fmt.Errorf("imaging: failed to encode webp: %w", err)
+	}
+
+	return nil
+}
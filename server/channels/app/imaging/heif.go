@@ -0,0 +1,697 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package imaging
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"io"
+	"mime"
+	"sync"
+
+	"github.com/anthonynsimon/bild/transform"
+	"github.com/gen2brain/avif"
+	"github.com/gen2brain/heic"
+)
+
+// This file implements a pure Go reader for the HEIF container format
+// (ISO/IEC 23008-12) used by HEIC and AVIF images. The container is parsed to
+// locate the primary image, assemble grid tiles and apply the rotation and
+// mirroring properties. The coded image data itself is handed to a codec
+// registered for the item type through RegisterHEIFCodec. JPEG coded items
+// are supported out of the box, and files whose primary image is HEVC or AV1
+// coded are decoded as a whole by libheif and libavif.
+
+// ErrUnsupportedHEIFCodec is returned when a HEIF image uses a codec for which
+// no decoder has been registered.
+var ErrUnsupportedHEIFCodec = errors.New("unsupported HEIF codec")
+
+const (
+	// heifMaxFileSize bounds the amount of data read into memory when
+	// decoding a HEIF container.
+	heifMaxFileSize = 256 * 1024 * 1024
+	// heifMaxTiles bounds the number of tiles in a grid image.
+	heifMaxTiles = 4096
+)
+
+// HEIFCodec decodes the coded data of a single HEIF image item. config holds
+// the payload of the item's codec configuration property (e.g. hvcC or av1C)
+// and may be nil.
+type HEIFCodec func(config, data []byte) (image.Image, error)
+
+var (
+	heifCodecsMut sync.RWMutex
+	heifCodecs    = map[string]HEIFCodec{
+		"jpeg": func(_, data []byte) (image.Image, error) {
+			return jpeg.Decode(bytes.NewReader(data))
+		},
+	}
+)
+
+// RegisterHEIFCodec registers a decoder for HEIF items of the given type,
+// such as "hvc1" for HEIC or "av01" for AVIF.
+func RegisterHEIFCodec(itemType string, codec HEIFCodec) {
+	heifCodecsMut.Lock()
+	defer heifCodecsMut.Unlock()
+	heifCodecs[itemType] = codec
+}
+
+func getHEIFCodec(itemType string) HEIFCodec {
+	heifCodecsMut.RLock()
+	defer heifCodecsMut.RUnlock()
+	return heifCodecs[itemType]
+}
+
+func init() {
+	// HEIF based formats are missing from the builtin MIME table on most
+	// systems, which would prevent previews from being generated for them.
+	for ext, mimeType := range map[string]string{
+		".heic": "image/heic",
+		".heif": "image/heif",
+		".avif": "image/avif",
+	} {
+		if mime.TypeByExtension(ext) == "" {
+			_ = mime.AddExtensionType(ext, mimeType)
+		}
+	}
+}
+
+// heifFileDecoders decode whole HEIF files whose primary image is coded with
+// a codec that has no HEIFCodec. libheif applies the rotation and mirroring
+// properties itself, libavif leaves them to the caller.
+var heifFileDecoders = map[string]struct {
+	decode      func(io.Reader) (image.Image, error)
+	transformed bool
+}{
+	"hvc1": {decode: heic.Decode, transformed: true},
+	"av01": {decode: avif.Decode},
+}
+
+// heifBrands maps the major brands of HEIF files to their image format.
+var heifBrands = map[string]string{
+	"heic": "heic",
+	"heix": "heic",
+	"hevc": "heic",
+	"hevx": "heic",
+	"heim": "heic",
+	"heis": "heic",
+	"avif": "avif",
+	"avis": "avif",
+	"mif1": "heif",
+	"msf1": "heif",
+}
+
+func init() {
+	for brand, format := range heifBrands {
+		image.RegisterFormat(format, "????ftyp"+brand, decodeHEIF, decodeHEIFConfig)
+	}
+}
+
+// heifFormat returns the image format of the HEIF file read by br, if any,
+// without consuming it.
+func heifFormat(br *bufio.Reader) string {
+	header, err := br.Peek(12)
+	if err != nil || string(header[4:8]) != "ftyp" {
+		return ""
+	}
+	return heifBrands[string(header[8:12])]
+}
+
+// decodeImage works like image.Decode, except that HEIF files are always
+// decoded by decodeHEIF rather than by the formats registered by the libheif
+// and libavif packages, which take precedence for some brands and don't apply
+// the HEIF transformations consistently.
+func decodeImage(rd io.Reader) (image.Image, string, error) {
+	br := bufio.NewReader(rd)
+	if format := heifFormat(br); format != "" {
+		img, err := decodeHEIF(br)
+		return img, format, err
+	}
+	return image.Decode(br)
+}
+
+// decodeImageConfig works like image.DecodeConfig, with the same HEIF
+// handling as decodeImage.
+func decodeImageConfig(rd io.Reader) (image.Config, string, error) {
+	br := bufio.NewReader(rd)
+	if format := heifFormat(br); format != "" {
+		config, err := decodeHEIFConfig(br)
+		return config, format, err
+	}
+	return image.DecodeConfig(br)
+}
+
+type heifBox struct {
+	typ  string
+	data []byte
+}
+
+// readBoxes splits data into the ISO base media file format boxes it contains.
+func readBoxes(data []byte) ([]heifBox, error) {
+	var boxes []heifBox
+	for len(data) > 0 {
+		if len(data) < 8 {
+			return nil, errors.New("truncated box header")
+		}
+		size := uint64(binary.BigEndian.Uint32(data[0:4]))
+		typ := string(data[4:8])
+		header := uint64(8)
+		switch size {
+		case 0:
+			size = uint64(len(data))
+		case 1:
+			if len(data) < 16 {
+				return nil, errors.New("truncated large box header")
+			}
+			size = binary.BigEndian.Uint64(data[8:16])
+			header = 16
+		}
+		if size < header || size > uint64(len(data)) {
+			return nil, fmt.Errorf("invalid size for box %q", typ)
+		}
+		boxes = append(boxes, heifBox{typ: typ, data: data[header:size]})
+		data = data[size:]
+	}
+	return boxes, nil
+}
+
+func findBox(boxes []heifBox, typ string) *heifBox {
+	for i := range boxes {
+		if boxes[i].typ == typ {
+			return &boxes[i]
+		}
+	}
+	return nil
+}
+
+// heifReader reads big-endian fields from a box payload.
+type heifReader struct {
+	data []byte
+	err  error
+}
+
+func (r *heifReader) bytes(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || n > len(r.data) {
+		r.err = errors.New("unexpected end of box")
+		return nil
+	}
+	b := r.data[:n]
+	r.data = r.data[n:]
+	return b
+}
+
+func (r *heifReader) uint(n int) uint64 {
+	b := r.bytes(n)
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v
+}
+
+func (r *heifReader) fullBoxHeader() (version uint8, flags uint32) {
+	v := r.uint(4)
+	return uint8(v >> 24), uint32(v & 0xffffff)
+}
+
+type heifExtent struct {
+	offset uint64
+	length uint64
+}
+
+type heifItem struct {
+	id                 uint32
+	typ                string
+	constructionMethod uint8
+	baseOffset         uint64
+	extents            []heifExtent
+	properties         []*heifBox
+	dimg               []uint32
+}
+
+func (it *heifItem) property(typ string) *heifBox {
+	for _, p := range it.properties {
+		if p.typ == typ {
+			return p
+		}
+	}
+	return nil
+}
+
+type heifFile struct {
+	file      []byte
+	idat      []byte
+	primaryID uint32
+	items     map[uint32]*heifItem
+}
+
+func parseHEIF(data []byte) (*heifFile, error) {
+	top, err := readBoxes(data)
+	if err != nil {
+		return nil, err
+	}
+	meta := findBox(top, "meta")
+	if meta == nil {
+		return nil, errors.New("missing meta box")
+	}
+	r := &heifReader{data: meta.data}
+	r.fullBoxHeader()
+	if r.err != nil {
+		return nil, r.err
+	}
+	children, err := readBoxes(r.data)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &heifFile{file: data, items: map[uint32]*heifItem{}}
+	if idat := findBox(children, "idat"); idat != nil {
+		f.idat = idat.data
+	}
+
+	pitm := findBox(children, "pitm")
+	if pitm == nil {
+		return nil, errors.New("missing pitm box")
+	}
+	r = &heifReader{data: pitm.data}
+	if version, _ := r.fullBoxHeader(); version == 0 {
+		f.primaryID = uint32(r.uint(2))
+	} else {
+		f.primaryID = uint32(r.uint(4))
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	if err := f.parseItemInfo(findBox(children, "iinf")); err != nil {
+		return nil, err
+	}
+	if err := f.parseItemLocations(findBox(children, "iloc")); err != nil {
+		return nil, err
+	}
+	if err := f.parseItemProperties(findBox(children, "iprp")); err != nil {
+		return nil, err
+	}
+	if err := f.parseItemReferences(findBox(children, "iref")); err != nil {
+		return nil, err
+	}
+
+	if f.items[f.primaryID] == nil {
+		return nil, errors.New("primary item not found")
+	}
+	return f, nil
+}
+
+func (f *heifFile) item(id uint32) *heifItem {
+	it, ok := f.items[id]
+	if !ok {
+		it = &heifItem{id: id}
+		f.items[id] = it
+	}
+	return it
+}
+
+func (f *heifFile) parseItemInfo(iinf *heifBox) error {
+	if iinf == nil {
+		return errors.New("missing iinf box")
+	}
+	r := &heifReader{data: iinf.data}
+	if version, _ := r.fullBoxHeader(); version == 0 {
+		r.uint(2)
+	} else {
+		r.uint(4)
+	}
+	if r.err != nil {
+		return r.err
+	}
+	entries, err := readBoxes(r.data)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.typ != "infe" {
+			continue
+		}
+		er := &heifReader{data: entry.data}
+		version, _ := er.fullBoxHeader()
+		if version < 2 {
+			// Versions 0 and 1 predate typed items and are not used by images.
+			continue
+		}
+		var id uint32
+		if version == 2 {
+			id = uint32(er.uint(2))
+		} else {
+			id = uint32(er.uint(4))
+		}
+		er.uint(2) // item_protection_index
+		typ := string(er.bytes(4))
+		if er.err != nil {
+			return er.err
+		}
+		f.item(id).typ = typ
+	}
+	return nil
+}
+
+func (f *heifFile) parseItemLocations(iloc *heifBox) error {
+	if iloc == nil {
+		return errors.New("missing iloc box")
+	}
+	r := &heifReader{data: iloc.data}
+	version, _ := r.fullBoxHeader()
+	sizes := r.uint(2)
+	offsetSize := int(sizes >> 12 & 0xf)
+	lengthSize := int(sizes >> 8 & 0xf)
+	baseOffsetSize := int(sizes >> 4 & 0xf)
+	indexSize := 0
+	if version == 1 || version == 2 {
+		indexSize = int(sizes & 0xf)
+	}
+
+	var count uint64
+	if version < 2 {
+		count = r.uint(2)
+	} else {
+		count = r.uint(4)
+	}
+	for i := uint64(0); i < count && r.err == nil; i++ {
+		var id uint32
+		if version < 2 {
+			id = uint32(r.uint(2))
+		} else {
+			id = uint32(r.uint(4))
+		}
+		it := f.item(id)
+		if version == 1 || version == 2 {
+			it.constructionMethod = uint8(r.uint(2) & 0xf)
+		}
+		r.uint(2) // data_reference_index
+		it.baseOffset = r.uint(baseOffsetSize)
+		extentCount := r.uint(2)
+		for j := uint64(0); j < extentCount && r.err == nil; j++ {
+			r.uint(indexSize)
+			offset := r.uint(offsetSize)
+			length := r.uint(lengthSize)
+			it.extents = append(it.extents, heifExtent{offset: offset, length: length})
+		}
+	}
+	return r.err
+}
+
+func (f *heifFile) parseItemProperties(iprp *heifBox) error {
+	if iprp == nil {
+		return errors.New("missing iprp box")
+	}
+	children, err := readBoxes(iprp.data)
+	if err != nil {
+		return err
+	}
+	ipco := findBox(children, "ipco")
+	if ipco == nil {
+		return errors.New("missing ipco box")
+	}
+	properties, err := readBoxes(ipco.data)
+	if err != nil {
+		return err
+	}
+
+	for _, ipma := range children {
+		if ipma.typ != "ipma" {
+			continue
+		}
+		r := &heifReader{data: ipma.data}
+		version, flags := r.fullBoxHeader()
+		count := r.uint(4)
+		for i := uint64(0); i < count && r.err == nil; i++ {
+			var id uint32
+			if version < 1 {
+				id = uint32(r.uint(2))
+			} else {
+				id = uint32(r.uint(4))
+			}
+			it := f.item(id)
+			associations := r.uint(1)
+			for j := uint64(0); j < associations && r.err == nil; j++ {
+				var index int
+				if flags&1 != 0 {
+					index = int(r.uint(2) & 0x7fff)
+				} else {
+					index = int(r.uint(1) & 0x7f)
+				}
+				// Property indices are 1-based, 0 means no property.
+				if index > 0 && index <= len(properties) {
+					it.properties = append(it.properties, &properties[index-1])
+				}
+			}
+		}
+		if r.err != nil {
+			return r.err
+		}
+	}
+	return nil
+}
+
+func (f *heifFile) parseItemReferences(iref *heifBox) error {
+	if iref == nil {
+		return nil
+	}
+	r := &heifReader{data: iref.data}
+	version, _ := r.fullBoxHeader()
+	if r.err != nil {
+		return r.err
+	}
+	refs, err := readBoxes(r.data)
+	if err != nil {
+		return err
+	}
+	idSize := 2
+	if version != 0 {
+		idSize = 4
+	}
+	for _, ref := range refs {
+		if ref.typ != "dimg" {
+			continue
+		}
+		rr := &heifReader{data: ref.data}
+		from := f.item(uint32(rr.uint(idSize)))
+		count := rr.uint(2)
+		for i := uint64(0); i < count && rr.err == nil; i++ {
+			from.dimg = append(from.dimg, uint32(rr.uint(idSize)))
+		}
+		if rr.err != nil {
+			return rr.err
+		}
+	}
+	return nil
+}
+
+// itemData returns the concatenated extents of the given item.
+func (f *heifFile) itemData(it *heifItem) ([]byte, error) {
+	var src []byte
+	switch it.constructionMethod {
+	case 0:
+		src = f.file
+	case 1:
+		src = f.idat
+	default:
+		return nil, fmt.Errorf("unsupported construction method %d", it.constructionMethod)
+	}
+
+	var data []byte
+	for _, e := range it.extents {
+		start := it.baseOffset + e.offset
+		length := e.length
+		if length == 0 {
+			length = uint64(len(src)) - min(start, uint64(len(src)))
+		}
+		if start > uint64(len(src)) || length > uint64(len(src))-start {
+			return nil, errors.New("item data out of bounds")
+		}
+		data = append(data, src[start:start+length]...)
+	}
+	return data, nil
+}
+
+// size returns the width and height of the item as declared by its ispe
+// property, before any transformation.
+func (it *heifItem) size() (int, int, error) {
+	ispe := it.property("ispe")
+	if ispe == nil {
+		return 0, 0, errors.New("missing ispe property")
+	}
+	r := &heifReader{data: ispe.data}
+	r.fullBoxHeader()
+	width, height := r.uint(4), r.uint(4)
+	if r.err != nil {
+		return 0, 0, r.err
+	}
+	return int(width), int(height), nil
+}
+
+func (f *heifFile) decodeItem(it *heifItem) (image.Image, error) {
+	if it.typ == "grid" {
+		return f.decodeGrid(it)
+	}
+
+	codec := getHEIFCodec(it.typ)
+	if codec == nil {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedHEIFCodec, it.typ)
+	}
+	data, err := f.itemData(it)
+	if err != nil {
+		return nil, err
+	}
+	var config []byte
+	for _, p := range it.properties {
+		if p.typ == "hvcC" || p.typ == "av1C" {
+			config = p.data
+			break
+		}
+	}
+	return codec(config, data)
+}
+
+func (f *heifFile) decodeGrid(it *heifItem) (image.Image, error) {
+	data, err := f.itemData(it)
+	if err != nil {
+		return nil, err
+	}
+	r := &heifReader{data: data}
+	r.uint(1) // version
+	flags := r.uint(1)
+	rows := int(r.uint(1)) + 1
+	columns := int(r.uint(1)) + 1
+	fieldSize := 2
+	if flags&1 != 0 {
+		fieldSize = 4
+	}
+	width, height := int(r.uint(fieldSize)), int(r.uint(fieldSize))
+	if r.err != nil {
+		return nil, r.err
+	}
+	if rows*columns > heifMaxTiles || rows*columns != len(it.dimg) {
+		return nil, errors.New("invalid grid layout")
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	var tileWidth, tileHeight int
+	for i, id := range it.dimg {
+		tile := f.items[id]
+		if tile == nil {
+			return nil, errors.New("missing grid tile")
+		}
+		img, err := f.decodeItem(tile)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			tileWidth, tileHeight = img.Bounds().Dx(), img.Bounds().Dy()
+		}
+		at := image.Pt((i%columns)*tileWidth, (i/columns)*tileHeight)
+		draw.Draw(dst, image.Rectangle{Min: at, Max: at.Add(img.Bounds().Size())}, img, img.Bounds().Min, draw.Src)
+	}
+	return dst, nil
+}
+
+// transform applies the irot and imir properties of the item in the order
+// in which they are associated.
+func (it *heifItem) transform(img image.Image) image.Image {
+	for _, p := range it.properties {
+		if len(p.data) < 1 {
+			continue
+		}
+		switch p.typ {
+		case "irot":
+			// Rotation is expressed in anti-clockwise quarter turns.
+			if angle := float64(p.data[0]&3) * 90; angle != 0 {
+				img = transform.Rotate(img, 360-angle, &transform.RotationOptions{ResizeBounds: true})
+			}
+		case "imir":
+			if p.data[0]&1 == 0 {
+				img = transform.FlipH(img)
+			} else {
+				img = transform.FlipV(img)
+			}
+		}
+	}
+	return img
+}
+
+func readHEIF(r io.Reader) (*heifFile, error) {
+	data, err := io.ReadAll(io.LimitReader(r, heifMaxFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > heifMaxFileSize {
+		return nil, errors.New("HEIF file is too large")
+	}
+	return parseHEIF(data)
+}
+
+func decodeHEIF(r io.Reader) (image.Image, error) {
+	f, err := readHEIF(r)
+	if err != nil {
+		return nil, err
+	}
+	primary := f.items[f.primaryID]
+	if codedType := f.codedType(primary); getHEIFCodec(codedType) == nil {
+		if fileDecoder, ok := heifFileDecoders[codedType]; ok {
+			img, err := fileDecoder.decode(bytes.NewReader(f.file))
+			if err != nil {
+				return nil, err
+			}
+			if fileDecoder.transformed {
+				return img, nil
+			}
+			return primary.transform(img), nil
+		}
+	}
+	img, err := f.decodeItem(primary)
+	if err != nil {
+		return nil, err
+	}
+	return primary.transform(img), nil
+}
+
+// codedType returns the type of the coded data of the item, which for a grid
+// is the type of its tiles.
+func (f *heifFile) codedType(it *heifItem) string {
+	if it.typ == "grid" && len(it.dimg) > 0 {
+		if tile := f.items[it.dimg[0]]; tile != nil {
+			return tile.typ
+		}
+	}
+	return it.typ
+}
+
+func decodeHEIFConfig(r io.Reader) (image.Config, error) {
+	f, err := readHEIF(r)
+	if err != nil {
+		return image.Config{}, err
+	}
+	primary := f.items[f.primaryID]
+	width, height, err := primary.size()
+	if err != nil {
+		return image.Config{}, err
+	}
+	if rot := primary.property("irot"); rot != nil && len(rot.data) > 0 && rot.data[0]&1 != 0 {
+		width, height = height, width
+	}
+	return image.Config{
+		ColorModel: color.NRGBAModel,
+		Width:      width,
+		Height:     height,
+	}, nil
+}
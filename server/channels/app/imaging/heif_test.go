@@ -0,0 +1,268 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/v8/channels/utils/fileutils"
+)
+
+func box(typ string, payload ...[]byte) []byte {
+	data := bytes.Join(payload, nil)
+	out := make([]byte, 8, 8+len(data))
+	binary.BigEndian.PutUint32(out, uint32(8+len(data)))
+	copy(out[4:], typ)
+	return append(out, data...)
+}
+
+func fullBox(typ string, version uint8, flags uint32, payload ...[]byte) []byte {
+	header := make([]byte, 4)
+	binary.BigEndian.PutUint32(header, uint32(version)<<24|flags)
+	return box(typ, append([][]byte{header}, payload...)...)
+}
+
+func u16(v int) []byte { return binary.BigEndian.AppendUint16(nil, uint16(v)) }
+func u32(v int) []byte { return binary.BigEndian.AppendUint32(nil, uint32(v)) }
+
+type testHEIFItem struct {
+	typ        string
+	data       []byte
+	properties []int
+}
+
+// buildHEIF assembles a minimal HEIF file. Items are numbered from 1 and
+// item 1 is the primary item. dimg lists the tiles of the primary item.
+func buildHEIF(t *testing.T, brand string, items []testHEIFItem, properties [][]byte, dimg []int) []byte {
+	t.Helper()
+
+	ftyp := box("ftyp", []byte(brand), u32(0), []byte("mif1"), []byte(brand))
+
+	var infe [][]byte
+	for i, it := range items {
+		infe = append(infe, fullBox("infe", 2, 0, u16(i+1), u16(0), []byte(it.typ), []byte{0}))
+	}
+	iinf := fullBox("iinf", 0, 0, append([][]byte{u16(len(items))}, infe...)...)
+
+	var ipma [][]byte
+	ipma = append(ipma, u32(len(items)))
+	for i, it := range items {
+		ipma = append(ipma, u16(i+1), []byte{byte(len(it.properties))})
+		for _, p := range it.properties {
+			ipma = append(ipma, []byte{byte(p)})
+		}
+	}
+	iprp := box("iprp", box("ipco", properties...), fullBox("ipma", 0, 0, ipma...))
+
+	var iref []byte
+	if len(dimg) > 0 {
+		refs := [][]byte{u16(1), u16(len(dimg))}
+		for _, id := range dimg {
+			refs = append(refs, u16(id))
+		}
+		iref = fullBox("iref", 0, 0, box("dimg", refs...))
+	}
+
+	// The iloc box references absolute offsets into the mdat box, so compute
+	// the final layout with placeholder offsets first.
+	buildMeta := func(mdatStart int) []byte {
+		entries := [][]byte{{0x44, 0x00}, u16(len(items))}
+		offset := mdatStart
+		for i, it := range items {
+			entries = append(entries, u16(i+1), u16(0), u16(1), u32(offset), u32(len(it.data)))
+			offset += len(it.data)
+		}
+		iloc := fullBox("iloc", 0, 0, entries...)
+		return fullBox("meta", 0, 0,
+			fullBox("hdlr", 0, 0, u32(0), []byte("pict"), make([]byte, 12), []byte{0}),
+			fullBox("pitm", 0, 0, u16(1)),
+			iinf, iloc, iprp, iref)
+	}
+	meta := buildMeta(0)
+	mdatStart := len(ftyp) + len(meta) + 8
+	meta = buildMeta(mdatStart)
+
+	var mdat [][]byte
+	for _, it := range items {
+		mdat = append(mdat, it.data)
+	}
+	return bytes.Join([][]byte{ftyp, meta, box("mdat", mdat...)}, nil)
+}
+
+func ispe(w, h int) []byte {
+	return fullBox("ispe", 0, 0, u32(w), u32(h))
+}
+
+func encodeTestJPEG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, &jpeg.Options{Quality: 100}))
+	return buf.Bytes()
+}
+
+func solidImage(w, h int, c color.Color) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(img, img.Rect, &image.Uniform{c}, image.Point{}, draw.Src)
+	return img
+}
+
+func requireColorNear(t *testing.T, expected color.Color, actual color.Color) {
+	t.Helper()
+	er, eg, eb, _ := expected.RGBA()
+	ar, ag, ab, _ := actual.RGBA()
+	near := func(a, b uint32) bool {
+		d := int(a>>8) - int(b>>8)
+		return d > -8 && d < 8
+	}
+	require.True(t, near(er, ar) && near(eg, ag) && near(eb, ab), "expected %v, got %v", expected, actual)
+}
+
+func TestDecodeHEIF(t *testing.T) {
+	red := color.RGBA{R: 255, A: 255}
+	blue := color.RGBA{B: 255, A: 255}
+
+	t.Run("single item", func(t *testing.T) {
+		data := buildHEIF(t, "heic",
+			[]testHEIFItem{{typ: "jpeg", data: encodeTestJPEG(t, solidImage(64, 32, red)), properties: []int{1}}},
+			[][]byte{ispe(64, 32)}, nil)
+
+		d, err := NewDecoder(DecoderOptions{})
+		require.NoError(t, err)
+
+		cfg, format, err := d.DecodeConfig(bytes.NewReader(data))
+		require.NoError(t, err)
+		require.Equal(t, "heic", format)
+		require.Equal(t, 64, cfg.Width)
+		require.Equal(t, 32, cfg.Height)
+
+		img, format, err := d.Decode(bytes.NewReader(data))
+		require.NoError(t, err)
+		require.Equal(t, "heic", format)
+		require.Equal(t, image.Rect(0, 0, 64, 32), img.Bounds())
+		requireColorNear(t, red, img.At(10, 10))
+	})
+
+	t.Run("rotated item", func(t *testing.T) {
+		src := image.NewRGBA(image.Rect(0, 0, 64, 32))
+		draw.Draw(src, src.Rect, &image.Uniform{red}, image.Point{}, draw.Src)
+		draw.Draw(src, image.Rect(32, 0, 64, 32), &image.Uniform{blue}, image.Point{}, draw.Src)
+
+		data := buildHEIF(t, "avif",
+			[]testHEIFItem{{typ: "jpeg", data: encodeTestJPEG(t, src), properties: []int{1, 2}}},
+			[][]byte{ispe(64, 32), box("irot", []byte{1})}, nil)
+
+		cfg, format, err := decodeImageConfig(bytes.NewReader(data))
+		require.NoError(t, err)
+		require.Equal(t, "avif", format)
+		require.Equal(t, 32, cfg.Width)
+		require.Equal(t, 64, cfg.Height)
+
+		img, _, err := decodeImage(bytes.NewReader(data))
+		require.NoError(t, err)
+		require.Equal(t, 32, img.Bounds().Dx())
+		require.Equal(t, 64, img.Bounds().Dy())
+		// A quarter turn anti-clockwise moves the right half to the top.
+		requireColorNear(t, blue, img.At(16, 8))
+		requireColorNear(t, red, img.At(16, 56))
+	})
+
+	t.Run("grid", func(t *testing.T) {
+		grid := []byte{0, 0, 0, 1}
+		grid = append(grid, u16(60)...)
+		grid = append(grid, u16(32)...)
+
+		data := buildHEIF(t, "heic",
+			[]testHEIFItem{
+				{typ: "grid", data: grid, properties: []int{1}},
+				{typ: "jpeg", data: encodeTestJPEG(t, solidImage(32, 32, red)), properties: []int{2}},
+				{typ: "jpeg", data: encodeTestJPEG(t, solidImage(32, 32, blue)), properties: []int{2}},
+			},
+			[][]byte{ispe(60, 32), ispe(32, 32)}, []int{2, 3})
+
+		img, _, err := decodeImage(bytes.NewReader(data))
+		require.NoError(t, err)
+		require.Equal(t, image.Rect(0, 0, 60, 32), img.Bounds())
+		requireColorNear(t, red, img.At(8, 8))
+		requireColorNear(t, blue, img.At(50, 8))
+	})
+
+	t.Run("unsupported codec", func(t *testing.T) {
+		data := buildHEIF(t, "heic",
+			[]testHEIFItem{{typ: "vvc1", data: []byte{0, 0, 0, 1}, properties: []int{1}}},
+			[][]byte{ispe(640, 480)}, nil)
+
+		cfg, _, err := decodeImageConfig(bytes.NewReader(data))
+		require.NoError(t, err)
+		require.Equal(t, 640, cfg.Width)
+
+		_, _, err = decodeImage(bytes.NewReader(data))
+		require.ErrorIs(t, err, ErrUnsupportedHEIFCodec)
+	})
+
+	t.Run("registered codec", func(t *testing.T) {
+		RegisterHEIFCodec("test", func(config, data []byte) (image.Image, error) {
+			return solidImage(int(data[0]), int(data[1]), blue), nil
+		})
+		defer func() {
+			heifCodecsMut.Lock()
+			delete(heifCodecs, "test")
+			heifCodecsMut.Unlock()
+		}()
+
+		data := buildHEIF(t, "heic",
+			[]testHEIFItem{{typ: "test", data: []byte{12, 7}, properties: []int{1, 2}}},
+			[][]byte{ispe(12, 7), box("imir", []byte{0})}, nil)
+
+		img, _, err := decodeImage(bytes.NewReader(data))
+		require.NoError(t, err)
+		require.Equal(t, image.Rect(0, 0, 12, 7), img.Bounds())
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		data := buildHEIF(t, "heic",
+			[]testHEIFItem{{typ: "jpeg", data: encodeTestJPEG(t, solidImage(8, 8, red)), properties: []int{1}}},
+			[][]byte{ispe(8, 8)}, nil)
+
+		_, _, err := decodeImage(bytes.NewReader(data[:40]))
+		require.Error(t, err)
+	})
+
+	for _, format := range []string{"heic", "avif"} {
+		t.Run(format+" file", func(t *testing.T) {
+			imgDir, ok := fileutils.FindDir("tests")
+			require.True(t, ok)
+			data, err := os.ReadFile(filepath.Join(imgDir, "test."+format))
+			require.NoError(t, err)
+
+			cfg, decodedFormat, err := decodeImageConfig(bytes.NewReader(data))
+			require.NoError(t, err)
+			require.Equal(t, format, decodedFormat)
+
+			img, decodedFormat, err := decodeImage(bytes.NewReader(data))
+			require.NoError(t, err)
+			require.Equal(t, format, decodedFormat)
+			require.Equal(t, image.Rect(0, 0, cfg.Width, cfg.Height), img.Bounds())
+		})
+	}
+}
+
+func TestHEIFMimeTypes(t *testing.T) {
+	// The system MIME table may already know these extensions, under a
+	// slightly different type, in which case it is kept.
+	for _, ext := range []string{".heic", ".heif", ".avif"} {
+		require.True(t, strings.HasPrefix(mime.TypeByExtension(ext), "image/"), ext)
+	}
+}
@@ -99,7 +99,9 @@ func (f *fwSeeker) Seek(offset int64, whence int) (int64, error) {
 }
 
 // GetImageOrientation reads the input data and returns the EXIF encoded
-// image orientation. Supported formats are JPEG, PNG, TIFF, and WebP.
+// image orientation. Supported formats are JPEG, PNG, TIFF, and WebP. HEIF
+// based formats are always reported as upright since their transformations
+// are applied while decoding.
 // Passing an io.ReadSeeker is preferable as we can't guarantee a plain
 // io.Reader will work for all formats (e.g. TIFF requires backwards seeking).
 func GetImageOrientation(input io.Reader, format string) (int, error) {
@@ -118,6 +120,10 @@ func GetImageOrientation(input io.Reader, format string) (int, error) {
 		imgFormat = imagemeta.TIFF
 	case "webp":
 		imgFormat = imagemeta.WebP
+	case "heic", "heif", "avif":
+		// HEIF images are rotated and mirrored by the decoder using the
+		// container properties, so they are always upright once decoded.
+		return orientation, nil
 	default:
 		// We don't support EXIF on any other format.
 		return orientation, fmt.Errorf("unsupported image format: %s", format)
@@ -0,0 +1,30 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package imaging
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"io"
+
+	"github.com/gen2brain/webp"
+)
+
+// webpMaxDimension is the largest width or height of a WebP image.
+const webpMaxDimension = 16383
+
+// encodeWebP writes img to w as a lossy WebP image of the given quality,
+// in the range [0,100].
+func encodeWebP(w io.Writer, img image.Image, quality int) error {
+	b := img.Bounds()
+	if b.Dx() <= 0 || b.Dy() <= 0 {
+		return errors.New("invalid image dimensions")
+	}
+	if b.Dx() > webpMaxDimension || b.Dy() > webpMaxDimension {
+		return fmt.Errorf("image dimensions %dx%d exceed the WebP limit of %d", b.Dx(), b.Dy(), webpMaxDimension)
+	}
+
+	return webp.Encode(w, img, webp.Options{Quality: quality, Method: webp.DefaultMethod})
+}
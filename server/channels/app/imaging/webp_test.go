@@ -0,0 +1,79 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/webp"
+
+	"github.com/mattermost/mattermost/server/v8/channels/utils/fileutils"
+)
+
+func TestEncodeWebP(t *testing.T) {
+	e, err := NewEncoder(EncoderOptions{})
+	require.NoError(t, err)
+
+	t.Run("solid image", func(t *testing.T) {
+		img := image.NewNRGBA(image.Rect(0, 0, 300, 200))
+		draw.Draw(img, img.Rect, &image.Uniform{color.NRGBA{R: 10, G: 200, B: 30, A: 255}}, image.Point{}, draw.Src)
+
+		var buf bytes.Buffer
+		require.NoError(t, e.EncodeWebP(&buf, img, 90))
+
+		decoded, err := webp.Decode(&buf)
+		require.NoError(t, err)
+		require.Equal(t, img.Bounds().Size(), decoded.Bounds().Size())
+
+		r, g, b, _ := decoded.At(150, 100).RGBA()
+		assert.InDelta(t, 10, r>>8, 16)
+		assert.InDelta(t, 200, g>>8, 16)
+		assert.InDelta(t, 30, b>>8, 16)
+	})
+
+	t.Run("photo is smaller than lossless", func(t *testing.T) {
+		imgDir, ok := fileutils.FindDir("tests")
+		require.True(t, ok)
+
+		data, err := os.ReadFile(imgDir + "/testjpg.jpg")
+		require.NoError(t, err)
+		img, _, err := image.Decode(bytes.NewReader(data))
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, e.EncodeWebP(&buf, img, 90))
+		var pngBuf bytes.Buffer
+		require.NoError(t, png.Encode(&pngBuf, img))
+		assert.Less(t, buf.Len(), pngBuf.Len())
+
+		decoded, err := webp.Decode(&buf)
+		require.NoError(t, err)
+		require.Equal(t, img.Bounds().Size(), decoded.Bounds().Size())
+	})
+
+	t.Run("sub image", func(t *testing.T) {
+		img := image.NewRGBA(image.Rect(0, 0, 40, 40))
+		sub := img.SubImage(image.Rect(5, 7, 31, 29))
+
+		var buf bytes.Buffer
+		require.NoError(t, e.EncodeWebP(&buf, sub, 90))
+
+		decoded, err := webp.Decode(&buf)
+		require.NoError(t, err)
+		require.Equal(t, sub.Bounds().Size(), decoded.Bounds().Size())
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		img := image.NewNRGBA(image.Rect(0, 0, webpMaxDimension+1, 1))
+		require.Error(t, e.EncodeWebP(&bytes.Buffer{}, img, 90))
+	})
+}
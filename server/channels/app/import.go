@@ -19,6 +19,7 @@ import (
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 	"github.com/mattermost/mattermost/server/public/shared/request"
 	"github.com/mattermost/mattermost/server/v8/channels/app/imports"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/filestore"
 )
 
 type ReactionImportData = imports.ReactionImportData // part of the app interface
@@ -207,18 +208,57 @@ func (a *App) bulkImportWorker(rctx request.CTX, dryRun, extractContent bool, wg
 }
 
 func (a *App) BulkImport(rctx request.CTX, jsonlReader io.Reader, attachmentsReader *zip.Reader, dryRun bool, workers int) (int, *model.AppError) {
-	return a.bulkImport(rctx, jsonlReader, attachmentsReader, dryRun, true, workers, "")
+	return a.bulkImport(rctx, jsonlReader, attachmentsReader, imports.BulkImportOpts{
+		DryRun:         dryRun,
+		ExtractContent: true,
+		Workers:        workers,
+	})
 }
 
 func (a *App) BulkImportWithPath(rctx request.CTX, jsonlReader io.Reader, attachmentsReader *zip.Reader, dryRun, extractContent bool, workers int, importPath string) (int, *model.AppError) {
-	return a.bulkImport(rctx, jsonlReader, attachmentsReader, dryRun, extractContent, workers, importPath)
+	return a.bulkImport(rctx, jsonlReader, attachmentsReader, imports.BulkImportOpts{
+		DryRun:         dryRun,
+		ExtractContent: extractContent,
+		Workers:        workers,
+		ImportPath:     importPath,
+	})
+}
+
+// BulkImportWithOpts runs a bulk import that can be resumed from its
+// checkpoints, or that reports all the failing lines.
+func (a *App) BulkImportWithOpts(rctx request.CTX, jsonlReader io.Reader, attachmentsReader *zip.Reader, opts imports.BulkImportOpts) (int, *model.AppError) {
+	return a.bulkImport(rctx, jsonlReader, attachmentsReader, opts)
+}
+
+// importErrorReporter writes the errors of an import to its error report.
+type importErrorReporter struct {
+	rctx      request.CTX
+	encoder   *json.Encoder
+	count     int
+	firstLine int
+}
+
+func (r *importErrorReporter) report(err imports.LineImportWorkerError) {
+	if r.count == 0 || err.LineNumber < r.firstLine {
+		r.firstLine = err.LineNumber
+	}
+	r.count++
+
+	line := imports.ImportErrorReportLine{
+		LineNumber: err.LineNumber,
+		ErrorId:    err.Error.Id,
+		Error:      err.Error.Error(),
+	}
+	if encodeErr := r.encoder.Encode(line); encodeErr != nil {
+		r.rctx.Logger().Warn("Failed to write to the import error report", mlog.Int("line_number", err.LineNumber), mlog.Err(encodeErr))
+	}
 }
 
 // bulkImport will extract attachments from attachmentsReader if it is
 // not nil. If it is nil, it will look for attachments on the
 // filesystem in the locations specified by the JSONL file according
 // to the older behavior
-func (a *App) bulkImport(rctx request.CTX, jsonlReader io.Reader, attachmentsReader *zip.Reader, dryRun, extractContent bool, workers int, importPath string) (int, *model.AppError) {
+func (a *App) bulkImport(rctx request.CTX, jsonlReader io.Reader, attachmentsReader *zip.Reader, opts imports.BulkImportOpts) (int, *model.AppError) {
 	scanner := bufio.NewScanner(jsonlReader)
 	buf := make([]byte, 0, 64*1024)
 	scanner.Buffer(buf, maxScanTokenSize)
@@ -228,10 +268,11 @@ func (a *App) bulkImport(rctx request.CTX, jsonlReader io.Reader, attachmentsRea
 	a.Srv().Store().LockToMaster()
 	defer a.Srv().Store().UnlockFromMaster()
 
-	errorsChan := make(chan imports.LineImportWorkerError, (2*workers)+1) // size chosen to ensure it never gets filled up completely.
+	errorsChan := make(chan imports.LineImportWorkerError, (2*opts.Workers)+1) // size chosen to ensure it never gets filled up completely.
 	var wg sync.WaitGroup
 	var linesChan chan imports.LineImportWorkerData
 	lastLineType := ""
+	lastCheckpoint := opts.StartLine
 
 	var attachedFiles map[string]*zip.File
 	if attachmentsReader != nil {
@@ -241,18 +282,88 @@ func (a *App) bulkImport(rctx request.CTX, jsonlReader io.Reader, attachmentsRea
 		}
 	}
 
+	var reporter *importErrorReporter
+	if opts.ErrorReport != nil {
+		reporter = &importErrorReporter{rctx: rctx, encoder: json.NewEncoder(opts.ErrorReport)}
+	}
+
+	// mustStop returns whether the import has to stop because of err.
+	mustStop := func(err imports.LineImportWorkerError) bool {
+		if reporter != nil {
+			reporter.report(err)
+			return false
+		}
+		return stopOnError(rctx, err)
+	}
+
+	startWorkers := func() {
+		linesChan = make(chan imports.LineImportWorkerData, opts.Workers)
+		for range opts.Workers {
+			wg.Add(1)
+			go a.bulkImportWorker(rctx, opts.DryRun, opts.ExtractContent, &wg, linesChan, errorsChan)
+		}
+	}
+
+	// stopWorkers clears out the worker queue, returning the first error that
+	// occurred meanwhile and has to stop the import.
+	stopWorkers := func() *imports.LineImportWorkerError {
+		close(linesChan)
+		linesChan = nil
+
+		done := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+
+		var stopErr *imports.LineImportWorkerError
+		for {
+			select {
+			case err := <-errorsChan:
+				if mustStop(err) && stopErr == nil {
+					stopErr = &err
+				}
+			case <-done:
+				for len(errorsChan) != 0 {
+					if err := <-errorsChan; mustStop(err) && stopErr == nil {
+						stopErr = &err
+					}
+				}
+				return stopErr
+			}
+		}
+	}
+
+	checkpoint := func(line int, completedPhase string) {
+		lastCheckpoint = line
+		if opts.Checkpoint != nil {
+			opts.Checkpoint(line, completedPhase)
+		}
+	}
+
 	for scanner.Scan() {
 		lineNumber++
 		if lineNumber%statusUpdateAfterLines == 0 {
 			rctx.Logger().Info("Reader progress", mlog.Int("processed_lines", lineNumber))
 		}
 
+		// Lines imported by a previous run are skipped, but the version line
+		// is always checked.
+		if lineNumber > 1 && lineNumber <= opts.StartLine {
+			continue
+		}
+
 		var line imports.LineImportData
 		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
-			return lineNumber, model.NewAppError("BulkImport", "app.import.bulk_import.json_decode.error", nil, "", http.StatusBadRequest).Wrap(err)
+			appErr := model.NewAppError("BulkImport", "app.import.bulk_import.json_decode.error", nil, "", http.StatusBadRequest).Wrap(err)
+			if reporter == nil || lineNumber == 1 {
+				return lineNumber, appErr
+			}
+			reporter.report(imports.LineImportWorkerError{Error: appErr, LineNumber: lineNumber})
+			continue
 		}
 
-		if err := processAttachments(rctx, &line, importPath, attachedFiles); err != nil {
+		if err := processAttachments(rctx, &line, opts.ImportPath, attachedFiles); err != nil {
 			rctx.Logger().Warn("Error while processing import attachments. Objects might be broken.", mlog.Err(err))
 		}
 
@@ -271,7 +382,7 @@ func (a *App) bulkImport(rctx request.CTX, jsonlReader io.Reader, attachmentsRea
 
 		if line.Type != lastLineType {
 			// Only clear the worker queue if is not the first data entry
-			if lineNumber != 2 {
+			if linesChan != nil {
 				rctx.Logger().Info(
 					"Finished parsing segment, waiting for workers to finish",
 					mlog.String("old_segment", lastLineType),
@@ -279,63 +390,68 @@ func (a *App) bulkImport(rctx request.CTX, jsonlReader io.Reader, attachmentsRea
 				)
 
 				// Changing type. Clear out the worker queue before continuing.
-				close(linesChan)
-				wg.Wait()
-
-				// Check no errors occurred while waiting for the queue to empty.
-				if len(errorsChan) != 0 {
-					err := <-errorsChan
-					if stopOnError(rctx, err) {
-						return err.LineNumber, err.Error
-					}
+				if err := stopWorkers(); err != nil {
+					return err.LineNumber, err.Error
 				}
+				checkpoint(lineNumber-1, lastLineType)
 			}
 
 			rctx.Logger().Info(
 				"Starting workers for new segment",
 				mlog.String("old_segment", lastLineType),
 				mlog.String("new_segment", line.Type),
-				mlog.Int("workers", workers),
+				mlog.Int("workers", opts.Workers),
 			)
 
 			// Set up the workers and channel for this type.
 			lastLineType = line.Type
-			linesChan = make(chan imports.LineImportWorkerData, workers)
-			for range workers {
-				wg.Add(1)
-				go a.bulkImportWorker(rctx, dryRun, extractContent, &wg, linesChan, errorsChan)
+			startWorkers()
+		}
+
+		workerData := imports.LineImportWorkerData{LineImportData: line, LineNumber: lineNumber}
+	send:
+		for {
+			select {
+			case linesChan <- workerData:
+				break send
+			case err := <-errorsChan:
+				if mustStop(err) {
+					stopWorkers()
+					return err.LineNumber, err.Error
+				}
 			}
 		}
 
-		select {
-		case linesChan <- imports.LineImportWorkerData{LineImportData: line, LineNumber: lineNumber}:
-		case err := <-errorsChan:
-			if stopOnError(rctx, err) {
-				close(linesChan)
-				wg.Wait()
+		if opts.CheckpointInterval > 0 && lineNumber-lastCheckpoint >= opts.CheckpointInterval {
+			if err := stopWorkers(); err != nil {
 				return err.LineNumber, err.Error
 			}
+			checkpoint(lineNumber, "")
+			startWorkers()
 		}
 	}
 
 	// No more lines. Clear out the worker queue before continuing.
+	completedPhase := ""
 	if linesChan != nil {
-		close(linesChan)
-	}
-	wg.Wait()
-
-	// Check no errors occurred while waiting for the queue to empty.
-	if len(errorsChan) != 0 {
-		err := <-errorsChan
-		if stopOnError(rctx, err) {
+		if err := stopWorkers(); err != nil {
 			return err.LineNumber, err.Error
 		}
+		completedPhase = lastLineType
 	}
 
 	if err := scanner.Err(); err != nil {
 		return 0, model.NewAppError("BulkImport", "app.import.bulk_import.file_scan.error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
 
+	if completedPhase != "" {
+		checkpoint(lineNumber, completedPhase)
+	}
+
+	if reporter != nil && reporter.count > 0 {
+		return reporter.firstLine, model.NewAppError("BulkImport", "app.import.bulk_import.invalid_lines.error", map[string]any{"Count": reporter.count}, "", http.StatusBadRequest)
+	}
+
 	return 0, nil
 }
 
@@ -389,6 +505,11 @@ func (a *App) importLine(rctx request.CTX, line imports.LineImportData, dryRun b
 			return model.NewAppError("BulkImport", "app.import.import_line.null_emoji.error", nil, "", http.StatusBadRequest)
 		}
 		return a.importEmoji(rctx, line.Emoji, dryRun)
+	case line.Type == "delete":
+		if line.Delete == nil {
+			return model.NewAppError("BulkImport", "app.import.import_line.null_delete.error", nil, "", http.StatusBadRequest)
+		}
+		return a.importDelete(rctx, line.Delete, dryRun)
 	default:
 		return model.NewAppError("BulkImport", "app.import.import_line.unknown_line_type.error", map[string]any{"Type": line.Type}, "", http.StatusBadRequest)
 	}
@@ -422,3 +543,17 @@ func (a *App) DeleteImport(name string) *model.AppError {
 
 	return a.RemoveFile(filePath)
 }
+
+// ImportErrorReportReader returns the error report of the validation of an
+// import file.
+func (a *App) ImportErrorReportReader(importName string) (filestore.ReadCloseSeeker, *model.AppError) {
+	filePath := filepath.Join(*a.Config().ImportSettings.Directory, imports.ErrorReportFileName(importName))
+
+	if ok, err := a.FileExists(filePath); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, model.NewAppError("ImportErrorReportReader", "app.import.error_report.not_found.app_error", nil, "", http.StatusNotFound)
+	}
+
+	return a.FileReader(filePath)
+}
@@ -315,7 +315,7 @@ func (a *App) importChannel(rctx request.CTX, data *imports.ChannelImportData, d
 
 	var chErr *model.AppError
 	if channel.Id == "" {
-		if _, chErr = a.CreateChannel(rctx, channel, false); chErr != nil {
+		if channel, chErr = a.CreateChannel(rctx, channel, false); chErr != nil {
 			return chErr
 		}
 	} else {
@@ -1361,6 +1361,80 @@ func (a *App) importReaction(data *imports.ReactionImportData, post *model.Post)
 	return nil
 }
 
+// importPoll creates the poll of an imported post with its votes, and renders
+// the post for it. The poll of a post imported again is left untouched.
+func (a *App) importPoll(rctx request.CTX, data *imports.PollImportData, post *model.Post) *model.AppError {
+	if err := imports.ValidatePollImportData(data); err != nil {
+		return err
+	}
+
+	if pollID, _ := post.GetProp(model.PostPropsPollId).(string); pollID != "" {
+		if existing, err := a.Srv().Store().Poll().Get(pollID); err == nil && existing.PostId == post.Id {
+			return nil
+		}
+	}
+
+	poll := &model.Poll{
+		CreateAt:       post.CreateAt,
+		CreatorId:      post.UserId,
+		ChannelId:      post.ChannelId,
+		PostId:         post.Id,
+		Question:       *data.Question,
+		Anonymous:      model.SafeDereference(data.Anonymous),
+		MultipleChoice: model.SafeDereference(data.MultipleChoice),
+		CloseAt:        model.SafeDereference(data.CloseAt),
+		ClosedAt:       model.SafeDereference(data.ClosedAt),
+	}
+	for _, option := range *data.Options {
+		poll.Options = append(poll.Options, &model.PollOption{Text: *option.Text})
+	}
+
+	poll, err := a.Srv().Store().Poll().Save(poll)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return appErr
+		default:
+			return model.NewAppError("importPoll", "app.poll.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	for i, option := range *data.Options {
+		if option.Voters == nil {
+			continue
+		}
+		for _, username := range *option.Voters {
+			user, nErr := a.Srv().Store().User().GetByUsername(username)
+			if nErr != nil {
+				return model.NewAppError("BulkImport", "app.import.import_post.user_not_found.error", map[string]any{"Username": username}, "", http.StatusBadRequest).Wrap(nErr)
+			}
+
+			if nErr = a.Srv().Store().Poll().SaveVote(&model.PollVote{
+				PollId:   poll.Id,
+				OptionId: poll.Options[i].Id,
+				UserId:   user.Id,
+				CreateAt: post.CreateAt,
+			}, false); nErr != nil {
+				return model.NewAppError("importPoll", "app.poll.vote.app_error", nil, "", http.StatusInternalServerError).Wrap(nErr)
+			}
+		}
+	}
+
+	results, appErr := a.GetPollResults(poll)
+	if appErr != nil {
+		return appErr
+	}
+	if appErr = a.renderPollPost(post, poll, results); appErr != nil {
+		return appErr
+	}
+	if _, err = a.Srv().Store().Post().Overwrite(rctx, post); err != nil {
+		return model.NewAppError("importPoll", "app.post.overwrite.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
 func (a *App) importReplies(rctx request.CTX, data []imports.ReplyImportData, post *model.Post, teamID string, extractContent bool) *model.AppError {
 	var err *model.AppError
 	usernames := []string{}
@@ -1401,13 +1475,13 @@ func (a *App) importReplies(rctx request.CTX, data []imports.ReplyImportData, po
 			return model.NewAppError("importReplies", "app.post.get_posts_created_at.app_error", nil, "", http.StatusInternalServerError).Wrap(nErr)
 		}
 
-		var reply *model.Post
+		threadReplies := make([]*model.Post, 0, len(replies))
 		for _, r := range replies {
-			if r.Message == *replyData.Message && r.RootId == post.Id {
-				reply = r
-				break
+			if r.RootId == post.Id {
+				threadReplies = append(threadReplies, r)
 			}
 		}
+		reply := findImportedPost(threadReplies, *replyData.Message, user.Id, replyData.EditAt != nil && *replyData.EditAt > 0)
 
 		if reply == nil {
 			reply = &model.Post{}
@@ -1775,6 +1849,28 @@ func getPostStrID(post *model.Post) string {
 
 // importMultiplePostLines will return an error and the line that
 // caused it whenever possible
+// findImportedPost returns the post, among those created at the same time, that an imported
+// post matches: the one with the same message or, when the post was edited since it was
+// imported, the only one of the same user.
+func findImportedPost(posts []*model.Post, message, userID string, edited bool) *model.Post {
+	var candidate *model.Post
+	candidates := 0
+	for _, p := range posts {
+		if p.Message == message {
+			return p
+		}
+		if edited && p.UserId == userID {
+			candidate = p
+			candidates++
+		}
+	}
+
+	if candidates == 1 {
+		return candidate
+	}
+	return nil
+}
+
 func (a *App) importMultiplePostLines(rctx request.CTX, lines []imports.LineImportWorkerData, dryRun, extractContent bool) (int, *model.AppError) {
 	if len(lines) == 0 {
 		return 0, nil
@@ -1843,13 +1939,7 @@ func (a *App) importMultiplePostLines(rctx request.CTX, lines []imports.LineImpo
 			return line.LineNumber, model.NewAppError("importMultiplePostLines", "app.post.get_posts_created_at.app_error", nil, "", http.StatusInternalServerError).Wrap(nErr)
 		}
 
-		var post *model.Post
-		for _, p := range posts {
-			if p.Message == *line.Post.Message {
-				post = p
-				break
-			}
-		}
+		post := findImportedPost(posts, *line.Post.Message, user.Id, line.Post.EditAt != nil && *line.Post.EditAt > 0)
 
 		if post == nil {
 			post = &model.Post{}
@@ -2014,6 +2104,12 @@ func (a *App) importMultiplePostLines(rctx request.CTX, lines []imports.LineImpo
 			}
 		}
 
+		if postWithData.postData.Poll != nil {
+			if err := a.importPoll(rctx, postWithData.postData.Poll, postWithData.post); err != nil {
+				return postWithData.lineNumber, err
+			}
+		}
+
 		if postWithData.postData.Replies != nil && len(*postWithData.postData.Replies) > 0 {
 			err := a.importReplies(rctx, *postWithData.postData.Replies, postWithData.post, postWithData.team.Id, extractContent)
 			if err != nil {
@@ -2363,13 +2459,7 @@ func (a *App) importMultipleDirectPostLines(rctx request.CTX, lines []imports.Li
 			return line.LineNumber, model.NewAppError("BulkImport", "app.post.get_posts_created_at.app_error", nil, "", http.StatusInternalServerError).Wrap(nErr)
 		}
 
-		var post *model.Post
-		for _, p := range posts {
-			if p.Message == *line.DirectPost.Message {
-				post = p
-				break
-			}
-		}
+		post := findImportedPost(posts, *line.DirectPost.Message, user.Id, line.DirectPost.EditAt != nil && *line.DirectPost.EditAt > 0)
 
 		if post == nil {
 			post = &model.Post{}
@@ -2522,6 +2612,12 @@ func (a *App) importMultipleDirectPostLines(rctx request.CTX, lines []imports.Li
 			}
 		}
 
+		if postWithData.directPostData.Poll != nil {
+			if err := a.importPoll(rctx, postWithData.directPostData.Poll, postWithData.post); err != nil {
+				return postWithData.lineNumber, err
+			}
+		}
+
 		if postWithData.directPostData.Replies != nil {
 			if err := a.importReplies(rctx, *postWithData.directPostData.Replies, postWithData.post, "noteam", extractContent); err != nil {
 				return postWithData.lineNumber, err
@@ -2604,6 +2700,174 @@ func (a *App) importEmoji(rctx request.CTX, data *imports.EmojiImportData, dryRu
 	return nil
 }
 
+// importDelete applies a tombstone line. Entities that don't exist, or are already deleted, are
+// skipped so that an incremental export can be imported more than once.
+func (a *App) importDelete(rctx request.CTX, data *imports.DeleteImportData, dryRun bool) *model.AppError {
+	var fields []mlog.Field
+	if data != nil && data.Entity != nil {
+		fields = append(fields, mlog.String("entity", *data.Entity))
+	}
+	rctx.Logger().Info("Validating tombstone", fields...)
+
+	if err := imports.ValidateDeleteImportData(data); err != nil {
+		return err
+	}
+
+	// If this is a Dry Run, do not continue any further.
+	if dryRun {
+		return nil
+	}
+
+	rctx.Logger().Info("Importing tombstone", fields...)
+
+	var team *model.Team
+	if data.Team != nil {
+		var err error
+		team, err = a.Srv().Store().Team().GetByName(strings.ToLower(*data.Team))
+		if err != nil {
+			var nfErr *store.ErrNotFound
+			if errors.As(err, &nfErr) {
+				return nil
+			}
+			return model.NewAppError("BulkImport", "app.import.import_delete.team_not_found.error", map[string]any{"TeamName": *data.Team}, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	var channel *model.Channel
+	if data.Channel != nil {
+		var err error
+		channel, err = a.Srv().Store().Channel().GetByNameIncludeDeleted(team.Id, strings.ToLower(*data.Channel), true)
+		if err != nil {
+			var nfErr *store.ErrNotFound
+			if errors.As(err, &nfErr) {
+				return nil
+			}
+			return model.NewAppError("BulkImport", "app.import.import_delete.channel_not_found.error", map[string]any{"ChannelName": *data.Channel}, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	var user *model.User
+	if data.User != nil {
+		var err error
+		user, err = a.Srv().Store().User().GetByUsername(strings.ToLower(*data.User))
+		if err != nil {
+			var nfErr *store.ErrNotFound
+			if errors.As(err, &nfErr) {
+				return nil
+			}
+			return model.NewAppError("BulkImport", "app.import.import_delete.user_not_found.error", map[string]any{"Username": *data.User}, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	switch *data.Entity {
+	case imports.DeleteEntityTeam:
+		if team.DeleteAt != 0 {
+			return nil
+		}
+		team.DeleteAt = *data.DeleteAt
+		if _, err := a.Srv().Store().Team().Update(team); err != nil {
+			return model.NewAppError("BulkImport", "app.import.import_delete.deleting.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	case imports.DeleteEntityChannel:
+		if channel.DeleteAt != 0 {
+			return nil
+		}
+		if err := a.Srv().Store().Channel().Delete(channel.Id, *data.DeleteAt); err != nil {
+			return model.NewAppError("BulkImport", "app.import.import_delete.deleting.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	case imports.DeleteEntityTeamMember:
+		member, err := a.Srv().Store().Team().GetMember(rctx, team.Id, user.Id)
+		if err != nil {
+			var nfErr *store.ErrNotFound
+			if errors.As(err, &nfErr) {
+				return nil
+			}
+			return model.NewAppError("BulkImport", "app.team.get_member.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		if member.DeleteAt != 0 {
+			return nil
+		}
+		member.DeleteAt = *data.DeleteAt
+		if _, err := a.Srv().Store().Team().UpdateMember(rctx, member); err != nil {
+			return model.NewAppError("BulkImport", "app.import.import_delete.deleting.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	case imports.DeleteEntityChannelMember:
+		if _, err := a.Srv().Store().Channel().GetMember(rctx.Context(), channel.Id, user.Id); err != nil {
+			var nfErr *store.ErrNotFound
+			if errors.As(err, &nfErr) {
+				return nil
+			}
+			return model.NewAppError("BulkImport", "app.channel.get_member.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		if err := a.Srv().Store().Channel().RemoveMember(rctx, channel.Id, user.Id); err != nil {
+			return model.NewAppError("BulkImport", "app.import.import_delete.deleting.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		if err := a.Srv().Store().ChannelMemberHistory().LogLeaveEvent(user.Id, channel.Id, *data.DeleteAt); err != nil {
+			return model.NewAppError("BulkImport", "app.channel_member_history.log_leave_event.internal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	case imports.DeleteEntityPost, imports.DeleteEntityDirectPost:
+		if *data.Entity == imports.DeleteEntityDirectPost {
+			var appErr *model.AppError
+			if channel, appErr = a.getDirectChannelForImport(*data.ChannelMembers); appErr != nil || channel == nil {
+				return appErr
+			}
+		}
+
+		posts, err := a.Srv().Store().Post().GetPostsCreatedAt(channel.Id, *data.CreateAt)
+		if err != nil {
+			return model.NewAppError("BulkImport", "app.post.get_posts_created_at.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		for _, post := range posts {
+			if post.UserId != user.Id || post.DeleteAt != 0 {
+				continue
+			}
+			if err := a.Srv().Store().Post().Delete(rctx, post.Id, *data.DeleteAt, ""); err != nil {
+				return model.NewAppError("BulkImport", "app.import.import_delete.deleting.error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// getDirectChannelForImport returns the direct or group channel of the users with the given
+// usernames, or nil if some of them or the channel don't exist.
+func (a *App) getDirectChannelForImport(usernames []string) (*model.Channel, *model.AppError) {
+	users, err := a.Srv().Store().User().GetProfilesByUsernames(usernames, nil)
+	if err != nil {
+		return nil, model.NewAppError("BulkImport", "app.user.get_profiles.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	if len(users) != len(utils.RemoveDuplicatesFromStringArray(usernames)) {
+		return nil, nil
+	}
+
+	userIDs := make([]string, 0, len(users))
+	for _, user := range users {
+		userIDs = append(userIDs, user.Id)
+	}
+
+	var name string
+	switch len(userIDs) {
+	case 1:
+		name = model.GetDMNameFromIds(userIDs[0], userIDs[0])
+	case 2:
+		name = model.GetDMNameFromIds(userIDs[0], userIDs[1])
+	default:
+		name = model.GetGroupNameFromUserIds(userIDs)
+	}
+
+	channel, err := a.Srv().Store().Channel().GetByNameIncludeDeleted("", name, true)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return nil, nil
+		}
+		return nil, model.NewAppError("BulkImport", "app.channel.get_by_name.existing.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return channel, nil
+}
+
 func (a *App) extractThreadMembers(line *imports.LineImportWorkerData, users map[string]*model.User, post *model.Post) ([]*model.ThreadMembership, int, *model.AppError) {
 	threadMemberships := []*model.ThreadMembership{}
 
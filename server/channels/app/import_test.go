@@ -5,6 +5,8 @@ package app
 
 import (
 	"archive/zip"
+	"bytes"
+	"encoding/json"
 	"io"
 	"net/http"
 	"os"
@@ -605,6 +607,97 @@ func TestProcessAttachments(t *testing.T) {
 	})
 }
 
+func TestImportBulkImportWithOpts(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t)
+	defer th.TearDown()
+
+	teamName := model.NewRandomTeamName()
+	channelName := model.NewId()
+	username := model.NewUsername()
+
+	data := `{"type": "version", "version": 1}
+{"type": "team", "team": {"type": "O", "display_name": "lskmw2d7a5ao7ppwqh5ljchvr4", "name": "` + teamName + `"}}
+{"type": "channel", "channel": {"type": "O", "display_name": "xr6m6udffngark2uekvr3hoeny", "team": "` + teamName + `", "name": "` + channelName + `"}}
+{"type": "user", "user": {"username": "` + username + `", "email": "` + username + `@example.com", "teams": [{"name": "` + teamName + `", "channels": [{"name": "` + channelName + `"}]}]}}
+{"type": "post", "post": {"team": "` + teamName + `", "channel": "` + channelName + `", "user": "` + username + `", "message": "Hello", "create_at": 123456789012}}
+{"type": "post", "post": {"team": "` + teamName + `", "channel": "` + channelName + `", "user": "` + username + `", "message": "World", "create_at": 123456789013}}
+{"type": "post", "post": {"team": "` + teamName + `", "channel": "` + channelName + `", "user": "` + username + `", "message": "Again", "create_at": 123456789014}}`
+
+	type checkpoint struct {
+		line  int
+		phase string
+	}
+
+	t.Run("checkpoints", func(t *testing.T) {
+		var checkpoints []checkpoint
+		line, appErr := th.App.BulkImportWithOpts(th.Context, strings.NewReader(data), nil, imports.BulkImportOpts{
+			Workers:            2,
+			CheckpointInterval: 2,
+			Checkpoint: func(line int, completedPhase string) {
+				checkpoints = append(checkpoints, checkpoint{line, completedPhase})
+			},
+		})
+		require.Nil(t, appErr)
+		require.Equal(t, 0, line)
+		assert.Equal(t, []checkpoint{
+			{2, ""},
+			{2, "team"},
+			{3, "channel"},
+			{4, "user"},
+			{6, ""},
+			{7, "post"},
+		}, checkpoints)
+	})
+
+	t.Run("resume from checkpoint", func(t *testing.T) {
+		// Lines up to the checkpoint are skipped, broken or not.
+		broken := strings.Replace(data, `"display_name": "lskmw2d7a5ao7ppwqh5ljchvr4"`, `"display_name": ""`, 1)
+
+		var checkpoints []checkpoint
+		line, appErr := th.App.BulkImportWithOpts(th.Context, strings.NewReader(broken), nil, imports.BulkImportOpts{
+			Workers:   2,
+			StartLine: 5,
+			Checkpoint: func(line int, completedPhase string) {
+				checkpoints = append(checkpoints, checkpoint{line, completedPhase})
+			},
+		})
+		require.Nil(t, appErr)
+		require.Equal(t, 0, line)
+		assert.Equal(t, []checkpoint{{7, "post"}}, checkpoints)
+
+		_, appErr = th.App.BulkImportWithOpts(th.Context, strings.NewReader(`{"type": "team"}`), nil, imports.BulkImportOpts{Workers: 2, StartLine: 5})
+		require.NotNil(t, appErr, "the version line should still be checked")
+	})
+
+	t.Run("error report", func(t *testing.T) {
+		invalid := data + `
+{"type": "post", "post": {"team": "` + teamName + `", "channel": "` + channelName + `", "user": "` + username + `", "create_at": 123456789015}}
+{"type": "post", "post":
+{"type": "direct_channel", "direct_channel": {"members": ["` + username + `"]}}`
+
+		var report bytes.Buffer
+		line, appErr := th.App.BulkImportWithOpts(th.Context, strings.NewReader(invalid), nil, imports.BulkImportOpts{
+			DryRun:      true,
+			Workers:     2,
+			ErrorReport: &report,
+		})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.import.bulk_import.invalid_lines.error", appErr.Id)
+		assert.Equal(t, 8, line)
+
+		var reported []int
+		decoder := json.NewDecoder(&report)
+		for decoder.More() {
+			var reportLine imports.ImportErrorReportLine
+			require.NoError(t, decoder.Decode(&reportLine))
+			assert.NotEmpty(t, reportLine.ErrorId)
+			reported = append(reported, reportLine.LineNumber)
+		}
+		assert.ElementsMatch(t, []int{8, 9, 10}, reported)
+	})
+}
+
 func BenchmarkBulkImport(b *testing.B) {
 	th := Setup(b)
 	defer th.TearDown()
@@ -6,6 +6,9 @@ package imports
 import (
 	"archive/zip"
 	"encoding/json"
+	"io"
+	"path/filepath"
+	"strings"
 
 	"github.com/mattermost/mattermost/server/public/model"
 )
@@ -24,6 +27,7 @@ type LineImportData struct {
 	DirectChannel *DirectChannelImportData `json:"direct_channel,omitempty"`
 	DirectPost    *DirectPostImportData    `json:"direct_post,omitempty"`
 	Emoji         *EmojiImportData         `json:"emoji,omitempty"`
+	Delete        *DeleteImportData        `json:"delete,omitempty"`
 	Version       *int                     `json:"version,omitempty"`
 	Info          *VersionInfoImportData   `json:"info,omitempty"`
 }
@@ -174,12 +178,49 @@ type EmojiImportData struct {
 	Data  *zip.File `json:"-"`
 }
 
+// The entities a tombstone line can delete.
+const (
+	DeleteEntityTeam          = "team"
+	DeleteEntityChannel       = "channel"
+	DeleteEntityTeamMember    = "team_member"
+	DeleteEntityChannelMember = "channel_member"
+	DeleteEntityPost          = "post"
+	DeleteEntityDirectPost    = "direct_post"
+)
+
+// DeleteImportData is a tombstone, written by incremental exports for an entity deleted since
+// the previous export. Posts, and replies alike, are identified by their channel, author and
+// creation time.
+type DeleteImportData struct {
+	Entity         *string   `json:"entity"`
+	Team           *string   `json:"team,omitempty"`
+	Channel        *string   `json:"channel,omitempty"`
+	ChannelMembers *[]string `json:"channel_members,omitempty"`
+	User           *string   `json:"user,omitempty"`
+	CreateAt       *int64    `json:"create_at,omitempty"`
+	DeleteAt       *int64    `json:"delete_at"`
+}
+
 type ReactionImportData struct {
 	User      *string `json:"user"`
 	CreateAt  *int64  `json:"create_at"`
 	EmojiName *string `json:"emoji_name"`
 }
 
+type PollImportData struct {
+	Question       *string                 `json:"question"`
+	Options        *[]PollOptionImportData `json:"options"`
+	Anonymous      *bool                   `json:"anonymous,omitempty"`
+	MultipleChoice *bool                   `json:"multiple_choice,omitempty"`
+	CloseAt        *int64                  `json:"close_at,omitempty"`
+	ClosedAt       *int64                  `json:"closed_at,omitempty"`
+}
+
+type PollOptionImportData struct {
+	Text   *string   `json:"text"`
+	Voters *[]string `json:"voters,omitempty"`
+}
+
 type ReplyImportData struct {
 	User *string `json:"user"`
 
@@ -213,6 +254,7 @@ type PostImportData struct {
 	IsPinned    *bool                   `json:"is_pinned,omitempty"`
 
 	ThreadFollowers *[]ThreadFollowerImportData `json:"thread_followers,omitempty"`
+	Poll            *PollImportData             `json:"poll,omitempty"`
 }
 
 type DirectChannelImportData struct {
@@ -241,6 +283,7 @@ type DirectPostImportData struct {
 	IsPinned    *bool                   `json:"is_pinned,omitempty"`
 
 	ThreadFollowers *[]ThreadFollowerImportData `json:"thread_followers,omitempty"`
+	Poll            *PollImportData             `json:"poll,omitempty"`
 }
 
 type SchemeImportData struct {
@@ -274,6 +317,42 @@ type LineImportWorkerError struct {
 	LineNumber int
 }
 
+// BulkImportOpts are the options of a bulk import.
+type BulkImportOpts struct {
+	DryRun         bool
+	ExtractContent bool
+	Workers        int
+	// ImportPath is the directory attachment paths are relative to.
+	ImportPath string
+	// StartLine is the checkpoint of a previous, interrupted, run of the
+	// import. The lines up to it are skipped.
+	StartLine int
+	// CheckpointInterval is the number of lines between two checkpoints within
+	// a phase. Checkpoints are only made at the end of phases if it is zero.
+	CheckpointInterval int
+	// Checkpoint, if set, is called once every line up to line has been
+	// imported. completedPhase is the type of the lines whose phase has just
+	// been completed, if any.
+	Checkpoint func(line int, completedPhase string)
+	// ErrorReport, if set, receives an ImportErrorReportLine for each line
+	// that failed to import, and the import carries on past them instead of
+	// stopping at the first failure.
+	ErrorReport io.Writer
+}
+
+// ErrorReportFileName returns the name of the error report of the validation
+// of an import file, stored next to it.
+func ErrorReportFileName(importFileName string) string {
+	return strings.TrimSuffix(filepath.Base(importFileName), filepath.Ext(importFileName)) + "_errors.jsonl"
+}
+
+// ImportErrorReportLine is a line of the error report of a bulk import.
+type ImportErrorReportLine struct {
+	LineNumber int    `json:"line_number"`
+	ErrorId    string `json:"error_id"`
+	Error      string `json:"error"`
+}
+
 type AttachmentImportData struct {
 	Path *string   `json:"path"`
 	Data *zip.File `json:"-"`
@@ -464,6 +464,30 @@ func ValidateReactionImportData(data *ReactionImportData, parentCreateAt int64)
 	return nil
 }
 
+func ValidatePollImportData(data *PollImportData) *model.AppError {
+	if data.Question == nil || *data.Question == "" {
+		return model.NewAppError("BulkImport", "app.import.validate_poll_import_data.question_missing.error", nil, "", http.StatusBadRequest)
+	} else if utf8.RuneCountInString(*data.Question) > model.PollQuestionMaxRunes {
+		return model.NewAppError("BulkImport", "app.import.validate_poll_import_data.question_length.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.Options == nil || len(*data.Options) < model.PollOptionsMin || len(*data.Options) > model.PollOptionsMax {
+		return model.NewAppError("BulkImport", "app.import.validate_poll_import_data.options.error", map[string]any{"Min": model.PollOptionsMin, "Max": model.PollOptionsMax}, "", http.StatusBadRequest)
+	}
+
+	for _, option := range *data.Options {
+		if option.Text == nil || *option.Text == "" || utf8.RuneCountInString(*option.Text) > model.PollOptionMaxRunes {
+			return model.NewAppError("BulkImport", "app.import.validate_poll_import_data.option_text.error", nil, "", http.StatusBadRequest)
+		}
+	}
+
+	if (data.CloseAt != nil && *data.CloseAt < 0) || (data.ClosedAt != nil && *data.ClosedAt < 0) {
+		return model.NewAppError("BulkImport", "app.import.validate_poll_import_data.close_at.error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
 func ValidateReplyImportData(data *ReplyImportData, parentCreateAt int64, maxPostSize int) *model.AppError {
 	if data.User == nil {
 		return model.NewAppError("BulkImport", "app.import.validate_reply_import_data.user_missing.error", nil, "", http.StatusBadRequest)
@@ -531,6 +555,12 @@ func ValidatePostImportData(data *PostImportData, maxPostSize int) *model.AppErr
 		return model.NewAppError("BulkImport", "app.import.validate_post_import_data.create_at_zero.error", nil, "", http.StatusBadRequest)
 	}
 
+	if data.Poll != nil {
+		if err := ValidatePollImportData(data.Poll); err != nil {
+			return err
+		}
+	}
+
 	if data.Reactions != nil {
 		for _, reaction := range *data.Reactions {
 			if err := ValidateReactionImportData(&reaction, *data.CreateAt); err != nil {
@@ -656,6 +686,12 @@ func ValidateDirectPostImportData(data *DirectPostImportData, maxPostSize int) *
 		}
 	}
 
+	if data.Poll != nil {
+		if err := ValidatePollImportData(data.Poll); err != nil {
+			return err
+		}
+	}
+
 	if data.Reactions != nil {
 		for _, reaction := range *data.Reactions {
 			if err := ValidateReactionImportData(&reaction, *data.CreateAt); err != nil {
@@ -718,6 +754,58 @@ func ValidateEmojiImportData(data *EmojiImportData) *model.AppError {
 	return nil
 }
 
+func ValidateDeleteImportData(data *DeleteImportData) *model.AppError {
+	if data == nil {
+		return model.NewAppError("BulkImport", "app.import.validate_delete_import_data.empty.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.Entity == nil {
+		return model.NewAppError("BulkImport", "app.import.validate_delete_import_data.entity_missing.error", nil, "", http.StatusBadRequest)
+	}
+
+	fields := map[string]bool{
+		"team":            data.Team != nil && *data.Team != "",
+		"channel":         data.Channel != nil && *data.Channel != "",
+		"channel_members": data.ChannelMembers != nil && len(*data.ChannelMembers) > 0,
+		"user":            data.User != nil && *data.User != "",
+		"create_at":       data.CreateAt != nil && *data.CreateAt > 0,
+	}
+
+	var required []string
+	switch *data.Entity {
+	case DeleteEntityTeam:
+		required = []string{"team"}
+	case DeleteEntityChannel:
+		required = []string{"team", "channel"}
+	case DeleteEntityTeamMember:
+		required = []string{"team", "user"}
+	case DeleteEntityChannelMember:
+		required = []string{"team", "channel", "user"}
+	case DeleteEntityPost:
+		required = []string{"team", "channel", "user", "create_at"}
+	case DeleteEntityDirectPost:
+		required = []string{"channel_members", "user", "create_at"}
+	default:
+		return model.NewAppError("BulkImport", "app.import.validate_delete_import_data.entity_invalid.error", map[string]any{"Entity": *data.Entity}, "", http.StatusBadRequest)
+	}
+
+	for _, field := range required {
+		if !fields[field] {
+			return model.NewAppError("BulkImport", "app.import.validate_delete_import_data.field_missing.error", map[string]any{"Entity": *data.Entity, "Field": field}, "", http.StatusBadRequest)
+		}
+	}
+
+	if data.ChannelMembers != nil && len(*data.ChannelMembers) > model.ChannelGroupMaxUsers {
+		return model.NewAppError("BulkImport", "app.import.validate_delete_import_data.channel_members_too_many.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.DeleteAt == nil || *data.DeleteAt <= 0 {
+		return model.NewAppError("BulkImport", "app.import.validate_delete_import_data.delete_at_missing.error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
 func ValidateThreadFollowerImportData(data *ThreadFollowerImportData) *model.AppError {
 	if data == nil {
 		return model.NewAppError("BulkImport", "app.import.validate_thread_follower_data.empty.error", nil, "", http.StatusBadRequest)
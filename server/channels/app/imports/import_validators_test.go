@@ -904,6 +904,40 @@ func TestImportValidateReactionImportData(t *testing.T) {
 	require.NotNil(t, err, "Should have failed due parent with newer create-at value.")
 }
 
+func TestImportValidatePollImportData(t *testing.T) {
+	// Test with minimum required valid properties.
+	data := PollImportData{
+		Question: model.NewPointer("Where do we go for lunch?"),
+		Options: &[]PollOptionImportData{
+			{Text: model.NewPointer("Pizza"), Voters: &[]string{"username"}},
+			{Text: model.NewPointer("Sushi")},
+		},
+	}
+	err := ValidatePollImportData(&data)
+	require.Nil(t, err, "Validation failed but should have been valid.")
+
+	// Test with missing required properties.
+	data.Question = nil
+	err = ValidatePollImportData(&data)
+	require.NotNil(t, err, "Should have failed due to missing required property.")
+
+	// Test with invalid options.
+	data.Question = model.NewPointer("Where do we go for lunch?")
+	data.Options = &[]PollOptionImportData{{Text: model.NewPointer("Pizza")}}
+	err = ValidatePollImportData(&data)
+	require.NotNil(t, err, "Should have failed due to a single option.")
+
+	data.Options = &[]PollOptionImportData{{Text: model.NewPointer("Pizza")}, {Text: model.NewPointer("")}}
+	err = ValidatePollImportData(&data)
+	require.NotNil(t, err, "Should have failed due to an empty option.")
+
+	// Test with an invalid close time.
+	data.Options = &[]PollOptionImportData{{Text: model.NewPointer("Pizza")}, {Text: model.NewPointer("Sushi")}}
+	data.CloseAt = model.NewPointer(int64(-1))
+	err = ValidatePollImportData(&data)
+	require.NotNil(t, err, "Should have failed due to an invalid close time.")
+}
+
 func TestImportValidateReplyImportData(t *testing.T) {
 	// Test with minimum required valid properties.
 	parentCreateAt := model.GetMillis() - 100
@@ -1541,6 +1575,44 @@ func TestImportValidateEmojiImportData(t *testing.T) {
 	}
 }
 
+func TestImportValidateDeleteImportData(t *testing.T) {
+	deleteAt := model.NewPointer(int64(1000))
+
+	testCases := []struct {
+		testName    string
+		input       *DeleteImportData
+		expectError string
+	}{
+		{"nil", nil, "app.import.validate_delete_import_data.empty.error"},
+		{"no entity", &DeleteImportData{DeleteAt: deleteAt}, "app.import.validate_delete_import_data.entity_missing.error"},
+		{"unknown entity", &DeleteImportData{Entity: model.NewPointer("emoji"), DeleteAt: deleteAt}, "app.import.validate_delete_import_data.entity_invalid.error"},
+		{"team", &DeleteImportData{Entity: model.NewPointer(DeleteEntityTeam), Team: model.NewPointer("team"), DeleteAt: deleteAt}, ""},
+		{"team without name", &DeleteImportData{Entity: model.NewPointer(DeleteEntityTeam), DeleteAt: deleteAt}, "app.import.validate_delete_import_data.field_missing.error"},
+		{"channel", &DeleteImportData{Entity: model.NewPointer(DeleteEntityChannel), Team: model.NewPointer("team"), Channel: model.NewPointer("channel"), DeleteAt: deleteAt}, ""},
+		{"channel without team", &DeleteImportData{Entity: model.NewPointer(DeleteEntityChannel), Channel: model.NewPointer("channel"), DeleteAt: deleteAt}, "app.import.validate_delete_import_data.field_missing.error"},
+		{"channel member", &DeleteImportData{Entity: model.NewPointer(DeleteEntityChannelMember), Team: model.NewPointer("team"), Channel: model.NewPointer("channel"), User: model.NewPointer("user"), DeleteAt: deleteAt}, ""},
+		{"team member without user", &DeleteImportData{Entity: model.NewPointer(DeleteEntityTeamMember), Team: model.NewPointer("team"), User: model.NewPointer(""), DeleteAt: deleteAt}, "app.import.validate_delete_import_data.field_missing.error"},
+		{"post", &DeleteImportData{Entity: model.NewPointer(DeleteEntityPost), Team: model.NewPointer("team"), Channel: model.NewPointer("channel"), User: model.NewPointer("user"), CreateAt: model.NewPointer(int64(500)), DeleteAt: deleteAt}, ""},
+		{"post without create at", &DeleteImportData{Entity: model.NewPointer(DeleteEntityPost), Team: model.NewPointer("team"), Channel: model.NewPointer("channel"), User: model.NewPointer("user"), DeleteAt: deleteAt}, "app.import.validate_delete_import_data.field_missing.error"},
+		{"direct post", &DeleteImportData{Entity: model.NewPointer(DeleteEntityDirectPost), ChannelMembers: &[]string{"user", "other"}, User: model.NewPointer("user"), CreateAt: model.NewPointer(int64(500)), DeleteAt: deleteAt}, ""},
+		{"direct post without members", &DeleteImportData{Entity: model.NewPointer(DeleteEntityDirectPost), ChannelMembers: &[]string{}, User: model.NewPointer("user"), CreateAt: model.NewPointer(int64(500)), DeleteAt: deleteAt}, "app.import.validate_delete_import_data.field_missing.error"},
+		{"direct post with too many members", &DeleteImportData{Entity: model.NewPointer(DeleteEntityDirectPost), ChannelMembers: &[]string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}, User: model.NewPointer("user"), CreateAt: model.NewPointer(int64(500)), DeleteAt: deleteAt}, "app.import.validate_delete_import_data.channel_members_too_many.error"},
+		{"no delete at", &DeleteImportData{Entity: model.NewPointer(DeleteEntityTeam), Team: model.NewPointer("team")}, "app.import.validate_delete_import_data.delete_at_missing.error"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			err := ValidateDeleteImportData(tc.input)
+			if tc.expectError != "" {
+				require.NotNil(t, err)
+				assert.Equal(t, tc.expectError, err.Id)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
 func TestImportValidateThreadFollowerImportData(t *testing.T) {
 	testCases := []struct {
 		testName    string
@@ -229,39 +229,16 @@ func (a *App) DoPostActionWithCookie(rctx request.CTX, postID, actionId, userID,
 		return "", appErr
 	}
 
-	requestJSON, err := json.Marshal(upstreamRequest)
-	if err != nil {
-		return "", model.NewAppError("DoPostActionWithCookie", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	var response *model.PostActionIntegrationResponse
+	name, builtIn := strings.CutPrefix(upstreamURL, model.PostActionBuiltInURLPrefix)
+	if builtIn {
+		response, appErr = a.doBuiltInPostAction(rctx, name, upstreamRequest)
+	} else {
+		response, appErr = a.doUpstreamPostAction(rctx, upstreamURL, upstreamRequest)
 	}
-
-	// Log request, regardless of whether destination is internal or external
-	rctx.Logger().Info("DoPostActionWithCookie POST request, through DoActionRequest",
-		mlog.String("url", upstreamURL),
-		mlog.String("user_id", upstreamRequest.UserId),
-		mlog.String("post_id", upstreamRequest.PostId),
-		mlog.String("channel_id", upstreamRequest.ChannelId),
-		mlog.String("team_id", upstreamRequest.TeamId),
-	)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(*a.Config().ServiceSettings.OutgoingIntegrationRequestsTimeout)*time.Second)
-	defer cancel()
-	resp, appErr := a.DoActionRequest(rctx.WithContext(ctx), upstreamURL, requestJSON)
 	if appErr != nil {
 		return "", appErr
 	}
-	defer resp.Body.Close()
-
-	var response model.PostActionIntegrationResponse
-	respBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", model.NewAppError("DoPostActionWithCookie", "api.post.do_action.action_integration.app_error", nil, "", http.StatusBadRequest).Wrap(err)
-	}
-
-	if len(respBytes) > 0 {
-		if err = json.Unmarshal(respBytes, &response); err != nil {
-			return "", model.NewAppError("DoPostActionWithCookie", "api.post.do_action.action_integration.app_error", nil, "", http.StatusBadRequest).Wrap(err)
-		}
-	}
 
 	if response.Update != nil {
 		response.Update.Id = postID
@@ -280,7 +257,7 @@ func (a *App) DoPostActionWithCookie(rctx request.CTX, postID, actionId, userID,
 		response.Update.IsPinned = originalIsPinned
 		response.Update.HasReactions = originalHasReactions
 
-		if _, appErr = a.UpdatePost(rctx, response.Update, &model.UpdatePostOptions{SafeUpdate: false}); appErr != nil {
+		if _, appErr = a.UpdatePost(rctx, response.Update, &model.UpdatePostOptions{SafeUpdate: false, AllowBuiltInPostActions: builtIn}); appErr != nil {
 			return "", appErr
 		}
 	}
@@ -306,6 +283,59 @@ func (a *App) DoPostActionWithCookie(rctx request.CTX, postID, actionId, userID,
 	return clientTriggerId, nil
 }
 
+// doUpstreamPostAction sends a post action request to the integration URL of
+// the action and returns its response.
+func (a *App) doUpstreamPostAction(rctx request.CTX, upstreamURL string, upstreamRequest *model.PostActionIntegrationRequest) (*model.PostActionIntegrationResponse, *model.AppError) {
+	requestJSON, err := json.Marshal(upstreamRequest)
+	if err != nil {
+		return nil, model.NewAppError("doUpstreamPostAction", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	// Log request, regardless of whether destination is internal or external
+	rctx.Logger().Info("DoPostActionWithCookie POST request, through DoActionRequest",
+		mlog.String("url", upstreamURL),
+		mlog.String("user_id", upstreamRequest.UserId),
+		mlog.String("post_id", upstreamRequest.PostId),
+		mlog.String("channel_id", upstreamRequest.ChannelId),
+		mlog.String("team_id", upstreamRequest.TeamId),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(*a.Config().ServiceSettings.OutgoingIntegrationRequestsTimeout)*time.Second)
+	defer cancel()
+	resp, appErr := a.DoActionRequest(rctx.WithContext(ctx), upstreamURL, requestJSON)
+	if appErr != nil {
+		return nil, appErr
+	}
+	defer resp.Body.Close()
+
+	var response model.PostActionIntegrationResponse
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, model.NewAppError("doUpstreamPostAction", "api.post.do_action.action_integration.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	if len(respBytes) > 0 {
+		if err = json.Unmarshal(respBytes, &response); err != nil {
+			return nil, model.NewAppError("doUpstreamPostAction", "api.post.do_action.action_integration.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+		}
+	}
+
+	return &response, nil
+}
+
+// doBuiltInPostAction handles the post actions whose integration URL has the
+// model.PostActionBuiltInURLPrefix prefix.
+func (a *App) doBuiltInPostAction(rctx request.CTX, name string, upstreamRequest *model.PostActionIntegrationRequest) (*model.PostActionIntegrationResponse, *model.AppError) {
+	switch name {
+	case model.ReminderPostActionName:
+		return a.doReminderPostAction(rctx, upstreamRequest)
+	case model.PollPostActionName:
+		return a.doPollPostAction(rctx, upstreamRequest)
+	default:
+		return nil, model.NewAppError("doBuiltInPostAction", "api.post.do_action.action_integration.app_error", nil, "unknown built-in action "+name, http.StatusBadRequest)
+	}
+}
+
 // DoActionRequest performs an HTTP POST request to an integration's action endpoint.
 // Caller must consume and close returned http.Response as necessary.
 // For internal requests, requests are routed directly to a plugin ServerHTTP hook
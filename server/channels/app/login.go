@@ -42,6 +42,13 @@ func (a *App) AuthenticateUserForLogin(rctx request.CTX, id, loginId, password,
 		return nil, model.NewAppError("AuthenticateUserForLogin", "api.user.login.blank_pwd.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if err = a.checkLoginIPLockout(rctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		a.recordLoginFailure(rctx, err)
+	}()
+
 	// Get the MM user we are trying to login
 	if user, err = a.GetUserForLogin(rctx, id, loginId); err != nil {
 		return nil, err
@@ -172,6 +179,17 @@ func (a *App) DoLogin(rctx request.CTX, w http.ResponseWriter, r *http.Request,
 	session.AddProp(model.SessionPropPlatform, plat)
 	session.AddProp(model.SessionPropOs, os)
 	session.AddProp(model.SessionPropBrowser, fmt.Sprintf("%v/%v", bname, bversion))
+
+	device := &model.UserDevice{
+		UserId:        user.Id,
+		Fingerprint:   model.NewUserDeviceFingerprint(a.getUserDeviceID(w, r, deviceID)),
+		Platform:      plat,
+		Os:            os,
+		Browser:       bname,
+		LastIPAddress: rctx.IPAddress(),
+	}
+	session.AddProp(model.SessionPropDeviceFingerprint, device.Fingerprint)
+	session.AddProp(model.SessionPropIPAddress, rctx.IPAddress())
 	if user.IsGuest() {
 		session.AddProp(model.SessionPropIsGuest, "true")
 	} else {
@@ -192,6 +210,11 @@ func (a *App) DoLogin(rctx request.CTX, w http.ResponseWriter, r *http.Request,
 
 	rctx = rctx.WithSession(session)
 
+	device.FirstSeenAt = session.CreateAt
+	a.Srv().Go(func() {
+		a.recordUserDevice(rctx, user, device)
+	})
+
 	if a.Srv().License() != nil && *a.Srv().License().Features.LDAP && a.Ldap() != nil {
 		userVal := *user
 		sessionVal := *session
@@ -0,0 +1,85 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+
+	"github.com/throttled/throttled"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+)
+
+// configureLoginIPRateLimiter sets up the lockout of the IP addresses failing to log in too
+// many times, on top of the lockout of the accounts. Its state is shared by the nodes of a
+// cluster when the cache is in Redis.
+//
+// It's disabled by default: behind a proxy, the requests all come from the address of the
+// proxy unless ServiceSettings.TrustedProxyIPHeader is set, and locking it out would lock out
+// every user at once.
+func (s *Server) configureLoginIPRateLimiter(cfg *model.Config) {
+	maxAttempts := *cfg.ServiceSettings.MaximumLoginAttemptsPerIP
+	if maxAttempts <= 0 {
+		s.loginIPRateLimiter.Store(nil)
+		return
+	}
+
+	store, err := s.platform.CacheProvider().NewRateLimitStore("login_ip", *cfg.RateLimitSettings.MemoryStoreSize)
+	if err != nil {
+		s.Log().Error("Failed to create the store of the login lockout by IP address", mlog.Err(err))
+		return
+	}
+
+	// The attempts are forgotten progressively, all of them after the window.
+	window := *cfg.ServiceSettings.LoginAttemptsPerIPWindowMinutes
+	limiter, err := throttled.NewGCRARateLimiter(store, throttled.RateQuota{
+		MaxRate:  throttled.PerDay(max(maxAttempts*24*60/window, 1)),
+		MaxBurst: maxAttempts - 1,
+	})
+	if err != nil {
+		s.Log().Error("Failed to create the login lockout by IP address", mlog.Err(err))
+		return
+	}
+
+	s.loginIPRateLimiter.Store(limiter)
+}
+
+// checkLoginIPLockout fails if the IP address of the request failed to log in too many times.
+func (a *App) checkLoginIPLockout(rctx request.CTX) *model.AppError {
+	limiter := a.Srv().loginIPRateLimiter.Load()
+	if limiter == nil || rctx.IPAddress() == "" {
+		return nil
+	}
+
+	// A quantity of 0 reads the remaining attempts without counting one.
+	_, result, err := limiter.RateLimit(rctx.IPAddress(), 0)
+	if err != nil {
+		rctx.Logger().Warn("Failed to check the login lockout by IP address", mlog.Err(err))
+		return nil
+	}
+	if result.Remaining <= 0 {
+		return model.NewAppError("checkLoginIPLockout", "api.user.login.ip_locked.app_error", nil, "ip="+rctx.IPAddress(), http.StatusTooManyRequests)
+	}
+
+	return nil
+}
+
+// recordLoginFailure counts a failed login attempt against the IP address of the request,
+// when the failure is due to invalid credentials.
+func (a *App) recordLoginFailure(rctx request.CTX, appErr *model.AppError) {
+	if appErr == nil || (appErr.StatusCode != http.StatusUnauthorized && appErr.Id != "store.sql_user.get_for_login.app_error") {
+		return
+	}
+
+	limiter := a.Srv().loginIPRateLimiter.Load()
+	if limiter == nil || rctx.IPAddress() == "" {
+		return
+	}
+
+	if _, _, err := limiter.RateLimit(rctx.IPAddress(), 1); err != nil {
+		rctx.Logger().Warn("Failed to record a failed login attempt by IP address", mlog.Err(err))
+	}
+}
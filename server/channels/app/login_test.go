@@ -4,6 +4,7 @@
 package app
 
 import (
+	"net/http"
 	"os"
 	"testing"
 
@@ -52,3 +53,42 @@ func TestCWSLogin(t *testing.T) {
 		require.Nil(t, user)
 	})
 }
+
+func TestLoginIPLockout(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.MaximumLoginAttemptsPerIP = 3
+	})
+
+	rctx := th.Context.WithIPAddress("10.1.2.3")
+
+	_, appErr := th.App.AuthenticateUserForLogin(rctx, "", "unknown"+model.NewId(), "wrong", "", "", false)
+	require.NotNil(t, appErr)
+	for range 2 {
+		_, appErr = th.App.AuthenticateUserForLogin(rctx, "", th.BasicUser.Username, "wrong", "", "", false)
+		require.NotNil(t, appErr)
+		require.Equal(t, http.StatusUnauthorized, appErr.StatusCode)
+	}
+
+	// The right password is refused too, while the IP address is locked.
+	_, appErr = th.App.AuthenticateUserForLogin(rctx, "", th.BasicUser.Username, "Password1", "", "", false)
+	require.NotNil(t, appErr)
+	require.Equal(t, "api.user.login.ip_locked.app_error", appErr.Id)
+	require.Equal(t, http.StatusTooManyRequests, appErr.StatusCode)
+
+	user, appErr := th.App.AuthenticateUserForLogin(th.Context.WithIPAddress("10.1.2.4"), "", th.BasicUser.Username, "Password1", "", "", false)
+	require.Nil(t, appErr)
+	require.Equal(t, th.BasicUser.Id, user.Id)
+
+	t.Run("can be disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.MaximumLoginAttemptsPerIP = 0
+		})
+
+		_, appErr := th.App.AuthenticateUserForLogin(rctx, "", th.BasicUser.Username, "Password1", "", "", false)
+		require.Nil(t, appErr)
+	})
+}
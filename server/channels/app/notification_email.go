@@ -393,5 +393,5 @@ func (a *App) getNotificationEmailBodyFromEmailNotification(rctx request.CTX, re
 		data.Props["Posts"] = []postData{}
 	}
 
-	return a.Srv().TemplatesContainer().RenderToString("messages_notification", data)
+	return a.Srv().EmailService.RenderTemplate("messages_notification", data)
 }
@@ -67,7 +67,7 @@ func TestSendNotifications(t *testing.T) {
 		group, updateErr := th.App.UpdateGroup(group)
 		require.Nil(t, updateErr)
 
-		_, upsertErr := th.App.UpsertGroupMember(group.Id, th.BasicUser2.Id)
+		_, upsertErr := th.App.UpsertGroupMember(th.Context, group.Id, th.BasicUser2.Id)
 		require.Nil(t, upsertErr)
 
 		groupMentionPost := &model.Post{
@@ -310,9 +310,9 @@ func TestSendNotifications_MentionsFollowers(t *testing.T) {
 		group, updateErr := th.App.UpdateGroup(group)
 		require.Nil(t, updateErr)
 
-		_, upsertErr := th.App.UpsertGroupMember(group.Id, th.BasicUser.Id)
+		_, upsertErr := th.App.UpsertGroupMember(th.Context, group.Id, th.BasicUser.Id)
 		require.Nil(t, upsertErr)
-		_, upsertErr = th.App.UpsertGroupMember(group.Id, th.BasicUser2.Id)
+		_, upsertErr = th.App.UpsertGroupMember(th.Context, group.Id, th.BasicUser2.Id)
 		require.Nil(t, upsertErr)
 
 		// Set up the websockets
@@ -826,9 +826,9 @@ func TestFilterOutOfChannelMentions(t *testing.T) {
 		th.LinkUserToTeam(nonGroupMember, th.BasicTeam)
 
 		group := th.CreateGroup()
-		_, appErr := th.App.UpsertGroupMember(group.Id, th.BasicUser.Id)
+		_, appErr := th.App.UpsertGroupMember(th.Context, group.Id, th.BasicUser.Id)
 		require.Nil(t, appErr)
-		_, appErr = th.App.UpsertGroupMember(group.Id, nonChannelMember.Id)
+		_, appErr = th.App.UpsertGroupMember(th.Context, group.Id, nonChannelMember.Id)
 		require.Nil(t, appErr)
 
 		constrainedChannel := th.CreateChannel(th.Context, th.BasicTeam)
@@ -2577,14 +2577,14 @@ func TestInsertGroupMentions(t *testing.T) {
 	th.LinkUserToTeam(groupChannelMember, team)
 	_, appErr := th.App.AddUserToChannel(th.Context, groupChannelMember, channel, false)
 	require.Nil(t, appErr)
-	_, err = th.App.UpsertGroupMember(group.Id, groupChannelMember.Id)
+	_, err = th.App.UpsertGroupMember(th.Context, group.Id, groupChannelMember.Id)
 	require.Nil(t, err)
 
 	senderGroupChannelMember := th.CreateUser()
 	th.LinkUserToTeam(senderGroupChannelMember, team)
 	_, appErr = th.App.AddUserToChannel(th.Context, senderGroupChannelMember, channel, false)
 	require.Nil(t, appErr)
-	_, err = th.App.UpsertGroupMember(group.Id, senderGroupChannelMember.Id)
+	_, err = th.App.UpsertGroupMember(th.Context, group.Id, senderGroupChannelMember.Id)
 	require.Nil(t, err)
 
 	nonGroupChannelMember := th.CreateUser()
@@ -2594,7 +2594,7 @@ func TestInsertGroupMentions(t *testing.T) {
 
 	nonChannelGroupMember := th.CreateUser()
 	th.LinkUserToTeam(nonChannelGroupMember, team)
-	_, err = th.App.UpsertGroupMember(group.Id, nonChannelGroupMember.Id)
+	_, err = th.App.UpsertGroupMember(th.Context, group.Id, nonChannelGroupMember.Id)
 	require.Nil(t, err)
 
 	groupWithNoMembers := th.CreateGroup()
@@ -2742,7 +2742,7 @@ func TestGetGroupsAllowedForReferenceInChannel(t *testing.T) {
 	assert.Nil(t, err)
 
 	u1 := th.BasicUser
-	_, err = th.App.UpsertGroupMember(customGroup.Id, u1.Id)
+	_, err = th.App.UpsertGroupMember(th.Context, customGroup.Id, u1.Id)
 	assert.Nil(t, err)
 
 	customGroup, err = th.App.GetGroup(customGroup.Id, &model.GetGroupOpts{IncludeMemberCount: true}, nil)
@@ -2777,7 +2777,7 @@ func TestGetGroupsAllowedForReferenceInChannel(t *testing.T) {
 
 	// Sync group2 to the team
 	team.GroupConstrained = model.NewPointer(true)
-	team, err = th.App.UpdateTeam(team)
+	team, err = th.App.UpdateTeam(th.Context, team)
 	require.Nil(t, err)
 	_, err = th.App.UpsertGroupSyncable(&model.GroupSyncable{
 		GroupId:    group2.Id,
@@ -2807,7 +2807,7 @@ func TestGetGroupsAllowedForReferenceInChannel(t *testing.T) {
 	})
 
 	team.GroupConstrained = model.NewPointer(false)
-	team, err = th.App.UpdateTeam(team)
+	team, err = th.App.UpdateTeam(th.Context, team)
 	require.Nil(t, err)
 
 	t.Run("should return all groups when team and channel are not group constrained", func(t *testing.T) {
@@ -3110,10 +3110,10 @@ func TestRemoveNotifications(t *testing.T) {
 		})
 		require.Nil(t, appErr)
 
-		_, appErr = th.App.UpsertGroupMember(group.Id, u1.Id)
+		_, appErr = th.App.UpsertGroupMember(th.Context, group.Id, u1.Id)
 		require.Nil(t, appErr)
 
-		_, appErr = th.App.UpsertGroupMember(group.Id, u2.Id)
+		_, appErr = th.App.UpsertGroupMember(th.Context, group.Id, u2.Id)
 		require.Nil(t, appErr)
 
 		rootPost := &model.Post{
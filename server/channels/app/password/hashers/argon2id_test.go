@@ -0,0 +1,141 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package hashers
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/mattermost/mattermost/server/v8/channels/app/password/phcparser"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/argon2"
+)
+
+func TestArgon2idHash(t *testing.T) {
+	password := "^a v3ery c0mp_ex Passw∙rd$"
+	memory := 64 * 1024
+	iterations := 3
+	parallelism := 4
+
+	hasher, err := NewArgon2id(memory, iterations, parallelism)
+	require.NoError(t, err)
+
+	str, err := hasher.Hash(password)
+	require.NoError(t, err)
+
+	phc, err := phcparser.New(strings.NewReader(str)).Parse()
+	require.NoError(t, err)
+	require.Equal(t, "argon2id", phc.Id)
+	require.Equal(t, "19", phc.Version)
+	require.Equal(t, map[string]string{
+		"m": "65536",
+		"t": "3",
+		"p": "4",
+	}, phc.Params)
+
+	salt, err := base64.RawStdEncoding.DecodeString(phc.Salt)
+	require.NoError(t, err)
+
+	hash := argon2.IDKey([]byte(password), salt, uint32(iterations), uint32(memory), uint8(parallelism), 32)
+
+	expectedHash := base64.RawStdEncoding.EncodeToString(hash)
+	require.Equal(t, expectedHash, phc.Hash)
+
+	_, err = hasher.Hash(strings.Repeat("a", PasswordMaxLengthBytes+1))
+	require.ErrorIs(t, err, ErrPasswordTooLong)
+}
+
+func TestNewArgon2id(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		memory      int
+		iterations  int
+		parallelism int
+		expectedErr bool
+	}{
+		{"default parameters", DefaultArgon2idMemory, DefaultArgon2idIterations, DefaultArgon2idParallelism, false},
+		{"maximum parameters", MaximumArgon2idMemory, MaximumArgon2idIterations, MaximumArgon2idParallelism, false},
+		{"minimum memory", 16, 1, 2, false},
+		{"too little memory per thread", 15, 1, 2, true},
+		{"no iterations", 1024, 0, 1, true},
+		{"no parallelism", 1024, 1, 0, true},
+		{"too much parallelism", 1 << 20, 1, 256, true},
+		{"too much memory", MaximumArgon2idMemory + 1, 1, 1, true},
+		{"too many iterations", 1024, MaximumArgon2idIterations + 1, 1, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			_, err := NewArgon2id(tc.memory, tc.iterations, tc.parallelism)
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestArgon2idCompareHashAndPassword(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		storedPwd   string
+		inputPwd    string
+		expectedErr error
+	}{
+		{
+			"empty password",
+			"",
+			"",
+			nil,
+		},
+		{
+			"same password",
+			"one password",
+			"one password",
+			nil,
+		},
+		{
+			"different password",
+			"one password",
+			"another password",
+			ErrMismatchedHashAndPassword,
+		},
+	}
+
+	hasher := DefaultArgon2id()
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			storedPHCStr, err := hasher.Hash(tc.storedPwd)
+			require.NoError(t, err)
+
+			storedPHC, err := phcparser.New(strings.NewReader(storedPHCStr)).Parse()
+			require.NoError(t, err)
+
+			err = hasher.CompareHashAndPassword(storedPHC, tc.inputPwd)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("hash with other parameters", func(t *testing.T) {
+		other, err := NewArgon2id(1024, 1, 1)
+		require.NoError(t, err)
+
+		storedPHCStr, err := other.Hash("one password")
+		require.NoError(t, err)
+
+		storedPHC, err := phcparser.New(strings.NewReader(storedPHCStr)).Parse()
+		require.NoError(t, err)
+
+		err = hasher.CompareHashAndPassword(storedPHC, "one password")
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrMismatchedHashAndPassword)
+	})
+}
@@ -16,7 +16,7 @@
 //	// latestHasher is the hasher currently in use.
 //	// Any password hashed with a different hasher must be migrated to this one.
 //
-// -	latestHasher PasswordHasher = DefaultPBKDF2()
+// -	latestHasher PasswordHasher = DefaultArgon2id()
 // +	latestHasher PasswordHasher = DefaultNewHasher()
 // ```
 //  3. Modify [GetHasherFromPHCString] to add a new case in the switch to
@@ -26,7 +26,9 @@
 // hashing method (let's say keep using PBKDF2 but increase the work factor
 // from 60,000 to 120,000), then no modification to [GetHasherFromPHCString]
 // is needed. Simply update the [latestHasher] varible with the new parameter,
-// and [IsPHCValid] will detect the difference in the parameter.
+// and [IsPHCValid] will detect the difference in the parameter. The parameters
+// of the latest hasher can also be changed at runtime with [SetLatestHasher],
+// which is how the Argon2id settings of the server's configuration are applied.
 //
 // Note that the migration happens in [App.migratePassword], which is triggered
 // whenever the user enters their password and an old hashing method is
@@ -43,6 +45,7 @@ package hashers
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/mattermost/mattermost/server/v8/channels/app/password/phcparser"
 )
@@ -78,10 +81,20 @@ const (
 	PasswordMaxLengthBytes = 72
 )
 
+// Names of the hashing functions, as reported by [GetAlgorithmFromPHCString].
+const (
+	BCryptAlgorithm   = "bcrypt"
+	PBKDF2Algorithm   = PBKDF2FunctionId
+	Argon2idAlgorithm = Argon2idFunctionId
+)
+
 var (
 	// latestHasher is the hasher currently in use.
 	// Any password hashed with a different hasher must be migrated to this one.
-	latestHasher PasswordHasher = DefaultPBKDF2()
+	latestHasher PasswordHasher = DefaultArgon2id()
+
+	// latestHasherMut guards latestHasher, which can be replaced at runtime.
+	latestHasherMut sync.RWMutex
 
 	// ErrPasswordTooLong is the error returned when the provided password is
 	// longer than [PasswordMaxLengthBytes].
@@ -114,12 +127,18 @@ func GetHasherFromPHCString(phcString string) (PasswordHasher, phcparser.PHC, er
 	}
 
 	// First check whether PHC conforms to the latest hasher
-	if latestHasher.IsPHCValid(phc) {
-		return latestHasher, phc, nil
+	if latest := getLatestHasher(); latest.IsPHCValid(phc) {
+		return latest, phc, nil
 	}
 
 	// If not, check the function ID and create a new one depending on it
 	switch phc.Id {
+	case Argon2idFunctionId:
+		argon2id, err := NewArgon2idFromPHC(phc)
+		if err != nil {
+			return Argon2id{}, phcparser.PHC{}, fmt.Errorf("the provided PHC string is Argon2id, but is not valid: %w", err)
+		}
+		return argon2id, phc, nil
 	case PBKDF2FunctionId:
 		pbkdf2, err := NewPBKDF2FromPHC(phc)
 		if err != nil {
@@ -133,19 +152,53 @@ func GetHasherFromPHCString(phcString string) (PasswordHasher, phcparser.PHC, er
 	}
 }
 
+// GetAlgorithmFromPHCString returns the name of the hashing function that was
+// used to generate the provided PHC string, and whether it was generated by the
+// latest hasher, with its current parameters. The salt and hash of the PHC
+// string can be omitted.
+func GetAlgorithmFromPHCString(phcString string) (string, bool) {
+	// Only strings with a known function ID but invalid parameters fail, in
+	// which case the returned hasher still has the type of that function
+	hasher, _, err := GetHasherFromPHCString(phcString)
+	isLatest := err == nil && IsLatestHasher(hasher)
+	switch hasher.(type) {
+	case Argon2id:
+		return Argon2idAlgorithm, isLatest
+	case PBKDF2:
+		return PBKDF2Algorithm, isLatest
+	default:
+		return BCryptAlgorithm, isLatest
+	}
+}
+
+func getLatestHasher() PasswordHasher {
+	latestHasherMut.RLock()
+	defer latestHasherMut.RUnlock()
+	return latestHasher
+}
+
+// SetLatestHasher replaces the hasher currently in use. Passwords hashed with
+// any other hasher, or with the same one but different parameters, are migrated
+// to it the next time their users log in.
+func SetLatestHasher(hasher PasswordHasher) {
+	latestHasherMut.Lock()
+	defer latestHasherMut.Unlock()
+	latestHasher = hasher
+}
+
 // Hash hashes the provided password with the latest hashing method.
 func Hash(password string) (string, error) {
-	return latestHasher.Hash(password)
+	return getLatestHasher().Hash(password)
 }
 
 // CompareHashAndPassword compares the parsed [phcparser.PHC] and the provided
 // password using the latest hashing method.
 func CompareHashAndPassword(phc phcparser.PHC, password string) error {
-	return latestHasher.CompareHashAndPassword(phc, password)
+	return getLatestHasher().CompareHashAndPassword(phc, password)
 }
 
 // IsLatestHasher verifies that the provided hasher is the latest one. This
 // function is useful for identifying stored hashes that require a migration.
 func IsLatestHasher(hasher PasswordHasher) bool {
-	return latestHasher == hasher
+	return getLatestHasher() == hasher
 }
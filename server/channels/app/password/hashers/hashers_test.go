@@ -4,6 +4,7 @@
 package hashers
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/mattermost/mattermost/server/v8/channels/app/password/phcparser"
@@ -19,9 +20,55 @@ func TestGetHasherFromPHCString(t *testing.T) {
 		expectedErr    bool
 	}{
 		{
-			testName:       "latest hasher (PBKDF2)",
-			input:          "$pbkdf2$f=SHA256,w=600000,l=32$5Zq8TvET7nMrXof49Rp4Sw$d0Mx8467kv+3ylbGrkyu4jTd8O8SP51k4s1RuWb9S/o",
+			testName:       "latest hasher (Argon2id)",
+			input:          "$argon2id$v=19$m=19456,t=2,p=1$5Zq8TvET7nMrXof49Rp4Sw$d0Mx8467kv+3ylbGrkyu4jTd8O8SP51k4s1RuWb9S/o",
 			expectedHasher: latestHasher,
+			expectedPHC: phcparser.PHC{
+				Id:      "argon2id",
+				Version: "19",
+				Params: map[string]string{
+					"m": "19456",
+					"t": "2",
+					"p": "1",
+				},
+				Salt: "5Zq8TvET7nMrXof49Rp4Sw",
+				Hash: "d0Mx8467kv+3ylbGrkyu4jTd8O8SP51k4s1RuWb9S/o",
+			},
+			expectedErr: false,
+		},
+		{
+			testName: "valid, non-default Argon2id",
+			input:    "$argon2id$v=19$m=65536,t=3,p=4$5Zq8TvET7nMrXof49Rp4Sw$d0Mx8467kv+3ylbGrkyu4jTd8O8SP51k4s1RuWb9S/o",
+			expectedHasher: Argon2id{
+				memory:      65536,
+				iterations:  3,
+				parallelism: 4,
+				phcHeader:   "$argon2id$v=19$m=65536,t=3,p=4$",
+			},
+			expectedPHC: phcparser.PHC{
+				Id:      "argon2id",
+				Version: "19",
+				Params: map[string]string{
+					"m": "65536",
+					"t": "3",
+					"p": "4",
+				},
+				Salt: "5Zq8TvET7nMrXof49Rp4Sw",
+				Hash: "d0Mx8467kv+3ylbGrkyu4jTd8O8SP51k4s1RuWb9S/o",
+			},
+			expectedErr: false,
+		},
+		{
+			testName:       "valid Argon2id with unsupported version",
+			input:          "$argon2id$v=16$m=19456,t=2,p=1$5Zq8TvET7nMrXof49Rp4Sw$d0Mx8467kv+3ylbGrkyu4jTd8O8SP51k4s1RuWb9S/o",
+			expectedHasher: Argon2id{},
+			expectedPHC:    phcparser.PHC{},
+			expectedErr:    true,
+		},
+		{
+			testName:       "default PBKDF2",
+			input:          "$pbkdf2$f=SHA256,w=600000,l=32$5Zq8TvET7nMrXof49Rp4Sw$d0Mx8467kv+3ylbGrkyu4jTd8O8SP51k4s1RuWb9S/o",
+			expectedHasher: DefaultPBKDF2(),
 			expectedPHC: phcparser.PHC{
 				Id:      "pbkdf2",
 				Version: "",
@@ -85,6 +132,12 @@ func TestGetHasherFromPHCString(t *testing.T) {
 			expectedPHC: phcparser.PHC{},
 			expectedErr: true,
 		},
+		{
+			testName:    "valid Argon2id with too much memory",
+			input:       "$argon2id$v=19$m=4294967295,t=2,p=1$5Zq8TvET7nMrXof49Rp4Sw$d0Mx8467kv+3ylbGrkyu4jTd8O8SP51k4s1RuWb9S/o",
+			expectedPHC: phcparser.PHC{},
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -104,6 +157,8 @@ func TestGetHasherFromPHCString(t *testing.T) {
 func TestIsLatestHasher(t *testing.T) {
 	pbkdf2WithOtherParams, err := NewPBKDF2(10000, 16)
 	require.NoError(t, err)
+	argon2idWithOtherParams, err := NewArgon2id(65536, 3, 4)
+	require.NoError(t, err)
 
 	testCases := []struct {
 		testName       string
@@ -116,10 +171,20 @@ func TestIsLatestHasher(t *testing.T) {
 			true,
 		},
 		{
-			"DefaultPBKDF2 is the latest hasher",
-			DefaultPBKDF2(),
+			"DefaultArgon2id is the latest hasher",
+			DefaultArgon2id(),
 			true,
 		},
+		{
+			"Argon2id with other parameters is not the latest hasher",
+			argon2idWithOtherParams,
+			false,
+		},
+		{
+			"DefaultPBKDF2 is not the latest hasher",
+			DefaultPBKDF2(),
+			false,
+		},
 		{
 			"PBKDF2 with other parameters is not the latest hasher",
 			pbkdf2WithOtherParams,
@@ -136,3 +201,49 @@ func TestIsLatestHasher(t *testing.T) {
 		require.Equal(t, tc.expectedOutput, actualOutput)
 	}
 }
+
+func TestSetLatestHasher(t *testing.T) {
+	hasher, err := NewArgon2id(1024, 1, 1)
+	require.NoError(t, err)
+
+	SetLatestHasher(hasher)
+	defer SetLatestHasher(DefaultArgon2id())
+
+	require.True(t, IsLatestHasher(hasher))
+	require.False(t, IsLatestHasher(DefaultArgon2id()))
+
+	str, err := Hash("password")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(str, "$argon2id$v=19$m=1024,t=1,p=1$"))
+
+	// Hashes with the previous parameters are now migrated
+	previous, err := DefaultArgon2id().Hash("password")
+	require.NoError(t, err)
+	previousHasher, _, err := GetHasherFromPHCString(previous)
+	require.NoError(t, err)
+	require.False(t, IsLatestHasher(previousHasher))
+}
+
+func TestGetAlgorithmFromPHCString(t *testing.T) {
+	testCases := []struct {
+		testName          string
+		input             string
+		expectedAlgorithm string
+		expectedLatest    bool
+	}{
+		{"latest Argon2id", "$argon2id$v=19$m=19456,t=2,p=1$5Zq8TvET7nMrXof49Rp4Sw$d0Mx8467kv+3ylbGrkyu4jTd8O8SP51k4s1RuWb9S/o", Argon2idAlgorithm, true},
+		{"latest Argon2id header", "$argon2id$v=19$m=19456,t=2,p=1", Argon2idAlgorithm, true},
+		{"Argon2id with other parameters", "$argon2id$v=19$m=65536,t=3,p=4", Argon2idAlgorithm, false},
+		{"invalid Argon2id", "$argon2id$v=19$m=1,t=0,p=0", Argon2idAlgorithm, false},
+		{"PBKDF2", "$pbkdf2$f=SHA256,w=600000,l=32$5Zq8TvET7nMrXof49Rp4Sw$d0Mx8467kv+3ylbGrkyu4jTd8O8SP51k4s1RuWb9S/o", PBKDF2Algorithm, false},
+		{"bcrypt", "$2a$10$z0OlN1MpiLVlLTyE1xtEjOJ6/xV95RAwwIUaYKQBAqoeyvPgLEnUa", BCryptAlgorithm, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			algorithm, latest := GetAlgorithmFromPHCString(tc.input)
+			require.Equal(t, tc.expectedAlgorithm, algorithm)
+			require.Equal(t, tc.expectedLatest, latest)
+		})
+	}
+}
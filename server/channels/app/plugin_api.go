@@ -190,7 +190,7 @@ func (api *PluginAPI) GetTeamsUnreadForUser(userID string) ([]*model.TeamUnread,
 }
 
 func (api *PluginAPI) UpdateTeam(team *model.Team) (*model.Team, *model.AppError) {
-	return api.app.UpdateTeam(team)
+	return api.app.UpdateTeam(api.ctx, team)
 }
 
 func (api *PluginAPI) GetTeamsForUser(userID string) ([]*model.Team, *model.AppError) {
@@ -683,14 +683,14 @@ func (api *PluginAPI) UpsertGroupMember(groupID string, userID string) (*model.G
 	if err := api.checkLDAPLicense(); err != nil {
 		return nil, model.NewAppError("UpsertGroupMember", "app.group.license_error", nil, "", http.StatusForbidden).Wrap(err)
 	}
-	return api.app.UpsertGroupMember(groupID, userID)
+	return api.app.UpsertGroupMember(api.ctx, groupID, userID)
 }
 
 func (api *PluginAPI) UpsertGroupMembers(groupID string, userIDs []string) ([]*model.GroupMember, *model.AppError) {
 	if err := api.checkLDAPLicense(); err != nil {
 		return nil, model.NewAppError("UpsertGroupMembers", "app.group.license_error", nil, "", http.StatusForbidden).Wrap(err)
 	}
-	return api.app.UpsertGroupMembers(groupID, userIDs)
+	return api.app.UpsertGroupMembers(api.ctx, groupID, userIDs)
 }
 
 func (api *PluginAPI) GetGroupByRemoteID(remoteID string, groupSource model.GroupSource) (*model.Group, *model.AppError) {
@@ -732,7 +732,7 @@ func (api *PluginAPI) DeleteGroupMember(groupID string, userID string) (*model.G
 	if err := api.checkLDAPLicense(); err != nil {
 		return nil, model.NewAppError("DeleteGroupMember", "app.group.license_error", nil, "", http.StatusForbidden).Wrap(err)
 	}
-	return api.app.DeleteGroupMember(groupID, userID)
+	return api.app.DeleteGroupMember(api.ctx, groupID, userID)
 }
 
 func (api *PluginAPI) GetGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, *model.AppError) {
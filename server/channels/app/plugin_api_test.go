@@ -16,6 +16,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -2620,6 +2621,56 @@ func TestPluginServeMetrics(t *testing.T) {
 	require.Equal(t, "METRICS SUBPATH", string(body))
 }
 
+// TestGRPCPythonPlugin activates a plugin written in Python, speaking the gRPC plugin protocol,
+// and expects its MessageWillBePosted hook to return "OK" like the Go plugins of plugin_api_tests.
+func TestGRPCPythonPlugin(t *testing.T) {
+	mainHelper.Parallel(t)
+
+	python, err := exec.LookPath("python3")
+	if err != nil {
+		t.Skip("python3 is required to run the gRPC Python plugin")
+	}
+	if out, err := exec.Command(python, "-c", "import grpc, grpc_tools, grpc_health").CombinedOutput(); err != nil {
+		t.Skipf("grpcio, grpcio-tools and grpcio-health-checking are required to run the gRPC Python plugin: %s", out)
+	}
+
+	th := Setup(t)
+	defer th.TearDown()
+
+	pluginID := "test_grpc_python_plugin"
+	pluginDir := t.TempDir()
+	backendDir := filepath.Join(pluginDir, pluginID)
+	require.NoError(t, os.Mkdir(backendDir, 0700))
+
+	code, err := os.ReadFile(filepath.Join(server.GetPackagePath(), "channels", "app", "plugin_api_tests", "manual.test_grpc_python_plugin", "plugin.py"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(backendDir, "plugin.py"), code, 0700))
+
+	protoDir := filepath.Join(server.GetPackagePath(), "public", "plugin", "grpcproto")
+	out, err := exec.Command(python, "-m", "grpc_tools.protoc", "-I", protoDir, "--python_out="+backendDir, "--grpc_python_out="+backendDir, "mattermost_plugin.proto").CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	manifest := fmt.Sprintf(`{"id": "%s", "server": {"executable": "plugin.py", "protocol": "grpc"}}`, pluginID)
+	require.NoError(t, os.WriteFile(filepath.Join(backendDir, "plugin.json"), []byte(manifest), 0600))
+
+	env, err := plugin.NewEnvironment(th.NewPluginAPI, NewDriverImpl(th.App.Srv()), pluginDir, t.TempDir(), th.App.Log(), nil)
+	require.NoError(t, err)
+	th.App.ch.SetPluginsEnvironment(env)
+
+	_, activated, err := env.Activate(pluginID)
+	require.NoError(t, err)
+	require.True(t, activated)
+
+	hooks, err := env.HooksForPlugin(pluginID)
+	require.NoError(t, err)
+	_, ret := hooks.MessageWillBePosted(nil, nil)
+	assert.Equal(t, "OK", ret)
+
+	value, appErr := th.App.GetPluginKey(pluginID, "some_key")
+	require.Nil(t, appErr)
+	assert.Equal(t, []byte("some data"), value)
+}
+
 func TestPluginGetChannelsForTeamForUser(t *testing.T) {
 	mainHelper.Parallel(t)
 	th := Setup(t).InitBasic()
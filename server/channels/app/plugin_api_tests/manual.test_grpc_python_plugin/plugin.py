@@ -0,0 +1,94 @@
+#!/usr/bin/env python3
+# Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+# See LICENSE.txt for license information.
+
+"""Sample server plugin written in Python, speaking the gRPC plugin protocol.
+
+It requires the grpcio and grpcio-health-checking packages, and the modules generated from
+server/public/plugin/grpcproto/mattermost_plugin.proto next to this file:
+
+    python3 -m grpc_tools.protoc -I server/public/plugin/grpcproto \
+        --python_out=. --grpc_python_out=. mattermost_plugin.proto
+"""
+
+import os
+import sys
+from concurrent import futures
+
+import grpc
+from grpc_health.v1 import health, health_pb2, health_pb2_grpc
+
+import mattermost_plugin_pb2 as pb
+import mattermost_plugin_pb2_grpc as pb_grpc
+
+MAGIC_COOKIE_KEY = "MATTERMOST_PLUGIN"
+MAGIC_COOKIE_VALUE = "Securely message teams, anywhere."
+
+
+class APIError(Exception):
+    pass
+
+
+class API:
+    """Client of the plugin API served by the Mattermost server."""
+
+    def __init__(self, target):
+        self.stub = pb_grpc.APIStub(grpc.insecure_channel(target))
+
+    def kv_set(self, key, value):
+        resp = self.stub.KVSet(pb.KVSetRequest(key=key, value=value))
+        if resp.HasField("error"):
+            raise APIError(resp.error.message)
+
+    def kv_get(self, key):
+        resp = self.stub.KVGet(pb.KVGetRequest(key=key))
+        if resp.HasField("error"):
+            raise APIError(resp.error.message)
+        return resp.result
+
+
+class Hooks(pb_grpc.HooksServicer):
+    def __init__(self):
+        self.api = None
+
+    def Implemented(self, request, context):
+        return pb.ImplementedResponse(hooks=["OnActivate", "MessageWillBePosted"])
+
+    def OnActivate(self, request, context):
+        self.api = API(request.api_target)
+        return pb.OnActivateResponse()
+
+    def MessageWillBePosted(self, request, context):
+        data = b"some data"
+        try:
+            self.api.kv_set("some_key", data)
+            stored = self.api.kv_get("some_key")
+        except APIError as e:
+            return pb.MessageWillBePostedResponse(result_2=str(e))
+        if stored != data:
+            return pb.MessageWillBePostedResponse(result_2="Data not equal, expected: %r, got: %r" % (data, stored))
+
+        return pb.MessageWillBePostedResponse(result_2="OK")
+
+
+def main():
+    if os.environ.get(MAGIC_COOKIE_KEY) != MAGIC_COOKIE_VALUE:
+        sys.exit("This is a Mattermost plugin and must be started by the Mattermost server.")
+
+    server = grpc.server(futures.ThreadPoolExecutor(max_workers=10))
+    pb_grpc.add_HooksServicer_to_server(Hooks(), server)
+
+    health_servicer = health.HealthServicer()
+    health_servicer.set("plugin", health_pb2.HealthCheckResponse.SERVING)
+    health_pb2_grpc.add_HealthServicer_to_server(health_servicer, server)
+
+    port = server.add_insecure_port("127.0.0.1:0")
+    server.start()
+
+    # The handshake expected by the Mattermost server.
+    print("1|1|tcp|127.0.0.1:%d|grpc" % port, flush=True)
+    server.wait_for_termination()
+
+
+if __name__ == "__main__":
+    main()
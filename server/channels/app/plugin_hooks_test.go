@@ -2341,3 +2341,195 @@ func TestUserHasJoinedChannel(t *testing.T) {
 		}
 	})
 }
+
+func TestHookChannelWillBeCreated(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	tearDown, _, _ := SetAppEnvironmentWithPlugins(t, []string{
+		`
+		package main
+
+		import (
+			"strings"
+
+			"github.com/mattermost/mattermost/server/public/plugin"
+			"github.com/mattermost/mattermost/server/public/model"
+		)
+
+		type MyPlugin struct {
+			plugin.MattermostPlugin
+		}
+
+		func (p *MyPlugin) ChannelWillBeCreated(c *plugin.Context, channel *model.Channel) (*model.Channel, string) {
+			if !strings.HasPrefix(channel.Name, "team-") {
+				return nil, "channel names must start with team-"
+			}
+			channel.Purpose = "Created by the naming policy"
+			return channel, ""
+		}
+
+		func main() {
+			plugin.ClientMain(&MyPlugin{})
+		}
+		`,
+	}, th.App, th.NewPluginAPI)
+	defer tearDown()
+
+	t.Run("rejected", func(t *testing.T) {
+		_, appErr := th.App.CreateChannelWithUser(th.Context, &model.Channel{
+			TeamId:      th.BasicTeam.Id,
+			Name:        "random-" + model.NewId()[:8],
+			DisplayName: "Random",
+			Type:        model.ChannelTypeOpen,
+		}, th.BasicUser.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.channel.create_channel.rejected_by_plugin.app_error", appErr.Id)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+	})
+
+	t.Run("modified", func(t *testing.T) {
+		channel, appErr := th.App.CreateChannelWithUser(th.Context, &model.Channel{
+			TeamId:      th.BasicTeam.Id,
+			Name:        "team-" + model.NewId()[:8],
+			DisplayName: "Team",
+			Type:        model.ChannelTypePrivate,
+		}, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, "Created by the naming policy", channel.Purpose)
+
+		_, appErr = th.App.GetChannelMember(th.Context, channel.Id, th.BasicUser.Id)
+		require.Nil(t, appErr)
+	})
+
+	t.Run("not invoked for direct channels", func(t *testing.T) {
+		_, appErr := th.App.GetOrCreateDirectChannel(th.Context, th.BasicUser.Id, th.BasicUser2.Id)
+		require.Nil(t, appErr)
+	})
+}
+
+func TestChannelTeamAndGroupLifecycleHooks(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	tearDown, pluginIDs, _ := SetAppEnvironmentWithPlugins(t, []string{
+		`
+		package main
+
+		import (
+			"github.com/mattermost/mattermost/server/public/plugin"
+			"github.com/mattermost/mattermost/server/public/model"
+		)
+
+		type MyPlugin struct {
+			plugin.MattermostPlugin
+		}
+
+		func actorID(actor *model.User) string {
+			if actor == nil {
+				return ""
+			}
+			return actor.Id
+		}
+
+		func (p *MyPlugin) ChannelHasBeenUpdated(c *plugin.Context, newChannel, oldChannel *model.Channel) {
+			p.API.KVSet("channel_updated_"+newChannel.Id, []byte(oldChannel.Purpose+" -> "+newChannel.Purpose))
+		}
+
+		func (p *MyPlugin) ChannelHasBeenArchived(c *plugin.Context, channel *model.Channel, actor *model.User) {
+			p.API.KVSet("channel_archived_"+channel.Id, []byte(actorID(actor)))
+		}
+
+		func (p *MyPlugin) ChannelHasBeenRestored(c *plugin.Context, channel *model.Channel, actor *model.User) {
+			p.API.KVSet("channel_restored_"+channel.Id, []byte(actorID(actor)))
+		}
+
+		func (p *MyPlugin) ChannelHasBeenDeleted(c *plugin.Context, channel *model.Channel) {
+			p.API.KVSet("channel_deleted_"+channel.Id, []byte(channel.Name))
+		}
+
+		func (p *MyPlugin) TeamHasBeenCreated(c *plugin.Context, team *model.Team) {
+			p.API.KVSet("team_created_"+team.Id, []byte(team.Name))
+		}
+
+		func (p *MyPlugin) TeamHasBeenUpdated(c *plugin.Context, newTeam, oldTeam *model.Team) {
+			p.API.KVSet("team_updated_"+newTeam.Id, []byte(oldTeam.DisplayName+" -> "+newTeam.DisplayName))
+		}
+
+		func (p *MyPlugin) UserHasJoinedGroup(c *plugin.Context, groupMember *model.GroupMember, actor *model.User) {
+			p.API.KVSet("group_joined_"+groupMember.GroupId, []byte(groupMember.UserId))
+		}
+
+		func (p *MyPlugin) UserHasLeftGroup(c *plugin.Context, groupMember *model.GroupMember, actor *model.User) {
+			p.API.KVSet("group_left_"+groupMember.GroupId, []byte(groupMember.UserId))
+		}
+
+		func main() {
+			plugin.ClientMain(&MyPlugin{})
+		}
+		`,
+	}, th.App, th.NewPluginAPI)
+	defer tearDown()
+	require.Len(t, pluginIDs, 1)
+
+	requireKey := func(t *testing.T, key, expected string) {
+		t.Helper()
+		require.EventuallyWithT(t, func(c *assert.CollectT) {
+			value, appErr := th.App.GetPluginKey(pluginIDs[0], key)
+			assert.Nil(c, appErr)
+			assert.Equal(c, expected, string(value))
+		}, 5*time.Second, 100*time.Millisecond)
+	}
+
+	t.Run("channel", func(t *testing.T) {
+		channel := th.CreateChannel(th.Context, th.BasicTeam)
+		oldPurpose := channel.Purpose
+
+		_, appErr := th.App.PatchChannel(th.Context, channel, &model.ChannelPatch{Purpose: model.NewPointer("Governed")}, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		requireKey(t, "channel_updated_"+channel.Id, oldPurpose+" -> Governed")
+
+		appErr = th.App.DeleteChannel(th.Context, channel, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		requireKey(t, "channel_archived_"+channel.Id, th.BasicUser.Id)
+
+		channel, appErr = th.App.GetChannel(th.Context, channel.Id)
+		require.Nil(t, appErr)
+		_, appErr = th.App.RestoreChannel(th.Context, channel, th.BasicUser2.Id)
+		require.Nil(t, appErr)
+		requireKey(t, "channel_restored_"+channel.Id, th.BasicUser2.Id)
+
+		appErr = th.App.PermanentDeleteChannel(th.Context, channel)
+		require.Nil(t, appErr)
+		requireKey(t, "channel_deleted_"+channel.Id, channel.Name)
+	})
+
+	t.Run("team", func(t *testing.T) {
+		team := th.CreateTeam()
+		requireKey(t, "team_created_"+team.Id, team.Name)
+
+		_, appErr := th.App.PatchTeam(th.Context, team.Id, &model.TeamPatch{DisplayName: model.NewPointer("Renamed")})
+		require.Nil(t, appErr)
+		requireKey(t, "team_updated_"+team.Id, team.DisplayName+" -> Renamed")
+
+		team, appErr = th.App.GetTeam(team.Id)
+		require.Nil(t, appErr)
+		_, appErr = th.App.RenameTeam(th.Context, team, "-", "Renamed again")
+		require.Nil(t, appErr)
+		requireKey(t, "team_updated_"+team.Id, "Renamed -> Renamed again")
+	})
+
+	t.Run("group", func(t *testing.T) {
+		group := th.CreateGroup()
+
+		_, appErr := th.App.UpsertGroupMember(th.Context, group.Id, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		requireKey(t, "group_joined_"+group.Id, th.BasicUser.Id)
+
+		_, appErr = th.App.DeleteGroupMember(th.Context, group.Id, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		requireKey(t, "group_left_"+group.Id, th.BasicUser.Id)
+	})
+}
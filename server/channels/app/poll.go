@@ -0,0 +1,401 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/i18n"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+const pollsBatchSize = 100
+
+// CreatePoll saves a poll and posts it to its channel as its creator.
+func (a *App) CreatePoll(rctx request.CTX, poll *model.Poll) (*model.Poll, *model.AppError) {
+	poll.PostId = ""
+	poll.ClosedAt = 0
+	if poll.CloseAt != 0 && poll.CloseAt <= model.GetMillis() {
+		return nil, model.NewAppError("CreatePoll", "app.poll.create.close_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	saved, err := a.Srv().Store().Poll().Save(poll)
+	if err != nil {
+		var appErr *model.AppError
+		var invErr *store.ErrInvalidInput
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &invErr):
+			return nil, model.NewAppError("CreatePoll", "app.poll.save.existing.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+		default:
+			return nil, model.NewAppError("CreatePoll", "app.poll.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	post := &model.Post{
+		ChannelId: saved.ChannelId,
+		UserId:    saved.CreatorId,
+		Type:      model.PostTypePoll,
+	}
+	if appErr := a.renderPollPost(post, saved, saved.Results(nil)); appErr != nil {
+		return nil, appErr
+	}
+
+	rpost, appErr := a.createPostAsUser(rctx, post, rctx.Session().Id, model.CreatePostFlags{TriggerWebhooks: true, SetOnline: true, AllowBuiltInPostActions: true})
+	if appErr != nil {
+		saved.DeleteAt = model.GetMillis()
+		if _, err = a.Srv().Store().Poll().Update(saved); err != nil {
+			rctx.Logger().Warn("Failed to delete the poll of a post that couldn't be created", mlog.String("poll_id", saved.Id), mlog.Err(err))
+		}
+		return nil, appErr
+	}
+
+	saved.PostId = rpost.Id
+	if saved, err = a.Srv().Store().Poll().Update(saved); err != nil {
+		return nil, model.NewAppError("CreatePoll", "app.poll.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return saved, nil
+}
+
+func (a *App) GetPoll(id string) (*model.Poll, *model.AppError) {
+	poll, err := a.Srv().Store().Poll().Get(id)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetPoll", "app.poll.get.not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetPoll", "app.poll.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return poll, nil
+}
+
+func (a *App) GetPollResults(poll *model.Poll) (*model.PollResults, *model.AppError) {
+	votes, err := a.Srv().Store().Poll().GetVotes(poll.Id)
+	if err != nil {
+		return nil, model.NewAppError("GetPollResults", "app.poll.get_votes.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return poll.Results(votes), nil
+}
+
+// VotePoll toggles the vote of the user for an option of the poll. Voting for
+// an option of a single choice poll removes the other vote of the user.
+func (a *App) VotePoll(rctx request.CTX, poll *model.Poll, userID, optionID string) (*model.PollResults, *model.AppError) {
+	if poll.IsClosed(model.GetMillis()) {
+		return nil, model.NewAppError("VotePoll", "app.poll.vote.closed.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if poll.Option(optionID) == nil {
+		return nil, model.NewAppError("VotePoll", "app.poll.vote.option.app_error", nil, "option_id="+optionID, http.StatusBadRequest)
+	}
+
+	votes, err := a.Srv().Store().Poll().GetVotes(poll.Id)
+	if err != nil {
+		return nil, model.NewAppError("VotePoll", "app.poll.get_votes.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	voted := false
+	for _, vote := range votes {
+		if vote.UserId == userID && vote.OptionId == optionID {
+			voted = true
+			break
+		}
+	}
+
+	if voted {
+		err = a.Srv().Store().Poll().DeleteVote(poll.Id, optionID, userID)
+	} else {
+		err = a.Srv().Store().Poll().SaveVote(&model.PollVote{
+			PollId:   poll.Id,
+			OptionId: optionID,
+			UserId:   userID,
+		}, !poll.MultipleChoice)
+	}
+	if err != nil {
+		return nil, model.NewAppError("VotePoll", "app.poll.vote.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	results, appErr := a.GetPollResults(poll)
+	if appErr != nil {
+		return nil, appErr
+	}
+	a.publishPollUpdated(rctx, poll, results)
+
+	return results, nil
+}
+
+// ClosePoll stops the poll from accepting votes and updates its post with the
+// final results.
+func (a *App) ClosePoll(rctx request.CTX, poll *model.Poll) (*model.Poll, *model.AppError) {
+	if poll.ClosedAt != 0 {
+		return poll, nil
+	}
+
+	poll.ClosedAt = model.GetMillis()
+	poll, err := a.Srv().Store().Poll().Update(poll)
+	if err != nil {
+		return nil, model.NewAppError("ClosePoll", "app.poll.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	results, appErr := a.GetPollResults(poll)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if poll.PostId != "" {
+		post, appErr := a.GetSinglePost(rctx, poll.PostId, false)
+		if appErr != nil {
+			return nil, appErr
+		}
+
+		update := post.Clone()
+		if appErr = a.renderPollPost(update, poll, results); appErr != nil {
+			return nil, appErr
+		}
+		if _, appErr = a.UpdatePost(rctx, update, &model.UpdatePostOptions{SafeUpdate: false, AllowBuiltInPostActions: true}); appErr != nil {
+			return nil, appErr
+		}
+	}
+
+	a.publishPollUpdated(rctx, poll, results)
+
+	return poll, nil
+}
+
+// CloseExpiredPolls closes the polls whose close time has passed.
+func (a *App) CloseExpiredPolls(rctx request.CTX) *model.AppError {
+	now := model.GetMillis()
+
+	for {
+		polls, err := a.Srv().Store().Poll().GetExpired(now, pollsBatchSize)
+		if err != nil {
+			return model.NewAppError("CloseExpiredPolls", "app.poll.get_expired.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		closed := 0
+		for _, poll := range polls {
+			if _, appErr := a.ClosePoll(rctx, poll); appErr != nil {
+				rctx.Logger().Warn("Failed to close poll", mlog.String("poll_id", poll.Id), mlog.Err(appErr))
+				continue
+			}
+			closed++
+		}
+
+		// Stop when there are no more expired polls, or when none of them
+		// could be closed, to retry them on the next run.
+		if len(polls) < pollsBatchSize || closed == 0 {
+			return nil
+		}
+	}
+}
+
+// CanClosePoll returns whether the user created the poll or can edit the
+// posts of others in its channel.
+func (a *App) CanClosePoll(rctx request.CTX, poll *model.Poll, userID string) bool {
+	return poll.CreatorId == userID || a.HasPermissionToChannel(rctx, userID, poll.ChannelId, model.PermissionEditOthersPosts)
+}
+
+func (a *App) publishPollUpdated(rctx request.CTX, poll *model.Poll, results *model.PollResults) {
+	pollJSON, err := json.Marshal(poll)
+	if err != nil {
+		rctx.Logger().Warn("Failed to encode poll to JSON", mlog.Err(err))
+		return
+	}
+	resultsJSON, err := json.Marshal(results)
+	if err != nil {
+		rctx.Logger().Warn("Failed to encode poll results to JSON", mlog.Err(err))
+		return
+	}
+
+	message := model.NewWebSocketEvent(model.WebsocketEventPollUpdated, "", poll.ChannelId, "", nil, "")
+	message.Add("poll", string(pollJSON))
+	message.Add("results", string(resultsJSON))
+	a.Publish(message)
+}
+
+// renderPollPost sets the message, props and attachment of the post of a poll
+// for the given results. The post is shared by all the members of the channel,
+// so it is rendered in the default language of the server.
+func (a *App) renderPollPost(post *model.Post, poll *model.Poll, results *model.PollResults) *model.AppError {
+	usernames := map[string]string{}
+	if !poll.Anonymous {
+		var userIDs []string
+		for _, voters := range results.Voters {
+			for _, userID := range voters {
+				if _, ok := usernames[userID]; !ok {
+					usernames[userID] = ""
+					userIDs = append(userIDs, userID)
+				}
+			}
+		}
+		if len(userIDs) > 0 {
+			users, appErr := a.GetUsers(userIDs)
+			if appErr != nil {
+				return appErr
+			}
+			for _, user := range users {
+				usernames[user.Id] = user.Username
+			}
+		}
+	}
+
+	post.Message = poll.Question
+	post.AddProp(model.PostPropsPollId, poll.Id)
+	post.AddProp(model.PostPropsPollResults, poll.ResultsText(results, usernames))
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{pollAttachment(poll, results, usernames, i18n.T)})
+
+	return nil
+}
+
+func pollAttachment(poll *model.Poll, results *model.PollResults, usernames map[string]string, T i18n.TranslateFunc) *model.SlackAttachment {
+	total := 0
+	for _, count := range results.Counts {
+		total += count
+	}
+
+	lines := make([]string, 0, len(poll.Options))
+	for _, option := range poll.Options {
+		count := results.Counts[option.Id]
+		percent := 0
+		if total > 0 {
+			percent = count * 100 / total
+		}
+		line := T("app.poll.post.option", map[string]any{"Option": option.Text, "Count": count, "Percent": percent})
+
+		voters := make([]string, 0, len(results.Voters[option.Id]))
+		for _, userID := range results.Voters[option.Id] {
+			if username := usernames[userID]; username != "" {
+				voters = append(voters, "@"+username)
+			}
+		}
+		if len(voters) > 0 {
+			line += fmt.Sprintf(" (%s)", strings.Join(voters, ", "))
+		}
+		lines = append(lines, line)
+	}
+
+	var footer []string
+	if poll.Anonymous {
+		footer = append(footer, T("app.poll.post.anonymous"))
+	}
+	if poll.MultipleChoice {
+		footer = append(footer, T("app.poll.post.multiple_choice"))
+	}
+	footer = append(footer, T("app.poll.post.voters", map[string]any{"Count": results.TotalVoters}))
+
+	attachment := &model.SlackAttachment{
+		Text: strings.Join(lines, "\n"),
+	}
+
+	if poll.ClosedAt != 0 {
+		footer = append(footer, T("app.poll.post.closed"))
+		attachment.Footer = strings.Join(footer, " · ")
+		return attachment
+	}
+
+	if poll.CloseAt != 0 {
+		attachment.Timestamp = poll.CloseAt / 1000
+		footer = append(footer, T("app.poll.post.closes"))
+	}
+	attachment.Footer = strings.Join(footer, " · ")
+
+	integration := func(action string, context map[string]any) *model.PostActionIntegration {
+		context[model.PostPropsPollId] = poll.Id
+		context["action"] = action
+		return &model.PostActionIntegration{
+			URL:     model.PostActionBuiltInURLPrefix + model.PollPostActionName,
+			Context: context,
+		}
+	}
+
+	for _, option := range poll.Options {
+		attachment.Actions = append(attachment.Actions, &model.PostAction{
+			Type:        model.PostActionTypeButton,
+			Name:        option.Text,
+			Integration: integration(model.PollPostActionVote, map[string]any{"option_id": option.Id}),
+		})
+	}
+	attachment.Actions = append(attachment.Actions, &model.PostAction{
+		Type:        model.PostActionTypeButton,
+		Name:        T("app.poll.action.close"),
+		Style:       "danger",
+		Integration: integration(model.PollPostActionClose, map[string]any{}),
+	})
+
+	return attachment
+}
+
+// doPollPostAction votes for an option of the poll of a post, or closes it.
+// Only the actions of the post the poll was created with are accepted, as the
+// actions of other posts could have been written by anyone.
+func (a *App) doPollPostAction(rctx request.CTX, upstreamRequest *model.PostActionIntegrationRequest) (*model.PostActionIntegrationResponse, *model.AppError) {
+	pollID, _ := upstreamRequest.Context[model.PostPropsPollId].(string)
+	optionID, _ := upstreamRequest.Context["option_id"].(string)
+	action, _ := upstreamRequest.Context["action"].(string)
+
+	user, appErr := a.GetUser(upstreamRequest.UserId)
+	if appErr != nil {
+		return nil, appErr
+	}
+	T := i18n.GetUserTranslations(user.Locale)
+
+	poll, appErr := a.GetPoll(pollID)
+	if appErr != nil {
+		if appErr.StatusCode == http.StatusNotFound {
+			return &model.PostActionIntegrationResponse{EphemeralText: T("app.poll.action.deleted")}, nil
+		}
+		return nil, appErr
+	}
+
+	if poll.PostId == "" || poll.PostId != upstreamRequest.PostId {
+		return nil, model.NewAppError("doPollPostAction", "app.poll.action.post.app_error", nil, "post_id="+upstreamRequest.PostId, http.StatusBadRequest)
+	}
+
+	switch action {
+	case model.PollPostActionVote:
+		if poll.IsClosed(model.GetMillis()) {
+			return &model.PostActionIntegrationResponse{EphemeralText: T("app.poll.action.closed")}, nil
+		}
+
+		results, appErr := a.VotePoll(rctx, poll, user.Id, optionID)
+		if appErr != nil {
+			return nil, appErr
+		}
+
+		post, appErr := a.GetSinglePost(rctx, poll.PostId, false)
+		if appErr != nil {
+			return nil, appErr
+		}
+		update := post.Clone()
+		if appErr = a.renderPollPost(update, poll, results); appErr != nil {
+			return nil, appErr
+		}
+
+		return &model.PostActionIntegrationResponse{Update: update}, nil
+	case model.PollPostActionClose:
+		if !a.CanClosePoll(rctx, poll, user.Id) {
+			return &model.PostActionIntegrationResponse{EphemeralText: T("app.poll.action.close.permissions")}, nil
+		}
+
+		if _, appErr = a.ClosePoll(rctx, poll); appErr != nil {
+			return nil, appErr
+		}
+
+		return &model.PostActionIntegrationResponse{}, nil
+	default:
+		return nil, model.NewAppError("doPollPostAction", "app.poll.action.unknown.app_error", nil, "action="+action, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestCreatePoll(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("posts the poll", func(t *testing.T) {
+		poll, appErr := th.App.CreatePoll(th.Context, &model.Poll{
+			CreatorId: th.BasicUser.Id,
+			ChannelId: th.BasicChannel.Id,
+			Question:  "Where do we go for lunch?",
+			Options:   model.PollOptions{{Text: "Pizza"}, {Text: "Sushi"}},
+		})
+		require.Nil(t, appErr)
+		require.NotEmpty(t, poll.PostId)
+
+		post, appErr := th.App.GetSinglePost(th.Context, poll.PostId, false)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.PostTypePoll, post.Type)
+		assert.Equal(t, th.BasicUser.Id, post.UserId)
+		assert.Equal(t, poll.Question, post.Message)
+		assert.Equal(t, poll.Id, post.GetProp(model.PostPropsPollId))
+
+		attachments := post.Attachments()
+		require.Len(t, attachments, 1)
+		require.Len(t, attachments[0].Actions, 3, "one button per option and one to end the poll")
+		assert.Equal(t, "Pizza", attachments[0].Actions[0].Name)
+	})
+
+	t.Run("close time in the past", func(t *testing.T) {
+		_, appErr := th.App.CreatePoll(th.Context, &model.Poll{
+			CreatorId: th.BasicUser.Id,
+			ChannelId: th.BasicChannel.Id,
+			Question:  "Where do we go for lunch?",
+			Options:   model.PollOptions{{Text: "Pizza"}, {Text: "Sushi"}},
+			CloseAt:   model.GetMillis() - 1000,
+		})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.poll.create.close_at.app_error", appErr.Id)
+	})
+
+	t.Run("invalid poll", func(t *testing.T) {
+		_, appErr := th.App.CreatePoll(th.Context, &model.Poll{
+			CreatorId: th.BasicUser.Id,
+			ChannelId: th.BasicChannel.Id,
+			Question:  "Where do we go for lunch?",
+			Options:   model.PollOptions{{Text: "Pizza"}},
+		})
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+	})
+}
+
+func TestDoPollPostAction(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	createPoll := func(t *testing.T, poll *model.Poll) (*model.Poll, *model.Post) {
+		t.Helper()
+
+		poll.CreatorId = th.BasicUser.Id
+		poll.ChannelId = th.BasicChannel.Id
+		poll.Question = "Where do we go for lunch?"
+		poll.Options = model.PollOptions{{Text: "Pizza"}, {Text: "Sushi"}}
+
+		poll, appErr := th.App.CreatePoll(th.Context, poll)
+		require.Nil(t, appErr)
+
+		post, appErr := th.App.GetSinglePost(th.Context, poll.PostId, false)
+		require.Nil(t, appErr)
+
+		return poll, post
+	}
+
+	actionID := func(t *testing.T, post *model.Post, action, optionID string) string {
+		t.Helper()
+
+		for _, a := range post.Attachments()[0].Actions {
+			if a.Integration.Context["action"] == action && (optionID == "" || a.Integration.Context["option_id"] == optionID) {
+				return a.Id
+			}
+		}
+		require.Fail(t, "action not found")
+		return ""
+	}
+
+	t.Run("single choice", func(t *testing.T) {
+		poll, post := createPoll(t, &model.Poll{})
+		pizza, sushi := poll.Options[0].Id, poll.Options[1].Id
+
+		_, appErr := th.App.DoPostActionWithCookie(th.Context, post.Id, actionID(t, post, model.PollPostActionVote, pizza), th.BasicUser2.Id, "", nil)
+		require.Nil(t, appErr)
+
+		results, appErr := th.App.GetPollResults(poll)
+		require.Nil(t, appErr)
+		assert.Equal(t, 1, results.Counts[pizza])
+		assert.Equal(t, []string{th.BasicUser2.Id}, results.Voters[pizza])
+
+		_, appErr = th.App.DoPostActionWithCookie(th.Context, post.Id, actionID(t, post, model.PollPostActionVote, sushi), th.BasicUser2.Id, "", nil)
+		require.Nil(t, appErr)
+
+		results, appErr = th.App.GetPollResults(poll)
+		require.Nil(t, appErr)
+		assert.Equal(t, 0, results.Counts[pizza], "voting for another option must replace the vote")
+		assert.Equal(t, 1, results.Counts[sushi])
+
+		updated, appErr := th.App.GetSinglePost(th.Context, post.Id, false)
+		require.Nil(t, appErr)
+		assert.Contains(t, updated.Attachments()[0].Text, "@"+th.BasicUser2.Username)
+		assert.Contains(t, updated.GetProp(model.PostPropsPollResults), "Sushi: 1")
+		assert.Equal(t, poll.Id, updated.GetProp(model.PostPropsPollId))
+
+		_, appErr = th.App.DoPostActionWithCookie(th.Context, post.Id, actionID(t, post, model.PollPostActionVote, sushi), th.BasicUser2.Id, "", nil)
+		require.Nil(t, appErr)
+
+		results, appErr = th.App.GetPollResults(poll)
+		require.Nil(t, appErr)
+		assert.Zero(t, results.TotalVoters, "voting again for an option must remove the vote")
+	})
+
+	t.Run("anonymous multiple choice", func(t *testing.T) {
+		poll, post := createPoll(t, &model.Poll{Anonymous: true, MultipleChoice: true})
+
+		for _, option := range poll.Options {
+			_, appErr := th.App.DoPostActionWithCookie(th.Context, post.Id, actionID(t, post, model.PollPostActionVote, option.Id), th.BasicUser2.Id, "", nil)
+			require.Nil(t, appErr)
+		}
+
+		results, appErr := th.App.GetPollResults(poll)
+		require.Nil(t, appErr)
+		assert.Equal(t, 1, results.Counts[poll.Options[0].Id])
+		assert.Equal(t, 1, results.Counts[poll.Options[1].Id])
+		assert.Equal(t, 1, results.TotalVoters)
+		assert.Nil(t, results.Voters)
+
+		updated, appErr := th.App.GetSinglePost(th.Context, post.Id, false)
+		require.Nil(t, appErr)
+		assert.NotContains(t, updated.Attachments()[0].Text, th.BasicUser2.Username)
+	})
+
+	t.Run("close", func(t *testing.T) {
+		poll, post := createPoll(t, &model.Poll{})
+
+		_, appErr := th.App.DoPostActionWithCookie(th.Context, post.Id, actionID(t, post, model.PollPostActionClose, ""), th.BasicUser2.Id, "", nil)
+		require.Nil(t, appErr)
+
+		unchanged, appErr := th.App.GetPoll(poll.Id)
+		require.Nil(t, appErr)
+		assert.Zero(t, unchanged.ClosedAt, "only the creator can end the poll")
+
+		_, appErr = th.App.DoPostActionWithCookie(th.Context, post.Id, actionID(t, post, model.PollPostActionClose, ""), th.BasicUser.Id, "", nil)
+		require.Nil(t, appErr)
+
+		closed, appErr := th.App.GetPoll(poll.Id)
+		require.Nil(t, appErr)
+		assert.NotZero(t, closed.ClosedAt)
+
+		updated, appErr := th.App.GetSinglePost(th.Context, post.Id, false)
+		require.Nil(t, appErr)
+		assert.Empty(t, updated.Attachments()[0].Actions)
+
+		_, appErr = th.App.VotePoll(th.Context, closed, th.BasicUser2.Id, poll.Options[0].Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.poll.vote.closed.app_error", appErr.Id)
+	})
+
+	t.Run("forged post", func(t *testing.T) {
+		poll, _ := createPoll(t, &model.Poll{})
+
+		_, appErr := th.App.doPollPostAction(th.Context, &model.PostActionIntegrationRequest{
+			UserId: th.BasicUser2.Id,
+			PostId: model.NewId(),
+			Context: map[string]any{
+				model.PostPropsPollId: poll.Id,
+				"option_id":           poll.Options[0].Id,
+				"action":              model.PollPostActionVote,
+			},
+		})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.poll.action.post.app_error", appErr.Id)
+	})
+}
+
+func TestCloseExpiredPolls(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	poll, appErr := th.App.CreatePoll(th.Context, &model.Poll{
+		CreatorId: th.BasicUser.Id,
+		ChannelId: th.BasicChannel.Id,
+		Question:  "Where do we go for lunch?",
+		Options:   model.PollOptions{{Text: "Pizza"}, {Text: "Sushi"}},
+		CloseAt:   model.GetMillis() + 60000,
+	})
+	require.Nil(t, appErr)
+
+	poll.CloseAt = model.GetMillis() - 1000
+	_, err := th.App.Srv().Store().Poll().Update(poll)
+	require.NoError(t, err)
+
+	require.Nil(t, th.App.CloseExpiredPolls(th.Context))
+
+	closed, appErr := th.App.GetPoll(poll.Id)
+	require.Nil(t, appErr)
+	assert.NotZero(t, closed.ClosedAt)
+
+	post, appErr := th.App.GetSinglePost(th.Context, poll.PostId, false)
+	require.Nil(t, appErr)
+	assert.Empty(t, post.Attachments()[0].Actions)
+}
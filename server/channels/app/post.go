@@ -35,6 +35,10 @@ const (
 var atMentionPattern = regexp.MustCompile(`\B@`)
 
 func (a *App) CreatePostAsUser(rctx request.CTX, post *model.Post, currentSessionId string, setOnline bool) (*model.Post, *model.AppError) {
+	return a.createPostAsUser(rctx, post, currentSessionId, model.CreatePostFlags{TriggerWebhooks: true, SetOnline: setOnline})
+}
+
+func (a *App) createPostAsUser(rctx request.CTX, post *model.Post, currentSessionId string, flags model.CreatePostFlags) (*model.Post, *model.AppError) {
 	// Check that channel has not been deleted
 	channel, errCh := a.Srv().Store().Channel().Get(post.ChannelId, true)
 	if errCh != nil {
@@ -52,7 +56,7 @@ func (a *App) CreatePostAsUser(rctx request.CTX, post *model.Post, currentSessio
 		return nil, err
 	}
 
-	rp, err := a.CreatePost(rctx, post, channel, model.CreatePostFlags{TriggerWebhooks: true, SetOnline: setOnline})
+	rp, err := a.CreatePost(rctx, post, channel, flags)
 	if err != nil {
 		if err.Id == "api.post.create_post.root_id.app_error" ||
 			err.Id == "api.post.create_post.channel_root_id.app_error" {
@@ -152,6 +156,10 @@ func (a *App) CreatePost(rctx request.CTX, post *model.Post, channel *model.Chan
 		return nil, model.NewAppError("CreatePost", "app.post.create_post.shared_dm_or_gm.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if !flags.AllowBuiltInPostActions && post.HasBuiltInPostActions() {
+		return nil, model.NewAppError("CreatePost", "app.post.built_in_post_actions.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	foundPost, err := a.deduplicateCreatePost(rctx, post)
 	if err != nil {
 		return nil, err
@@ -366,6 +374,14 @@ func (a *App) CreatePost(rctx request.CTX, post *model.Post, channel *model.Chan
 			hooks.MessageHasBeenPosted(pluginContext, pluginPost)
 			return true
 		}, plugin.MessageHasBeenPostedID)
+
+		a.sendEventToSubscriptions(rctx, &model.EventSubscriptionPayload{
+			Event:     model.EventTypeMessageHasBeenPosted,
+			TeamId:    channel.TeamId,
+			ChannelId: channel.Id,
+			UserId:    pluginPost.UserId,
+			Data:      pluginPost,
+		})
 	})
 
 	// Normally, we would let the API layer call PreparePostForClient, but we do it here since it also needs
@@ -689,6 +705,13 @@ func (a *App) UpdatePost(rctx request.CTX, receivedUpdatedPost *model.Post, upda
 		return nil, appErr
 	}
 
+	// The built-in post actions of a post can only be kept as they are,
+	// unless the server itself changes them.
+	if !updatePostOptions.AllowBuiltInPostActions && receivedUpdatedPost.HasBuiltInPostActions() && !receivedUpdatedPost.AttachmentsEqual(oldPost) {
+		appErr = model.NewAppError("UpdatePost", "app.post.built_in_post_actions.app_error", nil, "id="+receivedUpdatedPost.Id, http.StatusBadRequest)
+		return nil, appErr
+	}
+
 	channel, appErr := a.GetChannel(rctx, oldPost.ChannelId)
 	if appErr != nil {
 		return nil, appErr
@@ -767,6 +790,17 @@ func (a *App) UpdatePost(rctx request.CTX, receivedUpdatedPost *model.Post, upda
 			hooks.MessageHasBeenUpdated(pluginContext, pluginNewPost, pluginOldPost)
 			return true
 		}, plugin.MessageHasBeenUpdatedID)
+
+		a.sendEventToSubscriptions(rctx, &model.EventSubscriptionPayload{
+			Event:     model.EventTypeMessageHasBeenUpdated,
+			TeamId:    channel.TeamId,
+			ChannelId: channel.Id,
+			UserId:    pluginNewPost.UserId,
+			Data: map[string]any{
+				"new_post": pluginNewPost,
+				"old_post": pluginOldPost,
+			},
+		})
 	})
 
 	rpost = a.PreparePostForClientWithEmbedsAndImages(rctx, rpost, false, true, true)
@@ -2717,6 +2751,15 @@ func (a *App) CleanUpAfterPostDeletion(rctx request.CTX, post *model.Post, delet
 			hooks.MessageHasBeenDeleted(pluginContext, pluginPost)
 			return true
 		}, plugin.MessageHasBeenDeletedID)
+
+		a.sendEventToSubscriptions(rctx, &model.EventSubscriptionPayload{
+			Event:     model.EventTypeMessageHasBeenDeleted,
+			TeamId:    channel.TeamId,
+			ChannelId: channel.Id,
+			UserId:    pluginPost.UserId,
+			ActorId:   deleteByID,
+			Data:      pluginPost,
+		})
 	})
 
 	a.Srv().Go(func() {
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/throttled/throttled"
@@ -19,8 +20,18 @@ import (
 	"github.com/mattermost/mattermost/server/v8/channels/utils"
 )
 
+// Quota classes applied on top of the default rate limit, to the routes that are more
+// expensive or sensitive than the others.
+const (
+	RateLimitClassLogin       = "login"
+	RateLimitClassFileUpload  = "file_upload"
+	RateLimitClassSearch      = "search"
+	RateLimitClassIntegration = "integration"
+)
+
 type RateLimiter struct {
 	throttledRateLimiter *throttled.GCRARateLimiter
+	classRateLimiters    map[string]*throttled.GCRARateLimiter
 	useAuth              bool
 	useIP                bool
 	header               string
@@ -33,6 +44,12 @@ func NewRateLimiter(settings *model.RateLimitSettings, trustedProxyIPHeader []st
 		return nil, errors.Wrap(err, i18n.T("api.server.start_server.rate_limiting_memory_store"))
 	}
 
+	return NewRateLimiterWithStore(settings, trustedProxyIPHeader, store)
+}
+
+// NewRateLimiterWithStore creates a rate limiter keeping its state in the given store, which
+// may be shared by all the nodes of a cluster.
+func NewRateLimiterWithStore(settings *model.RateLimitSettings, trustedProxyIPHeader []string, store throttled.GCRAStore) (*RateLimiter, error) {
 	quota := throttled.RateQuota{
 		MaxRate:  throttled.PerSec(*settings.PerSec),
 		MaxBurst: *settings.MaxBurst,
@@ -43,8 +60,30 @@ func NewRateLimiter(settings *model.RateLimitSettings, trustedProxyIPHeader []st
 		return nil, errors.Wrap(err, i18n.T("api.server.start_server.rate_limiting_rate_limiter"))
 	}
 
+	classRateLimiters := map[string]*throttled.GCRARateLimiter{}
+	for class, classQuota := range map[string][2]*int{
+		RateLimitClassLogin:       {settings.LoginPerMin, settings.LoginMaxBurst},
+		RateLimitClassFileUpload:  {settings.FileUploadPerMin, settings.FileUploadMaxBurst},
+		RateLimitClassSearch:      {settings.SearchPerMin, settings.SearchMaxBurst},
+		RateLimitClassIntegration: {settings.IntegrationPerMin, settings.IntegrationMaxBurst},
+	} {
+		if classQuota[0] == nil || *classQuota[0] <= 0 || classQuota[1] == nil {
+			continue
+		}
+
+		classRateLimiter, err := throttled.NewGCRARateLimiter(newPrefixedGCRAStore(store, class+":"), throttled.RateQuota{
+			MaxRate:  throttled.PerMin(*classQuota[0]),
+			MaxBurst: *classQuota[1],
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, i18n.T("api.server.start_server.rate_limiting_rate_limiter"))
+		}
+		classRateLimiters[class] = classRateLimiter
+	}
+
 	return &RateLimiter{
 		throttledRateLimiter: throttledRateLimiter,
+		classRateLimiters:    classRateLimiters,
 		useAuth:              *settings.VaryByUser,
 		useIP:                *settings.VaryByRemoteAddr,
 		header:               settings.VaryByHeader,
@@ -76,7 +115,11 @@ func (rl *RateLimiter) GenerateKey(r *http.Request) string {
 }
 
 func (rl *RateLimiter) RateLimitWriter(key string, w http.ResponseWriter) bool {
-	limited, context, err := rl.throttledRateLimiter.RateLimit(key, 1)
+	return rateLimitWriter(rl.throttledRateLimiter, key, w)
+}
+
+func rateLimitWriter(limiter *throttled.GCRARateLimiter, key string, w http.ResponseWriter) bool {
+	limited, context, err := limiter.RateLimit(key, 1)
 	if err != nil {
 		mlog.Error("Internal server error when rate limiting. Rate Limiting broken.", mlog.Err(err))
 		return false
@@ -99,6 +142,40 @@ func (rl *RateLimiter) UserIdRateLimit(userID string, w http.ResponseWriter) boo
 	return false
 }
 
+// SessionRateLimit limits the requests of an authenticated session. Bots and integrations
+// using a token get the integration quota per token, while users are limited by user id.
+func (rl *RateLimiter) SessionRateLimit(session *model.Session, w http.ResponseWriter) bool {
+	if session.IsIntegration() {
+		if limiter, ok := rl.classRateLimiters[RateLimitClassIntegration]; ok {
+			key := session.Id
+			if tokenID := session.Props[model.SessionPropUserAccessTokenId]; tokenID != "" {
+				key = tokenID
+			}
+			return rateLimitWriter(limiter, key, w)
+		}
+	}
+
+	return rl.UserIdRateLimit(session.UserId, w)
+}
+
+// RateLimitClass applies the quota of the given class to the request, keyed like the
+// default rate limit. It returns true if the request was denied.
+func (rl *RateLimiter) RateLimitClass(class string, r *http.Request, w http.ResponseWriter) bool {
+	limiter, ok := rl.classRateLimiters[class]
+	if !ok {
+		return false
+	}
+
+	key := rl.GenerateKey(r)
+	if key == "" {
+		// Quota classes protect sensitive routes, so never share a single quota between
+		// every client.
+		key = utils.GetIPAddress(r, rl.trustedProxyIPHeader)
+	}
+
+	return rateLimitWriter(limiter, key, w)
+}
+
 func (rl *RateLimiter) RateLimitHandler(wrappedHandler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		key := rl.GenerateKey(r)
@@ -109,23 +186,50 @@ func (rl *RateLimiter) RateLimitHandler(wrappedHandler http.Handler) http.Handle
 	})
 }
 
-// Copied from https://github.com/throttled/throttled http.go
+// Adapted from https://github.com/throttled/throttled http.go, adding the RateLimit-* headers
+// of the IETF draft next to the historical X-RateLimit-* ones. Headers are set rather than
+// added, so that a quota class applied after the default rate limit reports its own quota.
 func setRateLimitHeaders(w http.ResponseWriter, context throttled.RateLimitResult) {
 	if v := context.Limit; v >= 0 {
-		w.Header().Add("X-RateLimit-Limit", strconv.Itoa(v))
+		w.Header().Set("X-RateLimit-Limit", strconv.Itoa(v))
+		w.Header().Set("RateLimit-Limit", strconv.Itoa(v))
 	}
 
 	if v := context.Remaining; v >= 0 {
-		w.Header().Add("X-RateLimit-Remaining", strconv.Itoa(v))
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(v))
+		w.Header().Set("RateLimit-Remaining", strconv.Itoa(v))
 	}
 
 	if v := context.ResetAfter; v >= 0 {
 		vi := int(math.Ceil(v.Seconds()))
-		w.Header().Add("X-RateLimit-Reset", strconv.Itoa(vi))
+		w.Header().Set("X-RateLimit-Reset", strconv.Itoa(vi))
+		w.Header().Set("RateLimit-Reset", strconv.Itoa(vi))
 	}
 
 	if v := context.RetryAfter; v >= 0 {
 		vi := int(math.Ceil(v.Seconds()))
-		w.Header().Add("Retry-After", strconv.Itoa(vi))
+		w.Header().Set("Retry-After", strconv.Itoa(vi))
 	}
 }
+
+// prefixedGCRAStore namespaces the keys of a store, letting several rate limiters share it.
+type prefixedGCRAStore struct {
+	store  throttled.GCRAStore
+	prefix string
+}
+
+func newPrefixedGCRAStore(store throttled.GCRAStore, prefix string) *prefixedGCRAStore {
+	return &prefixedGCRAStore{store: store, prefix: prefix}
+}
+
+func (s *prefixedGCRAStore) GetWithTime(key string) (int64, time.Time, error) {
+	return s.store.GetWithTime(s.prefix + key)
+}
+
+func (s *prefixedGCRAStore) SetIfNotExistsWithTTL(key string, value int64, ttl time.Duration) (bool, error) {
+	return s.store.SetIfNotExistsWithTTL(s.prefix+key, value, ttl)
+}
+
+func (s *prefixedGCRAStore) CompareAndSwapWithTTL(key string, old, new int64, ttl time.Duration) (bool, error) {
+	return s.store.CompareAndSwapWithTTL(s.prefix+key, old, new, ttl)
+}
@@ -107,3 +107,84 @@ func TestGenerateKey_TrustedHeader(t *testing.T) {
 	key = rateLimiter.GenerateKey(req)
 	require.Equal(t, "10.10.10.5", key, "Wrong key on test without allowed trusted proxy header")
 }
+
+func TestRateLimitClass(t *testing.T) {
+	mainHelper.Parallel(t)
+	settings := genRateLimitSettings(false, true, "")
+	settings.LoginPerMin = model.NewPointer(1)
+	settings.LoginMaxBurst = model.NewPointer(1)
+	settings.SearchPerMin = model.NewPointer(0)
+	settings.SearchMaxBurst = model.NewPointer(1)
+
+	rateLimiter, err := NewRateLimiter(settings, nil)
+	require.NoError(t, err)
+
+	newRequest := func(ip string) *http.Request {
+		req := httptest.NewRequest("POST", "/api/v4/users/login", nil)
+		req.RemoteAddr = ip + ":80"
+		return req
+	}
+
+	for range 2 {
+		w := httptest.NewRecorder()
+		require.False(t, rateLimiter.RateLimitClass(RateLimitClassLogin, newRequest("10.0.0.1"), w))
+		require.Equal(t, "2", w.Header().Get("RateLimit-Limit"))
+		require.Equal(t, w.Header().Get("RateLimit-Remaining"), w.Header().Get("X-RateLimit-Remaining"))
+	}
+
+	w := httptest.NewRecorder()
+	require.True(t, rateLimiter.RateLimitClass(RateLimitClassLogin, newRequest("10.0.0.1"), w))
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "0", w.Header().Get("RateLimit-Remaining"))
+	require.NotEmpty(t, w.Header().Get("Retry-After"))
+
+	// The quota is per client, and doesn't consume the default one.
+	require.False(t, rateLimiter.RateLimitClass(RateLimitClassLogin, newRequest("10.0.0.2"), httptest.NewRecorder()))
+	require.False(t, rateLimiter.RateLimitWriter(rateLimiter.GenerateKey(newRequest("10.0.0.1")), httptest.NewRecorder()))
+
+	// Disabled classes never limit.
+	for range 5 {
+		require.False(t, rateLimiter.RateLimitClass(RateLimitClassSearch, newRequest("10.0.0.1"), httptest.NewRecorder()))
+	}
+}
+
+func TestSessionRateLimit(t *testing.T) {
+	mainHelper.Parallel(t)
+	settings := genRateLimitSettings(true, false, "")
+	settings.PerSec = model.NewPointer(1)
+	settings.MaxBurst = model.NewPointer(0)
+	settings.IntegrationPerMin = model.NewPointer(1)
+	settings.IntegrationMaxBurst = model.NewPointer(2)
+
+	rateLimiter, err := NewRateLimiter(settings, nil)
+	require.NoError(t, err)
+
+	t.Run("users are limited by user id", func(t *testing.T) {
+		session := &model.Session{Id: model.NewId(), UserId: model.NewId()}
+		require.False(t, rateLimiter.SessionRateLimit(session, httptest.NewRecorder()))
+		require.True(t, rateLimiter.SessionRateLimit(session, httptest.NewRecorder()))
+
+		other := &model.Session{Id: model.NewId(), UserId: session.UserId}
+		require.True(t, rateLimiter.SessionRateLimit(other, httptest.NewRecorder()))
+	})
+
+	t.Run("integrations are limited by token", func(t *testing.T) {
+		userID := model.NewId()
+		newSession := func(tokenID string) *model.Session {
+			session := &model.Session{Id: model.NewId(), UserId: userID}
+			session.AddProp(model.SessionPropType, model.SessionTypeUserAccessToken)
+			session.AddProp(model.SessionPropUserAccessTokenId, tokenID)
+			return session
+		}
+
+		tokenID := model.NewId()
+		for range 3 {
+			w := httptest.NewRecorder()
+			require.False(t, rateLimiter.SessionRateLimit(newSession(tokenID), w))
+			require.Equal(t, "3", w.Header().Get("RateLimit-Limit"))
+		}
+		require.True(t, rateLimiter.SessionRateLimit(newSession(tokenID), httptest.NewRecorder()))
+
+		require.False(t, rateLimiter.SessionRateLimit(newSession(model.NewId()), httptest.NewRecorder()))
+	})
+}
@@ -88,6 +88,14 @@ func (a *App) SaveReactionForPost(rctx request.CTX, reaction *model.Reaction) (*
 			hooks.ReactionHasBeenAdded(pluginContext, reaction)
 			return true
 		}, plugin.ReactionHasBeenAddedID)
+
+		a.sendEventToSubscriptions(rctx, &model.EventSubscriptionPayload{
+			Event:     model.EventTypeReactionHasBeenAdded,
+			TeamId:    channel.TeamId,
+			ChannelId: channel.Id,
+			UserId:    reaction.UserId,
+			Data:      reaction,
+		})
 	})
 
 	a.sendReactionEvent(rctx, model.WebsocketEventReactionAdded, reaction, post)
@@ -159,6 +167,14 @@ func (a *App) DeleteReactionForPost(rctx request.CTX, reaction *model.Reaction)
 			hooks.ReactionHasBeenRemoved(pluginContext, reaction)
 			return true
 		}, plugin.ReactionHasBeenRemovedID)
+
+		a.sendEventToSubscriptions(rctx, &model.EventSubscriptionPayload{
+			Event:     model.EventTypeReactionHasBeenRemoved,
+			TeamId:    channel.TeamId,
+			ChannelId: channel.Id,
+			UserId:    reaction.UserId,
+			Data:      reaction,
+		})
 	})
 
 	a.sendReactionEvent(rctx, model.WebsocketEventReactionRemoved, reaction, post)
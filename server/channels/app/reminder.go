@@ -0,0 +1,371 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/i18n"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+const remindersBatchSize = 100
+
+type reminderSnoozeOption struct {
+	when    string
+	labelID string
+}
+
+// reminderSnoozeOptions are the times a reminder can be snoozed until, as
+// parsed by model.ParseReminderTime, with the ids of their labels.
+var reminderSnoozeOptions = []reminderSnoozeOption{
+	{"in 15 minutes", "app.reminder.snooze.15_minutes"},
+	{"in 1 hour", "app.reminder.snooze.1_hour"},
+	{"in 3 hours", "app.reminder.snooze.3_hours"},
+	{"tomorrow", "app.reminder.snooze.tomorrow"},
+}
+
+// CreateReminder saves a reminder firing at the time described by when, as
+// parsed by model.ParseReminderTime in the time zone of the reminder, or of
+// its creator if it isn't set.
+func (a *App) CreateReminder(rctx request.CTX, reminder *model.Reminder, when string) (*model.Reminder, *model.AppError) {
+	if reminder.TimeZone == "" {
+		creator, appErr := a.GetUser(reminder.CreatorId)
+		if appErr != nil {
+			return nil, appErr
+		}
+		reminder.TimeZone = a.GetReminderTimeZone(creator)
+	}
+
+	fireAt, repeat, err := model.ParseReminderTime(when, time.Now().In(reminder.Location()))
+	if err != nil {
+		return nil, model.NewAppError("CreateReminder", "app.reminder.parse_time.app_error", map[string]any{"When": when}, "", http.StatusBadRequest).Wrap(err)
+	}
+	reminder.Repeat = repeat
+	reminder.NextFireAt = fireAt.UnixMilli()
+
+	saved, err := a.Srv().Store().Reminder().Save(reminder)
+	if err != nil {
+		var appErr *model.AppError
+		var invErr *store.ErrInvalidInput
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &invErr):
+			return nil, model.NewAppError("CreateReminder", "app.reminder.save.existing.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+		default:
+			return nil, model.NewAppError("CreateReminder", "app.reminder.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return saved, nil
+}
+
+func (a *App) GetReminder(id string) (*model.Reminder, *model.AppError) {
+	reminder, err := a.Srv().Store().Reminder().Get(id)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetReminder", "app.reminder.get.not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetReminder", "app.reminder.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return reminder, nil
+}
+
+// GetRemindersForUser returns the reminders created by the user that still
+// have to fire, or to be snoozed or completed.
+func (a *App) GetRemindersForUser(userID string, page, perPage int) ([]*model.Reminder, *model.AppError) {
+	reminders, err := a.Srv().Store().Reminder().GetForCreator(userID, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetRemindersForUser", "app.reminder.get_for_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return reminders, nil
+}
+
+func (a *App) DeleteReminder(id string) *model.AppError {
+	if err := a.Srv().Store().Reminder().Delete(id, model.GetMillis()); err != nil {
+		return model.NewAppError("DeleteReminder", "app.reminder.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+// GetReminderTimeZone returns the time zone of the user if the server supports
+// it, or UTC.
+func (a *App) GetReminderTimeZone(user *model.User) string {
+	timeZone := user.GetPreferredTimezone()
+	if timeZone == "" || !slices.Contains(a.Timezones().GetSupported(), timeZone) {
+		return "UTC"
+	}
+
+	return timeZone
+}
+
+// SendDueReminders sends the reminders due by now, and schedules the next
+// time the repeating ones fire.
+func (a *App) SendDueReminders(rctx request.CTX) *model.AppError {
+	now := model.GetMillis()
+
+	for {
+		reminders, err := a.Srv().Store().Reminder().GetDue(now, remindersBatchSize)
+		if err != nil {
+			return model.NewAppError("SendDueReminders", "app.reminder.get_due.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		sent := 0
+		for _, reminder := range reminders {
+			if appErr := a.sendReminder(rctx, reminder, now); appErr != nil {
+				rctx.Logger().Warn("Failed to send reminder", mlog.String("reminder_id", reminder.Id), mlog.Err(appErr))
+				continue
+			}
+			sent++
+		}
+
+		// Stop when there are no more due reminders, or when none of them
+		// could be sent, to retry them on the next run.
+		if len(reminders) < remindersBatchSize || sent == 0 {
+			return nil
+		}
+	}
+}
+
+// sendReminder posts a due reminder. The reminder is rescheduled before
+// being posted so that, when several nodes of a cluster run the job, only
+// one of them posts it.
+func (a *App) sendReminder(rctx request.CTX, reminder *model.Reminder, now int64) *model.AppError {
+	var nextFireAt int64
+	if reminder.Repeat != "" {
+		loc := reminder.Location()
+		next, err := model.NextReminderTime(reminder.Repeat, time.UnixMilli(reminder.NextFireAt).In(loc), time.UnixMilli(now).In(loc))
+		if err != nil {
+			return model.NewAppError("sendReminder", "app.reminder.parse_time.app_error", map[string]any{"When": reminder.Repeat}, "", http.StatusInternalServerError).Wrap(err)
+		}
+		nextFireAt = next.UnixMilli()
+	}
+
+	rescheduled, err := a.Srv().Store().Reminder().Reschedule(reminder.Id, reminder.NextFireAt, nextFireAt)
+	if err != nil {
+		return model.NewAppError("sendReminder", "app.reminder.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	if !rescheduled {
+		return nil
+	}
+
+	systemBot, appErr := a.GetSystemBot(rctx)
+	if appErr != nil {
+		return appErr
+	}
+
+	creator, appErr := a.GetUser(reminder.CreatorId)
+	if appErr != nil {
+		return appErr
+	}
+
+	var channel *model.Channel
+	locale := creator.Locale
+	if reminder.TargetType == model.ReminderTargetUser {
+		target := creator
+		if reminder.TargetId != creator.Id {
+			if target, appErr = a.GetUser(reminder.TargetId); appErr != nil {
+				return appErr
+			}
+		}
+		if target.DeleteAt != 0 {
+			return nil
+		}
+		locale = target.Locale
+
+		if channel, appErr = a.GetOrCreateDirectChannel(rctx, systemBot.UserId, target.Id); appErr != nil {
+			return appErr
+		}
+	} else {
+		if channel, appErr = a.GetChannel(rctx, reminder.TargetId); appErr != nil {
+			return appErr
+		}
+		if channel.DeleteAt != 0 {
+			return nil
+		}
+	}
+
+	post := &model.Post{
+		ChannelId: channel.Id,
+		UserId:    systemBot.UserId,
+	}
+	post.AddProp(model.PostPropsReminderId, reminder.Id)
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{a.reminderAttachment(reminder, creator, i18n.GetUserTranslations(locale))})
+
+	if _, appErr = a.CreatePost(rctx, post, channel, model.CreatePostFlags{SetOnline: true, AllowBuiltInPostActions: true}); appErr != nil {
+		return appErr
+	}
+
+	return nil
+}
+
+func (a *App) reminderAttachment(reminder *model.Reminder, creator *model.User, T i18n.TranslateFunc) *model.SlackAttachment {
+	var text string
+	switch {
+	case reminder.TargetType == model.ReminderTargetChannel:
+		text = T("app.reminder.post.channel", map[string]any{"Username": creator.Username})
+	case reminder.TargetId != creator.Id:
+		text = T("app.reminder.post.other", map[string]any{"Username": creator.Username})
+	default:
+		text = T("app.reminder.post.self")
+	}
+
+	snoozeOptions := make([]*model.PostActionOptions, 0, len(reminderSnoozeOptions))
+	for _, option := range reminderSnoozeOptions {
+		snoozeOptions = append(snoozeOptions, &model.PostActionOptions{Text: T(option.labelID), Value: option.when})
+	}
+
+	integration := func(action string) *model.PostActionIntegration {
+		return &model.PostActionIntegration{
+			URL: model.PostActionBuiltInURLPrefix + model.ReminderPostActionName,
+			Context: map[string]any{
+				model.PostPropsReminderId: reminder.Id,
+				"action":                  action,
+			},
+		}
+	}
+
+	return &model.SlackAttachment{
+		Pretext: text,
+		Text:    reminder.Message,
+		Actions: []*model.PostAction{
+			{
+				Type:        model.PostActionTypeSelect,
+				Name:        T("app.reminder.action.snooze"),
+				Options:     snoozeOptions,
+				Integration: integration(model.ReminderPostActionSnooze),
+			},
+			{
+				Type:        model.PostActionTypeButton,
+				Name:        T("app.reminder.action.complete"),
+				Style:       "primary",
+				Integration: integration(model.ReminderPostActionComplete),
+			},
+		},
+	}
+}
+
+// doReminderPostAction snoozes or completes the reminder of a post. Snoozing
+// a repeating reminder creates a new reminder firing once, and completing
+// it only acknowledges the post, the reminder firing again at its next time.
+func (a *App) doReminderPostAction(rctx request.CTX, upstreamRequest *model.PostActionIntegrationRequest) (*model.PostActionIntegrationResponse, *model.AppError) {
+	reminderID, _ := upstreamRequest.Context[model.PostPropsReminderId].(string)
+	action, _ := upstreamRequest.Context["action"].(string)
+
+	user, appErr := a.GetUser(upstreamRequest.UserId)
+	if appErr != nil {
+		return nil, appErr
+	}
+	T := i18n.GetUserTranslations(user.Locale)
+
+	reminder, appErr := a.GetReminder(reminderID)
+	if appErr != nil {
+		if appErr.StatusCode == http.StatusNotFound {
+			return &model.PostActionIntegrationResponse{EphemeralText: T("app.reminder.action.deleted")}, nil
+		}
+		return nil, appErr
+	}
+
+	// The action must come from a post of the reminder by the system bot, as
+	// the context of an action can be set by whoever created the post.
+	post, appErr := a.GetSinglePost(rctx, upstreamRequest.PostId, false)
+	if appErr != nil {
+		return nil, appErr
+	}
+	systemBot, appErr := a.GetSystemBot(rctx)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if postReminderID, _ := post.GetProp(model.PostPropsReminderId).(string); postReminderID != reminder.Id || post.UserId != systemBot.UserId {
+		return nil, model.NewAppError("doReminderPostAction", "app.reminder.action.post.app_error", nil, "post_id="+upstreamRequest.PostId, http.StatusBadRequest)
+	}
+
+	if !a.canActOnReminder(rctx, reminder, user.Id) {
+		return nil, model.NewAppError("doReminderPostAction", "app.reminder.action.permissions.app_error", nil, "", http.StatusForbidden)
+	}
+
+	var footer string
+	switch action {
+	case model.ReminderPostActionComplete:
+		if reminder.Repeat == "" {
+			reminder.NextFireAt = 0
+			reminder.CompleteAt = model.GetMillis()
+			if _, err := a.Srv().Store().Reminder().Update(reminder); err != nil {
+				return nil, model.NewAppError("doReminderPostAction", "app.reminder.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+		}
+		footer = T("app.reminder.action.completed", map[string]any{"Username": user.Username})
+	case model.ReminderPostActionSnooze:
+		when, _ := upstreamRequest.Context["selected_option"].(string)
+		if !slices.ContainsFunc(reminderSnoozeOptions, func(option reminderSnoozeOption) bool { return option.when == when }) {
+			return nil, model.NewAppError("doReminderPostAction", "app.reminder.action.snooze.app_error", nil, "selected_option="+when, http.StatusBadRequest)
+		}
+
+		var snoozed *model.Reminder
+		if reminder.Repeat == "" {
+			fireAt, _, err := model.ParseReminderTime(when, time.Now().In(reminder.Location()))
+			if err != nil {
+				return nil, model.NewAppError("doReminderPostAction", "app.reminder.parse_time.app_error", map[string]any{"When": when}, "", http.StatusInternalServerError).Wrap(err)
+			}
+			reminder.NextFireAt = fireAt.UnixMilli()
+			if _, err = a.Srv().Store().Reminder().Update(reminder); err != nil {
+				return nil, model.NewAppError("doReminderPostAction", "app.reminder.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+			snoozed = reminder
+		} else {
+			snoozed, appErr = a.CreateReminder(rctx, &model.Reminder{
+				CreatorId:  reminder.CreatorId,
+				TargetType: reminder.TargetType,
+				TargetId:   reminder.TargetId,
+				Message:    reminder.Message,
+				TimeZone:   reminder.TimeZone,
+			}, when)
+			if appErr != nil {
+				return nil, appErr
+			}
+		}
+
+		footer = T("app.reminder.action.snoozed", map[string]any{
+			"Username": user.Username,
+			"Time":     time.UnixMilli(snoozed.NextFireAt).In(snoozed.Location()).Format(model.ReminderTimeLayout),
+		})
+	default:
+		return nil, model.NewAppError("doReminderPostAction", "app.reminder.action.unknown.app_error", nil, "action="+action, http.StatusBadRequest)
+	}
+
+	update := post.Clone()
+	attachments := update.Attachments()
+	for _, attachment := range attachments {
+		attachment.Actions = nil
+		attachment.Footer = footer
+	}
+	model.ParseSlackAttachment(update, attachments)
+
+	return &model.PostActionIntegrationResponse{Update: update}, nil
+}
+
+// canActOnReminder returns whether the user can snooze or complete the
+// reminder: its creator, its target user, or a member of its target channel.
+func (a *App) canActOnReminder(rctx request.CTX, reminder *model.Reminder, userID string) bool {
+	if userID == reminder.CreatorId {
+		return true
+	}
+	if reminder.TargetType == model.ReminderTargetUser {
+		return userID == reminder.TargetId
+	}
+	return a.HasPermissionToChannel(rctx, userID, reminder.TargetId, model.PermissionReadChannelContent)
+}
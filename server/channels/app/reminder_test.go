@@ -0,0 +1,297 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestCreateReminder(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("in the time zone of the creator", func(t *testing.T) {
+		th.BasicUser.Timezone = model.StringMap{
+			"useAutomaticTimezone": "false",
+			"manualTimezone":       "Asia/Tokyo",
+		}
+		_, appErr := th.App.UpdateUser(th.Context, th.BasicUser, false)
+		require.Nil(t, appErr)
+
+		reminder, appErr := th.App.CreateReminder(th.Context, &model.Reminder{
+			CreatorId:  th.BasicUser.Id,
+			TargetType: model.ReminderTargetUser,
+			TargetId:   th.BasicUser.Id,
+			Message:    "stretch",
+		}, "every day at 8am")
+		require.Nil(t, appErr)
+
+		assert.Equal(t, "Asia/Tokyo", reminder.TimeZone)
+		assert.Equal(t, "every day at 08:00", reminder.Repeat)
+
+		fireAt := time.UnixMilli(reminder.NextFireAt).In(reminder.Location())
+		assert.Equal(t, 8, fireAt.Hour())
+		assert.True(t, fireAt.After(time.Now()))
+	})
+
+	t.Run("invalid time", func(t *testing.T) {
+		_, appErr := th.App.CreateReminder(th.Context, &model.Reminder{
+			CreatorId:  th.BasicUser.Id,
+			TargetType: model.ReminderTargetUser,
+			TargetId:   th.BasicUser.Id,
+			Message:    "stretch",
+			TimeZone:   "UTC",
+		}, "some day")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.reminder.parse_time.app_error", appErr.Id)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+	})
+}
+
+func TestSendDueReminders(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	systemBot, appErr := th.App.GetSystemBot(th.Context)
+	require.Nil(t, appErr)
+
+	createDueReminder := func(t *testing.T, reminder *model.Reminder, when string) *model.Reminder {
+		t.Helper()
+
+		reminder, appErr := th.App.CreateReminder(th.Context, reminder, when)
+		require.Nil(t, appErr)
+
+		reminder.NextFireAt = model.GetMillis() - 1000
+		reminder, err := th.App.Srv().Store().Reminder().Update(reminder)
+		require.NoError(t, err)
+
+		return reminder
+	}
+
+	getReminderPost := func(t *testing.T, channelID, reminderID string) *model.Post {
+		t.Helper()
+
+		posts, appErr := th.App.GetPosts(channelID, 0, 10)
+		require.Nil(t, appErr)
+		for _, post := range posts.Posts {
+			if post.GetProp(model.PostPropsReminderId) == reminderID {
+				return post
+			}
+		}
+		return nil
+	}
+
+	t.Run("user reminder", func(t *testing.T) {
+		reminder := createDueReminder(t, &model.Reminder{
+			CreatorId:  th.BasicUser.Id,
+			TargetType: model.ReminderTargetUser,
+			TargetId:   th.BasicUser2.Id,
+			Message:    "submit the report",
+			TimeZone:   "UTC",
+		}, "in 1 hour")
+
+		require.Nil(t, th.App.SendDueReminders(th.Context))
+
+		channel, appErr := th.App.GetOrCreateDirectChannel(th.Context, systemBot.UserId, th.BasicUser2.Id)
+		require.Nil(t, appErr)
+		post := getReminderPost(t, channel.Id, reminder.Id)
+		require.NotNil(t, post)
+		assert.Equal(t, systemBot.UserId, post.UserId)
+		attachments := post.Attachments()
+		require.Len(t, attachments, 1)
+		assert.Equal(t, "submit the report", attachments[0].Text)
+		assert.Len(t, attachments[0].Actions, 2)
+
+		sent, appErr := th.App.GetReminder(reminder.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, int64(0), sent.NextFireAt, "a reminder firing once must wait to be snoozed or completed")
+		assert.Equal(t, reminder.NextFireAt, sent.LastFireAt)
+
+		require.Nil(t, th.App.SendDueReminders(th.Context))
+		posts, appErr := th.App.GetPosts(channel.Id, 0, 10)
+		require.Nil(t, appErr)
+		count := 0
+		for _, post := range posts.Posts {
+			if post.GetProp(model.PostPropsReminderId) == reminder.Id {
+				count++
+			}
+		}
+		assert.Equal(t, 1, count, "a reminder must only be sent once")
+	})
+
+	t.Run("repeating channel reminder", func(t *testing.T) {
+		reminder := createDueReminder(t, &model.Reminder{
+			CreatorId:  th.BasicUser.Id,
+			TargetType: model.ReminderTargetChannel,
+			TargetId:   th.BasicChannel.Id,
+			Message:    "stand-up",
+			TimeZone:   "UTC",
+		}, "every day at 9am")
+
+		require.Nil(t, th.App.SendDueReminders(th.Context))
+
+		require.NotNil(t, getReminderPost(t, th.BasicChannel.Id, reminder.Id))
+
+		sent, appErr := th.App.GetReminder(reminder.Id)
+		require.Nil(t, appErr)
+		next := time.UnixMilli(sent.NextFireAt).UTC()
+		assert.True(t, next.After(time.Now()))
+		assert.Equal(t, 9, next.Hour())
+	})
+}
+
+func TestDoReminderPostAction(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	sendReminder := func(t *testing.T, when string) (*model.Reminder, *model.Post) {
+		t.Helper()
+
+		reminder, appErr := th.App.CreateReminder(th.Context, &model.Reminder{
+			CreatorId:  th.BasicUser.Id,
+			TargetType: model.ReminderTargetChannel,
+			TargetId:   th.BasicChannel.Id,
+			Message:    "water the plants",
+			TimeZone:   "UTC",
+		}, when)
+		require.Nil(t, appErr)
+
+		require.Nil(t, th.App.sendReminder(th.Context, reminder, model.GetMillis()))
+
+		posts, appErr := th.App.GetPosts(th.BasicChannel.Id, 0, 10)
+		require.Nil(t, appErr)
+		for _, post := range posts.Posts {
+			if post.GetProp(model.PostPropsReminderId) == reminder.Id {
+				return reminder, post
+			}
+		}
+		require.Fail(t, "reminder post not found")
+		return nil, nil
+	}
+
+	actionID := func(t *testing.T, post *model.Post, action string) string {
+		t.Helper()
+
+		for _, a := range post.Attachments()[0].Actions {
+			if a.Integration.Context["action"] == action {
+				return a.Id
+			}
+		}
+		require.Fail(t, "action not found")
+		return ""
+	}
+
+	t.Run("complete", func(t *testing.T) {
+		reminder, post := sendReminder(t, "in 1 hour")
+
+		_, appErr := th.App.DoPostActionWithCookie(th.Context, post.Id, actionID(t, post, model.ReminderPostActionComplete), th.BasicUser2.Id, "", nil)
+		require.Nil(t, appErr)
+
+		completed, appErr := th.App.GetReminder(reminder.Id)
+		require.Nil(t, appErr)
+		assert.NotZero(t, completed.CompleteAt)
+		assert.False(t, completed.IsActive())
+
+		updated, appErr := th.App.GetSinglePost(th.Context, post.Id, false)
+		require.Nil(t, appErr)
+		attachments := updated.Attachments()
+		require.Len(t, attachments, 1)
+		assert.Empty(t, attachments[0].Actions)
+		assert.Contains(t, attachments[0].Footer, th.BasicUser2.Username)
+		assert.Equal(t, reminder.Id, updated.GetProp(model.PostPropsReminderId))
+	})
+
+	t.Run("snooze", func(t *testing.T) {
+		reminder, post := sendReminder(t, "in 1 hour")
+
+		_, appErr := th.App.DoPostActionWithCookie(th.Context, post.Id, actionID(t, post, model.ReminderPostActionSnooze), th.BasicUser.Id, "in 15 minutes", nil)
+		require.Nil(t, appErr)
+
+		snoozed, appErr := th.App.GetReminder(reminder.Id)
+		require.Nil(t, appErr)
+		assert.InDelta(t, model.GetMillis()+15*time.Minute.Milliseconds(), snoozed.NextFireAt, float64(time.Minute.Milliseconds()))
+	})
+
+	t.Run("snooze a repeating reminder", func(t *testing.T) {
+		reminder, post := sendReminder(t, "every day at 9am")
+
+		_, appErr := th.App.DoPostActionWithCookie(th.Context, post.Id, actionID(t, post, model.ReminderPostActionSnooze), th.BasicUser.Id, "in 1 hour", nil)
+		require.Nil(t, appErr)
+
+		reminders, appErr := th.App.GetRemindersForUser(th.BasicUser.Id, 0, 100)
+		require.Nil(t, appErr)
+		var snoozed *model.Reminder
+		for _, r := range reminders {
+			if r.Id != reminder.Id && r.Message == reminder.Message && r.Repeat == "" && r.NextFireAt > model.GetMillis()+50*time.Minute.Milliseconds() {
+				snoozed = r
+			}
+		}
+		require.NotNil(t, snoozed, "snoozing a repeating reminder must create a reminder firing once")
+
+		original, appErr := th.App.GetReminder(reminder.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, reminder.Repeat, original.Repeat)
+	})
+
+	t.Run("invalid snooze time", func(t *testing.T) {
+		_, post := sendReminder(t, "in 1 hour")
+
+		_, appErr := th.App.DoPostActionWithCookie(th.Context, post.Id, actionID(t, post, model.ReminderPostActionSnooze), th.BasicUser.Id, "in 100 years", nil)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.reminder.action.snooze.app_error", appErr.Id)
+	})
+
+	t.Run("post of another reminder", func(t *testing.T) {
+		_, post := sendReminder(t, "in 1 hour")
+		reminder, appErr := th.App.CreateReminder(th.Context, &model.Reminder{
+			CreatorId:  th.BasicUser.Id,
+			TargetType: model.ReminderTargetUser,
+			TargetId:   th.BasicUser.Id,
+			Message:    "private",
+			TimeZone:   "UTC",
+		}, "in 1 hour")
+		require.Nil(t, appErr)
+
+		_, appErr = th.App.doReminderPostAction(th.Context, &model.PostActionIntegrationRequest{
+			UserId: th.BasicUser.Id,
+			PostId: post.Id,
+			Context: map[string]any{
+				model.PostPropsReminderId: reminder.Id,
+				"action":                  model.ReminderPostActionComplete,
+			},
+		})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.reminder.action.post.app_error", appErr.Id)
+
+		notCompleted, appErr := th.App.GetReminder(reminder.Id)
+		require.Nil(t, appErr)
+		assert.Zero(t, notCompleted.CompleteAt)
+	})
+
+	t.Run("channel reminder of a non member", func(t *testing.T) {
+		reminder, post := sendReminder(t, "in 1 hour")
+		outsider := th.CreateUser()
+
+		_, appErr := th.App.doReminderPostAction(th.Context, &model.PostActionIntegrationRequest{
+			UserId: outsider.Id,
+			PostId: post.Id,
+			Context: map[string]any{
+				model.PostPropsReminderId: reminder.Id,
+				"action":                  model.ReminderPostActionComplete,
+			},
+		})
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusForbidden, appErr.StatusCode)
+	})
+}
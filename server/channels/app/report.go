@@ -8,12 +8,14 @@ import (
 	"encoding/csv"
 	"fmt"
 	"net/http"
+	"sort"
 	"strconv"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/i18n"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/app/password/hashers"
 )
 
 func (a *App) SaveReportChunk(format string, prefix string, count int, reportData []model.ReportableObject) *model.AppError {
@@ -198,6 +200,42 @@ func (a *App) GetUserCountForReport(filter *model.UserReportOptions) (*int64, *m
 	return &count, nil
 }
 
+// GetPasswordHashReport returns how many users have their password hashed with each
+// algorithm, telling apart the ones which will be rehashed on login.
+func (a *App) GetPasswordHashReport() ([]*model.PasswordHashReport, *model.AppError) {
+	counts, err := a.Srv().Store().User().AnalyticsGetPasswordHashCounts()
+	if err != nil {
+		return nil, model.NewAppError("GetPasswordHashReport", "app.report.get_password_hash_report.store_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	type key struct {
+		algorithm string
+		latest    bool
+	}
+	reports := map[key]*model.PasswordHashReport{}
+	for header, count := range counts {
+		algorithm, latest := hashers.GetAlgorithmFromPHCString(header)
+		k := key{algorithm, latest}
+		if reports[k] == nil {
+			reports[k] = &model.PasswordHashReport{Algorithm: algorithm, Latest: latest}
+		}
+		reports[k].Count += count
+	}
+
+	list := make([]*model.PasswordHashReport, 0, len(reports))
+	for _, report := range reports {
+		list = append(list, report)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Latest != list[j].Latest {
+			return list[i].Latest
+		}
+		return list[i].Algorithm < list[j].Algorithm
+	})
+
+	return list, nil
+}
+
 func (a *App) StartUsersBatchExport(rctx request.CTX, ro *model.UserReportOptions, startAt int64, endAt int64) *model.AppError {
 	if !model.MinimumProfessionalLicense(a.Srv().License()) {
 		return model.NewAppError("StartUsersBatchExport", "app.report.start_users_batch_export.license_error", nil, "", http.StatusBadRequest)
@@ -255,7 +255,7 @@ func testPermissionInheritance(t *testing.T, testCallback func(t *testing.T, th
 
 	// assign the scheme to the team
 	team.SchemeId = &teamScheme.Id
-	_, appErr = th.App.UpdateTeamScheme(team)
+	_, appErr = th.App.UpdateTeamScheme(th.Context, team)
 	require.Nil(t, appErr)
 
 	// test 24 combinations where the higher-scoped scheme is a TEAM scheme
@@ -16,6 +16,7 @@ import (
 	"path"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	"github.com/rs/cors"
+	"github.com/throttled/throttled"
 	"golang.org/x/crypto/acme/autocert"
 
 	"github.com/mattermost/mattermost/server/public/model"
@@ -40,10 +42,12 @@ import (
 	"github.com/mattermost/mattermost/server/v8/channels/audit"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/active_users"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs/audit_log_retention"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/cleanup_desktop_tokens"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/delete_dms_preferences_migration"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/delete_empty_drafts_migration"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/delete_orphan_drafts_migration"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs/email_digest"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/expirynotify"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/export_delete"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/export_process"
@@ -58,9 +62,11 @@ import (
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/mobile_session_metadata"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/notify_admin"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/plugins"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs/polls"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/post_persistent_notifications"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/product_notices"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/refresh_materialized_views"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs/reminders"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/resend_invitation_email"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/s3_path_migration"
 	"github.com/mattermost/mattermost/server/v8/channels/store"
@@ -101,6 +107,13 @@ type Server struct {
 	ListenAddr  *net.TCPAddr
 	RateLimiter *RateLimiter
 
+	loginIPRateLimiter atomic.Pointer[throttled.GCRARateLimiter]
+
+	// configRevisionUserId holds the author of the configuration being saved by
+	// SaveConfigWithRevision, which configRevisionMut serializes.
+	configRevisionMut    sync.Mutex
+	configRevisionUserId atomic.Pointer[string]
+
 	localModeServer *http.Server
 
 	didFinishListen chan struct{}
@@ -120,8 +133,11 @@ type Server struct {
 	htmlTemplateWatcher     *templates.Container
 	seenPendingPostIdsCache cache.Cache
 	openGraphDataCache      cache.Cache
-	clusterLeaderListenerId string
-	loggerLicenseListenerId string
+	webAuthnUsersCache      cache.Cache
+	// userAccessTokenUsageCache holds the tokens whose last use was recorded recently.
+	userAccessTokenUsageCache cache.Cache
+	clusterLeaderListenerId   string
+	loggerLicenseListenerId   string
 
 	platform         *platform.PlatformService
 	platformOptions  []platform.Option
@@ -316,6 +332,20 @@ func NewServer(options ...Option) (*Server, error) {
 	}); err != nil {
 		return nil, errors.Wrap(err, "Unable to create opengraphdata cache")
 	}
+	if s.webAuthnUsersCache, err = s.platform.CacheProvider().NewCache(&cache.CacheOptions{
+		Name: "webauthn_users",
+		Size: webAuthnUsersCacheSize,
+	}); err != nil {
+		return nil, errors.Wrap(err, "Unable to create webauthn users cache")
+	}
+
+	if s.userAccessTokenUsageCache, err = s.platform.CacheProvider().NewCache(&cache.CacheOptions{
+		Name:          "user_access_token_usage",
+		Size:          userAccessTokenUsageCacheSize,
+		DefaultExpiry: userAccessTokenUsageInterval,
+	}); err != nil {
+		return nil, errors.Wrap(err, "Unable to create user access token usage cache")
+	}
 
 	s.createPushNotificationsHub(request.EmptyContext(s.Log()))
 
@@ -351,6 +381,7 @@ func NewServer(options ...Option) (*Server, error) {
 		TemplatesContainer: s.TemplatesContainer(),
 		UserService:        s.userService,
 		Store:              s.GetStore(),
+		FileBackendFn:      s.FileBackend,
 	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to initialize email service")
@@ -389,6 +420,30 @@ func NewServer(options ...Option) (*Server, error) {
 		mlog.Error("SiteURL must be set. Some features will operate incorrectly if the SiteURL is not set. See documentation for details: https://mattermost.com/pl/configure-site-url")
 	}
 
+	configurePasswordHasher(s.Log(), s.platform.Config())
+	s.platform.AddConfigListener(func(oldCfg, newCfg *model.Config) {
+		if *oldCfg.PasswordSettings.Argon2idMemory != *newCfg.PasswordSettings.Argon2idMemory ||
+			*oldCfg.PasswordSettings.Argon2idIterations != *newCfg.PasswordSettings.Argon2idIterations ||
+			*oldCfg.PasswordSettings.Argon2idParallelism != *newCfg.PasswordSettings.Argon2idParallelism {
+			configurePasswordHasher(s.Log(), newCfg)
+		}
+	})
+
+	s.configureLoginIPRateLimiter(s.platform.Config())
+	s.platform.AddConfigListener(func(oldCfg, newCfg *model.Config) {
+		if *oldCfg.ServiceSettings.MaximumLoginAttemptsPerIP != *newCfg.ServiceSettings.MaximumLoginAttemptsPerIP ||
+			*oldCfg.ServiceSettings.LoginAttemptsPerIPWindowMinutes != *newCfg.ServiceSettings.LoginAttemptsPerIPWindowMinutes {
+			s.configureLoginIPRateLimiter(newCfg)
+		}
+	})
+
+	s.platform.AddConfigListener(func(oldCfg, newCfg *model.Config) {
+		if err := s.recordConfigRevision(oldCfg, newCfg); err != nil {
+			// The configuration is saved already, only its history is incomplete.
+			mlog.Warn("Failed to record the revision of the configuration", mlog.Err(err))
+		}
+	})
+
 	// Start email batching because it's not like the other jobs
 	s.platform.AddConfigListener(func(_, _ *model.Config) {
 		s.EmailService.InitEmailBatching()
@@ -408,6 +463,13 @@ func NewServer(options ...Option) (*Server, error) {
 			mlog.Error("Error configuring audit", mlog.Err(err))
 		}
 	}
+	s.configureAuditSink()
+	s.platform.AddConfigListener(func(oldCfg, newCfg *model.Config) {
+		if *oldCfg.ExperimentalAuditSettings.StoreEnabled != *newCfg.ExperimentalAuditSettings.StoreEnabled ||
+			*oldCfg.ExperimentalAuditSettings.StoreSigningKey != *newCfg.ExperimentalAuditSettings.StoreSigningKey {
+			s.configureAuditSink()
+		}
+	})
 
 	s.platform.RemoveUnlicensedLogTargets(license)
 	s.platform.EnableLoggingMetrics()
@@ -481,6 +543,7 @@ func (s *Server) runJobs() {
 		runDNDStatusExpireJob(appInstance)
 		runPostReminderJob(appInstance)
 		runScheduledPostJob(appInstance)
+		runOutgoingWebhookDeliveryJob(appInstance)
 	})
 	s.Go(func() {
 		runSecurityJob(s)
@@ -497,6 +560,9 @@ func (s *Server) runJobs() {
 	s.Go(func() {
 		runCommandWebhookCleanupJob(s)
 	})
+	s.Go(func() {
+		runOutgoingWebhookDeliveryCleanupJob(s)
+	})
 	s.Go(func() {
 		runConfigCleanupJob(s)
 	})
@@ -877,7 +943,13 @@ func (s *Server) Start() error {
 	if *s.platform.Config().RateLimitSettings.Enable {
 		mlog.Info("RateLimiter is enabled")
 
-		rateLimiter, err2 := NewRateLimiter(&s.platform.Config().RateLimitSettings, s.platform.Config().ServiceSettings.TrustedProxyIPHeader)
+		// The limiters of a cluster share their state when the cache is in Redis.
+		rateLimitStore, err2 := s.platform.CacheProvider().NewRateLimitStore("api", *s.platform.Config().RateLimitSettings.MemoryStoreSize)
+		if err2 != nil {
+			return errors.Wrap(err2, i18n.T("api.server.start_server.rate_limiting_memory_store"))
+		}
+
+		rateLimiter, err2 := NewRateLimiterWithStore(&s.platform.Config().RateLimitSettings, s.platform.Config().ServiceSettings.TrustedProxyIPHeader, rateLimitStore)
 		if err2 != nil {
 			return err2
 		}
@@ -1151,6 +1223,13 @@ func runCommandWebhookCleanupJob(s *Server) {
 	}, time.Hour*1)
 }
 
+func runOutgoingWebhookDeliveryCleanupJob(s *Server) {
+	doOutgoingWebhookDeliveryCleanup(s)
+	model.CreateRecurringTask("Outgoing Webhook Delivery Cleanup", func() {
+		doOutgoingWebhookDeliveryCleanup(s)
+	}, time.Hour*1)
+}
+
 func runSessionCleanupJob(s *Server) {
 	doSessionCleanup(s)
 	model.CreateRecurringTask("Session Cleanup", func() {
@@ -1222,6 +1301,20 @@ func doCommandWebhookCleanup(s *Server) {
 	s.Store().CommandWebhook().Cleanup()
 }
 
+func doOutgoingWebhookDeliveryCleanup(s *Server) {
+	endTime := model.GetMillis() - outgoingWebhookDeliveryRetention.Milliseconds()
+	for {
+		deleted, err := s.Store().Webhook().PermanentDeleteOutgoingDeliveriesBatch(endTime, outgoingWebhookDeliveryCleanupBatch)
+		if err != nil {
+			mlog.Error("Failed to delete old outgoing webhook deliveries", mlog.Err(err))
+			return
+		}
+		if deleted < outgoingWebhookDeliveryCleanupBatch {
+			return
+		}
+	}
+}
+
 const (
 	sessionsCleanupBatchSize = 1000
 	jobsCleanupBatchSize     = 1000
@@ -1468,7 +1561,7 @@ func (s *Server) initJobs() {
 
 	s.Jobs.RegisterJobType(
 		model.JobTypeExpiryNotify,
-		expirynotify.MakeWorker(s.Jobs, New(ServerConnector(s.Channels())).NotifySessionsExpired),
+		expirynotify.MakeWorker(s.Jobs, New(ServerConnector(s.Channels())).NotifySessionsExpired, New(ServerConnector(s.Channels())).NotifyUserAccessTokensExpiring),
 		expirynotify.MakeScheduler(s.Jobs),
 	)
 
@@ -1481,7 +1574,7 @@ func (s *Server) initJobs() {
 	s.Jobs.RegisterJobType(
 		model.JobTypeImportProcess,
 		import_process.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
-		nil,
+		import_process.MakeScheduler(s.Jobs),
 	)
 
 	s.Jobs.RegisterJobType(
@@ -1571,6 +1664,30 @@ func (s *Server) initJobs() {
 		post_persistent_notifications.MakeScheduler(s.Jobs, func() *model.License { return s.License() }),
 	)
 
+	s.Jobs.RegisterJobType(
+		model.JobTypeReminders,
+		reminders.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		reminders.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypePolls,
+		polls.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		polls.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeEmailDigest,
+		email_digest.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		email_digest.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeAuditLogRetention,
+		audit_log_retention.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		audit_log_retention.MakeScheduler(s.Jobs),
+	)
+
 	s.Jobs.RegisterJobType(
 		model.JobTypeInstallPluginNotifyAdmin,
 		notify_admin.MakeInstallPluginNotifyWorker(s.Jobs, New(ServerConnector(s.Channels()))),
@@ -1769,6 +1886,28 @@ func runPostReminderJob(a *App) {
 	})
 }
 
+func runOutgoingWebhookDeliveryJob(a *App) {
+	if a.IsLeader() {
+		rctx := request.EmptyContext(a.Log())
+		withMut(&a.ch.outgoingWebhookDeliveryMut, func() {
+			fn := func() { a.ProcessOutgoingWebhookDeliveries(rctx) }
+			a.ch.outgoingWebhookDeliveryTask = model.CreateRecurringTaskFromNextIntervalTime("Retry outgoing webhook deliveries", fn, outgoingWebhookDeliveryTaskInterval)
+		})
+	}
+	a.ch.srv.AddClusterLeaderChangedListener(func() {
+		mlog.Info("Cluster leader changed. Determining if outgoing webhook delivery task should be running", mlog.Bool("isLeader", a.IsLeader()))
+		if a.IsLeader() {
+			rctx := request.EmptyContext(a.Log())
+			withMut(&a.ch.outgoingWebhookDeliveryMut, func() {
+				fn := func() { a.ProcessOutgoingWebhookDeliveries(rctx) }
+				a.ch.outgoingWebhookDeliveryTask = model.CreateRecurringTaskFromNextIntervalTime("Retry outgoing webhook deliveries", fn, outgoingWebhookDeliveryTaskInterval)
+			})
+		} else {
+			cancelTask(&a.ch.outgoingWebhookDeliveryMut, &a.ch.outgoingWebhookDeliveryTask)
+		}
+	})
+}
+
 func runScheduledPostJob(a *App) {
 	if a.IsLeader() {
 		doRunScheduledPostJob(a)
@@ -9,6 +9,8 @@ import (
 	"math"
 	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
@@ -21,6 +23,11 @@ import (
 // maxSessionsLimit prevents a potential DOS caused by creating an unbounded number of sessions; MM-55320
 const maxSessionsLimit = 500
 
+const (
+	userAccessTokenUsageCacheSize = 10000
+	userAccessTokenUsageInterval  = 5 * time.Minute
+)
+
 func (a *App) CreateSession(rctx request.CTX, session *model.Session) (*model.Session, *model.AppError) {
 	if appErr := a.limitNumberOfSessions(rctx, session.UserId); appErr != nil {
 		return nil, appErr
@@ -401,6 +408,10 @@ func (a *App) CreateUserAccessToken(rctx request.CTX, token *model.UserAccessTok
 		return nil, model.NewAppError("CreateUserAccessToken", "app.user_access_token.disabled", nil, "", http.StatusNotImplemented)
 	}
 
+	if token.IsExpired() {
+		return nil, model.NewAppError("CreateUserAccessToken", "app.user_access_token.expires_at_past.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	token.Token = model.NewId()
 
 	token, nErr = a.Srv().Store().UserAccessToken().Save(token)
@@ -434,6 +445,10 @@ func (a *App) createSessionForUserAccessToken(rctx request.CTX, tokenString stri
 		return nil, model.NewAppError("createSessionForUserAccessToken", "app.user_access_token.invalid_or_missing", nil, "inactive_token", http.StatusUnauthorized)
 	}
 
+	if token.IsExpired() {
+		return nil, model.NewAppError("createSessionForUserAccessToken", "app.user_access_token.invalid_or_missing", nil, "expired_token", http.StatusUnauthorized)
+	}
+
 	user, nErr := a.Srv().Store().User().Get(rctx.Context(), token.UserId)
 	if nErr != nil {
 		var nfErr *store.ErrNotFound
@@ -466,6 +481,9 @@ func (a *App) createSessionForUserAccessToken(rctx request.CTX, tokenString stri
 
 	session.AddProp(model.SessionPropUserAccessTokenId, token.Id)
 	session.AddProp(model.SessionPropType, model.SessionTypeUserAccessToken)
+	if token.IsScoped() {
+		session.AddProp(model.SessionPropUserAccessTokenScopes, strings.Join(token.Scopes, " "))
+	}
 	if user.IsBot {
 		session.AddProp(model.SessionPropIsBot, model.SessionPropIsBotValue)
 	}
@@ -475,6 +493,9 @@ func (a *App) createSessionForUserAccessToken(rctx request.CTX, tokenString stri
 		session.AddProp(model.SessionPropIsGuest, "false")
 	}
 	a.ch.srv.platform.SetSessionExpireInHours(session, model.SessionUserAccessTokenExpiryHours)
+	if token.ExpiresAt > 0 && (session.ExpiresAt <= 0 || token.ExpiresAt < session.ExpiresAt) {
+		session.ExpiresAt = token.ExpiresAt
+	}
 
 	session, nErr = a.Srv().Store().Session().Save(rctx, session)
 	if nErr != nil {
@@ -494,6 +515,31 @@ func (a *App) createSessionForUserAccessToken(rctx request.CTX, tokenString stri
 	return session, nil
 }
 
+// UpdateUserAccessTokenLastUsedIfNeeded records the time and IP address of the last use of
+// the token of a session authenticated by a personal access token. To spare the database,
+// uses are recorded at most once per userAccessTokenUsageInterval.
+func (a *App) UpdateUserAccessTokenLastUsedIfNeeded(rctx request.CTX, session *model.Session) {
+	tokenID := session.Props[model.SessionPropUserAccessTokenId]
+	if tokenID == "" || !session.IsUserAccessToken() {
+		return
+	}
+
+	var recorded bool
+	if err := a.Srv().userAccessTokenUsageCache.Get(tokenID, &recorded); err == nil {
+		return
+	}
+	if err := a.Srv().userAccessTokenUsageCache.SetWithExpiry(tokenID, true, userAccessTokenUsageInterval); err != nil {
+		rctx.Logger().Warn("Failed to cache the use of a user access token", mlog.String("token_id", tokenID), mlog.Err(err))
+	}
+
+	ipAddress := rctx.IPAddress()
+	a.Srv().Go(func() {
+		if err := a.Srv().Store().UserAccessToken().UpdateLastUsed(tokenID, model.GetMillis(), ipAddress); err != nil {
+			rctx.Logger().Warn("Failed to record the use of a user access token", mlog.String("token_id", tokenID), mlog.Err(err))
+		}
+	})
+}
+
 func (a *App) RevokeUserAccessToken(rctx request.CTX, token *model.UserAccessToken) *model.AppError {
 	var session *model.Session
 	session, _ = a.ch.srv.platform.GetSessionContext(rctx, token.Token)
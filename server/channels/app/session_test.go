@@ -505,3 +505,94 @@ func TestSetExtraSessionProps(t *testing.T) {
 		assert.Equal(t, "true", storeSession.Props["testProp"])
 	})
 }
+
+func TestUserAccessTokenExpiryAndScopes(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableUserAccessTokens = true
+	})
+
+	t.Run("rejects an expiry date in the past", func(t *testing.T) {
+		_, appErr := th.App.CreateUserAccessToken(th.Context, &model.UserAccessToken{
+			UserId:      th.BasicUser.Id,
+			Description: "expired",
+			ExpiresAt:   model.GetMillis() - 1000,
+		})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.user_access_token.expires_at_past.app_error", appErr.Id)
+	})
+
+	t.Run("sessions expire with their token", func(t *testing.T) {
+		expiresAt := model.GetMillis() + 60*60*1000
+		token, appErr := th.App.CreateUserAccessToken(th.Context, &model.UserAccessToken{
+			UserId:      th.BasicUser.Id,
+			Description: "expiring",
+			ExpiresAt:   expiresAt,
+		})
+		require.Nil(t, appErr)
+
+		session, appErr := th.App.createSessionForUserAccessToken(th.Context, token.Token)
+		require.Nil(t, appErr)
+		assert.Equal(t, expiresAt, session.ExpiresAt)
+
+		expired, err := th.App.Srv().Store().UserAccessToken().Save(&model.UserAccessToken{
+			Token:       model.NewId(),
+			UserId:      th.BasicUser.Id,
+			Description: "expired",
+			ExpiresAt:   model.GetMillis() - 1000,
+		})
+		require.NoError(t, err)
+
+		_, appErr = th.App.createSessionForUserAccessToken(th.Context, expired.Token)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusUnauthorized, appErr.StatusCode)
+	})
+
+	t.Run("scopes restrict the permissions", func(t *testing.T) {
+		otherChannel := th.CreateChannel(th.Context, th.BasicTeam)
+		token, appErr := th.App.CreateUserAccessToken(th.Context, &model.UserAccessToken{
+			UserId:      th.SystemAdminUser.Id,
+			Description: "scoped",
+			Scopes:      model.StringArray{"read:posts", "write:posts:" + th.BasicChannel.Id},
+		})
+		require.Nil(t, appErr)
+
+		session, appErr := th.App.createSessionForUserAccessToken(th.Context, token.Token)
+		require.Nil(t, appErr)
+		assert.ElementsMatch(t, token.Scopes, session.GetUserAccessTokenScopes())
+
+		assert.True(t, th.App.SessionHasPermissionToChannel(th.Context, *session, th.BasicChannel.Id, model.PermissionCreatePost))
+		assert.True(t, th.App.SessionHasPermissionToChannel(th.Context, *session, th.BasicChannel.Id, model.PermissionReadChannelContent))
+		assert.False(t, th.App.SessionHasPermissionToChannel(th.Context, *session, otherChannel.Id, model.PermissionCreatePost))
+		assert.True(t, th.App.SessionHasPermissionToReadChannel(th.Context, *session, otherChannel))
+		assert.False(t, th.App.SessionHasPermissionToTeam(*session, th.BasicTeam.Id, model.PermissionManageIncomingWebhooks))
+		assert.False(t, th.App.SessionHasPermissionTo(*session, model.PermissionManageSystem))
+		assert.False(t, th.App.SessionHasPermissionTo(*session, model.PermissionCreateUserAccessToken))
+
+		unscoped := *session
+		unscoped.Props = model.StringMap{}
+		assert.True(t, th.App.SessionHasPermissionTo(unscoped, model.PermissionManageSystem))
+	})
+
+	t.Run("records the last use", func(t *testing.T) {
+		token, appErr := th.App.CreateUserAccessToken(th.Context, &model.UserAccessToken{
+			UserId:      th.BasicUser.Id,
+			Description: "used",
+		})
+		require.Nil(t, appErr)
+
+		session, appErr := th.App.createSessionForUserAccessToken(th.Context, token.Token)
+		require.Nil(t, appErr)
+
+		th.App.UpdateUserAccessTokenLastUsedIfNeeded(th.Context.WithIPAddress("10.0.0.1"), session)
+		require.EventuallyWithT(t, func(c *assert.CollectT) {
+			received, err := th.App.Srv().Store().UserAccessToken().Get(token.Id)
+			require.NoError(c, err)
+			assert.NotZero(c, received.LastUsedAt)
+			assert.Equal(c, "10.0.0.1", received.LastUsedIP)
+		}, 5*time.Second, 100*time.Millisecond)
+	})
+}
@@ -216,7 +216,7 @@ func TestInviteGroup(t *testing.T) {
 	_, _ = th.App.AddTeamMember(th.Context, th.BasicTeam.Id, th.BasicUser.Id)
 	_, err = th.App.AddTeamMember(th.Context, th.BasicTeam.Id, th.BasicUser2.Id)
 	require.Nil(t, err)
-	th.BasicTeam, _ = th.App.UpdateTeam(th.BasicTeam)
+	th.BasicTeam, _ = th.App.UpdateTeam(th.Context, th.BasicTeam)
 
 	privateChannel := th.createChannel(t, th.BasicTeam, model.ChannelTypePrivate)
 
@@ -281,7 +281,7 @@ func TestUserGroups(t *testing.T) {
 	teamGroupCommand := "@" + *teamGroup.Name + " ~" + privateChannel.Name
 
 	// th.App.Srv().SetLicense(model.NewTestLicenseSKU(model.LicenseShortSkuProfessional))
-	groupMembers, upsertErr := th.App.UpsertGroupMembers(teamGroup.Id, []string{th.BasicUser2.Id})
+	groupMembers, upsertErr := th.App.UpsertGroupMembers(th.Context, teamGroup.Id, []string{th.BasicUser2.Id})
 	require.Nil(t, upsertErr)
 	assert.Len(t, groupMembers, 1)
 
@@ -298,7 +298,7 @@ func TestUserGroups(t *testing.T) {
 	})
 	assert.Nil(t, err)
 	nonTeamGroupCommand := "@" + *nonTeamGroup.Name + " ~" + privateChannel.Name
-	nonTeamGroupMembers, upsertErr := th.App.UpsertGroupMembers(nonTeamGroup.Id, []string{basicUser3.Id, basicUser4.Id})
+	nonTeamGroupMembers, upsertErr := th.App.UpsertGroupMembers(th.Context, nonTeamGroup.Id, []string{basicUser3.Id, basicUser4.Id})
 	require.Nil(t, upsertErr)
 	assert.Len(t, nonTeamGroupMembers, 2)
 
@@ -0,0 +1,156 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package slashcommands
+
+import (
+	"net/http"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/i18n"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/app"
+)
+
+type PollProvider struct {
+}
+
+const (
+	CmdPoll = "poll"
+)
+
+// pollQuotes are the pairs of quotes the question and the options of a poll
+// can be enclosed in.
+var pollQuotes = map[rune]rune{
+	'"':  '"',
+	'“':  '”',
+	'\'': '\'',
+}
+
+func init() {
+	app.RegisterCommandProvider(&PollProvider{})
+}
+
+func (*PollProvider) GetTrigger() string {
+	return CmdPoll
+}
+
+func (*PollProvider) GetCommand(a *app.App, T i18n.TranslateFunc) *model.Command {
+	return &model.Command{
+		Trigger:          CmdPoll,
+		AutoComplete:     true,
+		AutoCompleteDesc: T("api.command_poll.desc"),
+		AutoCompleteHint: T("api.command_poll.hint"),
+		DisplayName:      T("api.command_poll.name"),
+	}
+}
+
+func (*PollProvider) DoCommand(a *app.App, rctx request.CTX, args *model.CommandArgs, message string) *model.CommandResponse {
+	poll := &model.Poll{
+		CreatorId: args.UserId,
+		ChannelId: args.ChannelId,
+	}
+
+	words, ok := splitPollArgs(message)
+	if !ok {
+		return response(args.T("api.command_poll.quotes.error"))
+	}
+
+	var texts []string
+	for i := 0; i < len(words); i++ {
+		switch strings.ToLower(words[i]) {
+		case "--anonymous":
+			poll.Anonymous = true
+		case "--multiple":
+			poll.MultipleChoice = true
+		case "--close-in":
+			if i+1 == len(words) {
+				return response(args.T("api.command_poll.close_in.error"))
+			}
+			i++
+			closeAt, repeat, err := model.ParseReminderTime("in "+words[i], time.Now())
+			if err != nil || repeat != "" {
+				return response(args.T("api.command_poll.close_in.error"))
+			}
+			poll.CloseAt = closeAt.UnixMilli()
+		default:
+			texts = append(texts, words[i])
+		}
+	}
+
+	if len(texts) == 0 {
+		return response(args.T("api.command_poll.help"))
+	}
+	if len(texts) < model.PollOptionsMin+1 {
+		return response(args.T("api.command_poll.options.error", map[string]any{"Min": model.PollOptionsMin}))
+	}
+
+	poll.Question = texts[0]
+	for _, text := range texts[1:] {
+		poll.Options = append(poll.Options, &model.PollOption{Text: text})
+	}
+
+	if !a.HasPermissionToChannel(rctx, args.UserId, args.ChannelId, model.PermissionCreatePost) {
+		return response(args.T("api.command_poll.permission.app_error"))
+	}
+
+	if _, appErr := a.CreatePoll(rctx, poll); appErr != nil {
+		if appErr.StatusCode == http.StatusBadRequest {
+			appErr.Translate(args.T)
+			return response(appErr.Message)
+		}
+		rctx.Logger().Warn("Failed to create a poll", mlog.Err(appErr))
+		return response(args.T("api.command_poll.error"))
+	}
+
+	return &model.CommandResponse{}
+}
+
+// splitPollArgs splits the arguments of the command on spaces, keeping the
+// quoted ones, such as the question and the options, whole. It returns false
+// when a quote isn't closed.
+func splitPollArgs(message string) ([]string, bool) {
+	var words []string
+	var word strings.Builder
+	var closing rune
+	quoted := false
+	inWord := false
+
+	for _, r := range message {
+		switch {
+		case quoted:
+			if r == closing {
+				words = append(words, strings.TrimSpace(word.String()))
+				word.Reset()
+				quoted = false
+				continue
+			}
+			word.WriteRune(r)
+		case unicode.IsSpace(r):
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		case !inWord && pollQuotes[r] != 0:
+			closing = pollQuotes[r]
+			quoted = true
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+
+	if quoted {
+		return nil, false
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+
+	return words, true
+}
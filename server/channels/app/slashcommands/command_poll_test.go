@@ -0,0 +1,86 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package slashcommands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/i18n"
+)
+
+func TestSplitPollArgs(t *testing.T) {
+	for name, tc := range map[string]struct {
+		message  string
+		expected []string
+		ok       bool
+	}{
+		"quoted":         {`"Where do we go?" "Pizza place" 'Sushi'`, []string{"Where do we go?", "Pizza place", "Sushi"}, true},
+		"smart quotes":   {`“Lunch?” “Pizza” “Sushi”`, []string{"Lunch?", "Pizza", "Sushi"}, true},
+		"flags":          {`"Lunch?" "Pizza" "Sushi" --anonymous --close-in 2h`, []string{"Lunch?", "Pizza", "Sushi", "--anonymous", "--close-in", "2h"}, true},
+		"apostrophe":     {`"Lunch?" don't care`, []string{"Lunch?", "don't", "care"}, true},
+		"trailing space": {`" Lunch? " Pizza`, []string{"Lunch?", "Pizza"}, true},
+		"unclosed quote": {`"Lunch?" "Pizza`, nil, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			words, ok := splitPollArgs(tc.message)
+			assert.Equal(t, tc.ok, ok)
+			if tc.ok {
+				assert.Equal(t, tc.expected, words)
+			}
+		})
+	}
+}
+
+func TestPollProviderDoCommand(t *testing.T) {
+	th := setup(t).initBasic(t)
+
+	cmd := &PollProvider{}
+	args := &model.CommandArgs{
+		T:         i18n.IdentityTfunc(),
+		UserId:    th.BasicUser.Id,
+		TeamId:    th.BasicTeam.Id,
+		ChannelId: th.BasicChannel.Id,
+	}
+
+	t.Run("help", func(t *testing.T) {
+		resp := cmd.DoCommand(th.App, th.Context, args, "")
+		assert.Equal(t, "api.command_poll.help", resp.Text)
+	})
+
+	t.Run("not enough options", func(t *testing.T) {
+		resp := cmd.DoCommand(th.App, th.Context, args, `"Lunch?" "Pizza"`)
+		assert.Equal(t, "api.command_poll.options.error", resp.Text)
+	})
+
+	t.Run("invalid close time", func(t *testing.T) {
+		resp := cmd.DoCommand(th.App, th.Context, args, `"Lunch?" "Pizza" "Sushi" --close-in sometime`)
+		assert.Equal(t, "api.command_poll.close_in.error", resp.Text)
+	})
+
+	t.Run("create", func(t *testing.T) {
+		resp := cmd.DoCommand(th.App, th.Context, args, `"Where do we go for lunch?" "Pizza" "Sushi" "Tacos" --anonymous --multiple --close-in 2h`)
+		assert.Empty(t, resp.Text)
+
+		posts, appErr := th.App.GetPosts(th.BasicChannel.Id, 0, 1)
+		require.Nil(t, appErr)
+		require.Len(t, posts.Order, 1)
+		post := posts.Posts[posts.Order[0]]
+		assert.Equal(t, model.PostTypePoll, post.Type)
+
+		pollID, _ := post.GetProp(model.PostPropsPollId).(string)
+		poll, appErr := th.App.GetPoll(pollID)
+		require.Nil(t, appErr)
+		assert.Equal(t, "Where do we go for lunch?", poll.Question)
+		require.Len(t, poll.Options, 3)
+		assert.Equal(t, "Tacos", poll.Options[2].Text)
+		assert.True(t, poll.Anonymous)
+		assert.True(t, poll.MultipleChoice)
+		assert.InDelta(t, model.GetMillis()+2*time.Hour.Milliseconds(), poll.CloseAt, float64(time.Minute.Milliseconds()))
+	})
+}
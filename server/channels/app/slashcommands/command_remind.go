@@ -0,0 +1,230 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package slashcommands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/i18n"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/app"
+)
+
+type RemindProvider struct {
+}
+
+const (
+	CmdRemind = "remind"
+
+	remindListMax = 50
+)
+
+// remindTimeKeywords are the words the time of a reminder can start with
+// when it follows its message.
+var remindTimeKeywords = map[string]bool{
+	"in":       true,
+	"at":       true,
+	"on":       true,
+	"every":    true,
+	"today":    true,
+	"tomorrow": true,
+	"next":     true,
+}
+
+func init() {
+	app.RegisterCommandProvider(&RemindProvider{})
+}
+
+func (*RemindProvider) GetTrigger() string {
+	return CmdRemind
+}
+
+func (*RemindProvider) GetCommand(a *app.App, T i18n.TranslateFunc) *model.Command {
+	return &model.Command{
+		Trigger:          CmdRemind,
+		AutoComplete:     true,
+		AutoCompleteDesc: T("api.command_remind.desc"),
+		AutoCompleteHint: T("api.command_remind.hint"),
+		DisplayName:      T("api.command_remind.name"),
+	}
+}
+
+func (*RemindProvider) DoCommand(a *app.App, rctx request.CTX, args *model.CommandArgs, message string) *model.CommandResponse {
+	message = strings.TrimSpace(message)
+	target, rest, _ := strings.Cut(message, " ")
+	rest = strings.TrimSpace(rest)
+
+	switch strings.ToLower(target) {
+	case "", "help":
+		return remindResponse(args.T("api.command_remind.help"))
+	case "list":
+		return doRemindList(a, args)
+	case "delete":
+		return doRemindDelete(a, args, rest)
+	}
+
+	user, appErr := a.GetUser(args.UserId)
+	if appErr != nil {
+		return remindResponse(args.T("api.command_remind.error"))
+	}
+
+	reminder := &model.Reminder{
+		CreatorId:  args.UserId,
+		TargetType: model.ReminderTargetUser,
+		TimeZone:   a.GetReminderTimeZone(user),
+	}
+
+	var targetName string
+	switch {
+	case strings.ToLower(target) == "me":
+		reminder.TargetId = args.UserId
+		targetName = args.T("api.command_remind.target.me")
+	case strings.HasPrefix(target, "@"):
+		targetUser, appErr := a.GetUserByUsername(strings.TrimPrefix(target, "@"))
+		if appErr != nil || targetUser.DeleteAt != 0 {
+			return remindResponse(args.T("api.command_remind.user.missing", map[string]any{"Username": target}))
+		}
+		if canSee, appErr := a.UserCanSeeOtherUser(rctx, args.UserId, targetUser.Id); appErr != nil || !canSee {
+			return remindResponse(args.T("api.command_remind.user.missing", map[string]any{"Username": target}))
+		}
+		reminder.TargetId = targetUser.Id
+		targetName = "@" + targetUser.Username
+	case strings.HasPrefix(target, "~"):
+		channel, appErr := a.GetChannelByName(rctx, strings.TrimPrefix(target, "~"), args.TeamId, false)
+		if appErr != nil || !a.HasPermissionToChannel(rctx, args.UserId, channel.Id, model.PermissionCreatePost) {
+			return remindResponse(args.T("api.command_remind.channel.missing", map[string]any{"Channel": target}))
+		}
+		reminder.TargetType = model.ReminderTargetChannel
+		reminder.TargetId = channel.Id
+		targetName = "~" + channel.Name
+	default:
+		return remindResponse(args.T("api.command_remind.help"))
+	}
+
+	what, when := splitRemindMessage(rest, time.Now().In(reminder.Location()))
+	if what == "" || when == "" {
+		return remindResponse(args.T("api.command_remind.parse_time.error"))
+	}
+	reminder.Message = what
+
+	reminder, appErr = a.CreateReminder(rctx, reminder, when)
+	if appErr != nil {
+		if appErr.Id == "app.reminder.parse_time.app_error" {
+			return remindResponse(args.T("api.command_remind.parse_time.error"))
+		}
+		rctx.Logger().Warn("Failed to create a reminder", mlog.Err(appErr))
+		return remindResponse(args.T("api.command_remind.error"))
+	}
+
+	text := args.T("api.command_remind.created", map[string]any{
+		"Target":  targetName,
+		"Message": reminder.Message,
+		"Time":    formatReminderTime(reminder, reminder.NextFireAt),
+	})
+	if reminder.Repeat != "" {
+		text += " " + args.T("api.command_remind.created.repeat", map[string]any{"Repeat": reminder.Repeat})
+	}
+
+	return remindResponse(text)
+}
+
+// splitRemindMessage splits what to be reminded of from when, which can
+// either follow it, as in "to call Bob at 3pm", or precede it, as in
+// "tomorrow to call Bob". The longest time that parses wins.
+func splitRemindMessage(text string, now time.Time) (string, string) {
+	words := strings.Fields(text)
+
+	for i := 1; i < len(words); i++ {
+		if !remindTimeKeywords[strings.ToLower(words[i])] {
+			continue
+		}
+		when := strings.Join(words[i:], " ")
+		if _, _, err := model.ParseReminderTime(when, now); err == nil {
+			return cleanRemindMessage(strings.Join(words[:i], " ")), when
+		}
+	}
+
+	for i := len(words) - 1; i > 0; i-- {
+		when := strings.Join(words[:i], " ")
+		if _, _, err := model.ParseReminderTime(when, now); err == nil {
+			return cleanRemindMessage(strings.Join(words[i:], " ")), when
+		}
+	}
+
+	return "", ""
+}
+
+func cleanRemindMessage(message string) string {
+	if rest, ok := strings.CutPrefix(message, "to "); ok {
+		message = rest
+	}
+	message = strings.TrimSpace(message)
+
+	for _, quotes := range [][2]string{{`"`, `"`}, {"'", "'"}, {"“", "”"}} {
+		if len(message) > len(quotes[0])+len(quotes[1]) && strings.HasPrefix(message, quotes[0]) && strings.HasSuffix(message, quotes[1]) {
+			return strings.TrimSpace(message[len(quotes[0]) : len(message)-len(quotes[1])])
+		}
+	}
+
+	return message
+}
+
+func formatReminderTime(reminder *model.Reminder, millis int64) string {
+	return time.UnixMilli(millis).In(reminder.Location()).Format(model.ReminderTimeLayout)
+}
+
+func doRemindList(a *app.App, args *model.CommandArgs) *model.CommandResponse {
+	reminders, appErr := a.GetRemindersForUser(args.UserId, 0, remindListMax)
+	if appErr != nil {
+		return remindResponse(args.T("api.command_remind.error"))
+	}
+
+	if len(reminders) == 0 {
+		return remindResponse(args.T("api.command_remind.list.empty"))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(args.T("api.command_remind.list.header"))
+	for _, reminder := range reminders {
+		next := args.T("api.command_remind.list.waiting")
+		if reminder.NextFireAt != 0 {
+			next = formatReminderTime(reminder, reminder.NextFireAt)
+		}
+		if reminder.Repeat != "" {
+			next = fmt.Sprintf("%s (%s)", next, reminder.Repeat)
+		}
+		sb.WriteString(fmt.Sprintf("\n| `%s` | %s | %s |", reminder.Id, strings.ReplaceAll(reminder.Message, "|", `\|`), next))
+	}
+
+	return remindResponse(sb.String())
+}
+
+func doRemindDelete(a *app.App, args *model.CommandArgs, id string) *model.CommandResponse {
+	id = strings.Trim(id, "` ")
+	if !model.IsValidId(id) {
+		return remindResponse(args.T("api.command_remind.delete.missing"))
+	}
+
+	reminder, appErr := a.GetReminder(id)
+	if appErr != nil || reminder.CreatorId != args.UserId {
+		return remindResponse(args.T("api.command_remind.delete.missing"))
+	}
+
+	if appErr = a.DeleteReminder(reminder.Id); appErr != nil {
+		return remindResponse(args.T("api.command_remind.error"))
+	}
+
+	return remindResponse(args.T("api.command_remind.delete.success", map[string]any{"Message": reminder.Message}))
+}
+
+func remindResponse(text string) *model.CommandResponse {
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         text,
+	}
+}
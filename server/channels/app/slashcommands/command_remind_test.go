@@ -0,0 +1,113 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package slashcommands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/i18n"
+)
+
+func TestSplitRemindMessage(t *testing.T) {
+	now := time.Date(2024, time.March, 13, 14, 20, 0, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		text         string
+		expectedWhat string
+		expectedWhen string
+	}{
+		"time after":                {"to call Bob at 3pm", "call Bob", "at 3pm"},
+		"keyword in the message":    {"to look at the report at 3pm", "look at the report", "at 3pm"},
+		"time before":               {"in 2 hours to check the build", "check the build", "in 2 hours"},
+		"quoted message":            {`"Stand-up starts now" every weekday at 9:30am`, "Stand-up starts now", "every weekday at 9:30am"},
+		"day and time":              {"pay rent tomorrow at noon", "pay rent", "tomorrow at noon"},
+		"message without a keyword": {"to stretch", "", ""},
+		"time without a message":    {"in 5 minutes", "", ""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			what, when := splitRemindMessage(tc.text, now)
+			assert.Equal(t, tc.expectedWhat, what)
+			assert.Equal(t, tc.expectedWhen, when)
+		})
+	}
+}
+
+func TestRemindProviderDoCommand(t *testing.T) {
+	th := setup(t).initBasic(t)
+
+	cmd := &RemindProvider{}
+	args := &model.CommandArgs{
+		T:         i18n.IdentityTfunc(),
+		UserId:    th.BasicUser.Id,
+		TeamId:    th.BasicTeam.Id,
+		ChannelId: th.BasicChannel.Id,
+	}
+
+	t.Run("help", func(t *testing.T) {
+		resp := cmd.DoCommand(th.App, th.Context, args, "")
+		assert.Equal(t, "api.command_remind.help", resp.Text)
+		assert.Equal(t, model.CommandResponseTypeEphemeral, resp.ResponseType)
+	})
+
+	t.Run("remind me", func(t *testing.T) {
+		resp := cmd.DoCommand(th.App, th.Context, args, "me to water the plants in 2 hours")
+		assert.Equal(t, "api.command_remind.created", resp.Text)
+
+		reminders, appErr := th.App.GetRemindersForUser(th.BasicUser.Id, 0, 10)
+		require.Nil(t, appErr)
+		require.Len(t, reminders, 1)
+		assert.Equal(t, "water the plants", reminders[0].Message)
+		assert.Equal(t, model.ReminderTargetUser, reminders[0].TargetType)
+		assert.Equal(t, th.BasicUser.Id, reminders[0].TargetId)
+		assert.InDelta(t, model.GetMillis()+2*time.Hour.Milliseconds(), reminders[0].NextFireAt, float64(time.Minute.Milliseconds()))
+	})
+
+	t.Run("remind a user", func(t *testing.T) {
+		resp := cmd.DoCommand(th.App, th.Context, args, "@"+th.BasicUser2.Username+" to submit the report tomorrow")
+		assert.Equal(t, "api.command_remind.created", resp.Text)
+
+		resp = cmd.DoCommand(th.App, th.Context, args, "@nobody-"+model.NewId()+" to submit the report tomorrow")
+		assert.Equal(t, "api.command_remind.user.missing", resp.Text)
+	})
+
+	t.Run("remind a channel", func(t *testing.T) {
+		resp := cmd.DoCommand(th.App, th.Context, args, "~"+th.BasicChannel.Name+" stand-up every weekday at 9am")
+		assert.Equal(t, "api.command_remind.created api.command_remind.created.repeat", resp.Text)
+
+		otherChannel := th.createChannelWithAnotherUser(t, th.BasicTeam, model.ChannelTypePrivate, th.BasicUser2.Id)
+		resp = cmd.DoCommand(th.App, th.Context, args, "~"+otherChannel.Name+" stand-up every weekday at 9am")
+		assert.Equal(t, "api.command_remind.channel.missing", resp.Text)
+	})
+
+	t.Run("unparsable time", func(t *testing.T) {
+		resp := cmd.DoCommand(th.App, th.Context, args, "me to stretch sometime")
+		assert.Equal(t, "api.command_remind.parse_time.error", resp.Text)
+	})
+
+	t.Run("list and delete", func(t *testing.T) {
+		resp := cmd.DoCommand(th.App, th.Context, args, "list")
+		assert.Contains(t, resp.Text, "water the plants")
+
+		reminders, appErr := th.App.GetRemindersForUser(th.BasicUser.Id, 0, 10)
+		require.Nil(t, appErr)
+		require.NotEmpty(t, reminders)
+
+		resp = cmd.DoCommand(th.App, th.Context, &model.CommandArgs{
+			T:      i18n.IdentityTfunc(),
+			UserId: th.BasicUser2.Id,
+		}, "delete "+reminders[0].Id)
+		assert.Equal(t, "api.command_remind.delete.missing", resp.Text, "only the creator can delete a reminder")
+
+		resp = cmd.DoCommand(th.App, th.Context, args, "delete "+reminders[0].Id)
+		assert.Equal(t, "api.command_remind.delete.success", resp.Text)
+
+		_, appErr = th.App.GetReminder(reminders[0].Id)
+		require.NotNil(t, appErr)
+	})
+}
@@ -99,12 +99,12 @@ func TestCreateDefaultMemberships(t *testing.T) {
 	singer1 := th.BasicUser
 	scientist1 := th.BasicUser2
 
-	_, err = th.App.UpsertGroupMember(gleeGroup.Id, singer1.Id)
+	_, err = th.App.UpsertGroupMember(th.Context, gleeGroup.Id, singer1.Id)
 	if err != nil {
 		t.Errorf("test groupmember not created: %s", err.Error())
 	}
 
-	scientistGroupMember, err := th.App.UpsertGroupMember(scienceGroup.Id, scientist1.Id)
+	scientistGroupMember, err := th.App.UpsertGroupMember(th.Context, scienceGroup.Id, scientist1.Id)
 	if err != nil {
 		t.Errorf("test groupmember not created: %s", err.Error())
 	}
@@ -357,7 +357,7 @@ func TestCreateDefaultMemberships(t *testing.T) {
 		restrictedUser.Email = "restricted@mattermost.org"
 		_, err = th.App.UpdateUser(th.Context, restrictedUser, false)
 		require.Nil(t, err)
-		_, err = th.App.UpsertGroupMember(scienceGroup.Id, restrictedUser.Id)
+		_, err = th.App.UpsertGroupMember(th.Context, scienceGroup.Id, restrictedUser.Id)
 		require.Nil(t, err)
 
 		restrictedTeam, err := th.App.CreateTeam(th.Context, &model.Team{
@@ -438,12 +438,12 @@ func TestCreateDefaultMemberships(t *testing.T) {
 		user1 := th.BasicUser
 		user2 := th.BasicUser2
 
-		_, err = th.App.UpsertGroupMember(group1.Id, user1.Id)
+		_, err = th.App.UpsertGroupMember(th.Context, group1.Id, user1.Id)
 		if err != nil {
 			t.Errorf("test groupmember not created: %s", err.Error())
 		}
 
-		_, err = th.App.UpsertGroupMember(group1.Id, user2.Id)
+		_, err = th.App.UpsertGroupMember(th.Context, group1.Id, user2.Id)
 		if err != nil {
 			t.Errorf("test groupmember not created: %s", err.Error())
 		}
@@ -503,11 +503,11 @@ func TestCreateDefaultMemberships(t *testing.T) {
 
 	t.Run("error should contain a information about all users that failed", func(t *testing.T) {
 		user1 := th.CreateUser()
-		_, err = th.App.UpsertGroupMember(scienceGroup.Id, user1.Id)
+		_, err = th.App.UpsertGroupMember(th.Context, scienceGroup.Id, user1.Id)
 		require.Nil(t, err)
 
 		user2 := th.CreateUser()
-		_, err = th.App.UpsertGroupMember(scienceGroup.Id, user2.Id)
+		_, err = th.App.UpsertGroupMember(th.Context, scienceGroup.Id, user2.Id)
 		require.Nil(t, err)
 
 		store := &mockStore{
@@ -564,7 +564,7 @@ func TestDeleteGroupMemberships(t *testing.T) {
 	// make team group-constrained
 	team := th.BasicTeam
 	team.GroupConstrained = model.NewPointer(true)
-	team, err = th.App.UpdateTeam(team)
+	team, err = th.App.UpdateTeam(th.Context, team)
 	require.Nil(t, err)
 	require.True(t, *team.GroupConstrained)
 
@@ -591,7 +591,7 @@ func TestDeleteGroupMemberships(t *testing.T) {
 	require.Equal(t, 3, int(cmemberCount))
 
 	// add a user to the group
-	_, err = th.App.UpsertGroupMember(group.Id, th.SystemAdminUser.Id)
+	_, err = th.App.UpsertGroupMember(th.Context, group.Id, th.SystemAdminUser.Id)
 	require.Nil(t, err)
 
 	// run the delete
@@ -641,7 +641,7 @@ func TestSyncSyncableRoles(t *testing.T) {
 	require.Nil(t, err)
 
 	for _, user := range []*model.User{user1, user2} {
-		_, err = th.App.UpsertGroupMember(group.Id, user.Id)
+		_, err = th.App.UpsertGroupMember(th.Context, group.Id, user.Id)
 		require.Nil(t, err)
 
 		var tm *model.TeamMember
@@ -31,6 +31,7 @@ import (
 )
 
 func (a *App) AdjustTeamsFromProductLimits(teamLimits *model.TeamsLimits) *model.AppError {
+	rctx := request.EmptyContext(a.Log())
 	maxActiveTeams := *teamLimits.Active
 	teams, appErr := a.GetAllTeams()
 	if appErr != nil {
@@ -66,7 +67,7 @@ func (a *App) AdjustTeamsFromProductLimits(teamLimits *model.TeamsLimits) *model
 			cloudLimitsArchived := true
 			// Archive the remainder
 			patch := model.TeamPatch{CloudLimitsArchived: &cloudLimitsArchived}
-			_, err := a.PatchTeam(team.Id, &patch)
+			_, err := a.PatchTeam(rctx, team.Id, &patch)
 			if err != nil {
 				return err
 			}
@@ -93,7 +94,7 @@ func (a *App) AdjustTeamsFromProductLimits(teamLimits *model.TeamsLimits) *model
 				return err
 			}
 
-			_, err = a.PatchTeam(team.Id, patch)
+			_, err = a.PatchTeam(rctx, team.Id, patch)
 			if err != nil {
 				return err
 			}
@@ -136,6 +137,15 @@ func (a *App) CreateTeam(rctx request.CTX, team *model.Team) (*model.Team, *mode
 		}
 	}
 
+	createdTeam := rteam.ShallowCopy()
+	a.Srv().Go(func() {
+		pluginContext := pluginContext(rctx)
+		a.ch.RunMultiHook(func(hooks plugin.Hooks, _ *model.Manifest) bool {
+			hooks.TeamHasBeenCreated(pluginContext, createdTeam)
+			return true
+		}, plugin.TeamHasBeenCreatedID)
+	})
+
 	return rteam, nil
 }
 
@@ -162,7 +172,12 @@ func (a *App) CreateTeamWithUser(rctx request.CTX, team *model.Team, userID stri
 	return rteam, nil
 }
 
-func (a *App) UpdateTeam(team *model.Team) (*model.Team, *model.AppError) {
+func (a *App) UpdateTeam(rctx request.CTX, team *model.Team) (*model.Team, *model.AppError) {
+	prevTeam, appErr := a.GetTeam(team.Id)
+	if appErr != nil {
+		return nil, appErr
+	}
+
 	oldTeam, err := a.ch.srv.teamService.UpdateTeam(team, teams.UpdateOptions{Sanitized: true})
 	if err != nil {
 		var invErr *store.ErrInvalidInput
@@ -187,11 +202,25 @@ func (a *App) UpdateTeam(team *model.Team) (*model.Team, *model.AppError) {
 		return nil, appErr
 	}
 
+	a.teamHasBeenUpdated(rctx, oldTeam, prevTeam)
+
 	return oldTeam, nil
 }
 
+// teamHasBeenUpdated runs the TeamHasBeenUpdated hook of the plugins in the background.
+func (a *App) teamHasBeenUpdated(rctx request.CTX, newTeam, oldTeam *model.Team) {
+	newTeam = newTeam.ShallowCopy()
+	a.Srv().Go(func() {
+		pluginContext := pluginContext(rctx)
+		a.ch.RunMultiHook(func(hooks plugin.Hooks, _ *model.Manifest) bool {
+			hooks.TeamHasBeenUpdated(pluginContext, newTeam, oldTeam)
+			return true
+		}, plugin.TeamHasBeenUpdatedID)
+	})
+}
+
 // RenameTeam is used to rename the team Name and the DisplayName fields
-func (a *App) RenameTeam(team *model.Team, newTeamName string, newDisplayName string) (*model.Team, *model.AppError) {
+func (a *App) RenameTeam(rctx request.CTX, team *model.Team, newTeamName string, newDisplayName string) (*model.Team, *model.AppError) {
 	// check if name is occupied
 	_, errnf := a.GetTeamByName(newTeamName)
 
@@ -201,6 +230,8 @@ func (a *App) RenameTeam(team *model.Team, newTeamName string, newDisplayName st
 		return nil, model.NewAppError("RenameTeam", "app.team.rename_team.name_occupied", nil, errbody, http.StatusBadRequest)
 	}
 
+	prevTeam := team.ShallowCopy()
+
 	if newTeamName != "-" {
 		team.Name = newTeamName
 	}
@@ -229,14 +260,17 @@ func (a *App) RenameTeam(team *model.Team, newTeamName string, newDisplayName st
 		}
 	}
 
+	a.teamHasBeenUpdated(rctx, newTeam, prevTeam)
+
 	return newTeam, nil
 }
 
-func (a *App) UpdateTeamScheme(team *model.Team) (*model.Team, *model.AppError) {
+func (a *App) UpdateTeamScheme(rctx request.CTX, team *model.Team) (*model.Team, *model.AppError) {
 	oldTeam, err := a.GetTeam(team.Id)
 	if err != nil {
 		return nil, err
 	}
+	prevTeam := oldTeam.ShallowCopy()
 
 	oldTeam.SchemeId = team.SchemeId
 
@@ -265,14 +299,17 @@ func (a *App) UpdateTeamScheme(team *model.Team) (*model.Team, *model.AppError)
 		return nil, appErr
 	}
 
+	a.teamHasBeenUpdated(rctx, oldTeam, prevTeam)
+
 	return oldTeam, nil
 }
 
-func (a *App) UpdateTeamPrivacy(teamID string, teamType string, allowOpenInvite bool) *model.AppError {
+func (a *App) UpdateTeamPrivacy(rctx request.CTX, teamID string, teamType string, allowOpenInvite bool) *model.AppError {
 	oldTeam, err := a.GetTeam(teamID)
 	if err != nil {
 		return err
 	}
+	prevTeam := oldTeam.ShallowCopy()
 
 	// Force a regeneration of the invite token if changing a team to restricted.
 	if (allowOpenInvite != oldTeam.AllowOpenInvite || teamType != oldTeam.Type) && (!allowOpenInvite || teamType == model.TeamInvite) {
@@ -300,10 +337,17 @@ func (a *App) UpdateTeamPrivacy(teamID string, teamType string, allowOpenInvite
 		return appErr
 	}
 
+	a.teamHasBeenUpdated(rctx, oldTeam, prevTeam)
+
 	return nil
 }
 
-func (a *App) PatchTeam(teamID string, patch *model.TeamPatch) (*model.Team, *model.AppError) {
+func (a *App) PatchTeam(rctx request.CTX, teamID string, patch *model.TeamPatch) (*model.Team, *model.AppError) {
+	prevTeam, appErr := a.GetTeam(teamID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
 	team, err := a.ch.srv.teamService.PatchTeam(teamID, patch)
 	if err != nil {
 		var invErr *store.ErrInvalidInput
@@ -328,6 +372,8 @@ func (a *App) PatchTeam(teamID string, patch *model.TeamPatch) (*model.Team, *mo
 		return nil, appErr
 	}
 
+	a.teamHasBeenUpdated(rctx, team, prevTeam)
+
 	return team, nil
 }
 
@@ -813,6 +859,14 @@ func (a *App) JoinUserToTeam(rctx request.CTX, team *model.Team, user *model.Use
 			hooks.UserHasJoinedTeam(pluginContext, teamMember, actor)
 			return true
 		}, plugin.UserHasJoinedTeamID)
+
+		a.sendEventToSubscriptions(rctx, &model.EventSubscriptionPayload{
+			Event:   model.EventTypeUserHasJoinedTeam,
+			TeamId:  teamMember.TeamId,
+			UserId:  teamMember.UserId,
+			ActorId: userRequestorId,
+			Data:    teamMember,
+		})
 	})
 
 	message := model.NewWebSocketEvent(model.WebsocketEventAddedToTeam, "", "", user.Id, nil, "")
@@ -1180,6 +1234,14 @@ func (a *App) postProcessTeamMemberLeave(rctx request.CTX, teamMember *model.Tea
 			hooks.UserHasLeftTeam(pluginContext, teamMember, actor)
 			return true
 		}, plugin.UserHasLeftTeamID)
+
+		a.sendEventToSubscriptions(rctx, &model.EventSubscriptionPayload{
+			Event:   model.EventTypeUserHasLeftTeam,
+			TeamId:  teamMember.TeamId,
+			UserId:  teamMember.UserId,
+			ActorId: requestorId,
+			Data:    teamMember,
+		})
 	})
 
 	user, nErr := a.Srv().Store().User().Get(context.Background(), teamMember.UserId)
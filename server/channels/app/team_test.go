@@ -77,7 +77,7 @@ func TestUpdateTeam(t *testing.T) {
 
 	th.BasicTeam.DisplayName = "Testing 123"
 
-	updatedTeam, err := th.App.UpdateTeam(th.BasicTeam)
+	updatedTeam, err := th.App.UpdateTeam(th.Context, th.BasicTeam)
 	require.Nil(t, err, "Should update the team")
 	require.Equal(t, "Testing 123", updatedTeam.DisplayName, "Wrong Team DisplayName")
 }
@@ -101,7 +101,7 @@ func TestAddUserToTeam(t *testing.T) {
 
 	t.Run("allow user by domain", func(t *testing.T) {
 		th.BasicTeam.AllowedDomains = "example.com"
-		_, err := th.App.UpdateTeam(th.BasicTeam)
+		_, err := th.App.UpdateTeam(th.Context, th.BasicTeam)
 		require.Nil(t, err, "Should update the team")
 
 		user := model.User{Email: strings.ToLower(model.NewId()) + "success+test@example.com", Nickname: "Darth Vader", Username: "vader" + model.NewId(), Password: "passwd1", AuthService: ""}
@@ -117,7 +117,7 @@ func TestAddUserToTeam(t *testing.T) {
 
 	t.Run("block user by domain but allow bot", func(t *testing.T) {
 		th.BasicTeam.AllowedDomains = "example.com"
-		_, err := th.App.UpdateTeam(th.BasicTeam)
+		_, err := th.App.UpdateTeam(th.Context, th.BasicTeam)
 		require.Nil(t, err, "Should update the team")
 
 		user := model.User{Email: strings.ToLower(model.NewId()) + "test@invalid.com", Nickname: "Darth Vader", Username: "vader" + model.NewId(), Password: "passwd1", AuthService: ""}
@@ -157,7 +157,7 @@ func TestAddUserToTeam(t *testing.T) {
 
 	t.Run("block user with subdomain", func(t *testing.T) {
 		th.BasicTeam.AllowedDomains = "example.com"
-		_, err := th.App.UpdateTeam(th.BasicTeam)
+		_, err := th.App.UpdateTeam(th.Context, th.BasicTeam)
 		require.Nil(t, err, "Should update the team")
 
 		user := model.User{Email: strings.ToLower(model.NewId()) + "test@invalid.example.com", Nickname: "Darth Vader", Username: "vader" + model.NewId(), Password: "passwd1", AuthService: ""}
@@ -174,7 +174,7 @@ func TestAddUserToTeam(t *testing.T) {
 
 	t.Run("allow users by multiple domains", func(t *testing.T) {
 		th.BasicTeam.AllowedDomains = "foo.com, bar.com"
-		_, err := th.App.UpdateTeam(th.BasicTeam)
+		_, err := th.App.UpdateTeam(th.Context, th.BasicTeam)
 		require.Nil(t, err, "Should update the team")
 
 		user1 := model.User{Email: strings.ToLower(model.NewId()) + "success+test@foo.com", Nickname: "Darth Vader", Username: "vader" + model.NewId(), Password: "passwd1", AuthService: ""}
@@ -423,7 +423,7 @@ func TestAddUserToTeamByToken(t *testing.T) {
 
 	t.Run("group-constrained team", func(t *testing.T) {
 		th.BasicTeam.GroupConstrained = model.NewPointer(true)
-		_, err := th.App.UpdateTeam(th.BasicTeam)
+		_, err := th.App.UpdateTeam(th.Context, th.BasicTeam)
 		require.Nil(t, err, "Should update the team")
 
 		token := model.NewToken(
@@ -437,13 +437,13 @@ func TestAddUserToTeamByToken(t *testing.T) {
 		require.Equal(t, "app.team.invite_token.group_constrained.error", err.Id)
 
 		th.BasicTeam.GroupConstrained = model.NewPointer(false)
-		_, err = th.App.UpdateTeam(th.BasicTeam)
+		_, err = th.App.UpdateTeam(th.Context, th.BasicTeam)
 		require.Nil(t, err, "Should update the team")
 	})
 
 	t.Run("block user", func(t *testing.T) {
 		th.BasicTeam.AllowedDomains = "example.com"
-		_, err := th.App.UpdateTeam(th.BasicTeam)
+		_, err := th.App.UpdateTeam(th.Context, th.BasicTeam)
 		require.Nil(t, err, "Should update the team")
 
 		user := model.User{Email: strings.ToLower(model.NewId()) + "test@invalid.com", Nickname: "Darth Vader", Username: "vader" + model.NewId(), Password: "passwd1", AuthService: ""}
@@ -501,7 +501,7 @@ func TestAddUserToTeamByTeamId(t *testing.T) {
 
 	t.Run("block user", func(t *testing.T) {
 		th.BasicTeam.AllowedDomains = "example.com"
-		_, err := th.App.UpdateTeam(th.BasicTeam)
+		_, err := th.App.UpdateTeam(th.Context, th.BasicTeam)
 		require.Nil(t, err, "Should update the team")
 
 		user := model.User{Email: strings.ToLower(model.NewId()) + "test@invalid.com", Nickname: "Darth Vader", Username: "vader" + model.NewId(), Password: "passwd1", AuthService: ""}
@@ -646,7 +646,7 @@ func TestAdjustTeamsFromProductLimits(t *testing.T) {
 
 		cloudLimitsArchived := false
 		patch := &model.TeamPatch{CloudLimitsArchived: &cloudLimitsArchived}
-		team, err := th.App.PatchTeam(teamIds[0], patch)
+		team, err := th.App.PatchTeam(th.Context, teamIds[0], patch)
 		require.Nil(t, err)
 		require.Equal(t, false, team.CloudLimitsArchived)
 
@@ -1017,7 +1017,7 @@ func TestJoinUserToTeam(t *testing.T) {
 
 		group := th.CreateGroup()
 
-		_, err = th.App.UpsertGroupMember(group.Id, user1.Id)
+		_, err = th.App.UpsertGroupMember(th.Context, group.Id, user1.Id)
 		require.Nil(t, err)
 
 		gs, err := th.App.UpsertGroupSyncable(&model.GroupSyncable{
@@ -1042,7 +1042,7 @@ func TestJoinUserToTeam(t *testing.T) {
 			require.Nil(t, appErr)
 		}()
 
-		_, err = th.App.UpsertGroupMember(group.Id, user2.Id)
+		_, err = th.App.UpsertGroupMember(th.Context, group.Id, user2.Id)
 		require.Nil(t, err)
 
 		gs.SchemeAdmin = true
@@ -1148,7 +1148,7 @@ func TestAppUpdateTeamScheme(t *testing.T) {
 	mockID := model.NewPointer("x")
 	team.SchemeId = mockID
 
-	updatedTeam, appErr := th.App.UpdateTeamScheme(th.BasicTeam)
+	updatedTeam, appErr := th.App.UpdateTeamScheme(th.Context, th.BasicTeam)
 	require.Nil(t, appErr)
 	require.Equal(t, mockID, updatedTeam.SchemeId, "Wrong Team SchemeId")
 
@@ -1190,7 +1190,7 @@ func TestAppUpdateTeamScheme(t *testing.T) {
 	require.True(t, th.App.SessionHasPermissionToChannel(th.Context, session, channel.Id, model.PermissionManagePublicChannelProperties))
 	// apply the team scheme
 	team2.SchemeId = &team2Scheme.Id
-	_, appErr = th.App.UpdateTeamScheme(team2)
+	_, appErr = th.App.UpdateTeamScheme(th.Context, team2)
 	require.Nil(t, appErr)
 	require.False(t, th.App.SessionHasPermissionToChannel(th.Context, session, channel.Id, model.PermissionManagePublicChannelProperties))
 }
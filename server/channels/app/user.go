@@ -328,6 +328,12 @@ func (a *App) createUserOrGuest(rctx request.CTX, user *model.User, guest bool)
 			hooks.UserHasBeenCreated(pluginContext, ruser)
 			return true
 		}, plugin.UserHasBeenCreatedID)
+
+		a.sendEventToSubscriptions(rctx, &model.EventSubscriptionPayload{
+			Event:  model.EventTypeUserHasBeenCreated,
+			UserId: ruser.Id,
+			Data:   eventSubscriptionUser(ruser),
+		})
 	})
 
 	userLimits, limitErr := a.GetServerLimits()
@@ -1135,6 +1141,12 @@ func (a *App) UpdateActive(rctx request.CTX, user *model.User, active bool) (*mo
 				hooks.UserHasBeenDeactivated(pluginContext, user)
 				return true
 			}, plugin.UserHasBeenDeactivatedID)
+
+			a.sendEventToSubscriptions(rctx, &model.EventSubscriptionPayload{
+				Event:  model.EventTypeUserHasBeenDeactivated,
+				UserId: user.Id,
+				Data:   eventSubscriptionUser(user),
+			})
 		})
 	}
 
@@ -1906,6 +1918,22 @@ func (a *App) PermanentDeleteUser(rctx request.CTX, user *model.User) *model.App
 		return model.NewAppError("PermanentDeleteUser", "app.scheduled_post.permanent_delete_by_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
 
+	if err := a.Srv().Store().Reminder().PermanentDeleteByUser(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.reminder.permanent_delete_by_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if err := a.Srv().Store().UserDevice().PermanentDeleteByUser(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.user_device.permanent_delete_by_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if err := a.Srv().Store().Poll().PermanentDeleteVotesByUser(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.poll.permanent_delete_votes_by_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if err := a.Srv().Store().WebAuthnCredential().PermanentDeleteByUser(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.webauthn_credential.permanent_delete_by_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
 	if err := a.Srv().Store().Draft().PermanentDeleteByUser(user.Id); err != nil {
 		return model.NewAppError("PermanentDeleteUser", "app.drafts.permanent_delete_by_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -0,0 +1,179 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/i18n"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/utils"
+)
+
+// userDeviceCookieMaxAge is how long browsers keep the device cookie, which is
+// renewed on every login.
+const userDeviceCookieMaxAge = 365 * 24 * time.Hour
+
+// getUserDeviceID returns the identifier of the device logging in. Mobile apps are
+// identified by their device id, and browsers by a long-lived device cookie, which
+// is issued to the browsers that don't have one yet.
+func (a *App) getUserDeviceID(w http.ResponseWriter, r *http.Request, deviceID string) string {
+	if deviceID != "" {
+		return deviceID
+	}
+
+	if cookie, err := r.Cookie(model.SessionCookieDevice); err == nil && model.IsValidId(cookie.Value) {
+		deviceID = cookie.Value
+	} else {
+		deviceID = model.NewId()
+	}
+
+	subpath, _ := utils.GetSubpathFromConfig(a.Config())
+	maxAgeSeconds := int(userDeviceCookieMaxAge.Seconds())
+	http.SetCookie(w, &http.Cookie{
+		Name:     model.SessionCookieDevice,
+		Value:    deviceID,
+		Path:     subpath,
+		MaxAge:   maxAgeSeconds,
+		Expires:  time.Unix(model.GetMillis()/1000+int64(maxAgeSeconds), 0),
+		HttpOnly: true,
+		Domain:   a.GetCookieDomain(),
+		Secure:   GetProtocol(r) == "https",
+	})
+
+	return deviceID
+}
+
+// recordUserDevice saves the device a user logged in from, and alerts the user when
+// it is a device they never logged in from before.
+func (a *App) recordUserDevice(rctx request.CTX, user *model.User, device *model.UserDevice) {
+	isNew, err := a.Srv().Store().UserDevice().Upsert(device)
+	if err != nil {
+		rctx.Logger().Warn("Failed to save the device of a login", mlog.String("user_id", user.Id), mlog.Err(err))
+		return
+	}
+	if !isNew || !*a.Config().ServiceSettings.EnableNewSignInAlerts {
+		return
+	}
+
+	// The first device of a user, including the first one seen after an upgrade,
+	// is not worth an alert.
+	devices, err := a.Srv().Store().UserDevice().GetForUser(user.Id)
+	if err != nil {
+		rctx.Logger().Warn("Failed to get the devices of a user", mlog.String("user_id", user.Id), mlog.Err(err))
+		return
+	}
+	if len(devices) < 2 {
+		return
+	}
+
+	a.notifyNewSignIn(rctx, user, device)
+}
+
+// notifyNewSignIn alerts a user of a login from a new device, by email and with a
+// direct message from the system bot.
+func (a *App) notifyNewSignIn(rctx request.CTX, user *model.User, device *model.UserDevice) {
+	if err := a.Srv().EmailService.SendNewSignInEmail(user.Email, user.Locale, a.GetSiteURL(), device); err != nil {
+		rctx.Logger().Error("Unable to send new sign-in email", mlog.String("user_id", user.Id), mlog.Err(err))
+	}
+
+	systemBot, appErr := a.GetSystemBot(rctx)
+	if appErr != nil {
+		rctx.Logger().Warn("Failed to get the system bot to notify a new sign-in", mlog.Err(appErr))
+		return
+	}
+
+	channel, appErr := a.GetOrCreateDirectChannel(rctx, systemBot.UserId, user.Id)
+	if appErr != nil {
+		rctx.Logger().Warn("Failed to get the direct channel to notify a new sign-in", mlog.String("user_id", user.Id), mlog.Err(appErr))
+		return
+	}
+
+	T := i18n.GetUserTranslations(user.Locale)
+	post := &model.Post{
+		ChannelId: channel.Id,
+		UserId:    systemBot.UserId,
+		Message: T("app.user_device.new_sign_in.message", map[string]any{
+			"Platform":  device.Platform,
+			"Os":        device.Os,
+			"Browser":   device.Browser,
+			"IPAddress": device.LastIPAddress,
+		}),
+	}
+
+	if _, appErr := a.CreatePost(rctx, post, channel, model.CreatePostFlags{}); appErr != nil {
+		rctx.Logger().Warn("Failed to notify a new sign-in", mlog.String("user_id", user.Id), mlog.Err(appErr))
+	}
+}
+
+// GetSessionDevices returns the sessions users logged in with, along with the devices
+// they were created from. The sessions of personal access tokens are left out.
+func (a *App) GetSessionDevices(rctx request.CTX, userID, currentSessionID string) ([]*model.SessionDevice, *model.AppError) {
+	sessions, appErr := a.GetSessions(rctx, userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	devices, err := a.Srv().Store().UserDevice().GetForUser(userID)
+	if err != nil {
+		return nil, model.NewAppError("GetSessionDevices", "app.user_device.get_for_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	firstSeenAt := make(map[string]int64, len(devices))
+	for _, device := range devices {
+		firstSeenAt[device.Fingerprint] = device.FirstSeenAt
+	}
+
+	sessionDevices := make([]*model.SessionDevice, 0, len(sessions))
+	for _, session := range sessions {
+		if session.IsUserAccessToken() {
+			continue
+		}
+
+		sessionDevice := &model.SessionDevice{
+			SessionId:      session.Id,
+			IsCurrent:      session.Id == currentSessionID,
+			IsMobile:       session.IsMobileApp(),
+			Platform:       session.Props[model.SessionPropPlatform],
+			Os:             session.Props[model.SessionPropOs],
+			Browser:        session.Props[model.SessionPropBrowser],
+			IPAddress:      session.Props[model.SessionPropIPAddress],
+			FirstSeenAt:    session.CreateAt,
+			LastActivityAt: session.LastActivityAt,
+			CreateAt:       session.CreateAt,
+			ExpiresAt:      session.ExpiresAt,
+		}
+		if seenAt, ok := firstSeenAt[session.Props[model.SessionPropDeviceFingerprint]]; ok && seenAt < sessionDevice.FirstSeenAt {
+			sessionDevice.FirstSeenAt = seenAt
+		}
+
+		sessionDevices = append(sessionDevices, sessionDevice)
+	}
+
+	return sessionDevices, nil
+}
+
+// RevokeOtherSessions logs a user out of all the devices but the one of the current
+// session. Unlike RevokeAllSessions, the personal access tokens keep working.
+func (a *App) RevokeOtherSessions(rctx request.CTX, userID, currentSessionID string) *model.AppError {
+	sessions, appErr := a.GetSessions(rctx, userID)
+	if appErr != nil {
+		return appErr
+	}
+
+	for _, session := range sessions {
+		if session.Id == currentSessionID || session.IsUserAccessToken() {
+			continue
+		}
+
+		if appErr := a.RevokeSession(rctx, session); appErr != nil {
+			return appErr
+		}
+	}
+
+	return nil
+}
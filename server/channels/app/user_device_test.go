@@ -0,0 +1,201 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+const (
+	testFirefoxUserAgent = "Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0"
+	testSafariUserAgent  = "Mozilla/5.0 (Macintosh; Intel Mac OS X 14_5) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.5 Safari/605.1.15"
+)
+
+func TestRecordUserDevice(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	systemBot, appErr := th.App.GetSystemBot(th.Context)
+	require.Nil(t, appErr)
+
+	countAlerts := func(t *testing.T) int {
+		t.Helper()
+
+		channel, appErr := th.App.GetOrCreateDirectChannel(th.Context, systemBot.UserId, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		posts, appErr := th.App.GetPosts(channel.Id, 0, 10)
+		require.Nil(t, appErr)
+		return len(posts.Posts)
+	}
+
+	newDevice := func(deviceID string) *model.UserDevice {
+		return &model.UserDevice{
+			UserId:        th.BasicUser.Id,
+			Fingerprint:   model.NewUserDeviceFingerprint(deviceID),
+			Platform:      "Linux",
+			Os:            "Linux",
+			Browser:       "Firefox",
+			FirstSeenAt:   model.GetMillis(),
+			LastIPAddress: "10.0.0.1",
+		}
+	}
+
+	firstDeviceID := model.NewId()
+	th.App.recordUserDevice(th.Context, th.BasicUser, newDevice(firstDeviceID))
+	assert.Zero(t, countAlerts(t), "the first device of a user must not be alerted of")
+
+	th.App.recordUserDevice(th.Context, th.BasicUser, newDevice(firstDeviceID))
+	assert.Zero(t, countAlerts(t), "a known device must not be alerted of")
+
+	th.App.recordUserDevice(th.Context, th.BasicUser, newDevice(model.NewId()))
+	assert.Equal(t, 1, countAlerts(t))
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableNewSignInAlerts = false
+	})
+	th.App.recordUserDevice(th.Context, th.BasicUser, newDevice(model.NewId()))
+	assert.Equal(t, 1, countAlerts(t))
+
+	devices, err := th.App.Srv().Store().UserDevice().GetForUser(th.BasicUser.Id)
+	require.NoError(t, err)
+	assert.Len(t, devices, 3)
+}
+
+func TestDoLoginUserDevice(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	systemBot, appErr := th.App.GetSystemBot(th.Context)
+	require.Nil(t, appErr)
+
+	login := func(t *testing.T, cookie *http.Cookie, deviceID string) *http.Cookie {
+		t.Helper()
+
+		r := &http.Request{Header: http.Header{"User-Agent": []string{testFirefoxUserAgent}}}
+		if cookie != nil {
+			r.AddCookie(cookie)
+		}
+		w := httptest.NewRecorder()
+		_, appErr := th.App.DoLogin(th.Context, w, r, th.BasicUser, deviceID, deviceID != "", false, false)
+		require.Nil(t, appErr)
+
+		for _, cookie := range w.Result().Cookies() {
+			if cookie.Name == model.SessionCookieDevice {
+				return cookie
+			}
+		}
+		return nil
+	}
+
+	requireDevicesAndAlerts := func(t *testing.T, devices, alerts int) {
+		t.Helper()
+
+		require.Eventually(t, func() bool {
+			userDevices, err := th.App.Srv().Store().UserDevice().GetForUser(th.BasicUser.Id)
+			if err != nil || len(userDevices) != devices {
+				return false
+			}
+
+			channel, appErr := th.App.GetOrCreateDirectChannel(th.Context, systemBot.UserId, th.BasicUser.Id)
+			if appErr != nil {
+				return false
+			}
+			posts, appErr := th.App.GetPosts(channel.Id, 0, 10)
+			return appErr == nil && len(posts.Posts) == alerts
+		}, 2*time.Second, 100*time.Millisecond)
+	}
+
+	cookie := login(t, nil, "")
+	require.NotNil(t, cookie, "a device cookie must be issued to a browser without one")
+	assert.True(t, model.IsValidId(cookie.Value))
+	assert.True(t, cookie.HttpOnly)
+	requireDevicesAndAlerts(t, 1, 0)
+
+	renewed := login(t, cookie, "")
+	require.NotNil(t, renewed)
+	assert.Equal(t, cookie.Value, renewed.Value, "the device cookie must be kept across logins")
+	requireDevicesAndAlerts(t, 1, 0)
+
+	t.Run("a second machine with the same user agent raises an alert", func(t *testing.T) {
+		other := login(t, nil, "")
+		require.NotNil(t, other)
+		assert.NotEqual(t, cookie.Value, other.Value)
+		requireDevicesAndAlerts(t, 2, 1)
+	})
+
+	t.Run("mobile apps are identified by their device id", func(t *testing.T) {
+		deviceID := "android_rn-v2:" + model.NewId()
+		assert.Nil(t, login(t, nil, deviceID), "mobile apps must not be issued a device cookie")
+		requireDevicesAndAlerts(t, 3, 2)
+
+		login(t, nil, deviceID)
+		requireDevicesAndAlerts(t, 3, 2)
+	})
+}
+
+func TestSessionDevices(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	login := func(t *testing.T, userAgent string) *model.Session {
+		t.Helper()
+
+		r := &http.Request{Header: http.Header{"User-Agent": []string{userAgent}}}
+		session, appErr := th.App.DoLogin(th.Context.WithIPAddress("10.0.0.2"), httptest.NewRecorder(), r, th.BasicUser, "", false, false, false)
+		require.Nil(t, appErr)
+		return session
+	}
+
+	firefox := login(t, testFirefoxUserAgent)
+	safari := login(t, testSafariUserAgent)
+
+	_, appErr := th.App.CreateSession(th.Context, &model.Session{
+		UserId: th.BasicUser.Id,
+		Props:  model.StringMap{model.SessionPropType: model.SessionTypeUserAccessToken},
+	})
+	require.Nil(t, appErr)
+
+	require.Eventually(t, func() bool {
+		devices, err := th.App.Srv().Store().UserDevice().GetForUser(th.BasicUser.Id)
+		return err == nil && len(devices) == 2
+	}, 2*time.Second, 100*time.Millisecond)
+
+	t.Run("get session devices", func(t *testing.T) {
+		devices, appErr := th.App.GetSessionDevices(th.Context, th.BasicUser.Id, safari.Id)
+		require.Nil(t, appErr)
+		require.Len(t, devices, 2, "the sessions of personal access tokens must be left out")
+
+		for _, device := range devices {
+			assert.Equal(t, device.SessionId == safari.Id, device.IsCurrent)
+			assert.Equal(t, "10.0.0.2", device.IPAddress)
+			assert.NotZero(t, device.FirstSeenAt)
+			if device.SessionId == firefox.Id {
+				assert.Equal(t, "Linux", device.Os)
+			}
+		}
+	})
+
+	t.Run("revoke other sessions", func(t *testing.T) {
+		appErr := th.App.RevokeOtherSessions(th.Context, th.BasicUser.Id, safari.Id)
+		require.Nil(t, appErr)
+
+		sessions, appErr := th.App.GetSessions(th.Context, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		require.Len(t, sessions, 2)
+		for _, session := range sessions {
+			assert.True(t, session.Id == safari.Id || session.IsUserAccessToken())
+		}
+	})
+}
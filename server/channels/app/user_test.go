@@ -1190,7 +1190,7 @@ func TestCreateUserWithToken(t *testing.T) {
 
 	t.Run("create guest having team and system email domain restrictions", func(t *testing.T) {
 		th.BasicTeam.AllowedDomains = "restricted-team.com"
-		_, err := th.App.UpdateTeam(th.BasicTeam)
+		_, err := th.App.UpdateTeam(th.Context, th.BasicTeam)
 		require.Nil(t, err, "Should update the team")
 		enableGuestDomainRestrictions := *th.App.Config().TeamSettings.RestrictCreationToDomains
 		defer func() {
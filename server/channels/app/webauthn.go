@@ -0,0 +1,499 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/webauthn"
+)
+
+const (
+	webAuthnUsersCacheSize = 10000
+	// webAuthnUsersCacheExpiry bounds how long a user keeps satisfying the enforcement
+	// policies on the other nodes of a cluster after removing their last credential.
+	webAuthnUsersCacheExpiry = 5 * time.Minute
+)
+
+// webAuthnChallenge is stored in the Extra field of the tokens the ceremonies are tracked
+// with. The token itself is the challenge.
+type webAuthnChallenge struct {
+	// UserId is empty for logins, where the user is only known once they have picked one of
+	// their passkeys.
+	UserId string `json:"user_id"`
+}
+
+func (a *App) webAuthnRelyingParty() (webauthn.RelyingParty, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableWebAuthn {
+		return webauthn.RelyingParty{}, model.NewAppError("webAuthnRelyingParty", "api.user.webauthn.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	siteURL, err := url.Parse(a.GetSiteURL())
+	if err != nil || siteURL.Host == "" {
+		return webauthn.RelyingParty{}, model.NewAppError("webAuthnRelyingParty", "api.user.webauthn.site_url.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	rpID := *a.Config().ServiceSettings.WebAuthnRelyingPartyId
+	if rpID == "" {
+		rpID = siteURL.Hostname()
+	}
+
+	return webauthn.RelyingParty{
+		ID:      rpID,
+		Origins: []string{siteURL.Scheme + "://" + siteURL.Host},
+	}, nil
+}
+
+func (a *App) webAuthnRequiresUserVerification() bool {
+	return *a.Config().ServiceSettings.WebAuthnUserVerification == model.WebAuthnUserVerificationRequired
+}
+
+func (a *App) newWebAuthnChallenge(tokenType, userID string) (*model.Token, *model.AppError) {
+	extra, err := json.Marshal(webAuthnChallenge{UserId: userID})
+	if err != nil {
+		return nil, model.NewAppError("newWebAuthnChallenge", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	token := model.NewToken(tokenType, string(extra))
+	if err := a.Srv().Store().Token().Save(token); err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("newWebAuthnChallenge", "app.recover.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return token, nil
+}
+
+// consumeWebAuthnChallenge finds the challenge a response answers, and deletes it so that it
+// can't be answered again.
+func (a *App) consumeWebAuthnChallenge(tokenType string, clientDataJSON []byte) ([]byte, *webAuthnChallenge, *model.AppError) {
+	challenge, err := webauthn.ChallengeFromClientData(clientDataJSON)
+	if err != nil {
+		return nil, nil, model.NewAppError("consumeWebAuthnChallenge", "api.user.webauthn.invalid_response.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	token, err := a.Srv().Store().Token().GetByToken(string(challenge))
+	if err != nil || token.Type != tokenType {
+		return nil, nil, model.NewAppError("consumeWebAuthnChallenge", "api.user.webauthn.invalid_challenge.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	if err = a.Srv().Store().Token().Delete(token.Token); err != nil {
+		return nil, nil, model.NewAppError("consumeWebAuthnChallenge", "app.recover.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if model.GetMillis()-token.CreateAt > model.WebAuthnCeremonyTimeout {
+		return nil, nil, model.NewAppError("consumeWebAuthnChallenge", "api.user.webauthn.invalid_challenge.app_error", nil, "expired", http.StatusBadRequest)
+	}
+
+	var data webAuthnChallenge
+	if err = json.Unmarshal([]byte(token.Extra), &data); err != nil {
+		return nil, nil, model.NewAppError("consumeWebAuthnChallenge", "api.user.webauthn.invalid_challenge.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	return challenge, &data, nil
+}
+
+func decodeWebAuthnField(value string) ([]byte, *model.AppError) {
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+	if err != nil || len(decoded) == 0 {
+		return nil, model.NewAppError("decodeWebAuthnField", "api.user.webauthn.invalid_response.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+	return decoded, nil
+}
+
+func webAuthnCredentialDescriptors(credentials []*model.WebAuthnCredential) []model.WebAuthnCredentialDescriptor {
+	descriptors := make([]model.WebAuthnCredentialDescriptor, 0, len(credentials))
+	for _, credential := range credentials {
+		descriptors = append(descriptors, model.WebAuthnCredentialDescriptor{
+			Type: model.WebAuthnCredentialTypePublicKey,
+			Id:   credential.CredentialId,
+		})
+	}
+	return descriptors
+}
+
+// canUseWebAuthn returns whether a user may register passkeys. Like MFA, they are only
+// available to the users signing in with a password.
+func canUseWebAuthn(user *model.User) bool {
+	return !user.IsBot && (user.AuthService == "" || user.AuthService == model.UserAuthServiceEmail || user.AuthService == model.UserAuthServiceLdap)
+}
+
+func (a *App) GetWebAuthnCredentials(userID string) ([]*model.WebAuthnCredential, *model.AppError) {
+	credentials, err := a.Srv().Store().WebAuthnCredential().GetForUser(userID)
+	if err != nil {
+		return nil, model.NewAppError("GetWebAuthnCredentials", "app.webauthn_credential.get_for_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return credentials, nil
+}
+
+// hasWebAuthnCredentials returns whether a user registered passkeys, and can use them as a
+// second factor.
+func (a *App) hasWebAuthnCredentials(userID string) (bool, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableWebAuthn {
+		return false, nil
+	}
+
+	// Only the users with credentials are cached, so that a user registering their first
+	// credential satisfies the enforcement policies right away.
+	var cached bool
+	if err := a.Srv().webAuthnUsersCache.Get(userID, &cached); err == nil && cached {
+		return true, nil
+	}
+
+	credentials, appErr := a.GetWebAuthnCredentials(userID)
+	if appErr != nil {
+		return false, appErr
+	}
+	if len(credentials) == 0 {
+		return false, nil
+	}
+
+	if err := a.Srv().webAuthnUsersCache.SetWithExpiry(userID, true, webAuthnUsersCacheExpiry); err != nil {
+		a.Log().Warn("Failed to cache the WebAuthn credentials of a user", mlog.String("user_id", userID), mlog.Err(err))
+	}
+
+	return true, nil
+}
+
+// StartWebAuthnRegistration starts the registration of a passkey or security key by a user,
+// returning the options to create it with.
+func (a *App) StartWebAuthnRegistration(rctx request.CTX, userID string) (*model.WebAuthnCreationOptions, *model.AppError) {
+	rp, appErr := a.webAuthnRelyingParty()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if !canUseWebAuthn(user) {
+		return nil, model.NewAppError("StartWebAuthnRegistration", "api.user.webauthn.auth_service.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	credentials, appErr := a.GetWebAuthnCredentials(user.Id)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if len(credentials) >= model.WebAuthnCredentialsMaxPerUser {
+		return nil, model.NewAppError("StartWebAuthnRegistration", "api.user.webauthn.too_many_credentials.app_error", map[string]any{"Max": model.WebAuthnCredentialsMaxPerUser}, "", http.StatusBadRequest)
+	}
+
+	token, appErr := a.newWebAuthnChallenge(model.TokenTypeWebAuthnRegistration, user.Id)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	params := make([]model.WebAuthnCredentialParameter, 0, len(webauthn.SupportedAlgorithms))
+	for _, alg := range webauthn.SupportedAlgorithms {
+		params = append(params, model.WebAuthnCredentialParameter{Type: model.WebAuthnCredentialTypePublicKey, Alg: alg})
+	}
+
+	displayName := user.GetFullName()
+	if displayName == "" {
+		displayName = user.Username
+	}
+
+	return &model.WebAuthnCreationOptions{
+		Challenge: base64.RawURLEncoding.EncodeToString([]byte(token.Token)),
+		RelyingParty: model.WebAuthnRelyingPartyEntity{
+			Id:   rp.ID,
+			Name: *a.Config().TeamSettings.SiteName,
+		},
+		User: model.WebAuthnUserEntity{
+			Id:          base64.RawURLEncoding.EncodeToString([]byte(user.Id)),
+			Name:        user.Username,
+			DisplayName: displayName,
+		},
+		PubKeyCredParams:   params,
+		Timeout:            model.WebAuthnCeremonyTimeout,
+		ExcludeCredentials: webAuthnCredentialDescriptors(credentials),
+		AuthenticatorSelection: model.WebAuthnAuthenticatorSelection{
+			// Logins only use discoverable credentials, which store the user they belong
+			// to, so that they never have to tell which credentials a user has.
+			ResidentKey:        "required",
+			RequireResidentKey: true,
+			UserVerification:   *a.Config().ServiceSettings.WebAuthnUserVerification,
+		},
+		Attestation: "none",
+	}, nil
+}
+
+// FinishWebAuthnRegistration verifies the credential created by a registration ceremony and
+// saves it. Like changing a password, registering a credential requires the user to
+// authenticate again, so that a stolen session can't be turned into a lasting login.
+func (a *App) FinishWebAuthnRegistration(rctx request.CTX, userID string, registration *model.WebAuthnRegistrationRequest) (*model.WebAuthnCredential, *model.AppError) {
+	rp, appErr := a.webAuthnRelyingParty()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if _, appErr = a.authenticateUser(rctx, user, registration.Password, registration.MfaToken); appErr != nil {
+		return nil, appErr
+	}
+
+	response := registration.Credential
+	clientDataJSON, appErr := decodeWebAuthnField(response.Response.ClientDataJSON)
+	if appErr != nil {
+		return nil, appErr
+	}
+	attestationObject, appErr := decodeWebAuthnField(response.Response.AttestationObject)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	challenge, data, appErr := a.consumeWebAuthnChallenge(model.TokenTypeWebAuthnRegistration, clientDataJSON)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if data.UserId != userID {
+		return nil, model.NewAppError("FinishWebAuthnRegistration", "api.user.webauthn.invalid_challenge.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	verified, err := rp.VerifyRegistration(challenge, clientDataJSON, attestationObject, a.webAuthnRequiresUserVerification())
+	if err != nil {
+		return nil, model.NewAppError("FinishWebAuthnRegistration", "api.user.webauthn.registration_failed.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	credential, err := a.Srv().Store().WebAuthnCredential().Save(&model.WebAuthnCredential{
+		UserId:       userID,
+		CredentialId: base64.RawURLEncoding.EncodeToString(verified.ID),
+		PublicKey:    verified.PublicKey,
+		SignCount:    int64(verified.SignCount),
+		AAGUID:       hex.EncodeToString(verified.AAGUID),
+		Name:         strings.TrimSpace(registration.Name),
+	})
+	if err != nil {
+		var appErr *model.AppError
+		var uniqueErr *store.ErrUniqueConstraint
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &uniqueErr):
+			return nil, model.NewAppError("FinishWebAuthnRegistration", "api.user.webauthn.already_registered.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+		default:
+			return nil, model.NewAppError("FinishWebAuthnRegistration", "app.webauthn_credential.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return credential, nil
+}
+
+func (a *App) DeleteWebAuthnCredential(rctx request.CTX, userID, id string) *model.AppError {
+	credential, err := a.Srv().Store().WebAuthnCredential().Get(id)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeleteWebAuthnCredential", "app.webauthn_credential.get.not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return model.NewAppError("DeleteWebAuthnCredential", "app.webauthn_credential.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	if credential.UserId != userID {
+		return model.NewAppError("DeleteWebAuthnCredential", "app.webauthn_credential.get.not_found.app_error", nil, "", http.StatusNotFound)
+	}
+
+	if err := a.Srv().Store().WebAuthnCredential().Delete(id); err != nil {
+		return model.NewAppError("DeleteWebAuthnCredential", "app.webauthn_credential.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if err := a.Srv().webAuthnUsersCache.Remove(userID); err != nil {
+		rctx.Logger().Warn("Failed to remove a user from the WebAuthn users cache", mlog.String("user_id", userID), mlog.Err(err))
+	}
+
+	return nil
+}
+
+// StartWebAuthnLogin starts an authentication ceremony, returning the options to run it
+// with. The ceremony is either for the second factor of a user or for a passwordless login.
+// Both only use discoverable credentials: the user is only known once they have picked one of
+// their passkeys, and the response tells nothing about who has an account or a passkey.
+func (a *App) StartWebAuthnLogin(rctx request.CTX, secondFactor bool) (*model.WebAuthnRequestOptions, *model.AppError) {
+	rp, appErr := a.webAuthnRelyingParty()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	userVerification := *a.Config().ServiceSettings.WebAuthnUserVerification
+	if !secondFactor {
+		if !*a.Config().ServiceSettings.EnablePasswordlessLogin {
+			return nil, model.NewAppError("StartWebAuthnLogin", "api.user.webauthn.passwordless_disabled.app_error", nil, "", http.StatusNotImplemented)
+		}
+		// Without a password, the passkey is the only factor and must verify the user.
+		userVerification = model.WebAuthnUserVerificationRequired
+	}
+
+	token, appErr := a.newWebAuthnChallenge(model.TokenTypeWebAuthnLogin, "")
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	return &model.WebAuthnRequestOptions{
+		Challenge:        base64.RawURLEncoding.EncodeToString([]byte(token.Token)),
+		Timeout:          model.WebAuthnCeremonyTimeout,
+		RelyingPartyId:   rp.ID,
+		AllowCredentials: []model.WebAuthnCredentialDescriptor{},
+		UserVerification: userVerification,
+	}, nil
+}
+
+// verifyWebAuthnAssertion verifies the response to an authentication ceremony, returning the
+// credential it was signed with.
+func (a *App) verifyWebAuthnAssertion(rctx request.CTX, response *model.WebAuthnAssertionResponse, requireUserVerification bool) (*model.WebAuthnCredential, *model.AppError) {
+	rp, appErr := a.webAuthnRelyingParty()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	clientDataJSON, appErr := decodeWebAuthnField(response.Response.ClientDataJSON)
+	if appErr != nil {
+		return nil, appErr
+	}
+	authenticatorData, appErr := decodeWebAuthnField(response.Response.AuthenticatorData)
+	if appErr != nil {
+		return nil, appErr
+	}
+	signature, appErr := decodeWebAuthnField(response.Response.Signature)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	challenge, _, appErr := a.consumeWebAuthnChallenge(model.TokenTypeWebAuthnLogin, clientDataJSON)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	credential, err := a.Srv().Store().WebAuthnCredential().GetByCredentialId(strings.TrimRight(response.RawId, "="))
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("verifyWebAuthnAssertion", "api.user.webauthn.authentication_failed.app_error", nil, "unknown credential", http.StatusUnauthorized).Wrap(err)
+		default:
+			return nil, model.NewAppError("verifyWebAuthnAssertion", "app.webauthn_credential.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	signCount, err := rp.VerifyAssertion(challenge, credential.PublicKey, uint32(credential.SignCount), clientDataJSON, authenticatorData, signature, requireUserVerification)
+	if err != nil {
+		if errors.Is(err, webauthn.ErrSignCount) {
+			rctx.Logger().Warn("The signature counter of a WebAuthn credential went backwards, the authenticator may have been cloned", mlog.String("user_id", credential.UserId), mlog.String("credential_id", credential.Id))
+		}
+		return nil, model.NewAppError("verifyWebAuthnAssertion", "api.user.webauthn.authentication_failed.app_error", nil, "", http.StatusUnauthorized).Wrap(err)
+	}
+
+	credential.SignCount = int64(signCount)
+	credential.LastUsedAt = model.GetMillis()
+	if err := a.Srv().Store().WebAuthnCredential().UpdateSignCount(credential.Id, credential.SignCount, credential.LastUsedAt); err != nil {
+		return nil, model.NewAppError("verifyWebAuthnAssertion", "app.webauthn_credential.update_sign_count.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return credential, nil
+}
+
+// isWebAuthnAssertion returns whether the MFA token of a login request is a WebAuthn assertion
+// rather than a TOTP code.
+func isWebAuthnAssertion(token string) bool {
+	return strings.HasPrefix(strings.TrimSpace(token), "{")
+}
+
+// checkUserWebAuthn checks a WebAuthn assertion sent as the second factor of a user.
+func (a *App) checkUserWebAuthn(rctx request.CTX, user *model.User, token string) *model.AppError {
+	var response model.WebAuthnAssertionResponse
+	if err := json.Unmarshal([]byte(token), &response); err != nil {
+		return model.NewAppError("checkUserWebAuthn", "api.user.webauthn.invalid_response.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	credential, appErr := a.verifyWebAuthnAssertion(rctx, &response, a.webAuthnRequiresUserVerification())
+	if appErr != nil {
+		return appErr
+	}
+
+	if credential.UserId != user.Id {
+		return model.NewAppError("checkUserWebAuthn", "api.user.webauthn.authentication_failed.app_error", nil, "credential of another user", http.StatusUnauthorized)
+	}
+
+	return nil
+}
+
+// AuthenticateUserWithWebAuthn authenticates a user with a passkey alone, for passwordless
+// logins.
+func (a *App) AuthenticateUserWithWebAuthn(rctx request.CTX, response *model.WebAuthnAssertionResponse) (user *model.User, appErr *model.AppError) {
+	defer func() {
+		if a.Metrics() != nil {
+			if user == nil || appErr != nil {
+				a.Metrics().IncrementLoginFail()
+			} else {
+				a.Metrics().IncrementLogin()
+			}
+		}
+	}()
+
+	if !*a.Config().ServiceSettings.EnablePasswordlessLogin {
+		return nil, model.NewAppError("AuthenticateUserWithWebAuthn", "api.user.webauthn.passwordless_disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if appErr = a.checkLoginIPLockout(rctx); appErr != nil {
+		return nil, appErr
+	}
+	defer func() {
+		a.recordLoginFailure(rctx, appErr)
+	}()
+
+	credential, appErr := a.verifyWebAuthnAssertion(rctx, response, true)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if response.Response.UserHandle != "" {
+		userHandle, appErr := decodeWebAuthnField(response.Response.UserHandle)
+		if appErr != nil {
+			return nil, appErr
+		}
+		if string(userHandle) != credential.UserId {
+			return nil, model.NewAppError("AuthenticateUserWithWebAuthn", "api.user.webauthn.authentication_failed.app_error", nil, "user handle mismatch", http.StatusUnauthorized)
+		}
+	}
+
+	if user, appErr = a.GetUser(credential.UserId); appErr != nil {
+		return nil, appErr
+	}
+
+	if !canUseWebAuthn(user) {
+		return nil, model.NewAppError("AuthenticateUserWithWebAuthn", "api.user.webauthn.auth_service.app_error", nil, "", http.StatusUnauthorized)
+	}
+
+	if appErr = a.CheckUserAllAuthenticationCriteria(rctx, user, ""); appErr != nil {
+		return nil, appErr
+	}
+
+	return user, nil
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// testPasskey simulates an authenticator holding a single ES256 credential.
+type testPasskey struct {
+	id        []byte
+	key       *ecdsa.PrivateKey
+	signCount uint32
+}
+
+func newTestPasskey(t *testing.T) *testPasskey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return &testPasskey{id: []byte(model.NewId()), key: key}
+}
+
+func cborBytes(data []byte) []byte {
+	if len(data) < 24 {
+		return append([]byte{0x40 | byte(len(data))}, data...)
+	}
+	if len(data) <= 0xff {
+		return append([]byte{0x58, byte(len(data))}, data...)
+	}
+	return append(binary.BigEndian.AppendUint16([]byte{0x59}, uint16(len(data))), data...)
+}
+
+func cborText(s string) []byte {
+	return append([]byte{0x60 | byte(len(s))}, s...)
+}
+
+func (p *testPasskey) authData(rpID string, attested bool) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	// User present and user verified.
+	flags := byte(0x05)
+	if attested {
+		flags |= 0x40
+	}
+	data := append(rpIDHash[:], flags)
+	data = binary.BigEndian.AppendUint32(data, p.signCount)
+	if attested {
+		data = append(data, make([]byte, 16)...)
+		data = binary.BigEndian.AppendUint16(data, uint16(len(p.id)))
+		data = append(data, p.id...)
+		// COSE key: {1: 2, 3: -7, -1: 1, -2: x, -3: y}
+		data = append(data, 0xa5, 0x01, 0x02, 0x03, 0x26, 0x20, 0x01, 0x21)
+		data = append(data, cborBytes(p.key.X.FillBytes(make([]byte, 32)))...)
+		data = append(data, 0x22)
+		data = append(data, cborBytes(p.key.Y.FillBytes(make([]byte, 32)))...)
+	}
+	return data
+}
+
+func testClientData(t *testing.T, ceremony, challenge, origin string) []byte {
+	data, err := json.Marshal(map[string]string{
+		"type":      ceremony,
+		"challenge": challenge,
+		"origin":    origin,
+	})
+	require.NoError(t, err)
+	return data
+}
+
+func (p *testPasskey) register(t *testing.T, options *model.WebAuthnCreationOptions, origin string) *model.WebAuthnRegistrationResponse {
+	clientData := testClientData(t, "webauthn.create", options.Challenge, origin)
+
+	attestationObject := []byte{0xa3}
+	attestationObject = append(attestationObject, cborText("fmt")...)
+	attestationObject = append(attestationObject, cborText("none")...)
+	attestationObject = append(attestationObject, cborText("attStmt")...)
+	attestationObject = append(attestationObject, 0xa0)
+	attestationObject = append(attestationObject, cborText("authData")...)
+	attestationObject = append(attestationObject, cborBytes(p.authData(options.RelyingParty.Id, true))...)
+
+	return &model.WebAuthnRegistrationResponse{
+		Id:    base64.RawURLEncoding.EncodeToString(p.id),
+		RawId: base64.RawURLEncoding.EncodeToString(p.id),
+		Type:  model.WebAuthnCredentialTypePublicKey,
+		Response: model.WebAuthnAttestationResponse{
+			ClientDataJSON:    base64.RawURLEncoding.EncodeToString(clientData),
+			AttestationObject: base64.RawURLEncoding.EncodeToString(attestationObject),
+		},
+	}
+}
+
+func (p *testPasskey) assert(t *testing.T, options *model.WebAuthnRequestOptions, origin, userID string) *model.WebAuthnAssertionResponse {
+	p.signCount++
+	clientData := testClientData(t, "webauthn.get", options.Challenge, origin)
+	authData := p.authData(options.RelyingPartyId, false)
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte(nil), authData...), clientDataHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, p.key, digest[:])
+	require.NoError(t, err)
+
+	return &model.WebAuthnAssertionResponse{
+		Id:    base64.RawURLEncoding.EncodeToString(p.id),
+		RawId: base64.RawURLEncoding.EncodeToString(p.id),
+		Type:  model.WebAuthnCredentialTypePublicKey,
+		Response: model.WebAuthnAuthenticatorAssertionResponse{
+			ClientDataJSON:    base64.RawURLEncoding.EncodeToString(clientData),
+			AuthenticatorData: base64.RawURLEncoding.EncodeToString(authData),
+			Signature:         base64.RawURLEncoding.EncodeToString(sig),
+			UserHandle:        base64.RawURLEncoding.EncodeToString([]byte(userID)),
+		},
+	}
+}
+
+func TestWebAuthn(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	const origin = "https://chat.example.com"
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.SiteURL = origin
+		*cfg.ServiceSettings.EnableWebAuthn = true
+		*cfg.ServiceSettings.EnablePasswordlessLogin = true
+	})
+
+	passkey := newTestPasskey(t)
+	register := func(userID, name string, response *model.WebAuthnRegistrationResponse) (*model.WebAuthnCredential, *model.AppError) {
+		return th.App.FinishWebAuthnRegistration(th.Context, userID, &model.WebAuthnRegistrationRequest{
+			Name:       name,
+			Credential: response,
+			Password:   "Password1",
+		})
+	}
+
+	t.Run("registers a credential", func(t *testing.T) {
+		options, appErr := th.App.StartWebAuthnRegistration(th.Context, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, "chat.example.com", options.RelyingParty.Id)
+		assert.Equal(t, "required", options.AuthenticatorSelection.ResidentKey)
+		assert.Empty(t, options.ExcludeCredentials)
+
+		credential, appErr := register(th.BasicUser.Id, " Laptop ", passkey.register(t, options, origin))
+		require.Nil(t, appErr)
+		assert.Equal(t, "Laptop", credential.Name)
+		assert.Equal(t, base64.RawURLEncoding.EncodeToString(passkey.id), credential.CredentialId)
+
+		credentials, appErr := th.App.GetWebAuthnCredentials(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		require.Len(t, credentials, 1)
+	})
+
+	t.Run("rejects a replayed registration", func(t *testing.T) {
+		options, appErr := th.App.StartWebAuthnRegistration(th.Context, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		require.Len(t, options.ExcludeCredentials, 1)
+
+		other := newTestPasskey(t)
+		response := other.register(t, options, origin)
+		_, appErr = register(th.BasicUser.Id, "Phone", response)
+		require.Nil(t, appErr)
+
+		_, appErr = register(th.BasicUser.Id, "Phone", response)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "api.user.webauthn.invalid_challenge.app_error", appErr.Id)
+	})
+
+	t.Run("requires the password of the user", func(t *testing.T) {
+		options, appErr := th.App.StartWebAuthnRegistration(th.Context, th.BasicUser.Id)
+		require.Nil(t, appErr)
+
+		_, appErr = th.App.FinishWebAuthnRegistration(th.Context, th.BasicUser.Id, &model.WebAuthnRegistrationRequest{
+			Name:       "Phone",
+			Credential: newTestPasskey(t).register(t, options, origin),
+			Password:   "wrong",
+		})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "api.user.check_user_password.invalid.app_error", appErr.Id)
+	})
+
+	t.Run("rejects a registration for another user", func(t *testing.T) {
+		options, appErr := th.App.StartWebAuthnRegistration(th.Context, th.BasicUser.Id)
+		require.Nil(t, appErr)
+
+		_, appErr = register(th.BasicUser2.Id, "Phone", newTestPasskey(t).register(t, options, origin))
+		require.NotNil(t, appErr)
+		assert.Equal(t, "api.user.webauthn.invalid_challenge.app_error", appErr.Id)
+	})
+
+	t.Run("rejects a registration from another origin", func(t *testing.T) {
+		options, appErr := th.App.StartWebAuthnRegistration(th.Context, th.BasicUser.Id)
+		require.Nil(t, appErr)
+
+		_, appErr = register(th.BasicUser.Id, "Phone", newTestPasskey(t).register(t, options, "https://evil.example.com"))
+		require.NotNil(t, appErr)
+		assert.Equal(t, "api.user.webauthn.registration_failed.app_error", appErr.Id)
+	})
+
+	t.Run("is a second factor", func(t *testing.T) {
+		options, appErr := th.App.StartWebAuthnLogin(th.Context, true)
+		require.Nil(t, appErr)
+		assert.Empty(t, options.AllowCredentials)
+
+		token, err := json.Marshal(passkey.assert(t, options, origin, th.BasicUser.Id))
+		require.NoError(t, err)
+
+		appErr = th.App.CheckUserMfa(th.Context, th.BasicUser, string(token))
+		require.Nil(t, appErr)
+
+		// The challenge can't be answered twice.
+		appErr = th.App.CheckUserMfa(th.Context, th.BasicUser, string(token))
+		require.NotNil(t, appErr)
+	})
+
+	t.Run("is not a second factor of another user", func(t *testing.T) {
+		options, appErr := th.App.StartWebAuthnLogin(th.Context, true)
+		require.Nil(t, appErr)
+
+		token, err := json.Marshal(passkey.assert(t, options, origin, th.BasicUser.Id))
+		require.NoError(t, err)
+
+		appErr = th.App.checkUserWebAuthn(th.Context, th.BasicUser2, string(token))
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusUnauthorized, appErr.StatusCode)
+	})
+
+	t.Run("logs in without a password", func(t *testing.T) {
+		options, appErr := th.App.StartWebAuthnLogin(th.Context, false)
+		require.Nil(t, appErr)
+		assert.Empty(t, options.AllowCredentials)
+		assert.Equal(t, model.WebAuthnUserVerificationRequired, options.UserVerification)
+
+		user, appErr := th.App.AuthenticateUserWithWebAuthn(th.Context, passkey.assert(t, options, origin, th.BasicUser.Id))
+		require.Nil(t, appErr)
+		assert.Equal(t, th.BasicUser.Id, user.Id)
+	})
+
+	t.Run("rejects a cloned authenticator", func(t *testing.T) {
+		options, appErr := th.App.StartWebAuthnLogin(th.Context, false)
+		require.Nil(t, appErr)
+
+		passkey.signCount -= 2
+		_, appErr = th.App.AuthenticateUserWithWebAuthn(th.Context, passkey.assert(t, options, origin, th.BasicUser.Id))
+		require.NotNil(t, appErr)
+		assert.Equal(t, "api.user.webauthn.authentication_failed.app_error", appErr.Id)
+		passkey.signCount += 10
+	})
+
+	t.Run("requires a passkey when enforced", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnforceWebAuthn = true })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnforceWebAuthn = false })
+
+		rctx := th.Context.WithSession(&model.Session{Id: model.NewId(), UserId: th.BasicUser.Id})
+		require.Nil(t, th.App.MFARequired(rctx))
+
+		rctx = th.Context.WithSession(&model.Session{Id: model.NewId(), UserId: th.BasicUser2.Id})
+		appErr := th.App.MFARequired(rctx)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "api.context.webauthn_required.app_error", appErr.Id)
+	})
+
+	t.Run("deletes credentials", func(t *testing.T) {
+		credentials, appErr := th.App.GetWebAuthnCredentials(th.BasicUser.Id)
+		require.Nil(t, appErr)
+
+		appErr = th.App.DeleteWebAuthnCredential(th.Context, th.BasicUser2.Id, credentials[0].Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+
+		for _, credential := range credentials {
+			require.Nil(t, th.App.DeleteWebAuthnCredential(th.Context, th.BasicUser.Id, credential.Id))
+		}
+
+		has, appErr := th.App.hasWebAuthnCredentials(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.False(t, has)
+	})
+}
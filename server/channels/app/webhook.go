@@ -4,8 +4,9 @@
 package app
 
 import (
-	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"io"
@@ -99,108 +100,101 @@ func (a *App) handleWebhookEvents(rctx request.CTX, post *model.Post, team *mode
 func (a *App) TriggerWebhook(rctx request.CTX, payload *model.OutgoingWebhookPayload, hook *model.OutgoingWebhook, post *model.Post, channel *model.Channel) {
 	logger := rctx.Logger().With(mlog.String("outgoing_webhook_id", hook.Id), mlog.String("post_id", post.Id), mlog.String("channel_id", channel.Id), mlog.String("content_type", hook.ContentType))
 
-	var jsonBytes []byte
-	var err error
+	var body string
 	contentType := "application/x-www-form-urlencoded"
 	if hook.ContentType == "application/json" {
 		contentType = "application/json"
-		jsonBytes, err = json.Marshal(payload)
+		jsonBytes, err := json.Marshal(payload)
 		if err != nil {
 			logger.Warn("Failed to encode to JSON", mlog.Err(err))
 			return
 		}
+		body = string(jsonBytes)
+	} else {
+		body = payload.ToFormValues()
 	}
 
 	var wg sync.WaitGroup
 
-	for i := range hook.CallbackURLs {
-		var body io.Reader
-		if hook.ContentType == "application/json" {
-			body = bytes.NewReader(jsonBytes)
-		} else {
-			body = strings.NewReader(payload.ToFormValues())
+	for _, url := range hook.CallbackURLs {
+		// The delivery is only retried once its first attempt had time to
+		// complete, so that no other server sends it meanwhile.
+		delivery := &model.OutgoingWebhookDelivery{
+			HookId:        hook.Id,
+			PostId:        post.Id,
+			ChannelId:     channel.Id,
+			URL:           url,
+			ContentType:   contentType,
+			Payload:       body,
+			NextAttemptAt: model.GetMillis() + a.outgoingWebhookDeliveryLease().Milliseconds(),
+		}
+		if _, err := a.Srv().Store().Webhook().SaveOutgoingDelivery(delivery); err != nil {
+			// Without a delivery to record its outcome in, the request is sent once,
+			// bypassing the retries and the delivery log.
+			logger.Error("Failed to save the outgoing webhook delivery, it will be sent once without being retried or logged", mlog.String("url", url), mlog.Err(err))
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				a.sendUnsavedOutgoingWebhookDelivery(rctx, hook, delivery, channel)
+			}()
+			continue
 		}
-		wg.Add(1)
-
-		// Get the callback URL by index to properly capture it for the go func
-		url := hook.CallbackURLs[i]
 
+		wg.Add(1)
 		go func() {
 			defer wg.Done()
+			a.deliverOutgoingWebhook(rctx, hook, delivery, channel)
+		}()
+	}
+	wg.Wait()
+}
 
-			var accessToken *model.OutgoingOAuthConnectionToken
-
-			// Retrieve an access token from a connection if one exists to use for the webhook request
-			if a.Config().ServiceSettings.EnableOutgoingOAuthConnections != nil && *a.Config().ServiceSettings.EnableOutgoingOAuthConnections && a.OutgoingOAuthConnections() != nil {
-				connection, err := a.OutgoingOAuthConnections().GetConnectionForAudience(rctx, url)
-				if err != nil {
-					logger.Error("Failed to find an outgoing oauth connection for the webhook", mlog.Err(err))
-					return
-				}
-
-				if connection != nil {
-					accessToken, err = a.OutgoingOAuthConnections().RetrieveTokenForConnection(rctx, connection)
-					if err != nil {
-						logger.Error("Failed to retrieve token for outgoing oauth connection", mlog.Err(err))
-						return
-					}
-				}
-			}
-
-			webhookResp, err := a.doOutgoingWebhookRequest(url, body, contentType, accessToken)
-			if err != nil {
-				if errors.Is(err, context.DeadlineExceeded) {
-					logger.Error("Outgoing Webhook POST timed out. Consider increasing ServiceSettings.OutgoingIntegrationRequestsTimeout.", mlog.Err(err))
-				} else {
-					logger.Error("Outgoing Webhook POST failed", mlog.Err(err))
-				}
-				return
-			}
+func (a *App) handleOutgoingWebhookResponse(rctx request.CTX, hook *model.OutgoingWebhook, channel *model.Channel, postID string, webhookResp *model.OutgoingWebhookResponse) {
+	if webhookResp == nil || (webhookResp.Text == nil && len(webhookResp.Attachments) == 0) {
+		return
+	}
 
-			if webhookResp != nil && (webhookResp.Text != nil || len(webhookResp.Attachments) > 0) {
-				postRootId := ""
-				if webhookResp.ResponseType == model.OutgoingHookResponseTypeComment {
-					postRootId = post.Id
-				}
-				if len(webhookResp.Props) == 0 {
-					webhookResp.Props = make(model.StringInterface)
-				}
-				webhookResp.Props[model.PostPropsWebhookDisplayName] = hook.DisplayName
+	postRootId := ""
+	if webhookResp.ResponseType == model.OutgoingHookResponseTypeComment {
+		postRootId = postID
+	}
+	if len(webhookResp.Props) == 0 {
+		webhookResp.Props = make(model.StringInterface)
+	}
+	webhookResp.Props[model.PostPropsWebhookDisplayName] = hook.DisplayName
 
-				text := ""
-				if webhookResp.Text != nil {
-					text = a.ProcessSlackText(*webhookResp.Text)
-				}
-				webhookResp.Attachments = a.ProcessSlackAttachments(webhookResp.Attachments)
-				// attachments is in here for slack compatibility
-				if len(webhookResp.Attachments) > 0 {
-					webhookResp.Props[model.PostPropsAttachments] = webhookResp.Attachments
-				}
-				if *a.Config().ServiceSettings.EnablePostUsernameOverride && hook.Username != "" && webhookResp.Username == "" {
-					webhookResp.Username = hook.Username
-				}
+	text := ""
+	if webhookResp.Text != nil {
+		text = a.ProcessSlackText(*webhookResp.Text)
+	}
+	webhookResp.Attachments = a.ProcessSlackAttachments(webhookResp.Attachments)
+	// attachments is in here for slack compatibility
+	if len(webhookResp.Attachments) > 0 {
+		webhookResp.Props[model.PostPropsAttachments] = webhookResp.Attachments
+	}
+	if *a.Config().ServiceSettings.EnablePostUsernameOverride && hook.Username != "" && webhookResp.Username == "" {
+		webhookResp.Username = hook.Username
+	}
 
-				if *a.Config().ServiceSettings.EnablePostIconOverride && hook.IconURL != "" && webhookResp.IconURL == "" {
-					webhookResp.IconURL = hook.IconURL
-				}
-				if _, err := a.CreateWebhookPost(rctx, hook.CreatorId, channel, text, webhookResp.Username, webhookResp.IconURL, "", webhookResp.Props, webhookResp.Type, postRootId, webhookResp.Priority); err != nil {
-					logger.Error("Failed to create response post.", mlog.Err(err))
-				}
-			}
-		}()
+	if *a.Config().ServiceSettings.EnablePostIconOverride && hook.IconURL != "" && webhookResp.IconURL == "" {
+		webhookResp.IconURL = hook.IconURL
+	}
+	if _, err := a.CreateWebhookPost(rctx, hook.CreatorId, channel, text, webhookResp.Username, webhookResp.IconURL, "", webhookResp.Props, webhookResp.Type, postRootId, webhookResp.Priority); err != nil {
+		rctx.Logger().Error("Failed to create response post.", mlog.String("outgoing_webhook_id", hook.Id), mlog.String("post_id", postID), mlog.Err(err))
 	}
-	wg.Wait()
 }
 
-func (a *App) doOutgoingWebhookRequest(url string, body io.Reader, contentType string, accessToken *model.OutgoingOAuthConnectionToken) (*model.OutgoingWebhookResponse, error) {
+func (a *App) doOutgoingWebhookRequest(url string, body io.Reader, contentType string, accessToken *model.OutgoingOAuthConnectionToken, header http.Header) (*model.OutgoingWebhookResponse, int, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(*a.Config().ServiceSettings.OutgoingIntegrationRequestsTimeout)*time.Second)
 	defer cancel()
 
 	req, err := http.NewRequestWithContext(ctx, "POST", url, body)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
+	maps.Copy(req.Header, header)
 	req.Header.Set("Content-Type", contentType)
 	req.Header.Set("Accept", "application/json")
 
@@ -210,20 +204,24 @@ func (a *App) doOutgoingWebhookRequest(url string, body io.Reader, contentType s
 
 	resp, err := a.Srv().outgoingWebhookClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	defer resp.Body.Close()
 
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, resp.StatusCode, model.NewAppError("doOutgoingWebhookRequest", "app.webhooks.outgoing_request.status_code.app_error", map[string]any{"StatusCode": resp.StatusCode}, "", http.StatusBadGateway)
+	}
+
 	var hookResp model.OutgoingWebhookResponse
 	if jsonErr := json.NewDecoder(io.LimitReader(resp.Body, MaxIntegrationResponseSize)).Decode(&hookResp); jsonErr != nil {
 		if jsonErr == io.EOF {
-			return nil, nil
+			return nil, resp.StatusCode, nil
 		}
-		return nil, model.NewAppError("doOutgoingWebhookRequest", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(jsonErr)
+		return nil, resp.StatusCode, model.NewAppError("doOutgoingWebhookRequest", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(jsonErr)
 	}
 
-	return &hookResp, nil
+	return &hookResp, resp.StatusCode, nil
 }
 
 func splitWebhookPost(post *model.Post, maxPostSize int) ([]*model.Post, *model.AppError) {
@@ -625,6 +623,7 @@ func (a *App) UpdateOutgoingWebhook(rctx request.CTX, oldHook, updatedHook *mode
 	updatedHook.CreateAt = oldHook.CreateAt
 	updatedHook.DeleteAt = oldHook.DeleteAt
 	updatedHook.TeamId = oldHook.TeamId
+	updatedHook.Secret = oldHook.Secret
 	updatedHook.UpdateAt = model.GetMillis()
 
 	webhook, err := a.Srv().Store().Webhook().UpdateOutgoing(updatedHook)
@@ -728,6 +727,21 @@ func (a *App) RegenOutgoingWebhookToken(hook *model.OutgoingWebhook) (*model.Out
 	return webhook, nil
 }
 
+func (a *App) RegenOutgoingWebhookSecret(hook *model.OutgoingWebhook) (*model.OutgoingWebhook, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableOutgoingWebhooks {
+		return nil, model.NewAppError("RegenOutgoingWebhookSecret", "api.outgoing_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	hook.Secret = model.NewRandomString(model.OutgoingWebhookSecretLength)
+
+	webhook, err := a.Srv().Store().Webhook().UpdateOutgoing(hook)
+	if err != nil {
+		return nil, model.NewAppError("RegenOutgoingWebhookSecret", "app.webhooks.update_outgoing.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return webhook, nil
+}
+
 func (a *App) HandleIncomingWebhook(rctx request.CTX, hookID string, req *model.IncomingWebhookRequest) *model.AppError {
 	if !*a.Config().ServiceSettings.EnableIncomingWebhooks {
 		return model.NewAppError("HandleIncomingWebhook", "web.incoming_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
@@ -861,8 +875,66 @@ func (a *App) HandleIncomingWebhook(rctx request.CTX, hookID string, req *model.
 		overrideIconURL = req.IconURL
 	}
 
-	_, err := a.CreateWebhookPost(rctx, hook.UserId, channel, text, overrideUsername, overrideIconURL, req.IconEmoji, req.Props, webhookType, "", req.Priority)
-	return err
+	var threadKey, rootID string
+	if req.ThreadKey != "" {
+		threadKey = incomingWebhookThreadKey(req.ThreadKey)
+		rootID = a.getIncomingWebhookThreadRoot(rctx, hook.Id, threadKey, channel.Id)
+	}
+
+	post, err := a.CreateWebhookPost(rctx, hook.UserId, channel, text, overrideUsername, overrideIconURL, req.IconEmoji, req.Props, webhookType, rootID, req.Priority)
+	if err != nil {
+		return err
+	}
+
+	if threadKey != "" && rootID == "" {
+		if nErr := a.Srv().Store().Webhook().SaveIncomingThread(hook.Id, threadKey, post.Id); nErr != nil {
+			rctx.Logger().Warn("Failed to save the thread of an incoming webhook", mlog.String("hook_id", hook.Id), mlog.String("post_id", post.Id), mlog.Err(nErr))
+		}
+	}
+
+	return nil
+}
+
+// DecodeIncomingWebhookRequest parses the JSON payload sent to an incoming
+// webhook with the adapter of the webhook, falling back to the
+// Slack-compatible format when the webhook can't be found.
+func (a *App) DecodeIncomingWebhookRequest(hookID string, data io.Reader) (*model.IncomingWebhookRequest, *model.AppError) {
+	hook, err := a.Srv().Store().Webhook().GetIncoming(hookID, true)
+	if err != nil || hook.Adapter == model.IncomingWebhookAdapterSlack {
+		return model.IncomingWebhookRequestFromJSON(data)
+	}
+
+	return model.IncomingWebhookRequestFromAdapter(hook.Adapter, data)
+}
+
+// incomingWebhookThreadKey hashes the thread key of an incoming webhook
+// request, as the keys built from alert groups have no length limit.
+func incomingWebhookThreadKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+// getIncomingWebhookThreadRoot returns the id of the post the posts of an
+// incoming webhook with the given thread key are replies to, or an empty
+// string when a new thread has to be started.
+func (a *App) getIncomingWebhookThreadRoot(rctx request.CTX, hookID, threadKey, channelID string) string {
+	postID, err := a.Srv().Store().Webhook().GetIncomingThread(hookID, threadKey)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			rctx.Logger().Warn("Failed to get the thread of an incoming webhook", mlog.String("hook_id", hookID), mlog.Err(err))
+		}
+		return ""
+	}
+
+	// The root post might have been deleted, or the request might target
+	// another channel.
+	post, err := a.Srv().Store().Post().GetSingle(rctx, postID, false)
+	if err != nil || post.ChannelId != channelID {
+		return ""
+	}
+
+	return post.Id
 }
 
 func (a *App) CreateCommandWebhook(commandID string, args *model.CommandArgs) (*model.CommandWebhook, *model.AppError) {
@@ -0,0 +1,299 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+const (
+	outgoingWebhookMaxAttempts           = 8
+	outgoingWebhookRetryInterval         = 30 * time.Second
+	outgoingWebhookMaxRetryInterval      = 6 * time.Hour
+	outgoingWebhookDeliveryBatchSize     = 100
+	outgoingWebhookDeliveryWorkers       = 10
+	outgoingWebhookDeliveryTaskInterval  = 30 * time.Second
+	outgoingWebhookDeliveryRetention     = 30 * 24 * time.Hour
+	outgoingWebhookDeliveryCleanupBatch  = 1000
+	outgoingWebhookDeliveryLeaseOverhead = time.Minute
+)
+
+// outgoingWebhookRetryDelay returns how long to wait before the next attempt
+// of a delivery that failed the given number of times.
+func outgoingWebhookRetryDelay(attempts int) time.Duration {
+	delay := outgoingWebhookRetryInterval
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= outgoingWebhookMaxRetryInterval {
+			return outgoingWebhookMaxRetryInterval
+		}
+	}
+	return delay
+}
+
+// outgoingWebhookDeliveryLease is how long a delivery being sent is hidden from
+// the retries.
+func (a *App) outgoingWebhookDeliveryLease() time.Duration {
+	return time.Duration(*a.Config().ServiceSettings.OutgoingIntegrationRequestsTimeout)*time.Second + outgoingWebhookDeliveryLeaseOverhead
+}
+
+// deliverOutgoingWebhook makes an attempt to send the delivery and records its
+// outcome, scheduling the next attempt if it failed. The response is only
+// posted on the first successful attempt, so that replaying a delivery or
+// retrying one whose outcome couldn't be recorded doesn't post it again.
+func (a *App) deliverOutgoingWebhook(rctx request.CTX, hook *model.OutgoingWebhook, delivery *model.OutgoingWebhookDelivery, channel *model.Channel) {
+	logger := rctx.Logger().With(
+		mlog.String("outgoing_webhook_id", hook.Id),
+		mlog.String("delivery_id", delivery.Id),
+		mlog.String("post_id", delivery.PostId),
+		mlog.String("channel_id", delivery.ChannelId),
+		mlog.Int("attempt", delivery.Attempts+1),
+	)
+
+	webhookResp, statusCode, err := a.sendOutgoingWebhookDelivery(rctx, hook, delivery)
+
+	delivery.Attempts++
+	delivery.LastAttemptAt = model.GetMillis()
+	delivery.ResponseCode = statusCode
+	firstDelivery := false
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			logger.Error("Outgoing Webhook POST timed out. Consider increasing ServiceSettings.OutgoingIntegrationRequestsTimeout.", mlog.Err(err))
+		} else {
+			logger.Error("Outgoing Webhook POST failed", mlog.Err(err))
+		}
+
+		delivery.Error = err.Error()
+		if delivery.Attempts >= outgoingWebhookMaxAttempts {
+			delivery.Status = model.OutgoingWebhookDeliveryStatusFailed
+		} else {
+			delivery.Status = model.OutgoingWebhookDeliveryStatusPending
+			delivery.NextAttemptAt = delivery.LastAttemptAt + outgoingWebhookRetryDelay(delivery.Attempts).Milliseconds()
+		}
+	} else {
+		delivery.Status = model.OutgoingWebhookDeliveryStatusDelivered
+		delivery.Error = ""
+		if delivery.DeliveredAt == 0 {
+			delivery.DeliveredAt = delivery.LastAttemptAt
+			firstDelivery = true
+		}
+	}
+
+	if _, storeErr := a.Srv().Store().Webhook().UpdateOutgoingDelivery(delivery); storeErr != nil {
+		logger.Error("Failed to update the outgoing webhook delivery", mlog.Err(storeErr))
+		return
+	}
+
+	if firstDelivery {
+		a.handleOutgoingWebhookResponse(rctx, hook, channel, delivery.PostId, webhookResp)
+	}
+}
+
+// sendUnsavedOutgoingWebhookDelivery makes a single attempt to send a delivery
+// which couldn't be saved, posting the response if it succeeds.
+func (a *App) sendUnsavedOutgoingWebhookDelivery(rctx request.CTX, hook *model.OutgoingWebhook, delivery *model.OutgoingWebhookDelivery, channel *model.Channel) {
+	webhookResp, _, err := a.sendOutgoingWebhookDelivery(rctx, hook, delivery)
+	if err != nil {
+		rctx.Logger().Error("Outgoing Webhook POST failed", mlog.String("outgoing_webhook_id", hook.Id), mlog.String("post_id", delivery.PostId), mlog.Err(err))
+		return
+	}
+
+	a.handleOutgoingWebhookResponse(rctx, hook, channel, delivery.PostId, webhookResp)
+}
+
+func (a *App) sendOutgoingWebhookDelivery(rctx request.CTX, hook *model.OutgoingWebhook, delivery *model.OutgoingWebhookDelivery) (*model.OutgoingWebhookResponse, int, error) {
+	var accessToken *model.OutgoingOAuthConnectionToken
+
+	// Retrieve an access token from a connection if one exists to use for the webhook request
+	if a.Config().ServiceSettings.EnableOutgoingOAuthConnections != nil && *a.Config().ServiceSettings.EnableOutgoingOAuthConnections && a.OutgoingOAuthConnections() != nil {
+		connection, err := a.OutgoingOAuthConnections().GetConnectionForAudience(rctx, delivery.URL)
+		if err != nil {
+			return nil, 0, model.NewAppError("sendOutgoingWebhookDelivery", "app.webhooks.outgoing_request.oauth_connection.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		if connection != nil {
+			accessToken, err = a.OutgoingOAuthConnections().RetrieveTokenForConnection(rctx, connection)
+			if err != nil {
+				return nil, 0, model.NewAppError("sendOutgoingWebhookDelivery", "app.webhooks.outgoing_request.oauth_token.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+		}
+	}
+
+	header := http.Header{}
+	header.Set(model.OutgoingWebhookDeliveryHeader, delivery.Id)
+	if hook.Secret != "" {
+		// Requests are signed when they're sent, so the retries of a delivery
+		// use the latest secret of the webhook.
+		header.Set(model.OutgoingWebhookSignatureHeader, model.SignOutgoingWebhookPayload(hook.Secret, time.Now().Unix(), []byte(delivery.Payload)))
+	}
+
+	return a.doOutgoingWebhookRequest(delivery.URL, strings.NewReader(delivery.Payload), delivery.ContentType, accessToken, header)
+}
+
+// ProcessOutgoingWebhookDeliveries retries the outgoing webhook deliveries
+// whose next attempt is due.
+func (a *App) ProcessOutgoingWebhookDeliveries(rctx request.CTX) {
+	if !*a.Config().ServiceSettings.EnableOutgoingWebhooks {
+		return
+	}
+
+	now := model.GetMillis()
+	deliveries, err := a.Srv().Store().Webhook().GetDueOutgoingDeliveries(now, outgoingWebhookDeliveryBatchSize)
+	if err != nil {
+		rctx.Logger().Error("Failed to get the outgoing webhook deliveries to retry", mlog.Err(err))
+		return
+	}
+
+	leaseUntil := now + a.outgoingWebhookDeliveryLease().Milliseconds()
+	workers := make(chan struct{}, outgoingWebhookDeliveryWorkers)
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		claimed, err := a.Srv().Store().Webhook().ClaimOutgoingDelivery(delivery.Id, delivery.NextAttemptAt, leaseUntil)
+		if err != nil {
+			rctx.Logger().Error("Failed to claim the outgoing webhook delivery", mlog.String("delivery_id", delivery.Id), mlog.Err(err))
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		delivery.NextAttemptAt = leaseUntil
+
+		wg.Add(1)
+		workers <- struct{}{}
+		go func() {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+			a.retryOutgoingWebhookDelivery(rctx, delivery)
+		}()
+	}
+	wg.Wait()
+}
+
+func (a *App) retryOutgoingWebhookDelivery(rctx request.CTX, delivery *model.OutgoingWebhookDelivery) {
+	logger := rctx.Logger().With(mlog.String("outgoing_webhook_id", delivery.HookId), mlog.String("delivery_id", delivery.Id))
+
+	hook, channel, err := a.getOutgoingWebhookDeliveryTarget(delivery)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			logger.Warn("Failed to get the outgoing webhook of the delivery, it will be retried later", mlog.Err(err))
+			return
+		}
+
+		// There is nothing left to deliver to.
+		delivery.Status = model.OutgoingWebhookDeliveryStatusFailed
+		delivery.Error = err.Error()
+		if _, err := a.Srv().Store().Webhook().UpdateOutgoingDelivery(delivery); err != nil {
+			logger.Error("Failed to update the outgoing webhook delivery", mlog.Err(err))
+		}
+		return
+	}
+
+	a.deliverOutgoingWebhook(rctx, hook, delivery, channel)
+}
+
+func (a *App) getOutgoingWebhookDeliveryTarget(delivery *model.OutgoingWebhookDelivery) (*model.OutgoingWebhook, *model.Channel, error) {
+	hook, err := a.Srv().Store().Webhook().GetOutgoing(delivery.HookId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	channel, err := a.Srv().Store().Channel().Get(delivery.ChannelId, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return hook, channel, nil
+}
+
+func (a *App) GetOutgoingWebhookDeliveries(hookID string, status string, page, perPage int) ([]*model.OutgoingWebhookDelivery, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableOutgoingWebhooks {
+		return nil, model.NewAppError("GetOutgoingWebhookDeliveries", "api.outgoing_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	deliveries, err := a.Srv().Store().Webhook().GetOutgoingDeliveriesByHook(hookID, status, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetOutgoingWebhookDeliveries", "app.webhooks.get_outgoing_deliveries.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return deliveries, nil
+}
+
+func (a *App) GetOutgoingWebhookDelivery(hookID, deliveryID string) (*model.OutgoingWebhookDelivery, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableOutgoingWebhooks {
+		return nil, model.NewAppError("GetOutgoingWebhookDelivery", "api.outgoing_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	delivery, err := a.Srv().Store().Webhook().GetOutgoingDelivery(deliveryID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetOutgoingWebhookDelivery", "app.webhooks.get_outgoing_delivery.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetOutgoingWebhookDelivery", "app.webhooks.get_outgoing_delivery.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	if delivery.HookId != hookID {
+		return nil, model.NewAppError("GetOutgoingWebhookDelivery", "app.webhooks.get_outgoing_delivery.app_error", nil, "", http.StatusNotFound)
+	}
+
+	return delivery, nil
+}
+
+// ReplayOutgoingWebhookDelivery sends a delivery of the outgoing webhook again
+// right away, whatever its status, and returns its outcome.
+func (a *App) ReplayOutgoingWebhookDelivery(rctx request.CTX, hook *model.OutgoingWebhook, deliveryID string) (*model.OutgoingWebhookDelivery, *model.AppError) {
+	delivery, appErr := a.GetOutgoingWebhookDelivery(hook.Id, deliveryID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	channel, err := a.Srv().Store().Channel().Get(delivery.ChannelId, true)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("ReplayOutgoingWebhookDelivery", "app.channel.get.existing.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("ReplayOutgoingWebhookDelivery", "app.channel.get.find.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	// Hide the delivery from the retries while it's being sent.
+	leaseUntil := model.GetMillis() + a.outgoingWebhookDeliveryLease().Milliseconds()
+	if delivery.Status == model.OutgoingWebhookDeliveryStatusPending {
+		claimed, err := a.Srv().Store().Webhook().ClaimOutgoingDelivery(delivery.Id, delivery.NextAttemptAt, leaseUntil)
+		if err != nil {
+			return nil, model.NewAppError("ReplayOutgoingWebhookDelivery", "app.webhooks.update_outgoing_delivery.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		if !claimed {
+			return nil, model.NewAppError("ReplayOutgoingWebhookDelivery", "app.webhooks.replay_outgoing_delivery.in_progress.app_error", nil, "", http.StatusConflict)
+		}
+	} else {
+		delivery.Status = model.OutgoingWebhookDeliveryStatusPending
+		delivery.NextAttemptAt = leaseUntil
+		if _, err := a.Srv().Store().Webhook().UpdateOutgoingDelivery(delivery); err != nil {
+			return nil, model.NewAppError("ReplayOutgoingWebhookDelivery", "app.webhooks.update_outgoing_delivery.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+	delivery.NextAttemptAt = leaseUntil
+
+	a.deliverOutgoingWebhook(rctx, hook, delivery, channel)
+
+	return delivery, nil
+}
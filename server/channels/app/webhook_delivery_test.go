@@ -0,0 +1,196 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestOutgoingWebhookRetryDelay(t *testing.T) {
+	assert.Equal(t, 30*time.Second, outgoingWebhookRetryDelay(1))
+	assert.Equal(t, time.Minute, outgoingWebhookRetryDelay(2))
+	assert.Equal(t, 2*time.Minute, outgoingWebhookRetryDelay(3))
+	assert.Equal(t, outgoingWebhookMaxRetryInterval, outgoingWebhookRetryDelay(20))
+}
+
+func TestOutgoingWebhookDeliveries(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableOutgoingWebhooks = true
+		*cfg.ServiceSettings.AllowedUntrustedInternalConnections = "localhost,127.0.0.1"
+	})
+
+	var failing atomic.Bool
+	received := make(chan *http.Request, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		r.Header.Set("X-Test-Body", string(body))
+		received <- r
+
+		if failing.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	hook, appErr := th.App.CreateOutgoingWebhook(&model.OutgoingWebhook{
+		ChannelId:    th.BasicChannel.Id,
+		TeamId:       th.BasicTeam.Id,
+		CallbackURLs: []string{server.URL},
+		CreatorId:    th.BasicUser.Id,
+		TriggerWords: []string{"Abracadabra"},
+		ContentType:  "application/json",
+	})
+	require.Nil(t, appErr)
+	require.NotEmpty(t, hook.Secret)
+
+	payload := &model.OutgoingWebhookPayload{
+		Token:     hook.Token,
+		TeamId:    hook.TeamId,
+		ChannelId: th.BasicChannel.Id,
+		PostId:    th.BasicPost.Id,
+		Text:      th.BasicPost.Message,
+	}
+
+	waitForDelivery := func(t *testing.T, status string) *model.OutgoingWebhookDelivery {
+		t.Helper()
+
+		var delivery *model.OutgoingWebhookDelivery
+		require.Eventually(t, func() bool {
+			deliveries, appErr := th.App.GetOutgoingWebhookDeliveries(hook.Id, status, 0, 10)
+			require.Nil(t, appErr)
+			if len(deliveries) == 0 {
+				return false
+			}
+			delivery = deliveries[0]
+			return true
+		}, 5*time.Second, 50*time.Millisecond)
+
+		return delivery
+	}
+
+	t.Run("signed delivery", func(t *testing.T) {
+		failing.Store(false)
+
+		th.App.TriggerWebhook(th.Context, payload, hook, th.BasicPost, th.BasicChannel)
+
+		var r *http.Request
+		select {
+		case r = <-received:
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "Timeout, webhook request not received")
+		}
+
+		delivery := waitForDelivery(t, model.OutgoingWebhookDeliveryStatusDelivered)
+		assert.Equal(t, 1, delivery.Attempts)
+		assert.Equal(t, http.StatusOK, delivery.ResponseCode)
+		assert.Equal(t, delivery.Id, r.Header.Get(model.OutgoingWebhookDeliveryHeader))
+
+		signature := r.Header.Get(model.OutgoingWebhookSignatureHeader)
+		require.NotEmpty(t, signature)
+		var timestamp int64
+		_, err := fmt.Sscanf(signature, "t=%d,", &timestamp)
+		require.NoError(t, err)
+		assert.Equal(t, model.SignOutgoingWebhookPayload(hook.Secret, timestamp, []byte(r.Header.Get("X-Test-Body"))), signature)
+	})
+
+	t.Run("failed delivery is scheduled for a retry and can be replayed", func(t *testing.T) {
+		failing.Store(true)
+
+		th.App.TriggerWebhook(th.Context, payload, hook, th.BasicPost, th.BasicChannel)
+		<-received
+
+		var delivery *model.OutgoingWebhookDelivery
+		require.Eventually(t, func() bool {
+			deliveries, appErr := th.App.GetOutgoingWebhookDeliveries(hook.Id, model.OutgoingWebhookDeliveryStatusPending, 0, 10)
+			require.Nil(t, appErr)
+			if len(deliveries) == 0 || deliveries[0].Attempts == 0 {
+				return false
+			}
+			delivery = deliveries[0]
+			return true
+		}, 5*time.Second, 50*time.Millisecond)
+
+		assert.Equal(t, 1, delivery.Attempts)
+		assert.Equal(t, http.StatusInternalServerError, delivery.ResponseCode)
+		assert.NotEmpty(t, delivery.Error)
+		assert.Greater(t, delivery.NextAttemptAt, delivery.LastAttemptAt)
+
+		failing.Store(false)
+		replayed, appErr := th.App.ReplayOutgoingWebhookDelivery(th.Context, hook, delivery.Id)
+		require.Nil(t, appErr)
+		<-received
+
+		assert.Equal(t, model.OutgoingWebhookDeliveryStatusDelivered, replayed.Status)
+		assert.Equal(t, 2, replayed.Attempts)
+	})
+
+	t.Run("delivery of another webhook", func(t *testing.T) {
+		delivery := waitForDelivery(t, model.OutgoingWebhookDeliveryStatusDelivered)
+
+		_, appErr := th.App.GetOutgoingWebhookDelivery(model.NewId(), delivery.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+	})
+
+	t.Run("response is only posted on the first successful delivery", func(t *testing.T) {
+		responseText := "pong " + model.NewId()
+		responder := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"text": %q}`, responseText)
+		}))
+		defer responder.Close()
+
+		respondingHook, appErr := th.App.CreateOutgoingWebhook(&model.OutgoingWebhook{
+			ChannelId:    th.BasicChannel.Id,
+			TeamId:       th.BasicTeam.Id,
+			CallbackURLs: []string{responder.URL},
+			CreatorId:    th.BasicUser.Id,
+			TriggerWords: []string{"Hocuspocus"},
+			ContentType:  "application/json",
+		})
+		require.Nil(t, appErr)
+
+		countResponses := func() int {
+			posts, appErr := th.App.GetPosts(th.BasicChannel.Id, 0, 100)
+			require.Nil(t, appErr)
+			count := 0
+			for _, post := range posts.Posts {
+				if post.Message == responseText {
+					count++
+				}
+			}
+			return count
+		}
+
+		th.App.TriggerWebhook(th.Context, payload, respondingHook, th.BasicPost, th.BasicChannel)
+		require.Equal(t, 1, countResponses())
+
+		deliveries, appErr := th.App.GetOutgoingWebhookDeliveries(respondingHook.Id, model.OutgoingWebhookDeliveryStatusDelivered, 0, 10)
+		require.Nil(t, appErr)
+		require.Len(t, deliveries, 1)
+		assert.NotZero(t, deliveries[0].DeliveredAt)
+
+		replayed, appErr := th.App.ReplayOutgoingWebhookDelivery(th.Context, respondingHook, deliveries[0].Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.OutgoingWebhookDeliveryStatusDelivered, replayed.Status)
+		assert.Equal(t, deliveries[0].DeliveredAt, replayed.DeliveredAt)
+		assert.Equal(t, 1, countResponses())
+	})
+}
@@ -590,6 +590,99 @@ func TestCreateWebhookPostLinks(t *testing.T) {
 	}
 }
 
+func TestHandleIncomingWebhookThreads(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableIncomingWebhooks = true })
+
+	hook, appErr := th.App.CreateIncomingWebhookForChannel(th.BasicUser.Id, th.BasicChannel, &model.IncomingWebhook{
+		ChannelId: th.BasicChannel.Id,
+		Adapter:   model.IncomingWebhookAdapterAlertmanager,
+	})
+	require.Nil(t, appErr)
+
+	lastPost := func(t *testing.T) *model.Post {
+		t.Helper()
+		posts, appErr := th.App.GetPosts(th.BasicChannel.Id, 0, 1)
+		require.Nil(t, appErr)
+		require.Len(t, posts.Order, 1)
+		return posts.Posts[posts.Order[0]]
+	}
+
+	handle := func(t *testing.T, threadKey string) *model.Post {
+		t.Helper()
+		appErr := th.App.HandleIncomingWebhook(th.Context, hook.Id, &model.IncomingWebhookRequest{
+			Attachments: []*model.SlackAttachment{{Title: "[FIRING:1] HighLatency"}},
+			ThreadKey:   threadKey,
+		})
+		require.Nil(t, appErr)
+		return lastPost(t)
+	}
+
+	root := handle(t, "alerts:{}:{alertname=\"HighLatency\"}")
+	assert.Empty(t, root.RootId)
+	assert.Equal(t, model.PostTypeSlackAttachment, root.Type)
+
+	t.Run("posts with the same thread key are collapsed in the thread", func(t *testing.T) {
+		reply := handle(t, "alerts:{}:{alertname=\"HighLatency\"}")
+		assert.Equal(t, root.Id, reply.RootId)
+	})
+
+	t.Run("posts with another thread key start a new thread", func(t *testing.T) {
+		post := handle(t, "alerts:{}:{alertname=\"DiskFull\"}")
+		assert.Empty(t, post.RootId)
+	})
+
+	t.Run("posts without a thread key aren't threaded", func(t *testing.T) {
+		post := handle(t, "")
+		assert.Empty(t, post.RootId)
+	})
+
+	t.Run("a new thread is started when the root post is deleted", func(t *testing.T) {
+		_, appErr := th.App.DeletePost(th.Context, root.Id, th.BasicUser.Id)
+		require.Nil(t, appErr)
+
+		post := handle(t, "alerts:{}:{alertname=\"HighLatency\"}")
+		assert.Empty(t, post.RootId)
+
+		reply := handle(t, "alerts:{}:{alertname=\"HighLatency\"}")
+		assert.Equal(t, post.Id, reply.RootId)
+	})
+}
+
+func TestDecodeIncomingWebhookRequest(t *testing.T) {
+	mainHelper.Parallel(t)
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableIncomingWebhooks = true })
+
+	slackHook, appErr := th.App.CreateIncomingWebhookForChannel(th.BasicUser.Id, th.BasicChannel, &model.IncomingWebhook{ChannelId: th.BasicChannel.Id})
+	require.Nil(t, appErr)
+
+	gitLabHook, appErr := th.App.CreateIncomingWebhookForChannel(th.BasicUser.Id, th.BasicChannel, &model.IncomingWebhook{
+		ChannelId: th.BasicChannel.Id,
+		Adapter:   model.IncomingWebhookAdapterGitLab,
+	})
+	require.Nil(t, appErr)
+
+	req, appErr := th.App.DecodeIncomingWebhookRequest(slackHook.Id, strings.NewReader(`{"text": "hello"}`))
+	require.Nil(t, appErr)
+	assert.Equal(t, "hello", req.Text)
+
+	req, appErr = th.App.DecodeIncomingWebhookRequest(model.NewId(), strings.NewReader(`{"text": "hello"}`))
+	require.Nil(t, appErr, "unknown webhooks should be reported when handling the request")
+	assert.Equal(t, "hello", req.Text)
+
+	req, appErr = th.App.DecodeIncomingWebhookRequest(gitLabHook.Id, strings.NewReader(`{"object_kind": "pipeline", "object_attributes": {"id": 3, "status": "success"}, "project": {"id": 7}}`))
+	require.Nil(t, appErr)
+	require.Len(t, req.Attachments, 1)
+	assert.Equal(t, "good", req.Attachments[0].Color)
+	assert.Equal(t, "gitlab:pipeline:7:3", req.ThreadKey)
+}
+
 func TestSplitWebhookPost(t *testing.T) {
 	mainHelper.Parallel(t)
 	type TestCase struct {
@@ -1064,7 +1157,7 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 		}))
 		defer server.Close()
 
-		resp, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil)
+		resp, _, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil, nil)
 		require.NoError(t, err)
 
 		require.NotNil(t, resp)
@@ -1079,7 +1172,7 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 		}))
 		defer server.Close()
 
-		_, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil)
+		_, _, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil, nil)
 		require.Error(t, err)
 		require.Equal(t, "api.unmarshal_error", err.(*model.AppError).Id)
 	})
@@ -1092,7 +1185,7 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 		}))
 		defer server.Close()
 
-		_, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil)
+		_, _, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil, nil)
 		require.Error(t, err)
 		require.Equal(t, "api.unmarshal_error", err.(*model.AppError).Id)
 	})
@@ -1105,7 +1198,7 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 		}))
 		defer server.Close()
 
-		_, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil)
+		_, _, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil, nil)
 		require.Error(t, err)
 		require.Equal(t, "api.unmarshal_error", err.(*model.AppError).Id)
 	})
@@ -1124,7 +1217,7 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 			cfg.ServiceSettings.OutgoingIntegrationRequestsTimeout = model.NewPointer(int64(1))
 		})
 
-		_, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil)
+		_, _, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil, nil)
 		require.Error(t, err)
 		require.IsType(t, &url.Error{}, err)
 	})
@@ -1142,7 +1235,7 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 			cfg.ServiceSettings.OutgoingIntegrationRequestsTimeout = model.NewPointer(int64(2))
 		})
 
-		resp, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil)
+		resp, _, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil, nil)
 		require.NoError(t, err)
 		require.NotNil(t, resp)
 		assert.NotNil(t, resp.Text)
@@ -1154,11 +1247,40 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 		}))
 		defer server.Close()
 
-		resp, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil)
+		resp, _, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil, nil)
 		require.NoError(t, err)
 		require.Nil(t, resp)
 	})
 
+	t.Run("with an error status code", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		resp, statusCode, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil, nil)
+		require.Error(t, err)
+		require.Nil(t, resp)
+		require.Equal(t, http.StatusServiceUnavailable, statusCode)
+		require.Equal(t, "app.webhooks.outgoing_request.status_code.app_error", err.(*model.AppError).Id)
+	})
+
+	t.Run("with extra headers", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, err := io.Copy(w, strings.NewReader(fmt.Sprintf(`{"text":"%s"}`, r.Header.Get(model.OutgoingWebhookDeliveryHeader))))
+			require.NoError(t, err)
+		}))
+		defer server.Close()
+
+		header := http.Header{}
+		header.Set(model.OutgoingWebhookDeliveryHeader, "delivery")
+
+		resp, statusCode, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil, header)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, "delivery", *resp.Text)
+	})
+
 	t.Run("with auth token", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			_, err := io.Copy(w, strings.NewReader(fmt.Sprintf(`{"text":"%s"}`, r.Header.Get("Authorization"))))
@@ -1166,10 +1288,10 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 		}))
 		defer server.Close()
 
-		resp, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", &model.OutgoingOAuthConnectionToken{
+		resp, _, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", &model.OutgoingOAuthConnectionToken{
 			AccessToken: "test",
 			TokenType:   "Bearer",
-		})
+		}, nil)
 		require.NoError(t, err)
 		require.Equal(t, `Bearer test`, *resp.Text)
 	})
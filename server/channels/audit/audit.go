@@ -21,6 +21,8 @@ type Audit struct {
 
 	// OnError is called when an error occurs while writing an audit record.
 	OnError func(err error)
+
+	sinks *sinkQueue
 }
 
 func (a *Audit) Init(maxQueueSize int) {
@@ -30,6 +32,15 @@ func (a *Audit) Init(maxQueueSize int) {
 		mlog.OnQueueFull(a.onQueueFull),
 		mlog.OnTargetQueueFull(a.onTargetQueueFull),
 	)
+	a.sinks = &sinkQueue{}
+}
+
+// SetSink sets a sink to persist the audit records to, in addition to the
+// targets. A nil sink stops persisting them.
+func (a *Audit) SetSink(sink Sink, maxQueueSize int) {
+	if a.sinks != nil {
+		a.sinks.set(sink, maxQueueSize, a.onLoggerError)
+	}
 }
 
 // LogRecord emits an audit record with complete info.
@@ -44,6 +55,10 @@ func (a *Audit) LogRecord(level mlog.Level, rec model.AuditRecord) {
 	}
 
 	a.logger.Log(level, "", flds...)
+
+	if a.sinks != nil {
+		a.sinks.add(rec, a.onSinkQueueFull)
+	}
 }
 
 // Configure sets zero or more target to output audit logs to.
@@ -62,6 +77,8 @@ func (a *Audit) Flush() error {
 
 // Shutdown cleanly stops the audit engine after making best efforts to flush all targets.
 func (a *Audit) Shutdown() error {
+	a.SetSink(nil, 0)
+
 	err := a.logger.Shutdown()
 	if err != nil {
 		a.onLoggerError(err)
@@ -85,6 +102,14 @@ func (a *Audit) onTargetQueueFull(target mlog.Target, rec *mlog.LogRec, maxQueue
 	return true
 }
 
+func (a *Audit) onSinkQueueFull(maxQueueSize int) bool {
+	if a.OnQueueFull != nil {
+		return a.OnQueueFull("sink", maxQueueSize)
+	}
+	mlog.Error("Audit sink queue full, dropping record.", mlog.Int("queueSize", maxQueueSize))
+	return true
+}
+
 func (a *Audit) onLoggerError(err error) {
 	if a.OnError != nil {
 		a.OnError(err)
@@ -5,11 +5,13 @@ package audit
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -100,3 +102,50 @@ func TestAudit_LogAuditRecord(t *testing.T) {
 		})
 	}
 }
+
+type testSink struct {
+	mux  sync.Mutex
+	recs []model.AuditRecord
+	err  error
+}
+
+func (s *testSink) Write(rec model.AuditRecord) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.recs = append(s.recs, rec)
+	return s.err
+}
+
+func TestAudit_SetSink(t *testing.T) {
+	t.Run("audit records are written to the sink", func(t *testing.T) {
+		audit := &Audit{}
+		audit.Init(DefMaxQueueSize)
+
+		sink := &testSink{}
+		audit.SetSink(sink, DefMaxQueueSize)
+		audit.LogRecord(mlog.LvlAuditAPI, model.AuditRecord{EventName: model.AuditEventUpdateConfig})
+		audit.LogRecord(mlog.LvlAuditAPI, model.AuditRecord{EventName: model.AuditEventPatchConfig})
+
+		// Removing the sink writes the queued audit records first.
+		audit.SetSink(nil, 0)
+		audit.LogRecord(mlog.LvlAuditAPI, model.AuditRecord{EventName: model.AuditEventUpdateConfig})
+		require.NoError(t, audit.Shutdown())
+
+		require.Len(t, sink.recs, 2)
+		require.Equal(t, model.AuditEventUpdateConfig, sink.recs[0].EventName)
+		require.Equal(t, model.AuditEventPatchConfig, sink.recs[1].EventName)
+	})
+
+	t.Run("errors of the sink are reported", func(t *testing.T) {
+		var errs []error
+		audit := &Audit{OnError: func(err error) { errs = append(errs, err) }}
+		audit.Init(DefMaxQueueSize)
+
+		audit.SetSink(&testSink{err: errors.New("store is down")}, DefMaxQueueSize)
+		audit.LogRecord(mlog.LvlAuditAPI, model.AuditRecord{EventName: model.AuditEventUpdateConfig})
+		require.NoError(t, audit.Shutdown())
+
+		require.Len(t, errs, 1)
+		require.EqualError(t, errs[0], "store is down")
+	})
+}
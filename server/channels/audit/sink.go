@@ -0,0 +1,74 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package audit
+
+import (
+	"sync"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// Sink persists audit records, in addition to the targets of the audit logger.
+type Sink interface {
+	Write(rec model.AuditRecord) error
+}
+
+// sinkQueue writes the audit records to a sink from a goroutine, so that
+// logging an audit record never waits for the sink unless its queue is full.
+type sinkQueue struct {
+	mux   sync.RWMutex
+	sink  Sink
+	queue chan model.AuditRecord
+	done  chan struct{}
+}
+
+// set replaces the sink, after writing the audit records queued for the
+// previous one. A nil sink stops writing the audit records.
+func (q *sinkQueue) set(sink Sink, maxQueueSize int, onError func(err error)) {
+	q.mux.Lock()
+	defer q.mux.Unlock()
+
+	if q.sink != nil {
+		close(q.queue)
+		<-q.done
+		q.sink = nil
+	}
+
+	if sink == nil {
+		return
+	}
+
+	q.sink = sink
+	q.queue = make(chan model.AuditRecord, maxQueueSize)
+	q.done = make(chan struct{})
+	go q.write(sink, q.queue, q.done, onError)
+}
+
+func (q *sinkQueue) write(sink Sink, queue chan model.AuditRecord, done chan struct{}, onError func(err error)) {
+	defer close(done)
+	for rec := range queue {
+		if err := sink.Write(rec); err != nil {
+			onError(err)
+		}
+	}
+}
+
+// add queues an audit record for the sink, if any. onQueueFull decides whether
+// the audit record is dropped or waits for room in the queue.
+func (q *sinkQueue) add(rec model.AuditRecord, onQueueFull func(maxQueueSize int) bool) {
+	q.mux.RLock()
+	defer q.mux.RUnlock()
+
+	if q.sink == nil {
+		return
+	}
+
+	select {
+	case q.queue <- rec:
+	default:
+		if !onQueueFull(cap(q.queue)) {
+			q.queue <- rec
+		}
+	}
+}
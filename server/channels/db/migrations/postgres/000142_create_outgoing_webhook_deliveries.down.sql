@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS OutgoingWebhookDeliveries;
+
+ALTER TABLE outgoingwebhooks DROP COLUMN IF EXISTS secret;
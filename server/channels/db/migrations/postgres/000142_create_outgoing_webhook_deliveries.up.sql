@@ -0,0 +1,23 @@
+ALTER TABLE outgoingwebhooks ADD COLUMN IF NOT EXISTS secret varchar(64) NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS OutgoingWebhookDeliveries (
+    Id varchar(26) PRIMARY KEY,
+    HookId varchar(26) NOT NULL,
+    PostId varchar(26) NOT NULL,
+    ChannelId varchar(26) NOT NULL,
+    URL text NOT NULL,
+    ContentType varchar(128) NOT NULL,
+    Payload text NOT NULL,
+    Status varchar(16) NOT NULL,
+    Attempts integer NOT NULL DEFAULT 0,
+    CreateAt bigint NOT NULL,
+    UpdateAt bigint NOT NULL,
+    LastAttemptAt bigint NOT NULL DEFAULT 0,
+    NextAttemptAt bigint NOT NULL DEFAULT 0,
+    DeliveredAt bigint NOT NULL DEFAULT 0,
+    ResponseCode integer NOT NULL DEFAULT 0,
+    Error text NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_outgoingwebhookdeliveries_hookid_createat ON OutgoingWebhookDeliveries (HookId, CreateAt);
+CREATE INDEX IF NOT EXISTS idx_outgoingwebhookdeliveries_status_nextattemptat ON OutgoingWebhookDeliveries (Status, NextAttemptAt);
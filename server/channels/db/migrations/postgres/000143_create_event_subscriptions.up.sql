@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS EventSubscriptions (
+    Id varchar(26) PRIMARY KEY,
+    CreateAt bigint NOT NULL,
+    UpdateAt bigint NOT NULL,
+    DeleteAt bigint NOT NULL DEFAULT 0,
+    CreatorId varchar(26) NOT NULL,
+    DisplayName varchar(64) NOT NULL DEFAULT '',
+    Description varchar(500) NOT NULL DEFAULT '',
+    URL varchar(1024) NOT NULL,
+    EventTypes text NOT NULL,
+    TeamId varchar(26) NOT NULL DEFAULT '',
+    ChannelId varchar(26) NOT NULL DEFAULT '',
+    Secret varchar(64) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_eventsubscriptions_deleteat ON EventSubscriptions (DeleteAt);
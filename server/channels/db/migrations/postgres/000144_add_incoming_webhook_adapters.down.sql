@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS IncomingWebhookThreads;
+
+ALTER TABLE incomingwebhooks DROP COLUMN IF EXISTS adapter;
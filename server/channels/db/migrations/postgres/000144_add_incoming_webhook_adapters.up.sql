@@ -0,0 +1,9 @@
+ALTER TABLE incomingwebhooks ADD COLUMN IF NOT EXISTS adapter varchar(32) NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS IncomingWebhookThreads (
+    HookId varchar(26) NOT NULL,
+    ThreadKey varchar(64) NOT NULL,
+    PostId varchar(26) NOT NULL,
+    CreateAt bigint NOT NULL,
+    PRIMARY KEY (HookId, ThreadKey)
+);
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS Reminders (
+    Id varchar(26) PRIMARY KEY,
+    CreateAt bigint NOT NULL,
+    UpdateAt bigint NOT NULL,
+    DeleteAt bigint NOT NULL DEFAULT 0,
+    CreatorId varchar(26) NOT NULL,
+    TargetType varchar(16) NOT NULL,
+    TargetId varchar(26) NOT NULL,
+    Message text NOT NULL,
+    TimeZone varchar(64) NOT NULL DEFAULT '',
+    Repeat varchar(64) NOT NULL DEFAULT '',
+    NextFireAt bigint NOT NULL DEFAULT 0,
+    LastFireAt bigint NOT NULL DEFAULT 0,
+    CompleteAt bigint NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_reminders_nextfireat ON Reminders (NextFireAt) WHERE DeleteAt = 0 AND CompleteAt = 0;
+CREATE INDEX IF NOT EXISTS idx_reminders_creatorid ON Reminders (CreatorId);
+CREATE INDEX IF NOT EXISTS idx_reminders_targetid ON Reminders (TargetId);
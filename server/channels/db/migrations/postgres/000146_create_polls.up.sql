@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS Polls (
+    Id varchar(26) PRIMARY KEY,
+    CreateAt bigint NOT NULL,
+    UpdateAt bigint NOT NULL,
+    DeleteAt bigint NOT NULL DEFAULT 0,
+    CreatorId varchar(26) NOT NULL,
+    ChannelId varchar(26) NOT NULL,
+    PostId varchar(26) NOT NULL DEFAULT '',
+    Question text NOT NULL,
+    Options jsonb NOT NULL,
+    Anonymous boolean NOT NULL DEFAULT false,
+    MultipleChoice boolean NOT NULL DEFAULT false,
+    CloseAt bigint NOT NULL DEFAULT 0,
+    ClosedAt bigint NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_polls_closeat ON Polls (CloseAt) WHERE CloseAt > 0 AND ClosedAt = 0 AND DeleteAt = 0;
+CREATE INDEX IF NOT EXISTS idx_polls_postid ON Polls (PostId);
+
+CREATE TABLE IF NOT EXISTS PollVotes (
+    PollId varchar(26) NOT NULL,
+    OptionId varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    CreateAt bigint NOT NULL,
+    PRIMARY KEY (PollId, OptionId, UserId)
+);
+
+CREATE INDEX IF NOT EXISTS idx_pollvotes_userid ON PollVotes (UserId);
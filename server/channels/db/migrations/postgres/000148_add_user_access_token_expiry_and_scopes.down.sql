@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_useraccesstokens_expiresat;
+
+ALTER TABLE UserAccessTokens DROP COLUMN IF EXISTS ExpiryNotified;
+ALTER TABLE UserAccessTokens DROP COLUMN IF EXISTS LastUsedIP;
+ALTER TABLE UserAccessTokens DROP COLUMN IF EXISTS LastUsedAt;
+ALTER TABLE UserAccessTokens DROP COLUMN IF EXISTS Scopes;
+ALTER TABLE UserAccessTokens DROP COLUMN IF EXISTS ExpiresAt;
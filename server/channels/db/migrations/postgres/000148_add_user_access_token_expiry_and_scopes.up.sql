@@ -0,0 +1,7 @@
+ALTER TABLE UserAccessTokens ADD COLUMN IF NOT EXISTS ExpiresAt bigint NOT NULL DEFAULT 0;
+ALTER TABLE UserAccessTokens ADD COLUMN IF NOT EXISTS Scopes varchar(4000);
+ALTER TABLE UserAccessTokens ADD COLUMN IF NOT EXISTS LastUsedAt bigint NOT NULL DEFAULT 0;
+ALTER TABLE UserAccessTokens ADD COLUMN IF NOT EXISTS LastUsedIP varchar(64) NOT NULL DEFAULT '';
+ALTER TABLE UserAccessTokens ADD COLUMN IF NOT EXISTS ExpiryNotified boolean NOT NULL DEFAULT false;
+
+CREATE INDEX IF NOT EXISTS idx_useraccesstokens_expiresat ON UserAccessTokens (ExpiresAt) WHERE ExpiresAt > 0;
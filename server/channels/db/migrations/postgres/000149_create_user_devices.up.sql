@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS UserDevices (
+    UserId varchar(26) NOT NULL,
+    Fingerprint varchar(64) NOT NULL,
+    Platform varchar(64) NOT NULL DEFAULT '',
+    Os varchar(64) NOT NULL DEFAULT '',
+    Browser varchar(64) NOT NULL DEFAULT '',
+    FirstSeenAt bigint NOT NULL,
+    LastSeenAt bigint NOT NULL,
+    LastIPAddress varchar(64) NOT NULL DEFAULT '',
+    PRIMARY KEY (UserId, Fingerprint)
+);
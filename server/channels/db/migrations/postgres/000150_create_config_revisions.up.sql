@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS ConfigRevisions (
+    Id varchar(26) PRIMARY KEY,
+    CreateAt bigint NOT NULL,
+    UserId varchar(26) NOT NULL DEFAULT '',
+    Value text NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_configrevisions_createat ON ConfigRevisions (CreateAt);
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS AuditLogs (
+    Id varchar(26) PRIMARY KEY,
+    Sequence bigint NOT NULL,
+    CreateAt bigint NOT NULL,
+    EventName varchar(128) NOT NULL,
+    Status varchar(16) NOT NULL DEFAULT '',
+    UserId varchar(128) NOT NULL DEFAULT '',
+    SessionId varchar(26) NOT NULL DEFAULT '',
+    IpAddress varchar(64) NOT NULL DEFAULT '',
+    ObjectType varchar(64) NOT NULL DEFAULT '',
+    ObjectIds jsonb NOT NULL DEFAULT '[]',
+    Record text NOT NULL,
+    PrevHash varchar(64) NOT NULL DEFAULT '',
+    Hash varchar(64) NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_auditlogs_sequence ON AuditLogs (Sequence);
+CREATE INDEX IF NOT EXISTS idx_auditlogs_createat ON AuditLogs (CreateAt);
+CREATE INDEX IF NOT EXISTS idx_auditlogs_userid_createat ON AuditLogs (UserId, CreateAt);
+CREATE INDEX IF NOT EXISTS idx_auditlogs_eventname_createat ON AuditLogs (EventName, CreateAt);
+CREATE INDEX IF NOT EXISTS idx_auditlogs_objectids ON AuditLogs USING gin (ObjectIds jsonb_path_ops);
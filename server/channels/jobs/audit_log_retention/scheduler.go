@@ -0,0 +1,23 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package audit_log_retention
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs"
+)
+
+const schedFreq = 24 * time.Hour
+
+// isEnabled doesn't depend on the store of the audit logs being enabled, so that
+// the audit logs kept from before it was disabled are deleted too.
+func isEnabled(cfg *model.Config) bool {
+	return *cfg.ExperimentalAuditSettings.StoreRetentionDays > 0
+}
+
+func MakeScheduler(jobServer *jobs.JobServer) *jobs.PeriodicScheduler {
+	return jobs.NewPeriodicScheduler(jobServer, model.JobTypeAuditLogRetention, schedFreq, isEnabled)
+}
@@ -0,0 +1,22 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package email_digest
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs"
+)
+
+// schedFreq is the granularity of the minutes the digests of the users are spread over.
+const schedFreq = 5 * time.Minute
+
+func isEnabled(cfg *model.Config) bool {
+	return *cfg.EmailSettings.SendEmailNotifications && *cfg.EmailSettings.EnableEmailDigests
+}
+
+func MakeScheduler(jobServer *jobs.JobServer) *jobs.PeriodicScheduler {
+	return jobs.NewPeriodicScheduler(jobServer, model.JobTypeEmailDigest, schedFreq, isEnabled)
+}
@@ -12,9 +12,16 @@ import (
 
 const schedFreq = 10 * time.Minute
 
+// userAccessTokensEnabled returns true if personal access tokens, possibly expiring, may be
+// used by the users or the bots.
+func userAccessTokensEnabled(cfg *model.Config) bool {
+	return *cfg.ServiceSettings.EnableUserAccessTokens || *cfg.ServiceSettings.EnableBotAccountCreation
+}
+
+func isEnabled(cfg *model.Config) bool {
+	return *cfg.ServiceSettings.ExtendSessionLengthWithActivity || userAccessTokensEnabled(cfg)
+}
+
 func MakeScheduler(jobServer *jobs.JobServer) *jobs.PeriodicScheduler {
-	isEnabled := func(cfg *model.Config) bool {
-		return *cfg.ServiceSettings.ExtendSessionLengthWithActivity
-	}
 	return jobs.NewPeriodicScheduler(jobServer, model.JobTypeExpiryNotify, schedFreq, isEnabled)
 }
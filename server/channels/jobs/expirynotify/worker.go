@@ -4,21 +4,27 @@
 package expirynotify
 
 import (
+	"errors"
+
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs"
 )
 
-func MakeWorker(jobServer *jobs.JobServer, notifySessionsExpired func() error) *jobs.SimpleWorker {
+func MakeWorker(jobServer *jobs.JobServer, notifySessionsExpired, notifyUserAccessTokensExpiring func() error) *jobs.SimpleWorker {
 	const workerName = "ExpiryNotify"
 
-	isEnabled := func(cfg *model.Config) bool {
-		return *cfg.ServiceSettings.ExtendSessionLengthWithActivity
-	}
 	execute := func(logger mlog.LoggerIFace, job *model.Job) error {
 		defer jobServer.HandleJobPanic(logger, job)
 
-		return notifySessionsExpired()
+		var errs []error
+		if *jobServer.Config().ServiceSettings.ExtendSessionLengthWithActivity {
+			errs = append(errs, notifySessionsExpired())
+		}
+		if userAccessTokensEnabled(jobServer.Config()) {
+			errs = append(errs, notifyUserAccessTokensExpiring())
+		}
+		return errors.Join(errs...)
 	}
 	return jobs.NewSimpleWorker(workerName, jobServer, execute, isEnabled)
 }
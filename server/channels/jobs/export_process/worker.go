@@ -7,6 +7,7 @@ import (
 	"context"
 	"io"
 	"path/filepath"
+	"strconv"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/configservice"
@@ -53,6 +54,14 @@ func MakeWorker(jobServer *jobs.JobServer, app AppIface) *jobs.SimpleWorker {
 			opts.IncludeRolesAndSchemes = true
 		}
 
+		if incremental, ok := job.Data["incremental"]; ok && incremental == "true" {
+			since, err := incrementalSince(jobServer, job)
+			if err != nil {
+				return err
+			}
+			opts.IncrementalSince = since
+		}
+
 		outPath := *app.Config().ExportSettings.Directory
 		exportFilename := job.Id + "_export.zip"
 
@@ -81,3 +90,22 @@ func MakeWorker(jobServer *jobs.JobServer, app AppIface) *jobs.SimpleWorker {
 	worker := jobs.NewSimpleWorker(workerName, jobServer, execute, isEnabled)
 	return worker
 }
+
+// incrementalSince returns the time an incremental export starts from: the one given with the
+// job or else the watermark of the last successful export. It returns zero, meaning a full
+// export, if there is neither.
+func incrementalSince(jobServer *jobs.JobServer, job *model.Job) (int64, error) {
+	if since, ok := job.Data["incremental_since"]; ok && since != "" {
+		return strconv.ParseInt(since, 10, 64)
+	}
+
+	lastJob, appErr := jobServer.GetLastSuccessfulJobByType(model.JobTypeExportProcess)
+	if appErr != nil {
+		return 0, appErr
+	}
+	if lastJob == nil || lastJob.Data["export_watermark"] == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(lastJob.Data["export_watermark"], 10, 64)
+}
@@ -5,6 +5,7 @@ package extract_content
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
@@ -48,6 +49,17 @@ func MakeWorker(jobServer *jobs.JobServer, app AppIface, store store.Store) *job
 			}
 			toTS *= 1000
 		}
+		// An optional list of extensions restricts the job to those file
+		// types, e.g. to backfill formats that became extractable.
+		var extensions map[string]bool
+		if extensionsStr := job.Data["extensions"]; extensionsStr != "" {
+			extensions = make(map[string]bool)
+			for ext := range strings.SplitSeq(extensionsStr, ",") {
+				if ext = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(ext), ".")); ext != "" {
+					extensions[ext] = true
+				}
+			}
+		}
 
 		var nFiles int
 		var nErrs int
@@ -65,6 +77,9 @@ func MakeWorker(jobServer *jobs.JobServer, app AppIface, store store.Store) *job
 				break
 			}
 			for _, fileInfo := range fileInfos {
+				if extensions != nil && !extensions[strings.ToLower(fileInfo.Extension)] {
+					continue
+				}
 				if !ignoredFiles[fileInfo.Extension] {
 					logger.Debug("Extracting file", mlog.String("filename", fileInfo.Name), mlog.String("filepath", fileInfo.Path))
 
@@ -0,0 +1,68 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package import_process
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs"
+)
+
+const orphanedJobsCheckInterval = 5 * time.Minute
+
+// Scheduler doesn't create import jobs, which are only created on demand. It moves
+// the import jobs left in progress by a server which stopped back to pending, for
+// another worker to resume them from their checkpoint.
+type Scheduler struct {
+	jobServer *jobs.JobServer
+}
+
+var _ jobs.Scheduler = (*Scheduler)(nil)
+
+func MakeScheduler(jobServer *jobs.JobServer) *Scheduler {
+	return &Scheduler{jobServer}
+}
+
+func (scheduler *Scheduler) Enabled(_ *model.Config) bool {
+	return true
+}
+
+//nolint:unparam
+func (scheduler *Scheduler) NextScheduleTime(_ *model.Config, now time.Time, _ bool, _ *model.Job) *time.Time {
+	nextTime := now.Add(orphanedJobsCheckInterval)
+	return &nextTime
+}
+
+//nolint:unparam
+func (scheduler *Scheduler) ScheduleJob(rctx request.CTX, _ *model.Config, _ bool, _ *model.Job) (*model.Job, *model.AppError) {
+	inProgressJobs, appErr := scheduler.jobServer.GetJobsByTypeAndStatus(rctx, model.JobTypeImportProcess, model.JobStatusInProgress)
+	if appErr != nil {
+		rctx.Logger().Error("Failed to get the import jobs in progress", mlog.Err(appErr))
+		return nil, nil
+	}
+
+	staleBefore := model.GetMillis() - model.ImportProcessJobStaleTimeoutMilliseconds
+	for _, job := range inProgressJobs {
+		if job.LastActivityAt >= staleBefore {
+			continue
+		}
+
+		logger := rctx.Logger().With(jobs.JobLoggerFields(job)...)
+
+		// The job is only moved if it's still in progress, without activity since.
+		resumed, err := scheduler.jobServer.Store.Job().UpdateStatusOptimistically(job.Id, model.JobStatusInProgress, model.JobStatusPending)
+		if err != nil {
+			logger.Error("Failed to resume the orphaned import job", mlog.Err(err))
+			continue
+		}
+		if resumed != nil {
+			logger.Warn("Import job appears to be orphaned. Resuming it from its checkpoint.", mlog.Millis("last_activity_at", job.LastActivityAt), mlog.String("checkpoint_line", job.Data["checkpoint_line"]))
+		}
+	}
+
+	return nil, nil
+}
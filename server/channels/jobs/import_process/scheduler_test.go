@@ -0,0 +1,51 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package import_process
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs"
+	"github.com/mattermost/mattermost/server/v8/channels/store/storetest"
+	"github.com/mattermost/mattermost/server/v8/channels/utils/testutils"
+)
+
+func TestSchedulerResumesOrphanedJobs(t *testing.T) {
+	mockStore := &storetest.Store{}
+	t.Cleanup(func() {
+		mockStore.AssertExpectations(t)
+	})
+
+	logger := mlog.CreateConsoleTestLogger(t)
+	jobServer := jobs.NewJobServer(&testutils.StaticConfigService{}, mockStore, nil, logger)
+	rctx := request.EmptyContext(logger)
+
+	running := &model.Job{
+		Id:             model.NewId(),
+		Type:           model.JobTypeImportProcess,
+		Status:         model.JobStatusInProgress,
+		LastActivityAt: model.GetMillis(),
+	}
+	orphaned := &model.Job{
+		Id:             model.NewId(),
+		Type:           model.JobTypeImportProcess,
+		Status:         model.JobStatusInProgress,
+		LastActivityAt: model.GetMillis() - model.ImportProcessJobStaleTimeoutMilliseconds - 1000,
+	}
+
+	mockStore.JobStore.On("GetAllByTypeAndStatus", mock.Anything, model.JobTypeImportProcess, model.JobStatusInProgress).
+		Return([]*model.Job{running, orphaned}, nil).Once()
+	mockStore.JobStore.On("UpdateStatusOptimistically", orphaned.Id, model.JobStatusInProgress, model.JobStatusPending).
+		Return(&model.Job{Id: orphaned.Id, Status: model.JobStatusPending}, nil).Once()
+
+	job, appErr := MakeScheduler(jobServer).ScheduleJob(rctx, nil, false, nil)
+	require.Nil(t, appErr)
+	require.Nil(t, job, "no import job should be created")
+}
@@ -5,6 +5,8 @@ package import_process
 
 import (
 	"archive/zip"
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -12,24 +14,55 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/configservice"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/app/imports"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs"
+	"github.com/mattermost/mattermost/server/v8/platform/services/importconverter"
+	"github.com/mattermost/mattermost/server/v8/platform/services/rocketchatimport"
+	"github.com/mattermost/mattermost/server/v8/platform/services/slackimport"
+	"github.com/mattermost/mattermost/server/v8/platform/services/teamsimport"
 	"github.com/mattermost/mattermost/server/v8/platform/shared/filestore"
 )
 
+// importSources maps the job sources to the converters of their exports,
+// which are converted to the bulk import format before being processed.
+var importSources = map[string]importconverter.ConvertFunc{
+	"slack":      slackimport.ConvertToBulkImport,
+	"teams":      teamsimport.ConvertToBulkImport,
+	"rocketchat": rocketchatimport.ConvertToBulkImport,
+}
+
+const (
+	// importCheckpointInterval is the number of lines imported between two
+	// checkpoints of the job.
+	importCheckpointInterval = 10000
+
+	// importPhaseValidation is the phase of the dry run validating the whole
+	// import file before importing it. The other phases are named after the
+	// types of lines they import.
+	importPhaseValidation = "validation"
+
+	// importActivityInterval is how often the activity of a running import job is
+	// refreshed, for the job not to be deemed orphaned and resumed elsewhere.
+	importActivityInterval = time.Minute
+)
+
 type AppIface interface {
 	configservice.ConfigService
 	RemoveFile(path string) *model.AppError
 	FileExists(path string) (bool, *model.AppError)
 	FileSize(path string) (int64, *model.AppError)
 	FileReader(path string) (filestore.ReadCloseSeeker, *model.AppError)
-	BulkImportWithPath(rctx request.CTX, jsonlReader io.Reader, attachmentsReader *zip.Reader, dryRun, extractContent bool, workers int, importPath string) (int, *model.AppError)
+	WriteFile(fr io.Reader, path string) (int64, *model.AppError)
+	BulkImportWithOpts(rctx request.CTX, jsonlReader io.Reader, attachmentsReader *zip.Reader, opts imports.BulkImportOpts) (int, *model.AppError)
 	Log() *mlog.Logger
 }
 
@@ -43,6 +76,9 @@ func MakeWorker(jobServer *jobs.JobServer, app AppIface) *jobs.SimpleWorker {
 	execute := func(logger mlog.LoggerIFace, job *model.Job) error {
 		defer jobServer.HandleJobPanic(logger, job)
 
+		stopActivity := keepImportJobActive(logger, jobServer, job)
+		defer stopActivity()
+
 		importFileName, ok := job.Data["import_file"]
 		if !ok {
 			return model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.missing_file", nil, "", http.StatusBadRequest)
@@ -94,41 +130,67 @@ func MakeWorker(jobServer *jobs.JobServer, app AppIface) *jobs.SimpleWorker {
 			}
 		}
 
-		importZipReader, err := zip.NewReader(importFile.(io.ReaderAt), importFileSize)
-		if err != nil {
-			return model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.open_file", nil, "", http.StatusInternalServerError).Wrap(err)
+		source := job.Data["source"]
+		archive := &importArchive{file: importFile, importPath: model.ExportDataDir}
+		if source == "" && strings.EqualFold(filepath.Ext(importFileName), ".jsonl") {
+			// Attachments of a plain JSONL file are read from the filesystem,
+			// next to the file in local mode.
+			if job.Data["local_mode"] == "true" {
+				archive.importPath = filepath.Dir(importFileName)
+			}
+		} else {
+			var err error
+			archive.zipReader, err = zip.NewReader(importFile.(io.ReaderAt), importFileSize)
+			if err != nil {
+				return model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.open_file", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
 		}
 
-		// find JSONL import file.
-		var jsonFile io.ReadCloser
-		for _, f := range importZipReader.File {
-			if filepath.Ext(f.Name) != ".jsonl" {
-				continue
-			}
-			// avoid "zip slip"
-			if strings.Contains(f.Name, "..") {
-				return model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.open_file", nil, "jsonFilePath contains path traversal", http.StatusForbidden)
+		if source != "" {
+			convert, ok := importSources[source]
+			if !ok {
+				return model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.unsupported_source", map[string]any{"Source": source}, "", http.StatusBadRequest)
 			}
 
-			jsonFile, err = f.Open()
+			var cleanup func()
+			var err error
+			archive.zipReader, cleanup, err = convertImport(appContext, app, job, convert, importFileName, archive.zipReader)
 			if err != nil {
-				return model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.open_file", nil, "", http.StatusInternalServerError).Wrap(err)
+				return err
 			}
+			defer cleanup()
 
-			defer jsonFile.Close()
-			break
+			if appErr := jobServer.UpdateInProgressJobData(job); appErr != nil {
+				logger.Warn("Failed to store the conversion report in the job data", mlog.Err(appErr))
+			}
 		}
 
-		if jsonFile == nil {
-			return model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.missing_jsonl", nil, "jsonFile was nil", http.StatusBadRequest)
+		extractContent := job.Data["extract_content"] == "true"
+		dryRun := job.Data["dry_run"] == "true"
+
+		saveJobData := func() {
+			if appErr := jobServer.UpdateInProgressJobData(job); appErr != nil {
+				logger.Warn("Failed to store the import checkpoint in the job data", mlog.Err(appErr))
+			}
+		}
+
+		// The import file is validated as a whole first, so that every
+		// invalid line is listed in the error report and an invalid file
+		// doesn't leave a partial import behind.
+		if !hasCompletedPhase(job, importPhaseValidation) {
+			if err := validateImport(appContext, app, job, importFileName, archive, extractContent); err != nil {
+				return err
+			}
+			if dryRun {
+				return nil
+			}
+			addCompletedPhase(job, importPhaseValidation)
+			saveJobData()
 		}
 
-		extractContent := job.Data["extract_content"] == "true"
 		// do the actual import.
-		lineNumber, appErr := app.BulkImportWithPath(appContext, jsonFile, importZipReader, false, extractContent, runtime.NumCPU(), model.ExportDataDir)
-		if appErr != nil {
-			job.Data["line_number"] = strconv.Itoa(lineNumber)
-			return appErr
+		if err := runImport(appContext, app, job, archive, extractContent, saveJobData); err != nil {
+			return err
 		}
 
 		// No need to remove the file in local mode.
@@ -143,3 +205,223 @@ func MakeWorker(jobServer *jobs.JobServer, app AppIface) *jobs.SimpleWorker {
 	worker := jobs.NewSimpleWorker(workerName, jobServer, execute, isEnabled)
 	return worker
 }
+
+// keepImportJobActive refreshes the activity of the job until the returned function
+// is called, so that a job making no checkpoint for a while isn't taken as orphaned.
+func keepImportJobActive(logger mlog.LoggerIFace, jobServer *jobs.JobServer, job *model.Job) func() {
+	stop := make(chan struct{})
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(importActivityInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if appErr := jobServer.UpdateInProgressJobActivity(job); appErr != nil {
+					logger.Warn("Failed to refresh the activity of the import job", mlog.Err(appErr))
+				}
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		close(stop)
+		<-done
+	}
+}
+
+// importArchive is the file being imported: either a zip archive holding the
+// JSONL file and its attachments, or a plain JSONL file whose attachments are
+// read from the filesystem.
+type importArchive struct {
+	file       filestore.ReadCloseSeeker
+	zipReader  *zip.Reader
+	importPath string
+}
+
+// openJSONL opens the JSONL file of the archive from its start.
+func (a *importArchive) openJSONL() (io.ReadCloser, error) {
+	if a.zipReader != nil {
+		return openJSONL(a.zipReader)
+	}
+	if _, err := a.file.Seek(0, io.SeekStart); err != nil {
+		return nil, model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.open_file", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return io.NopCloser(a.file), nil
+}
+
+// runImport runs the bulk import of the JSONL file of the archive, resuming
+// from the checkpoint of a previous run of the job, if any.
+func runImport(rctx request.CTX, app AppIface, job *model.Job, archive *importArchive, extractContent bool, saveJobData func()) error {
+	jsonFile, err := archive.openJSONL()
+	if err != nil {
+		return err
+	}
+	defer jsonFile.Close()
+
+	startLine, _ := strconv.Atoi(job.Data["checkpoint_line"])
+	if startLine > 0 {
+		rctx.Logger().Info("Resuming import from checkpoint", mlog.Int("checkpoint_line", startLine), mlog.String("completed_phases", job.Data["completed_phases"]))
+	}
+
+	lineNumber, appErr := app.BulkImportWithOpts(rctx, jsonFile, archive.zipReader, imports.BulkImportOpts{
+		ExtractContent:     extractContent,
+		Workers:            runtime.NumCPU(),
+		ImportPath:         archive.importPath,
+		StartLine:          startLine,
+		CheckpointInterval: importCheckpointInterval,
+		Checkpoint: func(line int, completedPhase string) {
+			job.Data["checkpoint_line"] = strconv.Itoa(line)
+			if completedPhase != "" {
+				addCompletedPhase(job, completedPhase)
+			}
+			saveJobData()
+		},
+	})
+	if appErr != nil {
+		job.Data["line_number"] = strconv.Itoa(lineNumber)
+		return appErr
+	}
+	return nil
+}
+
+// validateImport runs the bulk import of the JSONL file of the archive as a
+// dry run. The lines that fail validation are listed in an error report
+// stored next to the import file.
+func validateImport(rctx request.CTX, app AppIface, job *model.Job, importFileName string, archive *importArchive, extractContent bool) error {
+	jsonFile, err := archive.openJSONL()
+	if err != nil {
+		return err
+	}
+	defer jsonFile.Close()
+
+	errorReport, err := os.CreateTemp("", "import_process_errors_*.jsonl")
+	if err != nil {
+		return model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.error_report", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	defer func() {
+		errorReport.Close()
+		if err := os.Remove(errorReport.Name()); err != nil {
+			rctx.Logger().Warn("Failed to remove the import error report", mlog.String("path", errorReport.Name()), mlog.Err(err))
+		}
+	}()
+
+	lineNumber, appErr := app.BulkImportWithOpts(rctx, jsonFile, archive.zipReader, imports.BulkImportOpts{
+		DryRun:         true,
+		ExtractContent: extractContent,
+		Workers:        runtime.NumCPU(),
+		ImportPath:     archive.importPath,
+		ErrorReport:    errorReport,
+	})
+	if appErr == nil {
+		return nil
+	}
+	job.Data["line_number"] = strconv.Itoa(lineNumber)
+
+	if info, err := errorReport.Stat(); err != nil || info.Size() == 0 {
+		return appErr
+	}
+	if _, err := errorReport.Seek(0, io.SeekStart); err != nil {
+		return model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.error_report", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	reportFileName := imports.ErrorReportFileName(importFileName)
+	if _, writeErr := app.WriteFile(errorReport, filepath.Join(*app.Config().ImportSettings.Directory, reportFileName)); writeErr != nil {
+		rctx.Logger().Warn("Failed to store the import error report", mlog.Err(writeErr))
+		return appErr
+	}
+	job.Data["error_report_file"] = reportFileName
+
+	return appErr
+}
+
+func hasCompletedPhase(job *model.Job, phase string) bool {
+	return slices.Contains(strings.Split(job.Data["completed_phases"], ","), phase)
+}
+
+func addCompletedPhase(job *model.Job, phase string) {
+	if hasCompletedPhase(job, phase) {
+		return
+	}
+	if job.Data["completed_phases"] == "" {
+		job.Data["completed_phases"] = phase
+		return
+	}
+	job.Data["completed_phases"] += "," + phase
+}
+
+// openJSONL opens the JSONL import file of the archive.
+func openJSONL(importZipReader *zip.Reader) (io.ReadCloser, error) {
+	for _, f := range importZipReader.File {
+		if filepath.Ext(f.Name) != ".jsonl" {
+			continue
+		}
+		// avoid "zip slip"
+		if strings.Contains(f.Name, "..") {
+			return nil, model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.open_file", nil, "jsonFilePath contains path traversal", http.StatusForbidden)
+		}
+
+		jsonFile, err := f.Open()
+		if err != nil {
+			return nil, model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.open_file", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		return jsonFile, nil
+	}
+
+	return nil, model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.missing_jsonl", nil, "jsonFile was nil", http.StatusBadRequest)
+}
+
+// convertImport converts an export of another product to a bulk import
+// archive held in a temporary file, and stores the report of the items that
+// couldn't be converted next to the import file.
+func convertImport(rctx request.CTX, app AppIface, job *model.Job, convert importconverter.ConvertFunc, importFileName string, exportZipReader *zip.Reader) (*zip.Reader, func(), error) {
+	tmpFile, err := os.CreateTemp("", "import_process_*.zip")
+	if err != nil {
+		return nil, nil, model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.convert", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	cleanup := func() {
+		tmpFile.Close()
+		if err := os.Remove(tmpFile.Name()); err != nil {
+			rctx.Logger().Warn("Failed to remove the converted import file", mlog.String("path", tmpFile.Name()), mlog.Err(err))
+		}
+	}
+
+	report, appErr := convert(rctx, exportZipReader, importconverter.Options{
+		Team:        job.Data["team"],
+		MaxFileSize: *app.Config().FileSettings.MaxFileSize,
+	}, tmpFile)
+	if appErr != nil {
+		cleanup()
+		return nil, nil, appErr
+	}
+
+	info, err := tmpFile.Stat()
+	if err != nil {
+		cleanup()
+		return nil, nil, model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.convert", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	zipReader, err := zip.NewReader(tmpFile, info.Size())
+	if err != nil {
+		cleanup()
+		return nil, nil, model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.convert", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	reportJSON, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		cleanup()
+		return nil, nil, model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.convert", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	reportFileName := strings.TrimSuffix(filepath.Base(importFileName), filepath.Ext(importFileName)) + "_report.json"
+	if _, appErr := app.WriteFile(bytes.NewReader(reportJSON), filepath.Join(*app.Config().ImportSettings.Directory, reportFileName)); appErr != nil {
+		cleanup()
+		return nil, nil, appErr
+	}
+	job.Data["report_file"] = reportFileName
+	job.Data["skipped_items"] = strconv.Itoa(len(report.Skipped))
+
+	return zipReader, cleanup, nil
+}
@@ -217,6 +217,15 @@ func (srv *JobServer) UpdateInProgressJobData(job *model.Job) *model.AppError {
 	return nil
 }
 
+// UpdateInProgressJobActivity refreshes the last activity of the job in progress,
+// without updating its data.
+func (srv *JobServer) UpdateInProgressJobActivity(job *model.Job) *model.AppError {
+	if _, err := srv.Store.Job().UpdateLastActivityOptimistically(job.Id, model.JobStatusInProgress); err != nil {
+		return model.NewAppError("UpdateInProgressJobActivity", "app.job.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return nil
+}
+
 // HandleJobPanic is used to handle panics during the execution of a job. It logs the panic and sets the status for the job.
 // After handling, the method repanics! This method is supposed to be `defer`'d at the start of the job.
 func (srv *JobServer) HandleJobPanic(logger mlog.LoggerIFace, job *model.Job) {
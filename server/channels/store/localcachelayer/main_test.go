@@ -71,6 +71,10 @@ func getMockStore(t *testing.T) *mocks.Store {
 	mockWebhookStore := mocks.WebhookStore{}
 	mockWebhookStore.On("GetIncoming", "123", true).Return(&fakeWebhook, nil)
 	mockWebhookStore.On("GetIncoming", "123", false).Return(&fakeWebhook, nil)
+	fakeEventSubscriptions := []*model.EventSubscription{{Id: "123", EventTypes: model.StringArray{model.EventTypeUserHasJoinedChannel}}}
+	mockWebhookStore.On("GetEventSubscriptionsForEvent", model.EventTypeUserHasJoinedChannel, true).Return(fakeEventSubscriptions, nil)
+	mockWebhookStore.On("GetEventSubscriptionsForEvent", model.EventTypeUserHasJoinedChannel, false).Return(fakeEventSubscriptions, nil)
+	mockWebhookStore.On("DeleteEventSubscription", "123", mock.AnythingOfType("int64")).Return(nil)
 	mockStore.On("Webhook").Return(&mockWebhookStore)
 
 	fakeEmoji := model.Emoji{Id: "123", Name: "name123"}
@@ -87,3 +87,59 @@ func (s LocalCacheWebhookStore) PermanentDeleteIncomingByChannel(channelId strin
 	s.ClearCaches()
 	return nil
 }
+
+func eventSubscriptionsCacheKey(eventType string) string {
+	return "event_subscriptions:" + eventType
+}
+
+func (s LocalCacheWebhookStore) GetEventSubscriptionsForEvent(eventType string, allowFromCache bool) ([]*model.EventSubscription, error) {
+	if !allowFromCache {
+		return s.WebhookStore.GetEventSubscriptionsForEvent(eventType, allowFromCache)
+	}
+
+	var subscriptions []*model.EventSubscription
+	if err := s.rootStore.doStandardReadCache(s.rootStore.webhookCache, eventSubscriptionsCacheKey(eventType), &subscriptions); err == nil {
+		return subscriptions, nil
+	}
+
+	subscriptions, err := s.WebhookStore.GetEventSubscriptionsForEvent(eventType, allowFromCache)
+	if err != nil {
+		return nil, err
+	}
+
+	s.rootStore.doStandardAddToCache(s.rootStore.webhookCache, eventSubscriptionsCacheKey(eventType), subscriptions)
+
+	return subscriptions, nil
+}
+
+func (s LocalCacheWebhookStore) SaveEventSubscription(subscription *model.EventSubscription) (*model.EventSubscription, error) {
+	subscription, err := s.WebhookStore.SaveEventSubscription(subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	s.ClearCaches()
+	return subscription, nil
+}
+
+func (s LocalCacheWebhookStore) UpdateEventSubscription(subscription *model.EventSubscription) (*model.EventSubscription, error) {
+	subscription, err := s.WebhookStore.UpdateEventSubscription(subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	// The subscriptions of the event types the subscription had before the
+	// update are stale too, so the whole cache is cleared.
+	s.ClearCaches()
+	return subscription, nil
+}
+
+func (s LocalCacheWebhookStore) DeleteEventSubscription(id string, time int64) error {
+	err := s.WebhookStore.DeleteEventSubscription(id, time)
+	if err != nil {
+		return err
+	}
+
+	s.ClearCaches()
+	return nil
+}
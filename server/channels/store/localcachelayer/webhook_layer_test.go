@@ -64,3 +64,49 @@ func TestWebhookStoreCache(t *testing.T) {
 		mockStore.Webhook().(*mocks.WebhookStore).AssertNumberOfCalls(t, "GetIncoming", 2)
 	})
 }
+
+func TestWebhookStoreEventSubscriptionsCache(t *testing.T) {
+	logger := mlog.CreateConsoleTestLogger(t)
+
+	t.Run("first call not cached, second cached and returning same data", func(t *testing.T) {
+		mockStore := getMockStore(t)
+		mockCacheProvider := getMockCacheProvider()
+		cachedStore, err := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider, logger)
+		require.NoError(t, err)
+
+		subscriptions, err := cachedStore.Webhook().GetEventSubscriptionsForEvent(model.EventTypeUserHasJoinedChannel, true)
+		require.NoError(t, err)
+		require.Len(t, subscriptions, 1)
+		mockStore.Webhook().(*mocks.WebhookStore).AssertNumberOfCalls(t, "GetEventSubscriptionsForEvent", 1)
+
+		cachedSubscriptions, err := cachedStore.Webhook().GetEventSubscriptionsForEvent(model.EventTypeUserHasJoinedChannel, true)
+		require.NoError(t, err)
+		assert.Equal(t, subscriptions, cachedSubscriptions)
+		mockStore.Webhook().(*mocks.WebhookStore).AssertNumberOfCalls(t, "GetEventSubscriptionsForEvent", 1)
+	})
+
+	t.Run("first call not cached, second force not cached", func(t *testing.T) {
+		mockStore := getMockStore(t)
+		mockCacheProvider := getMockCacheProvider()
+		cachedStore, err := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider, logger)
+		require.NoError(t, err)
+
+		cachedStore.Webhook().GetEventSubscriptionsForEvent(model.EventTypeUserHasJoinedChannel, true)
+		mockStore.Webhook().(*mocks.WebhookStore).AssertNumberOfCalls(t, "GetEventSubscriptionsForEvent", 1)
+		cachedStore.Webhook().GetEventSubscriptionsForEvent(model.EventTypeUserHasJoinedChannel, false)
+		mockStore.Webhook().(*mocks.WebhookStore).AssertNumberOfCalls(t, "GetEventSubscriptionsForEvent", 2)
+	})
+
+	t.Run("first call not cached, delete, and then not cached again", func(t *testing.T) {
+		mockStore := getMockStore(t)
+		mockCacheProvider := getMockCacheProvider()
+		cachedStore, err := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider, logger)
+		require.NoError(t, err)
+
+		cachedStore.Webhook().GetEventSubscriptionsForEvent(model.EventTypeUserHasJoinedChannel, true)
+		mockStore.Webhook().(*mocks.WebhookStore).AssertNumberOfCalls(t, "GetEventSubscriptionsForEvent", 1)
+		require.NoError(t, cachedStore.Webhook().DeleteEventSubscription("123", model.GetMillis()))
+		cachedStore.Webhook().GetEventSubscriptionsForEvent(model.EventTypeUserHasJoinedChannel, true)
+		mockStore.Webhook().(*mocks.WebhookStore).AssertNumberOfCalls(t, "GetEventSubscriptionsForEvent", 2)
+	})
+}
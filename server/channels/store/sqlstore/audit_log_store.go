@@ -0,0 +1,180 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"fmt"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+// auditLogsLockKey is the key of the advisory lock taken to append to the chain
+// of the audit logs, which the servers of a cluster share.
+const auditLogsLockKey = 7263512384
+
+var auditLogColumns = []string{
+	"Id",
+	"Sequence",
+	"CreateAt",
+	"EventName",
+	"Status",
+	"UserId",
+	"SessionId",
+	"IpAddress",
+	"ObjectType",
+	"ObjectIds",
+	"Record",
+	"PrevHash",
+	"Hash",
+}
+
+type SqlAuditLogStore struct {
+	*SqlStore
+}
+
+func newSqlAuditLogStore(sqlStore *SqlStore) store.AuditLogStore {
+	return &SqlAuditLogStore{
+		SqlStore: sqlStore,
+	}
+}
+
+func (s *SqlAuditLogStore) Save(auditLog *model.AuditLog, hashKey []byte) (_ *model.AuditLog, err error) {
+	if auditLog.Id != "" {
+		return nil, store.NewErrInvalidInput("AuditLog", "id", auditLog.Id)
+	}
+	auditLog.PreSave()
+
+	tx, err := s.GetMaster().Beginx()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to start transaction")
+	}
+	defer finalizeTransactionX(tx, &err)
+
+	if _, err = tx.Exec("SELECT pg_advisory_xact_lock(?)", auditLogsLockKey); err != nil {
+		return nil, errors.Wrap(err, "failed to lock the AuditLogs")
+	}
+
+	var last struct {
+		Sequence int64
+		Hash     string
+	}
+	lastQuery := s.getQueryBuilder().
+		Select("Sequence", "Hash").
+		From("AuditLogs").
+		OrderBy("Sequence DESC").
+		Limit(1)
+	if err = tx.GetBuilder(&last, lastQuery); err != nil && err != sql.ErrNoRows {
+		return nil, errors.Wrap(err, "failed to get the last AuditLog")
+	}
+
+	auditLog.Sequence = last.Sequence + 1
+	auditLog.PrevHash = last.Hash
+	auditLog.Hash = auditLog.ComputeHash(hashKey)
+	if appErr := auditLog.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	insertQuery := s.getQueryBuilder().
+		Insert("AuditLogs").
+		Columns(auditLogColumns...).
+		Values(
+			auditLog.Id,
+			auditLog.Sequence,
+			auditLog.CreateAt,
+			auditLog.EventName,
+			auditLog.Status,
+			auditLog.UserId,
+			auditLog.SessionId,
+			auditLog.IpAddress,
+			auditLog.ObjectType,
+			auditLog.ObjectIds,
+			auditLog.Record,
+			auditLog.PrevHash,
+			auditLog.Hash,
+		)
+	if _, err = tx.ExecBuilder(insertQuery); err != nil {
+		return nil, errors.Wrapf(err, "failed to save AuditLog with id=%s", auditLog.Id)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit_transaction")
+	}
+
+	return auditLog, nil
+}
+
+func (s *SqlAuditLogStore) Search(search *model.AuditLogSearch) ([]*model.AuditLog, error) {
+	auditLogs := []*model.AuditLog{}
+
+	query := s.getQueryBuilder().
+		Select(auditLogColumns...).
+		From("AuditLogs").
+		OrderBy("Sequence DESC").
+		Offset(uint64(search.Page * search.PerPage)).
+		Limit(uint64(search.PerPage))
+
+	if search.UserId != "" {
+		query = query.Where(sq.Eq{"UserId": search.UserId})
+	}
+	if search.EventName != "" {
+		query = query.Where(sq.Eq{"EventName": search.EventName})
+	}
+	if search.ObjectId != "" {
+		query = query.Where(sq.Expr("ObjectIds @> ?::jsonb", fmt.Sprintf("[%q]", search.ObjectId)))
+	}
+	if search.Since > 0 {
+		query = query.Where(sq.GtOrEq{"CreateAt": search.Since})
+	}
+	if search.Until > 0 {
+		query = query.Where(sq.LtOrEq{"CreateAt": search.Until})
+	}
+
+	if err := s.GetReplica().SelectBuilder(&auditLogs, query); err != nil {
+		return nil, errors.Wrap(err, "failed to search AuditLogs")
+	}
+
+	return auditLogs, nil
+}
+
+func (s *SqlAuditLogStore) GetChain(afterSequence int64, limit int) ([]*model.AuditLog, error) {
+	auditLogs := []*model.AuditLog{}
+
+	query := s.getQueryBuilder().
+		Select(auditLogColumns...).
+		From("AuditLogs").
+		Where(sq.Gt{"Sequence": afterSequence}).
+		OrderBy("Sequence").
+		Limit(uint64(limit))
+
+	if err := s.GetMaster().SelectBuilder(&auditLogs, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get the AuditLogs after sequence=%d", afterSequence)
+	}
+
+	return auditLogs, nil
+}
+
+func (s *SqlAuditLogStore) PermanentDeleteUntil(sequence int64) (int64, error) {
+	// The last audit log is kept for the next one to be chained to it.
+	query := s.getQueryBuilder().
+		Delete("AuditLogs").
+		Where(sq.LtOrEq{"Sequence": sequence}).
+		Where(sq.Expr("Sequence < (SELECT MAX(Sequence) FROM AuditLogs)"))
+
+	result, err := s.GetMaster().ExecBuilder(query)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to delete the AuditLogs until sequence=%d", sequence)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to count the deleted AuditLogs")
+	}
+
+	return deleted, nil
+}
@@ -336,3 +336,25 @@ func (s SqlChannelMemberHistoryStore) GetChannelsLeftSince(userID string, since
 
 	return channelIds, nil
 }
+
+// GetUsersWithMembershipChangesSince returns the list of users that joined or left a channel
+// after a given time.
+func (s SqlChannelMemberHistoryStore) GetUsersWithMembershipChangesSince(since int64) ([]string, error) {
+	query, params, err := s.getQueryBuilder().
+		Select("DISTINCT UserId").
+		From("ChannelMemberHistory").
+		Where(sq.Or{
+			sq.GtOrEq{"JoinTime": since},
+			sq.GtOrEq{"LeaveTime": since},
+		}).ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_member_history_to_sql")
+	}
+	userIds := []string{}
+	err = s.GetReplica().Select(&userIds, query, params...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GetUsersWithMembershipChangesSince since=%d", since)
+	}
+
+	return userIds, nil
+}
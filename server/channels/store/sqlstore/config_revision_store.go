@@ -0,0 +1,112 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"fmt"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+type SqlConfigRevisionStore struct {
+	*SqlStore
+}
+
+func newSqlConfigRevisionStore(sqlStore *SqlStore) store.ConfigRevisionStore {
+	return &SqlConfigRevisionStore{
+		SqlStore: sqlStore,
+	}
+}
+
+func (s *SqlConfigRevisionStore) Save(revision *model.ConfigRevision) (*model.ConfigRevision, error) {
+	if revision.Id != "" {
+		return nil, store.NewErrInvalidInput("ConfigRevision", "id", revision.Id)
+	}
+
+	revision.PreSave()
+	if err := revision.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.GetMaster().NamedExec(`INSERT INTO ConfigRevisions
+			(Id, CreateAt, UserId, Value)
+			VALUES
+			(:Id, :CreateAt, :UserId, :Value)`, revision); err != nil {
+		return nil, errors.Wrapf(err, "failed to save ConfigRevision with id=%s", revision.Id)
+	}
+
+	return revision, nil
+}
+
+func (s *SqlConfigRevisionStore) Get(id string) (*model.ConfigRevision, error) {
+	var revision model.ConfigRevision
+
+	query := s.getQueryBuilder().
+		Select("Id", "CreateAt", "UserId", "Value").
+		From("ConfigRevisions").
+		Where(sq.Eq{"Id": id})
+
+	if err := s.GetReplica().GetBuilder(&revision, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ConfigRevision", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get ConfigRevision with id=%s", id)
+	}
+
+	return &revision, nil
+}
+
+func (s *SqlConfigRevisionStore) GetAll(offset, limit int) ([]*model.ConfigRevision, error) {
+	revisions := []*model.ConfigRevision{}
+
+	query := s.getQueryBuilder().
+		Select("Id", "CreateAt", "UserId").
+		From("ConfigRevisions").
+		OrderBy("CreateAt DESC").
+		Offset(uint64(offset)).
+		Limit(uint64(limit))
+
+	if err := s.GetReplica().SelectBuilder(&revisions, query); err != nil {
+		return nil, errors.Wrap(err, "failed to find ConfigRevisions")
+	}
+
+	return revisions, nil
+}
+
+func (s *SqlConfigRevisionStore) GetPrevious(createAt int64) (*model.ConfigRevision, error) {
+	var revision model.ConfigRevision
+
+	query := s.getQueryBuilder().
+		Select("Id", "CreateAt", "UserId", "Value").
+		From("ConfigRevisions").
+		Where(sq.Lt{"CreateAt": createAt}).
+		OrderBy("CreateAt DESC").
+		Limit(1)
+
+	if err := s.GetReplica().GetBuilder(&revision, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ConfigRevision", fmt.Sprintf("createAt<%d", createAt))
+		}
+		return nil, errors.Wrap(err, "failed to get the previous ConfigRevision")
+	}
+
+	return &revision, nil
+}
+
+func (s *SqlConfigRevisionStore) PermanentDeleteAllButLatest(keep int) error {
+	query := s.getQueryBuilder().
+		Delete("ConfigRevisions").
+		Where(sq.Expr("Id NOT IN (SELECT Id FROM ConfigRevisions ORDER BY CreateAt DESC LIMIT ?)", keep))
+
+	if _, err := s.GetMaster().ExecBuilder(query); err != nil {
+		return errors.Wrap(err, "failed to delete the oldest ConfigRevisions")
+	}
+
+	return nil
+}
@@ -225,6 +225,25 @@ func (jss SqlJobStore) UpdateStatusOptimistically(id string, currentStatus strin
 	return job[0], nil
 }
 
+func (jss SqlJobStore) UpdateLastActivityOptimistically(id string, currentStatus string) (bool, error) {
+	query := jss.getQueryBuilder().
+		Update("Jobs").
+		Set("LastActivityAt", model.GetMillis()).
+		Where(sq.Eq{"Id": id, "Status": currentStatus})
+
+	sqlResult, err := jss.GetMaster().ExecBuilder(query)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to update the last activity of Job with id=%s", id)
+	}
+
+	rows, err := sqlResult.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "unable to get rows affected")
+	}
+
+	return rows == 1, nil
+}
+
 func (jss SqlJobStore) Get(rctx request.CTX, id string) (*model.Job, error) {
 	query, args, err := jss.jobQuery.
 		Where(sq.Eq{"Id": id}).ToSql()
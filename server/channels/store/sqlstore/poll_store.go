@@ -0,0 +1,207 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+type SqlPollStore struct {
+	*SqlStore
+
+	pollSelectQuery sq.SelectBuilder
+}
+
+func newSqlPollStore(sqlStore *SqlStore) store.PollStore {
+	s := &SqlPollStore{
+		SqlStore: sqlStore,
+	}
+
+	s.pollSelectQuery = s.getQueryBuilder().
+		Select(
+			"Id",
+			"CreateAt",
+			"UpdateAt",
+			"DeleteAt",
+			"CreatorId",
+			"ChannelId",
+			"PostId",
+			"Question",
+			"Options",
+			"Anonymous",
+			"MultipleChoice",
+			"CloseAt",
+			"ClosedAt",
+		).
+		From("Polls")
+
+	return s
+}
+
+func (s *SqlPollStore) Save(poll *model.Poll) (*model.Poll, error) {
+	if poll.Id != "" {
+		return nil, store.NewErrInvalidInput("Poll", "id", poll.Id)
+	}
+
+	poll.PreSave()
+	if err := poll.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.GetMaster().NamedExec(`INSERT INTO Polls
+			(Id, CreateAt, UpdateAt, DeleteAt, CreatorId, ChannelId, PostId, Question, Options, Anonymous, MultipleChoice, CloseAt, ClosedAt)
+			VALUES
+			(:Id, :CreateAt, :UpdateAt, :DeleteAt, :CreatorId, :ChannelId, :PostId, :Question, :Options, :Anonymous, :MultipleChoice, :CloseAt, :ClosedAt)`, poll); err != nil {
+		return nil, errors.Wrapf(err, "failed to save Poll with id=%s", poll.Id)
+	}
+
+	return poll, nil
+}
+
+func (s *SqlPollStore) Get(id string) (*model.Poll, error) {
+	var poll model.Poll
+
+	query := s.pollSelectQuery.
+		Where(sq.And{
+			sq.Eq{"Id": id},
+			sq.Eq{"DeleteAt": 0},
+		})
+
+	// Polls are read right before being voted on, which must see the
+	// poll being closed.
+	if err := s.GetMaster().GetBuilder(&poll, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("Poll", id)
+		}
+
+		return nil, errors.Wrapf(err, "failed to get Poll with id=%s", id)
+	}
+
+	return &poll, nil
+}
+
+func (s *SqlPollStore) Update(poll *model.Poll) (*model.Poll, error) {
+	poll.PreUpdate()
+	if err := poll.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.GetMaster().NamedExec(`UPDATE Polls SET
+			UpdateAt = :UpdateAt, DeleteAt = :DeleteAt, PostId = :PostId, CloseAt = :CloseAt, ClosedAt = :ClosedAt
+			WHERE Id = :Id`, poll); err != nil {
+		return nil, errors.Wrapf(err, "failed to update Poll with id=%s", poll.Id)
+	}
+
+	return poll, nil
+}
+
+func (s *SqlPollStore) GetExpired(before int64, limit int) ([]*model.Poll, error) {
+	polls := []*model.Poll{}
+
+	query := s.pollSelectQuery.
+		Where(sq.And{
+			sq.Gt{"CloseAt": 0},
+			sq.LtOrEq{"CloseAt": before},
+			sq.Eq{"ClosedAt": 0},
+			sq.Eq{"DeleteAt": 0},
+		}).
+		OrderBy("CloseAt", "Id").
+		Limit(uint64(limit))
+
+	if err := s.GetMaster().SelectBuilder(&polls, query); err != nil {
+		return nil, errors.Wrap(err, "failed to find expired Polls")
+	}
+
+	return polls, nil
+}
+
+func (s *SqlPollStore) SaveVote(vote *model.PollVote, replaceOthers bool) (err error) {
+	if vote.CreateAt == 0 {
+		vote.CreateAt = model.GetMillis()
+	}
+
+	tx, err := s.GetMaster().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to start transaction")
+	}
+	defer finalizeTransactionX(tx, &err)
+
+	if replaceOthers {
+		deleteQuery := s.getQueryBuilder().
+			Delete("PollVotes").
+			Where(sq.And{
+				sq.Eq{"PollId": vote.PollId},
+				sq.Eq{"UserId": vote.UserId},
+				sq.NotEq{"OptionId": vote.OptionId},
+			})
+		if _, err = tx.ExecBuilder(deleteQuery); err != nil {
+			return errors.Wrapf(err, "failed to delete the other PollVotes of userId=%s for pollId=%s", vote.UserId, vote.PollId)
+		}
+	}
+
+	insertQuery := s.getQueryBuilder().
+		Insert("PollVotes").
+		Columns("PollId", "OptionId", "UserId", "CreateAt").
+		Values(vote.PollId, vote.OptionId, vote.UserId, vote.CreateAt).
+		SuffixExpr(sq.Expr("ON CONFLICT (PollId, OptionId, UserId) DO NOTHING"))
+	if _, err = tx.ExecBuilder(insertQuery); err != nil {
+		return errors.Wrapf(err, "failed to save PollVote of userId=%s for pollId=%s", vote.UserId, vote.PollId)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+func (s *SqlPollStore) DeleteVote(pollID, optionID, userID string) error {
+	query := s.getQueryBuilder().
+		Delete("PollVotes").
+		Where(sq.And{
+			sq.Eq{"PollId": pollID},
+			sq.Eq{"OptionId": optionID},
+			sq.Eq{"UserId": userID},
+		})
+
+	if _, err := s.GetMaster().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete PollVote of userId=%s for pollId=%s", userID, pollID)
+	}
+
+	return nil
+}
+
+func (s *SqlPollStore) GetVotes(pollID string) ([]*model.PollVote, error) {
+	votes := []*model.PollVote{}
+
+	query := s.getQueryBuilder().
+		Select("PollId", "OptionId", "UserId", "CreateAt").
+		From("PollVotes").
+		Where(sq.Eq{"PollId": pollID}).
+		OrderBy("CreateAt", "UserId", "OptionId")
+
+	if err := s.GetMaster().SelectBuilder(&votes, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to find PollVotes with pollId=%s", pollID)
+	}
+
+	return votes, nil
+}
+
+func (s *SqlPollStore) PermanentDeleteVotesByUser(userID string) error {
+	query := s.getQueryBuilder().
+		Delete("PollVotes").
+		Where(sq.Eq{"UserId": userID})
+
+	if _, err := s.GetMaster().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete PollVotes of userId=%s", userID)
+	}
+
+	return nil
+}
@@ -2647,36 +2647,9 @@ func (s *SqlPostStore) GetParentsForExportAfter(limit int, afterId string, inclu
 			return postsForExport, nil
 		}
 
-		excludeDeletedCond := sq.And{
-			sq.Eq{"Teams.DeleteAt": 0},
-		}
-		if !includeArchivedChannel {
-			excludeDeletedCond = append(excludeDeletedCond, sq.Eq{"Channels.DeleteAt": 0})
-		}
-
-		aggFn := "COALESCE(json_agg(u1.username) FILTER (WHERE u1.username IS NOT NULL), '[]')"
-		result := []*model.PostForExport{}
-
-		builder := s.getQueryBuilder().
-			Select(fmt.Sprintf("%s, Users.Username as Username, Teams.Name as TeamName, Channels.Name as ChannelName, %s as FlaggedBy", strings.Join(postSliceColumnsWithName("p1"), ", "), aggFn)).
-			FromSelect(sq.Select("*").From("Posts").Where(sq.Eq{"Posts.Id": rootIds}), "p1").
-			LeftJoin("Preferences ON p1.Id = Preferences.Name").
-			LeftJoin("Users u1 ON Preferences.UserId = u1.Id").
-			InnerJoin("Channels ON p1.ChannelId = Channels.Id").
-			InnerJoin("Teams ON Channels.TeamId = Teams.Id").
-			InnerJoin("Users ON p1.UserId = Users.Id").
-			Where(excludeDeletedCond).
-			GroupBy(fmt.Sprintf("%s, Users.Username, Teams.Name, Channels.Name", strings.Join(postSliceColumnsWithName("p1"), ", "))).
-			OrderBy("p1.Id")
-
-		query, args, err := builder.ToSql()
+		result, err := s.getParentsForExport(rootIds, includeArchivedChannel)
 		if err != nil {
-			return nil, errors.Wrap(err, "postsForExport_toSql")
-		}
-
-		err = s.GetSearchReplicaX().Select(&result, query, args...)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to find Posts")
+			return nil, err
 		}
 
 		if len(result) == 0 {
@@ -2690,6 +2663,53 @@ func (s *SqlPostStore) GetParentsForExportAfter(limit int, afterId string, inclu
 	}
 }
 
+// GetParentsForExportByIds returns the root posts with the given ids for export, skipping those
+// that are deleted or in deleted teams, or in archived channels unless includeArchivedChannels is set.
+func (s *SqlPostStore) GetParentsForExportByIds(rootIds []string, includeArchivedChannels bool) ([]*model.PostForExport, error) {
+	if len(rootIds) == 0 {
+		return []*model.PostForExport{}, nil
+	}
+	return s.getParentsForExport(rootIds, includeArchivedChannels)
+}
+
+func (s *SqlPostStore) getParentsForExport(rootIds []string, includeArchivedChannel bool) ([]*model.PostForExport, error) {
+	excludeDeletedCond := sq.And{
+		sq.Eq{"p1.RootId": ""},
+		sq.Eq{"p1.DeleteAt": 0},
+		sq.Eq{"Teams.DeleteAt": 0},
+	}
+	if !includeArchivedChannel {
+		excludeDeletedCond = append(excludeDeletedCond, sq.Eq{"Channels.DeleteAt": 0})
+	}
+
+	aggFn := "COALESCE(json_agg(u1.username) FILTER (WHERE u1.username IS NOT NULL), '[]')"
+	result := []*model.PostForExport{}
+
+	builder := s.getQueryBuilder().
+		Select(fmt.Sprintf("%s, Users.Username as Username, Teams.Name as TeamName, Channels.Name as ChannelName, %s as FlaggedBy", strings.Join(postSliceColumnsWithName("p1"), ", "), aggFn)).
+		FromSelect(sq.Select("*").From("Posts").Where(sq.Eq{"Posts.Id": rootIds}), "p1").
+		LeftJoin("Preferences ON p1.Id = Preferences.Name").
+		LeftJoin("Users u1 ON Preferences.UserId = u1.Id").
+		InnerJoin("Channels ON p1.ChannelId = Channels.Id").
+		InnerJoin("Teams ON Channels.TeamId = Teams.Id").
+		InnerJoin("Users ON p1.UserId = Users.Id").
+		Where(excludeDeletedCond).
+		GroupBy(fmt.Sprintf("%s, Users.Username, Teams.Name, Channels.Name", strings.Join(postSliceColumnsWithName("p1"), ", "))).
+		OrderBy("p1.Id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "postsForExport_toSql")
+	}
+
+	err = s.GetSearchReplicaX().Select(&result, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find Posts")
+	}
+
+	return result, nil
+}
+
 func (s *SqlPostStore) GetRepliesForExport(rootId string) ([]*model.ReplyForExport, error) {
 	aggFn := "COALESCE(json_agg(u1.username) FILTER (WHERE u1.username IS NOT NULL), '[]')"
 	result := []*model.ReplyForExport{}
@@ -2717,6 +2737,20 @@ func (s *SqlPostStore) GetRepliesForExport(rootId string) ([]*model.ReplyForExpo
 }
 
 func (s *SqlPostStore) GetDirectPostParentsForExportAfter(limit int, afterId string, includeArchivedChannels bool) ([]*model.DirectPostForExport, error) {
+	return s.getDirectPostParentsForExport(sq.Gt{"p.Id": afterId}, uint64(limit), includeArchivedChannels)
+}
+
+// GetDirectPostParentsForExportByIds returns the root posts of direct and group channels with the
+// given ids for export, skipping those that are deleted, or in archived channels unless
+// includeArchivedChannels is set.
+func (s *SqlPostStore) GetDirectPostParentsForExportByIds(rootIds []string, includeArchivedChannels bool) ([]*model.DirectPostForExport, error) {
+	if len(rootIds) == 0 {
+		return []*model.DirectPostForExport{}, nil
+	}
+	return s.getDirectPostParentsForExport(sq.Eq{"p.Id": rootIds}, 0, includeArchivedChannels)
+}
+
+func (s *SqlPostStore) getDirectPostParentsForExport(cond sq.Sqlizer, limit uint64, includeArchivedChannels bool) ([]*model.DirectPostForExport, error) {
 	aggFn := "COALESCE(json_agg(u1.username) FILTER (WHERE u1.username IS NOT NULL), '[]')"
 	result := []*model.DirectPostForExport{}
 
@@ -2728,14 +2762,17 @@ func (s *SqlPostStore) GetDirectPostParentsForExportAfter(limit int, afterId str
 		Join("Channels ON p.ChannelId = Channels.Id").
 		Join("Users u2 ON p.UserId = u2.Id").
 		Where(sq.And{
-			sq.Gt{"p.Id": afterId},
+			cond,
 			sq.Eq{"p.RootId": ""},
 			sq.Eq{"p.DeleteAt": 0},
 			sq.Eq{"Channels.Type": []model.ChannelType{model.ChannelTypeDirect, model.ChannelTypeGroup}},
 		}).
 		GroupBy("p.Id, u2.Username").
-		OrderBy("p.Id").
-		Limit(uint64(limit))
+		OrderBy("p.Id")
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
 
 	if !includeArchivedChannels {
 		query = query.Where(
@@ -0,0 +1,194 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+type SqlReminderStore struct {
+	*SqlStore
+
+	reminderSelectQuery sq.SelectBuilder
+}
+
+func newSqlReminderStore(sqlStore *SqlStore) store.ReminderStore {
+	s := &SqlReminderStore{
+		SqlStore: sqlStore,
+	}
+
+	s.reminderSelectQuery = s.getQueryBuilder().
+		Select(
+			"Id",
+			"CreateAt",
+			"UpdateAt",
+			"DeleteAt",
+			"CreatorId",
+			"TargetType",
+			"TargetId",
+			"Message",
+			"TimeZone",
+			"Repeat",
+			"NextFireAt",
+			"LastFireAt",
+			"CompleteAt",
+		).
+		From("Reminders")
+
+	return s
+}
+
+func (s *SqlReminderStore) Save(reminder *model.Reminder) (*model.Reminder, error) {
+	if reminder.Id != "" {
+		return nil, store.NewErrInvalidInput("Reminder", "id", reminder.Id)
+	}
+
+	reminder.PreSave()
+	if err := reminder.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.GetMaster().NamedExec(`INSERT INTO Reminders
+			(Id, CreateAt, UpdateAt, DeleteAt, CreatorId, TargetType, TargetId, Message, TimeZone, Repeat, NextFireAt, LastFireAt, CompleteAt)
+			VALUES
+			(:Id, :CreateAt, :UpdateAt, :DeleteAt, :CreatorId, :TargetType, :TargetId, :Message, :TimeZone, :Repeat, :NextFireAt, :LastFireAt, :CompleteAt)`, reminder); err != nil {
+		return nil, errors.Wrapf(err, "failed to save Reminder with id=%s", reminder.Id)
+	}
+
+	return reminder, nil
+}
+
+func (s *SqlReminderStore) Get(id string) (*model.Reminder, error) {
+	var reminder model.Reminder
+
+	query := s.reminderSelectQuery.
+		Where(sq.And{
+			sq.Eq{"Id": id},
+			sq.Eq{"DeleteAt": 0},
+		})
+
+	// Reminders are read right before being snoozed or completed, which
+	// must not race with the job firing them.
+	if err := s.GetMaster().GetBuilder(&reminder, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("Reminder", id)
+		}
+
+		return nil, errors.Wrapf(err, "failed to get Reminder with id=%s", id)
+	}
+
+	return &reminder, nil
+}
+
+func (s *SqlReminderStore) Update(reminder *model.Reminder) (*model.Reminder, error) {
+	reminder.PreUpdate()
+	if err := reminder.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.GetMaster().NamedExec(`UPDATE Reminders SET
+			UpdateAt = :UpdateAt, Message = :Message, TimeZone = :TimeZone, Repeat = :Repeat,
+			NextFireAt = :NextFireAt, LastFireAt = :LastFireAt, CompleteAt = :CompleteAt WHERE Id = :Id`, reminder); err != nil {
+		return nil, errors.Wrapf(err, "failed to update Reminder with id=%s", reminder.Id)
+	}
+
+	return reminder, nil
+}
+
+func (s *SqlReminderStore) GetForCreator(creatorID string, offset, limit int) ([]*model.Reminder, error) {
+	reminders := []*model.Reminder{}
+
+	query := s.reminderSelectQuery.
+		Where(sq.And{
+			sq.Eq{"CreatorId": creatorID},
+			sq.Eq{"DeleteAt": 0},
+			sq.Eq{"CompleteAt": 0},
+		}).
+		OrderBy("NextFireAt = 0", "NextFireAt", "Id").
+		Limit(uint64(limit)).
+		Offset(uint64(offset))
+
+	if err := s.GetReplica().SelectBuilder(&reminders, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to find Reminders with creatorId=%s", creatorID)
+	}
+
+	return reminders, nil
+}
+
+func (s *SqlReminderStore) GetDue(before int64, limit int) ([]*model.Reminder, error) {
+	reminders := []*model.Reminder{}
+
+	query := s.reminderSelectQuery.
+		Where(sq.And{
+			sq.Gt{"NextFireAt": 0},
+			sq.LtOrEq{"NextFireAt": before},
+			sq.Eq{"DeleteAt": 0},
+			sq.Eq{"CompleteAt": 0},
+		}).
+		OrderBy("NextFireAt", "Id").
+		Limit(uint64(limit))
+
+	if err := s.GetMaster().SelectBuilder(&reminders, query); err != nil {
+		return nil, errors.Wrap(err, "failed to find due Reminders")
+	}
+
+	return reminders, nil
+}
+
+func (s *SqlReminderStore) Reschedule(id string, fireAt, nextFireAt int64) (bool, error) {
+	query := s.getQueryBuilder().
+		Update("Reminders").
+		Set("NextFireAt", nextFireAt).
+		Set("LastFireAt", fireAt).
+		Set("UpdateAt", model.GetMillis()).
+		Where(sq.And{
+			sq.Eq{"Id": id},
+			sq.Eq{"NextFireAt": fireAt},
+			sq.Eq{"DeleteAt": 0},
+		})
+
+	result, err := s.GetMaster().ExecBuilder(query)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to reschedule Reminder with id=%s", id)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get rows affected when rescheduling Reminder with id=%s", id)
+	}
+
+	return rows == 1, nil
+}
+
+func (s *SqlReminderStore) Delete(id string, deleteAt int64) error {
+	if _, err := s.GetMaster().Exec("UPDATE Reminders SET DeleteAt = ?, UpdateAt = ? WHERE Id = ?", deleteAt, deleteAt, id); err != nil {
+		return errors.Wrapf(err, "failed to delete Reminder with id=%s", id)
+	}
+
+	return nil
+}
+
+func (s *SqlReminderStore) PermanentDeleteByUser(userID string) error {
+	query := s.getQueryBuilder().
+		Delete("Reminders").
+		Where(sq.Or{
+			sq.Eq{"CreatorId": userID},
+			sq.And{
+				sq.Eq{"TargetType": model.ReminderTargetUser},
+				sq.Eq{"TargetId": userID},
+			},
+		})
+
+	if _, err := s.GetMaster().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete Reminders of userId=%s", userID)
+	}
+
+	return nil
+}
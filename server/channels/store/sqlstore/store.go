@@ -105,6 +105,12 @@ type SqlStoreStores struct {
 	desktopTokens              store.DesktopTokensStore
 	channelBookmarks           store.ChannelBookmarkStore
 	scheduledPost              store.ScheduledPostStore
+	reminder                   store.ReminderStore
+	userDevice                 store.UserDeviceStore
+	configRevision             store.ConfigRevisionStore
+	auditLog                   store.AuditLogStore
+	poll                       store.PollStore
+	webAuthnCredential         store.WebAuthnCredentialStore
 	propertyGroup              store.PropertyGroupStore
 	propertyField              store.PropertyFieldStore
 	propertyValue              store.PropertyValueStore
@@ -254,6 +260,12 @@ func New(settings model.SqlSettings, logger mlog.LoggerIFace, metrics einterface
 	store.stores.desktopTokens = newSqlDesktopTokensStore(store, metrics)
 	store.stores.channelBookmarks = newSqlChannelBookmarkStore(store)
 	store.stores.scheduledPost = newScheduledPostStore(store)
+	store.stores.reminder = newSqlReminderStore(store)
+	store.stores.userDevice = newSqlUserDeviceStore(store)
+	store.stores.configRevision = newSqlConfigRevisionStore(store)
+	store.stores.auditLog = newSqlAuditLogStore(store)
+	store.stores.poll = newSqlPollStore(store)
+	store.stores.webAuthnCredential = newSqlWebAuthnCredentialStore(store)
 	store.stores.propertyGroup = newPropertyGroupStore(store)
 	store.stores.propertyField = newPropertyFieldStore(store)
 	store.stores.propertyValue = newPropertyValueStore(store)
@@ -1059,3 +1071,27 @@ func (ss *SqlStore) determineMaxColumnSize(tableName, columnName string) (int, e
 func (ss *SqlStore) ScheduledPost() store.ScheduledPostStore {
 	return ss.stores.scheduledPost
 }
+
+func (ss *SqlStore) Reminder() store.ReminderStore {
+	return ss.stores.reminder
+}
+
+func (ss *SqlStore) UserDevice() store.UserDeviceStore {
+	return ss.stores.userDevice
+}
+
+func (ss *SqlStore) ConfigRevision() store.ConfigRevisionStore {
+	return ss.stores.configRevision
+}
+
+func (ss *SqlStore) AuditLog() store.AuditLogStore {
+	return ss.stores.auditLog
+}
+
+func (ss *SqlStore) Poll() store.PollStore {
+	return ss.stores.poll
+}
+
+func (ss *SqlStore) WebAuthnCredential() store.WebAuthnCredentialStore {
+	return ss.stores.webAuthnCredential
+}
@@ -32,6 +32,10 @@ func newSqlUserAccessTokenStore(sqlStore *SqlStore) store.UserAccessTokenStore {
 			"UserAccessTokens.UserId",
 			"UserAccessTokens.Description",
 			"UserAccessTokens.IsActive",
+			"UserAccessTokens.ExpiresAt",
+			"UserAccessTokens.Scopes",
+			"UserAccessTokens.LastUsedAt",
+			"UserAccessTokens.LastUsedIP",
 		).
 		From("UserAccessTokens")
 
@@ -46,8 +50,8 @@ func (s SqlUserAccessTokenStore) Save(token *model.UserAccessToken) (*model.User
 	}
 
 	query, args, err := s.getQueryBuilder().Insert("UserAccessTokens").
-		Columns("Id", "Token", "UserId", "Description", "IsActive").
-		Values(token.Id, token.Token, token.UserId, token.Description, token.IsActive).
+		Columns("Id", "Token", "UserId", "Description", "IsActive", "ExpiresAt", "Scopes").
+		Values(token.Id, token.Token, token.UserId, token.Description, token.IsActive, token.ExpiresAt, token.Scopes).
 		ToSql()
 	if err != nil {
 		return nil, errors.Wrap(err, "UserAccessToken_tosql")
@@ -248,3 +252,52 @@ func (s SqlUserAccessTokenStore) updateTokenDisable(transaction *sqlxTxWrapper,
 
 	return nil
 }
+
+func (s SqlUserAccessTokenStore) UpdateLastUsed(tokenId string, lastUsedAt int64, ipAddress string) error {
+	query := s.getQueryBuilder().
+		Update("UserAccessTokens").
+		Set("LastUsedAt", lastUsedAt).
+		Set("LastUsedIP", ipAddress).
+		Where(sq.Eq{"Id": tokenId})
+
+	if _, err := s.GetMaster().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to update last use of UserAccessToken with id=%s", tokenId)
+	}
+
+	return nil
+}
+
+// GetExpiringForNotification returns the active tokens expiring before the given time, which
+// haven't expired yet and whose owners weren't notified yet.
+func (s SqlUserAccessTokenStore) GetExpiringForNotification(before int64, limit int) ([]*model.UserAccessToken, error) {
+	tokens := []*model.UserAccessToken{}
+
+	query := s.userAccessTokensSelectQuery.
+		Where(sq.And{
+			sq.Eq{"UserAccessTokens.IsActive": true},
+			sq.Eq{"UserAccessTokens.ExpiryNotified": false},
+			sq.Gt{"UserAccessTokens.ExpiresAt": model.GetMillis()},
+			sq.LtOrEq{"UserAccessTokens.ExpiresAt": before},
+		}).
+		OrderBy("UserAccessTokens.ExpiresAt").
+		Limit(uint64(limit))
+
+	if err := s.GetReplica().SelectBuilder(&tokens, query); err != nil {
+		return nil, errors.Wrap(err, "failed to find expiring UserAccessTokens")
+	}
+
+	return tokens, nil
+}
+
+func (s SqlUserAccessTokenStore) MarkExpiryNotified(tokenId string) error {
+	query := s.getQueryBuilder().
+		Update("UserAccessTokens").
+		Set("ExpiryNotified", true).
+		Where(sq.Eq{"Id": tokenId})
+
+	if _, err := s.GetMaster().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to mark the expiry notification of UserAccessToken with id=%s", tokenId)
+	}
+
+	return nil
+}
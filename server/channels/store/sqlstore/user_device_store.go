@@ -0,0 +1,106 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+type SqlUserDeviceStore struct {
+	*SqlStore
+
+	userDeviceSelectQuery sq.SelectBuilder
+}
+
+func newSqlUserDeviceStore(sqlStore *SqlStore) store.UserDeviceStore {
+	s := &SqlUserDeviceStore{
+		SqlStore: sqlStore,
+	}
+
+	s.userDeviceSelectQuery = s.getQueryBuilder().
+		Select(
+			"UserId",
+			"Fingerprint",
+			"Platform",
+			"Os",
+			"Browser",
+			"FirstSeenAt",
+			"LastSeenAt",
+			"LastIPAddress",
+		).
+		From("UserDevices")
+
+	return s
+}
+
+func (s *SqlUserDeviceStore) Upsert(device *model.UserDevice) (bool, error) {
+	device.PreSave()
+	if err := device.IsValid(); err != nil {
+		return false, err
+	}
+
+	insert := s.getQueryBuilder().
+		Insert("UserDevices").
+		Columns("UserId", "Fingerprint", "Platform", "Os", "Browser", "FirstSeenAt", "LastSeenAt", "LastIPAddress").
+		Values(device.UserId, device.Fingerprint, device.Platform, device.Os, device.Browser, device.FirstSeenAt, device.LastSeenAt, device.LastIPAddress).
+		Suffix("ON CONFLICT (UserId, Fingerprint) DO NOTHING")
+
+	result, err := s.GetMaster().ExecBuilder(insert)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to save UserDevice with userId=%s", device.UserId)
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get the number of saved UserDevices")
+	}
+	if inserted == 1 {
+		return true, nil
+	}
+
+	update := s.getQueryBuilder().
+		Update("UserDevices").
+		Set("LastSeenAt", device.LastSeenAt).
+		Set("LastIPAddress", device.LastIPAddress).
+		Where(sq.Eq{
+			"UserId":      device.UserId,
+			"Fingerprint": device.Fingerprint,
+		})
+
+	if _, err := s.GetMaster().ExecBuilder(update); err != nil {
+		return false, errors.Wrapf(err, "failed to update UserDevice with userId=%s", device.UserId)
+	}
+
+	return false, nil
+}
+
+func (s *SqlUserDeviceStore) GetForUser(userID string) ([]*model.UserDevice, error) {
+	devices := []*model.UserDevice{}
+
+	query := s.userDeviceSelectQuery.
+		Where(sq.Eq{"UserId": userID}).
+		OrderBy("LastSeenAt DESC")
+
+	if err := s.GetReplica().SelectBuilder(&devices, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to find UserDevices with userId=%s", userID)
+	}
+
+	return devices, nil
+}
+
+func (s *SqlUserDeviceStore) PermanentDeleteByUser(userID string) error {
+	query := s.getQueryBuilder().
+		Delete("UserDevices").
+		Where(sq.Eq{"UserId": userID})
+
+	if _, err := s.GetMaster().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete UserDevices of userId=%s", userID)
+	}
+
+	return nil
+}
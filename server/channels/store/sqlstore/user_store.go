@@ -1706,6 +1706,31 @@ func (us SqlUserStore) performSearch(query sq.SelectBuilder, term string, option
 	return users, nil
 }
 
+func (us SqlUserStore) AnalyticsGetPasswordHashCounts() (map[string]int64, error) {
+	// Strip the salt and the hash, the last two fields of the PHC string, so
+	// that the hashes are grouped by function and parameters.
+	query := us.getQueryBuilder().
+		Select(`regexp_replace(Password, '\$[^$]*\$[^$]*$', '') AS Header`, "COUNT(*) AS Count").
+		From("Users").
+		Where(sq.NotEq{"Password": ""}).
+		GroupBy("Header")
+
+	rows := []struct {
+		Header string
+		Count  int64
+	}{}
+	if err := us.GetReplica().SelectBuilder(&rows, query); err != nil {
+		return nil, errors.Wrap(err, "failed to count password hashes")
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Header] = row.Count
+	}
+
+	return counts, nil
+}
+
 func (us SqlUserStore) AnalyticsGetInactiveUsersCount() (int64, error) {
 	query := us.getQueryBuilder().
 		Select("COUNT(Id)").
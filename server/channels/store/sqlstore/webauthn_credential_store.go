@@ -0,0 +1,141 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+type SqlWebAuthnCredentialStore struct {
+	*SqlStore
+
+	credentialSelectQuery sq.SelectBuilder
+}
+
+func newSqlWebAuthnCredentialStore(sqlStore *SqlStore) store.WebAuthnCredentialStore {
+	s := &SqlWebAuthnCredentialStore{
+		SqlStore: sqlStore,
+	}
+
+	s.credentialSelectQuery = s.getQueryBuilder().
+		Select(
+			"Id",
+			"UserId",
+			"CredentialId",
+			"PublicKey",
+			"SignCount",
+			"AAGUID",
+			"Name",
+			"CreateAt",
+			"LastUsedAt",
+		).
+		From("WebAuthnCredentials")
+
+	return s
+}
+
+func (s *SqlWebAuthnCredentialStore) Save(credential *model.WebAuthnCredential) (*model.WebAuthnCredential, error) {
+	if credential.Id != "" {
+		return nil, store.NewErrInvalidInput("WebAuthnCredential", "id", credential.Id)
+	}
+
+	credential.PreSave()
+	if err := credential.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.GetMaster().NamedExec(`INSERT INTO WebAuthnCredentials
+			(Id, UserId, CredentialId, PublicKey, SignCount, AAGUID, Name, CreateAt, LastUsedAt)
+			VALUES
+			(:Id, :UserId, :CredentialId, :PublicKey, :SignCount, :AAGUID, :Name, :CreateAt, :LastUsedAt)`, credential); err != nil {
+		if IsUniqueConstraintError(err, []string{"CredentialId", "idx_webauthncredentials_credentialid"}) {
+			return nil, store.NewErrUniqueConstraint("CredentialId")
+		}
+		return nil, errors.Wrapf(err, "failed to save WebAuthnCredential with id=%s", credential.Id)
+	}
+
+	return credential, nil
+}
+
+func (s *SqlWebAuthnCredentialStore) getBy(where sq.Eq, notFoundID string) (*model.WebAuthnCredential, error) {
+	var credential model.WebAuthnCredential
+
+	// Credentials are read right before checking their signature counter, which must see
+	// the last use of the credential.
+	if err := s.GetMaster().GetBuilder(&credential, s.credentialSelectQuery.Where(where)); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("WebAuthnCredential", notFoundID)
+		}
+
+		return nil, errors.Wrapf(err, "failed to get WebAuthnCredential with id=%s", notFoundID)
+	}
+
+	return &credential, nil
+}
+
+func (s *SqlWebAuthnCredentialStore) Get(id string) (*model.WebAuthnCredential, error) {
+	return s.getBy(sq.Eq{"Id": id}, id)
+}
+
+func (s *SqlWebAuthnCredentialStore) GetByCredentialId(credentialID string) (*model.WebAuthnCredential, error) {
+	return s.getBy(sq.Eq{"CredentialId": credentialID}, credentialID)
+}
+
+func (s *SqlWebAuthnCredentialStore) GetForUser(userID string) ([]*model.WebAuthnCredential, error) {
+	credentials := []*model.WebAuthnCredential{}
+
+	query := s.credentialSelectQuery.
+		Where(sq.Eq{"UserId": userID}).
+		OrderBy("CreateAt", "Id")
+
+	if err := s.GetReplica().SelectBuilder(&credentials, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to find WebAuthnCredentials with userId=%s", userID)
+	}
+
+	return credentials, nil
+}
+
+func (s *SqlWebAuthnCredentialStore) UpdateSignCount(id string, signCount, lastUsedAt int64) error {
+	query := s.getQueryBuilder().
+		Update("WebAuthnCredentials").
+		Set("SignCount", signCount).
+		Set("LastUsedAt", lastUsedAt).
+		Where(sq.Eq{"Id": id})
+
+	if _, err := s.GetMaster().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to update WebAuthnCredential with id=%s", id)
+	}
+
+	return nil
+}
+
+func (s *SqlWebAuthnCredentialStore) Delete(id string) error {
+	query := s.getQueryBuilder().
+		Delete("WebAuthnCredentials").
+		Where(sq.Eq{"Id": id})
+
+	if _, err := s.GetMaster().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete WebAuthnCredential with id=%s", id)
+	}
+
+	return nil
+}
+
+func (s *SqlWebAuthnCredentialStore) PermanentDeleteByUser(userID string) error {
+	query := s.getQueryBuilder().
+		Delete("WebAuthnCredentials").
+		Where(sq.Eq{"UserId": userID})
+
+	if _, err := s.GetMaster().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete WebAuthnCredentials of userId=%s", userID)
+	}
+
+	return nil
+}
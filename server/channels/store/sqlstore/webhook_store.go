@@ -18,8 +18,10 @@ type SqlWebhookStore struct {
 	*SqlStore
 	metrics einterfaces.MetricsInterface
 
-	incomingWebhookSelectQuery sq.SelectBuilder
-	outgoingWebhookSelectQuery sq.SelectBuilder
+	incomingWebhookSelectQuery         sq.SelectBuilder
+	outgoingWebhookSelectQuery         sq.SelectBuilder
+	outgoingWebhookDeliverySelectQuery sq.SelectBuilder
+	eventSubscriptionSelectQuery       sq.SelectBuilder
 }
 
 func (s SqlWebhookStore) ClearCaches() {
@@ -46,6 +48,7 @@ func newSqlWebhookStore(sqlStore *SqlStore, metrics einterfaces.MetricsInterface
 			"Username",
 			"IconURL",
 			"ChannelLocked",
+			"Adapter",
 		).
 		From("IncomingWebhooks")
 
@@ -68,9 +71,48 @@ func newSqlWebhookStore(sqlStore *SqlStore, metrics einterfaces.MetricsInterface
 			"ContentType",
 			"Username",
 			"IconURL",
+			"Secret",
 		).
 		From("OutgoingWebhooks")
 
+	s.outgoingWebhookDeliverySelectQuery = s.getQueryBuilder().
+		Select(
+			"Id",
+			"HookId",
+			"PostId",
+			"ChannelId",
+			"URL",
+			"ContentType",
+			"Payload",
+			"Status",
+			"Attempts",
+			"CreateAt",
+			"UpdateAt",
+			"LastAttemptAt",
+			"NextAttemptAt",
+			"ResponseCode",
+			"Error",
+			"DeliveredAt",
+		).
+		From("OutgoingWebhookDeliveries")
+
+	s.eventSubscriptionSelectQuery = s.getQueryBuilder().
+		Select(
+			"Id",
+			"CreateAt",
+			"UpdateAt",
+			"DeleteAt",
+			"CreatorId",
+			"DisplayName",
+			"Description",
+			"URL",
+			"EventTypes",
+			"TeamId",
+			"ChannelId",
+			"Secret",
+		).
+		From("EventSubscriptions")
+
 	return s
 }
 
@@ -88,9 +130,9 @@ func (s SqlWebhookStore) SaveIncoming(webhook *model.IncomingWebhook) (*model.In
 	}
 
 	if _, err := s.GetMaster().NamedExec(`INSERT INTO IncomingWebhooks
-		(Id, CreateAt, UpdateAt, DeleteAt, UserId, ChannelId, TeamId, DisplayName, Description, Username, IconURL, ChannelLocked)
+		(Id, CreateAt, UpdateAt, DeleteAt, UserId, ChannelId, TeamId, DisplayName, Description, Username, IconURL, ChannelLocked, Adapter)
 		VALUES
-		(:Id, :CreateAt, :UpdateAt, :DeleteAt, :UserId, :ChannelId, :TeamId, :DisplayName, :Description, :Username, :IconURL, :ChannelLocked)`, webhook); err != nil {
+		(:Id, :CreateAt, :UpdateAt, :DeleteAt, :UserId, :ChannelId, :TeamId, :DisplayName, :Description, :Username, :IconURL, :ChannelLocked, :Adapter)`, webhook); err != nil {
 		return nil, errors.Wrapf(err, "failed to save IncomingWebhook with id=%s", webhook.Id)
 	}
 
@@ -102,7 +144,7 @@ func (s SqlWebhookStore) UpdateIncoming(hook *model.IncomingWebhook) (*model.Inc
 
 	_, err := s.GetMaster().NamedExec(`UPDATE IncomingWebhooks SET
 			CreateAt=:CreateAt, UpdateAt=:UpdateAt, DeleteAt=:DeleteAt, ChannelId=:ChannelId, TeamId=:TeamId, DisplayName=:DisplayName,
-			Description=:Description, Username=:Username, IconURL=:IconURL, ChannelLocked=:ChannelLocked
+			Description=:Description, Username=:Username, IconURL=:IconURL, ChannelLocked=:ChannelLocked, Adapter=:Adapter
 			WHERE Id=:Id`, hook)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to update IncomingWebhook with id=%s", hook.Id)
@@ -136,10 +178,18 @@ func (s SqlWebhookStore) DeleteIncoming(webhookId string, time int64) error {
 		return errors.Wrapf(err, "failed to update IncomingWebhook with id=%s", webhookId)
 	}
 
+	if _, err := s.GetMaster().Exec("DELETE FROM IncomingWebhookThreads WHERE HookId = ?", webhookId); err != nil {
+		return errors.Wrapf(err, "failed to delete IncomingWebhookThreads with hookId=%s", webhookId)
+	}
+
 	return nil
 }
 
 func (s SqlWebhookStore) PermanentDeleteIncomingByUser(userId string) error {
+	if _, err := s.GetMaster().Exec("DELETE FROM IncomingWebhookThreads WHERE HookId IN (SELECT Id FROM IncomingWebhooks WHERE UserId = ?)", userId); err != nil {
+		return errors.Wrapf(err, "failed to delete IncomingWebhookThreads with userId=%s", userId)
+	}
+
 	_, err := s.GetMaster().Exec("DELETE FROM IncomingWebhooks WHERE UserId = ?", userId)
 	if err != nil {
 		return errors.Wrapf(err, "failed to delete IncomingWebhook with userId=%s", userId)
@@ -149,6 +199,10 @@ func (s SqlWebhookStore) PermanentDeleteIncomingByUser(userId string) error {
 }
 
 func (s SqlWebhookStore) PermanentDeleteIncomingByChannel(channelId string) error {
+	if _, err := s.GetMaster().Exec("DELETE FROM IncomingWebhookThreads WHERE HookId IN (SELECT Id FROM IncomingWebhooks WHERE ChannelId = ?)", channelId); err != nil {
+		return errors.Wrapf(err, "failed to delete IncomingWebhookThreads with channelId=%s", channelId)
+	}
+
 	_, err := s.GetMaster().Exec("DELETE FROM IncomingWebhooks WHERE ChannelId = ?", channelId)
 	if err != nil {
 		return errors.Wrapf(err, "failed to delete IncomingWebhook with channelId=%s", channelId)
@@ -157,6 +211,42 @@ func (s SqlWebhookStore) PermanentDeleteIncomingByChannel(channelId string) erro
 	return nil
 }
 
+// GetIncomingThread returns the id of the root post of the thread the posts
+// of an incoming webhook with the given thread key are collapsed in.
+func (s SqlWebhookStore) GetIncomingThread(hookID, threadKey string) (string, error) {
+	var postID string
+	query := s.getQueryBuilder().
+		Select("PostId").
+		From("IncomingWebhookThreads").
+		Where(sq.Eq{"HookId": hookID, "ThreadKey": threadKey})
+
+	if err := s.GetMaster().GetBuilder(&postID, query); err != nil {
+		if err == sql.ErrNoRows {
+			return "", store.NewErrNotFound("IncomingWebhookThread", threadKey)
+		}
+		return "", errors.Wrapf(err, "failed to get IncomingWebhookThread with hookId=%s", hookID)
+	}
+
+	return postID, nil
+}
+
+// SaveIncomingThread stores the root post of the thread the posts of an
+// incoming webhook with the given thread key are collapsed in, replacing
+// any previous one.
+func (s SqlWebhookStore) SaveIncomingThread(hookID, threadKey, postID string) error {
+	query := s.getQueryBuilder().
+		Insert("IncomingWebhookThreads").
+		Columns("HookId", "ThreadKey", "PostId", "CreateAt").
+		Values(hookID, threadKey, postID, model.GetMillis()).
+		SuffixExpr(sq.Expr("ON CONFLICT (HookId, ThreadKey) DO UPDATE SET PostId = excluded.PostId, CreateAt = excluded.CreateAt"))
+
+	if _, err := s.GetMaster().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to save IncomingWebhookThread with hookId=%s", hookID)
+	}
+
+	return nil
+}
+
 func (s SqlWebhookStore) GetIncomingList(offset, limit int) ([]*model.IncomingWebhook, error) {
 	return s.GetIncomingListByUser("", offset, limit)
 }
@@ -234,10 +324,10 @@ func (s SqlWebhookStore) SaveOutgoing(webhook *model.OutgoingWebhook) (*model.Ou
 
 	if _, err := s.GetMaster().NamedExec(`INSERT INTO OutgoingWebhooks
 			(Id, Token, CreateAt, UpdateAt, DeleteAt, CreatorId, ChannelId, TeamId, TriggerWords, TriggerWhen,
-			CallbackURLs, DisplayName, Description, ContentType, Username, IconURL)
+			CallbackURLs, DisplayName, Description, ContentType, Username, IconURL, Secret)
 			VALUES
 			(:Id, :Token, :CreateAt, :UpdateAt, :DeleteAt, :CreatorId, :ChannelId, :TeamId, :TriggerWords, :TriggerWhen,
-			:CallbackURLs, :DisplayName, :Description, :ContentType, :Username, :IconURL)`, webhook); err != nil {
+			:CallbackURLs, :DisplayName, :Description, :ContentType, :Username, :IconURL, :Secret)`, webhook); err != nil {
 		return nil, errors.Wrapf(err, "failed to save OutgoingWebhook with id=%s", webhook.Id)
 	}
 
@@ -379,7 +469,7 @@ func (s SqlWebhookStore) UpdateOutgoing(hook *model.OutgoingWebhook) (*model.Out
 			CreateAt = :CreateAt, UpdateAt = :UpdateAt, DeleteAt = :DeleteAt, Token = :Token, CreatorId = :CreatorId,
 			ChannelId = :ChannelId, TeamId = :TeamId, TriggerWords = :TriggerWords, TriggerWhen = :TriggerWhen,
 			CallbackURLs = :CallbackURLs, DisplayName = :DisplayName, Description = :Description,
-			ContentType = :ContentType, Username = :Username, IconURL = :IconURL WHERE Id = :Id`, hook)
+			ContentType = :ContentType, Username = :Username, IconURL = :IconURL, Secret = :Secret WHERE Id = :Id`, hook)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to update OutgoingWebhook with id=%s", hook.Id)
 	}
@@ -387,6 +477,248 @@ func (s SqlWebhookStore) UpdateOutgoing(hook *model.OutgoingWebhook) (*model.Out
 	return hook, nil
 }
 
+func (s SqlWebhookStore) SaveOutgoingDelivery(delivery *model.OutgoingWebhookDelivery) (*model.OutgoingWebhookDelivery, error) {
+	if delivery.Id != "" {
+		return nil, store.NewErrInvalidInput("OutgoingWebhookDelivery", "id", delivery.Id)
+	}
+
+	delivery.PreSave()
+	if err := delivery.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.GetMaster().NamedExec(`INSERT INTO OutgoingWebhookDeliveries
+			(Id, HookId, PostId, ChannelId, URL, ContentType, Payload, Status, Attempts, CreateAt, UpdateAt,
+			LastAttemptAt, NextAttemptAt, ResponseCode, Error, DeliveredAt)
+			VALUES
+			(:Id, :HookId, :PostId, :ChannelId, :URL, :ContentType, :Payload, :Status, :Attempts, :CreateAt, :UpdateAt,
+			:LastAttemptAt, :NextAttemptAt, :ResponseCode, :Error, :DeliveredAt)`, delivery); err != nil {
+		return nil, errors.Wrapf(err, "failed to save OutgoingWebhookDelivery with id=%s", delivery.Id)
+	}
+
+	return delivery, nil
+}
+
+func (s SqlWebhookStore) UpdateOutgoingDelivery(delivery *model.OutgoingWebhookDelivery) (*model.OutgoingWebhookDelivery, error) {
+	delivery.PreUpdate()
+	if err := delivery.IsValid(); err != nil {
+		return nil, err
+	}
+
+	result, err := s.GetMaster().NamedExec(`UPDATE OutgoingWebhookDeliveries SET
+			Status = :Status, Attempts = :Attempts, UpdateAt = :UpdateAt, LastAttemptAt = :LastAttemptAt,
+			NextAttemptAt = :NextAttemptAt, ResponseCode = :ResponseCode, Error = :Error, DeliveredAt = :DeliveredAt
+			WHERE Id = :Id`, delivery)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update OutgoingWebhookDelivery with id=%s", delivery.Id)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get rows affected for OutgoingWebhookDelivery with id=%s", delivery.Id)
+	}
+	if rowsAffected == 0 {
+		return nil, store.NewErrNotFound("OutgoingWebhookDelivery", delivery.Id)
+	}
+
+	return delivery, nil
+}
+
+func (s SqlWebhookStore) GetOutgoingDelivery(id string) (*model.OutgoingWebhookDelivery, error) {
+	var delivery model.OutgoingWebhookDelivery
+
+	query := s.outgoingWebhookDeliverySelectQuery.Where(sq.Eq{"Id": id})
+
+	if err := s.GetMaster().GetBuilder(&delivery, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("OutgoingWebhookDelivery", id)
+		}
+
+		return nil, errors.Wrapf(err, "failed to get OutgoingWebhookDelivery with id=%s", id)
+	}
+
+	return &delivery, nil
+}
+
+func (s SqlWebhookStore) GetOutgoingDeliveriesByHook(hookID string, status string, offset, limit int) ([]*model.OutgoingWebhookDelivery, error) {
+	deliveries := []*model.OutgoingWebhookDelivery{}
+
+	query := s.outgoingWebhookDeliverySelectQuery.
+		Where(sq.Eq{"HookId": hookID}).
+		OrderBy("CreateAt DESC", "Id DESC").
+		Limit(uint64(limit)).
+		Offset(uint64(offset))
+
+	if status != "" {
+		query = query.Where(sq.Eq{"Status": status})
+	}
+
+	if err := s.GetReplica().SelectBuilder(&deliveries, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to find OutgoingWebhookDeliveries with hookId=%s", hookID)
+	}
+
+	return deliveries, nil
+}
+
+func (s SqlWebhookStore) GetDueOutgoingDeliveries(now int64, limit int) ([]*model.OutgoingWebhookDelivery, error) {
+	deliveries := []*model.OutgoingWebhookDelivery{}
+
+	query := s.outgoingWebhookDeliverySelectQuery.
+		Where(sq.And{
+			sq.Eq{"Status": model.OutgoingWebhookDeliveryStatusPending},
+			sq.LtOrEq{"NextAttemptAt": now},
+		}).
+		OrderBy("NextAttemptAt ASC").
+		Limit(uint64(limit))
+
+	if err := s.GetMaster().SelectBuilder(&deliveries, query); err != nil {
+		return nil, errors.Wrap(err, "failed to find due OutgoingWebhookDeliveries")
+	}
+
+	return deliveries, nil
+}
+
+// ClaimOutgoingDelivery moves the next attempt of a pending delivery to
+// leaseUntil, so no other server picks it up meanwhile. It fails to claim the
+// delivery if its next attempt time isn't nextAttemptAt anymore.
+func (s SqlWebhookStore) ClaimOutgoingDelivery(id string, nextAttemptAt, leaseUntil int64) (bool, error) {
+	query := s.getQueryBuilder().
+		Update("OutgoingWebhookDeliveries").
+		Set("NextAttemptAt", leaseUntil).
+		Where(sq.And{
+			sq.Eq{"Id": id},
+			sq.Eq{"Status": model.OutgoingWebhookDeliveryStatusPending},
+			sq.Eq{"NextAttemptAt": nextAttemptAt},
+		})
+
+	result, err := s.GetMaster().ExecBuilder(query)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to claim OutgoingWebhookDelivery with id=%s", id)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrapf(err, "unable to get rows affected for OutgoingWebhookDelivery with id=%s", id)
+	}
+
+	return rowsAffected == 1, nil
+}
+
+func (s SqlWebhookStore) PermanentDeleteOutgoingDeliveriesBatch(endTime int64, limit int64) (int64, error) {
+	query := "DELETE FROM OutgoingWebhookDeliveries WHERE Id = any (array (SELECT Id FROM OutgoingWebhookDeliveries WHERE CreateAt < ? AND Status <> ? LIMIT ?))"
+
+	result, err := s.GetMaster().Exec(query, endTime, model.OutgoingWebhookDeliveryStatusPending, limit)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete OutgoingWebhookDeliveries")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get rows affected for deleted OutgoingWebhookDeliveries")
+	}
+
+	return rowsAffected, nil
+}
+
+func (s SqlWebhookStore) SaveEventSubscription(subscription *model.EventSubscription) (*model.EventSubscription, error) {
+	if subscription.Id != "" {
+		return nil, store.NewErrInvalidInput("EventSubscription", "id", subscription.Id)
+	}
+
+	subscription.PreSave()
+	if err := subscription.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.GetMaster().NamedExec(`INSERT INTO EventSubscriptions
+			(Id, CreateAt, UpdateAt, DeleteAt, CreatorId, DisplayName, Description, URL, EventTypes, TeamId, ChannelId, Secret)
+			VALUES
+			(:Id, :CreateAt, :UpdateAt, :DeleteAt, :CreatorId, :DisplayName, :Description, :URL, :EventTypes, :TeamId, :ChannelId, :Secret)`, subscription); err != nil {
+		return nil, errors.Wrapf(err, "failed to save EventSubscription with id=%s", subscription.Id)
+	}
+
+	return subscription, nil
+}
+
+func (s SqlWebhookStore) GetEventSubscription(id string) (*model.EventSubscription, error) {
+	var subscription model.EventSubscription
+
+	query := s.eventSubscriptionSelectQuery.
+		Where(sq.And{
+			sq.Eq{"Id": id},
+			sq.Eq{"DeleteAt": 0},
+		})
+
+	if err := s.GetReplica().GetBuilder(&subscription, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("EventSubscription", id)
+		}
+
+		return nil, errors.Wrapf(err, "failed to get EventSubscription with id=%s", id)
+	}
+
+	return &subscription, nil
+}
+
+func (s SqlWebhookStore) GetEventSubscriptions(offset, limit int) ([]*model.EventSubscription, error) {
+	subscriptions := []*model.EventSubscription{}
+
+	query := s.eventSubscriptionSelectQuery.
+		Where(sq.Eq{"DeleteAt": 0}).
+		OrderBy("CreateAt ASC", "Id ASC").
+		Limit(uint64(limit)).
+		Offset(uint64(offset))
+
+	if err := s.GetReplica().SelectBuilder(&subscriptions, query); err != nil {
+		return nil, errors.Wrap(err, "failed to find EventSubscriptions")
+	}
+
+	return subscriptions, nil
+}
+
+func (s SqlWebhookStore) GetEventSubscriptionsForEvent(eventType string, allowFromCache bool) ([]*model.EventSubscription, error) {
+	subscriptions := []*model.EventSubscription{}
+
+	// EventTypes holds a JSON array of event type names, none of which is
+	// contained in another one.
+	query := s.eventSubscriptionSelectQuery.
+		Where(sq.And{
+			sq.Eq{"DeleteAt": 0},
+			sq.Like{"EventTypes": "%\"" + eventType + "\"%"},
+		})
+
+	if err := s.GetReplica().SelectBuilder(&subscriptions, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to find EventSubscriptions with eventType=%s", eventType)
+	}
+
+	return subscriptions, nil
+}
+
+func (s SqlWebhookStore) UpdateEventSubscription(subscription *model.EventSubscription) (*model.EventSubscription, error) {
+	subscription.PreUpdate()
+	if err := subscription.IsValid(); err != nil {
+		return nil, err
+	}
+
+	_, err := s.GetMaster().NamedExec(`UPDATE EventSubscriptions SET
+			UpdateAt = :UpdateAt, DisplayName = :DisplayName, Description = :Description, URL = :URL,
+			EventTypes = :EventTypes, TeamId = :TeamId, ChannelId = :ChannelId, Secret = :Secret WHERE Id = :Id`, subscription)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update EventSubscription with id=%s", subscription.Id)
+	}
+
+	return subscription, nil
+}
+
+func (s SqlWebhookStore) DeleteEventSubscription(id string, time int64) error {
+	_, err := s.GetMaster().Exec("UPDATE EventSubscriptions SET DeleteAt = ?, UpdateAt = ? WHERE Id = ?", time, time, id)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete EventSubscription with id=%s", id)
+	}
+
+	return nil
+}
+
 func (s SqlWebhookStore) AnalyticsIncomingCount(teamID string, userID string) (int64, error) {
 	queryBuilder :=
 		s.getQueryBuilder().
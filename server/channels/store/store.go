@@ -91,6 +91,12 @@ type Store interface {
 	DesktopTokens() DesktopTokensStore
 	ChannelBookmark() ChannelBookmarkStore
 	ScheduledPost() ScheduledPostStore
+	Reminder() ReminderStore
+	UserDevice() UserDeviceStore
+	ConfigRevision() ConfigRevisionStore
+	AuditLog() AuditLogStore
+	Poll() PollStore
+	WebAuthnCredential() WebAuthnCredentialStore
 	PropertyGroup() PropertyGroupStore
 	PropertyField() PropertyFieldStore
 	PropertyValue() PropertyValueStore
@@ -330,6 +336,7 @@ type ChannelMemberHistoryStore interface {
 	DeleteOrphanedRows(limit int) (deleted int64, err error)
 	PermanentDeleteBatch(endTime int64, limit int64) (int64, error)
 	GetChannelsLeftSince(userID string, since int64) ([]string, error)
+	GetUsersWithMembershipChangesSince(since int64) ([]string, error)
 }
 type ThreadStore interface {
 	GetThreadFollowers(threadID string, fetchOnlyActive bool) ([]string, error)
@@ -405,8 +412,10 @@ type PostStore interface {
 	GetOldest() (*model.Post, error)
 	GetMaxPostSize() int
 	GetParentsForExportAfter(limit int, afterID string, includeArchivedChannels bool) ([]*model.PostForExport, error)
+	GetParentsForExportByIds(rootIDs []string, includeArchivedChannels bool) ([]*model.PostForExport, error)
 	GetRepliesForExport(parentID string) ([]*model.ReplyForExport, error)
 	GetDirectPostParentsForExportAfter(limit int, afterID string, includeArchivedChannels bool) ([]*model.DirectPostForExport, error)
+	GetDirectPostParentsForExportByIds(rootIDs []string, includeArchivedChannels bool) ([]*model.DirectPostForExport, error)
 	SearchPostsForUser(rctx request.CTX, paramsList []*model.SearchParams, userID, teamID string, page, perPage int) (*model.PostSearchResults, error)
 	GetOldestEntityCreationTime() (int64, error)
 	HasAutoResponsePostByUserSince(options model.GetPostsSinceOptions, userID string) (bool, error)
@@ -481,6 +490,10 @@ type UserStore interface {
 	SearchInGroup(groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error)
 	SearchNotInGroup(groupID string, term string, options *model.UserSearchOptions) ([]*model.User, error)
 	AnalyticsGetInactiveUsersCount() (int64, error)
+	// AnalyticsGetPasswordHashCounts returns the number of users with a password, including
+	// deactivated ones, by the PHC header of their password hash: the hashing function and its
+	// parameters, without the salt and the hash.
+	AnalyticsGetPasswordHashCounts() (map[string]int64, error)
 	AnalyticsGetExternalUsers(hostDomain string) (bool, error)
 	AnalyticsGetSystemAdminCount() (int64, error)
 	AnalyticsGetGuestCount() (int64, error)
@@ -627,6 +640,8 @@ type WebhookStore interface {
 	DeleteIncoming(webhookID string, timestamp int64) error
 	PermanentDeleteIncomingByChannel(channelID string) error
 	PermanentDeleteIncomingByUser(userID string) error
+	GetIncomingThread(hookID, threadKey string) (string, error)
+	SaveIncomingThread(hookID, threadKey, postID string) error
 
 	SaveOutgoing(webhook *model.OutgoingWebhook) (*model.OutgoingWebhook, error)
 	GetOutgoing(id string) (*model.OutgoingWebhook, error)
@@ -641,6 +656,21 @@ type WebhookStore interface {
 	PermanentDeleteOutgoingByUser(userID string) error
 	UpdateOutgoing(hook *model.OutgoingWebhook) (*model.OutgoingWebhook, error)
 
+	SaveOutgoingDelivery(delivery *model.OutgoingWebhookDelivery) (*model.OutgoingWebhookDelivery, error)
+	UpdateOutgoingDelivery(delivery *model.OutgoingWebhookDelivery) (*model.OutgoingWebhookDelivery, error)
+	GetOutgoingDelivery(id string) (*model.OutgoingWebhookDelivery, error)
+	GetOutgoingDeliveriesByHook(hookID string, status string, offset, limit int) ([]*model.OutgoingWebhookDelivery, error)
+	GetDueOutgoingDeliveries(now int64, limit int) ([]*model.OutgoingWebhookDelivery, error)
+	ClaimOutgoingDelivery(id string, nextAttemptAt, leaseUntil int64) (bool, error)
+	PermanentDeleteOutgoingDeliveriesBatch(endTime int64, limit int64) (int64, error)
+
+	SaveEventSubscription(subscription *model.EventSubscription) (*model.EventSubscription, error)
+	GetEventSubscription(id string) (*model.EventSubscription, error)
+	GetEventSubscriptions(offset, limit int) ([]*model.EventSubscription, error)
+	GetEventSubscriptionsForEvent(eventType string, allowFromCache bool) ([]*model.EventSubscription, error)
+	UpdateEventSubscription(subscription *model.EventSubscription) (*model.EventSubscription, error)
+	DeleteEventSubscription(id string, timestamp int64) error
+
 	AnalyticsIncomingCount(teamID string, userID string) (int64, error)
 	AnalyticsOutgoingCount(teamID string) (int64, error)
 	InvalidateWebhookCache(webhook string)
@@ -782,6 +812,9 @@ type JobStore interface {
 	UpdateOptimistically(job *model.Job, currentStatus string) (bool, error)
 	UpdateStatus(id string, status string) (*model.Job, error)
 	UpdateStatusOptimistically(id string, currentStatus string, newStatus string) (*model.Job, error)
+	// UpdateLastActivityOptimistically refreshes the last activity of the job if it
+	// still has the given status, leaving the rest of the job alone.
+	UpdateLastActivityOptimistically(id string, currentStatus string) (bool, error)
 	Get(rctx request.CTX, id string) (*model.Job, error)
 	GetAllByType(rctx request.CTX, jobType string) ([]*model.Job, error)
 	GetAllByTypeAndStatus(rctx request.CTX, jobType string, status string) ([]*model.Job, error)
@@ -807,6 +840,9 @@ type UserAccessTokenStore interface {
 	Search(term string) ([]*model.UserAccessToken, error)
 	UpdateTokenEnable(tokenID string) error
 	UpdateTokenDisable(tokenID string) error
+	UpdateLastUsed(tokenID string, lastUsedAt int64, ipAddress string) error
+	GetExpiringForNotification(before int64, limit int) ([]*model.UserAccessToken, error)
+	MarkExpiryNotified(tokenID string) error
 }
 
 type PluginStore interface {
@@ -1089,6 +1125,78 @@ type ScheduledPostStore interface {
 	PermanentDeleteByUser(userId string) error
 }
 
+type ReminderStore interface {
+	Save(reminder *model.Reminder) (*model.Reminder, error)
+	Get(id string) (*model.Reminder, error)
+	Update(reminder *model.Reminder) (*model.Reminder, error)
+	GetForCreator(creatorID string, offset, limit int) ([]*model.Reminder, error)
+	GetDue(before int64, limit int) ([]*model.Reminder, error)
+	// Reschedule moves a reminder due at fireAt to nextFireAt, and returns
+	// false when the reminder was rescheduled or deleted in the meantime,
+	// such as by another node of the cluster.
+	Reschedule(id string, fireAt, nextFireAt int64) (bool, error)
+	Delete(id string, deleteAt int64) error
+	PermanentDeleteByUser(userID string) error
+}
+
+type PollStore interface {
+	Save(poll *model.Poll) (*model.Poll, error)
+	Get(id string) (*model.Poll, error)
+	Update(poll *model.Poll) (*model.Poll, error)
+	// GetExpired returns the open polls with a close time before the given
+	// one.
+	GetExpired(before int64, limit int) ([]*model.Poll, error)
+	// SaveVote saves the vote of a user, replacing the other votes of the
+	// user for the poll when replaceOthers is true.
+	SaveVote(vote *model.PollVote, replaceOthers bool) error
+	DeleteVote(pollID, optionID, userID string) error
+	GetVotes(pollID string) ([]*model.PollVote, error)
+	PermanentDeleteVotesByUser(userID string) error
+}
+
+type WebAuthnCredentialStore interface {
+	Save(credential *model.WebAuthnCredential) (*model.WebAuthnCredential, error)
+	Get(id string) (*model.WebAuthnCredential, error)
+	GetByCredentialId(credentialID string) (*model.WebAuthnCredential, error)
+	GetForUser(userID string) ([]*model.WebAuthnCredential, error)
+	// UpdateSignCount records a use of the credential, along with the new
+	// value of its signature counter.
+	UpdateSignCount(id string, signCount, lastUsedAt int64) error
+	Delete(id string) error
+	PermanentDeleteByUser(userID string) error
+}
+
+type UserDeviceStore interface {
+	// Upsert saves a device a user logged in from, or updates when it was
+	// last seen, and returns true when the user never used the device before.
+	Upsert(device *model.UserDevice) (bool, error)
+	GetForUser(userID string) ([]*model.UserDevice, error)
+	PermanentDeleteByUser(userID string) error
+}
+
+type ConfigRevisionStore interface {
+	Save(revision *model.ConfigRevision) (*model.ConfigRevision, error)
+	Get(id string) (*model.ConfigRevision, error)
+	// GetAll returns the revisions from the latest, without their value.
+	GetAll(offset, limit int) ([]*model.ConfigRevision, error)
+	// GetPrevious returns the latest revision created before createAt.
+	GetPrevious(createAt int64) (*model.ConfigRevision, error)
+	PermanentDeleteAllButLatest(keep int) error
+}
+
+type AuditLogStore interface {
+	// Save chains the audit log to the latest one, setting its sequence and its
+	// hashes, keyed with hashKey.
+	Save(auditLog *model.AuditLog, hashKey []byte) (*model.AuditLog, error)
+	// Search returns the audit logs matching the search, from the latest.
+	Search(search *model.AuditLogSearch) ([]*model.AuditLog, error)
+	// GetChain returns the audit logs following the given sequence, in order.
+	GetChain(afterSequence int64, limit int) ([]*model.AuditLog, error)
+	// PermanentDeleteUntil deletes the audit logs up to the given sequence, always
+	// keeping the latest one.
+	PermanentDeleteUntil(sequence int64) (int64, error)
+}
+
 type PropertyGroupStore interface {
 	Register(name string) (*model.PropertyGroup, error)
 	Get(name string) (*model.PropertyGroup, error)
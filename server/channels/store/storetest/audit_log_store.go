@@ -0,0 +1,130 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestAuditLogStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGetChain", func(t *testing.T) { testAuditLogStoreSaveAndGetChain(t, rctx, ss) })
+	t.Run("Search", func(t *testing.T) { testAuditLogStoreSearch(t, rctx, ss) })
+	t.Run("PermanentDeleteUntil", func(t *testing.T) { testAuditLogStorePermanentDeleteUntil(t, rctx, ss) })
+}
+
+var testAuditLogHashKey = []byte(model.NewRandomString(model.AuditLogSigningKeyMinLength))
+
+func saveTestAuditLog(t *testing.T, ss store.Store, rec *model.AuditRecord, createAt int64) *model.AuditLog {
+	t.Helper()
+
+	auditLog, err := model.NewAuditLog(rec)
+	require.NoError(t, err)
+	auditLog.CreateAt = createAt
+
+	auditLog, err = ss.AuditLog().Save(auditLog, testAuditLogHashKey)
+	require.NoError(t, err)
+	return auditLog
+}
+
+func testAuditLogStoreSaveAndGetChain(t *testing.T, rctx request.CTX, ss store.Store) {
+	first := saveTestAuditLog(t, ss, &model.AuditRecord{EventName: model.AuditEventUpdateConfig, Status: model.AuditStatusSuccess}, 0)
+	second := saveTestAuditLog(t, ss, &model.AuditRecord{EventName: model.AuditEventPatchConfig, Status: model.AuditStatusFail}, 0)
+	require.NotEmpty(t, first.Id)
+	require.NotZero(t, first.CreateAt)
+	assert.Equal(t, first.Sequence+1, second.Sequence)
+	assert.Equal(t, first.Hash, second.PrevHash)
+	assert.Nil(t, second.IsValid())
+
+	_, err := ss.AuditLog().Save(second, testAuditLogHashKey)
+	require.Error(t, err, "saving an audit log with an id must fail")
+
+	chain, err := ss.AuditLog().GetChain(first.Sequence-1, 10)
+	require.NoError(t, err)
+	require.Len(t, chain, 2)
+	assert.Equal(t, first, chain[0])
+	assert.Equal(t, second, chain[1])
+	assert.Empty(t, chain[1].Verify(chain[0], testAuditLogHashKey))
+
+	chain, err = ss.AuditLog().GetChain(second.Sequence, 10)
+	require.NoError(t, err)
+	assert.Empty(t, chain)
+}
+
+func testAuditLogStoreSearch(t *testing.T, rctx request.CTX, ss store.Store) {
+	actorID := model.NewId()
+	channelID := model.NewId()
+	since := model.GetMillis()
+
+	removed := saveTestAuditLog(t, ss, &model.AuditRecord{
+		EventName: model.AuditEventRemoveChannelMember,
+		Status:    model.AuditStatusSuccess,
+		Actor:     model.AuditEventActor{UserId: actorID},
+		EventData: model.AuditEventData{Parameters: map[string]any{"channel_id": channelID, "user_id": model.NewId()}},
+	}, since+1)
+	added := saveTestAuditLog(t, ss, &model.AuditRecord{
+		EventName: model.AuditEventAddChannelMember,
+		Status:    model.AuditStatusSuccess,
+		Actor:     model.AuditEventActor{UserId: actorID},
+		EventData: model.AuditEventData{Parameters: map[string]any{"channel_id": channelID}},
+	}, since+2)
+	other := saveTestAuditLog(t, ss, &model.AuditRecord{
+		EventName: model.AuditEventRemoveChannelMember,
+		Status:    model.AuditStatusSuccess,
+		Actor:     model.AuditEventActor{UserId: model.NewId()},
+	}, since+3)
+
+	search := func(search model.AuditLogSearch) []string {
+		t.Helper()
+		search.PerPage = 10
+		auditLogs, err := ss.AuditLog().Search(&search)
+		require.NoError(t, err)
+		ids := []string{}
+		for _, auditLog := range auditLogs {
+			ids = append(ids, auditLog.Id)
+		}
+		return ids
+	}
+
+	assert.Equal(t, []string{added.Id, removed.Id}, search(model.AuditLogSearch{UserId: actorID}))
+	assert.Equal(t, []string{added.Id, removed.Id}, search(model.AuditLogSearch{ObjectId: channelID}))
+	assert.Equal(t, []string{removed.Id}, search(model.AuditLogSearch{UserId: actorID, EventName: model.AuditEventRemoveChannelMember}))
+	assert.Equal(t, []string{other.Id, added.Id}, search(model.AuditLogSearch{Since: since + 2, Until: since + 3}))
+	assert.Empty(t, search(model.AuditLogSearch{ObjectId: model.NewId()}))
+
+	page, err := ss.AuditLog().Search(&model.AuditLogSearch{UserId: actorID, Page: 1, PerPage: 1})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, removed.Id, page[0].Id)
+}
+
+func testAuditLogStorePermanentDeleteUntil(t *testing.T, rctx request.CTX, ss store.Store) {
+	_, err := ss.AuditLog().PermanentDeleteUntil(math.MaxInt64)
+	require.NoError(t, err)
+
+	old := saveTestAuditLog(t, ss, &model.AuditRecord{EventName: model.AuditEventUpdateConfig}, 1000)
+	older := saveTestAuditLog(t, ss, &model.AuditRecord{EventName: model.AuditEventUpdateConfig}, 2000)
+	latest := saveTestAuditLog(t, ss, &model.AuditRecord{EventName: model.AuditEventUpdateConfig}, 3000)
+
+	deleted, err := ss.AuditLog().PermanentDeleteUntil(old.Sequence)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted, "only up to the sequence must be deleted")
+
+	deleted, err = ss.AuditLog().PermanentDeleteUntil(math.MaxInt64)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	chain, err := ss.AuditLog().GetChain(old.Sequence-1, 10)
+	require.NoError(t, err)
+	require.Len(t, chain, 1, "the latest audit log must be kept")
+	assert.Equal(t, latest.Id, chain[0].Id)
+	assert.Equal(t, older.Hash, chain[0].PrevHash)
+}
@@ -25,6 +25,7 @@ func TestChannelMemberHistoryStore(t *testing.T, rctx request.CTX, ss store.Stor
 	t.Run("TestPermanentDeleteBatch", func(t *testing.T) { testPermanentDeleteBatch(t, rctx, ss) })
 	t.Run("TestPermanentDeleteBatchForRetentionPolicies", func(t *testing.T) { testPermanentDeleteBatchForRetentionPolicies(t, rctx, ss) })
 	t.Run("TestGetChannelsLeftSince", func(t *testing.T) { testGetChannelsLeftSince(t, rctx, ss) })
+	t.Run("TestGetUsersWithMembershipChangesSince", func(t *testing.T) { testGetUsersWithMembershipChangesSince(t, rctx, ss) })
 	t.Run("TestDeleteOrphanedRows", func(t *testing.T) { testDeleteOrphanedRows(t, rctx, ss) })
 }
 
@@ -611,6 +612,29 @@ func testGetChannelsLeftSince(t *testing.T, rctx request.CTX, ss store.Store) {
 	assert.Equal(t, []string{channel.Id}, ids)
 }
 
+func testGetUsersWithMembershipChangesSince(t *testing.T, rctx request.CTX, ss store.Store) {
+	channelID := model.NewId()
+	joined := model.NewId()
+	left := model.NewId()
+	unchanged := model.NewId()
+
+	// Far in the future, so that other tests don't interfere.
+	since := model.GetMillis() + 1000000
+
+	require.NoError(t, ss.ChannelMemberHistory().LogJoinEvent(unchanged, channelID, since-100))
+	require.NoError(t, ss.ChannelMemberHistory().LogJoinEvent(left, channelID, since-100))
+	require.NoError(t, ss.ChannelMemberHistory().LogLeaveEvent(left, channelID, since+100))
+	require.NoError(t, ss.ChannelMemberHistory().LogJoinEvent(joined, channelID, since))
+
+	ids, err := ss.ChannelMemberHistory().GetUsersWithMembershipChangesSince(since)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{joined, left}, ids)
+
+	ids, err = ss.ChannelMemberHistory().GetUsersWithMembershipChangesSince(since + 200)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
 func testDeleteOrphanedRows(t *testing.T, rctx request.CTX, ss store.Store) {
 	// Create a channel
 	channelToKeep := &model.Channel{
@@ -0,0 +1,73 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestConfigRevisionStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGet", func(t *testing.T) { testConfigRevisionStoreSaveAndGet(t, rctx, ss) })
+	t.Run("GetAllAndGetPrevious", func(t *testing.T) { testConfigRevisionStoreGetAllAndGetPrevious(t, rctx, ss) })
+}
+
+func testConfigRevisionStoreSaveAndGet(t *testing.T, rctx request.CTX, ss store.Store) {
+	revision, err := ss.ConfigRevision().Save(&model.ConfigRevision{UserId: model.NewId(), Value: `{"TeamSettings":{"SiteName":"saved"}}`})
+	require.NoError(t, err)
+	require.NotEmpty(t, revision.Id)
+	require.NotZero(t, revision.CreateAt)
+
+	_, err = ss.ConfigRevision().Save(revision)
+	require.Error(t, err, "saving a revision with an id must fail")
+
+	_, err = ss.ConfigRevision().Save(&model.ConfigRevision{Value: "{"})
+	require.Error(t, err)
+
+	fetched, err := ss.ConfigRevision().Get(revision.Id)
+	require.NoError(t, err)
+	assert.Equal(t, revision, fetched)
+
+	_, err = ss.ConfigRevision().Get(model.NewId())
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr)
+}
+
+func testConfigRevisionStoreGetAllAndGetPrevious(t *testing.T, rctx request.CTX, ss store.Store) {
+	require.NoError(t, ss.ConfigRevision().PermanentDeleteAllButLatest(0))
+
+	var revisions []*model.ConfigRevision
+	for i := range 3 {
+		revision, err := ss.ConfigRevision().Save(&model.ConfigRevision{CreateAt: int64(1000 + i), Value: "{}"})
+		require.NoError(t, err)
+		revisions = append(revisions, revision)
+	}
+
+	all, err := ss.ConfigRevision().GetAll(0, 2)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, revisions[2].Id, all[0].Id)
+	assert.Equal(t, revisions[1].Id, all[1].Id)
+	assert.Empty(t, all[0].Value, "the values must be left out")
+
+	previous, err := ss.ConfigRevision().GetPrevious(revisions[2].CreateAt)
+	require.NoError(t, err)
+	assert.Equal(t, revisions[1], previous)
+
+	_, err = ss.ConfigRevision().GetPrevious(revisions[0].CreateAt)
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr)
+
+	require.NoError(t, ss.ConfigRevision().PermanentDeleteAllButLatest(2))
+	all, err = ss.ConfigRevision().GetAll(0, 10)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, revisions[1].Id, all[1].Id)
+}
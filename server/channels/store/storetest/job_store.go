@@ -32,6 +32,7 @@ func TestJobStore(t *testing.T, rctx request.CTX, ss store.Store) {
 	t.Run("GetCountByStatusAndType", func(t *testing.T) { testJobStoreGetCountByStatusAndType(t, rctx, ss) })
 	t.Run("JobUpdateOptimistically", func(t *testing.T) { testJobUpdateOptimistically(t, rctx, ss) })
 	t.Run("JobUpdateStatusUpdateStatusOptimistically", func(t *testing.T) { testJobUpdateStatusUpdateStatusOptimistically(t, rctx, ss) })
+	t.Run("JobUpdateLastActivityOptimistically", func(t *testing.T) { testJobUpdateLastActivityOptimistically(t, rctx, ss) })
 	t.Run("JobDelete", func(t *testing.T) { testJobDelete(t, rctx, ss) })
 	t.Run("JobCleanup", func(t *testing.T) { testJobCleanup(t, rctx, ss) })
 }
@@ -685,6 +686,37 @@ func testJobUpdateOptimistically(t *testing.T, rctx request.CTX, ss store.Store)
 	require.Equal(t, updatedJob.Data["Foo"], job.Data["Foo"])
 }
 
+func testJobUpdateLastActivityOptimistically(t *testing.T, rctx request.CTX, ss store.Store) {
+	job := &model.Job{
+		Id:             model.NewId(),
+		Type:           model.JobTypeImportProcess,
+		CreateAt:       model.GetMillis(),
+		LastActivityAt: model.GetMillis(),
+		Status:         model.JobStatusInProgress,
+		Data:           map[string]string{"checkpoint_line": "10"},
+	}
+
+	_, err := ss.Job().Save(job)
+	require.NoError(t, err)
+	defer ss.Job().Delete(job.Id)
+
+	time.Sleep(2 * time.Millisecond)
+
+	updated, err := ss.Job().UpdateLastActivityOptimistically(job.Id, model.JobStatusPending)
+	require.NoError(t, err)
+	require.False(t, updated, "a job with another status should not be updated")
+
+	updated, err = ss.Job().UpdateLastActivityOptimistically(job.Id, model.JobStatusInProgress)
+	require.NoError(t, err)
+	require.True(t, updated)
+
+	updatedJob, err := ss.Job().Get(rctx, job.Id)
+	require.NoError(t, err)
+	require.Greater(t, updatedJob.LastActivityAt, job.LastActivityAt)
+	require.Equal(t, job.Status, updatedJob.Status)
+	require.Equal(t, job.Data, updatedJob.Data)
+}
+
 func testJobUpdateStatusUpdateStatusOptimistically(t *testing.T, rctx request.CTX, ss store.Store) {
 	job := &model.Job{
 		Id:       model.NewId(),
@@ -132,6 +132,36 @@ func (_m *ChannelMemberHistoryStore) GetUsersInChannelDuring(startTime int64, en
 	return r0, r1
 }
 
+// GetUsersWithMembershipChangesSince provides a mock function with given fields: since
+func (_m *ChannelMemberHistoryStore) GetUsersWithMembershipChangesSince(since int64) ([]string, error) {
+	ret := _m.Called(since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsersWithMembershipChangesSince")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) ([]string, error)); ok {
+		return rf(since)
+	}
+	if rf, ok := ret.Get(0).(func(int64) []string); ok {
+		r0 = rf(since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LogJoinEvent provides a mock function with given fields: userID, channelID, joinTime
 func (_m *ChannelMemberHistoryStore) LogJoinEvent(userID string, channelID string, joinTime int64) error {
 	ret := _m.Called(userID, channelID, joinTime)
@@ -419,6 +419,34 @@ func (_m *JobStore) SaveOnce(job *model.Job) (*model.Job, error) {
 	return r0, r1
 }
 
+// UpdateLastActivityOptimistically provides a mock function with given fields: id, currentStatus
+func (_m *JobStore) UpdateLastActivityOptimistically(id string, currentStatus string) (bool, error) {
+	ret := _m.Called(id, currentStatus)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLastActivityOptimistically")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (bool, error)); ok {
+		return rf(id, currentStatus)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(id, currentStatus)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(id, currentStatus)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateOptimistically provides a mock function with given fields: job, currentStatus
 func (_m *JobStore) UpdateOptimistically(job *model.Job, currentStatus string) (bool, error) {
 	ret := _m.Called(job, currentStatus)
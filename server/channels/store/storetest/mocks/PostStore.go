@@ -219,6 +219,36 @@ func (_m *PostStore) GetDirectPostParentsForExportAfter(limit int, afterID strin
 	return r0, r1
 }
 
+// GetDirectPostParentsForExportByIds provides a mock function with given fields: rootIDs, includeArchivedChannels
+func (_m *PostStore) GetDirectPostParentsForExportByIds(rootIDs []string, includeArchivedChannels bool) ([]*model.DirectPostForExport, error) {
+	ret := _m.Called(rootIDs, includeArchivedChannels)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDirectPostParentsForExportByIds")
+	}
+
+	var r0 []*model.DirectPostForExport
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string, bool) ([]*model.DirectPostForExport, error)); ok {
+		return rf(rootIDs, includeArchivedChannels)
+	}
+	if rf, ok := ret.Get(0).(func([]string, bool) []*model.DirectPostForExport); ok {
+		r0 = rf(rootIDs, includeArchivedChannels)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.DirectPostForExport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string, bool) error); ok {
+		r1 = rf(rootIDs, includeArchivedChannels)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetEditHistoryForPost provides a mock function with given fields: postID
 func (_m *PostStore) GetEditHistoryForPost(postID string) ([]*model.Post, error) {
 	ret := _m.Called(postID)
@@ -491,6 +521,36 @@ func (_m *PostStore) GetParentsForExportAfter(limit int, afterID string, include
 	return r0, r1
 }
 
+// GetParentsForExportByIds provides a mock function with given fields: rootIDs, includeArchivedChannels
+func (_m *PostStore) GetParentsForExportByIds(rootIDs []string, includeArchivedChannels bool) ([]*model.PostForExport, error) {
+	ret := _m.Called(rootIDs, includeArchivedChannels)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetParentsForExportByIds")
+	}
+
+	var r0 []*model.PostForExport
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string, bool) ([]*model.PostForExport, error)); ok {
+		return rf(rootIDs, includeArchivedChannels)
+	}
+	if rf, ok := ret.Get(0).(func([]string, bool) []*model.PostForExport); ok {
+		r0 = rf(rootIDs, includeArchivedChannels)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PostForExport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string, bool) error); ok {
+		r1 = rf(rootIDs, includeArchivedChannels)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPostAfterTime provides a mock function with given fields: channelID, timestamp, collapsedThreads
 func (_m *PostStore) GetPostAfterTime(channelID string, timestamp int64, collapsedThreads bool) (*model.Post, error) {
 	ret := _m.Called(channelID, timestamp, collapsedThreads)
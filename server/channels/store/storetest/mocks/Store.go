@@ -84,6 +84,24 @@ func (_m *Store) Audit() store.AuditStore {
 	return r0
 }
 
+// AuditLog provides a mock function with no fields
+func (_m *Store) AuditLog() store.AuditLogStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuditLog")
+	}
+
+	var r0 store.AuditLogStore
+	if rf, ok := ret.Get(0).(func() store.AuditLogStore); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(store.AuditLogStore)
+	}
+
+	return r0
+}
+
 // Bot provides a mock function with no fields
 func (_m *Store) Bot() store.BotStore {
 	ret := _m.Called()
@@ -269,6 +287,24 @@ func (_m *Store) Compliance() store.ComplianceStore {
 	return r0
 }
 
+// ConfigRevision provides a mock function with no fields
+func (_m *Store) ConfigRevision() store.ConfigRevisionStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfigRevision")
+	}
+
+	var r0 store.ConfigRevisionStore
+	if rf, ok := ret.Get(0).(func() store.ConfigRevisionStore); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(store.ConfigRevisionStore)
+	}
+
+	return r0
+}
+
 // Context provides a mock function with no fields
 func (_m *Store) Context() context.Context {
 	ret := _m.Called()
@@ -728,6 +764,24 @@ func (_m *Store) Plugin() store.PluginStore {
 	return r0
 }
 
+// Poll provides a mock function with no fields
+func (_m *Store) Poll() store.PollStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Poll")
+	}
+
+	var r0 store.PollStore
+	if rf, ok := ret.Get(0).(func() store.PollStore); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(store.PollStore)
+	}
+
+	return r0
+}
+
 // Post provides a mock function with no fields
 func (_m *Store) Post() store.PostStore {
 	ret := _m.Called()
@@ -933,6 +987,24 @@ func (_m *Store) RecycleDBConnections(d time.Duration) {
 	_m.Called(d)
 }
 
+// Reminder provides a mock function with no fields
+func (_m *Store) Reminder() store.ReminderStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reminder")
+	}
+
+	var r0 store.ReminderStore
+	if rf, ok := ret.Get(0).(func() store.ReminderStore); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(store.ReminderStore)
+	}
+
+	return r0
+}
+
 // RemoteCluster provides a mock function with no fields
 func (_m *Store) RemoteCluster() store.RemoteClusterStore {
 	ret := _m.Called()
@@ -1353,6 +1425,24 @@ func (_m *Store) UserAccessToken() store.UserAccessTokenStore {
 	return r0
 }
 
+// UserDevice provides a mock function with no fields
+func (_m *Store) UserDevice() store.UserDeviceStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserDevice")
+	}
+
+	var r0 store.UserDeviceStore
+	if rf, ok := ret.Get(0).(func() store.UserDeviceStore); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(store.UserDeviceStore)
+	}
+
+	return r0
+}
+
 // UserTermsOfService provides a mock function with no fields
 func (_m *Store) UserTermsOfService() store.UserTermsOfServiceStore {
 	ret := _m.Called()
@@ -1373,6 +1463,24 @@ func (_m *Store) UserTermsOfService() store.UserTermsOfServiceStore {
 	return r0
 }
 
+// WebAuthnCredential provides a mock function with no fields
+func (_m *Store) WebAuthnCredential() store.WebAuthnCredentialStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WebAuthnCredential")
+	}
+
+	var r0 store.WebAuthnCredentialStore
+	if rf, ok := ret.Get(0).(func() store.WebAuthnCredentialStore); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(store.WebAuthnCredentialStore)
+	}
+
+	return r0
+}
+
 // Webhook provides a mock function with no fields
 func (_m *Store) Webhook() store.WebhookStore {
 	ret := _m.Called()
@@ -170,6 +170,54 @@ func (_m *UserAccessTokenStore) GetByUser(userID string, page int, perPage int)
 	return r0, r1
 }
 
+// GetExpiringForNotification provides a mock function with given fields: before, limit
+func (_m *UserAccessTokenStore) GetExpiringForNotification(before int64, limit int) ([]*model.UserAccessToken, error) {
+	ret := _m.Called(before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpiringForNotification")
+	}
+
+	var r0 []*model.UserAccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int) ([]*model.UserAccessToken, error)); ok {
+		return rf(before, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int) []*model.UserAccessToken); ok {
+		r0 = rf(before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.UserAccessToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int) error); ok {
+		r1 = rf(before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkExpiryNotified provides a mock function with given fields: tokenID
+func (_m *UserAccessTokenStore) MarkExpiryNotified(tokenID string) error {
+	ret := _m.Called(tokenID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkExpiryNotified")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(tokenID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Save provides a mock function with given fields: token
 func (_m *UserAccessTokenStore) Save(token *model.UserAccessToken) (*model.UserAccessToken, error) {
 	ret := _m.Called(token)
@@ -230,6 +278,24 @@ func (_m *UserAccessTokenStore) Search(term string) ([]*model.UserAccessToken, e
 	return r0, r1
 }
 
+// UpdateLastUsed provides a mock function with given fields: tokenID, lastUsedAt, ipAddress
+func (_m *UserAccessTokenStore) UpdateLastUsed(tokenID string, lastUsedAt int64, ipAddress string) error {
+	ret := _m.Called(tokenID, lastUsedAt, ipAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLastUsed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64, string) error); ok {
+		r0 = rf(tokenID, lastUsedAt, ipAddress)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateTokenDisable provides a mock function with given fields: tokenID
 func (_m *UserAccessTokenStore) UpdateTokenDisable(tokenID string) error {
 	ret := _m.Called(tokenID)
@@ -160,6 +160,36 @@ func (_m *UserStore) AnalyticsGetInactiveUsersCount() (int64, error) {
 	return r0, r1
 }
 
+// AnalyticsGetPasswordHashCounts provides a mock function with no fields
+func (_m *UserStore) AnalyticsGetPasswordHashCounts() (map[string]int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AnalyticsGetPasswordHashCounts")
+	}
+
+	var r0 map[string]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (map[string]int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() map[string]int64); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AnalyticsGetSystemAdminCount provides a mock function with no fields
 func (_m *UserStore) AnalyticsGetSystemAdminCount() (int64, error) {
 	ret := _m.Called()
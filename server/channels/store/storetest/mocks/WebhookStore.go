@@ -70,11 +70,57 @@ func (_m *WebhookStore) AnalyticsOutgoingCount(teamID string) (int64, error) {
 	return r0, r1
 }
 
+// ClaimOutgoingDelivery provides a mock function with given fields: id, nextAttemptAt, leaseUntil
+func (_m *WebhookStore) ClaimOutgoingDelivery(id string, nextAttemptAt int64, leaseUntil int64) (bool, error) {
+	ret := _m.Called(id, nextAttemptAt, leaseUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimOutgoingDelivery")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, int64) (bool, error)); ok {
+		return rf(id, nextAttemptAt, leaseUntil)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, int64) bool); ok {
+		r0 = rf(id, nextAttemptAt, leaseUntil)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, int64) error); ok {
+		r1 = rf(id, nextAttemptAt, leaseUntil)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ClearCaches provides a mock function with no fields
 func (_m *WebhookStore) ClearCaches() {
 	_m.Called()
 }
 
+// DeleteEventSubscription provides a mock function with given fields: id, timestamp
+func (_m *WebhookStore) DeleteEventSubscription(id string, timestamp int64) error {
+	ret := _m.Called(id, timestamp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEventSubscription")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(id, timestamp)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteIncoming provides a mock function with given fields: webhookID, timestamp
 func (_m *WebhookStore) DeleteIncoming(webhookID string, timestamp int64) error {
 	ret := _m.Called(webhookID, timestamp)
@@ -111,6 +157,126 @@ func (_m *WebhookStore) DeleteOutgoing(webhookID string, timestamp int64) error
 	return r0
 }
 
+// GetDueOutgoingDeliveries provides a mock function with given fields: now, limit
+func (_m *WebhookStore) GetDueOutgoingDeliveries(now int64, limit int) ([]*model.OutgoingWebhookDelivery, error) {
+	ret := _m.Called(now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDueOutgoingDeliveries")
+	}
+
+	var r0 []*model.OutgoingWebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int) ([]*model.OutgoingWebhookDelivery, error)); ok {
+		return rf(now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int) []*model.OutgoingWebhookDelivery); ok {
+		r0 = rf(now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.OutgoingWebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int) error); ok {
+		r1 = rf(now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEventSubscription provides a mock function with given fields: id
+func (_m *WebhookStore) GetEventSubscription(id string) (*model.EventSubscription, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEventSubscription")
+	}
+
+	var r0 *model.EventSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.EventSubscription, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.EventSubscription); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.EventSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEventSubscriptions provides a mock function with given fields: offset, limit
+func (_m *WebhookStore) GetEventSubscriptions(offset int, limit int) ([]*model.EventSubscription, error) {
+	ret := _m.Called(offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEventSubscriptions")
+	}
+
+	var r0 []*model.EventSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*model.EventSubscription, error)); ok {
+		return rf(offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*model.EventSubscription); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.EventSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEventSubscriptionsForEvent provides a mock function with given fields: eventType, allowFromCache
+func (_m *WebhookStore) GetEventSubscriptionsForEvent(eventType string, allowFromCache bool) ([]*model.EventSubscription, error) {
+	ret := _m.Called(eventType, allowFromCache)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEventSubscriptionsForEvent")
+	}
+
+	var r0 []*model.EventSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, bool) ([]*model.EventSubscription, error)); ok {
+		return rf(eventType, allowFromCache)
+	}
+	if rf, ok := ret.Get(0).(func(string, bool) []*model.EventSubscription); ok {
+		r0 = rf(eventType, allowFromCache)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.EventSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, bool) error); ok {
+		r1 = rf(eventType, allowFromCache)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetIncoming provides a mock function with given fields: id, allowFromCache
 func (_m *WebhookStore) GetIncoming(id string, allowFromCache bool) (*model.IncomingWebhook, error) {
 	ret := _m.Called(id, allowFromCache)
@@ -291,6 +457,34 @@ func (_m *WebhookStore) GetIncomingListByUser(userID string, offset int, limit i
 	return r0, r1
 }
 
+// GetIncomingThread provides a mock function with given fields: hookID, threadKey
+func (_m *WebhookStore) GetIncomingThread(hookID string, threadKey string) (string, error) {
+	ret := _m.Called(hookID, threadKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIncomingThread")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (string, error)); ok {
+		return rf(hookID, threadKey)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(hookID, threadKey)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(hookID, threadKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetOutgoing provides a mock function with given fields: id
 func (_m *WebhookStore) GetOutgoing(id string) (*model.OutgoingWebhook, error) {
 	ret := _m.Called(id)
@@ -441,6 +635,66 @@ func (_m *WebhookStore) GetOutgoingByTeamByUser(teamID string, userID string, of
 	return r0, r1
 }
 
+// GetOutgoingDeliveriesByHook provides a mock function with given fields: hookID, status, offset, limit
+func (_m *WebhookStore) GetOutgoingDeliveriesByHook(hookID string, status string, offset int, limit int) ([]*model.OutgoingWebhookDelivery, error) {
+	ret := _m.Called(hookID, status, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOutgoingDeliveriesByHook")
+	}
+
+	var r0 []*model.OutgoingWebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int, int) ([]*model.OutgoingWebhookDelivery, error)); ok {
+		return rf(hookID, status, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int) []*model.OutgoingWebhookDelivery); ok {
+		r0 = rf(hookID, status, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.OutgoingWebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int) error); ok {
+		r1 = rf(hookID, status, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOutgoingDelivery provides a mock function with given fields: id
+func (_m *WebhookStore) GetOutgoingDelivery(id string) (*model.OutgoingWebhookDelivery, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOutgoingDelivery")
+	}
+
+	var r0 *model.OutgoingWebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.OutgoingWebhookDelivery, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.OutgoingWebhookDelivery); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OutgoingWebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetOutgoingList provides a mock function with given fields: offset, limit
 func (_m *WebhookStore) GetOutgoingList(offset int, limit int) ([]*model.OutgoingWebhook, error) {
 	ret := _m.Called(offset, limit)
@@ -578,6 +832,64 @@ func (_m *WebhookStore) PermanentDeleteOutgoingByUser(userID string) error {
 	return r0
 }
 
+// PermanentDeleteOutgoingDeliveriesBatch provides a mock function with given fields: endTime, limit
+func (_m *WebhookStore) PermanentDeleteOutgoingDeliveriesBatch(endTime int64, limit int64) (int64, error) {
+	ret := _m.Called(endTime, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PermanentDeleteOutgoingDeliveriesBatch")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int64) (int64, error)); ok {
+		return rf(endTime, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64) int64); ok {
+		r0 = rf(endTime, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(endTime, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveEventSubscription provides a mock function with given fields: subscription
+func (_m *WebhookStore) SaveEventSubscription(subscription *model.EventSubscription) (*model.EventSubscription, error) {
+	ret := _m.Called(subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveEventSubscription")
+	}
+
+	var r0 *model.EventSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.EventSubscription) (*model.EventSubscription, error)); ok {
+		return rf(subscription)
+	}
+	if rf, ok := ret.Get(0).(func(*model.EventSubscription) *model.EventSubscription); ok {
+		r0 = rf(subscription)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.EventSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.EventSubscription) error); ok {
+		r1 = rf(subscription)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SaveIncoming provides a mock function with given fields: webhook
 func (_m *WebhookStore) SaveIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error) {
 	ret := _m.Called(webhook)
@@ -608,6 +920,24 @@ func (_m *WebhookStore) SaveIncoming(webhook *model.IncomingWebhook) (*model.Inc
 	return r0, r1
 }
 
+// SaveIncomingThread provides a mock function with given fields: hookID, threadKey, postID
+func (_m *WebhookStore) SaveIncomingThread(hookID string, threadKey string, postID string) error {
+	ret := _m.Called(hookID, threadKey, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveIncomingThread")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(hookID, threadKey, postID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SaveOutgoing provides a mock function with given fields: webhook
 func (_m *WebhookStore) SaveOutgoing(webhook *model.OutgoingWebhook) (*model.OutgoingWebhook, error) {
 	ret := _m.Called(webhook)
@@ -638,6 +968,66 @@ func (_m *WebhookStore) SaveOutgoing(webhook *model.OutgoingWebhook) (*model.Out
 	return r0, r1
 }
 
+// SaveOutgoingDelivery provides a mock function with given fields: delivery
+func (_m *WebhookStore) SaveOutgoingDelivery(delivery *model.OutgoingWebhookDelivery) (*model.OutgoingWebhookDelivery, error) {
+	ret := _m.Called(delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveOutgoingDelivery")
+	}
+
+	var r0 *model.OutgoingWebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.OutgoingWebhookDelivery) (*model.OutgoingWebhookDelivery, error)); ok {
+		return rf(delivery)
+	}
+	if rf, ok := ret.Get(0).(func(*model.OutgoingWebhookDelivery) *model.OutgoingWebhookDelivery); ok {
+		r0 = rf(delivery)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OutgoingWebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.OutgoingWebhookDelivery) error); ok {
+		r1 = rf(delivery)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateEventSubscription provides a mock function with given fields: subscription
+func (_m *WebhookStore) UpdateEventSubscription(subscription *model.EventSubscription) (*model.EventSubscription, error) {
+	ret := _m.Called(subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEventSubscription")
+	}
+
+	var r0 *model.EventSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.EventSubscription) (*model.EventSubscription, error)); ok {
+		return rf(subscription)
+	}
+	if rf, ok := ret.Get(0).(func(*model.EventSubscription) *model.EventSubscription); ok {
+		r0 = rf(subscription)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.EventSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.EventSubscription) error); ok {
+		r1 = rf(subscription)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateIncoming provides a mock function with given fields: webhook
 func (_m *WebhookStore) UpdateIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error) {
 	ret := _m.Called(webhook)
@@ -698,6 +1088,36 @@ func (_m *WebhookStore) UpdateOutgoing(hook *model.OutgoingWebhook) (*model.Outg
 	return r0, r1
 }
 
+// UpdateOutgoingDelivery provides a mock function with given fields: delivery
+func (_m *WebhookStore) UpdateOutgoingDelivery(delivery *model.OutgoingWebhookDelivery) (*model.OutgoingWebhookDelivery, error) {
+	ret := _m.Called(delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOutgoingDelivery")
+	}
+
+	var r0 *model.OutgoingWebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.OutgoingWebhookDelivery) (*model.OutgoingWebhookDelivery, error)); ok {
+		return rf(delivery)
+	}
+	if rf, ok := ret.Get(0).(func(*model.OutgoingWebhookDelivery) *model.OutgoingWebhookDelivery); ok {
+		r0 = rf(delivery)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OutgoingWebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.OutgoingWebhookDelivery) error); ok {
+		r1 = rf(delivery)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewWebhookStore creates a new instance of WebhookStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewWebhookStore(t interface {
@@ -0,0 +1,157 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestPollStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGet", func(t *testing.T) { testPollStoreSaveAndGet(t, rctx, ss) })
+	t.Run("Update", func(t *testing.T) { testPollStoreUpdate(t, rctx, ss) })
+	t.Run("GetExpired", func(t *testing.T) { testPollStoreGetExpired(t, rctx, ss) })
+	t.Run("Votes", func(t *testing.T) { testPollStoreVotes(t, rctx, ss) })
+	t.Run("PermanentDeleteVotesByUser", func(t *testing.T) { testPollStorePermanentDeleteVotesByUser(t, rctx, ss) })
+}
+
+func newTestPoll(closeAt int64) *model.Poll {
+	return &model.Poll{
+		CreatorId: model.NewId(),
+		ChannelId: model.NewId(),
+		Question:  "Where do we go for lunch?",
+		Options: model.PollOptions{
+			{Text: "Pizza"},
+			{Text: "Sushi"},
+			{Text: "Tacos"},
+		},
+		CloseAt: closeAt,
+	}
+}
+
+func testPollStoreSaveAndGet(t *testing.T, rctx request.CTX, ss store.Store) {
+	poll, err := ss.Poll().Save(newTestPoll(0))
+	require.NoError(t, err)
+	require.NotEmpty(t, poll.Id)
+	require.NotZero(t, poll.CreateAt)
+	for _, option := range poll.Options {
+		require.NotEmpty(t, option.Id)
+	}
+
+	_, err = ss.Poll().Save(poll)
+	require.Error(t, err, "saving a poll with an id must fail")
+
+	invalid := newTestPoll(0)
+	invalid.Options = invalid.Options[:1]
+	_, err = ss.Poll().Save(invalid)
+	require.Error(t, err)
+
+	fetched, err := ss.Poll().Get(poll.Id)
+	require.NoError(t, err)
+	assert.Equal(t, poll, fetched)
+
+	_, err = ss.Poll().Get(model.NewId())
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr)
+}
+
+func testPollStoreUpdate(t *testing.T, rctx request.CTX, ss store.Store) {
+	poll, err := ss.Poll().Save(newTestPoll(0))
+	require.NoError(t, err)
+
+	poll.PostId = model.NewId()
+	poll.ClosedAt = model.GetMillis()
+	poll.Question = "changed"
+	_, err = ss.Poll().Update(poll)
+	require.NoError(t, err)
+
+	fetched, err := ss.Poll().Get(poll.Id)
+	require.NoError(t, err)
+	assert.Equal(t, poll.PostId, fetched.PostId)
+	assert.Equal(t, poll.ClosedAt, fetched.ClosedAt)
+	assert.Equal(t, "Where do we go for lunch?", fetched.Question, "the question of a poll can't be changed")
+}
+
+func testPollStoreGetExpired(t *testing.T, rctx request.CTX, ss store.Store) {
+	now := model.GetMillis()
+
+	expired, err := ss.Poll().Save(newTestPoll(now - 1000))
+	require.NoError(t, err)
+
+	closed := newTestPoll(now - 1000)
+	closed.ClosedAt = now - 500
+	closed, err = ss.Poll().Save(closed)
+	require.NoError(t, err)
+
+	open, err := ss.Poll().Save(newTestPoll(now + 60000))
+	require.NoError(t, err)
+
+	polls, err := ss.Poll().GetExpired(now, 1000)
+	require.NoError(t, err)
+
+	ids := make([]string, 0, len(polls))
+	for _, poll := range polls {
+		ids = append(ids, poll.Id)
+	}
+	assert.Contains(t, ids, expired.Id)
+	assert.NotContains(t, ids, closed.Id)
+	assert.NotContains(t, ids, open.Id)
+}
+
+func testPollStoreVotes(t *testing.T, rctx request.CTX, ss store.Store) {
+	poll, err := ss.Poll().Save(newTestPoll(0))
+	require.NoError(t, err)
+
+	userID := model.NewId()
+	otherUserID := model.NewId()
+
+	require.NoError(t, ss.Poll().SaveVote(&model.PollVote{PollId: poll.Id, OptionId: poll.Options[0].Id, UserId: userID}, false))
+	require.NoError(t, ss.Poll().SaveVote(&model.PollVote{PollId: poll.Id, OptionId: poll.Options[1].Id, UserId: userID}, false))
+	require.NoError(t, ss.Poll().SaveVote(&model.PollVote{PollId: poll.Id, OptionId: poll.Options[1].Id, UserId: userID}, false), "voting twice for an option must be a no-op")
+	require.NoError(t, ss.Poll().SaveVote(&model.PollVote{PollId: poll.Id, OptionId: poll.Options[0].Id, UserId: otherUserID}, false))
+
+	votes, err := ss.Poll().GetVotes(poll.Id)
+	require.NoError(t, err)
+	require.Len(t, votes, 3)
+
+	require.NoError(t, ss.Poll().SaveVote(&model.PollVote{PollId: poll.Id, OptionId: poll.Options[2].Id, UserId: userID}, true))
+
+	votes, err = ss.Poll().GetVotes(poll.Id)
+	require.NoError(t, err)
+	require.Len(t, votes, 2)
+	results := poll.Results(votes)
+	assert.Equal(t, 1, results.Counts[poll.Options[0].Id])
+	assert.Equal(t, 0, results.Counts[poll.Options[1].Id])
+	assert.Equal(t, 1, results.Counts[poll.Options[2].Id])
+
+	require.NoError(t, ss.Poll().DeleteVote(poll.Id, poll.Options[2].Id, userID))
+
+	votes, err = ss.Poll().GetVotes(poll.Id)
+	require.NoError(t, err)
+	require.Len(t, votes, 1)
+	assert.Equal(t, otherUserID, votes[0].UserId)
+}
+
+func testPollStorePermanentDeleteVotesByUser(t *testing.T, rctx request.CTX, ss store.Store) {
+	poll, err := ss.Poll().Save(newTestPoll(0))
+	require.NoError(t, err)
+
+	userID := model.NewId()
+	otherUserID := model.NewId()
+	require.NoError(t, ss.Poll().SaveVote(&model.PollVote{PollId: poll.Id, OptionId: poll.Options[0].Id, UserId: userID}, false))
+	require.NoError(t, ss.Poll().SaveVote(&model.PollVote{PollId: poll.Id, OptionId: poll.Options[0].Id, UserId: otherUserID}, false))
+
+	require.NoError(t, ss.Poll().PermanentDeleteVotesByUser(userID))
+
+	votes, err := ss.Poll().GetVotes(poll.Id)
+	require.NoError(t, err)
+	require.Len(t, votes, 1)
+	assert.Equal(t, otherUserID, votes[0].UserId)
+}
@@ -54,6 +54,7 @@ func TestPostStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
 	t.Run("GetOldest", func(t *testing.T) { testPostStoreGetOldest(t, rctx, ss) })
 	t.Run("TestGetMaxPostSize", func(t *testing.T) { testGetMaxPostSize(t, rctx, ss) })
 	t.Run("GetParentsForExportAfter", func(t *testing.T) { testPostStoreGetParentsForExportAfter(t, rctx, ss) })
+	t.Run("GetParentsForExportByIds", func(t *testing.T) { testPostStoreGetParentsForExportByIds(t, rctx, ss) })
 	t.Run("GetRepliesForExport", func(t *testing.T) { testPostStoreGetRepliesForExport(t, rctx, ss) })
 	t.Run("GetDirectPostParentsForExportAfter", func(t *testing.T) { testPostStoreGetDirectPostParentsForExportAfter(t, rctx, ss, s) })
 	t.Run("GetDirectPostParentsForExportAfterDeleted", func(t *testing.T) { testPostStoreGetDirectPostParentsForExportAfterDeleted(t, rctx, ss, s) })
@@ -4839,6 +4840,74 @@ func testPostStoreGetRepliesForExport(t *testing.T, rctx request.CTX, ss store.S
 	assert.Equal(t, reply1.Username, u1.Username)
 }
 
+func testPostStoreGetParentsForExportByIds(t *testing.T, rctx request.CTX, ss store.Store) {
+	team, err := ss.Team().Save(&model.Team{
+		DisplayName: "Name",
+		Name:        NewTestID(),
+		Email:       MakeEmail(),
+		Type:        model.TeamOpen,
+	})
+	require.NoError(t, err)
+
+	channel, err := ss.Channel().Save(rctx, &model.Channel{
+		TeamId:      team.Id,
+		DisplayName: "Channel",
+		Name:        NewTestID(),
+		Type:        model.ChannelTypeOpen,
+	}, -1)
+	require.NoError(t, err)
+
+	archived, err := ss.Channel().Save(rctx, &model.Channel{
+		TeamId:      team.Id,
+		DisplayName: "Archived",
+		Name:        NewTestID(),
+		Type:        model.ChannelTypeOpen,
+	}, -1)
+	require.NoError(t, err)
+
+	user, err := ss.User().Save(rctx, &model.User{
+		Username: model.NewUsername(),
+		Email:    MakeEmail(),
+	})
+	require.NoError(t, err)
+
+	root, err := ss.Post().Save(rctx, &model.Post{ChannelId: channel.Id, UserId: user.Id, Message: NewTestID()})
+	require.NoError(t, err)
+	reply, err := ss.Post().Save(rctx, &model.Post{ChannelId: channel.Id, UserId: user.Id, RootId: root.Id, Message: NewTestID()})
+	require.NoError(t, err)
+	deleted, err := ss.Post().Save(rctx, &model.Post{ChannelId: channel.Id, UserId: user.Id, Message: NewTestID()})
+	require.NoError(t, err)
+	err = ss.Post().Delete(rctx, deleted.Id, model.GetMillis(), user.Id)
+	require.NoError(t, err)
+	inArchived, err := ss.Post().Save(rctx, &model.Post{ChannelId: archived.Id, UserId: user.Id, Message: NewTestID()})
+	require.NoError(t, err)
+	err = ss.Channel().Delete(archived.Id, model.GetMillis())
+	require.NoError(t, err)
+
+	ids := []string{root.Id, reply.Id, deleted.Id, inArchived.Id}
+
+	t.Run("without archived channels", func(t *testing.T) {
+		posts, err := ss.Post().GetParentsForExportByIds(ids, false)
+		require.NoError(t, err)
+		require.Len(t, posts, 1)
+		assert.Equal(t, root.Id, posts[0].Id)
+		assert.Equal(t, user.Username, posts[0].Username)
+		assert.Equal(t, channel.Name, posts[0].ChannelName)
+	})
+
+	t.Run("with archived channels", func(t *testing.T) {
+		posts, err := ss.Post().GetParentsForExportByIds(ids, true)
+		require.NoError(t, err)
+		require.Len(t, posts, 2)
+	})
+
+	t.Run("no ids", func(t *testing.T) {
+		posts, err := ss.Post().GetParentsForExportByIds(nil, true)
+		require.NoError(t, err)
+		assert.Empty(t, posts)
+	})
+}
+
 func testPostStoreGetDirectPostParentsForExportAfter(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
 	teamID := model.NewId()
 
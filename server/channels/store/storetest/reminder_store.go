@@ -0,0 +1,184 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestReminderStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGet", func(t *testing.T) { testReminderStoreSaveAndGet(t, rctx, ss) })
+	t.Run("Update", func(t *testing.T) { testReminderStoreUpdate(t, rctx, ss) })
+	t.Run("GetForCreator", func(t *testing.T) { testReminderStoreGetForCreator(t, rctx, ss) })
+	t.Run("GetDueAndReschedule", func(t *testing.T) { testReminderStoreGetDueAndReschedule(t, rctx, ss) })
+	t.Run("Delete", func(t *testing.T) { testReminderStoreDelete(t, rctx, ss) })
+	t.Run("PermanentDeleteByUser", func(t *testing.T) { testReminderStorePermanentDeleteByUser(t, rctx, ss) })
+}
+
+func newTestReminder(creatorID string, nextFireAt int64) *model.Reminder {
+	return &model.Reminder{
+		CreatorId:  creatorID,
+		TargetType: model.ReminderTargetUser,
+		TargetId:   creatorID,
+		Message:    "water the plants",
+		TimeZone:   "UTC",
+		NextFireAt: nextFireAt,
+	}
+}
+
+func testReminderStoreSaveAndGet(t *testing.T, rctx request.CTX, ss store.Store) {
+	reminder, err := ss.Reminder().Save(newTestReminder(model.NewId(), model.GetMillis()))
+	require.NoError(t, err)
+	require.NotEmpty(t, reminder.Id)
+	require.NotZero(t, reminder.CreateAt)
+
+	_, err = ss.Reminder().Save(reminder)
+	require.Error(t, err, "saving a reminder with an id must fail")
+
+	invalid := newTestReminder(model.NewId(), 0)
+	invalid.Message = ""
+	_, err = ss.Reminder().Save(invalid)
+	require.Error(t, err)
+
+	fetched, err := ss.Reminder().Get(reminder.Id)
+	require.NoError(t, err)
+	assert.Equal(t, reminder, fetched)
+
+	_, err = ss.Reminder().Get(model.NewId())
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr)
+}
+
+func testReminderStoreUpdate(t *testing.T, rctx request.CTX, ss store.Store) {
+	reminder, err := ss.Reminder().Save(newTestReminder(model.NewId(), model.GetMillis()))
+	require.NoError(t, err)
+
+	reminder.NextFireAt = 0
+	reminder.CompleteAt = model.GetMillis()
+	_, err = ss.Reminder().Update(reminder)
+	require.NoError(t, err)
+
+	fetched, err := ss.Reminder().Get(reminder.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), fetched.NextFireAt)
+	assert.Equal(t, reminder.CompleteAt, fetched.CompleteAt)
+}
+
+func testReminderStoreGetForCreator(t *testing.T, rctx request.CTX, ss store.Store) {
+	creatorID := model.NewId()
+
+	later, err := ss.Reminder().Save(newTestReminder(creatorID, 3000))
+	require.NoError(t, err)
+	sooner, err := ss.Reminder().Save(newTestReminder(creatorID, 2000))
+	require.NoError(t, err)
+	snoozable, err := ss.Reminder().Save(newTestReminder(creatorID, 0))
+	require.NoError(t, err)
+
+	completed := newTestReminder(creatorID, 1000)
+	completed.CompleteAt = 1000
+	_, err = ss.Reminder().Save(completed)
+	require.NoError(t, err)
+
+	_, err = ss.Reminder().Save(newTestReminder(model.NewId(), 1000))
+	require.NoError(t, err)
+
+	reminders, err := ss.Reminder().GetForCreator(creatorID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, reminders, 3)
+	assert.Equal(t, sooner.Id, reminders[0].Id)
+	assert.Equal(t, later.Id, reminders[1].Id)
+	assert.Equal(t, snoozable.Id, reminders[2].Id, "reminders waiting to be snoozed must come last")
+
+	reminders, err = ss.Reminder().GetForCreator(creatorID, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, reminders, 1)
+	assert.Equal(t, later.Id, reminders[0].Id)
+}
+
+func testReminderStoreGetDueAndReschedule(t *testing.T, rctx request.CTX, ss store.Store) {
+	now := model.GetMillis()
+
+	due, err := ss.Reminder().Save(newTestReminder(model.NewId(), now-1000))
+	require.NoError(t, err)
+	_, err = ss.Reminder().Save(newTestReminder(model.NewId(), now+model.DayInMilliseconds))
+	require.NoError(t, err)
+	_, err = ss.Reminder().Save(newTestReminder(model.NewId(), 0))
+	require.NoError(t, err)
+
+	reminders, err := ss.Reminder().GetDue(now, 1000)
+	require.NoError(t, err)
+	ids := make([]string, 0, len(reminders))
+	for _, reminder := range reminders {
+		assert.LessOrEqual(t, reminder.NextFireAt, now)
+		assert.NotZero(t, reminder.NextFireAt)
+		ids = append(ids, reminder.Id)
+	}
+	assert.Contains(t, ids, due.Id)
+
+	rescheduled, err := ss.Reminder().Reschedule(due.Id, due.NextFireAt, 0)
+	require.NoError(t, err)
+	assert.True(t, rescheduled)
+
+	rescheduled, err = ss.Reminder().Reschedule(due.Id, due.NextFireAt, 0)
+	require.NoError(t, err)
+	assert.False(t, rescheduled, "a reminder must not be rescheduled twice")
+
+	fetched, err := ss.Reminder().Get(due.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), fetched.NextFireAt)
+	assert.Equal(t, due.NextFireAt, fetched.LastFireAt)
+
+	reminders, err = ss.Reminder().GetDue(now, 1000)
+	require.NoError(t, err)
+	for _, reminder := range reminders {
+		assert.NotEqual(t, due.Id, reminder.Id)
+	}
+}
+
+func testReminderStoreDelete(t *testing.T, rctx request.CTX, ss store.Store) {
+	reminder, err := ss.Reminder().Save(newTestReminder(model.NewId(), model.GetMillis()))
+	require.NoError(t, err)
+
+	require.NoError(t, ss.Reminder().Delete(reminder.Id, model.GetMillis()))
+
+	_, err = ss.Reminder().Get(reminder.Id)
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr)
+
+	rescheduled, err := ss.Reminder().Reschedule(reminder.Id, reminder.NextFireAt, 0)
+	require.NoError(t, err)
+	assert.False(t, rescheduled)
+}
+
+func testReminderStorePermanentDeleteByUser(t *testing.T, rctx request.CTX, ss store.Store) {
+	userID := model.NewId()
+
+	created, err := ss.Reminder().Save(newTestReminder(userID, 1000))
+	require.NoError(t, err)
+
+	targeted := newTestReminder(model.NewId(), 1000)
+	targeted.TargetId = userID
+	targeted, err = ss.Reminder().Save(targeted)
+	require.NoError(t, err)
+
+	other, err := ss.Reminder().Save(newTestReminder(model.NewId(), 1000))
+	require.NoError(t, err)
+
+	require.NoError(t, ss.Reminder().PermanentDeleteByUser(userID))
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.Reminder().Get(created.Id)
+	require.ErrorAs(t, err, &nfErr)
+	_, err = ss.Reminder().Get(targeted.Id)
+	require.ErrorAs(t, err, &nfErr)
+	_, err = ss.Reminder().Get(other.Id)
+	require.NoError(t, err)
+}
@@ -65,6 +65,12 @@ type Store struct {
 	DesktopTokensStore              mocks.DesktopTokensStore
 	ChannelBookmarkStore            mocks.ChannelBookmarkStore
 	ScheduledPostStore              mocks.ScheduledPostStore
+	ReminderStore                   mocks.ReminderStore
+	UserDeviceStore                 mocks.UserDeviceStore
+	ConfigRevisionStore             mocks.ConfigRevisionStore
+	AuditLogStore                   mocks.AuditLogStore
+	PollStore                       mocks.PollStore
+	WebAuthnCredentialStore         mocks.WebAuthnCredentialStore
 	PropertyGroupStore              mocks.PropertyGroupStore
 	PropertyFieldStore              mocks.PropertyFieldStore
 	PropertyValueStore              mocks.PropertyValueStore
@@ -122,6 +128,11 @@ func (s *Store) LinkMetadata() store.LinkMetadataStore       { return &s.LinkMet
 func (s *Store) SharedChannel() store.SharedChannelStore     { return &s.SharedChannelStore }
 func (s *Store) PostPriority() store.PostPriorityStore       { return &s.PostPriorityStore }
 func (s *Store) ScheduledPost() store.ScheduledPostStore     { return &s.ScheduledPostStore }
+func (s *Store) Reminder() store.ReminderStore               { return &s.ReminderStore }
+func (s *Store) UserDevice() store.UserDeviceStore           { return &s.UserDeviceStore }
+func (s *Store) ConfigRevision() store.ConfigRevisionStore   { return &s.ConfigRevisionStore }
+func (s *Store) AuditLog() store.AuditLogStore               { return &s.AuditLogStore }
+func (s *Store) Poll() store.PollStore                       { return &s.PollStore }
 func (s *Store) PropertyGroup() store.PropertyGroupStore     { return &s.PropertyGroupStore }
 func (s *Store) PropertyField() store.PropertyFieldStore     { return &s.PropertyFieldStore }
 func (s *Store) PropertyValue() store.PropertyValueStore     { return &s.PropertyValueStore }
@@ -131,6 +142,9 @@ func (s *Store) PostAcknowledgement() store.PostAcknowledgementStore {
 func (s *Store) PostPersistentNotification() store.PostPersistentNotificationStore {
 	return &s.PostPersistentNotificationStore
 }
+func (s *Store) WebAuthnCredential() store.WebAuthnCredentialStore {
+	return &s.WebAuthnCredentialStore
+}
 func (s *Store) MarkSystemRanUnitTests()             { /* do nothing */ }
 func (s *Store) Close()                              { /* do nothing */ }
 func (s *Store) LockToMaster()                       { /* do nothing */ }
@@ -208,6 +222,12 @@ func (s *Store) AssertExpectations(t mock.TestingT) bool {
 		&s.DesktopTokensStore,
 		&s.ChannelBookmarkStore,
 		&s.ScheduledPostStore,
+		&s.ReminderStore,
+		&s.UserDeviceStore,
+		&s.ConfigRevisionStore,
+		&s.AuditLogStore,
+		&s.PollStore,
+		&s.WebAuthnCredentialStore,
 		&s.AccessControlPolicyStore,
 		&s.AttributesStore,
 	)
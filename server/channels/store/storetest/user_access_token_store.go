@@ -18,6 +18,8 @@ func TestUserAccessTokenStore(t *testing.T, rctx request.CTX, ss store.Store) {
 	t.Run("UserAccessTokenDisableEnable", func(t *testing.T) { testUserAccessTokenDisableEnable(t, rctx, ss) })
 	t.Run("UserAccessTokenSearch", func(t *testing.T) { testUserAccessTokenSearch(t, rctx, ss) })
 	t.Run("UserAccessTokenPagination", func(t *testing.T) { testUserAccessTokenPagination(t, rctx, ss) })
+	t.Run("UserAccessTokenScopesAndUsage", func(t *testing.T) { testUserAccessTokenScopesAndUsage(t, rctx, ss) })
+	t.Run("UserAccessTokenExpiryNotification", func(t *testing.T) { testUserAccessTokenExpiryNotification(t, rctx, ss) })
 }
 
 func testUserAccessTokenSaveGetDelete(t *testing.T, rctx request.CTX, ss store.Store) {
@@ -245,3 +247,68 @@ func testUserAccessTokenPagination(t *testing.T, rctx request.CTX, ss store.Stor
 	require.NoError(t, nErr)
 	require.Len(t, result, 0, "Should return 0 tokens for non-existent user")
 }
+
+func testUserAccessTokenScopesAndUsage(t *testing.T, rctx request.CTX, ss store.Store) {
+	uat := &model.UserAccessToken{
+		Token:       model.NewId(),
+		UserId:      model.NewId(),
+		Description: "testtoken",
+		ExpiresAt:   model.GetMillis() + 60*60*1000,
+		Scopes:      model.StringArray{"read:posts", "write:posts:" + model.NewId()},
+	}
+
+	_, err := ss.UserAccessToken().Save(uat)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, ss.UserAccessToken().Delete(uat.Id))
+	}()
+
+	received, err := ss.UserAccessToken().GetByToken(uat.Token)
+	require.NoError(t, err)
+	require.Equal(t, uat.ExpiresAt, received.ExpiresAt)
+	require.Equal(t, uat.Scopes, received.Scopes)
+	require.Zero(t, received.LastUsedAt)
+
+	lastUsedAt := model.GetMillis()
+	require.NoError(t, ss.UserAccessToken().UpdateLastUsed(uat.Id, lastUsedAt, "10.0.0.1"))
+
+	received, err = ss.UserAccessToken().Get(uat.Id)
+	require.NoError(t, err)
+	require.Equal(t, lastUsedAt, received.LastUsedAt)
+	require.Equal(t, "10.0.0.1", received.LastUsedIP)
+}
+
+func testUserAccessTokenExpiryNotification(t *testing.T, rctx request.CTX, ss store.Store) {
+	now := model.GetMillis()
+	newToken := func(expiresAt int64) *model.UserAccessToken {
+		uat, err := ss.UserAccessToken().Save(&model.UserAccessToken{
+			Token:       model.NewId(),
+			UserId:      model.NewId(),
+			Description: "testtoken",
+			ExpiresAt:   expiresAt,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, ss.UserAccessToken().Delete(uat.Id))
+		})
+		return uat
+	}
+
+	expiringSoon := newToken(now + 60*60*1000)
+	newToken(now + 30*24*60*60*1000)
+	newToken(now - 60*60*1000)
+	newToken(0)
+	disabled := newToken(now + 60*60*1000)
+	require.NoError(t, ss.UserAccessToken().UpdateTokenDisable(disabled.Id))
+
+	tokens, err := ss.UserAccessToken().GetExpiringForNotification(now+model.UserAccessTokenExpiryNotifyMillis, 100)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	require.Equal(t, expiringSoon.Id, tokens[0].Id)
+
+	require.NoError(t, ss.UserAccessToken().MarkExpiryNotified(expiringSoon.Id))
+
+	tokens, err = ss.UserAccessToken().GetExpiringForNotification(now+model.UserAccessTokenExpiryNotifyMillis, 100)
+	require.NoError(t, err)
+	require.Empty(t, tokens)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestUserDeviceStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
+	t.Run("Upsert", func(t *testing.T) { testUserDeviceStoreUpsert(t, rctx, ss) })
+	t.Run("PermanentDeleteByUser", func(t *testing.T) { testUserDeviceStorePermanentDeleteByUser(t, rctx, ss) })
+}
+
+func testUserDeviceStoreUpsert(t *testing.T, rctx request.CTX, ss store.Store) {
+	userID := model.NewId()
+
+	isNew, err := ss.UserDevice().Upsert(&model.UserDevice{
+		UserId:        userID,
+		Platform:      "Linux",
+		Os:            "Linux",
+		Browser:       "Firefox",
+		FirstSeenAt:   1000,
+		LastIPAddress: "10.0.0.1",
+	})
+	require.NoError(t, err)
+	assert.True(t, isNew)
+
+	isNew, err = ss.UserDevice().Upsert(&model.UserDevice{
+		UserId:        userID,
+		Platform:      "Linux",
+		Os:            "Linux",
+		Browser:       "Firefox",
+		FirstSeenAt:   2000,
+		LastIPAddress: "10.0.0.2",
+	})
+	require.NoError(t, err)
+	assert.False(t, isNew, "the same device must not be new twice")
+
+	isNew, err = ss.UserDevice().Upsert(&model.UserDevice{
+		UserId:      userID,
+		Platform:    "Macintosh",
+		Os:          "Mac OS",
+		Browser:     "Safari",
+		FirstSeenAt: 3000,
+	})
+	require.NoError(t, err)
+	assert.True(t, isNew)
+
+	_, err = ss.UserDevice().Upsert(&model.UserDevice{UserId: "invalid"})
+	require.Error(t, err)
+
+	devices, err := ss.UserDevice().GetForUser(userID)
+	require.NoError(t, err)
+	require.Len(t, devices, 2)
+	assert.Equal(t, "Safari", devices[0].Browser)
+	assert.Equal(t, "Firefox", devices[1].Browser)
+	assert.Equal(t, int64(1000), devices[1].FirstSeenAt)
+	assert.Equal(t, int64(2000), devices[1].LastSeenAt)
+	assert.Equal(t, "10.0.0.2", devices[1].LastIPAddress)
+
+	devices, err = ss.UserDevice().GetForUser(model.NewId())
+	require.NoError(t, err)
+	assert.Empty(t, devices)
+}
+
+func testUserDeviceStorePermanentDeleteByUser(t *testing.T, rctx request.CTX, ss store.Store) {
+	userID := model.NewId()
+	otherUserID := model.NewId()
+
+	for _, id := range []string{userID, otherUserID} {
+		_, err := ss.UserDevice().Upsert(&model.UserDevice{UserId: id, Platform: "Windows", Os: "Windows 10", Browser: "Chrome"})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, ss.UserDevice().PermanentDeleteByUser(userID))
+
+	devices, err := ss.UserDevice().GetForUser(userID)
+	require.NoError(t, err)
+	assert.Empty(t, devices)
+
+	devices, err = ss.UserDevice().GetForUser(otherUserID)
+	require.NoError(t, err)
+	assert.Len(t, devices, 1)
+}
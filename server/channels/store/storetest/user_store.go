@@ -45,6 +45,7 @@ func TestUserStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
 	t.Run("AnalyticsActiveCount", func(t *testing.T) { testUserStoreAnalyticsActiveCount(t, rctx, ss, s) })
 	t.Run("AnalyticsActiveCountForPeriod", func(t *testing.T) { testUserStoreAnalyticsActiveCountForPeriod(t, rctx, ss, s) })
 	t.Run("AnalyticsGetInactiveUsersCount", func(t *testing.T) { testUserStoreAnalyticsGetInactiveUsersCount(t, rctx, ss) })
+	t.Run("AnalyticsGetPasswordHashCounts", func(t *testing.T) { testUserStoreAnalyticsGetPasswordHashCounts(t, rctx, ss) })
 	t.Run("AnalyticsGetInactiveUsersCountIgnoreBots", func(t *testing.T) { testUserStoreAnalyticsGetInactiveUsersCountIgnoreBots(t, rctx, ss) })
 	t.Run("AnalyticsGetSystemAdminCount", func(t *testing.T) { testUserStoreAnalyticsGetSystemAdminCount(t, rctx, ss) })
 	t.Run("AnalyticsGetGuestCount", func(t *testing.T) { testUserStoreAnalyticsGetGuestCount(t, rctx, ss) })
@@ -4460,6 +4461,34 @@ func testUserStoreAnalyticsGetInactiveUsersCount(t *testing.T, rctx request.CTX,
 	require.Equal(t, count, newCount-1, "Expected 1 more inactive users but found otherwise.")
 }
 
+func testUserStoreAnalyticsGetPasswordHashCounts(t *testing.T, rctx request.CTX, ss store.Store) {
+	countsBefore, err := ss.User().AnalyticsGetPasswordHashCounts()
+	require.NoError(t, err)
+
+	hashes := []string{
+		"$argon2id$v=19$m=19456,t=2,p=1$5Zq8TvET7nMrXof49Rp4Sw$d0Mx8467kv+3ylbGrkyu4jTd8O8SP51k4s1RuWb9S/o",
+		"$argon2id$v=19$m=19456,t=2,p=1$Rp4Sw5Zq8TvET7nMrXof49$d0Mx8467kv+3ylbGrkyu4jTd8O8SP51k4s1RuWb9S/o",
+		"$pbkdf2$f=SHA256,w=600000,l=32$5Zq8TvET7nMrXof49Rp4Sw$d0Mx8467kv+3ylbGrkyu4jTd8O8SP51k4s1RuWb9S/o",
+		"$2a$10$z0OlN1MpiLVlLTyE1xtEjOJ6/xV95RAwwIUaYKQBAqoeyvPgLEnUa",
+		"",
+	}
+	for _, hash := range hashes {
+		u := &model.User{}
+		u.Email = MakeEmail()
+		_, err = ss.User().Save(rctx, u)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, ss.User().PermanentDelete(rctx, u.Id)) })
+		require.NoError(t, ss.User().UpdatePassword(u.Id, hash))
+	}
+
+	counts, err := ss.User().AnalyticsGetPasswordHashCounts()
+	require.NoError(t, err)
+	assert.Equal(t, countsBefore["$argon2id$v=19$m=19456,t=2,p=1"]+2, counts["$argon2id$v=19$m=19456,t=2,p=1"])
+	assert.Equal(t, countsBefore["$pbkdf2$f=SHA256,w=600000,l=32"]+1, counts["$pbkdf2$f=SHA256,w=600000,l=32"])
+	assert.Equal(t, countsBefore["$2a"]+1, counts["$2a"])
+	assert.NotContains(t, counts, "")
+}
+
 func testUserStoreAnalyticsGetInactiveUsersCountIgnoreBots(t *testing.T, rctx request.CTX, ss store.Store) {
 	u1 := &model.User{}
 	u1.Email = MakeEmail()
@@ -0,0 +1,126 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestWebAuthnCredentialStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGet", func(t *testing.T) { testWebAuthnCredentialStoreSaveAndGet(t, rctx, ss) })
+	t.Run("GetForUser", func(t *testing.T) { testWebAuthnCredentialStoreGetForUser(t, rctx, ss) })
+	t.Run("UpdateSignCount", func(t *testing.T) { testWebAuthnCredentialStoreUpdateSignCount(t, rctx, ss) })
+	t.Run("Delete", func(t *testing.T) { testWebAuthnCredentialStoreDelete(t, rctx, ss) })
+}
+
+func newTestWebAuthnCredential(userID string) *model.WebAuthnCredential {
+	return &model.WebAuthnCredential{
+		UserId:       userID,
+		CredentialId: model.NewId(),
+		PublicKey:    []byte{0xa1, 0x01, 0x02},
+		AAGUID:       "00000000000000000000000000000000",
+		Name:         "Security key",
+	}
+}
+
+func testWebAuthnCredentialStoreSaveAndGet(t *testing.T, rctx request.CTX, ss store.Store) {
+	credential, err := ss.WebAuthnCredential().Save(newTestWebAuthnCredential(model.NewId()))
+	require.NoError(t, err)
+	require.NotEmpty(t, credential.Id)
+	require.NotZero(t, credential.CreateAt)
+
+	_, err = ss.WebAuthnCredential().Save(credential)
+	require.Error(t, err, "saving a credential with an id must fail")
+
+	invalid := newTestWebAuthnCredential(model.NewId())
+	invalid.PublicKey = nil
+	_, err = ss.WebAuthnCredential().Save(invalid)
+	require.Error(t, err)
+
+	duplicate := newTestWebAuthnCredential(model.NewId())
+	duplicate.CredentialId = credential.CredentialId
+	_, err = ss.WebAuthnCredential().Save(duplicate)
+	var uniqueErr *store.ErrUniqueConstraint
+	require.ErrorAs(t, err, &uniqueErr)
+
+	fetched, err := ss.WebAuthnCredential().Get(credential.Id)
+	require.NoError(t, err)
+	assert.Equal(t, credential, fetched)
+
+	fetched, err = ss.WebAuthnCredential().GetByCredentialId(credential.CredentialId)
+	require.NoError(t, err)
+	assert.Equal(t, credential, fetched)
+
+	_, err = ss.WebAuthnCredential().Get(model.NewId())
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr)
+
+	_, err = ss.WebAuthnCredential().GetByCredentialId(model.NewId())
+	require.ErrorAs(t, err, &nfErr)
+}
+
+func testWebAuthnCredentialStoreGetForUser(t *testing.T, rctx request.CTX, ss store.Store) {
+	userID := model.NewId()
+
+	first, err := ss.WebAuthnCredential().Save(newTestWebAuthnCredential(userID))
+	require.NoError(t, err)
+	second := newTestWebAuthnCredential(userID)
+	second.CreateAt = first.CreateAt + 1
+	second, err = ss.WebAuthnCredential().Save(second)
+	require.NoError(t, err)
+	_, err = ss.WebAuthnCredential().Save(newTestWebAuthnCredential(model.NewId()))
+	require.NoError(t, err)
+
+	credentials, err := ss.WebAuthnCredential().GetForUser(userID)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.WebAuthnCredential{first, second}, credentials)
+
+	credentials, err = ss.WebAuthnCredential().GetForUser(model.NewId())
+	require.NoError(t, err)
+	assert.Empty(t, credentials)
+}
+
+func testWebAuthnCredentialStoreUpdateSignCount(t *testing.T, rctx request.CTX, ss store.Store) {
+	credential, err := ss.WebAuthnCredential().Save(newTestWebAuthnCredential(model.NewId()))
+	require.NoError(t, err)
+
+	now := model.GetMillis()
+	require.NoError(t, ss.WebAuthnCredential().UpdateSignCount(credential.Id, 42, now))
+
+	fetched, err := ss.WebAuthnCredential().Get(credential.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), fetched.SignCount)
+	assert.Equal(t, now, fetched.LastUsedAt)
+}
+
+func testWebAuthnCredentialStoreDelete(t *testing.T, rctx request.CTX, ss store.Store) {
+	userID := model.NewId()
+
+	first, err := ss.WebAuthnCredential().Save(newTestWebAuthnCredential(userID))
+	require.NoError(t, err)
+	_, err = ss.WebAuthnCredential().Save(newTestWebAuthnCredential(userID))
+	require.NoError(t, err)
+	other, err := ss.WebAuthnCredential().Save(newTestWebAuthnCredential(model.NewId()))
+	require.NoError(t, err)
+
+	require.NoError(t, ss.WebAuthnCredential().Delete(first.Id))
+	credentials, err := ss.WebAuthnCredential().GetForUser(userID)
+	require.NoError(t, err)
+	require.Len(t, credentials, 1)
+
+	require.NoError(t, ss.WebAuthnCredential().PermanentDeleteByUser(userID))
+	credentials, err = ss.WebAuthnCredential().GetForUser(userID)
+	require.NoError(t, err)
+	require.Empty(t, credentials)
+
+	_, err = ss.WebAuthnCredential().Get(other.Id)
+	require.NoError(t, err)
+}
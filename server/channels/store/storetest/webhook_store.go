@@ -27,6 +27,7 @@ func TestWebhookStore(t *testing.T, rctx request.CTX, ss store.Store) {
 	t.Run("DeleteIncoming", func(t *testing.T) { testWebhookStoreDeleteIncoming(t, rctx, ss) })
 	t.Run("DeleteIncomingByChannel", func(t *testing.T) { testWebhookStoreDeleteIncomingByChannel(t, rctx, ss) })
 	t.Run("DeleteIncomingByUser", func(t *testing.T) { testWebhookStoreDeleteIncomingByUser(t, rctx, ss) })
+	t.Run("IncomingThreads", func(t *testing.T) { testWebhookStoreIncomingThreads(t, rctx, ss) })
 	t.Run("SaveOutgoing", func(t *testing.T) { testWebhookStoreSaveOutgoing(t, rctx, ss) })
 	t.Run("GetOutgoing", func(t *testing.T) { testWebhookStoreGetOutgoing(t, rctx, ss) })
 	t.Run("GetOutgoingList", func(t *testing.T) { testWebhookStoreGetOutgoingList(t, rctx, ss) })
@@ -39,6 +40,17 @@ func TestWebhookStore(t *testing.T, rctx request.CTX, ss store.Store) {
 	t.Run("DeleteOutgoingByChannel", func(t *testing.T) { testWebhookStoreDeleteOutgoingByChannel(t, rctx, ss) })
 	t.Run("DeleteOutgoingByUser", func(t *testing.T) { testWebhookStoreDeleteOutgoingByUser(t, rctx, ss) })
 	t.Run("UpdateOutgoing", func(t *testing.T) { testWebhookStoreUpdateOutgoing(t, rctx, ss) })
+	t.Run("SaveOutgoingDelivery", func(t *testing.T) { testWebhookStoreSaveOutgoingDelivery(t, rctx, ss) })
+	t.Run("UpdateOutgoingDelivery", func(t *testing.T) { testWebhookStoreUpdateOutgoingDelivery(t, rctx, ss) })
+	t.Run("GetOutgoingDeliveriesByHook", func(t *testing.T) { testWebhookStoreGetOutgoingDeliveriesByHook(t, rctx, ss) })
+	t.Run("GetDueOutgoingDeliveries", func(t *testing.T) { testWebhookStoreGetDueOutgoingDeliveries(t, rctx, ss) })
+	t.Run("ClaimOutgoingDelivery", func(t *testing.T) { testWebhookStoreClaimOutgoingDelivery(t, rctx, ss) })
+	t.Run("PermanentDeleteOutgoingDeliveriesBatch", func(t *testing.T) { testWebhookStorePermanentDeleteOutgoingDeliveriesBatch(t, rctx, ss) })
+	t.Run("SaveEventSubscription", func(t *testing.T) { testWebhookStoreSaveEventSubscription(t, rctx, ss) })
+	t.Run("GetEventSubscriptions", func(t *testing.T) { testWebhookStoreGetEventSubscriptions(t, rctx, ss) })
+	t.Run("GetEventSubscriptionsForEvent", func(t *testing.T) { testWebhookStoreGetEventSubscriptionsForEvent(t, rctx, ss) })
+	t.Run("UpdateEventSubscription", func(t *testing.T) { testWebhookStoreUpdateEventSubscription(t, rctx, ss) })
+	t.Run("DeleteEventSubscription", func(t *testing.T) { testWebhookStoreDeleteEventSubscription(t, rctx, ss) })
 	t.Run("CountIncoming", func(t *testing.T) { testWebhookStoreCountIncoming(t, rctx, ss) })
 	t.Run("CountOutgoing", func(t *testing.T) { testWebhookStoreCountOutgoing(t, rctx, ss) })
 }
@@ -71,6 +83,46 @@ func testWebhookStoreUpdateIncoming(t *testing.T, rctx request.CTX, ss store.Sto
 	require.NotEqual(t, webhook.UpdateAt, previousUpdatedAt, "should have updated the UpdatedAt of the hook")
 
 	require.Equal(t, "TestHook", webhook.DisplayName, "display name is not updated")
+
+	o1.Adapter = model.IncomingWebhookAdapterAlertmanager
+	_, err = ss.Webhook().UpdateIncoming(o1)
+	require.NoError(t, err)
+
+	webhook, err = ss.Webhook().GetIncoming(o1.Id, false)
+	require.NoError(t, err)
+	require.Equal(t, model.IncomingWebhookAdapterAlertmanager, webhook.Adapter, "adapter is not updated")
+}
+
+func testWebhookStoreIncomingThreads(t *testing.T, rctx request.CTX, ss store.Store) {
+	o1, err := ss.Webhook().SaveIncoming(buildIncomingWebhook())
+	require.NoError(t, err)
+
+	_, err = ss.Webhook().GetIncomingThread(o1.Id, "alerts:key")
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+
+	postID := model.NewId()
+	require.NoError(t, ss.Webhook().SaveIncomingThread(o1.Id, "alerts:key", postID))
+
+	threadPostID, err := ss.Webhook().GetIncomingThread(o1.Id, "alerts:key")
+	require.NoError(t, err)
+	require.Equal(t, postID, threadPostID)
+
+	// Saving the thread again replaces its root post.
+	newPostID := model.NewId()
+	require.NoError(t, ss.Webhook().SaveIncomingThread(o1.Id, "alerts:key", newPostID))
+
+	threadPostID, err = ss.Webhook().GetIncomingThread(o1.Id, "alerts:key")
+	require.NoError(t, err)
+	require.Equal(t, newPostID, threadPostID)
+
+	_, err = ss.Webhook().GetIncomingThread(model.NewId(), "alerts:key")
+	require.True(t, errors.As(err, &nfErr))
+
+	require.NoError(t, ss.Webhook().DeleteIncoming(o1.Id, model.GetMillis()))
+
+	_, err = ss.Webhook().GetIncomingThread(o1.Id, "alerts:key")
+	require.True(t, errors.As(err, &nfErr), "the threads of a deleted webhook should be deleted")
 }
 
 func testWebhookStoreGetIncoming(t *testing.T, rctx request.CTX, ss store.Store) {
@@ -564,9 +616,297 @@ func testWebhookStoreUpdateOutgoing(t *testing.T, rctx request.CTX, ss store.Sto
 
 	o1.Token = model.NewId()
 	o1.Username = "another-test-user-name"
+	o1.Secret = model.NewRandomString(model.OutgoingWebhookSecretLength)
 
 	_, err := ss.Webhook().UpdateOutgoing(o1)
 	require.NoError(t, err)
+
+	webhook, err := ss.Webhook().GetOutgoing(o1.Id)
+	require.NoError(t, err)
+	require.Equal(t, o1.Secret, webhook.Secret)
+}
+
+func buildOutgoingWebhookDelivery(hookID string) *model.OutgoingWebhookDelivery {
+	return &model.OutgoingWebhookDelivery{
+		HookId:      hookID,
+		PostId:      model.NewId(),
+		ChannelId:   model.NewId(),
+		URL:         "http://nowhere.com/",
+		ContentType: "application/json",
+		Payload:     `{"text":"hello"}`,
+	}
+}
+
+func testWebhookStoreSaveOutgoingDelivery(t *testing.T, rctx request.CTX, ss store.Store) {
+	d1 := buildOutgoingWebhookDelivery(model.NewId())
+
+	_, err := ss.Webhook().SaveOutgoingDelivery(d1)
+	require.NoError(t, err, "couldn't save item")
+	require.Equal(t, model.OutgoingWebhookDeliveryStatusPending, d1.Status)
+
+	_, err = ss.Webhook().SaveOutgoingDelivery(d1)
+	require.Error(t, err, "shouldn't be able to update from save")
+
+	delivery, err := ss.Webhook().GetOutgoingDelivery(d1.Id)
+	require.NoError(t, err)
+	require.Equal(t, d1, delivery)
+
+	_, err = ss.Webhook().GetOutgoingDelivery(model.NewId())
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr)
+}
+
+func testWebhookStoreUpdateOutgoingDelivery(t *testing.T, rctx request.CTX, ss store.Store) {
+	d1, err := ss.Webhook().SaveOutgoingDelivery(buildOutgoingWebhookDelivery(model.NewId()))
+	require.NoError(t, err)
+
+	d1.Status = model.OutgoingWebhookDeliveryStatusFailed
+	d1.Attempts = 3
+	d1.LastAttemptAt = model.GetMillis()
+	d1.ResponseCode = 503
+	d1.Error = "service unavailable"
+
+	_, err = ss.Webhook().UpdateOutgoingDelivery(d1)
+	require.NoError(t, err)
+
+	delivery, err := ss.Webhook().GetOutgoingDelivery(d1.Id)
+	require.NoError(t, err)
+	require.Equal(t, d1, delivery)
+
+	d1.Status = "unknown"
+	_, err = ss.Webhook().UpdateOutgoingDelivery(d1)
+	require.Error(t, err, "invalid status should fail")
+
+	unsaved := buildOutgoingWebhookDelivery(model.NewId())
+	unsaved.PreSave()
+	_, err = ss.Webhook().UpdateOutgoingDelivery(unsaved)
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr, "a delivery which wasn't saved should not be found")
+}
+
+func testWebhookStoreGetOutgoingDeliveriesByHook(t *testing.T, rctx request.CTX, ss store.Store) {
+	hookID := model.NewId()
+
+	d1, err := ss.Webhook().SaveOutgoingDelivery(buildOutgoingWebhookDelivery(hookID))
+	require.NoError(t, err)
+
+	time.Sleep(2 * time.Millisecond)
+
+	d2, err := ss.Webhook().SaveOutgoingDelivery(buildOutgoingWebhookDelivery(hookID))
+	require.NoError(t, err)
+	d2.Status = model.OutgoingWebhookDeliveryStatusDelivered
+	_, err = ss.Webhook().UpdateOutgoingDelivery(d2)
+	require.NoError(t, err)
+
+	_, err = ss.Webhook().SaveOutgoingDelivery(buildOutgoingWebhookDelivery(model.NewId()))
+	require.NoError(t, err)
+
+	deliveries, err := ss.Webhook().GetOutgoingDeliveriesByHook(hookID, "", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	require.Equal(t, d2.Id, deliveries[0].Id, "newest deliveries should come first")
+	require.Equal(t, d1.Id, deliveries[1].Id)
+
+	deliveries, err = ss.Webhook().GetOutgoingDeliveriesByHook(hookID, "", 1, 1)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, d1.Id, deliveries[0].Id)
+
+	deliveries, err = ss.Webhook().GetOutgoingDeliveriesByHook(hookID, model.OutgoingWebhookDeliveryStatusPending, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, d1.Id, deliveries[0].Id)
+}
+
+func testWebhookStoreGetDueOutgoingDeliveries(t *testing.T, rctx request.CTX, ss store.Store) {
+	// Deliveries left by other tests are due far earlier than these ones.
+	now := model.GetMillis() + int64(time.Hour/time.Millisecond)
+
+	due := buildOutgoingWebhookDelivery(model.NewId())
+	due.NextAttemptAt = now - 1000
+	due, err := ss.Webhook().SaveOutgoingDelivery(due)
+	require.NoError(t, err)
+
+	later := buildOutgoingWebhookDelivery(model.NewId())
+	later.NextAttemptAt = now + 1000
+	_, err = ss.Webhook().SaveOutgoingDelivery(later)
+	require.NoError(t, err)
+
+	failed := buildOutgoingWebhookDelivery(model.NewId())
+	failed.NextAttemptAt = now - 1000
+	failed.Status = model.OutgoingWebhookDeliveryStatusFailed
+	_, err = ss.Webhook().SaveOutgoingDelivery(failed)
+	require.NoError(t, err)
+
+	deliveries, err := ss.Webhook().GetDueOutgoingDeliveries(now, 1000)
+	require.NoError(t, err)
+
+	ids := make([]string, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		ids = append(ids, delivery.Id)
+	}
+	require.Contains(t, ids, due.Id)
+	require.NotContains(t, ids, later.Id)
+	require.NotContains(t, ids, failed.Id)
+}
+
+func testWebhookStoreClaimOutgoingDelivery(t *testing.T, rctx request.CTX, ss store.Store) {
+	d1 := buildOutgoingWebhookDelivery(model.NewId())
+	d1.NextAttemptAt = 1000
+	d1, err := ss.Webhook().SaveOutgoingDelivery(d1)
+	require.NoError(t, err)
+
+	claimed, err := ss.Webhook().ClaimOutgoingDelivery(d1.Id, 1000, 5000)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	claimed, err = ss.Webhook().ClaimOutgoingDelivery(d1.Id, 1000, 6000)
+	require.NoError(t, err)
+	require.False(t, claimed, "a delivery can only be claimed once")
+
+	delivery, err := ss.Webhook().GetOutgoingDelivery(d1.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(5000), delivery.NextAttemptAt)
+}
+
+func testWebhookStorePermanentDeleteOutgoingDeliveriesBatch(t *testing.T, rctx request.CTX, ss store.Store) {
+	delivered, err := ss.Webhook().SaveOutgoingDelivery(buildOutgoingWebhookDelivery(model.NewId()))
+	require.NoError(t, err)
+	delivered.Status = model.OutgoingWebhookDeliveryStatusDelivered
+	_, err = ss.Webhook().UpdateOutgoingDelivery(delivered)
+	require.NoError(t, err)
+
+	pending, err := ss.Webhook().SaveOutgoingDelivery(buildOutgoingWebhookDelivery(model.NewId()))
+	require.NoError(t, err)
+
+	for {
+		deleted, err := ss.Webhook().PermanentDeleteOutgoingDeliveriesBatch(model.GetMillis()+1, 1000)
+		require.NoError(t, err)
+		if deleted < 1000 {
+			break
+		}
+	}
+
+	_, err = ss.Webhook().GetOutgoingDelivery(delivered.Id)
+	require.Error(t, err, "finished deliveries should be deleted")
+
+	_, err = ss.Webhook().GetOutgoingDelivery(pending.Id)
+	require.NoError(t, err, "pending deliveries should be kept")
+}
+
+func buildEventSubscription(eventTypes ...string) *model.EventSubscription {
+	return &model.EventSubscription{
+		CreatorId:   model.NewId(),
+		DisplayName: "Subscription",
+		URL:         "http://nowhere.com/",
+		EventTypes:  eventTypes,
+	}
+}
+
+func testWebhookStoreSaveEventSubscription(t *testing.T, rctx request.CTX, ss store.Store) {
+	s1 := buildEventSubscription(model.EventTypeUserHasJoinedChannel)
+
+	_, err := ss.Webhook().SaveEventSubscription(s1)
+	require.NoError(t, err, "couldn't save item")
+	require.NotEmpty(t, s1.Secret)
+
+	_, err = ss.Webhook().SaveEventSubscription(s1)
+	require.Error(t, err, "shouldn't be able to update from save")
+
+	subscription, err := ss.Webhook().GetEventSubscription(s1.Id)
+	require.NoError(t, err)
+	require.Equal(t, s1, subscription)
+
+	_, err = ss.Webhook().GetEventSubscription(model.NewId())
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr)
+}
+
+func testWebhookStoreGetEventSubscriptions(t *testing.T, rctx request.CTX, ss store.Store) {
+	s1, err := ss.Webhook().SaveEventSubscription(buildEventSubscription(model.EventTypeUserHasJoinedChannel))
+	require.NoError(t, err)
+
+	s2, err := ss.Webhook().SaveEventSubscription(buildEventSubscription(model.EventTypeUserHasLeftChannel))
+	require.NoError(t, err)
+
+	subscriptions, err := ss.Webhook().GetEventSubscriptions(0, 10000)
+	require.NoError(t, err)
+	ids := make([]string, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		ids = append(ids, subscription.Id)
+	}
+	require.Contains(t, ids, s1.Id)
+	require.Contains(t, ids, s2.Id)
+
+	subscriptions, err = ss.Webhook().GetEventSubscriptions(0, 1)
+	require.NoError(t, err)
+	require.Len(t, subscriptions, 1)
+}
+
+func testWebhookStoreGetEventSubscriptionsForEvent(t *testing.T, rctx request.CTX, ss store.Store) {
+	s1, err := ss.Webhook().SaveEventSubscription(buildEventSubscription(model.EventTypeReactionHasBeenAdded, model.EventTypeReactionHasBeenRemoved))
+	require.NoError(t, err)
+
+	s2, err := ss.Webhook().SaveEventSubscription(buildEventSubscription(model.EventTypeReactionHasBeenRemoved))
+	require.NoError(t, err)
+
+	hasSubscription := func(subscriptions []*model.EventSubscription, id string) bool {
+		for _, subscription := range subscriptions {
+			if subscription.Id == id {
+				return true
+			}
+		}
+		return false
+	}
+
+	subscriptions, err := ss.Webhook().GetEventSubscriptionsForEvent(model.EventTypeReactionHasBeenAdded, false)
+	require.NoError(t, err)
+	require.True(t, hasSubscription(subscriptions, s1.Id))
+	require.False(t, hasSubscription(subscriptions, s2.Id))
+
+	subscriptions, err = ss.Webhook().GetEventSubscriptionsForEvent(model.EventTypeReactionHasBeenRemoved, false)
+	require.NoError(t, err)
+	require.True(t, hasSubscription(subscriptions, s1.Id))
+	require.True(t, hasSubscription(subscriptions, s2.Id))
+
+	err = ss.Webhook().DeleteEventSubscription(s2.Id, model.GetMillis())
+	require.NoError(t, err)
+
+	subscriptions, err = ss.Webhook().GetEventSubscriptionsForEvent(model.EventTypeReactionHasBeenRemoved, false)
+	require.NoError(t, err)
+	require.False(t, hasSubscription(subscriptions, s2.Id))
+}
+
+func testWebhookStoreUpdateEventSubscription(t *testing.T, rctx request.CTX, ss store.Store) {
+	s1, err := ss.Webhook().SaveEventSubscription(buildEventSubscription(model.EventTypeUserHasBeenCreated))
+	require.NoError(t, err)
+
+	s1.EventTypes = model.StringArray{model.EventTypeUserHasBeenDeactivated}
+	s1.TeamId = model.NewId()
+	s1.Secret = model.NewRandomString(model.OutgoingWebhookSecretLength)
+
+	_, err = ss.Webhook().UpdateEventSubscription(s1)
+	require.NoError(t, err)
+
+	subscription, err := ss.Webhook().GetEventSubscription(s1.Id)
+	require.NoError(t, err)
+	require.Equal(t, s1, subscription)
+
+	s1.EventTypes = model.StringArray{"Unknown"}
+	_, err = ss.Webhook().UpdateEventSubscription(s1)
+	require.Error(t, err)
+}
+
+func testWebhookStoreDeleteEventSubscription(t *testing.T, rctx request.CTX, ss store.Store) {
+	s1, err := ss.Webhook().SaveEventSubscription(buildEventSubscription(model.EventTypeUserHasBeenCreated))
+	require.NoError(t, err)
+
+	err = ss.Webhook().DeleteEventSubscription(s1.Id, model.GetMillis())
+	require.NoError(t, err)
+
+	_, err = ss.Webhook().GetEventSubscription(s1.Id)
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr)
 }
 
 func testWebhookStoreCountIncoming(t *testing.T, rctx request.CTX, ss store.Store) {
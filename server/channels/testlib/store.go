@@ -124,6 +124,11 @@ func GetMockStoreForSetupFunctions() *mocks.Store {
 	pluginStore := mocks.PluginStore{}
 	pluginStore.On("List", mock.Anything, mock.Anything, mock.Anything).Return([]string{}, nil)
 
+	configRevisionStore := mocks.ConfigRevisionStore{}
+	configRevisionStore.On("GetAll", mock.Anything, mock.Anything).Return([]*model.ConfigRevision{}, nil)
+	configRevisionStore.On("Save", mock.AnythingOfType("*model.ConfigRevision")).Return(&model.ConfigRevision{}, nil)
+	configRevisionStore.On("PermanentDeleteAllButLatest", mock.Anything).Return(nil)
+
 	propertyGroupStore := mocks.PropertyGroupStore{}
 	propertyFieldStore := mocks.PropertyFieldStore{}
 	propertyValueStore := mocks.PropertyValueStore{}
@@ -150,6 +155,7 @@ func GetMockStoreForSetupFunctions() *mocks.Store {
 	mockStore.On("Group").Return(&groupStore)
 	mockStore.On("GetDBSchemaVersion").Return(1, nil)
 	mockStore.On("Plugin").Return(&pluginStore)
+	mockStore.On("ConfigRevision").Return(&configRevisionStore)
 	mockStore.On("PropertyGroup").Return(&propertyGroupStore)
 	mockStore.On("PropertyField").Return(&propertyFieldStore)
 	mockStore.On("PropertyValue").Return(&propertyValueStore)
@@ -412,6 +412,50 @@ func (c *Context) RequirePostId() *Context {
 	return c
 }
 
+func (c *Context) RequirePollId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.PollId) {
+		c.SetInvalidURLParam("poll_id")
+	}
+	return c
+}
+
+func (c *Context) RequireCredentialId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.CredentialId) {
+		c.SetInvalidURLParam("credential_id")
+	}
+	return c
+}
+
+func (c *Context) RequireRevisionId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.RevisionId) {
+		c.SetInvalidURLParam("revision_id")
+	}
+	return c
+}
+
+func (c *Context) RequireTemplateName() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidEmailTemplateName(c.Params.TemplateName) {
+		c.SetInvalidURLParam("template_name")
+	}
+	return c
+}
+
 func (c *Context) RequirePolicyId() *Context {
 	if c.Err != nil {
 		return c
@@ -270,11 +270,12 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			c.Err = model.NewAppError("ServeHTTP", "api.context.token_provided.app_error", nil, "token="+token, http.StatusUnauthorized)
 		} else {
 			c.AppContext = c.AppContext.WithSession(session)
+			c.App.UpdateUserAccessTokenLastUsedIfNeeded(c.AppContext, session)
 		}
 
-		// Rate limit by UserID
+		// Rate limit by UserID, or by token for bots and integrations
 		if c.App.Srv().RateLimiter != nil {
-			rateLimitExceeded = c.App.Srv().RateLimiter.UserIdRateLimit(c.AppContext.Session().UserId, w)
+			rateLimitExceeded = c.App.Srv().RateLimiter.SessionRateLimit(c.AppContext.Session(), w)
 			if rateLimitExceeded {
 				return
 			}
@@ -35,6 +35,7 @@ type Params struct {
 	TimeRange                          string
 	ChannelId                          string
 	PostId                             string
+	PollId                             string
 	PolicyId                           string
 	FileId                             string
 	Filename                           string
@@ -119,6 +120,15 @@ type Params struct {
 
 	// Custom Profile Attributes
 	FieldId string
+
+	// WebAuthn
+	CredentialId string
+
+	// Config revisions
+	RevisionId string
+
+	// Email templates
+	TemplateName string
 }
 
 var getChannelMembersForUserRegex = regexp.MustCompile("/api/v4/users/[A-Za-z0-9]{26}/channel_members")
@@ -144,6 +154,7 @@ func ParamsFromRequest(r *http.Request) *Params {
 	}
 
 	params.PostId = props["post_id"]
+	params.PollId = props["poll_id"]
 	params.PolicyId = props["policy_id"]
 	params.FileId = props["file_id"]
 	params.Filename = query.Get("filename")
@@ -190,6 +201,9 @@ func ParamsFromRequest(r *http.Request) *Params {
 	params.ExcludeRemote, _ = strconv.ParseBool(query.Get("exclude_remote"))
 	params.ChannelBookmarkId = props["bookmark_id"]
 	params.FieldId = props["field_id"]
+	params.CredentialId = props["credential_id"]
+	params.RevisionId = props["revision_id"]
+	params.TemplateName = props["template_name"]
 	params.Scope = query.Get("scope")
 
 	if val, err := strconv.Atoi(query.Get("page")); err != nil || (val < 0 && params.UserId == "" && !getChannelMembersForUserRegex.MatchString(r.URL.Path)) {
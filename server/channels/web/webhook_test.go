@@ -228,6 +228,30 @@ func TestIncomingWebhook(t *testing.T) {
 		assert.True(t, resp.StatusCode == http.StatusForbidden)
 	})
 
+	t.Run("AdapterWebhook", func(t *testing.T) {
+		hook, err := th.App.CreateIncomingWebhookForChannel(th.BasicUser.Id, th.BasicChannel, &model.IncomingWebhook{ChannelId: th.BasicChannel.Id, Adapter: model.IncomingWebhookAdapterAlertmanager})
+		require.Nil(t, err)
+
+		apiHookURL := apiClient.URL + "/hooks/" + hook.Id
+
+		payload := `{"status": "firing", "groupKey": "{}:{alertname=\"HighLatency\"}", "groupLabels": {"alertname": "HighLatency"}, "alerts": [{"status": "firing", "labels": {"alertname": "HighLatency"}, "fingerprint": "c4ef4fd7b7f5d1e2"}]}`
+		resp, err2 := http.Post(apiHookURL, "application/json", strings.NewReader(payload))
+		require.NoError(t, err2)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		posts, err := th.App.GetPosts(th.BasicChannel.Id, 0, 1)
+		require.Nil(t, err)
+		require.Len(t, posts.Order, 1)
+		post := posts.Posts[posts.Order[0]]
+		assert.Equal(t, model.PostTypeSlackAttachment, post.Type)
+		require.Len(t, post.Attachments(), 1)
+		assert.Equal(t, "[FIRING:1] HighLatency", post.Attachments()[0].Title)
+
+		resp, err2 = http.Post(apiHookURL, "application/json", strings.NewReader(`{"text": "this is a test"}`))
+		require.NoError(t, err2)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "should have errored - not an Alertmanager payload")
+	})
+
 	t.Run("DisableWebhooks", func(t *testing.T) {
 		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableIncomingWebhooks = false })
 		resp, err := http.Post(url, "application/json", strings.NewReader("{\"text\":\"this is a test\"}"))
@@ -50,6 +50,7 @@ func init() {
 	BulkExportCmd.Flags().Bool("with-profile-pictures", false, "Also exports profile pictures.")
 	BulkExportCmd.Flags().Bool("attachments", false, "Also export file attachments.")
 	BulkExportCmd.Flags().Bool("archive", false, "Outputs a single archive file.")
+	BulkExportCmd.Flags().Int64("since", 0, "Only exports what was created, updated or deleted since this timestamp, expressed in milliseconds since the unix epoch.")
 
 	ExportCmd.AddCommand(ScheduleExportCmd)
 	ExportCmd.AddCommand(BulkExportCmd)
@@ -157,6 +158,11 @@ func bulkExportCmdF(command *cobra.Command, args []string) error {
 		return errors.Wrap(err, "with-profile-pictures flag error")
 	}
 
+	since, err := command.Flags().GetInt64("since")
+	if err != nil {
+		return errors.Wrap(err, "since flag error")
+	}
+
 	fileWriter, err := os.Create(args[0])
 	if err != nil {
 		return err
@@ -173,6 +179,7 @@ func bulkExportCmdF(command *cobra.Command, args []string) error {
 	opts.CreateArchive = archive
 	opts.IncludeArchivedChannels = withArchivedChannels
 	opts.IncludeProfilePictures = includeProfilePictures
+	opts.IncrementalSince = since
 	if err := a.BulkExport(rctx, fileWriter, filepath.Dir(outPath), nil /* nil job since it's spawned from CLI */, opts); err != nil {
 		CommandPrintErrorln(err.Error())
 		return err
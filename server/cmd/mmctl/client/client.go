@@ -66,6 +66,7 @@ type Client interface {
 	GetUserByEmail(ctx context.Context, email, etag string) (*model.User, *model.Response, error)
 	GetUsersByIds(ctx context.Context, userIDs []string) ([]*model.User, *model.Response, error)
 	GetUsersWithCustomQueryParameters(ctx context.Context, page, perPage int, queryParameters string, etag string) ([]*model.User, *model.Response, error)
+	GetPasswordHashReport(ctx context.Context) ([]*model.PasswordHashReport, *model.Response, error)
 	GetUsersInTeam(ctx context.Context, teamID string, page, perPage int, etag string) ([]*model.User, *model.Response, error)
 	PermanentDeleteUser(ctx context.Context, userID string) (*model.Response, error)
 	PermanentDeleteAllUsers(ctx context.Context) (*model.Response, error)
@@ -80,6 +81,7 @@ type Client interface {
 	UpdateUserPassword(ctx context.Context, userID, currentPassword, newPassword string) (*model.Response, error)
 	UpdateUserHashedPassword(ctx context.Context, userID, newHashedPassword string) (*model.Response, error)
 	CreateUserAccessToken(ctx context.Context, userID, description string) (*model.UserAccessToken, *model.Response, error)
+	CreateUserAccessTokenWithOptions(ctx context.Context, userID string, token *model.UserAccessToken) (*model.UserAccessToken, *model.Response, error)
 	RevokeUserAccessToken(ctx context.Context, tokenID string) (*model.Response, error)
 	GetUserAccessTokensForUser(ctx context.Context, userID string, page, perPage int) ([]*model.UserAccessToken, *model.Response, error)
 	ConvertUserToBot(ctx context.Context, userID string) (*model.Bot, *model.Response, error)
@@ -99,6 +101,9 @@ type Client interface {
 	PatchConfig(context.Context, *model.Config) (*model.Config, *model.Response, error)
 	ReloadConfig(ctx context.Context) (*model.Response, error)
 	MigrateConfig(ctx context.Context, from, to string) (*model.Response, error)
+	GetConfigRevisions(ctx context.Context, page, perPage int) ([]*model.ConfigRevision, *model.Response, error)
+	GetConfigRevisionDiff(ctx context.Context, revisionID, base string) ([]*model.ConfigChange, *model.Response, error)
+	RollbackConfig(ctx context.Context, revisionID string) (*model.Config, *model.Response, error)
 	SyncLdap(ctx context.Context) (*model.Response, error)
 	MigrateIdLdap(ctx context.Context, toAttribute string) (*model.Response, error)
 	GetUsers(ctx context.Context, page, perPage int, etag string) ([]*model.User, *model.Response, error)
@@ -150,10 +155,27 @@ type Client interface {
 	GetOutgoingWebhooksForChannel(ctx context.Context, channelID string, page int, perPage int, etag string) ([]*model.OutgoingWebhook, *model.Response, error)
 	GetOutgoingWebhooksForTeam(ctx context.Context, teamID string, page int, perPage int, etag string) ([]*model.OutgoingWebhook, *model.Response, error)
 	RegenOutgoingHookToken(ctx context.Context, hookID string) (*model.OutgoingWebhook, *model.Response, error)
+	RegenOutgoingHookSecret(ctx context.Context, hookID string) (*model.OutgoingWebhook, *model.Response, error)
+	GetOutgoingWebhookDeliveries(ctx context.Context, hookID string, status string, page int, perPage int) ([]*model.OutgoingWebhookDelivery, *model.Response, error)
+	ReplayOutgoingWebhookDelivery(ctx context.Context, hookID, deliveryID string) (*model.OutgoingWebhookDelivery, *model.Response, error)
+	CreateEventSubscription(ctx context.Context, subscription *model.EventSubscription) (*model.EventSubscription, *model.Response, error)
+	GetEventSubscriptions(ctx context.Context, page int, perPage int) ([]*model.EventSubscription, *model.Response, error)
+	GetEventSubscription(ctx context.Context, subscriptionID string) (*model.EventSubscription, *model.Response, error)
+	PatchEventSubscription(ctx context.Context, subscriptionID string, patch *model.EventSubscriptionPatch) (*model.EventSubscription, *model.Response, error)
+	RegenEventSubscriptionSecret(ctx context.Context, subscriptionID string) (*model.EventSubscription, *model.Response, error)
+	DeleteEventSubscription(ctx context.Context, subscriptionID string) (*model.Response, error)
+	GetEmailTemplates(ctx context.Context, locale string) ([]*model.EmailTemplate, *model.Response, error)
+	GetEmailTemplate(ctx context.Context, name string, locale string) (*model.EmailTemplate, *model.Response, error)
+	UpdateEmailTemplate(ctx context.Context, emailTemplate *model.EmailTemplate) (*model.EmailTemplate, *model.Response, error)
+	DeleteEmailTemplate(ctx context.Context, name string, locale string) (*model.EmailTemplate, *model.Response, error)
+	PreviewEmailTemplate(ctx context.Context, emailTemplate *model.EmailTemplate, email string) (*model.Response, error)
+	SearchAuditLogs(ctx context.Context, search *model.AuditLogSearch) ([]*model.AuditLog, *model.Response, error)
+	VerifyAuditLogs(ctx context.Context) (*model.AuditLogVerification, *model.Response, error)
 	DeleteOutgoingWebhook(ctx context.Context, hookID string) (*model.Response, error)
 	ListExports(ctx context.Context) ([]string, *model.Response, error)
 	DeleteExport(ctx context.Context, name string) (*model.Response, error)
 	DownloadExport(ctx context.Context, name string, wr io.Writer, offset int64) (int64, *model.Response, error)
+	DownloadImportErrorReport(ctx context.Context, name string, wr io.Writer) (int64, *model.Response, error)
 	DownloadComplianceExport(ctx context.Context, jobID string, wr io.Writer) (string, error)
 	GeneratePresignedURL(ctx context.Context, name string) (*model.PresignURLResponse, *model.Response, error)
 	ResetSamlAuthDataToEmail(ctx context.Context, includeDeleted bool, dryRun bool, userIDs []string) (int64, *model.Response, error)
@@ -172,5 +194,14 @@ type Client interface {
 	DeleteCPAField(ctx context.Context, fieldID string) (*model.Response, error)
 	ListCPAValues(ctx context.Context, userID string) (map[string]json.RawMessage, *model.Response, error)
 	PatchCPAValues(ctx context.Context, values map[string]json.RawMessage) (map[string]json.RawMessage, *model.Response, error)
+	GetTeamMembers(ctx context.Context, teamID string, page int, perPage int, etag string) ([]*model.TeamMember, *model.Response, error)
+	UpdateTeamScheme(ctx context.Context, teamID, schemeID string) (*model.Response, error)
+	UpdateChannelScheme(ctx context.Context, channelID, schemeID string) (*model.Response, error)
+	GetSchemes(ctx context.Context, scope string, page int, perPage int) ([]*model.Scheme, *model.Response, error)
+	CreateScheme(ctx context.Context, scheme *model.Scheme) (*model.Scheme, *model.Response, error)
+	PatchScheme(ctx context.Context, schemeID string, patch *model.SchemePatch) (*model.Scheme, *model.Response, error)
+	GetSidebarCategoriesForTeamForUser(ctx context.Context, userID, teamID, etag string) (*model.OrderedSidebarCategories, *model.Response, error)
+	CreateSidebarCategoryForTeamForUser(ctx context.Context, userID, teamID string, category *model.SidebarCategoryWithChannels) (*model.SidebarCategoryWithChannels, *model.Response, error)
+	UpdateSidebarCategoryForTeamForUser(ctx context.Context, userID, teamID, categoryID string, category *model.SidebarCategoryWithChannels) (*model.SidebarCategoryWithChannels, *model.Response, error)
 	PatchCPAValuesForUser(ctx context.Context, userID string, values map[string]json.RawMessage) (map[string]json.RawMessage, *model.Response, error)
 }
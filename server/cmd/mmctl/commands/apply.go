@@ -0,0 +1,180 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/client"
+	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/printer"
+)
+
+const workspaceLong = `The workspace configuration is a YAML file declaring schemes, role permissions, bots and teams, with their channels, members, slash commands and webhooks:
+
+  schemes:
+    - name: engineering
+      scope: team
+      permissions:
+        team_user: [create_public_channel, create_private_channel]
+  roles:
+    - name: system_user
+      permissions: [create_team, create_direct_channel]
+  bots:
+    - username: deploybot
+      display_name: Deploy Bot
+  teams:
+    - name: engineering
+      display_name: Engineering
+      type: invite
+      scheme: engineering
+      members: [alice, bob, deploybot]
+      channels:
+        - name: backend
+          type: private
+          header: Backend discussions
+          category: Backend
+          members: [alice, deploybot]
+      commands:
+        - trigger: deploy
+          url: https://deploy.example.com/mattermost
+      incoming_webhooks:
+        - display_name: CI
+          channel: backend
+      outgoing_webhooks:
+        - display_name: Alerts
+          channel: backend
+          trigger_words: [alert]
+          callback_urls: [https://alerts.example.com/mattermost]
+
+The optional fields of teams and channels that are not declared, such as headers, are left untouched, and members are only managed when they are listed. With --prune, the channels, members, slash commands and webhooks of the declared teams, and the bots, that are not declared are removed: channels are archived and bots are disabled. Bots owned by plugins are never pruned.`
+
+var ApplyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Apply a workspace configuration",
+	Long:  "Show the changes needed for the server to match a workspace configuration and, once confirmed, apply them.\n\n" + workspaceLong,
+	Example: `  apply -f workspace.yaml
+  apply -f teams.yaml -f integrations.yaml --prune --confirm`,
+	Args: cobra.NoArgs,
+	RunE: withClient(applyCmdF),
+}
+
+var DiffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Show the changes a workspace configuration would make",
+	Long:  "Show the changes needed for the server to match a workspace configuration, without applying them.\n\n" + workspaceLong,
+	Example: `  diff -f workspace.yaml
+  diff -f workspace.yaml --prune --json`,
+	Args: cobra.NoArgs,
+	RunE: withClient(diffCmdF),
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{ApplyCmd, DiffCmd} {
+		cmd.Flags().StringArrayP("file", "f", nil, "Workspace configuration file, or - to read from standard input (required)")
+		_ = cmd.MarkFlagRequired("file")
+		cmd.Flags().Bool("prune", false, "Remove the channels, members, slash commands, webhooks and bots that are not declared")
+	}
+	ApplyCmd.Flags().Bool("confirm", false, "Apply the changes without asking for confirmation")
+
+	RootCmd.AddCommand(ApplyCmd, DiffCmd)
+}
+
+const workspaceChangeTemplate = "{{.Symbol}} {{.Action}} {{.Kind}} {{.Name}}{{range .Fields}}\n    {{.}}{{end}}"
+
+// planWorkspace reads the workspace configuration files of the command and computes the
+// changes needed for the server to match it.
+func planWorkspace(c client.Client, cmd *cobra.Command) ([]*workspaceChange, error) {
+	files, _ := cmd.Flags().GetStringArray("file")
+	prune, _ := cmd.Flags().GetBool("prune")
+
+	var documents [][]byte
+	for _, file := range files {
+		var data []byte
+		var err error
+		if file == "-" {
+			data, err = io.ReadAll(os.Stdin)
+		} else {
+			data, err = os.ReadFile(file)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %q", file)
+		}
+		documents = append(documents, data)
+	}
+
+	spec, err := parseWorkspaceSpecs(documents...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newWorkspacePlanner(c, prune).plan(context.TODO(), spec)
+}
+
+// printWorkspacePlan prints the changes and a summary of them.
+func printWorkspacePlan(changes []*workspaceChange) {
+	if len(changes) == 0 {
+		printer.Print("No changes. The server matches the workspace configuration.")
+		return
+	}
+
+	var created, updated, deleted int
+	for _, change := range changes {
+		printer.PrintT(workspaceChangeTemplate, change)
+		switch change.Symbol() {
+		case "+":
+			created++
+		case "-":
+			deleted++
+		default:
+			updated++
+		}
+	}
+	printer.Print(fmt.Sprintf("Plan: %d to add, %d to change, %d to remove.", created, updated, deleted))
+}
+
+func diffCmdF(c client.Client, cmd *cobra.Command, args []string) error {
+	changes, err := planWorkspace(c, cmd)
+	if err != nil {
+		return err
+	}
+
+	printWorkspacePlan(changes)
+	return nil
+}
+
+func applyCmdF(c client.Client, cmd *cobra.Command, args []string) error {
+	changes, err := planWorkspace(c, cmd)
+	if err != nil {
+		return err
+	}
+
+	printWorkspacePlan(changes)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	confirmFlag, _ := cmd.Flags().GetBool("confirm")
+	if !confirmFlag {
+		if err := getConfirmation("Do you want to apply these changes?", false); err != nil {
+			return err
+		}
+	}
+
+	// Later changes depend on the objects created by earlier ones, so the first failure
+	// stops the apply. Running it again plans the remaining changes.
+	for i, change := range changes {
+		if err := change.apply(context.TODO()); err != nil {
+			return fmt.Errorf("failed to %s %s %s, %d of %d changes applied: %w", change.Action, change.Kind, change.Name, i, len(changes), err)
+		}
+	}
+
+	printer.Print(fmt.Sprintf("Apply complete: %d changes applied.", len(changes)))
+	return nil
+}
@@ -0,0 +1,267 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/printer"
+)
+
+func (s *MmctlUnitTestSuite) newWorkspaceCmd(spec string, prune bool) *cobra.Command {
+	file := filepath.Join(s.T().TempDir(), "workspace.yaml")
+	s.Require().NoError(os.WriteFile(file, []byte(spec), 0600))
+
+	cmd := &cobra.Command{}
+	cmd.Flags().StringArray("file", []string{file}, "")
+	cmd.Flags().Bool("prune", prune, "")
+	cmd.Flags().Bool("confirm", true, "")
+	return cmd
+}
+
+func (s *MmctlUnitTestSuite) expectNoSchemesNorBots() {
+	s.client.
+		EXPECT().
+		GetSchemes(context.TODO(), "", 0, workspacePerPage).
+		Return([]*model.Scheme{}, &model.Response{}, nil).
+		Times(1)
+	s.client.
+		EXPECT().
+		GetBotsIncludeDeleted(context.TODO(), 0, workspacePerPage, "").
+		Return([]*model.Bot{}, &model.Response{}, nil).
+		Times(1)
+}
+
+func (s *MmctlUnitTestSuite) TestParseWorkspaceSpecs() {
+	s.Run("Merge documents", func() {
+		spec, err := parseWorkspaceSpecs(
+			[]byte("teams:\n  - name: first\n"),
+			[]byte("teams:\n  - name: second\nbots:\n  - username: bot\n"),
+		)
+		s.Require().NoError(err)
+		s.Require().Len(spec.Teams, 2)
+		s.Require().Len(spec.Bots, 1)
+		s.Require().Nil(spec.Teams[0].Members)
+	})
+
+	for name, document := range map[string]string{
+		"Duplicate team":                   "teams:\n  - name: team\n  - name: team\n",
+		"Invalid channel type":             "teams:\n  - name: team\n    channels:\n      - name: channel\n        type: secret\n",
+		"Category without members":         "teams:\n  - name: team\n    channels:\n      - name: channel\n        category: Work\n",
+		"Incoming webhook without channel": "teams:\n  - name: team\n    incoming_webhooks:\n      - display_name: CI\n",
+		"Unknown scheme role":              "schemes:\n  - name: scheme\n    scope: channel\n    permissions:\n      team_user: [create_team]\n",
+	} {
+		s.Run(name, func() {
+			_, err := parseWorkspaceSpecs([]byte(document))
+			s.Require().Error(err)
+		})
+	}
+}
+
+func (s *MmctlUnitTestSuite) TestDiffCmd() {
+	s.Run("Diff an existing team", func() {
+		printer.Clean()
+
+		team := &model.Team{Id: model.NewId(), Name: "team", DisplayName: "Team", Type: model.TeamOpen}
+		alice := &model.User{Id: model.NewId(), Username: "alice"}
+		bob := &model.User{Id: model.NewId(), Username: "bob"}
+		general := &model.Channel{Id: model.NewId(), TeamId: team.Id, Name: "general", Header: "Old header", Type: model.ChannelTypeOpen}
+		old := &model.Channel{Id: model.NewId(), TeamId: team.Id, Name: "old", Type: model.ChannelTypeOpen}
+		townSquare := &model.Channel{Id: model.NewId(), TeamId: team.Id, Name: model.DefaultChannelName, Type: model.ChannelTypeOpen}
+
+		cmd := s.newWorkspaceCmd(`
+teams:
+  - name: team
+    members: [alice]
+    channels:
+      - name: general
+        header: New header
+      - name: new-channel
+        type: private
+`, true)
+
+		s.expectNoSchemesNorBots()
+		s.client.
+			EXPECT().
+			GetTeamByName(context.TODO(), "team", "").
+			Return(team, &model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			GetTeamMembers(context.TODO(), team.Id, 0, workspacePerPage, "").
+			Return([]*model.TeamMember{{TeamId: team.Id, UserId: alice.Id}, {TeamId: team.Id, UserId: bob.Id}}, &model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			GetUserByUsername(context.TODO(), "alice", "").
+			Return(alice, &model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			GetUsersByIds(context.TODO(), []string{bob.Id}).
+			Return([]*model.User{bob}, &model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			GetChannelByNameIncludeDeleted(context.TODO(), "general", team.Id, "").
+			Return(general, &model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			GetChannelByNameIncludeDeleted(context.TODO(), "new-channel", team.Id, "").
+			Return(nil, &model.Response{StatusCode: http.StatusNotFound}, errors.New("mock error")).
+			Times(1)
+		s.client.
+			EXPECT().
+			GetPublicChannelsForTeam(context.TODO(), team.Id, 0, workspacePerPage, "").
+			Return([]*model.Channel{townSquare, general, old}, &model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			GetPrivateChannelsForTeam(context.TODO(), team.Id, 0, workspacePerPage, "").
+			Return([]*model.Channel{}, &model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			ListCommands(context.TODO(), team.Id, true).
+			Return([]*model.Command{}, &model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			GetIncomingWebhooksForTeam(context.TODO(), team.Id, 0, workspacePerPage, "").
+			Return([]*model.IncomingWebhook{}, &model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			GetOutgoingWebhooksForTeam(context.TODO(), team.Id, 0, workspacePerPage, "").
+			Return([]*model.OutgoingWebhook{}, &model.Response{}, nil).
+			Times(1)
+
+		err := diffCmdF(s.client, cmd, []string{})
+		s.Require().NoError(err)
+
+		lines := printer.GetLines()
+		s.Require().Len(lines, 5)
+		s.Require().Equal(&workspaceChange{Action: workspaceActionUpdate, Kind: "team members", Name: "team", Fields: []string{"- bob"}}, stripApply(lines[0]))
+		s.Require().Equal(&workspaceChange{Action: workspaceActionUpdate, Kind: "channel", Name: "team/general", Fields: []string{`header: "Old header" => "New header"`}}, stripApply(lines[1]))
+		s.Require().Equal(&workspaceChange{Action: workspaceActionCreate, Kind: "channel", Name: "team/new-channel"}, stripApply(lines[2]))
+		s.Require().Equal(&workspaceChange{Action: workspaceActionDelete, Kind: "channel", Name: "team/old"}, stripApply(lines[3]))
+		s.Require().Equal("Plan: 1 to add, 2 to change, 1 to remove.", lines[4])
+	})
+
+	s.Run("Unknown member", func() {
+		printer.Clean()
+
+		cmd := s.newWorkspaceCmd("teams:\n  - name: team\n    members: [nobody]\n", false)
+
+		s.expectNoSchemesNorBots()
+		s.client.
+			EXPECT().
+			GetTeamByName(context.TODO(), "team", "").
+			Return(nil, &model.Response{StatusCode: http.StatusNotFound}, errors.New("mock error")).
+			Times(1)
+		s.client.
+			EXPECT().
+			GetUserByUsername(context.TODO(), "nobody", "").
+			Return(nil, &model.Response{StatusCode: http.StatusNotFound}, errors.New("mock error")).
+			Times(1)
+
+		err := diffCmdF(s.client, cmd, []string{})
+		s.Require().ErrorContains(err, `failed to find user "nobody"`)
+		s.Require().Len(printer.GetLines(), 0)
+	})
+}
+
+func (s *MmctlUnitTestSuite) TestApplyCmd() {
+	s.Run("Create a team with a channel and a bot member", func() {
+		printer.Clean()
+
+		team := &model.Team{Id: model.NewId(), Name: "team", DisplayName: "Team", Type: model.TeamInvite}
+		channel := &model.Channel{Id: model.NewId(), TeamId: team.Id, Name: "backend", DisplayName: "backend", Type: model.ChannelTypePrivate}
+		bot := &model.Bot{UserId: model.NewId(), Username: "deploybot"}
+
+		cmd := s.newWorkspaceCmd(`
+bots:
+  - username: deploybot
+teams:
+  - name: team
+    display_name: Team
+    type: invite
+    members: [deploybot]
+    channels:
+      - name: backend
+        type: private
+`, false)
+
+		s.expectNoSchemesNorBots()
+		s.client.
+			EXPECT().
+			GetTeamByName(context.TODO(), "team", "").
+			Return(nil, &model.Response{StatusCode: http.StatusNotFound}, errors.New("mock error")).
+			Times(1)
+		s.client.
+			EXPECT().
+			CreateBot(context.TODO(), &model.Bot{Username: "deploybot"}).
+			Return(bot, &model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			CreateTeam(context.TODO(), &model.Team{Name: "team", DisplayName: "Team", Type: model.TeamInvite}).
+			Return(team, &model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			AddTeamMember(context.TODO(), team.Id, bot.UserId).
+			Return(&model.TeamMember{}, &model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			CreateChannel(context.TODO(), &model.Channel{TeamId: team.Id, Name: "backend", DisplayName: "backend", Type: model.ChannelTypePrivate}).
+			Return(channel, &model.Response{}, nil).
+			Times(1)
+
+		err := applyCmdF(s.client, cmd, []string{})
+		s.Require().NoError(err)
+
+		lines := printer.GetLines()
+		s.Require().Len(lines, 6)
+		s.Require().Equal("Plan: 3 to add, 1 to change, 0 to remove.", lines[4])
+		s.Require().Equal("Apply complete: 4 changes applied.", lines[5])
+	})
+
+	s.Run("Stop at the first failure", func() {
+		printer.Clean()
+
+		cmd := s.newWorkspaceCmd("teams:\n  - name: team\n    channels:\n      - name: backend\n", false)
+
+		s.expectNoSchemesNorBots()
+		s.client.
+			EXPECT().
+			GetTeamByName(context.TODO(), "team", "").
+			Return(nil, &model.Response{StatusCode: http.StatusNotFound}, errors.New("mock error")).
+			Times(1)
+		s.client.
+			EXPECT().
+			CreateTeam(context.TODO(), &model.Team{Name: "team", DisplayName: "team", Type: model.TeamOpen}).
+			Return(nil, &model.Response{}, errors.New("mock error")).
+			Times(1)
+
+		err := applyCmdF(s.client, cmd, []string{})
+		s.Require().EqualError(err, "failed to create team team, 0 of 2 changes applied: mock error")
+	})
+}
+
+// stripApply returns a printed change without its apply function, for comparison.
+func stripApply(line any) *workspaceChange {
+	change := *line.(*workspaceChange)
+	change.apply = nil
+	return &change
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/client"
+	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/printer"
+)
+
+var AuditLogCmd = &cobra.Command{
+	Use:   "audit-log",
+	Short: "Management of the audit logs kept in the database",
+	Long:  "Search and verify the audit logs kept in the database when ExperimentalAuditSettings.StoreEnabled is set.",
+}
+
+var SearchAuditLogsCmd = &cobra.Command{
+	Use:   "search",
+	Short: "Search the audit logs",
+	Long:  "Search the audit logs by the user who did the action, the event, an object involved and a time range. The latest audit logs are listed first.",
+	Example: `  audit-log search --object-id 8hgd8tnqr7rhbkkmzkjptrwfdc --event removeChannelMember
+  audit-log search --user-id q5s1rrsbhjdfbxuyc8o4idyxnh --since 2026-10-01T00:00:00+00:00`,
+	Args: cobra.NoArgs,
+	RunE: withClient(searchAuditLogsCmdF),
+}
+
+var VerifyAuditLogsCmd = &cobra.Command{
+	Use:     "verify",
+	Short:   "Verify the audit logs",
+	Long:    "Verify the chain of the audit logs from the last one deleted for retention, and report the first one which was changed or follows a removed one.",
+	Example: "  audit-log verify",
+	Args:    cobra.NoArgs,
+	RunE:    withClient(verifyAuditLogsCmdF),
+}
+
+func init() {
+	SearchAuditLogsCmd.Flags().String("user-id", "", "Id of the user who did the action")
+	SearchAuditLogsCmd.Flags().String("event", "", "Name of the event, like removeChannelMember")
+	SearchAuditLogsCmd.Flags().String("object-id", "", "Id of an object involved in the event, like a channel or a user")
+	SearchAuditLogsCmd.Flags().String("since", "", "List the audit logs created from a certain time (ISO 8601)")
+	SearchAuditLogsCmd.Flags().String("until", "", "List the audit logs created up to a certain time (ISO 8601)")
+	SearchAuditLogsCmd.Flags().Int("page", 0, "Page number to fetch for the list of audit logs")
+	SearchAuditLogsCmd.Flags().Int("per-page", DefaultPageSize, "Number of audit logs to be fetched")
+
+	AuditLogCmd.AddCommand(
+		SearchAuditLogsCmd,
+		VerifyAuditLogsCmd,
+	)
+
+	RootCmd.AddCommand(AuditLogCmd)
+}
+
+func auditLogTimeFlag(cmd *cobra.Command, name string) (int64, error) {
+	value, _ := cmd.Flags().GetString(name)
+	if value == "" {
+		return 0, nil
+	}
+
+	t, err := time.Parse(ISO8601Layout, value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s time '%s'", name, value)
+	}
+	return model.GetMillisForTime(t), nil
+}
+
+func searchAuditLogsCmdF(c client.Client, cmd *cobra.Command, _ []string) error {
+	search := &model.AuditLogSearch{}
+	search.UserId, _ = cmd.Flags().GetString("user-id")
+	search.EventName, _ = cmd.Flags().GetString("event")
+	search.ObjectId, _ = cmd.Flags().GetString("object-id")
+	search.Page, _ = cmd.Flags().GetInt("page")
+	search.PerPage, _ = cmd.Flags().GetInt("per-page")
+
+	var err error
+	if search.Since, err = auditLogTimeFlag(cmd, "since"); err != nil {
+		return err
+	}
+	if search.Until, err = auditLogTimeFlag(cmd, "until"); err != nil {
+		return err
+	}
+
+	auditLogs, _, err := c.SearchAuditLogs(context.TODO(), search)
+	if err != nil {
+		return fmt.Errorf("failed to search the audit logs: %w", err)
+	}
+
+	printer.SetTemplateFunc("millisToTime", func(millis int64) string {
+		return time.UnixMilli(millis).UTC().Format(time.RFC3339)
+	})
+
+	for _, auditLog := range auditLogs {
+		printer.PrintT("{{millisToTime .CreateAt}} {{.EventName}} {{.Status}}{{if .UserId}} by {{.UserId}}{{end}}", auditLog)
+	}
+
+	return nil
+}
+
+func verifyAuditLogsCmdF(c client.Client, cmd *cobra.Command, _ []string) error {
+	printer.SetSingle(true)
+
+	verification, _, err := c.VerifyAuditLogs(context.TODO())
+	if err != nil {
+		return fmt.Errorf("failed to verify the audit logs: %w", err)
+	}
+
+	if !verification.Valid {
+		printer.PrintT("{{if .InvalidId}}Audit log {{.InvalidId}} with sequence {{.InvalidSequence}} breaks the chain: {{.Reason}} mismatch{{else}}The retention cutoff at sequence {{.InvalidSequence}} has an invalid signature{{end}}", verification)
+		return errors.New("the audit logs were tampered with")
+	}
+
+	printer.PrintT("{{.Checked}} audit logs verified{{if .Checked}}, from sequence {{.FirstSequence}} to {{.LastSequence}}{{end}}", verification)
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/printer"
+)
+
+func (s *MmctlUnitTestSuite) TestSearchAuditLogsCmd() {
+	newCmd := func(since string) *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.Flags().String("user-id", "", "")
+		cmd.Flags().String("event", model.AuditEventRemoveChannelMember, "")
+		cmd.Flags().String("object-id", "8hgd8tnqr7rhbkkmzkjptrwfdc", "")
+		cmd.Flags().String("since", since, "")
+		cmd.Flags().String("until", "", "")
+		cmd.Flags().Int("page", 0, "")
+		cmd.Flags().Int("per-page", 10, "")
+		return cmd
+	}
+
+	s.Run("Search the audit logs", func() {
+		printer.Clean()
+
+		auditLogs := []*model.AuditLog{
+			{Id: model.NewId(), EventName: model.AuditEventRemoveChannelMember, Status: model.AuditStatusSuccess, UserId: model.NewId()},
+		}
+
+		s.client.
+			EXPECT().
+			SearchAuditLogs(context.TODO(), &model.AuditLogSearch{
+				EventName: model.AuditEventRemoveChannelMember,
+				ObjectId:  "8hgd8tnqr7rhbkkmzkjptrwfdc",
+				Since:     1790812800000,
+				PerPage:   10,
+			}).
+			Return(auditLogs, &model.Response{}, nil).
+			Times(1)
+
+		err := searchAuditLogsCmdF(s.client, newCmd("2026-10-01T00:00:00+00:00"), []string{})
+		s.Require().NoError(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().Equal(auditLogs[0], printer.GetLines()[0])
+	})
+
+	s.Run("Fail on an invalid time", func() {
+		printer.Clean()
+
+		err := searchAuditLogsCmdF(s.client, newCmd("yesterday"), []string{})
+		s.Require().EqualError(err, "invalid since time 'yesterday'")
+		s.Require().Len(printer.GetLines(), 0)
+	})
+
+	s.Run("Fail to search the audit logs", func() {
+		printer.Clean()
+
+		s.client.
+			EXPECT().
+			SearchAuditLogs(context.TODO(), &model.AuditLogSearch{
+				EventName: model.AuditEventRemoveChannelMember,
+				ObjectId:  "8hgd8tnqr7rhbkkmzkjptrwfdc",
+				PerPage:   10,
+			}).
+			Return(nil, &model.Response{}, errors.New("mock error")).
+			Times(1)
+
+		err := searchAuditLogsCmdF(s.client, newCmd(""), []string{})
+		s.Require().Error(err)
+		s.Require().Len(printer.GetLines(), 0)
+	})
+}
+
+func (s *MmctlUnitTestSuite) TestVerifyAuditLogsCmd() {
+	s.Run("Verify an intact chain", func() {
+		printer.Clean()
+
+		verification := &model.AuditLogVerification{Valid: true, Checked: 3, FirstSequence: 1, LastSequence: 3}
+
+		s.client.
+			EXPECT().
+			VerifyAuditLogs(context.TODO()).
+			Return(verification, &model.Response{}, nil).
+			Times(1)
+
+		err := verifyAuditLogsCmdF(s.client, &cobra.Command{}, []string{})
+		s.Require().NoError(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().Equal(verification, printer.GetLines()[0])
+	})
+
+	s.Run("Report a broken chain", func() {
+		printer.Clean()
+
+		verification := &model.AuditLogVerification{Checked: 1, InvalidId: model.NewId(), InvalidSequence: 2, Reason: model.AuditLogVerificationHash}
+
+		s.client.
+			EXPECT().
+			VerifyAuditLogs(context.TODO()).
+			Return(verification, &model.Response{}, nil).
+			Times(1)
+
+		err := verifyAuditLogsCmdF(s.client, &cobra.Command{}, []string{})
+		s.Require().Error(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().Equal(verification, printer.GetLines()[0])
+	})
+	s.Run("Report a moved retention cutoff", func() {
+		printer.Clean()
+
+		verification := &model.AuditLogVerification{InvalidSequence: 2, Reason: model.AuditLogVerificationCutoff}
+
+		s.client.
+			EXPECT().
+			VerifyAuditLogs(context.TODO()).
+			Return(verification, &model.Response{}, nil).
+			Times(1)
+
+		err := verifyAuditLogsCmdF(s.client, &cobra.Command{}, []string{})
+		s.Require().Error(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().Equal(verification, printer.GetLines()[0])
+	})
+}
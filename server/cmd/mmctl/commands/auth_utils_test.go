@@ -23,10 +23,10 @@ func TestResolveConfigFilePath(t *testing.T) {
 
 	testUser, err := user.Current()
 	require.NoError(t, err)
+	ignoredDir := filepath.Join(t.TempDir(), "ignored")
 
 	t.Run("should return the default config file location if nothing else is set", func(t *testing.T) {
-		tmp, _ := os.MkdirTemp("", "mmctl-")
-		defer os.RemoveAll(tmp)
+		tmp := t.TempDir()
 		testUser.HomeDir = tmp
 		SetUser(testUser)
 
@@ -39,14 +39,13 @@ func TestResolveConfigFilePath(t *testing.T) {
 	})
 
 	t.Run("should return config file location from xdg environment variable", func(t *testing.T) {
-		tmp, _ := os.MkdirTemp("", "mmctl-")
-		defer os.RemoveAll(tmp)
+		tmp := t.TempDir()
 		testUser.HomeDir = tmp
 		SetUser(testUser)
 
 		expected := filepath.Join(testUser.HomeDir, ".config", configParent, configFileName)
 
-		_ = os.Setenv("XDG_CONFIG_HOME", filepath.Join(testUser.HomeDir, ".config"))
+		t.Setenv("XDG_CONFIG_HOME", filepath.Join(testUser.HomeDir, ".config"))
 		viper.Set("config", filepath.Join(xdgConfigHomeVar, configParent, configFileName))
 
 		p := resolveConfigFilePath()
@@ -54,16 +53,14 @@ func TestResolveConfigFilePath(t *testing.T) {
 	})
 
 	t.Run("should return the user-defined config file path if one is set", func(t *testing.T) {
-		tmp, _ := os.MkdirTemp("", "mmctl-")
-		defer os.RemoveAll(tmp)
+		tmp := t.TempDir()
 
-		testUser.HomeDir = "path/should/be/ignored"
+		testUser.HomeDir = ignoredDir
 		SetUser(testUser)
 
 		expected := filepath.Join(tmp, configFileName)
 
-		err := os.Setenv("XDG_CONFIG_HOME", "path/should/be/ignored")
-		require.NoError(t, err)
+		t.Setenv("XDG_CONFIG_HOME", ignoredDir)
 		viper.Set("config", expected)
 
 		p := resolveConfigFilePath()
@@ -71,16 +68,12 @@ func TestResolveConfigFilePath(t *testing.T) {
 	})
 
 	t.Run("should resolve config file path if $HOME variable is used", func(t *testing.T) {
-		tmp, _ := os.MkdirTemp("", "mmctl-")
-		defer os.RemoveAll(tmp)
-
-		testUser.HomeDir = "path/should/be/ignored"
+		testUser.HomeDir = ignoredDir
 		SetUser(testUser)
 
 		expected := filepath.Join(testUser.HomeDir, "/.config/mmctl/config")
 
-		err := os.Setenv("XDG_CONFIG_HOME", "path/should/be/ignored")
-		require.NoError(t, err)
+		t.Setenv("XDG_CONFIG_HOME", ignoredDir)
 		viper.Set("config", "$HOME/.config/mmctl/config")
 
 		p := resolveConfigFilePath()
@@ -88,20 +81,18 @@ func TestResolveConfigFilePath(t *testing.T) {
 	})
 
 	t.Run("should create the user-defined config file path if one is set", func(t *testing.T) {
-		tmp, _ := os.MkdirTemp("", "mmctl-")
-		defer os.RemoveAll(tmp)
+		tmp := t.TempDir()
 
-		testUser.HomeDir = "path/should/be/ignored"
+		testUser.HomeDir = ignoredDir
 		SetUser(testUser)
 		extraDir := "extra"
 
 		expected := filepath.Join(tmp, extraDir, "config.json")
 
-		err := os.Setenv("XDG_CONFIG_HOME", "path/should/be/ignored")
-		require.NoError(t, err)
+		t.Setenv("XDG_CONFIG_HOME", ignoredDir)
 		viper.Set("config", expected)
 
-		err = SaveCredentials(Credentials{})
+		err := SaveCredentials(Credentials{})
 		require.NoError(t, err)
 		info, err := os.Stat(expected)
 		require.NoError(t, err)
@@ -111,17 +102,15 @@ func TestResolveConfigFilePath(t *testing.T) {
 	})
 
 	t.Run("should return error if the config flag is set to a directory", func(t *testing.T) {
-		tmp, _ := os.MkdirTemp("", "mmctl-")
-		defer os.RemoveAll(tmp)
+		tmp := t.TempDir()
 
-		testUser.HomeDir = "path/should/be/ignored"
+		testUser.HomeDir = ignoredDir
 		SetUser(testUser)
 
-		err := os.Setenv("XDG_CONFIG_HOME", "path/should/be/ignored")
-		require.NoError(t, err)
+		t.Setenv("XDG_CONFIG_HOME", ignoredDir)
 		viper.Set("config", tmp)
 
-		err = SaveCredentials(Credentials{})
+		err := SaveCredentials(Credentials{})
 		require.Error(t, err)
 		require.True(t, strings.HasSuffix(err.Error(), "is a directory"))
 	})
@@ -6,7 +6,6 @@ package commands
 import (
 	"context"
 	"fmt"
-	"os"
 	"testing"
 
 	gomock "github.com/golang/mock/gomock"
@@ -235,11 +234,11 @@ func (s *MmctlUnitTestSuite) TestComplianceExportDownloadCmdF() {
 		Type:     model.JobTypeMessageExport,
 	}
 
+	// Files are downloaded to the working directory unless a path is given.
+	s.T().Chdir(s.T().TempDir())
+
 	s.Run("download job file successfully", func() {
 		printer.Clean()
-		defer func() {
-			_ = os.Remove("suggested-filename.zip")
-		}()
 
 		s.client.
 			EXPECT().
@@ -258,9 +257,6 @@ func (s *MmctlUnitTestSuite) TestComplianceExportDownloadCmdF() {
 
 	s.Run("download job file with explicit path", func() {
 		printer.Clean()
-		defer func() {
-			_ = os.Remove("custom-path.zip")
-		}()
 
 		s.client.
 			EXPECT().
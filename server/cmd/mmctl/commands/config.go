@@ -12,6 +12,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/v8/channels/utils"
@@ -129,6 +130,37 @@ var ConfigExportCmd = &cobra.Command{
 	RunE:    withClient(configExportCmdF),
 }
 
+var ConfigHistoryCmd = &cobra.Command{
+	Use:     "history",
+	Short:   "List the revisions of the configuration",
+	Long:    "Lists the revisions of the server configuration saved through the API, from the latest, with who saved them and when.",
+	Example: "config history --page 1 --per-page 20",
+	Args:    cobra.NoArgs,
+	RunE:    withClient(configHistoryCmdF),
+}
+
+var ConfigDiffCmd = &cobra.Command{
+	Use:   "diff [revision]",
+	Short: "Show the settings changed by a revision of the configuration",
+	Long:  "Shows the settings changed by a revision of the server configuration, compared with the previous revision by default. Secrets are masked.",
+	Example: `  # show what a revision changed
+  config diff f6w4sbkyktnc9cgrfrk5b7cpor
+
+  # show what rolling back to a revision would change
+  config diff f6w4sbkyktnc9cgrfrk5b7cpor --base current`,
+	Args: cobra.ExactArgs(1),
+	RunE: withClient(configDiffCmdF),
+}
+
+var ConfigRollbackCmd = &cobra.Command{
+	Use:     "rollback [revision]",
+	Short:   "Restore a revision of the configuration",
+	Long:    "Restores the server configuration of a revision. The restored configuration is saved as a new revision.",
+	Example: "config rollback f6w4sbkyktnc9cgrfrk5b7cpor",
+	Args:    cobra.ExactArgs(1),
+	RunE:    withClient(configRollbackCmdF),
+}
+
 func init() {
 	ConfigResetCmd.Flags().Bool("confirm", false, "confirm you really want to reset all configuration settings to its default value")
 
@@ -140,6 +172,13 @@ func init() {
 	ConfigExportCmd.Flags().Bool("remove-masked", true, "remove masked values from the exported configuration")
 	ConfigExportCmd.Flags().Bool("remove-defaults", false, "remove default values from the exported configuration")
 
+	ConfigHistoryCmd.Flags().Int("page", 0, "Page number to fetch for the list of revisions")
+	ConfigHistoryCmd.Flags().Int("per-page", DefaultPageSize, "Number of revisions to be fetched")
+
+	ConfigDiffCmd.Flags().String("base", "", "revision to compare with, or \"current\" for the current configuration. Defaults to the previous revision")
+
+	ConfigRollbackCmd.Flags().Bool("confirm", false, "confirm you really want to restore the configuration of the revision")
+
 	ConfigCmd.AddCommand(
 		ConfigGetCmd,
 		ConfigSetCmd,
@@ -151,6 +190,9 @@ func init() {
 		ConfigMigrateCmd,
 		ConfigSubpathCmd,
 		ConfigExportCmd,
+		ConfigHistoryCmd,
+		ConfigDiffCmd,
+		ConfigRollbackCmd,
 	)
 	RootCmd.AddCommand(ConfigCmd)
 }
@@ -550,6 +592,71 @@ func configMigrateCmdF(c client.Client, cmd *cobra.Command, args []string) error
 	return nil
 }
 
+func configHistoryCmdF(c client.Client, cmd *cobra.Command, _ []string) error {
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+
+	revisions, _, err := c.GetConfigRevisions(context.TODO(), page, perPage)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the revisions of the configuration")
+	}
+
+	printer.SetTemplateFunc("millisToTime", func(millis int64) string {
+		return time.UnixMilli(millis).UTC().Format(time.RFC3339)
+	})
+
+	for _, revision := range revisions {
+		printer.PrintT("{{.Id}}: {{millisToTime .CreateAt}}{{if .UserId}} by {{.UserId}}{{end}}", revision)
+	}
+
+	return nil
+}
+
+func configDiffCmdF(c client.Client, cmd *cobra.Command, args []string) error {
+	base, _ := cmd.Flags().GetString("base")
+
+	changes, _, err := c.GetConfigRevisionDiff(context.TODO(), args[0], base)
+	if err != nil {
+		return errors.Wrapf(err, "failed to compare the revision %s of the configuration", args[0])
+	}
+
+	if len(changes) == 0 {
+		printer.Print("No setting changed")
+		return nil
+	}
+
+	printer.SetTemplateFunc("json", func(v any) string {
+		b, _ := json.Marshal(v)
+		return string(b)
+	})
+
+	for _, change := range changes {
+		printer.PrintT("{{.Path}}: {{json .BaseVal}} -> {{json .ActualVal}}", change)
+	}
+
+	return nil
+}
+
+func configRollbackCmdF(c client.Client, cmd *cobra.Command, args []string) error {
+	confirmFlag, _ := cmd.Flags().GetBool("confirm")
+	if !confirmFlag {
+		if err := getConfirmation(fmt.Sprintf(
+			"Are you sure you want to restore the configuration of the revision %s? (YES/NO): ",
+			args[0]), false); err != nil {
+			return err
+		}
+	}
+
+	config, _, err := c.RollbackConfig(context.TODO(), args[0])
+	if err != nil {
+		return errors.Wrapf(err, "failed to restore the revision %s of the configuration", args[0])
+	}
+
+	printer.PrintT("Configuration restored from the revision "+args[0], config)
+
+	return nil
+}
+
 func configSubpathCmdF(cmd *cobra.Command, _ []string) error {
 	assetsDir, _ := cmd.Flags().GetString("assets-dir")
 	path, _ := cmd.Flags().GetString("path")
@@ -848,6 +848,97 @@ func (s *MmctlUnitTestSuite) TestConfigReloadCmd() {
 	})
 }
 
+func (s *MmctlUnitTestSuite) TestConfigHistoryCmd() {
+	s.Run("Should list the revisions", func() {
+		printer.Clean()
+
+		revisions := []*model.ConfigRevision{
+			{Id: model.NewId(), CreateAt: 2000, UserId: model.NewId()},
+			{Id: model.NewId(), CreateAt: 1000},
+		}
+
+		s.client.
+			EXPECT().
+			GetConfigRevisions(context.TODO(), 0, DefaultPageSize).
+			Return(revisions, &model.Response{StatusCode: http.StatusOK}, nil).
+			Times(1)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().Int("page", 0, "")
+		cmd.Flags().Int("per-page", DefaultPageSize, "")
+
+		err := configHistoryCmdF(s.client, cmd, []string{})
+		s.Require().Nil(err)
+		s.Require().Len(printer.GetLines(), 2)
+		s.Equal(revisions[0], printer.GetLines()[0])
+		s.Len(printer.GetErrorLines(), 0)
+	})
+}
+
+func (s *MmctlUnitTestSuite) TestConfigDiffCmd() {
+	revisionID := model.NewId()
+
+	s.Run("Should show the changes since the current configuration", func() {
+		printer.Clean()
+
+		changes := []*model.ConfigChange{
+			{Path: "TeamSettings.SiteName", BaseVal: "Current", ActualVal: "Previous"},
+		}
+
+		s.client.
+			EXPECT().
+			GetConfigRevisionDiff(context.TODO(), revisionID, model.ConfigRevisionBaseCurrent).
+			Return(changes, &model.Response{StatusCode: http.StatusOK}, nil).
+			Times(1)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("base", model.ConfigRevisionBaseCurrent, "")
+
+		err := configDiffCmdF(s.client, cmd, []string{revisionID})
+		s.Require().Nil(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Equal(changes[0], printer.GetLines()[0])
+	})
+
+	s.Run("Should fail on error", func() {
+		printer.Clean()
+
+		s.client.
+			EXPECT().
+			GetConfigRevisionDiff(context.TODO(), revisionID, "").
+			Return(nil, &model.Response{StatusCode: http.StatusNotFound}, errors.New("some-error")).
+			Times(1)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("base", "", "")
+
+		err := configDiffCmdF(s.client, cmd, []string{revisionID})
+		s.Require().Error(err)
+	})
+}
+
+func (s *MmctlUnitTestSuite) TestConfigRollbackCmd() {
+	s.Run("Should restore the revision", func() {
+		printer.Clean()
+		revisionID := model.NewId()
+		cfg := &model.Config{}
+
+		s.client.
+			EXPECT().
+			RollbackConfig(context.TODO(), revisionID).
+			Return(cfg, &model.Response{StatusCode: http.StatusOK}, nil).
+			Times(1)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().Bool("confirm", true, "")
+
+		err := configRollbackCmdF(s.client, cmd, []string{revisionID})
+		s.Require().Nil(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Equal(cfg, printer.GetLines()[0])
+	})
+}
+
 func (s *MmctlUnitTestSuite) TestConfigMigrateCmd() {
 	s.Run("Should fail without the --local flag", func() {
 		printer.Clean()
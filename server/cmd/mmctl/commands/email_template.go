@@ -0,0 +1,177 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/client"
+	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/printer"
+)
+
+var EmailTemplateCmd = &cobra.Command{
+	Use:   "email-template",
+	Short: "Management of email templates",
+	Long:  "Customize the HTML templates of the emails sent by the server, per locale. Templates: " + strings.Join(model.EmailTemplateNames, ", ") + ".",
+}
+
+var ListEmailTemplatesCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List email templates",
+	Long:    "List the email templates of a locale, and whether they were customized.",
+	Example: "  email-template list --locale vi",
+	Args:    cobra.NoArgs,
+	RunE:    withClient(listEmailTemplatesCmdF),
+}
+
+var GetEmailTemplateCmd = &cobra.Command{
+	Use:     "get [template]",
+	Short:   "Print an email template",
+	Long:    "Print the current HTML template of an email in a locale.",
+	Example: "  email-template get reset_body --locale vi > reset_body.html",
+	Args:    cobra.ExactArgs(1),
+	RunE:    withClient(getEmailTemplateCmdF),
+}
+
+var SetEmailTemplateCmd = &cobra.Command{
+	Use:     "set [template] [file]",
+	Short:   "Customize an email template",
+	Long:    "Replace the template of an email in a locale by the one in a file. The template must render with sample data to be saved.",
+	Example: "  email-template set reset_body reset_body.html --locale vi",
+	Args:    cobra.ExactArgs(2),
+	RunE:    withClient(setEmailTemplateCmdF),
+}
+
+var ResetEmailTemplateCmd = &cobra.Command{
+	Use:     "reset [template]",
+	Short:   "Restore an email template",
+	Long:    "Restore the built-in template of an email in a locale.",
+	Example: "  email-template reset reset_body --locale vi",
+	Args:    cobra.ExactArgs(1),
+	RunE:    withClient(resetEmailTemplateCmdF),
+}
+
+var PreviewEmailTemplateCmd = &cobra.Command{
+	Use:   "preview [template]",
+	Short: "Send a preview of an email template",
+	Long:  "Send an email rendered from a template with sample data to the email address of the current user, or to the one given in local mode, through the SMTP server of the server. The current template of the locale is sent unless a file is given.",
+	Example: `  email-template preview reset_body --locale vi
+  email-template preview reset_body --locale vi --file reset_body.html
+  email-template preview reset_body --local --email admin@example.com`,
+	Args: cobra.ExactArgs(1),
+	RunE: withClient(previewEmailTemplateCmdF),
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{ListEmailTemplatesCmd, GetEmailTemplateCmd, SetEmailTemplateCmd, ResetEmailTemplateCmd, PreviewEmailTemplateCmd} {
+		cmd.Flags().String("locale", "", "Locale of the template. Defaults to the default server locale")
+	}
+	PreviewEmailTemplateCmd.Flags().String("file", "", "File with the template to preview instead of the current one")
+	PreviewEmailTemplateCmd.Flags().String("email", "", "Email address to send the preview to, required in local mode")
+
+	EmailTemplateCmd.AddCommand(
+		ListEmailTemplatesCmd,
+		GetEmailTemplateCmd,
+		SetEmailTemplateCmd,
+		ResetEmailTemplateCmd,
+		PreviewEmailTemplateCmd,
+	)
+
+	RootCmd.AddCommand(EmailTemplateCmd)
+}
+
+func listEmailTemplatesCmdF(c client.Client, command *cobra.Command, args []string) error {
+	locale, _ := command.Flags().GetString("locale")
+
+	emailTemplates, _, err := c.GetEmailTemplates(context.TODO(), locale)
+	if err != nil {
+		return fmt.Errorf("unable to get the email templates: %w", err)
+	}
+
+	for _, emailTemplate := range emailTemplates {
+		printer.PrintT("{{.Name}}{{if .Overridden}} (customized){{end}}", emailTemplate)
+	}
+
+	return nil
+}
+
+func getEmailTemplateCmdF(c client.Client, command *cobra.Command, args []string) error {
+	printer.SetSingle(true)
+	locale, _ := command.Flags().GetString("locale")
+
+	emailTemplate, _, err := c.GetEmailTemplate(context.TODO(), args[0], locale)
+	if err != nil {
+		return fmt.Errorf("unable to get the email template %q: %w", args[0], err)
+	}
+
+	printer.PrintT("{{.Content}}", emailTemplate)
+	return nil
+}
+
+func setEmailTemplateCmdF(c client.Client, command *cobra.Command, args []string) error {
+	printer.SetSingle(true)
+	locale, _ := command.Flags().GetString("locale")
+
+	content, err := os.ReadFile(args[1])
+	if err != nil {
+		return fmt.Errorf("unable to read the email template: %w", err)
+	}
+
+	emailTemplate, _, err := c.UpdateEmailTemplate(context.TODO(), &model.EmailTemplate{
+		Name:    args[0],
+		Locale:  locale,
+		Content: string(content),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to save the email template %q: %w", args[0], err)
+	}
+
+	printer.PrintT("Email template {{.Name}} of locale {{.Locale}} customized", emailTemplate)
+	return nil
+}
+
+func resetEmailTemplateCmdF(c client.Client, command *cobra.Command, args []string) error {
+	printer.SetSingle(true)
+	locale, _ := command.Flags().GetString("locale")
+
+	emailTemplate, _, err := c.DeleteEmailTemplate(context.TODO(), args[0], locale)
+	if err != nil {
+		return fmt.Errorf("unable to restore the email template %q: %w", args[0], err)
+	}
+
+	printer.PrintT("Email template {{.Name}} of locale {{.Locale}} restored", emailTemplate)
+	return nil
+}
+
+func previewEmailTemplateCmdF(c client.Client, command *cobra.Command, args []string) error {
+	locale, _ := command.Flags().GetString("locale")
+	file, _ := command.Flags().GetString("file")
+	email, _ := command.Flags().GetString("email")
+
+	emailTemplate := &model.EmailTemplate{Name: args[0], Locale: locale}
+	if file != "" {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("unable to read the email template: %w", err)
+		}
+		emailTemplate.Content = string(content)
+	}
+
+	if _, err := c.PreviewEmailTemplate(context.TODO(), emailTemplate, email); err != nil {
+		return fmt.Errorf("unable to send the preview of the email template %q: %w", args[0], err)
+	}
+
+	if email != "" {
+		printer.Print("Preview sent to " + email)
+		return nil
+	}
+	printer.Print("Preview sent to your email address")
+	return nil
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/printer"
+)
+
+func (s *MmctlUnitTestSuite) TestListEmailTemplatesCmd() {
+	s.Run("List the email templates of a locale", func() {
+		printer.Clean()
+
+		emailTemplates := []*model.EmailTemplate{
+			{Name: "email_footer", Locale: "vi", Overridden: true},
+			{Name: "reset_body", Locale: "vi"},
+		}
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("locale", "vi", "")
+
+		s.client.
+			EXPECT().
+			GetEmailTemplates(context.TODO(), "vi").
+			Return(emailTemplates, &model.Response{}, nil).
+			Times(1)
+
+		err := listEmailTemplatesCmdF(s.client, cmd, []string{})
+		s.Require().NoError(err)
+		s.Require().Len(printer.GetLines(), 2)
+		s.Require().Equal(emailTemplates[0], printer.GetLines()[0])
+		s.Require().Equal(emailTemplates[1], printer.GetLines()[1])
+	})
+
+	s.Run("Fail to list the email templates", func() {
+		printer.Clean()
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("locale", "", "")
+
+		s.client.
+			EXPECT().
+			GetEmailTemplates(context.TODO(), "").
+			Return(nil, &model.Response{}, errors.New("mock error")).
+			Times(1)
+
+		err := listEmailTemplatesCmdF(s.client, cmd, []string{})
+		s.Require().Error(err)
+		s.Require().Len(printer.GetLines(), 0)
+	})
+}
+
+func (s *MmctlUnitTestSuite) TestSetEmailTemplateCmd() {
+	s.Run("Customize an email template from a file", func() {
+		printer.Clean()
+
+		file := filepath.Join(s.T().TempDir(), "email_footer.html")
+		s.Require().NoError(os.WriteFile(file, []byte("<p>Công ty</p>"), 0600))
+
+		emailTemplate := &model.EmailTemplate{Name: "email_footer", Locale: "vi", Content: "<p>Công ty</p>"}
+		saved := *emailTemplate
+		saved.Overridden = true
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("locale", "vi", "")
+
+		s.client.
+			EXPECT().
+			UpdateEmailTemplate(context.TODO(), emailTemplate).
+			Return(&saved, &model.Response{}, nil).
+			Times(1)
+
+		err := setEmailTemplateCmdF(s.client, cmd, []string{"email_footer", file})
+		s.Require().NoError(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().Equal(&saved, printer.GetLines()[0])
+	})
+
+	s.Run("Fail to read the file", func() {
+		printer.Clean()
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("locale", "vi", "")
+
+		err := setEmailTemplateCmdF(s.client, cmd, []string{"email_footer", filepath.Join(s.T().TempDir(), "missing.html")})
+		s.Require().Error(err)
+		s.Require().Len(printer.GetLines(), 0)
+	})
+
+	s.Run("Fail to save an invalid template", func() {
+		printer.Clean()
+
+		file := filepath.Join(s.T().TempDir(), "email_footer.html")
+		s.Require().NoError(os.WriteFile(file, []byte("{{.Props.Footer"), 0600))
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("locale", "vi", "")
+
+		s.client.
+			EXPECT().
+			UpdateEmailTemplate(context.TODO(), &model.EmailTemplate{Name: "email_footer", Locale: "vi", Content: "{{.Props.Footer"}).
+			Return(nil, &model.Response{StatusCode: 400}, errors.New("mock error")).
+			Times(1)
+
+		err := setEmailTemplateCmdF(s.client, cmd, []string{"email_footer", file})
+		s.Require().Error(err)
+		s.Require().Len(printer.GetLines(), 0)
+	})
+}
+
+func (s *MmctlUnitTestSuite) TestResetEmailTemplateCmd() {
+	s.Run("Restore an email template", func() {
+		printer.Clean()
+
+		emailTemplate := &model.EmailTemplate{Name: "email_footer", Locale: "vi", Content: "<td></td>"}
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("locale", "vi", "")
+
+		s.client.
+			EXPECT().
+			DeleteEmailTemplate(context.TODO(), "email_footer", "vi").
+			Return(emailTemplate, &model.Response{}, nil).
+			Times(1)
+
+		err := resetEmailTemplateCmdF(s.client, cmd, []string{"email_footer"})
+		s.Require().NoError(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().Equal(emailTemplate, printer.GetLines()[0])
+	})
+}
+
+func (s *MmctlUnitTestSuite) TestPreviewEmailTemplateCmd() {
+	s.Run("Preview the current template", func() {
+		printer.Clean()
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("locale", "vi", "")
+		cmd.Flags().String("file", "", "")
+		cmd.Flags().String("email", "", "")
+
+		s.client.
+			EXPECT().
+			PreviewEmailTemplate(context.TODO(), &model.EmailTemplate{Name: "reset_body", Locale: "vi"}, "").
+			Return(&model.Response{}, nil).
+			Times(1)
+
+		err := previewEmailTemplateCmdF(s.client, cmd, []string{"reset_body"})
+		s.Require().NoError(err)
+		s.Require().Len(printer.GetLines(), 1)
+	})
+
+	s.Run("Preview a template from a file", func() {
+		printer.Clean()
+
+		file := filepath.Join(s.T().TempDir(), "reset_body.html")
+		s.Require().NoError(os.WriteFile(file, []byte("<p>{{.Props.Title}}</p>"), 0600))
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("locale", "vi", "")
+		cmd.Flags().String("file", file, "")
+		cmd.Flags().String("email", "", "")
+
+		s.client.
+			EXPECT().
+			PreviewEmailTemplate(context.TODO(), &model.EmailTemplate{Name: "reset_body", Locale: "vi", Content: "<p>{{.Props.Title}}</p>"}, "").
+			Return(&model.Response{}, nil).
+			Times(1)
+
+		err := previewEmailTemplateCmdF(s.client, cmd, []string{"reset_body"})
+		s.Require().NoError(err)
+		s.Require().Len(printer.GetLines(), 1)
+	})
+
+	s.Run("Preview to an email address", func() {
+		printer.Clean()
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("locale", "vi", "")
+		cmd.Flags().String("file", "", "")
+		cmd.Flags().String("email", "admin@example.com", "")
+
+		s.client.
+			EXPECT().
+			PreviewEmailTemplate(context.TODO(), &model.EmailTemplate{Name: "reset_body", Locale: "vi"}, "admin@example.com").
+			Return(&model.Response{}, nil).
+			Times(1)
+
+		err := previewEmailTemplateCmdF(s.client, cmd, []string{"reset_body"})
+		s.Require().NoError(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().Equal("Preview sent to admin@example.com", printer.GetLines()[0])
+	})
+}
@@ -0,0 +1,273 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/client"
+	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/printer"
+)
+
+var EventSubscriptionCmd = &cobra.Command{
+	Use:   "event-subscription",
+	Short: "Management of event subscriptions",
+	Long:  "Management of the subscriptions that send server events, such as users joining channels or reactions being added, to a URL.",
+}
+
+var ListEventSubscriptionsCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List event subscriptions",
+	Long:    "List the event subscriptions of the server.",
+	Example: "  event-subscription list",
+	Args:    cobra.NoArgs,
+	RunE:    withClient(listEventSubscriptionsCmdF),
+}
+
+var ShowEventSubscriptionCmd = &cobra.Command{
+	Use:     "show [subscriptionID]",
+	Short:   "Show an event subscription",
+	Long:    "Show the details of an event subscription, including the secret its deliveries are signed with.",
+	Example: "  event-subscription show w16zb5tu3n1zkqo18goqry1je",
+	Args:    cobra.ExactArgs(1),
+	RunE:    withClient(showEventSubscriptionCmdF),
+}
+
+var CreateEventSubscriptionCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an event subscription",
+	Long:  "Create a subscription that sends the events of the given types to a URL, optionally only those of a team or a channel. Event types: " + strings.Join(model.EventSubscriptionEventTypes, ", ") + ".",
+	Example: `  event-subscription create --url https://example.com/events --event UserHasJoinedChannel --event UserHasLeftChannel
+  event-subscription create --display-name "Town square reactions" --url https://example.com/events --event ReactionHasBeenAdded --channel myteam:town-square`,
+	Args: cobra.NoArgs,
+	RunE: withClient(createEventSubscriptionCmdF),
+}
+
+var ModifyEventSubscriptionCmd = &cobra.Command{
+	Use:     "modify [subscriptionID]",
+	Short:   "Modify an event subscription",
+	Long:    "Change the URL, the event types, the filters, the display name or the description of an event subscription.",
+	Example: "  event-subscription modify w16zb5tu3n1zkqo18goqry1je --event UserHasBeenCreated --event UserHasBeenDeactivated --team \"\"",
+	Args:    cobra.ExactArgs(1),
+	RunE:    withClient(modifyEventSubscriptionCmdF),
+}
+
+var DeleteEventSubscriptionCmd = &cobra.Command{
+	Use:     "delete [subscriptionID...]",
+	Short:   "Delete event subscriptions",
+	Long:    "Delete event subscriptions, so they stop receiving events.",
+	Example: "  event-subscription delete w16zb5tu3n1zkqo18goqry1je",
+	Args:    cobra.MinimumNArgs(1),
+	RunE:    withClient(deleteEventSubscriptionsCmdF),
+}
+
+var RegenEventSubscriptionSecretCmd = &cobra.Command{
+	Use:     "regen-secret [subscriptionID]",
+	Short:   "Regenerate the secret of an event subscription",
+	Long:    "Regenerate the secret the deliveries of an event subscription are signed with.",
+	Example: "  event-subscription regen-secret w16zb5tu3n1zkqo18goqry1je",
+	Args:    cobra.ExactArgs(1),
+	RunE:    withClient(regenEventSubscriptionSecretCmdF),
+}
+
+func init() {
+	ListEventSubscriptionsCmd.Flags().Int("page", 0, "Page number to fetch for the list of event subscriptions")
+	ListEventSubscriptionsCmd.Flags().Int("per-page", 200, "Number of event subscriptions to be fetched")
+
+	CreateEventSubscriptionCmd.Flags().String("url", "", "URL the events are sent to (required)")
+	_ = CreateEventSubscriptionCmd.MarkFlagRequired("url")
+	CreateEventSubscriptionCmd.Flags().StringArray("event", []string{}, "Type of the events to send (required)")
+	_ = CreateEventSubscriptionCmd.MarkFlagRequired("event")
+	CreateEventSubscriptionCmd.Flags().String("display-name", "", "Event subscription display name")
+	CreateEventSubscriptionCmd.Flags().String("description", "", "Event subscription description")
+	CreateEventSubscriptionCmd.Flags().String("team", "", "Only send the events of this team, by name or ID")
+	CreateEventSubscriptionCmd.Flags().String("channel", "", "Only send the events of this channel, by ID or as team:channel")
+
+	ModifyEventSubscriptionCmd.Flags().String("url", "", "URL the events are sent to")
+	ModifyEventSubscriptionCmd.Flags().StringArray("event", []string{}, "Type of the events to send, replacing the current ones")
+	ModifyEventSubscriptionCmd.Flags().String("display-name", "", "Event subscription display name")
+	ModifyEventSubscriptionCmd.Flags().String("description", "", "Event subscription description")
+	ModifyEventSubscriptionCmd.Flags().String("team", "", "Only send the events of this team, by name or ID. An empty value removes the filter")
+	ModifyEventSubscriptionCmd.Flags().String("channel", "", "Only send the events of this channel, by ID or as team:channel. An empty value removes the filter")
+
+	EventSubscriptionCmd.AddCommand(
+		ListEventSubscriptionsCmd,
+		ShowEventSubscriptionCmd,
+		CreateEventSubscriptionCmd,
+		ModifyEventSubscriptionCmd,
+		DeleteEventSubscriptionCmd,
+		RegenEventSubscriptionSecretCmd,
+	)
+
+	RootCmd.AddCommand(EventSubscriptionCmd)
+}
+
+const eventSubscriptionTemplate = "{{.Id}}: {{.URL}} ({{range $i, $e := .EventTypes}}{{if $i}}, {{end}}{{$e}}{{end}}){{if .TeamId}} team {{.TeamId}}{{end}}{{if .ChannelId}} channel {{.ChannelId}}{{end}}"
+
+func listEventSubscriptionsCmdF(c client.Client, command *cobra.Command, args []string) error {
+	page, _ := command.Flags().GetInt("page")
+	perPage, _ := command.Flags().GetInt("per-page")
+
+	subscriptions, _, err := c.GetEventSubscriptions(context.TODO(), page, perPage)
+	if err != nil {
+		return fmt.Errorf("unable to get the event subscriptions: %w", err)
+	}
+
+	if len(subscriptions) == 0 {
+		printer.Print("No event subscriptions found")
+		return nil
+	}
+
+	for _, subscription := range subscriptions {
+		printer.PrintT(eventSubscriptionTemplate, subscription)
+	}
+
+	return nil
+}
+
+func showEventSubscriptionCmdF(c client.Client, command *cobra.Command, args []string) error {
+	printer.SetSingle(true)
+
+	subscription, _, err := c.GetEventSubscription(context.TODO(), args[0])
+	if err != nil {
+		return fmt.Errorf("unable to find event subscription %q: %w", args[0], err)
+	}
+
+	printer.Print(subscription)
+	return nil
+}
+
+// eventSubscriptionFilters returns the ids of the team and of the channel the
+// flags of the command filter the events by.
+func eventSubscriptionFilters(c client.Client, command *cobra.Command) (teamID, channelID string, err error) {
+	if teamArg, _ := command.Flags().GetString("team"); teamArg != "" {
+		team := getTeamFromTeamArg(c, teamArg)
+		if team == nil {
+			return "", "", errors.New("unable to find team '" + teamArg + "'")
+		}
+		teamID = team.Id
+	}
+
+	if channelArg, _ := command.Flags().GetString("channel"); channelArg != "" {
+		channel := getChannelFromChannelArg(c, channelArg)
+		if channel == nil {
+			return "", "", errors.New("unable to find channel '" + channelArg + "'")
+		}
+		channelID = channel.Id
+	}
+
+	return teamID, channelID, nil
+}
+
+func createEventSubscriptionCmdF(c client.Client, command *cobra.Command, args []string) error {
+	printer.SetSingle(true)
+
+	url, _ := command.Flags().GetString("url")
+	eventTypes, _ := command.Flags().GetStringArray("event")
+	displayName, _ := command.Flags().GetString("display-name")
+	description, _ := command.Flags().GetString("description")
+
+	teamID, channelID, err := eventSubscriptionFilters(c, command)
+	if err != nil {
+		return err
+	}
+
+	subscription := &model.EventSubscription{
+		DisplayName: displayName,
+		Description: description,
+		URL:         url,
+		EventTypes:  eventTypes,
+		TeamId:      teamID,
+		ChannelId:   channelID,
+	}
+
+	created, _, err := c.CreateEventSubscription(context.TODO(), subscription)
+	if err != nil {
+		printer.PrintError("Unable to create event subscription")
+		return err
+	}
+
+	printer.PrintT("Event subscription {{.Id}} created, deliveries are signed with the secret {{.Secret}}", created)
+	return nil
+}
+
+func modifyEventSubscriptionCmdF(c client.Client, command *cobra.Command, args []string) error {
+	printer.SetSingle(true)
+
+	patch := &model.EventSubscriptionPatch{}
+
+	if command.Flags().Changed("url") {
+		url, _ := command.Flags().GetString("url")
+		patch.URL = &url
+	}
+
+	if command.Flags().Changed("event") {
+		eventTypes, _ := command.Flags().GetStringArray("event")
+		patch.EventTypes = model.NewPointer(model.StringArray(eventTypes))
+	}
+
+	if command.Flags().Changed("display-name") {
+		displayName, _ := command.Flags().GetString("display-name")
+		patch.DisplayName = &displayName
+	}
+
+	if command.Flags().Changed("description") {
+		description, _ := command.Flags().GetString("description")
+		patch.Description = &description
+	}
+
+	teamID, channelID, err := eventSubscriptionFilters(c, command)
+	if err != nil {
+		return err
+	}
+	if command.Flags().Changed("team") {
+		patch.TeamId = &teamID
+	}
+	if command.Flags().Changed("channel") {
+		patch.ChannelId = &channelID
+	}
+
+	subscription, _, err := c.PatchEventSubscription(context.TODO(), args[0], patch)
+	if err != nil {
+		printer.PrintError("Unable to modify event subscription '" + args[0] + "'")
+		return err
+	}
+
+	printer.PrintT("Event subscription {{.Id}} successfully modified", subscription)
+	return nil
+}
+
+func deleteEventSubscriptionsCmdF(c client.Client, command *cobra.Command, args []string) error {
+	var result *multierror.Error
+	for _, subscriptionID := range args {
+		if _, err := c.DeleteEventSubscription(context.TODO(), subscriptionID); err != nil {
+			printer.PrintError(fmt.Sprintf("could not delete event subscription '%v'", subscriptionID))
+			result = multierror.Append(result, fmt.Errorf("could not delete event subscription %q: %w", subscriptionID, err))
+			continue
+		}
+
+		printer.Print("Event subscription " + subscriptionID + " successfully deleted")
+	}
+
+	return result.ErrorOrNil()
+}
+
+func regenEventSubscriptionSecretCmdF(c client.Client, command *cobra.Command, args []string) error {
+	printer.SetSingle(true)
+
+	subscription, _, err := c.RegenEventSubscriptionSecret(context.TODO(), args[0])
+	if err != nil {
+		return fmt.Errorf("unable to regenerate the secret of event subscription %q: %w", args[0], err)
+	}
+
+	printer.PrintT("Event subscription {{.Id}} secret regenerated: {{.Secret}}", subscription)
+	return nil
+}
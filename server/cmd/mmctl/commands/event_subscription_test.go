@@ -0,0 +1,204 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/printer"
+)
+
+func (s *MmctlUnitTestSuite) TestListEventSubscriptionsCmd() {
+	s.Run("List event subscriptions", func() {
+		printer.Clean()
+
+		subscriptions := []*model.EventSubscription{
+			{Id: model.NewId(), URL: "https://example.com", EventTypes: model.StringArray{model.EventTypeUserHasJoinedChannel}},
+			{Id: model.NewId(), URL: "https://example.com", EventTypes: model.StringArray{model.EventTypeUserHasLeftChannel}},
+		}
+
+		cmd := &cobra.Command{}
+		cmd.Flags().Int("page", 0, "")
+		cmd.Flags().Int("per-page", 200, "")
+
+		s.client.
+			EXPECT().
+			GetEventSubscriptions(context.TODO(), 0, 200).
+			Return(subscriptions, &model.Response{}, nil).
+			Times(1)
+
+		err := listEventSubscriptionsCmdF(s.client, cmd, []string{})
+		s.Require().NoError(err)
+		s.Require().Len(printer.GetLines(), 2)
+		s.Require().Equal(subscriptions[0], printer.GetLines()[0])
+		s.Require().Equal(subscriptions[1], printer.GetLines()[1])
+	})
+
+	s.Run("Fail to list event subscriptions", func() {
+		printer.Clean()
+
+		cmd := &cobra.Command{}
+		cmd.Flags().Int("page", 0, "")
+		cmd.Flags().Int("per-page", 200, "")
+
+		s.client.
+			EXPECT().
+			GetEventSubscriptions(context.TODO(), 0, 200).
+			Return(nil, &model.Response{}, errors.New("mock error")).
+			Times(1)
+
+		err := listEventSubscriptionsCmdF(s.client, cmd, []string{})
+		s.Require().Error(err)
+		s.Require().Len(printer.GetLines(), 0)
+	})
+}
+
+func (s *MmctlUnitTestSuite) TestCreateEventSubscriptionCmd() {
+	s.Run("Create an event subscription filtered by team", func() {
+		printer.Clean()
+
+		team := &model.Team{Id: model.NewId(), Name: "team"}
+		subscription := &model.EventSubscription{
+			URL:        "https://example.com",
+			EventTypes: model.StringArray{model.EventTypeUserHasJoinedTeam},
+			TeamId:     team.Id,
+		}
+		created := *subscription
+		created.Id = model.NewId()
+		created.Secret = "secret"
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("url", subscription.URL, "")
+		cmd.Flags().StringArray("event", subscription.EventTypes, "")
+		cmd.Flags().String("display-name", "", "")
+		cmd.Flags().String("description", "", "")
+		cmd.Flags().String("team", team.Id, "")
+		cmd.Flags().String("channel", "", "")
+
+		s.client.
+			EXPECT().
+			GetTeam(context.TODO(), team.Id, "").
+			Return(team, &model.Response{}, nil).
+			Times(1)
+
+		s.client.
+			EXPECT().
+			CreateEventSubscription(context.TODO(), subscription).
+			Return(&created, &model.Response{}, nil).
+			Times(1)
+
+		err := createEventSubscriptionCmdF(s.client, cmd, []string{})
+		s.Require().NoError(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().Equal(&created, printer.GetLines()[0])
+	})
+
+	s.Run("Fail to find the team", func() {
+		printer.Clean()
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("url", "https://example.com", "")
+		cmd.Flags().StringArray("event", []string{model.EventTypeUserHasJoinedTeam}, "")
+		cmd.Flags().String("team", "unknown", "")
+
+		s.client.
+			EXPECT().
+			GetTeam(context.TODO(), "unknown", "").
+			Return(nil, &model.Response{}, errors.New("mock error")).
+			Times(1)
+
+		s.client.
+			EXPECT().
+			GetTeamByName(context.TODO(), "unknown", "").
+			Return(nil, &model.Response{}, errors.New("mock error")).
+			Times(1)
+
+		err := createEventSubscriptionCmdF(s.client, cmd, []string{})
+		s.Require().EqualError(err, "unable to find team 'unknown'")
+	})
+}
+
+func (s *MmctlUnitTestSuite) TestModifyEventSubscriptionCmd() {
+	s.Run("Only the changed flags are patched", func() {
+		printer.Clean()
+
+		subscriptionID := model.NewId()
+		patch := &model.EventSubscriptionPatch{
+			EventTypes: &model.StringArray{model.EventTypeUserHasBeenCreated},
+			TeamId:     model.NewPointer(""),
+		}
+		subscription := &model.EventSubscription{Id: subscriptionID, EventTypes: *patch.EventTypes}
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("url", "", "")
+		cmd.Flags().StringArray("event", []string{}, "")
+		cmd.Flags().String("display-name", "", "")
+		cmd.Flags().String("description", "", "")
+		cmd.Flags().String("team", "", "")
+		cmd.Flags().String("channel", "", "")
+		s.Require().NoError(cmd.Flags().Set("event", model.EventTypeUserHasBeenCreated))
+		s.Require().NoError(cmd.Flags().Set("team", ""))
+
+		s.client.
+			EXPECT().
+			PatchEventSubscription(context.TODO(), subscriptionID, patch).
+			Return(subscription, &model.Response{}, nil).
+			Times(1)
+
+		err := modifyEventSubscriptionCmdF(s.client, cmd, []string{subscriptionID})
+		s.Require().NoError(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().Equal(subscription, printer.GetLines()[0])
+	})
+}
+
+func (s *MmctlUnitTestSuite) TestDeleteEventSubscriptionsCmd() {
+	s.Run("Delete several event subscriptions, one failing", func() {
+		printer.Clean()
+
+		subscriptionID := model.NewId()
+		failingID := model.NewId()
+
+		s.client.
+			EXPECT().
+			DeleteEventSubscription(context.TODO(), subscriptionID).
+			Return(&model.Response{}, nil).
+			Times(1)
+
+		s.client.
+			EXPECT().
+			DeleteEventSubscription(context.TODO(), failingID).
+			Return(&model.Response{}, errors.New("mock error")).
+			Times(1)
+
+		err := deleteEventSubscriptionsCmdF(s.client, &cobra.Command{}, []string{subscriptionID, failingID})
+		s.Require().Error(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().Len(printer.GetErrorLines(), 1)
+		s.Require().Equal("could not delete event subscription '"+failingID+"'", printer.GetErrorLines()[0])
+	})
+}
+
+func (s *MmctlUnitTestSuite) TestRegenEventSubscriptionSecretCmd() {
+	s.Run("Regenerate the secret", func() {
+		printer.Clean()
+
+		subscription := &model.EventSubscription{Id: model.NewId(), Secret: "newsecret"}
+
+		s.client.
+			EXPECT().
+			RegenEventSubscriptionSecret(context.TODO(), subscription.Id).
+			Return(subscription, &model.Response{}, nil).
+			Times(1)
+
+		err := regenEventSubscriptionSecretCmdF(s.client, &cobra.Command{}, []string{subscription.Id})
+		s.Require().NoError(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().Equal(subscription, printer.GetLines()[0])
+	})
+}
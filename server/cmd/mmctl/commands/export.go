@@ -5,9 +5,11 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 
 	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/client"
 	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/printer"
@@ -99,6 +101,8 @@ func init() {
 	ExportCreateCmd.Flags().Bool("include-archived-channels", false, "Include archived channels in the export file.")
 	ExportCreateCmd.Flags().Bool("include-profile-pictures", false, "Include profile pictures in the export file.")
 	ExportCreateCmd.Flags().Bool("no-roles-and-schemes", false, "Exclude roles and custom permission schemes from the export file.")
+	ExportCreateCmd.Flags().Bool("incremental", false, "Only export what was created, updated or deleted since the last successful export.")
+	ExportCreateCmd.Flags().Int64("since", 0, "Only export what was created, updated or deleted since this time, in milliseconds. Implies --incremental.")
 
 	ExportDownloadCmd.Flags().Int("num-retries", 5, "Number of retries to do to resume a download.")
 
@@ -145,6 +149,18 @@ func exportCreateCmdF(c client.Client, command *cobra.Command, args []string) er
 		data["include_profile_pictures"] = "true"
 	}
 
+	incremental, _ := command.Flags().GetBool("incremental")
+	since, _ := command.Flags().GetInt64("since")
+	if since < 0 {
+		return errors.New("since must be a positive time in milliseconds")
+	}
+	if incremental || since > 0 {
+		data["incremental"] = "true"
+	}
+	if since > 0 {
+		data["incremental_since"] = strconv.FormatInt(since, 10)
+	}
+
 	job, _, err := c.CreateJob(context.TODO(), &model.Job{
 		Type: model.JobTypeExportProcess,
 		Data: data,
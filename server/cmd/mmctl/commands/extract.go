@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/client"
@@ -23,7 +24,7 @@ var ExtractCmd = &cobra.Command{
 
 var ExtractRunCmd = &cobra.Command{
 	Use:     "run",
-	Example: "  extract run",
+	Example: "  extract run\n  extract run --extensions xlsx,ods,csv,epub",
 	Short:   "Start a content extraction job.",
 	Args:    cobra.NoArgs,
 	RunE:    withClient(extractRunCmdF),
@@ -54,6 +55,7 @@ var ExtractJobShowCmd = &cobra.Command{
 func init() {
 	ExtractRunCmd.Flags().Int64("from", 0, "The timestamp of the earliest file to extract, expressed in seconds since the unix epoch.")
 	ExtractRunCmd.Flags().Int64("to", 0, "The timestamp of the latest file to extract, expressed in seconds since the unix epoch. Defaults to the current time.")
+	ExtractRunCmd.Flags().StringSlice("extensions", []string{}, "Only extract the content of files with these extensions, e.g. to backfill newly supported formats.")
 	ExtractJobListCmd.Flags().Int("page", 0, "Page number to fetch for the list of extract jobs")
 	ExtractJobListCmd.Flags().Int("per-page", DefaultPageSize, "Number of extract jobs to be fetched")
 	ExtractJobListCmd.Flags().Bool("all", false, "Fetch all extract jobs. --page flag will be ignore if provided")
@@ -80,13 +82,22 @@ func extractRunCmdF(c client.Client, command *cobra.Command, args []string) erro
 	if to == 0 {
 		to = model.GetMillis() / 1000
 	}
+	extensions, err := command.Flags().GetStringSlice("extensions")
+	if err != nil {
+		return err
+	}
+
+	data := map[string]string{
+		"from": strconv.FormatInt(from, 10),
+		"to":   strconv.FormatInt(to, 10),
+	}
+	if len(extensions) > 0 {
+		data["extensions"] = strings.Join(extensions, ",")
+	}
 
 	job, _, err := c.CreateJob(context.TODO(), &model.Job{
 		Type: model.JobTypeExtractContent,
-		Data: map[string]string{
-			"from": strconv.FormatInt(from, 10),
-			"to":   strconv.FormatInt(to, 10),
-		},
+		Data: data,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create content extraction job: %w", err)
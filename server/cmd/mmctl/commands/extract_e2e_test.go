@@ -28,6 +28,7 @@ func (s *MmctlE2ETestSuite) TestExtractRunCmdF() {
 		cmd := &cobra.Command{}
 		cmd.Flags().Int64("from", 0, "")
 		cmd.Flags().Int64("to", model.GetMillis()/1000, "")
+		cmd.Flags().StringSlice("extensions", []string{}, "")
 
 		err := extractRunCmdF(s.th.Client, cmd, []string{})
 		s.Require().NotNil(err)
@@ -60,6 +61,7 @@ func (s *MmctlE2ETestSuite) TestExtractRunCmdF() {
 		cmd := &cobra.Command{}
 		cmd.Flags().Int64("from", 0, "")
 		cmd.Flags().Int64("to", model.GetMillis()/1000, "")
+		cmd.Flags().StringSlice("extensions", []string{}, "")
 
 		err = extractRunCmdF(c, cmd, []string{})
 		s.Require().Nil(err)
@@ -309,7 +309,7 @@ func (s *MmctlE2ETestSuite) TestTeamGroupDisableCmd() {
 	defer cleanUpFn()
 
 	team.GroupConstrained = model.NewPointer(true)
-	_, err := s.th.App.UpdateTeam(team)
+	_, err := s.th.App.UpdateTeam(s.th.Context, team)
 	s.Require().Nil(err)
 
 	s.Run("MM-T3919 Should not allow regular user to disable group for team", func() {
@@ -329,7 +329,7 @@ func (s *MmctlE2ETestSuite) TestTeamGroupDisableCmd() {
 
 		team.GroupConstrained = model.NewPointer(true)
 		defer func() {
-			_, err := s.th.App.UpdateTeam(team)
+			_, err := s.th.App.UpdateTeam(s.th.Context, team)
 			s.Require().Nil(err)
 		}()
 
@@ -365,7 +365,7 @@ func (s *MmctlE2ETestSuite) TestTeamGroupEnableCmd() {
 
 		team.GroupConstrained = model.NewPointer(false)
 		defer func() {
-			_, err := s.th.App.UpdateTeam(team)
+			_, err := s.th.App.UpdateTeam(s.th.Context, team)
 			s.Require().Nil(err)
 		}()
 
@@ -90,12 +90,31 @@ var ImportJobShowCmd = &cobra.Command{
 	RunE:    withClient(importJobShowCmdF),
 }
 
-var ImportProcessCmd = &cobra.Command{
-	Use:     "process [importname]",
-	Example: "  import process 35uy6cwrqfnhdx3genrhqqznxc_import.zip",
-	Short:   "Start an import job",
+var ImportJobResumeCmd = &cobra.Command{
+	Use:     "resume [importJobID]",
+	Example: " import job resume f3d68qkkm7n8xgsfxwuo498rah",
+	Short:   "Resume an interrupted or failed import job from its last checkpoint",
 	Args:    cobra.ExactArgs(1),
-	RunE:    withClient(importProcessCmdF),
+	RunE:    withClient(importJobResumeCmdF),
+}
+
+var ImportErrorReportCmd = &cobra.Command{
+	Use:     "error-report [importname] [filepath]",
+	Example: "  import error-report import_file.zip import_file_errors.jsonl",
+	Short:   "Download the list of invalid lines found while validating an import file",
+	Args:    cobra.RangeArgs(1, 2),
+	RunE:    withClient(importErrorReportCmdF),
+}
+
+var ImportProcessCmd = &cobra.Command{
+	Use: "process [importname]",
+	Example: `  import process 35uy6cwrqfnhdx3genrhqqznxc_import.zip
+  import process /path/to/import.jsonl --bypass-upload
+  import process 35uy6cwrqfnhdx3genrhqqznxc_slack_export.zip --source slack --team myteam
+  import process 35uy6cwrqfnhdx3genrhqqznxc_teams_export.zip --source teams --team myteam --dry-run`,
+	Short: "Start an import job",
+	Args:  cobra.ExactArgs(1),
+	RunE:  withClient(importProcessCmdF),
 }
 
 var ImportValidateCmd = &cobra.Command{
@@ -123,6 +142,9 @@ func init() {
 
 	ImportProcessCmd.Flags().Bool("bypass-upload", false, "If this is set, the file is not processed from the server, but rather directly read from the filesystem. Works only in --local mode.")
 	ImportProcessCmd.Flags().Bool("extract-content", true, "If this is set, document attachments will be extracted and indexed during the import process. It is advised to disable it to improve performance.")
+	ImportProcessCmd.Flags().String("source", "", "The product the import file was exported from, if not Mattermost. Supported values: slack, teams, rocketchat.")
+	ImportProcessCmd.Flags().String("team", "", "The name of the team to import the channels of a --source export into.")
+	ImportProcessCmd.Flags().Bool("dry-run", false, "If this is set, the import file is only validated and nothing is imported.")
 
 	ImportListCmd.AddCommand(
 		ImportListAvailableCmd,
@@ -131,6 +153,7 @@ func init() {
 	ImportJobCmd.AddCommand(
 		ImportJobListCmd,
 		ImportJobShowCmd,
+		ImportJobResumeCmd,
 	)
 	ImportCmd.AddCommand(
 		ImportUploadCmd,
@@ -139,6 +162,7 @@ func init() {
 		ImportJobCmd,
 		ImportValidateCmd,
 		ImportDeleteCmd,
+		ImportErrorReportCmd,
 	)
 	RootCmd.AddCommand(ImportCmd)
 }
@@ -311,13 +335,29 @@ func importProcessCmdF(c client.Client, command *cobra.Command, args []string) e
 
 	extractContent, _ := command.Flags().GetBool("extract-content")
 
+	data := map[string]string{
+		"import_file":     importFile,
+		"local_mode":      strconv.FormatBool(isLocal && bypassUpload),
+		"extract_content": strconv.FormatBool(extractContent),
+	}
+
+	source, _ := command.Flags().GetString("source")
+	if source != "" {
+		team, _ := command.Flags().GetString("team")
+		if team == "" {
+			return errors.New("--team is required when --source is set")
+		}
+		data["source"] = source
+		data["team"] = team
+	}
+
+	if dryRun, _ := command.Flags().GetBool("dry-run"); dryRun {
+		data["dry_run"] = "true"
+	}
+
 	job, _, err := c.CreateJob(context.TODO(), &model.Job{
 		Type: model.JobTypeImportProcess,
-		Data: map[string]string{
-			"import_file":     importFile,
-			"local_mode":      strconv.FormatBool(isLocal && bypassUpload),
-			"extract_content": strconv.FormatBool(extractContent),
-		},
+		Data: data,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create import process job: %w", err)
@@ -339,6 +379,68 @@ func importJobShowCmdF(c client.Client, command *cobra.Command, args []string) e
 	return nil
 }
 
+func importJobResumeCmdF(c client.Client, command *cobra.Command, args []string) error {
+	job, _, err := c.GetJob(context.TODO(), args[0])
+	if err != nil {
+		return fmt.Errorf("failed to get import job: %w", err)
+	}
+
+	if job.Type != model.JobTypeImportProcess {
+		return fmt.Errorf("job %s is not an import job", job.Id)
+	}
+	switch job.Status {
+	case model.JobStatusInProgress:
+		// A job in progress may still be running on another server, so it's
+		// only resumed once it has gone without activity for a while.
+		if model.GetMillis()-job.LastActivityAt < model.ImportProcessJobStaleTimeoutMilliseconds {
+			return fmt.Errorf("import job %s is still in progress, it can only be resumed after %s without activity", job.Id, time.Duration(model.ImportProcessJobStaleTimeoutMilliseconds)*time.Millisecond)
+		}
+	case model.JobStatusError, model.JobStatusCanceled:
+	default:
+		return fmt.Errorf("import job %s can't be resumed from the %s status", job.Id, job.Status)
+	}
+
+	// Moving the job back to pending lets the job server pick it up again,
+	// with the checkpoint stored in its data.
+	if _, err := c.UpdateJobStatus(context.TODO(), job.Id, model.JobStatusPending, true); err != nil {
+		return fmt.Errorf("failed to resume import job: %w", err)
+	}
+
+	checkpoint := job.Data["checkpoint_line"]
+	if checkpoint == "" {
+		checkpoint = "0"
+	}
+	printer.PrintT("Import job {{.Id}} will resume after line {{.Line}}", map[string]string{"Id": job.Id, "Line": checkpoint})
+
+	return nil
+}
+
+func importErrorReportCmdF(c client.Client, command *cobra.Command, args []string) error {
+	name := args[0]
+	path := strings.TrimSuffix(filepath.Base(name), filepath.Ext(name)) + "_errors.jsonl"
+	if len(args) > 1 {
+		path = args[1]
+	}
+
+	if info, err := os.Stat(path); err == nil && info.Size() > 0 {
+		return fmt.Errorf("file %s already exists", path)
+	}
+
+	outFile, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create error report file: %w", err)
+	}
+	defer outFile.Close()
+
+	if _, _, err := c.DownloadImportErrorReport(context.TODO(), name, outFile); err != nil {
+		os.Remove(path)
+		return fmt.Errorf("failed to download error report: %w", err)
+	}
+
+	printer.Print(fmt.Sprintf("Error report downloaded to %q", path))
+	return nil
+}
+
 func importJobListCmdF(c client.Client, command *cobra.Command, args []string) error {
 	return jobListCmdF(c, command, model.JobTypeImportProcess, "")
 }
@@ -7,11 +7,13 @@ import (
 	"archive/zip"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
 
+	gomock "github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
@@ -210,6 +212,174 @@ func (s *MmctlUnitTestSuite) TestImportJobListCmdF() {
 	})
 }
 
+func (s *MmctlUnitTestSuite) TestImportJobResumeCmdF() {
+	s.Run("resume failed job", func() {
+		printer.Clean()
+		printer.SetFormat(printer.FormatPlain)
+		defer printer.SetFormat(printer.FormatJSON)
+
+		mockJob := &model.Job{
+			Id:     model.NewId(),
+			Type:   model.JobTypeImportProcess,
+			Status: model.JobStatusError,
+			Data:   map[string]string{"checkpoint_line": "20000"},
+		}
+
+		s.client.
+			EXPECT().
+			GetJob(context.TODO(), mockJob.Id).
+			Return(mockJob, &model.Response{}, nil).
+			Times(1)
+
+		s.client.
+			EXPECT().
+			UpdateJobStatus(context.TODO(), mockJob.Id, model.JobStatusPending, true).
+			Return(&model.Response{}, nil).
+			Times(1)
+
+		err := importJobResumeCmdF(s.client, &cobra.Command{}, []string{mockJob.Id})
+		s.Require().Nil(err)
+		s.Len(printer.GetLines(), 1)
+		s.Empty(printer.GetErrorLines())
+		s.Equal(fmt.Sprintf("Import job %s will resume after line 20000", mockJob.Id), printer.GetLines()[0])
+	})
+
+	s.Run("not an import job", func() {
+		printer.Clean()
+
+		mockJob := &model.Job{
+			Id:     model.NewId(),
+			Type:   model.JobTypeExportProcess,
+			Status: model.JobStatusError,
+		}
+
+		s.client.
+			EXPECT().
+			GetJob(context.TODO(), mockJob.Id).
+			Return(mockJob, &model.Response{}, nil).
+			Times(1)
+
+		err := importJobResumeCmdF(s.client, &cobra.Command{}, []string{mockJob.Id})
+		s.Require().EqualError(err, fmt.Sprintf("job %s is not an import job", mockJob.Id))
+		s.Empty(printer.GetLines())
+	})
+
+	s.Run("job already finished", func() {
+		printer.Clean()
+
+		mockJob := &model.Job{
+			Id:     model.NewId(),
+			Type:   model.JobTypeImportProcess,
+			Status: model.JobStatusSuccess,
+		}
+
+		s.client.
+			EXPECT().
+			GetJob(context.TODO(), mockJob.Id).
+			Return(mockJob, &model.Response{}, nil).
+			Times(1)
+
+		err := importJobResumeCmdF(s.client, &cobra.Command{}, []string{mockJob.Id})
+		s.Require().EqualError(err, fmt.Sprintf("import job %s can't be resumed from the success status", mockJob.Id))
+		s.Empty(printer.GetLines())
+	})
+
+	s.Run("job still running", func() {
+		printer.Clean()
+
+		mockJob := &model.Job{
+			Id:             model.NewId(),
+			Type:           model.JobTypeImportProcess,
+			Status:         model.JobStatusInProgress,
+			LastActivityAt: model.GetMillis(),
+		}
+
+		s.client.
+			EXPECT().
+			GetJob(context.TODO(), mockJob.Id).
+			Return(mockJob, &model.Response{}, nil).
+			Times(1)
+
+		err := importJobResumeCmdF(s.client, &cobra.Command{}, []string{mockJob.Id})
+		s.Require().EqualError(err, fmt.Sprintf("import job %s is still in progress, it can only be resumed after 10m0s without activity", mockJob.Id))
+		s.Empty(printer.GetLines())
+	})
+
+	s.Run("orphaned job in progress", func() {
+		printer.Clean()
+		printer.SetFormat(printer.FormatPlain)
+		defer printer.SetFormat(printer.FormatJSON)
+
+		mockJob := &model.Job{
+			Id:             model.NewId(),
+			Type:           model.JobTypeImportProcess,
+			Status:         model.JobStatusInProgress,
+			LastActivityAt: model.GetMillis() - model.ImportProcessJobStaleTimeoutMilliseconds - 1000,
+			Data:           map[string]string{"checkpoint_line": "10000"},
+		}
+
+		s.client.
+			EXPECT().
+			GetJob(context.TODO(), mockJob.Id).
+			Return(mockJob, &model.Response{}, nil).
+			Times(1)
+
+		s.client.
+			EXPECT().
+			UpdateJobStatus(context.TODO(), mockJob.Id, model.JobStatusPending, true).
+			Return(&model.Response{}, nil).
+			Times(1)
+
+		err := importJobResumeCmdF(s.client, &cobra.Command{}, []string{mockJob.Id})
+		s.Require().Nil(err)
+		s.Equal(fmt.Sprintf("Import job %s will resume after line 10000", mockJob.Id), printer.GetLines()[0])
+	})
+}
+
+func (s *MmctlUnitTestSuite) TestImportErrorReportCmdF() {
+	s.Run("download report", func() {
+		printer.Clean()
+
+		importName := "import.zip"
+		path := filepath.Join(s.T().TempDir(), "report.jsonl")
+
+		s.client.
+			EXPECT().
+			DownloadImportErrorReport(context.TODO(), importName, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, wr io.Writer) (int64, *model.Response, error) {
+				n, err := io.WriteString(wr, `{"line_number":2,"error_id":"some.error","error":"some error"}`)
+				return int64(n), &model.Response{}, err
+			}).
+			Times(1)
+
+		err := importErrorReportCmdF(s.client, &cobra.Command{}, []string{importName, path})
+		s.Require().Nil(err)
+		s.Len(printer.GetLines(), 1)
+		s.Empty(printer.GetErrorLines())
+
+		data, err := os.ReadFile(path)
+		s.Require().NoError(err)
+		s.Contains(string(data), `"line_number":2`)
+	})
+
+	s.Run("no report", func() {
+		printer.Clean()
+
+		importName := "import.zip"
+		path := filepath.Join(s.T().TempDir(), "report.jsonl")
+
+		s.client.
+			EXPECT().
+			DownloadImportErrorReport(context.TODO(), importName, gomock.Any()).
+			Return(int64(0), &model.Response{StatusCode: http.StatusNotFound}, errors.New("not found")).
+			Times(1)
+
+		err := importErrorReportCmdF(s.client, &cobra.Command{}, []string{importName, path})
+		s.Require().Error(err)
+		s.NoFileExists(path)
+	})
+}
+
 func (s *MmctlUnitTestSuite) TestImportProcessCmdF() {
 	printer.Clean()
 	importFile := "import.zip"
@@ -235,6 +405,83 @@ func (s *MmctlUnitTestSuite) TestImportProcessCmdF() {
 	s.Equal(mockJob, printer.GetLines()[0].(*model.Job))
 }
 
+func (s *MmctlUnitTestSuite) TestImportProcessCmdFWithSource() {
+	importFile := "slack_export.zip"
+
+	s.Run("source without team", func() {
+		printer.Clean()
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("source", "slack", "")
+		cmd.Flags().String("team", "", "")
+
+		err := importProcessCmdF(s.client, cmd, []string{importFile})
+		s.Require().EqualError(err, "--team is required when --source is set")
+		s.Empty(printer.GetLines())
+	})
+
+	s.Run("source with team", func() {
+		printer.Clean()
+
+		mockJob := &model.Job{
+			Type: model.JobTypeImportProcess,
+			Data: map[string]string{
+				"import_file":     importFile,
+				"local_mode":      "false",
+				"extract_content": "false",
+				"source":          "slack",
+				"team":            "myteam",
+			},
+		}
+
+		s.client.
+			EXPECT().
+			CreateJob(context.TODO(), mockJob).
+			Return(mockJob, &model.Response{}, nil).
+			Times(1)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("source", "slack", "")
+		cmd.Flags().String("team", "myteam", "")
+
+		err := importProcessCmdF(s.client, cmd, []string{importFile})
+		s.Require().Nil(err)
+		s.Len(printer.GetLines(), 1)
+		s.Equal(mockJob, printer.GetLines()[0].(*model.Job))
+	})
+	s.Run("dry run", func() {
+		printer.Clean()
+
+		mockJob := &model.Job{
+			Type: model.JobTypeImportProcess,
+			Data: map[string]string{
+				"import_file":     importFile,
+				"local_mode":      "false",
+				"extract_content": "false",
+				"source":          "rocketchat",
+				"team":            "myteam",
+				"dry_run":         "true",
+			},
+		}
+
+		s.client.
+			EXPECT().
+			CreateJob(context.TODO(), mockJob).
+			Return(mockJob, &model.Response{}, nil).
+			Times(1)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("source", "rocketchat", "")
+		cmd.Flags().String("team", "myteam", "")
+		cmd.Flags().Bool("dry-run", true, "")
+
+		err := importProcessCmdF(s.client, cmd, []string{importFile})
+		s.Require().Nil(err)
+		s.Len(printer.GetLines(), 1)
+		s.Equal(mockJob, printer.GetLines()[0].(*model.Job))
+	})
+}
+
 func (s *MmctlUnitTestSuite) TestImportValidateCmdF() {
 	importFilePath := filepath.Join(os.TempDir(), "import.zip")
 
@@ -169,7 +169,7 @@ func (s *MmctlE2ETestSuite) TestModifyTeamsCmdF() {
 
 		s.Require().Equal(model.TeamInvite, printer.GetLines()[0].(*model.Team).Type)
 		// teardown
-		appErr := s.th.App.UpdateTeamPrivacy(teamID, model.TeamOpen, true)
+		appErr := s.th.App.UpdateTeamPrivacy(s.th.Context, teamID, model.TeamOpen, true)
 		s.Require().Nil(appErr)
 		t, err := s.th.App.GetTeam(teamID)
 		s.Require().Nil(err)
@@ -6,6 +6,10 @@ package commands
 import (
 	"context"
 	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
 
 	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/client"
 	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/printer"
@@ -22,8 +26,8 @@ var TokenCmd = &cobra.Command{
 var GenerateUserTokenCmd = &cobra.Command{
 	Use:     "generate [user] [description]",
 	Short:   "Generate token for a user",
-	Long:    "Generate token for a user",
-	Example: "  generate testuser test-token",
+	Long:    "Generate token for a user, optionally expiring and restricted to some scopes.",
+	Example: "  generate testuser test-token\n  generate ci-bot deploy --expires-in 720h --scope write:posts:4xp9fdt7pbgium38k1ewgfmpha --scope read:users",
 	RunE:    withClient(generateTokenForAUserCmdF),
 	Args:    cobra.ExactArgs(2),
 }
@@ -47,6 +51,9 @@ var ListUserTokensCmd = &cobra.Command{
 }
 
 func init() {
+	GenerateUserTokenCmd.Flags().Duration("expires-in", 0, "Duration after which the token expires, such as 720h. The token never expires if not set")
+	GenerateUserTokenCmd.Flags().StringSlice("scope", nil, "Scope restricting the permissions of the token, such as read:posts, write:posts:<channel-id> or manage:webhooks. Can be repeated")
+
 	ListUserTokensCmd.Flags().Int("page", 0, "Page number to fetch for the list of users")
 	ListUserTokensCmd.Flags().Int("per-page", DefaultPageSize, "Number of users to be fetched")
 	ListUserTokensCmd.Flags().Bool("all", false, "Fetch all tokens. --page flag will be ignore if provided")
@@ -71,7 +78,26 @@ func generateTokenForAUserCmdF(c client.Client, command *cobra.Command, args []s
 		return errors.Errorf("could not retrieve user information of %q", userArg)
 	}
 
-	token, _, err := c.CreateUserAccessToken(context.TODO(), user.Id, args[1])
+	expiresIn, _ := command.Flags().GetDuration("expires-in")
+	scopes, _ := command.Flags().GetStringSlice("scope")
+	if expiresIn < 0 {
+		return errors.New("the expiry duration must be positive")
+	}
+
+	var token *model.UserAccessToken
+	var err error
+	if expiresIn == 0 && len(scopes) == 0 {
+		token, _, err = c.CreateUserAccessToken(context.TODO(), user.Id, args[1])
+	} else {
+		options := &model.UserAccessToken{
+			Description: args[1],
+			Scopes:      scopes,
+		}
+		if expiresIn > 0 {
+			options.ExpiresAt = model.GetMillisForTime(time.Now().Add(expiresIn))
+		}
+		token, _, err = c.CreateUserAccessTokenWithOptions(context.TODO(), user.Id, options)
+	}
 	if err != nil {
 		return errors.Errorf("could not create token for %q: %s", userArg, err.Error())
 	}
@@ -80,6 +106,10 @@ func generateTokenForAUserCmdF(c client.Client, command *cobra.Command, args []s
 	return nil
 }
 
+const tokenListTemplate = "{{.Id}}: {{.Description}}" +
+	"{{if .Scopes}} (scopes: {{join .Scopes \", \"}}){{end}}" +
+	"{{if .ExpiresAt}} (expires: {{millisToTime .ExpiresAt}}){{end}}"
+
 func listTokensOfAUserCmdF(c client.Client, command *cobra.Command, args []string) error {
 	page, _ := command.Flags().GetInt("page")
 	perPage, _ := command.Flags().GetInt("per-page")
@@ -108,12 +138,17 @@ func listTokensOfAUserCmdF(c client.Client, command *cobra.Command, args []strin
 		return errors.Errorf("there are no tokens for the %q", userArg)
 	}
 
+	printer.SetTemplateFunc("join", strings.Join)
+	printer.SetTemplateFunc("millisToTime", func(millis int64) string {
+		return time.UnixMilli(millis).UTC().Format(time.RFC3339)
+	})
+
 	for _, t := range tokens {
 		if t.IsActive && !inactive {
-			printer.PrintT("{{.Id}}: {{.Description}}", t)
+			printer.PrintT(tokenListTemplate, t)
 		}
 		if !t.IsActive && !active {
-			printer.PrintT("{{.Id}}: {{.Description}}", t)
+			printer.PrintT(tokenListTemplate, t)
 		}
 	}
 	return nil
@@ -7,7 +7,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/pkg/errors"
 
@@ -48,6 +50,40 @@ func (s *MmctlUnitTestSuite) TestGenerateTokenForAUserCmd() {
 		s.Require().Equal(&mockToken, printer.GetLines()[0])
 	})
 
+	s.Run("Should generate an expiring and scoped token for a user", func() {
+		printer.Clean()
+
+		mockUser := model.User{Id: "userId1", Email: "user1@example.com", Username: "user1"}
+		mockToken := model.UserAccessToken{Token: "token-id", Description: "token-desc", Scopes: model.StringArray{"read:posts", "manage:webhooks"}}
+
+		s.client.
+			EXPECT().
+			GetUserByUsername(context.TODO(), mockUser.Username, "").
+			Return(&mockUser, &model.Response{}, nil).
+			Times(1)
+
+		before := model.GetMillis()
+		s.client.
+			EXPECT().
+			CreateUserAccessTokenWithOptions(context.TODO(), mockUser.Id, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, token *model.UserAccessToken) (*model.UserAccessToken, *model.Response, error) {
+				s.Require().Equal(mockToken.Description, token.Description)
+				s.Require().Equal(mockToken.Scopes, token.Scopes)
+				s.Require().InDelta(before+24*60*60*1000, token.ExpiresAt, 60*1000)
+				return &mockToken, &model.Response{}, nil
+			}).
+			Times(1)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().Duration("expires-in", 24*time.Hour, "")
+		cmd.Flags().StringSlice("scope", []string{"read:posts", "manage:webhooks"}, "")
+
+		err := generateTokenForAUserCmdF(s.client, cmd, []string{mockUser.Username, mockToken.Description})
+		s.Require().Nil(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().Equal(&mockToken, printer.GetLines()[0])
+	})
+
 	s.Run("Should fail on an invalid username", func() {
 		printer.Clean()
 
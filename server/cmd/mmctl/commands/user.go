@@ -194,6 +194,16 @@ var ListUsersCmd = &cobra.Command{
 	Args:    cobra.NoArgs,
 }
 
+var UserPasswordHashesCmd = &cobra.Command{
+	Use:   "password-hashes",
+	Short: "Report the password hashing algorithms in use",
+	Long: `Report how many users have their password hashed with each algorithm.
+Passwords not hashed with the latest algorithm and parameters are rehashed when their users log in.`,
+	Example: "  user password-hashes",
+	RunE:    withClient(userPasswordHashesCmdF),
+	Args:    cobra.NoArgs,
+}
+
 var VerifyUserEmailWithoutTokenCmd = &cobra.Command{
 	Use:     "verify [users]",
 	Short:   "Mark user's email as verified",
@@ -429,6 +439,7 @@ Global Flags:
 		DeleteAllUsersCmd,
 		SearchUserCmd,
 		ListUsersCmd,
+		UserPasswordHashesCmd,
 		VerifyUserEmailWithoutTokenCmd,
 		UserConvertCmd,
 		MigrateAuthCmd,
@@ -795,6 +806,19 @@ func ResetListUsersCmd(t *testing.T) *cobra.Command {
 	return ListUsersCmd
 }
 
+func userPasswordHashesCmdF(c client.Client, command *cobra.Command, args []string) error {
+	report, _, err := c.GetPasswordHashReport(context.TODO())
+	if err != nil {
+		return errors.Wrap(err, "Failed to get the password hash report")
+	}
+
+	for _, line := range report {
+		printer.PrintT(`{{.Algorithm}}{{if .Latest}} (latest){{end}}: {{.Count}}`, line)
+	}
+
+	return nil
+}
+
 func listUsersCmdF(c client.Client, command *cobra.Command, args []string) error {
 	page, err := command.Flags().GetInt("page")
 	if err != nil {
@@ -410,7 +410,7 @@ func (s *MmctlE2ETestSuite) TestUserInviteCmdf() {
 
 		team := s.th.CreateTeam()
 		team.AllowedDomains = "@example.com"
-		team, appErr := s.th.App.UpdateTeam(team)
+		team, appErr := s.th.App.UpdateTeam(s.th.Context, team)
 		s.Require().Nil(appErr)
 
 		user := s.th.CreateUser()
@@ -1678,6 +1678,43 @@ func (s *MmctlUnitTestSuite) TestResetUserMfaCmd() {
 	})
 }
 
+func (s *MmctlUnitTestSuite) TestUserPasswordHashesCmdF() {
+	s.Run("Report the password hashes", func() {
+		printer.Clean()
+
+		report := []*model.PasswordHashReport{
+			{Algorithm: "argon2id", Latest: true, Count: 10},
+			{Algorithm: "bcrypt", Count: 3},
+		}
+
+		s.client.
+			EXPECT().
+			GetPasswordHashReport(context.TODO()).
+			Return(report, &model.Response{}, nil).
+			Times(1)
+
+		err := userPasswordHashesCmdF(s.client, &cobra.Command{}, []string{})
+		s.Require().Nil(err)
+		s.Require().Len(printer.GetLines(), 2)
+		s.Require().Equal(report[0], printer.GetLines()[0])
+		s.Require().Equal(report[1], printer.GetLines()[1])
+	})
+
+	s.Run("Fail to get the report", func() {
+		printer.Clean()
+
+		s.client.
+			EXPECT().
+			GetPasswordHashReport(context.TODO()).
+			Return(nil, &model.Response{}, errors.New("mock error")).
+			Times(1)
+
+		err := userPasswordHashesCmdF(s.client, &cobra.Command{}, []string{})
+		s.Require().Error(err)
+		s.Require().Empty(printer.GetLines())
+	})
+}
+
 func (s *MmctlUnitTestSuite) TestListUserCmdF() {
 	s.Run("Listing users with paging", func() {
 		printer.Clean()
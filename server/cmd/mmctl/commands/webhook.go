@@ -5,12 +5,14 @@ package commands
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/mattermost/mattermost/server/public/model"
 
 	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/client"
 	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/printer"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
@@ -53,7 +55,7 @@ var CreateIncomingWebhookCmd = &cobra.Command{
 var ModifyIncomingWebhookCmd = &cobra.Command{
 	Use:     "modify-incoming",
 	Short:   "Modify incoming webhook",
-	Long:    "Modify existing incoming webhook by changing its title, description, channel or icon url",
+	Long:    "Modify existing incoming webhook by changing its title, description, channel, icon url or adapter",
 	Args:    cobra.ExactArgs(1),
 	Example: "  webhook modify-incoming [webhookID] --channel [channelID] --display-name [displayName] --description [webhookDescription] --lock-to-channel --icon [iconURL]",
 	RunE:    withClient(modifyIncomingWebhookCmdF),
@@ -86,6 +88,33 @@ var DeleteWebhookCmd = &cobra.Command{
 	RunE:    withClient(deleteWebhookCmdF),
 }
 
+var RegenOutgoingWebhookSecretCmd = &cobra.Command{
+	Use:     "regen-secret [webhookID]",
+	Short:   "Regenerate the secret of an outgoing webhook",
+	Long:    "Regenerate the secret used to sign the requests of an outgoing webhook. Deliveries are signed with the new secret, including the retries of the pending ones.",
+	Args:    cobra.ExactArgs(1),
+	Example: "  webhook regen-secret [webhookID]",
+	RunE:    withClient(regenOutgoingWebhookSecretCmdF),
+}
+
+var ListOutgoingWebhookDeliveriesCmd = &cobra.Command{
+	Use:     "deliveries [webhookID]",
+	Short:   "List the deliveries of an outgoing webhook",
+	Long:    "List the requests sent, or still to be sent, by an outgoing webhook, newest first.",
+	Args:    cobra.ExactArgs(1),
+	Example: "  webhook deliveries [webhookID] --status failed",
+	RunE:    withClient(listOutgoingWebhookDeliveriesCmdF),
+}
+
+var ReplayOutgoingWebhookDeliveryCmd = &cobra.Command{
+	Use:     "replay [webhookID] [deliveryID...]",
+	Short:   "Replay deliveries of an outgoing webhook",
+	Long:    "Send deliveries of an outgoing webhook again, whatever their status.",
+	Args:    cobra.MinimumNArgs(2),
+	Example: "  webhook replay [webhookID] [deliveryID] [anotherDeliveryID]",
+	RunE:    withClient(replayOutgoingWebhookDeliveryCmdF),
+}
+
 func listWebhookCmdF(c client.Client, command *cobra.Command, args []string) error {
 	var teams []*model.Team
 
@@ -164,6 +193,7 @@ func createIncomingWebhookCmdF(c client.Client, command *cobra.Command, args []s
 	description, _ := command.Flags().GetString("description")
 	iconURL, _ := command.Flags().GetString("icon")
 	channelLocked, _ := command.Flags().GetBool("lock-to-channel")
+	adapter, _ := command.Flags().GetString("adapter")
 
 	incomingWebhook := &model.IncomingWebhook{
 		ChannelId:     channel.Id,
@@ -171,6 +201,7 @@ func createIncomingWebhookCmdF(c client.Client, command *cobra.Command, args []s
 		Description:   description,
 		IconURL:       iconURL,
 		ChannelLocked: channelLocked,
+		Adapter:       adapter,
 		Username:      user.Username,
 		UserId:        user.Id,
 	}
@@ -222,6 +253,9 @@ func modifyIncomingWebhookCmdF(c client.Client, command *cobra.Command, args []s
 	}
 	channelLocked, _ := command.Flags().GetBool("lock-to-channel")
 	updatedHook.ChannelLocked = channelLocked
+	if command.Flags().Changed("adapter") {
+		updatedHook.Adapter, _ = command.Flags().GetString("adapter")
+	}
 
 	var newHook *model.IncomingWebhook
 	if newHook, _, err = c.UpdateIncomingWebhook(context.TODO(), updatedHook); err != nil {
@@ -426,6 +460,64 @@ func showWebhookCmdF(c client.Client, command *cobra.Command, args []string) err
 	return errors.New("Webhook with id '" + webhookID + "' not found")
 }
 
+func regenOutgoingWebhookSecretCmdF(c client.Client, command *cobra.Command, args []string) error {
+	printer.SetSingle(true)
+
+	hook, _, err := c.RegenOutgoingHookSecret(context.TODO(), args[0])
+	if err != nil {
+		return fmt.Errorf("unable to regenerate the secret of webhook %q: %w", args[0], err)
+	}
+
+	printer.PrintT("Webhook {{.Id}} secret regenerated: {{.Secret}}", hook)
+	return nil
+}
+
+func listOutgoingWebhookDeliveriesCmdF(c client.Client, command *cobra.Command, args []string) error {
+	status, _ := command.Flags().GetString("status")
+	page, _ := command.Flags().GetInt("page")
+	perPage, _ := command.Flags().GetInt("per-page")
+
+	switch status {
+	case "", model.OutgoingWebhookDeliveryStatusPending, model.OutgoingWebhookDeliveryStatusDelivered, model.OutgoingWebhookDeliveryStatusFailed:
+	default:
+		return fmt.Errorf("invalid delivery status: %s", status)
+	}
+
+	deliveries, _, err := c.GetOutgoingWebhookDeliveries(context.TODO(), args[0], status, page, perPage)
+	if err != nil {
+		return fmt.Errorf("unable to get the deliveries of webhook %q: %w", args[0], err)
+	}
+
+	if len(deliveries) == 0 {
+		printer.Print("No deliveries found")
+		return nil
+	}
+
+	for _, delivery := range deliveries {
+		printer.PrintT("{{.Id}}: {{.Status}} after {{.Attempts}} attempt(s) to {{.URL}}{{if .ResponseCode}}, last response {{.ResponseCode}}{{end}}{{if .Error}}, error: {{.Error}}{{end}}", delivery)
+	}
+
+	return nil
+}
+
+func replayOutgoingWebhookDeliveryCmdF(c client.Client, command *cobra.Command, args []string) error {
+	hookID := args[0]
+
+	var result *multierror.Error
+	for _, deliveryID := range args[1:] {
+		delivery, _, err := c.ReplayOutgoingWebhookDelivery(context.TODO(), hookID, deliveryID)
+		if err != nil {
+			printer.PrintError(fmt.Sprintf("could not replay delivery '%v'", deliveryID))
+			result = multierror.Append(result, fmt.Errorf("could not replay delivery %q: %w", deliveryID, err))
+			continue
+		}
+
+		printer.PrintT("Delivery {{.Id}} replayed: {{.Status}}", delivery)
+	}
+
+	return result.ErrorOrNil()
+}
+
 func init() {
 	CreateIncomingWebhookCmd.Flags().String("channel", "", "Channel ID (required)")
 	_ = CreateIncomingWebhookCmd.MarkFlagRequired("channel")
@@ -435,12 +527,14 @@ func init() {
 	CreateIncomingWebhookCmd.Flags().String("description", "", "Incoming webhook description")
 	CreateIncomingWebhookCmd.Flags().String("icon", "", "Icon URL")
 	CreateIncomingWebhookCmd.Flags().Bool("lock-to-channel", false, "Lock to channel")
+	CreateIncomingWebhookCmd.Flags().String("adapter", "", "Format of the payloads the webhook receives: gitlab, alertmanager or grafana. Defaults to the Slack-compatible format")
 
 	ModifyIncomingWebhookCmd.Flags().String("channel", "", "Channel ID")
 	ModifyIncomingWebhookCmd.Flags().String("display-name", "", "Incoming webhook display name")
 	ModifyIncomingWebhookCmd.Flags().String("description", "", "Incoming webhook description")
 	ModifyIncomingWebhookCmd.Flags().String("icon", "", "Icon URL")
 	ModifyIncomingWebhookCmd.Flags().Bool("lock-to-channel", false, "Lock to channel")
+	ModifyIncomingWebhookCmd.Flags().String("adapter", "", "Format of the payloads the webhook receives: gitlab, alertmanager or grafana. An empty value restores the Slack-compatible format")
 
 	CreateOutgoingWebhookCmd.Flags().String("team", "", "Team name or ID (required)")
 	_ = CreateOutgoingWebhookCmd.MarkFlagRequired("team")
@@ -467,6 +561,10 @@ func init() {
 	ModifyOutgoingWebhookCmd.Flags().StringArray("url", []string{}, "Callback URL")
 	ModifyOutgoingWebhookCmd.Flags().String("content-type", "", "Content-type")
 
+	ListOutgoingWebhookDeliveriesCmd.Flags().String("status", "", "Only list the deliveries with this status (pending, delivered or failed)")
+	ListOutgoingWebhookDeliveriesCmd.Flags().Int("page", 0, "Page number to fetch for the list of deliveries")
+	ListOutgoingWebhookDeliveriesCmd.Flags().Int("per-page", 20, "Number of deliveries to be fetched")
+
 	WebhookCmd.AddCommand(
 		ListWebhookCmd,
 		CreateIncomingWebhookCmd,
@@ -475,6 +573,9 @@ func init() {
 		ModifyOutgoingWebhookCmd,
 		DeleteWebhookCmd,
 		ShowWebhookCmd,
+		RegenOutgoingWebhookSecretCmd,
+		ListOutgoingWebhookDeliveriesCmd,
+		ReplayOutgoingWebhookDeliveryCmd,
 	)
 
 	RootCmd.AddCommand(WebhookCmd)
@@ -414,6 +414,40 @@ func (s *MmctlUnitTestSuite) TestModifyIncomingWebhookCmd() {
 		s.Len(printer.GetErrorLines(), 1)
 		s.Require().Equal("Unable to modify incoming webhook", printer.GetErrorLines()[0])
 	})
+
+	s.Run("Change the adapter of an incoming webhook", func() {
+		printer.Clean()
+
+		mockIncomingWebhook := model.IncomingWebhook{
+			Id:          incomingWebhookID,
+			ChannelId:   channelID,
+			Username:    userName,
+			DisplayName: displayName,
+		}
+		updatedIncomingWebhook := mockIncomingWebhook
+		updatedIncomingWebhook.Adapter = model.IncomingWebhookAdapterAlertmanager
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("adapter", "", "")
+		_ = cmd.Flags().Set("adapter", model.IncomingWebhookAdapterAlertmanager)
+
+		s.client.
+			EXPECT().
+			GetIncomingWebhook(context.TODO(), incomingWebhookID, "").
+			Return(&mockIncomingWebhook, &model.Response{}, nil).
+			Times(1)
+
+		s.client.
+			EXPECT().
+			UpdateIncomingWebhook(context.TODO(), &updatedIncomingWebhook).
+			Return(&updatedIncomingWebhook, &model.Response{}, nil).
+			Times(1)
+
+		err := modifyIncomingWebhookCmdF(s.client, cmd, []string{incomingWebhookID})
+		s.Require().Nil(err)
+		s.Len(printer.GetLines(), 1)
+		s.Require().Equal(&updatedIncomingWebhook, printer.GetLines()[0])
+	})
 }
 
 func (s *MmctlUnitTestSuite) TestCreateOutgoingWebhookCmd() {
@@ -788,3 +822,107 @@ func (s *MmctlUnitTestSuite) TestShowWebhookCmd() {
 		s.Require().Equal("Webhook with id '"+nonExistentID+"' not found", err.Error())
 	})
 }
+
+func (s *MmctlUnitTestSuite) TestRegenOutgoingWebhookSecretCmd() {
+	s.Run("Successfully regenerate the secret", func() {
+		printer.Clean()
+
+		mockHook := &model.OutgoingWebhook{Id: model.NewId(), Secret: "newsecret"}
+
+		s.client.
+			EXPECT().
+			RegenOutgoingHookSecret(context.TODO(), mockHook.Id).
+			Return(mockHook, &model.Response{}, nil).
+			Times(1)
+
+		err := regenOutgoingWebhookSecretCmdF(s.client, &cobra.Command{}, []string{mockHook.Id})
+		s.Require().NoError(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().Equal(mockHook, printer.GetLines()[0])
+	})
+
+	s.Run("Fail to regenerate the secret", func() {
+		printer.Clean()
+
+		hookID := model.NewId()
+		s.client.
+			EXPECT().
+			RegenOutgoingHookSecret(context.TODO(), hookID).
+			Return(nil, &model.Response{}, errors.New("mock error")).
+			Times(1)
+
+		err := regenOutgoingWebhookSecretCmdF(s.client, &cobra.Command{}, []string{hookID})
+		s.Require().Error(err)
+		s.Require().Len(printer.GetLines(), 0)
+	})
+}
+
+func (s *MmctlUnitTestSuite) TestListOutgoingWebhookDeliveriesCmd() {
+	hookID := model.NewId()
+
+	s.Run("List the failed deliveries", func() {
+		printer.Clean()
+
+		deliveries := []*model.OutgoingWebhookDelivery{
+			{Id: model.NewId(), HookId: hookID, Status: model.OutgoingWebhookDeliveryStatusFailed},
+			{Id: model.NewId(), HookId: hookID, Status: model.OutgoingWebhookDeliveryStatusFailed},
+		}
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("status", model.OutgoingWebhookDeliveryStatusFailed, "")
+		cmd.Flags().Int("page", 1, "")
+		cmd.Flags().Int("per-page", 2, "")
+
+		s.client.
+			EXPECT().
+			GetOutgoingWebhookDeliveries(context.TODO(), hookID, model.OutgoingWebhookDeliveryStatusFailed, 1, 2).
+			Return(deliveries, &model.Response{}, nil).
+			Times(1)
+
+		err := listOutgoingWebhookDeliveriesCmdF(s.client, cmd, []string{hookID})
+		s.Require().NoError(err)
+		s.Require().Len(printer.GetLines(), 2)
+		s.Require().Equal(deliveries[0], printer.GetLines()[0])
+		s.Require().Equal(deliveries[1], printer.GetLines()[1])
+	})
+
+	s.Run("Reject an invalid status", func() {
+		printer.Clean()
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("status", "unknown", "")
+
+		err := listOutgoingWebhookDeliveriesCmdF(s.client, cmd, []string{hookID})
+		s.Require().EqualError(err, "invalid delivery status: unknown")
+	})
+}
+
+func (s *MmctlUnitTestSuite) TestReplayOutgoingWebhookDeliveryCmd() {
+	hookID := model.NewId()
+
+	s.Run("Replay several deliveries, one failing", func() {
+		printer.Clean()
+
+		delivery := &model.OutgoingWebhookDelivery{Id: model.NewId(), HookId: hookID, Status: model.OutgoingWebhookDeliveryStatusDelivered}
+		failingID := model.NewId()
+
+		s.client.
+			EXPECT().
+			ReplayOutgoingWebhookDelivery(context.TODO(), hookID, delivery.Id).
+			Return(delivery, &model.Response{}, nil).
+			Times(1)
+
+		s.client.
+			EXPECT().
+			ReplayOutgoingWebhookDelivery(context.TODO(), hookID, failingID).
+			Return(nil, &model.Response{StatusCode: http.StatusConflict}, errors.New("mock error")).
+			Times(1)
+
+		err := replayOutgoingWebhookDeliveryCmdF(s.client, &cobra.Command{}, []string{hookID, delivery.Id, failingID})
+		s.Require().Error(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().Equal(delivery, printer.GetLines()[0])
+		s.Require().Len(printer.GetErrorLines(), 1)
+		s.Require().Equal("could not replay delivery '"+failingID+"'", printer.GetErrorLines()[0])
+	})
+}
@@ -0,0 +1,290 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// workspaceSpec is the desired state of a workspace, as declared in the files given to
+// mmctl apply and mmctl diff.
+//
+// Optional fields of teams and channels that are left out of the spec are not managed, so
+// that a spec can take over an existing workspace piece by piece. Likewise, the members of a
+// team or a channel are only managed when the members list is present.
+type workspaceSpec struct {
+	Schemes []*workspaceScheme `yaml:"schemes"`
+	Roles   []*workspaceRole   `yaml:"roles"`
+	Bots    []*workspaceBot    `yaml:"bots"`
+	Teams   []*workspaceTeam   `yaml:"teams"`
+}
+
+type workspaceScheme struct {
+	Name        string `yaml:"name"`
+	DisplayName string `yaml:"display_name"`
+	Description string `yaml:"description"`
+	Scope       string `yaml:"scope"`
+	// Permissions of the roles of the scheme, keyed by team_admin, team_user, team_guest,
+	// channel_admin, channel_user and channel_guest.
+	Permissions map[string][]string `yaml:"permissions"`
+}
+
+type workspaceRole struct {
+	Name        string   `yaml:"name"`
+	Permissions []string `yaml:"permissions"`
+}
+
+type workspaceBot struct {
+	Username    string `yaml:"username"`
+	DisplayName string `yaml:"display_name"`
+	Description string `yaml:"description"`
+}
+
+type workspaceTeam struct {
+	Name             string                      `yaml:"name"`
+	DisplayName      *string                     `yaml:"display_name"`
+	Description      *string                     `yaml:"description"`
+	Type             *string                     `yaml:"type"`
+	Scheme           *string                     `yaml:"scheme"`
+	Members          []string                    `yaml:"members"`
+	Channels         []*workspaceChannel         `yaml:"channels"`
+	Commands         []*workspaceCommand         `yaml:"commands"`
+	IncomingWebhooks []*workspaceIncomingWebhook `yaml:"incoming_webhooks"`
+	OutgoingWebhooks []*workspaceOutgoingWebhook `yaml:"outgoing_webhooks"`
+}
+
+type workspaceChannel struct {
+	Name        string  `yaml:"name"`
+	DisplayName *string `yaml:"display_name"`
+	Type        *string `yaml:"type"`
+	Header      *string `yaml:"header"`
+	Purpose     *string `yaml:"purpose"`
+	Scheme      *string `yaml:"scheme"`
+	// Category is the custom sidebar category the channel is placed in for its members. It
+	// requires the members of the channel to be managed.
+	Category *string  `yaml:"category"`
+	Members  []string `yaml:"members"`
+}
+
+type workspaceCommand struct {
+	Trigger          string `yaml:"trigger"`
+	DisplayName      string `yaml:"display_name"`
+	Description      string `yaml:"description"`
+	URL              string `yaml:"url"`
+	Method           string `yaml:"method"`
+	Username         string `yaml:"username"`
+	IconURL          string `yaml:"icon_url"`
+	AutoComplete     bool   `yaml:"auto_complete"`
+	AutoCompleteDesc string `yaml:"auto_complete_desc"`
+	AutoCompleteHint string `yaml:"auto_complete_hint"`
+}
+
+type workspaceIncomingWebhook struct {
+	DisplayName   string `yaml:"display_name"`
+	Description   string `yaml:"description"`
+	Channel       string `yaml:"channel"`
+	Username      string `yaml:"username"`
+	IconURL       string `yaml:"icon_url"`
+	ChannelLocked bool   `yaml:"channel_locked"`
+	Adapter       string `yaml:"adapter"`
+}
+
+type workspaceOutgoingWebhook struct {
+	DisplayName  string   `yaml:"display_name"`
+	Description  string   `yaml:"description"`
+	Channel      string   `yaml:"channel"`
+	TriggerWords []string `yaml:"trigger_words"`
+	TriggerWhen  string   `yaml:"trigger_when"`
+	CallbackURLs []string `yaml:"callback_urls"`
+	ContentType  string   `yaml:"content_type"`
+	Username     string   `yaml:"username"`
+	IconURL      string   `yaml:"icon_url"`
+}
+
+// workspaceSchemeRoles maps the keys of the permissions of a scheme to its roles.
+var workspaceSchemeRoles = map[string]func(*model.Scheme) string{
+	"team_admin":    func(s *model.Scheme) string { return s.DefaultTeamAdminRole },
+	"team_user":     func(s *model.Scheme) string { return s.DefaultTeamUserRole },
+	"team_guest":    func(s *model.Scheme) string { return s.DefaultTeamGuestRole },
+	"channel_admin": func(s *model.Scheme) string { return s.DefaultChannelAdminRole },
+	"channel_user":  func(s *model.Scheme) string { return s.DefaultChannelUserRole },
+	"channel_guest": func(s *model.Scheme) string { return s.DefaultChannelGuestRole },
+}
+
+// parseWorkspaceSpecs merges the given YAML documents into a single spec and validates it.
+func parseWorkspaceSpecs(documents ...[]byte) (*workspaceSpec, error) {
+	spec := &workspaceSpec{}
+	for _, document := range documents {
+		var part workspaceSpec
+		if err := yaml.Unmarshal(document, &part); err != nil {
+			return nil, errors.Wrap(err, "failed to parse the workspace configuration")
+		}
+		spec.Schemes = append(spec.Schemes, part.Schemes...)
+		spec.Roles = append(spec.Roles, part.Roles...)
+		spec.Bots = append(spec.Bots, part.Bots...)
+		spec.Teams = append(spec.Teams, part.Teams...)
+	}
+
+	if err := spec.validate(); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// checkUnique returns an error if a name is declared twice.
+func checkUnique(kind string, names map[string]bool, name string) error {
+	if name == "" {
+		return errors.Errorf("%s without a name", kind)
+	}
+	if names[name] {
+		return errors.Errorf("%s %q is declared more than once", kind, name)
+	}
+	names[name] = true
+	return nil
+}
+
+func (s *workspaceSpec) validate() error {
+	schemes := map[string]bool{}
+	for _, scheme := range s.Schemes {
+		if err := checkUnique("scheme", schemes, scheme.Name); err != nil {
+			return err
+		}
+		if scheme.Scope != model.SchemeScopeTeam && scheme.Scope != model.SchemeScopeChannel {
+			return errors.Errorf("scheme %q: scope must be %s or %s", scheme.Name, model.SchemeScopeTeam, model.SchemeScopeChannel)
+		}
+		for key := range scheme.Permissions {
+			if _, ok := workspaceSchemeRoles[key]; !ok {
+				return errors.Errorf("scheme %q: unknown role %q", scheme.Name, key)
+			}
+			if scheme.Scope == model.SchemeScopeChannel && !slices.Contains([]string{"channel_admin", "channel_user", "channel_guest"}, key) {
+				return errors.Errorf("scheme %q: role %q is not part of a channel scheme", scheme.Name, key)
+			}
+		}
+	}
+
+	roles := map[string]bool{}
+	for _, role := range s.Roles {
+		if err := checkUnique("role", roles, role.Name); err != nil {
+			return err
+		}
+	}
+
+	bots := map[string]bool{}
+	for _, bot := range s.Bots {
+		if err := checkUnique("bot", bots, bot.Username); err != nil {
+			return err
+		}
+	}
+
+	teams := map[string]bool{}
+	for _, team := range s.Teams {
+		if err := checkUnique("team", teams, team.Name); err != nil {
+			return err
+		}
+		if team.Type != nil && *team.Type != "open" && *team.Type != "invite" {
+			return errors.Errorf("team %q: type must be open or invite", team.Name)
+		}
+
+		channels := map[string]bool{}
+		for _, channel := range team.Channels {
+			if err := checkUnique("channel", channels, channel.Name); err != nil {
+				return errors.Wrapf(err, "team %q", team.Name)
+			}
+			if !model.IsValidChannelIdentifier(channel.Name) {
+				return errors.Errorf("channel %q: invalid name", channel.Name)
+			}
+			if channel.Type != nil && *channel.Type != "public" && *channel.Type != "private" {
+				return errors.Errorf("channel %q: type must be public or private", channel.Name)
+			}
+			if channel.Category != nil && channel.Members == nil {
+				return errors.Errorf("channel %q: a category requires the members of the channel", channel.Name)
+			}
+		}
+
+		commands := map[string]bool{}
+		for _, command := range team.Commands {
+			if err := checkUnique("command", commands, command.Trigger); err != nil {
+				return errors.Wrapf(err, "team %q", team.Name)
+			}
+			if command.Method != "" && command.Method != "post" && command.Method != "get" {
+				return errors.Errorf("command %q: method must be post or get", command.Trigger)
+			}
+		}
+
+		incomingWebhooks := map[string]bool{}
+		for _, hook := range team.IncomingWebhooks {
+			if err := checkUnique("incoming webhook", incomingWebhooks, hook.DisplayName); err != nil {
+				return errors.Wrapf(err, "team %q", team.Name)
+			}
+			if hook.Channel == "" {
+				return errors.Errorf("incoming webhook %q: a channel is required", hook.DisplayName)
+			}
+		}
+
+		outgoingWebhooks := map[string]bool{}
+		for _, hook := range team.OutgoingWebhooks {
+			if err := checkUnique("outgoing webhook", outgoingWebhooks, hook.DisplayName); err != nil {
+				return errors.Wrapf(err, "team %q", team.Name)
+			}
+			if hook.TriggerWhen != "" && hook.TriggerWhen != "exact" && hook.TriggerWhen != "start" {
+				return errors.Errorf("outgoing webhook %q: trigger_when must be exact or start", hook.DisplayName)
+			}
+			if hook.Channel == "" && len(hook.TriggerWords) == 0 {
+				return errors.Errorf("outgoing webhook %q: a channel or trigger words are required", hook.DisplayName)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (t *workspaceTeam) teamType() string {
+	if t.Type != nil && *t.Type == "invite" {
+		return model.TeamInvite
+	}
+	return model.TeamOpen
+}
+
+func (c *workspaceChannel) channelType() model.ChannelType {
+	if c.Type != nil && *c.Type == "private" {
+		return model.ChannelTypePrivate
+	}
+	return model.ChannelTypeOpen
+}
+
+func (c *workspaceCommand) method() string {
+	if c.Method == "get" {
+		return model.CommandMethodGet
+	}
+	return model.CommandMethodPost
+}
+
+func (h *workspaceOutgoingWebhook) triggerWhen() int {
+	if h.TriggerWhen == "start" {
+		return 1
+	}
+	return 0
+}
+
+// valueOr returns the value of an optional field of the spec, or def when it is not set.
+func valueOr(value *string, def string) string {
+	if value == nil {
+		return def
+	}
+	return *value
+}
+
+// fieldChange describes the change of a field for the plan, if its value changes.
+func fieldChange(fields []string, name string, from, to any) []string {
+	if fmt.Sprint(from) == fmt.Sprint(to) {
+		return fields
+	}
+	return append(fields, fmt.Sprintf("%s: %q => %q", name, fmt.Sprint(from), fmt.Sprint(to)))
+}
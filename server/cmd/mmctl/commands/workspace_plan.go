@@ -0,0 +1,1144 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/client"
+)
+
+const (
+	workspaceActionCreate  = "create"
+	workspaceActionUpdate  = "update"
+	workspaceActionDelete  = "delete"
+	workspaceActionRestore = "restore"
+	workspaceActionDisable = "disable"
+	workspaceActionEnable  = "enable"
+
+	workspacePerPage = 200
+)
+
+// workspaceChange is a step of the plan that reconciles the server with a workspace spec.
+type workspaceChange struct {
+	Action string   `json:"action"`
+	Kind   string   `json:"kind"`
+	Name   string   `json:"name"`
+	Fields []string `json:"fields,omitempty"`
+
+	apply func(ctx context.Context) error
+}
+
+// Symbol is the prefix of the change in the printed plan.
+func (c *workspaceChange) Symbol() string {
+	switch c.Action {
+	case workspaceActionCreate, workspaceActionRestore, workspaceActionEnable:
+		return "+"
+	case workspaceActionDelete, workspaceActionDisable:
+		return "-"
+	default:
+		return "~"
+	}
+}
+
+// workspacePlanner computes the changes that make the server match a workspace spec.
+//
+// The changes are computed against the current state of the server but the objects they
+// refer to are looked up when they are applied, since earlier changes of the plan create
+// teams, channels, bots and schemes that later changes depend on.
+type workspacePlanner struct {
+	c     client.Client
+	prune bool
+
+	changes []*workspaceChange
+
+	schemes    map[string]*model.Scheme
+	bots       map[string]bool
+	userIDs    map[string]string
+	teamIDs    map[string]string
+	channelIDs map[string]string
+	categories map[string]*model.OrderedSidebarCategories
+}
+
+func newWorkspacePlanner(c client.Client, prune bool) *workspacePlanner {
+	return &workspacePlanner{
+		c:          c,
+		prune:      prune,
+		schemes:    map[string]*model.Scheme{},
+		bots:       map[string]bool{},
+		userIDs:    map[string]string{},
+		teamIDs:    map[string]string{},
+		channelIDs: map[string]string{},
+		categories: map[string]*model.OrderedSidebarCategories{},
+	}
+}
+
+func (p *workspacePlanner) add(action, kind, name string, fields []string, apply func(ctx context.Context) error) {
+	p.changes = append(p.changes, &workspaceChange{Action: action, Kind: kind, Name: name, Fields: fields, apply: apply})
+}
+
+func isNotFound(resp *model.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusNotFound
+}
+
+// fetchAllPages calls fetch with increasing page numbers until a page is not full.
+func fetchAllPages[T any](fetch func(page int) ([]T, error)) ([]T, error) {
+	var all []T
+	for page := 0; ; page++ {
+		items, err := fetch(page)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if len(items) < workspacePerPage {
+			return all, nil
+		}
+	}
+}
+
+func channelKey(teamName, channelName string) string {
+	return teamName + "/" + channelName
+}
+
+// plan computes the changes for the spec, in the order they must be applied.
+func (p *workspacePlanner) plan(ctx context.Context, spec *workspaceSpec) ([]*workspaceChange, error) {
+	if err := p.planSchemes(ctx, spec.Schemes); err != nil {
+		return nil, err
+	}
+	for _, role := range spec.Roles {
+		if err := p.planRole(ctx, role); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.planBots(ctx, spec.Bots); err != nil {
+		return nil, err
+	}
+	for _, team := range spec.Teams {
+		if err := p.planTeam(ctx, team); err != nil {
+			return nil, errors.Wrapf(err, "team %q", team.Name)
+		}
+	}
+	return p.changes, nil
+}
+
+func (p *workspacePlanner) planSchemes(ctx context.Context, specs []*workspaceScheme) error {
+	schemes, err := fetchAllPages(func(page int) ([]*model.Scheme, error) {
+		schemes, _, err := p.c.GetSchemes(ctx, "", page, workspacePerPage)
+		return schemes, err
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get the schemes")
+	}
+	for _, scheme := range schemes {
+		p.schemes[scheme.Name] = scheme
+	}
+
+	for _, spec := range specs {
+		scheme := p.schemes[spec.Name]
+		displayName := spec.DisplayName
+		if displayName == "" {
+			displayName = spec.Name
+		}
+
+		if scheme == nil {
+			p.add(workspaceActionCreate, "scheme", spec.Name, nil, func(ctx context.Context) error {
+				created, _, err := p.c.CreateScheme(ctx, &model.Scheme{
+					Name:        spec.Name,
+					DisplayName: displayName,
+					Description: spec.Description,
+					Scope:       spec.Scope,
+				})
+				if err != nil {
+					return err
+				}
+				p.schemes[spec.Name] = created
+				return nil
+			})
+		} else {
+			var fields []string
+			fields = fieldChange(fields, "display_name", scheme.DisplayName, displayName)
+			fields = fieldChange(fields, "description", scheme.Description, spec.Description)
+			if len(fields) > 0 {
+				p.add(workspaceActionUpdate, "scheme", spec.Name, fields, func(ctx context.Context) error {
+					_, _, err := p.c.PatchScheme(ctx, scheme.Id, &model.SchemePatch{DisplayName: &displayName, Description: &spec.Description})
+					return err
+				})
+			}
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(spec.Permissions)) {
+			roleName := func() string {
+				if scheme := p.schemes[spec.Name]; scheme != nil {
+					return workspaceSchemeRoles[key](scheme)
+				}
+				return ""
+			}
+			if err := p.planPermissions(ctx, "scheme role", spec.Name+"/"+key, roleName, spec.Permissions[key]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *workspacePlanner) planRole(ctx context.Context, spec *workspaceRole) error {
+	return p.planPermissions(ctx, "role", spec.Name, func() string { return spec.Name }, spec.Permissions)
+}
+
+// planPermissions plans setting the permissions of a role, whose name is only known once
+// the scheme it belongs to is created.
+func (p *workspacePlanner) planPermissions(ctx context.Context, kind, name string, roleName func() string, permissions []string) error {
+	var current []string
+	if roleName() != "" {
+		role, _, err := p.c.GetRoleByName(ctx, roleName())
+		if err != nil {
+			return errors.Wrapf(err, "failed to get role %q", roleName())
+		}
+		current = role.Permissions
+	}
+
+	var fields []string
+	for _, permission := range permissions {
+		if !slices.Contains(current, permission) {
+			fields = append(fields, "+ "+permission)
+		}
+	}
+	for _, permission := range current {
+		if !slices.Contains(permissions, permission) {
+			fields = append(fields, "- "+permission)
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	p.add(workspaceActionUpdate, kind, name, fields, func(ctx context.Context) error {
+		role, _, err := p.c.GetRoleByName(ctx, roleName())
+		if err != nil {
+			return err
+		}
+		_, _, err = p.c.PatchRole(ctx, role.Id, &model.RolePatch{Permissions: &permissions})
+		return err
+	})
+	return nil
+}
+
+func (p *workspacePlanner) planBots(ctx context.Context, specs []*workspaceBot) error {
+	bots, err := fetchAllPages(func(page int) ([]*model.Bot, error) {
+		bots, _, err := p.c.GetBotsIncludeDeleted(ctx, page, workspacePerPage, "")
+		return bots, err
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get the bots")
+	}
+	existing := map[string]*model.Bot{}
+	for _, bot := range bots {
+		existing[bot.Username] = bot
+	}
+
+	for _, spec := range specs {
+		p.bots[spec.Username] = true
+		bot := existing[spec.Username]
+		if bot == nil {
+			p.add(workspaceActionCreate, "bot", spec.Username, nil, func(ctx context.Context) error {
+				created, _, err := p.c.CreateBot(ctx, &model.Bot{Username: spec.Username, DisplayName: spec.DisplayName, Description: spec.Description})
+				if err != nil {
+					return err
+				}
+				p.userIDs[spec.Username] = created.UserId
+				return nil
+			})
+			continue
+		}
+
+		p.userIDs[spec.Username] = bot.UserId
+		if bot.DeleteAt != 0 {
+			p.add(workspaceActionEnable, "bot", spec.Username, nil, func(ctx context.Context) error {
+				_, _, err := p.c.EnableBot(ctx, bot.UserId)
+				return err
+			})
+		}
+
+		var fields []string
+		fields = fieldChange(fields, "display_name", bot.DisplayName, spec.DisplayName)
+		fields = fieldChange(fields, "description", bot.Description, spec.Description)
+		if len(fields) > 0 {
+			p.add(workspaceActionUpdate, "bot", spec.Username, fields, func(ctx context.Context) error {
+				_, _, err := p.c.PatchBot(ctx, bot.UserId, &model.BotPatch{DisplayName: &spec.DisplayName, Description: &spec.Description})
+				return err
+			})
+		}
+	}
+
+	if p.prune {
+		for _, bot := range bots {
+			// Bots owned by plugins are managed by their plugin.
+			if p.bots[bot.Username] || bot.DeleteAt != 0 || !model.IsValidId(bot.OwnerId) {
+				continue
+			}
+			p.add(workspaceActionDisable, "bot", bot.Username, nil, func(ctx context.Context) error {
+				_, _, err := p.c.DisableBot(ctx, bot.UserId)
+				return err
+			})
+		}
+	}
+
+	return nil
+}
+
+// userID returns the id of a user, or an empty string for a bot of the spec that is not
+// created yet.
+func (p *workspacePlanner) userID(ctx context.Context, username string) (string, error) {
+	if id, ok := p.userIDs[username]; ok || p.bots[username] {
+		return id, nil
+	}
+
+	user, _, err := p.c.GetUserByUsername(ctx, username, "")
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to find user %q", username)
+	}
+	p.userIDs[username] = user.Id
+	return user.Id, nil
+}
+
+func (p *workspacePlanner) schemeID(name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	scheme := p.schemes[name]
+	if scheme == nil {
+		return "", errors.Errorf("failed to find scheme %q", name)
+	}
+	return scheme.Id, nil
+}
+
+// checkScheme returns an error if a scheme is neither on the server nor in the spec.
+func (p *workspacePlanner) checkScheme(name *string, changes []*workspaceChange) error {
+	if name == nil || *name == "" || p.schemes[*name] != nil {
+		return nil
+	}
+	for _, change := range changes {
+		if change.Kind == "scheme" && change.Name == *name {
+			return nil
+		}
+	}
+	return errors.Errorf("scheme %q not found", *name)
+}
+
+func (p *workspacePlanner) planTeam(ctx context.Context, spec *workspaceTeam) error {
+	if err := p.checkScheme(spec.Scheme, p.changes); err != nil {
+		return err
+	}
+
+	team, resp, err := p.c.GetTeamByName(ctx, spec.Name, "")
+	if err != nil && !isNotFound(resp) {
+		return errors.Wrap(err, "failed to get the team")
+	}
+
+	if team == nil {
+		p.add(workspaceActionCreate, "team", spec.Name, nil, func(ctx context.Context) error {
+			created, _, err := p.c.CreateTeam(ctx, &model.Team{
+				Name:        spec.Name,
+				DisplayName: valueOr(spec.DisplayName, spec.Name),
+				Description: valueOr(spec.Description, ""),
+				Type:        spec.teamType(),
+			})
+			if err != nil {
+				return err
+			}
+			p.teamIDs[spec.Name] = created.Id
+			if spec.Scheme != nil && *spec.Scheme != "" {
+				schemeID, err := p.schemeID(*spec.Scheme)
+				if err != nil {
+					return err
+				}
+				_, err = p.c.UpdateTeamScheme(ctx, created.Id, schemeID)
+				return err
+			}
+			return nil
+		})
+	} else {
+		p.teamIDs[spec.Name] = team.Id
+		if err := p.planTeamUpdate(spec, team); err != nil {
+			return err
+		}
+	}
+
+	if err := p.planTeamMembers(ctx, spec, team); err != nil {
+		return err
+	}
+	if err := p.planChannels(ctx, spec, team); err != nil {
+		return err
+	}
+	if err := p.planCategories(ctx, spec, team); err != nil {
+		return err
+	}
+	if err := p.planCommands(ctx, spec, team); err != nil {
+		return err
+	}
+	if err := p.planIncomingWebhooks(ctx, spec, team); err != nil {
+		return err
+	}
+	return p.planOutgoingWebhooks(ctx, spec, team)
+}
+
+func (p *workspacePlanner) planTeamUpdate(spec *workspaceTeam, team *model.Team) error {
+	if team.DeleteAt != 0 {
+		p.add(workspaceActionRestore, "team", spec.Name, nil, func(ctx context.Context) error {
+			_, _, err := p.c.RestoreTeam(ctx, team.Id)
+			return err
+		})
+	}
+
+	patch := &model.TeamPatch{}
+	var fields []string
+	if spec.DisplayName != nil && *spec.DisplayName != team.DisplayName {
+		fields = fieldChange(fields, "display_name", team.DisplayName, *spec.DisplayName)
+		patch.DisplayName = spec.DisplayName
+	}
+	if spec.Description != nil && *spec.Description != team.Description {
+		fields = fieldChange(fields, "description", team.Description, *spec.Description)
+		patch.Description = spec.Description
+	}
+	updateType := spec.Type != nil && spec.teamType() != team.Type
+	if updateType {
+		fields = fieldChange(fields, "type", team.Type, spec.teamType())
+	}
+	updateScheme := spec.Scheme != nil && *spec.Scheme != p.schemeName(team.SchemeId)
+	if updateScheme {
+		fields = fieldChange(fields, "scheme", p.schemeName(team.SchemeId), *spec.Scheme)
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	p.add(workspaceActionUpdate, "team", spec.Name, fields, func(ctx context.Context) error {
+		if patch.DisplayName != nil || patch.Description != nil {
+			if _, _, err := p.c.PatchTeam(ctx, team.Id, patch); err != nil {
+				return err
+			}
+		}
+		if updateType {
+			if _, _, err := p.c.UpdateTeamPrivacy(ctx, team.Id, spec.teamType()); err != nil {
+				return err
+			}
+		}
+		if updateScheme {
+			schemeID, err := p.schemeID(*spec.Scheme)
+			if err != nil {
+				return err
+			}
+			if _, err := p.c.UpdateTeamScheme(ctx, team.Id, schemeID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return nil
+}
+
+// schemeName returns the name of the scheme with the given id, if any.
+func (p *workspacePlanner) schemeName(id *string) string {
+	if id == nil || *id == "" {
+		return ""
+	}
+	for _, scheme := range p.schemes {
+		if scheme.Id == *id {
+			return scheme.Name
+		}
+	}
+	return *id
+}
+
+// planMembers plans adding the missing members of a team or a channel and, when pruning,
+// removing the members that are not listed.
+func (p *workspacePlanner) planMembers(ctx context.Context, kind, name string, usernames []string, currentIDs []string, add, remove func(ctx context.Context, userID string) error) error {
+	var fields []string
+	var toAdd []string
+	wanted := map[string]bool{}
+	for _, username := range usernames {
+		userID, err := p.userID(ctx, username)
+		if err != nil {
+			return err
+		}
+		wanted[userID] = true
+		if userID == "" || !slices.Contains(currentIDs, userID) {
+			toAdd = append(toAdd, username)
+			fields = append(fields, "+ "+username)
+		}
+	}
+
+	var toRemove []string
+	if p.prune {
+		for _, userID := range currentIDs {
+			if !wanted[userID] {
+				toRemove = append(toRemove, userID)
+			}
+		}
+		if len(toRemove) > 0 {
+			users, _, err := p.c.GetUsersByIds(ctx, toRemove)
+			if err != nil {
+				return errors.Wrap(err, "failed to get the members to remove")
+			}
+			for _, user := range users {
+				fields = append(fields, "- "+user.Username)
+			}
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	p.add(workspaceActionUpdate, kind, name, fields, func(ctx context.Context) error {
+		var result *multierror.Error
+		for _, username := range toAdd {
+			if err := add(ctx, p.userIDs[username]); err != nil {
+				result = multierror.Append(result, fmt.Errorf("failed to add %q: %w", username, err))
+			}
+		}
+		for _, userID := range toRemove {
+			if err := remove(ctx, userID); err != nil {
+				result = multierror.Append(result, fmt.Errorf("failed to remove %q: %w", userID, err))
+			}
+		}
+		return result.ErrorOrNil()
+	})
+	return nil
+}
+
+func (p *workspacePlanner) planTeamMembers(ctx context.Context, spec *workspaceTeam, team *model.Team) error {
+	if spec.Members == nil {
+		return nil
+	}
+
+	var currentIDs []string
+	if team != nil {
+		members, err := fetchAllPages(func(page int) ([]*model.TeamMember, error) {
+			members, _, err := p.c.GetTeamMembers(ctx, team.Id, page, workspacePerPage, "")
+			return members, err
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to get the team members")
+		}
+		for _, member := range members {
+			if member.DeleteAt == 0 {
+				currentIDs = append(currentIDs, member.UserId)
+			}
+		}
+	}
+
+	return p.planMembers(ctx, "team members", spec.Name, spec.Members, currentIDs,
+		func(ctx context.Context, userID string) error {
+			_, _, err := p.c.AddTeamMember(ctx, p.teamIDs[spec.Name], userID)
+			return err
+		},
+		func(ctx context.Context, userID string) error {
+			_, err := p.c.RemoveTeamMember(ctx, p.teamIDs[spec.Name], userID)
+			return err
+		},
+	)
+}
+
+func (p *workspacePlanner) planChannels(ctx context.Context, teamSpec *workspaceTeam, team *model.Team) error {
+	for _, spec := range teamSpec.Channels {
+		if err := p.checkScheme(spec.Scheme, p.changes); err != nil {
+			return errors.Wrapf(err, "channel %q", spec.Name)
+		}
+
+		var channel *model.Channel
+		if team != nil {
+			var resp *model.Response
+			var err error
+			channel, resp, err = p.c.GetChannelByNameIncludeDeleted(ctx, spec.Name, team.Id, "")
+			if err != nil && !isNotFound(resp) {
+				return errors.Wrapf(err, "failed to get channel %q", spec.Name)
+			}
+		}
+
+		key := channelKey(teamSpec.Name, spec.Name)
+		if channel == nil {
+			p.add(workspaceActionCreate, "channel", key, nil, func(ctx context.Context) error {
+				created, _, err := p.c.CreateChannel(ctx, &model.Channel{
+					TeamId:      p.teamIDs[teamSpec.Name],
+					Name:        spec.Name,
+					DisplayName: valueOr(spec.DisplayName, spec.Name),
+					Type:        spec.channelType(),
+					Header:      valueOr(spec.Header, ""),
+					Purpose:     valueOr(spec.Purpose, ""),
+				})
+				if err != nil {
+					return err
+				}
+				p.channelIDs[key] = created.Id
+				if spec.Scheme != nil && *spec.Scheme != "" {
+					schemeID, err := p.schemeID(*spec.Scheme)
+					if err != nil {
+						return err
+					}
+					_, err = p.c.UpdateChannelScheme(ctx, created.Id, schemeID)
+					return err
+				}
+				return nil
+			})
+		} else {
+			p.channelIDs[key] = channel.Id
+			p.planChannelUpdate(key, spec, channel)
+		}
+
+		if err := p.planChannelMembers(ctx, key, spec, channel); err != nil {
+			return err
+		}
+	}
+
+	if !p.prune || team == nil {
+		return nil
+	}
+
+	var channels []*model.Channel
+	for _, fetch := range []func(ctx context.Context, teamID string, page, perPage int, etag string) ([]*model.Channel, *model.Response, error){
+		p.c.GetPublicChannelsForTeam,
+		p.c.GetPrivateChannelsForTeam,
+	} {
+		page, err := fetchAllPages(func(page int) ([]*model.Channel, error) {
+			channels, _, err := fetch(ctx, team.Id, page, workspacePerPage, "")
+			return channels, err
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to get the channels")
+		}
+		channels = append(channels, page...)
+	}
+
+	for _, channel := range channels {
+		key := channelKey(teamSpec.Name, channel.Name)
+		if _, ok := p.channelIDs[key]; ok || channel.Name == model.DefaultChannelName || channel.DeleteAt != 0 {
+			continue
+		}
+		p.add(workspaceActionDelete, "channel", key, nil, func(ctx context.Context) error {
+			_, err := p.c.DeleteChannel(ctx, channel.Id)
+			return err
+		})
+	}
+
+	return nil
+}
+
+func (p *workspacePlanner) planChannelUpdate(key string, spec *workspaceChannel, channel *model.Channel) {
+	if channel.DeleteAt != 0 {
+		p.add(workspaceActionRestore, "channel", key, nil, func(ctx context.Context) error {
+			_, _, err := p.c.RestoreChannel(ctx, channel.Id)
+			return err
+		})
+	}
+
+	patch := &model.ChannelPatch{}
+	var fields []string
+	if spec.DisplayName != nil && *spec.DisplayName != channel.DisplayName {
+		fields = fieldChange(fields, "display_name", channel.DisplayName, *spec.DisplayName)
+		patch.DisplayName = spec.DisplayName
+	}
+	if spec.Header != nil && *spec.Header != channel.Header {
+		fields = fieldChange(fields, "header", channel.Header, *spec.Header)
+		patch.Header = spec.Header
+	}
+	if spec.Purpose != nil && *spec.Purpose != channel.Purpose {
+		fields = fieldChange(fields, "purpose", channel.Purpose, *spec.Purpose)
+		patch.Purpose = spec.Purpose
+	}
+	updateType := spec.Type != nil && spec.channelType() != channel.Type
+	if updateType {
+		fields = fieldChange(fields, "type", channel.Type, spec.channelType())
+	}
+	updateScheme := spec.Scheme != nil && *spec.Scheme != p.schemeName(channel.SchemeId)
+	if updateScheme {
+		fields = fieldChange(fields, "scheme", p.schemeName(channel.SchemeId), *spec.Scheme)
+	}
+	if len(fields) == 0 {
+		return
+	}
+
+	p.add(workspaceActionUpdate, "channel", key, fields, func(ctx context.Context) error {
+		if patch.DisplayName != nil || patch.Header != nil || patch.Purpose != nil {
+			if _, _, err := p.c.PatchChannel(ctx, channel.Id, patch); err != nil {
+				return err
+			}
+		}
+		if updateType {
+			if _, _, err := p.c.UpdateChannelPrivacy(ctx, channel.Id, spec.channelType()); err != nil {
+				return err
+			}
+		}
+		if updateScheme {
+			schemeID, err := p.schemeID(*spec.Scheme)
+			if err != nil {
+				return err
+			}
+			if _, err := p.c.UpdateChannelScheme(ctx, channel.Id, schemeID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (p *workspacePlanner) planChannelMembers(ctx context.Context, key string, spec *workspaceChannel, channel *model.Channel) error {
+	if spec.Members == nil {
+		return nil
+	}
+
+	var currentIDs []string
+	if channel != nil {
+		members, err := fetchAllPages(func(page int) ([]model.ChannelMember, error) {
+			members, _, err := p.c.GetChannelMembers(ctx, channel.Id, page, workspacePerPage, "")
+			return members, err
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get the members of channel %q", spec.Name)
+		}
+		for _, member := range members {
+			currentIDs = append(currentIDs, member.UserId)
+		}
+	}
+
+	return p.planMembers(ctx, "channel members", key, spec.Members, currentIDs,
+		func(ctx context.Context, userID string) error {
+			_, _, err := p.c.AddChannelMember(ctx, p.channelIDs[key], userID)
+			return err
+		},
+		func(ctx context.Context, userID string) error {
+			_, err := p.c.RemoveUserFromChannel(ctx, p.channelIDs[key], userID)
+			return err
+		},
+	)
+}
+
+// sidebarCategories returns the sidebar categories of a user in a team, fetching them once.
+func (p *workspacePlanner) sidebarCategories(ctx context.Context, userID, teamID string) (*model.OrderedSidebarCategories, error) {
+	key := userID + "/" + teamID
+	if categories, ok := p.categories[key]; ok {
+		return categories, nil
+	}
+	categories, _, err := p.c.GetSidebarCategoriesForTeamForUser(ctx, userID, teamID, "")
+	if err != nil {
+		return nil, err
+	}
+	p.categories[key] = categories
+	return categories, nil
+}
+
+func findCustomCategory(categories *model.OrderedSidebarCategories, displayName string) *model.SidebarCategoryWithChannels {
+	if categories == nil {
+		return nil
+	}
+	for _, category := range categories.Categories {
+		if category.Type == model.SidebarCategoryCustom && category.DisplayName == displayName {
+			return category
+		}
+	}
+	return nil
+}
+
+// planCategories plans placing the channels of a team in the sidebar categories of their
+// members.
+func (p *workspacePlanner) planCategories(ctx context.Context, teamSpec *workspaceTeam, team *model.Team) error {
+	type placement struct {
+		username string
+		channels []string
+	}
+	var order []string
+	placements := map[string][]*placement{}
+
+	for _, spec := range teamSpec.Channels {
+		if spec.Category == nil {
+			continue
+		}
+		key := channelKey(teamSpec.Name, spec.Name)
+
+		for _, username := range spec.Members {
+			userID := p.userIDs[username]
+			channelID := p.channelIDs[key]
+			if team != nil && userID != "" && channelID != "" {
+				categories, err := p.sidebarCategories(ctx, userID, team.Id)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get the sidebar categories of %q", username)
+				}
+				if category := findCustomCategory(categories, *spec.Category); category != nil && slices.Contains(category.Channels, channelID) {
+					continue
+				}
+			}
+
+			if _, ok := placements[*spec.Category]; !ok {
+				order = append(order, *spec.Category)
+			}
+			i := slices.IndexFunc(placements[*spec.Category], func(pl *placement) bool { return pl.username == username })
+			if i == -1 {
+				placements[*spec.Category] = append(placements[*spec.Category], &placement{username: username})
+				i = len(placements[*spec.Category]) - 1
+			}
+			placements[*spec.Category][i].channels = append(placements[*spec.Category][i].channels, key)
+		}
+	}
+
+	for _, displayName := range order {
+		var fields []string
+		for _, pl := range placements[displayName] {
+			for _, key := range pl.channels {
+				fields = append(fields, fmt.Sprintf("+ %s: %s", pl.username, key))
+			}
+		}
+
+		p.add(workspaceActionUpdate, "sidebar category", teamSpec.Name+"/"+displayName, fields, func(ctx context.Context) error {
+			teamID := p.teamIDs[teamSpec.Name]
+			var result *multierror.Error
+			for _, pl := range placements[displayName] {
+				var channelIDs []string
+				for _, key := range pl.channels {
+					channelIDs = append(channelIDs, p.channelIDs[key])
+				}
+				if err := p.placeInCategory(ctx, p.userIDs[pl.username], teamID, displayName, channelIDs); err != nil {
+					result = multierror.Append(result, fmt.Errorf("failed to update the sidebar of %q: %w", pl.username, err))
+				}
+			}
+			return result.ErrorOrNil()
+		})
+	}
+
+	return nil
+}
+
+func (p *workspacePlanner) placeInCategory(ctx context.Context, userID, teamID, displayName string, channelIDs []string) error {
+	categories, _, err := p.c.GetSidebarCategoriesForTeamForUser(ctx, userID, teamID, "")
+	if err != nil {
+		return err
+	}
+
+	category := findCustomCategory(categories, displayName)
+	if category == nil {
+		_, _, err = p.c.CreateSidebarCategoryForTeamForUser(ctx, userID, teamID, &model.SidebarCategoryWithChannels{
+			SidebarCategory: model.SidebarCategory{
+				UserId:      userID,
+				TeamId:      teamID,
+				DisplayName: displayName,
+				Type:        model.SidebarCategoryCustom,
+			},
+			Channels: channelIDs,
+		})
+		return err
+	}
+
+	for _, channelID := range channelIDs {
+		if !slices.Contains(category.Channels, channelID) {
+			category.Channels = append(category.Channels, channelID)
+		}
+	}
+	_, _, err = p.c.UpdateSidebarCategoryForTeamForUser(ctx, userID, teamID, category.Id, category)
+	return err
+}
+
+func (p *workspacePlanner) planCommands(ctx context.Context, teamSpec *workspaceTeam, team *model.Team) error {
+	existing := map[string]*model.Command{}
+	var commands []*model.Command
+	if team != nil {
+		var err error
+		commands, _, err = p.c.ListCommands(ctx, team.Id, true)
+		if err != nil {
+			return errors.Wrap(err, "failed to get the commands")
+		}
+		for _, command := range commands {
+			existing[command.Trigger] = command
+		}
+	}
+
+	for _, spec := range teamSpec.Commands {
+		name := teamSpec.Name + "/" + spec.Trigger
+		command := existing[spec.Trigger]
+		if command == nil {
+			p.add(workspaceActionCreate, "command", name, nil, func(ctx context.Context) error {
+				command := &model.Command{TeamId: p.teamIDs[teamSpec.Name]}
+				spec.applyTo(command)
+				_, _, err := p.c.CreateCommand(ctx, command)
+				return err
+			})
+			continue
+		}
+
+		updated := *command
+		spec.applyTo(&updated)
+		var fields []string
+		fields = fieldChange(fields, "display_name", command.DisplayName, updated.DisplayName)
+		fields = fieldChange(fields, "description", command.Description, updated.Description)
+		fields = fieldChange(fields, "url", command.URL, updated.URL)
+		fields = fieldChange(fields, "method", command.Method, updated.Method)
+		fields = fieldChange(fields, "username", command.Username, updated.Username)
+		fields = fieldChange(fields, "icon_url", command.IconURL, updated.IconURL)
+		fields = fieldChange(fields, "auto_complete", command.AutoComplete, updated.AutoComplete)
+		fields = fieldChange(fields, "auto_complete_desc", command.AutoCompleteDesc, updated.AutoCompleteDesc)
+		fields = fieldChange(fields, "auto_complete_hint", command.AutoCompleteHint, updated.AutoCompleteHint)
+		if len(fields) > 0 {
+			p.add(workspaceActionUpdate, "command", name, fields, func(ctx context.Context) error {
+				_, _, err := p.c.UpdateCommand(ctx, &updated)
+				return err
+			})
+		}
+	}
+
+	if p.prune {
+		for _, command := range commands {
+			if slices.ContainsFunc(teamSpec.Commands, func(spec *workspaceCommand) bool { return spec.Trigger == command.Trigger }) {
+				continue
+			}
+			p.add(workspaceActionDelete, "command", teamSpec.Name+"/"+command.Trigger, nil, func(ctx context.Context) error {
+				_, err := p.c.DeleteCommand(ctx, command.Id)
+				return err
+			})
+		}
+	}
+
+	return nil
+}
+
+func (c *workspaceCommand) applyTo(command *model.Command) {
+	command.Trigger = c.Trigger
+	command.DisplayName = c.DisplayName
+	command.Description = c.Description
+	command.URL = c.URL
+	command.Method = c.method()
+	command.Username = c.Username
+	command.IconURL = c.IconURL
+	command.AutoComplete = c.AutoComplete
+	command.AutoCompleteDesc = c.AutoCompleteDesc
+	command.AutoCompleteHint = c.AutoCompleteHint
+}
+
+// channelID returns the id of a channel of a team, looking up the channels that are not in
+// the spec.
+func (p *workspacePlanner) channelID(ctx context.Context, teamName, channelName string) (string, error) {
+	key := channelKey(teamName, channelName)
+	if id, ok := p.channelIDs[key]; ok {
+		return id, nil
+	}
+	teamID := p.teamIDs[teamName]
+	if teamID == "" {
+		return "", errors.Errorf("channel %q not found", channelName)
+	}
+
+	channel, _, err := p.c.GetChannelByName(ctx, channelName, teamID, "")
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to find channel %q", channelName)
+	}
+	p.channelIDs[key] = channel.Id
+	return channel.Id, nil
+}
+
+// isPlannedChannel returns whether a channel is created by the plan.
+func (p *workspacePlanner) isPlannedChannel(teamName, channelName string) bool {
+	return slices.ContainsFunc(p.changes, func(change *workspaceChange) bool {
+		return change.Kind == "channel" && change.Action == workspaceActionCreate && change.Name == channelKey(teamName, channelName)
+	})
+}
+
+// plannedChannelID returns the id of the channel of a webhook when planning, which is empty
+// for channels created by the plan.
+func (p *workspacePlanner) plannedChannelID(ctx context.Context, teamName, channelName string) (string, error) {
+	if channelName == "" || p.isPlannedChannel(teamName, channelName) {
+		return "", nil
+	}
+	return p.channelID(ctx, teamName, channelName)
+}
+
+func (p *workspacePlanner) planIncomingWebhooks(ctx context.Context, teamSpec *workspaceTeam, team *model.Team) error {
+	existing := map[string]*model.IncomingWebhook{}
+	var hooks []*model.IncomingWebhook
+	if team != nil {
+		var err error
+		hooks, err = fetchAllPages(func(page int) ([]*model.IncomingWebhook, error) {
+			hooks, _, err := p.c.GetIncomingWebhooksForTeam(ctx, team.Id, page, workspacePerPage, "")
+			return hooks, err
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to get the incoming webhooks")
+		}
+		for _, hook := range hooks {
+			if _, ok := existing[hook.DisplayName]; !ok {
+				existing[hook.DisplayName] = hook
+			}
+		}
+	}
+
+	for _, spec := range teamSpec.IncomingWebhooks {
+		name := teamSpec.Name + "/" + spec.DisplayName
+		hook := existing[spec.DisplayName]
+		if hook == nil {
+			p.add(workspaceActionCreate, "incoming webhook", name, nil, func(ctx context.Context) error {
+				channelID, err := p.channelID(ctx, teamSpec.Name, spec.Channel)
+				if err != nil {
+					return err
+				}
+				hook := &model.IncomingWebhook{ChannelId: channelID}
+				spec.applyTo(hook)
+				_, _, err = p.c.CreateIncomingWebhook(ctx, hook)
+				return err
+			})
+			continue
+		}
+
+		channelID, err := p.plannedChannelID(ctx, teamSpec.Name, spec.Channel)
+		if err != nil {
+			return errors.Wrapf(err, "incoming webhook %q", spec.DisplayName)
+		}
+		updated := *hook
+		spec.applyTo(&updated)
+		var fields []string
+		if channelID != hook.ChannelId {
+			fields = append(fields, fmt.Sprintf("channel: => %q", spec.Channel))
+		}
+		fields = fieldChange(fields, "description", hook.Description, updated.Description)
+		fields = fieldChange(fields, "username", hook.Username, updated.Username)
+		fields = fieldChange(fields, "icon_url", hook.IconURL, updated.IconURL)
+		fields = fieldChange(fields, "channel_locked", hook.ChannelLocked, updated.ChannelLocked)
+		fields = fieldChange(fields, "adapter", hook.Adapter, updated.Adapter)
+		if len(fields) > 0 {
+			p.add(workspaceActionUpdate, "incoming webhook", name, fields, func(ctx context.Context) error {
+				channelID, err := p.channelID(ctx, teamSpec.Name, spec.Channel)
+				if err != nil {
+					return err
+				}
+				updated.ChannelId = channelID
+				_, _, err = p.c.UpdateIncomingWebhook(ctx, &updated)
+				return err
+			})
+		}
+	}
+
+	if p.prune {
+		for _, hook := range hooks {
+			if slices.ContainsFunc(teamSpec.IncomingWebhooks, func(spec *workspaceIncomingWebhook) bool { return existing[spec.DisplayName] == hook }) {
+				continue
+			}
+			p.add(workspaceActionDelete, "incoming webhook", teamSpec.Name+"/"+hook.DisplayName, nil, func(ctx context.Context) error {
+				_, err := p.c.DeleteIncomingWebhook(ctx, hook.Id)
+				return err
+			})
+		}
+	}
+
+	return nil
+}
+
+func (h *workspaceIncomingWebhook) applyTo(hook *model.IncomingWebhook) {
+	hook.DisplayName = h.DisplayName
+	hook.Description = h.Description
+	hook.Username = h.Username
+	hook.IconURL = h.IconURL
+	hook.ChannelLocked = h.ChannelLocked
+	hook.Adapter = h.Adapter
+}
+
+func (p *workspacePlanner) planOutgoingWebhooks(ctx context.Context, teamSpec *workspaceTeam, team *model.Team) error {
+	existing := map[string]*model.OutgoingWebhook{}
+	var hooks []*model.OutgoingWebhook
+	if team != nil {
+		var err error
+		hooks, err = fetchAllPages(func(page int) ([]*model.OutgoingWebhook, error) {
+			hooks, _, err := p.c.GetOutgoingWebhooksForTeam(ctx, team.Id, page, workspacePerPage, "")
+			return hooks, err
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to get the outgoing webhooks")
+		}
+		for _, hook := range hooks {
+			if _, ok := existing[hook.DisplayName]; !ok {
+				existing[hook.DisplayName] = hook
+			}
+		}
+	}
+
+	for _, spec := range teamSpec.OutgoingWebhooks {
+		name := teamSpec.Name + "/" + spec.DisplayName
+		hook := existing[spec.DisplayName]
+		if hook == nil {
+			p.add(workspaceActionCreate, "outgoing webhook", name, nil, func(ctx context.Context) error {
+				hook := &model.OutgoingWebhook{TeamId: p.teamIDs[teamSpec.Name]}
+				if spec.Channel != "" {
+					channelID, err := p.channelID(ctx, teamSpec.Name, spec.Channel)
+					if err != nil {
+						return err
+					}
+					hook.ChannelId = channelID
+				}
+				spec.applyTo(hook)
+				_, _, err := p.c.CreateOutgoingWebhook(ctx, hook)
+				return err
+			})
+			continue
+		}
+
+		channelID, err := p.plannedChannelID(ctx, teamSpec.Name, spec.Channel)
+		if err != nil {
+			return errors.Wrapf(err, "outgoing webhook %q", spec.DisplayName)
+		}
+		updated := *hook
+		spec.applyTo(&updated)
+		var fields []string
+		if channelID != hook.ChannelId || (spec.Channel != "" && channelID == "") {
+			fields = append(fields, fmt.Sprintf("channel: => %q", spec.Channel))
+		}
+		fields = fieldChange(fields, "description", hook.Description, updated.Description)
+		fields = fieldChange(fields, "trigger_words", hook.TriggerWords, updated.TriggerWords)
+		fields = fieldChange(fields, "trigger_when", hook.TriggerWhen, updated.TriggerWhen)
+		fields = fieldChange(fields, "callback_urls", hook.CallbackURLs, updated.CallbackURLs)
+		fields = fieldChange(fields, "content_type", hook.ContentType, updated.ContentType)
+		fields = fieldChange(fields, "username", hook.Username, updated.Username)
+		fields = fieldChange(fields, "icon_url", hook.IconURL, updated.IconURL)
+		if len(fields) > 0 {
+			p.add(workspaceActionUpdate, "outgoing webhook", name, fields, func(ctx context.Context) error {
+				updated.ChannelId = ""
+				if spec.Channel != "" {
+					channelID, err := p.channelID(ctx, teamSpec.Name, spec.Channel)
+					if err != nil {
+						return err
+					}
+					updated.ChannelId = channelID
+				}
+				_, _, err := p.c.UpdateOutgoingWebhook(ctx, &updated)
+				return err
+			})
+		}
+	}
+
+	if p.prune {
+		for _, hook := range hooks {
+			if slices.ContainsFunc(teamSpec.OutgoingWebhooks, func(spec *workspaceOutgoingWebhook) bool { return existing[spec.DisplayName] == hook }) {
+				continue
+			}
+			p.add(workspaceActionDelete, "outgoing webhook", teamSpec.Name+"/"+hook.DisplayName, nil, func(ctx context.Context) error {
+				_, err := p.c.DeleteOutgoingWebhook(ctx, hook.Id)
+				return err
+			})
+		}
+	}
+
+	return nil
+}
+
+func (h *workspaceOutgoingWebhook) applyTo(hook *model.OutgoingWebhook) {
+	hook.DisplayName = h.DisplayName
+	hook.Description = h.Description
+	hook.TriggerWords = h.TriggerWords
+	hook.TriggerWhen = h.triggerWhen()
+	hook.CallbackURLs = h.CallbackURLs
+	hook.ContentType = h.ContentType
+	hook.Username = h.Username
+	hook.IconURL = h.IconURL
+}
@@ -231,6 +231,22 @@ func (mr *MockClientMockRecorder) CreateCommand(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCommand", reflect.TypeOf((*MockClient)(nil).CreateCommand), arg0, arg1)
 }
 
+// CreateEventSubscription mocks base method.
+func (m *MockClient) CreateEventSubscription(arg0 context.Context, arg1 *model.EventSubscription) (*model.EventSubscription, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEventSubscription", arg0, arg1)
+	ret0, _ := ret[0].(*model.EventSubscription)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateEventSubscription indicates an expected call of CreateEventSubscription.
+func (mr *MockClientMockRecorder) CreateEventSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventSubscription", reflect.TypeOf((*MockClient)(nil).CreateEventSubscription), arg0, arg1)
+}
+
 // CreateIncomingWebhook mocks base method.
 func (m *MockClient) CreateIncomingWebhook(arg0 context.Context, arg1 *model.IncomingWebhook) (*model.IncomingWebhook, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -295,6 +311,38 @@ func (mr *MockClientMockRecorder) CreatePost(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePost", reflect.TypeOf((*MockClient)(nil).CreatePost), arg0, arg1)
 }
 
+// CreateScheme mocks base method.
+func (m *MockClient) CreateScheme(arg0 context.Context, arg1 *model.Scheme) (*model.Scheme, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheme", arg0, arg1)
+	ret0, _ := ret[0].(*model.Scheme)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateScheme indicates an expected call of CreateScheme.
+func (mr *MockClientMockRecorder) CreateScheme(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheme", reflect.TypeOf((*MockClient)(nil).CreateScheme), arg0, arg1)
+}
+
+// CreateSidebarCategoryForTeamForUser mocks base method.
+func (m *MockClient) CreateSidebarCategoryForTeamForUser(arg0 context.Context, arg1, arg2 string, arg3 *model.SidebarCategoryWithChannels) (*model.SidebarCategoryWithChannels, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSidebarCategoryForTeamForUser", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.SidebarCategoryWithChannels)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateSidebarCategoryForTeamForUser indicates an expected call of CreateSidebarCategoryForTeamForUser.
+func (mr *MockClientMockRecorder) CreateSidebarCategoryForTeamForUser(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSidebarCategoryForTeamForUser", reflect.TypeOf((*MockClient)(nil).CreateSidebarCategoryForTeamForUser), arg0, arg1, arg2, arg3)
+}
+
 // CreateTeam mocks base method.
 func (m *MockClient) CreateTeam(arg0 context.Context, arg1 *model.Team) (*model.Team, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -359,6 +407,22 @@ func (mr *MockClientMockRecorder) CreateUserAccessToken(arg0, arg1, arg2 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserAccessToken", reflect.TypeOf((*MockClient)(nil).CreateUserAccessToken), arg0, arg1, arg2)
 }
 
+// CreateUserAccessTokenWithOptions mocks base method.
+func (m *MockClient) CreateUserAccessTokenWithOptions(arg0 context.Context, arg1 string, arg2 *model.UserAccessToken) (*model.UserAccessToken, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserAccessTokenWithOptions", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.UserAccessToken)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateUserAccessTokenWithOptions indicates an expected call of CreateUserAccessTokenWithOptions.
+func (mr *MockClientMockRecorder) CreateUserAccessTokenWithOptions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserAccessTokenWithOptions", reflect.TypeOf((*MockClient)(nil).CreateUserAccessTokenWithOptions), arg0, arg1, arg2)
+}
+
 // DeleteCPAField mocks base method.
 func (m *MockClient) DeleteCPAField(arg0 context.Context, arg1 string) (*model.Response, error) {
 	m.ctrl.T.Helper()
@@ -404,6 +468,37 @@ func (mr *MockClientMockRecorder) DeleteCommand(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCommand", reflect.TypeOf((*MockClient)(nil).DeleteCommand), arg0, arg1)
 }
 
+// DeleteEmailTemplate mocks base method.
+func (m *MockClient) DeleteEmailTemplate(arg0 context.Context, arg1, arg2 string) (*model.EmailTemplate, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmailTemplate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.EmailTemplate)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DeleteEmailTemplate indicates an expected call of DeleteEmailTemplate.
+func (mr *MockClientMockRecorder) DeleteEmailTemplate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmailTemplate", reflect.TypeOf((*MockClient)(nil).DeleteEmailTemplate), arg0, arg1, arg2)
+}
+
+// DeleteEventSubscription mocks base method.
+func (m *MockClient) DeleteEventSubscription(arg0 context.Context, arg1 string) (*model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventSubscription", arg0, arg1)
+	ret0, _ := ret[0].(*model.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventSubscription indicates an expected call of DeleteEventSubscription.
+func (mr *MockClientMockRecorder) DeleteEventSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventSubscription", reflect.TypeOf((*MockClient)(nil).DeleteEventSubscription), arg0, arg1)
+}
+
 // DeleteExport mocks base method.
 func (m *MockClient) DeleteExport(arg0 context.Context, arg1 string) (*model.Response, error) {
 	m.ctrl.T.Helper()
@@ -586,6 +681,22 @@ func (mr *MockClientMockRecorder) DownloadExport(arg0, arg1, arg2, arg3 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadExport", reflect.TypeOf((*MockClient)(nil).DownloadExport), arg0, arg1, arg2, arg3)
 }
 
+// DownloadImportErrorReport mocks base method.
+func (m *MockClient) DownloadImportErrorReport(arg0 context.Context, arg1 string, arg2 io.Writer) (int64, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadImportErrorReport", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DownloadImportErrorReport indicates an expected call of DownloadImportErrorReport.
+func (mr *MockClientMockRecorder) DownloadImportErrorReport(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadImportErrorReport", reflect.TypeOf((*MockClient)(nil).DownloadImportErrorReport), arg0, arg1, arg2)
+}
+
 // EnableBot mocks base method.
 func (m *MockClient) EnableBot(arg0 context.Context, arg1 string) (*model.Bot, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -842,6 +953,38 @@ func (mr *MockClientMockRecorder) GetConfig(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfig", reflect.TypeOf((*MockClient)(nil).GetConfig), arg0)
 }
 
+// GetConfigRevisionDiff mocks base method.
+func (m *MockClient) GetConfigRevisionDiff(arg0 context.Context, arg1, arg2 string) ([]*model.ConfigChange, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfigRevisionDiff", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model.ConfigChange)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetConfigRevisionDiff indicates an expected call of GetConfigRevisionDiff.
+func (mr *MockClientMockRecorder) GetConfigRevisionDiff(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigRevisionDiff", reflect.TypeOf((*MockClient)(nil).GetConfigRevisionDiff), arg0, arg1, arg2)
+}
+
+// GetConfigRevisions mocks base method.
+func (m *MockClient) GetConfigRevisions(arg0 context.Context, arg1, arg2 int) ([]*model.ConfigRevision, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfigRevisions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model.ConfigRevision)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetConfigRevisions indicates an expected call of GetConfigRevisions.
+func (mr *MockClientMockRecorder) GetConfigRevisions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigRevisions", reflect.TypeOf((*MockClient)(nil).GetConfigRevisions), arg0, arg1, arg2)
+}
+
 // GetConfigWithOptions mocks base method.
 func (m *MockClient) GetConfigWithOptions(arg0 context.Context, arg1 model.GetConfigOptions) (map[string]interface{}, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -874,6 +1017,70 @@ func (mr *MockClientMockRecorder) GetDeletedChannelsForTeam(arg0, arg1, arg2, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedChannelsForTeam", reflect.TypeOf((*MockClient)(nil).GetDeletedChannelsForTeam), arg0, arg1, arg2, arg3, arg4)
 }
 
+// GetEmailTemplate mocks base method.
+func (m *MockClient) GetEmailTemplate(arg0 context.Context, arg1, arg2 string) (*model.EmailTemplate, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmailTemplate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.EmailTemplate)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEmailTemplate indicates an expected call of GetEmailTemplate.
+func (mr *MockClientMockRecorder) GetEmailTemplate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailTemplate", reflect.TypeOf((*MockClient)(nil).GetEmailTemplate), arg0, arg1, arg2)
+}
+
+// GetEmailTemplates mocks base method.
+func (m *MockClient) GetEmailTemplates(arg0 context.Context, arg1 string) ([]*model.EmailTemplate, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmailTemplates", arg0, arg1)
+	ret0, _ := ret[0].([]*model.EmailTemplate)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEmailTemplates indicates an expected call of GetEmailTemplates.
+func (mr *MockClientMockRecorder) GetEmailTemplates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailTemplates", reflect.TypeOf((*MockClient)(nil).GetEmailTemplates), arg0, arg1)
+}
+
+// GetEventSubscription mocks base method.
+func (m *MockClient) GetEventSubscription(arg0 context.Context, arg1 string) (*model.EventSubscription, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventSubscription", arg0, arg1)
+	ret0, _ := ret[0].(*model.EventSubscription)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEventSubscription indicates an expected call of GetEventSubscription.
+func (mr *MockClientMockRecorder) GetEventSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventSubscription", reflect.TypeOf((*MockClient)(nil).GetEventSubscription), arg0, arg1)
+}
+
+// GetEventSubscriptions mocks base method.
+func (m *MockClient) GetEventSubscriptions(arg0 context.Context, arg1 int, arg2 int) ([]*model.EventSubscription, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventSubscriptions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model.EventSubscription)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEventSubscriptions indicates an expected call of GetEventSubscriptions.
+func (mr *MockClientMockRecorder) GetEventSubscriptions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventSubscriptions", reflect.TypeOf((*MockClient)(nil).GetEventSubscriptions), arg0, arg1, arg2)
+}
+
 // GetGroupsByChannel mocks base method.
 func (m *MockClient) GetGroupsByChannel(arg0 context.Context, arg1 string, arg2 model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, int, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -1084,6 +1291,22 @@ func (mr *MockClientMockRecorder) GetOutgoingWebhook(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutgoingWebhook", reflect.TypeOf((*MockClient)(nil).GetOutgoingWebhook), arg0, arg1)
 }
 
+// GetOutgoingWebhookDeliveries mocks base method.
+func (m *MockClient) GetOutgoingWebhookDeliveries(arg0 context.Context, arg1 string, arg2 string, arg3 int, arg4 int) ([]*model.OutgoingWebhookDelivery, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutgoingWebhookDeliveries", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*model.OutgoingWebhookDelivery)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOutgoingWebhookDeliveries indicates an expected call of GetOutgoingWebhookDeliveries.
+func (mr *MockClientMockRecorder) GetOutgoingWebhookDeliveries(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutgoingWebhookDeliveries", reflect.TypeOf((*MockClient)(nil).GetOutgoingWebhookDeliveries), arg0, arg1, arg2, arg3, arg4)
+}
+
 // GetOutgoingWebhooks mocks base method.
 func (m *MockClient) GetOutgoingWebhooks(arg0 context.Context, arg1, arg2 int, arg3 string) ([]*model.OutgoingWebhook, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -1132,6 +1355,22 @@ func (mr *MockClientMockRecorder) GetOutgoingWebhooksForTeam(arg0, arg1, arg2, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutgoingWebhooksForTeam", reflect.TypeOf((*MockClient)(nil).GetOutgoingWebhooksForTeam), arg0, arg1, arg2, arg3, arg4)
 }
 
+// GetPasswordHashReport mocks base method.
+func (m *MockClient) GetPasswordHashReport(arg0 context.Context) ([]*model.PasswordHashReport, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPasswordHashReport", arg0)
+	ret0, _ := ret[0].([]*model.PasswordHashReport)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPasswordHashReport indicates an expected call of GetPasswordHashReport.
+func (mr *MockClientMockRecorder) GetPasswordHashReport(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPasswordHashReport", reflect.TypeOf((*MockClient)(nil).GetPasswordHashReport), arg0)
+}
+
 // GetPing mocks base method.
 func (m *MockClient) GetPing(arg0 context.Context) (string, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -1340,6 +1579,22 @@ func (mr *MockClientMockRecorder) GetRoleByName(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleByName", reflect.TypeOf((*MockClient)(nil).GetRoleByName), arg0, arg1)
 }
 
+// GetSchemes mocks base method.
+func (m *MockClient) GetSchemes(arg0 context.Context, arg1 string, arg2, arg3 int) ([]*model.Scheme, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchemes", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*model.Scheme)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSchemes indicates an expected call of GetSchemes.
+func (mr *MockClientMockRecorder) GetSchemes(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchemes", reflect.TypeOf((*MockClient)(nil).GetSchemes), arg0, arg1, arg2, arg3)
+}
+
 // GetServerBusy mocks base method.
 func (m *MockClient) GetServerBusy(arg0 context.Context) (*model.ServerBusyState, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -1356,6 +1611,22 @@ func (mr *MockClientMockRecorder) GetServerBusy(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerBusy", reflect.TypeOf((*MockClient)(nil).GetServerBusy), arg0)
 }
 
+// GetSidebarCategoriesForTeamForUser mocks base method.
+func (m *MockClient) GetSidebarCategoriesForTeamForUser(arg0 context.Context, arg1, arg2, arg3 string) (*model.OrderedSidebarCategories, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSidebarCategoriesForTeamForUser", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.OrderedSidebarCategories)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSidebarCategoriesForTeamForUser indicates an expected call of GetSidebarCategoriesForTeamForUser.
+func (mr *MockClientMockRecorder) GetSidebarCategoriesForTeamForUser(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSidebarCategoriesForTeamForUser", reflect.TypeOf((*MockClient)(nil).GetSidebarCategoriesForTeamForUser), arg0, arg1, arg2, arg3)
+}
+
 // GetTeam mocks base method.
 func (m *MockClient) GetTeam(arg0 context.Context, arg1, arg2 string) (*model.Team, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -1388,6 +1659,22 @@ func (mr *MockClientMockRecorder) GetTeamByName(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamByName", reflect.TypeOf((*MockClient)(nil).GetTeamByName), arg0, arg1, arg2)
 }
 
+// GetTeamMembers mocks base method.
+func (m *MockClient) GetTeamMembers(arg0 context.Context, arg1 string, arg2, arg3 int, arg4 string) ([]*model.TeamMember, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamMembers", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*model.TeamMember)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTeamMembers indicates an expected call of GetTeamMembers.
+func (mr *MockClientMockRecorder) GetTeamMembers(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamMembers", reflect.TypeOf((*MockClient)(nil).GetTeamMembers), arg0, arg1, arg2, arg3, arg4)
+}
+
 // GetUpload mocks base method.
 func (m *MockClient) GetUpload(arg0 context.Context, arg1 string) (*model.UploadSession, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -1862,6 +2149,22 @@ func (mr *MockClientMockRecorder) PatchConfig(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchConfig", reflect.TypeOf((*MockClient)(nil).PatchConfig), arg0, arg1)
 }
 
+// PatchEventSubscription mocks base method.
+func (m *MockClient) PatchEventSubscription(arg0 context.Context, arg1 string, arg2 *model.EventSubscriptionPatch) (*model.EventSubscription, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchEventSubscription", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.EventSubscription)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PatchEventSubscription indicates an expected call of PatchEventSubscription.
+func (mr *MockClientMockRecorder) PatchEventSubscription(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchEventSubscription", reflect.TypeOf((*MockClient)(nil).PatchEventSubscription), arg0, arg1, arg2)
+}
+
 // PatchRole mocks base method.
 func (m *MockClient) PatchRole(arg0 context.Context, arg1 string, arg2 *model.RolePatch) (*model.Role, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -1878,6 +2181,22 @@ func (mr *MockClientMockRecorder) PatchRole(arg0, arg1, arg2 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchRole", reflect.TypeOf((*MockClient)(nil).PatchRole), arg0, arg1, arg2)
 }
 
+// PatchScheme mocks base method.
+func (m *MockClient) PatchScheme(arg0 context.Context, arg1 string, arg2 *model.SchemePatch) (*model.Scheme, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchScheme", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Scheme)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PatchScheme indicates an expected call of PatchScheme.
+func (mr *MockClientMockRecorder) PatchScheme(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchScheme", reflect.TypeOf((*MockClient)(nil).PatchScheme), arg0, arg1, arg2)
+}
+
 // PatchTeam mocks base method.
 func (m *MockClient) PatchTeam(arg0 context.Context, arg1 string, arg2 *model.TeamPatch) (*model.Team, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -1969,6 +2288,21 @@ func (mr *MockClientMockRecorder) PermanentDeleteUser(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentDeleteUser", reflect.TypeOf((*MockClient)(nil).PermanentDeleteUser), arg0, arg1)
 }
 
+// PreviewEmailTemplate mocks base method.
+func (m *MockClient) PreviewEmailTemplate(arg0 context.Context, arg1 *model.EmailTemplate, arg2 string) (*model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewEmailTemplate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewEmailTemplate indicates an expected call of PreviewEmailTemplate.
+func (mr *MockClientMockRecorder) PreviewEmailTemplate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewEmailTemplate", reflect.TypeOf((*MockClient)(nil).PreviewEmailTemplate), arg0, arg1, arg2)
+}
+
 // PromoteGuestToUser mocks base method.
 func (m *MockClient) PromoteGuestToUser(arg0 context.Context, arg1 string) (*model.Response, error) {
 	m.ctrl.T.Helper()
@@ -1984,6 +2318,38 @@ func (mr *MockClientMockRecorder) PromoteGuestToUser(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteGuestToUser", reflect.TypeOf((*MockClient)(nil).PromoteGuestToUser), arg0, arg1)
 }
 
+// RegenEventSubscriptionSecret mocks base method.
+func (m *MockClient) RegenEventSubscriptionSecret(arg0 context.Context, arg1 string) (*model.EventSubscription, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenEventSubscriptionSecret", arg0, arg1)
+	ret0, _ := ret[0].(*model.EventSubscription)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RegenEventSubscriptionSecret indicates an expected call of RegenEventSubscriptionSecret.
+func (mr *MockClientMockRecorder) RegenEventSubscriptionSecret(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenEventSubscriptionSecret", reflect.TypeOf((*MockClient)(nil).RegenEventSubscriptionSecret), arg0, arg1)
+}
+
+// RegenOutgoingHookSecret mocks base method.
+func (m *MockClient) RegenOutgoingHookSecret(arg0 context.Context, arg1 string) (*model.OutgoingWebhook, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenOutgoingHookSecret", arg0, arg1)
+	ret0, _ := ret[0].(*model.OutgoingWebhook)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RegenOutgoingHookSecret indicates an expected call of RegenOutgoingHookSecret.
+func (mr *MockClientMockRecorder) RegenOutgoingHookSecret(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenOutgoingHookSecret", reflect.TypeOf((*MockClient)(nil).RegenOutgoingHookSecret), arg0, arg1)
+}
+
 // RegenOutgoingHookToken mocks base method.
 func (m *MockClient) RegenOutgoingHookToken(arg0 context.Context, arg1 string) (*model.OutgoingWebhook, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -2075,6 +2441,22 @@ func (mr *MockClientMockRecorder) RemoveUserFromChannel(arg0, arg1, arg2 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserFromChannel", reflect.TypeOf((*MockClient)(nil).RemoveUserFromChannel), arg0, arg1, arg2)
 }
 
+// ReplayOutgoingWebhookDelivery mocks base method.
+func (m *MockClient) ReplayOutgoingWebhookDelivery(arg0 context.Context, arg1 string, arg2 string) (*model.OutgoingWebhookDelivery, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayOutgoingWebhookDelivery", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.OutgoingWebhookDelivery)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReplayOutgoingWebhookDelivery indicates an expected call of ReplayOutgoingWebhookDelivery.
+func (mr *MockClientMockRecorder) ReplayOutgoingWebhookDelivery(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayOutgoingWebhookDelivery", reflect.TypeOf((*MockClient)(nil).ReplayOutgoingWebhookDelivery), arg0, arg1, arg2)
+}
+
 // ResetSamlAuthDataToEmail mocks base method.
 func (m *MockClient) ResetSamlAuthDataToEmail(arg0 context.Context, arg1, arg2 bool, arg3 []string) (int64, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -2154,6 +2536,38 @@ func (mr *MockClientMockRecorder) RevokeUserAccessToken(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserAccessToken", reflect.TypeOf((*MockClient)(nil).RevokeUserAccessToken), arg0, arg1)
 }
 
+// RollbackConfig mocks base method.
+func (m *MockClient) RollbackConfig(arg0 context.Context, arg1 string) (*model.Config, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackConfig", arg0, arg1)
+	ret0, _ := ret[0].(*model.Config)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RollbackConfig indicates an expected call of RollbackConfig.
+func (mr *MockClientMockRecorder) RollbackConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackConfig", reflect.TypeOf((*MockClient)(nil).RollbackConfig), arg0, arg1)
+}
+
+// SearchAuditLogs mocks base method.
+func (m *MockClient) SearchAuditLogs(arg0 context.Context, arg1 *model.AuditLogSearch) ([]*model.AuditLog, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAuditLogs", arg0, arg1)
+	ret0, _ := ret[0].([]*model.AuditLog)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchAuditLogs indicates an expected call of SearchAuditLogs.
+func (mr *MockClientMockRecorder) SearchAuditLogs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAuditLogs", reflect.TypeOf((*MockClient)(nil).SearchAuditLogs), arg0, arg1)
+}
+
 // SearchTeams mocks base method.
 func (m *MockClient) SearchTeams(arg0 context.Context, arg1 *model.TeamSearch) ([]*model.Team, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -2246,6 +2660,21 @@ func (mr *MockClientMockRecorder) UpdateChannelPrivacy(arg0, arg1, arg2 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChannelPrivacy", reflect.TypeOf((*MockClient)(nil).UpdateChannelPrivacy), arg0, arg1, arg2)
 }
 
+// UpdateChannelScheme mocks base method.
+func (m *MockClient) UpdateChannelScheme(arg0 context.Context, arg1, arg2 string) (*model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChannelScheme", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateChannelScheme indicates an expected call of UpdateChannelScheme.
+func (mr *MockClientMockRecorder) UpdateChannelScheme(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChannelScheme", reflect.TypeOf((*MockClient)(nil).UpdateChannelScheme), arg0, arg1, arg2)
+}
+
 // UpdateCommand mocks base method.
 func (m *MockClient) UpdateCommand(arg0 context.Context, arg1 *model.Command) (*model.Command, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -2278,6 +2707,22 @@ func (mr *MockClientMockRecorder) UpdateConfig(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConfig", reflect.TypeOf((*MockClient)(nil).UpdateConfig), arg0, arg1)
 }
 
+// UpdateEmailTemplate mocks base method.
+func (m *MockClient) UpdateEmailTemplate(arg0 context.Context, arg1 *model.EmailTemplate) (*model.EmailTemplate, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEmailTemplate", arg0, arg1)
+	ret0, _ := ret[0].(*model.EmailTemplate)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateEmailTemplate indicates an expected call of UpdateEmailTemplate.
+func (mr *MockClientMockRecorder) UpdateEmailTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmailTemplate", reflect.TypeOf((*MockClient)(nil).UpdateEmailTemplate), arg0, arg1)
+}
+
 // UpdateIncomingWebhook mocks base method.
 func (m *MockClient) UpdateIncomingWebhook(arg0 context.Context, arg1 *model.IncomingWebhook) (*model.IncomingWebhook, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -2340,6 +2785,22 @@ func (mr *MockClientMockRecorder) UpdatePreferences(arg0, arg1, arg2 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePreferences", reflect.TypeOf((*MockClient)(nil).UpdatePreferences), arg0, arg1, arg2)
 }
 
+// UpdateSidebarCategoryForTeamForUser mocks base method.
+func (m *MockClient) UpdateSidebarCategoryForTeamForUser(arg0 context.Context, arg1, arg2, arg3 string, arg4 *model.SidebarCategoryWithChannels) (*model.SidebarCategoryWithChannels, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSidebarCategoryForTeamForUser", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*model.SidebarCategoryWithChannels)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateSidebarCategoryForTeamForUser indicates an expected call of UpdateSidebarCategoryForTeamForUser.
+func (mr *MockClientMockRecorder) UpdateSidebarCategoryForTeamForUser(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSidebarCategoryForTeamForUser", reflect.TypeOf((*MockClient)(nil).UpdateSidebarCategoryForTeamForUser), arg0, arg1, arg2, arg3, arg4)
+}
+
 // UpdateTeam mocks base method.
 func (m *MockClient) UpdateTeam(arg0 context.Context, arg1 *model.Team) (*model.Team, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -2372,6 +2833,21 @@ func (mr *MockClientMockRecorder) UpdateTeamPrivacy(arg0, arg1, arg2 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamPrivacy", reflect.TypeOf((*MockClient)(nil).UpdateTeamPrivacy), arg0, arg1, arg2)
 }
 
+// UpdateTeamScheme mocks base method.
+func (m *MockClient) UpdateTeamScheme(arg0 context.Context, arg1, arg2 string) (*model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamScheme", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTeamScheme indicates an expected call of UpdateTeamScheme.
+func (mr *MockClientMockRecorder) UpdateTeamScheme(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamScheme", reflect.TypeOf((*MockClient)(nil).UpdateTeamScheme), arg0, arg1, arg2)
+}
+
 // UpdateUser mocks base method.
 func (m *MockClient) UpdateUser(arg0 context.Context, arg1 *model.User) (*model.User, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -2542,6 +3018,22 @@ func (mr *MockClientMockRecorder) UploadPluginForced(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadPluginForced", reflect.TypeOf((*MockClient)(nil).UploadPluginForced), arg0, arg1)
 }
 
+// VerifyAuditLogs mocks base method.
+func (m *MockClient) VerifyAuditLogs(arg0 context.Context) (*model.AuditLogVerification, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyAuditLogs", arg0)
+	ret0, _ := ret[0].(*model.AuditLogVerification)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// VerifyAuditLogs indicates an expected call of VerifyAuditLogs.
+func (mr *MockClientMockRecorder) VerifyAuditLogs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyAuditLogs", reflect.TypeOf((*MockClient)(nil).VerifyAuditLogs), arg0)
+}
+
 // VerifyUserEmailWithoutToken mocks base method.
 func (m *MockClient) VerifyUserEmailWithoutToken(arg0 context.Context, arg1 string) (*model.User, *model.Response, error) {
 	m.ctrl.T.Helper()
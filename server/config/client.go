@@ -65,6 +65,7 @@ func GenerateClientConfig(c *model.Config, telemetryID string, license *model.Li
 	props["SendPushNotifications"] = strconv.FormatBool(*c.EmailSettings.SendPushNotifications)
 	props["RequireEmailVerification"] = strconv.FormatBool(*c.EmailSettings.RequireEmailVerification)
 	props["EnableEmailBatching"] = strconv.FormatBool(*c.EmailSettings.EnableEmailBatching)
+	props["EnableEmailDigests"] = strconv.FormatBool(*c.EmailSettings.EnableEmailDigests)
 	props["EnablePreviewModeBanner"] = strconv.FormatBool(*c.EmailSettings.EnablePreviewModeBanner)
 	props["EmailNotificationContentsType"] = *c.EmailSettings.EmailNotificationContentsType
 
@@ -343,6 +344,8 @@ func GenerateLimitedClientConfig(c *model.Config, telemetryID string, license *m
 	props["CustomDescriptionText"] = *c.TeamSettings.CustomDescriptionText
 	props["EnableMultifactorAuthentication"] = strconv.FormatBool(*c.ServiceSettings.EnableMultifactorAuthentication)
 	props["EnforceMultifactorAuthentication"] = "false"
+	props["EnableWebAuthn"] = strconv.FormatBool(*c.ServiceSettings.EnableWebAuthn)
+	props["EnablePasswordlessLogin"] = strconv.FormatBool(*c.ServiceSettings.EnableWebAuthn && *c.ServiceSettings.EnablePasswordlessLogin)
 	props["EnableGuestAccounts"] = strconv.FormatBool(*c.GuestAccountsSettings.Enable)
 	props["HideGuestTags"] = strconv.FormatBool(*c.GuestAccountsSettings.HideTags)
 	props["GuestAccountsEnforceMultifactorAuthentication"] = strconv.FormatBool(*c.GuestAccountsSettings.EnforceMultifactorAuthentication)
@@ -370,6 +373,7 @@ func GenerateLimitedClientConfig(c *model.Config, telemetryID string, license *m
 
 	// Open source license always has MFA enabled
 	props["EnforceMultifactorAuthentication"] = strconv.FormatBool(*c.ServiceSettings.EnforceMultifactorAuthentication)
+	props["EnforceWebAuthn"] = strconv.FormatBool(*c.ServiceSettings.EnableWebAuthn && *c.ServiceSettings.EnforceWebAuthn)
 
 	// Open source license is not cloud
 	// MM-48727: enable SSO options for free cloud - not in self hosted
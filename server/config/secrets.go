@@ -0,0 +1,334 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+const (
+	// SecretReferenceFilePrefix marks a setting whose value is read from a file,
+	// e.g. file:///run/secrets/smtp_password.
+	SecretReferenceFilePrefix = "file://"
+	// SecretReferenceEnvPrefix marks a setting whose value is read from an
+	// environment variable, e.g. env:SMTP_PASSWORD.
+	SecretReferenceEnvPrefix = "env:"
+)
+
+// IsSecretReference returns whether the value of a setting references a secret
+// stored outside of the configuration.
+func IsSecretReference(value string) bool {
+	return strings.HasPrefix(value, SecretReferenceFilePrefix) || strings.HasPrefix(value, SecretReferenceEnvPrefix)
+}
+
+// secretReferenceFile returns the path of the file a secret reference points to, if any.
+func secretReferenceFile(ref string) (string, bool) {
+	if !strings.HasPrefix(ref, SecretReferenceFilePrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(ref, SecretReferenceFilePrefix), true
+}
+
+// resolveSecretReference returns the secret a reference points to. The trailing
+// newlines of the secret files are left out.
+func resolveSecretReference(ref string) (string, error) {
+	if path, ok := secretReferenceFile(ref); ok {
+		if !filepath.IsAbs(path) {
+			return "", errors.Errorf("secret file %q must be an absolute path", path)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to read secret file %q", path)
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	}
+
+	name := strings.TrimPrefix(ref, SecretReferenceEnvPrefix)
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return "", errors.Errorf("secret environment variable %q is not set", name)
+	}
+	return value, nil
+}
+
+// secretSettings lists the settings whose values may reference a secret. The other
+// settings are used as they are, even when their values look like a reference.
+var secretSettings = [][]string{
+	{"SqlSettings", "DataSource"},
+	{"SqlSettings", "DataSourceReplicas"},
+	{"SqlSettings", "DataSourceSearchReplicas"},
+	{"SqlSettings", "AtRestEncryptKey"},
+	{"EmailSettings", "SMTPPassword"},
+	{"FileSettings", "AmazonS3SecretAccessKey"},
+	{"FileSettings", "ExportAmazonS3SecretAccessKey"},
+	{"LdapSettings", "BindPassword"},
+	{"GitLabSettings", "Secret"},
+	{"GoogleSettings", "Secret"},
+	{"Office365Settings", "Secret"},
+	{"OpenIdSettings", "Secret"},
+	{"ElasticsearchSettings", "Password"},
+	{"CacheSettings", "RedisPassword"},
+	{"MessageExportSettings", "GlobalRelaySettings", "SMTPPassword"},
+	{"ExperimentalAuditSettings", "StoreSigningKey"},
+}
+
+// findSecretReferences creates a map[string]any mirroring the configuration structure,
+// with the secret references of the secret settings at the leaves. The settings listing
+// several secrets have the index of each reference as the last key of its path.
+func findSecretReferences(cfg *model.Config) map[string]any {
+	refs := make(map[string]any)
+	for _, path := range secretSettings {
+		switch val := getVal(cfg, path); val.Kind() {
+		case reflect.String:
+			if IsSecretReference(val.String()) {
+				setSecretReference(refs, path, val.String())
+			}
+		case reflect.Slice:
+			for i := 0; i < val.Len(); i++ {
+				if IsSecretReference(val.Index(i).String()) {
+					setSecretReference(refs, append(slices.Clone(path), strconv.Itoa(i)), val.Index(i).String())
+				}
+			}
+		}
+	}
+
+	if len(refs) == 0 {
+		return nil
+	}
+
+	return refs
+}
+
+// getSecretVal returns the reflect.Value of the setting at the end of path, or of one
+// of its elements when path ends with an index.
+func getSecretVal(cfg *model.Config, path []string) reflect.Value {
+	i, err := strconv.Atoi(path[len(path)-1])
+	if err != nil {
+		return getVal(cfg, path)
+	}
+
+	val := getVal(cfg, path[:len(path)-1])
+	if val.Kind() != reflect.Slice || i >= val.Len() {
+		return reflect.Value{}
+	}
+	return val.Index(i)
+}
+
+// applySecretReferences returns a new config with the given secret references
+// replaced by the secrets they point to.
+func applySecretReferences(cfg *model.Config, refs map[string]any) (*model.Config, error) {
+	resolvedCfg := cfg.Clone()
+	for _, path := range getPaths(refs) {
+		secret, err := resolveSecretReference(getSecretReference(refs, path))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to resolve %s", strings.Join(path, "."))
+		}
+		if val := getSecretVal(resolvedCfg, path); val.CanSet() {
+			val.SetString(secret)
+		}
+	}
+	return resolvedCfg, nil
+}
+
+// restoreSecretReferences puts the secret references back in place of the secrets
+// they were resolved to in resolvedCfg, as long as cfg still holds the same secrets.
+// The settings overridden by environment variables are left alone. It returns the
+// secret references that were restored.
+func restoreSecretReferences(cfg, resolvedCfg *model.Config, refs, envOverrides map[string]any) map[string]any {
+	restored := make(map[string]any)
+	for _, path := range getPaths(refs) {
+		if isEnvOverride(envOverrides, path) {
+			continue
+		}
+
+		val := getSecretVal(cfg, path)
+		resolvedVal := getSecretVal(resolvedCfg, path)
+		if !val.CanSet() || !resolvedVal.IsValid() || val.String() != resolvedVal.String() {
+			continue
+		}
+
+		ref := getSecretReference(refs, path)
+		val.SetString(ref)
+		setSecretReference(restored, path, ref)
+	}
+	return restored
+}
+
+// desanitizeSecretReferences puts back the secrets masked in the settings referencing
+// them, like desanitize does for the sensitive settings.
+func desanitizeSecretReferences(actual, target *model.Config, refs map[string]any) {
+	for _, path := range getPaths(refs) {
+		val := getSecretVal(target, path)
+		actualVal := getSecretVal(actual, path)
+		if val.CanSet() && val.String() == model.FakeSetting && actualVal.IsValid() {
+			val.SetString(actualVal.String())
+		}
+	}
+}
+
+// maskSecretReferences replaces the secrets resolved from references with model.FakeSetting.
+func maskSecretReferences(cfg *model.Config, refs map[string]any) {
+	for _, path := range getPaths(refs) {
+		if val := getSecretVal(cfg, path); val.CanSet() {
+			val.SetString(model.FakeSetting)
+		}
+	}
+}
+
+func getSecretReference(refs map[string]any, path []string) string {
+	var node any = refs
+	for _, key := range path {
+		m, ok := node.(map[string]any)
+		if !ok {
+			return ""
+		}
+		node = m[key]
+	}
+	ref, _ := node.(string)
+	return ref
+}
+
+func setSecretReference(refs map[string]any, path []string, ref string) {
+	for _, key := range path[:len(path)-1] {
+		next, ok := refs[key].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			refs[key] = next
+		}
+		refs = next
+	}
+	refs[path[len(path)-1]] = ref
+}
+
+func mergeSecretReferences(dst, src map[string]any) map[string]any {
+	for _, path := range getPaths(src) {
+		setSecretReference(dst, path, getSecretReference(src, path))
+	}
+	return dst
+}
+
+func isEnvOverride(envOverrides map[string]any, path []string) bool {
+	var node any = envOverrides
+	for _, key := range path {
+		m, ok := node.(map[string]any)
+		if !ok {
+			// The whole list of secrets is overridden.
+			break
+		}
+		node = m[key]
+	}
+	overridden, _ := node.(bool)
+	return overridden
+}
+
+// secretFiles returns the paths of the files referenced by the given secret references.
+func secretFiles(refs map[string]any) []string {
+	var files []string
+	for _, path := range getPaths(refs) {
+		if file, ok := secretReferenceFile(getSecretReference(refs, path)); ok && filepath.IsAbs(file) {
+			files = append(files, filepath.Clean(file))
+		}
+	}
+	return files
+}
+
+// secretWatcher watches the files secrets are read from, calling back when any of
+// them changes. The directories holding the files are watched rather than the files
+// themselves, since secrets mounted by orchestrators are replaced by swapping
+// symbolic links.
+type secretWatcher struct {
+	watcher *fsnotify.Watcher
+	files   map[string]bool
+	close   chan struct{}
+}
+
+func newSecretWatcher(files []string, callback func()) (*secretWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the watcher of the secret files")
+	}
+
+	w := &secretWatcher{
+		watcher: watcher,
+		files:   make(map[string]bool, len(files)),
+		close:   make(chan struct{}),
+	}
+
+	dirs := make(map[string]bool)
+	for _, file := range files {
+		w.files[file] = true
+		dirs[filepath.Dir(file)] = true
+	}
+	for dir := range dirs {
+		if err := watcher.Add(dir); err != nil {
+			watcher.Close()
+			return nil, errors.Wrapf(err, "failed to watch the secret files in %q", dir)
+		}
+	}
+
+	go func() {
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				// Kubernetes updates the secrets through a hidden ..data directory.
+				if w.files[filepath.Clean(event.Name)] || strings.HasPrefix(filepath.Base(event.Name), "..") {
+					callback()
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				mlog.Warn("Failed to watch the secret files", mlog.Err(err))
+			case <-w.close:
+				return
+			}
+		}
+	}()
+
+	return w, nil
+}
+
+// watches returns whether the watcher watches exactly the given files.
+func (w *secretWatcher) watches(files []string) bool {
+	if w == nil {
+		return len(files) == 0
+	}
+
+	set := make(map[string]bool, len(files))
+	for _, file := range files {
+		set[file] = true
+	}
+	if len(set) != len(w.files) {
+		return false
+	}
+	for file := range set {
+		if !w.files[file] {
+			return false
+		}
+	}
+	return true
+}
+
+func (w *secretWatcher) Close() error {
+	if w == nil {
+		return nil
+	}
+
+	close(w.close)
+	return w.watcher.Close()
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestResolveSecretReference(t *testing.T) {
+	t.Run("file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "secret")
+		require.NoError(t, os.WriteFile(path, []byte("s3cr3t\n"), 0600))
+
+		secret, err := resolveSecretReference("file://" + path)
+		require.NoError(t, err)
+		assert.Equal(t, "s3cr3t", secret)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := resolveSecretReference("file://" + filepath.Join(t.TempDir(), "missing"))
+		require.Error(t, err)
+	})
+
+	t.Run("relative file", func(t *testing.T) {
+		_, err := resolveSecretReference("file://secret")
+		require.Error(t, err)
+	})
+
+	t.Run("environment variable", func(t *testing.T) {
+		t.Setenv("TEST_SECRET_REFERENCE", "s3cr3t")
+
+		secret, err := resolveSecretReference("env:TEST_SECRET_REFERENCE")
+		require.NoError(t, err)
+		assert.Equal(t, "s3cr3t", secret)
+	})
+
+	t.Run("missing environment variable", func(t *testing.T) {
+		_, err := resolveSecretReference("env:TEST_SECRET_REFERENCE_MISSING")
+		require.Error(t, err)
+	})
+}
+
+func newSecretReferenceTestStore(t *testing.T, cfg *model.Config) *Store {
+	t.Helper()
+
+	memoryStore, err := NewMemoryStoreWithOptions(&MemoryStoreOptions{InitialConfig: cfg})
+	require.NoError(t, err)
+
+	store, err := NewStoreFromBacking(memoryStore, nil, false)
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close() })
+
+	return store
+}
+
+func TestStoreSecretReferences(t *testing.T) {
+	secretPath := filepath.Join(t.TempDir(), "smtp_password")
+	require.NoError(t, os.WriteFile(secretPath, []byte("smtp-secret\n"), 0600))
+	t.Setenv("TEST_S3_SECRET", "s3-secret")
+
+	newConfig := func() *model.Config {
+		cfg := &model.Config{}
+		cfg.SetDefaults()
+		cfg.EmailSettings.SMTPPassword = model.NewPointer("file://" + secretPath)
+		cfg.FileSettings.AmazonS3SecretAccessKey = model.NewPointer("env:TEST_S3_SECRET")
+		return cfg
+	}
+
+	t.Run("load resolves the references without persisting the secrets", func(t *testing.T) {
+		store := newSecretReferenceTestStore(t, newConfig())
+
+		assert.Equal(t, "smtp-secret", *store.Get().EmailSettings.SMTPPassword)
+		assert.Equal(t, "s3-secret", *store.Get().FileSettings.AmazonS3SecretAccessKey)
+		assert.Equal(t, "file://"+secretPath, *store.GetNoEnv().EmailSettings.SMTPPassword)
+		assert.Equal(t, "env:TEST_S3_SECRET", *store.GetNoEnv().FileSettings.AmazonS3SecretAccessKey)
+
+		assert.Equal(t, map[string]any{
+			"EmailSettings": map[string]any{"SMTPPassword": true},
+			"FileSettings":  map[string]any{"AmazonS3SecretAccessKey": true},
+		}, store.GetSecretReferences())
+	})
+
+	t.Run("only the secret settings reference secrets", func(t *testing.T) {
+		cfg := newConfig()
+		cfg.TeamSettings.SiteName = model.NewPointer("env:TEST_S3_SECRET")
+		cfg.SqlSettings.DataSourceReplicas = []string{"postgres://replica", "env:TEST_S3_SECRET"}
+		store := newSecretReferenceTestStore(t, cfg)
+
+		assert.Equal(t, "env:TEST_S3_SECRET", *store.Get().TeamSettings.SiteName)
+		assert.Equal(t, []string{"postgres://replica", "s3-secret"}, store.Get().SqlSettings.DataSourceReplicas)
+		assert.Equal(t, []string{"postgres://replica", "env:TEST_S3_SECRET"}, store.GetNoEnv().SqlSettings.DataSourceReplicas)
+
+		assert.Equal(t, map[string]any{
+			"EmailSettings": map[string]any{"SMTPPassword": true},
+			"FileSettings":  map[string]any{"AmazonS3SecretAccessKey": true},
+			"SqlSettings":   map[string]any{"DataSourceReplicas": true},
+		}, store.GetSecretReferences())
+	})
+
+	t.Run("new references", func(t *testing.T) {
+		store := newSecretReferenceTestStore(t, newConfig())
+
+		cfg := store.GetNoEnv().Clone()
+		assert.Empty(t, store.NewSecretReferences(cfg))
+
+		cfg.EmailSettings.SMTPPassword = model.NewPointer("env:TEST_S3_SECRET")
+		cfg.LdapSettings.BindPassword = model.NewPointer("file:///etc/passwd")
+		assert.Equal(t, []string{"EmailSettings.SMTPPassword", "LdapSettings.BindPassword"}, store.NewSecretReferences(cfg))
+	})
+
+	t.Run("load fails on an unresolvable reference", func(t *testing.T) {
+		cfg := newConfig()
+		cfg.EmailSettings.SMTPPassword = model.NewPointer("env:TEST_SECRET_REFERENCE_MISSING")
+
+		memoryStore, err := NewMemoryStoreWithOptions(&MemoryStoreOptions{InitialConfig: cfg})
+		require.NoError(t, err)
+
+		_, err = NewStoreFromBacking(memoryStore, nil, false)
+		require.Error(t, err)
+	})
+
+	t.Run("set keeps the references of the unchanged secrets", func(t *testing.T) {
+		store := newSecretReferenceTestStore(t, newConfig())
+
+		cfg := store.Get().Clone()
+		store.MaskSecretReferences(cfg)
+		assert.Equal(t, model.FakeSetting, *cfg.EmailSettings.SMTPPassword)
+		assert.Equal(t, model.FakeSetting, *cfg.FileSettings.AmazonS3SecretAccessKey)
+
+		cfg.ServiceSettings.SiteURL = model.NewPointer("http://example.com")
+		_, newCfg, err := store.Set(cfg)
+		require.NoError(t, err)
+
+		assert.Equal(t, "smtp-secret", *newCfg.EmailSettings.SMTPPassword)
+		assert.Equal(t, "s3-secret", *newCfg.FileSettings.AmazonS3SecretAccessKey)
+		assert.Equal(t, "file://"+secretPath, *store.GetNoEnv().EmailSettings.SMTPPassword)
+		assert.Equal(t, "env:TEST_S3_SECRET", *store.GetNoEnv().FileSettings.AmazonS3SecretAccessKey)
+
+		// The secrets in use are traded back for their references too.
+		cfgNoEnv := store.RemoveEnvironmentOverrides(store.Get())
+		assert.Equal(t, "file://"+secretPath, *cfgNoEnv.EmailSettings.SMTPPassword)
+	})
+
+	t.Run("set replaces the references of the changed secrets", func(t *testing.T) {
+		store := newSecretReferenceTestStore(t, newConfig())
+		t.Setenv("TEST_SMTP_SECRET", "new-smtp-secret")
+
+		cfg := store.Get().Clone()
+		cfg.EmailSettings.SMTPPassword = model.NewPointer("env:TEST_SMTP_SECRET")
+		cfg.FileSettings.AmazonS3SecretAccessKey = model.NewPointer("plain-secret")
+		_, newCfg, err := store.Set(cfg)
+		require.NoError(t, err)
+
+		assert.Equal(t, "new-smtp-secret", *newCfg.EmailSettings.SMTPPassword)
+		assert.Equal(t, "plain-secret", *newCfg.FileSettings.AmazonS3SecretAccessKey)
+		assert.Equal(t, "env:TEST_SMTP_SECRET", *store.GetNoEnv().EmailSettings.SMTPPassword)
+		assert.Equal(t, "plain-secret", *store.GetNoEnv().FileSettings.AmazonS3SecretAccessKey)
+
+		assert.Equal(t, map[string]any{
+			"EmailSettings": map[string]any{"SMTPPassword": true},
+		}, store.GetSecretReferences())
+	})
+
+	t.Run("set fails on an unresolvable reference", func(t *testing.T) {
+		store := newSecretReferenceTestStore(t, newConfig())
+
+		cfg := store.Get().Clone()
+		cfg.EmailSettings.SMTPPassword = model.NewPointer("env:TEST_SECRET_REFERENCE_MISSING")
+		_, _, err := store.Set(cfg)
+		require.Error(t, err)
+		assert.Equal(t, "smtp-secret", *store.Get().EmailSettings.SMTPPassword)
+	})
+
+	t.Run("the secret reloads when its file changes", func(t *testing.T) {
+		store := newSecretReferenceTestStore(t, newConfig())
+
+		var notified atomic.Bool
+		store.AddListener(func(_, newCfg *model.Config) {
+			if *newCfg.EmailSettings.SMTPPassword == "rotated-smtp-secret" {
+				notified.Store(true)
+			}
+		})
+
+		require.NoError(t, os.WriteFile(secretPath, []byte("rotated-smtp-secret\n"), 0600))
+		t.Cleanup(func() {
+			os.WriteFile(secretPath, []byte("smtp-secret\n"), 0600)
+		})
+
+		require.Eventually(t, func() bool {
+			return *store.Get().EmailSettings.SMTPPassword == "rotated-smtp-secret"
+		}, 5*time.Second, 50*time.Millisecond)
+		assert.Equal(t, "file://"+secretPath, *store.GetNoEnv().EmailSettings.SMTPPassword)
+
+		require.Eventually(t, notified.Load, 5*time.Second, 50*time.Millisecond)
+	})
+}
@@ -6,6 +6,9 @@ package config
 import (
 	"encoding/json"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -13,6 +16,7 @@ import (
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/i18n"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
 	"github.com/mattermost/mattermost/server/public/utils"
 )
 
@@ -33,6 +37,10 @@ type Store struct {
 	configNoEnv          *model.Config
 	configCustomDefaults *model.Config
 
+	// secretRefs holds the secret references resolved in config, see findSecretReferences.
+	secretRefs    map[string]any
+	secretWatcher *secretWatcher
+
 	readOnly   bool
 	readOnlyFF bool
 }
@@ -148,11 +156,70 @@ func (s *Store) GetEnvironmentOverridesWithFilter(filter func(reflect.StructFiel
 }
 
 // RemoveEnvironmentOverrides returns a new config without the environment
-// overrides.
+// overrides, and with the secret references in place of the secrets they
+// resolve to.
 func (s *Store) RemoveEnvironmentOverrides(cfg *model.Config) *model.Config {
 	s.configLock.RLock()
 	defer s.configLock.RUnlock()
-	return removeEnvOverrides(cfg, s.configNoEnv, s.GetEnvironmentOverrides())
+	envOverrides := s.GetEnvironmentOverrides()
+	cfgNoEnv := removeEnvOverrides(cfg, s.configNoEnv, envOverrides)
+	restoreSecretReferences(cfgNoEnv, s.config, s.secretRefs, envOverrides)
+	return cfgNoEnv
+}
+
+// GetSecretReferences fetches the configuration fields whose values are read from
+// a file or an environment variable, as a map mirroring the configuration structure
+// with true at the leaves.
+func (s *Store) GetSecretReferences() map[string]any {
+	s.configLock.RLock()
+	defer s.configLock.RUnlock()
+
+	paths := make(map[string]any)
+	for _, path := range getPaths(s.secretRefs) {
+		// The settings listing several secrets are marked as a whole.
+		if _, err := strconv.Atoi(path[len(path)-1]); err == nil {
+			path = path[:len(path)-1]
+		}
+		node := paths
+		for _, key := range path[:len(path)-1] {
+			next, ok := node[key].(map[string]any)
+			if !ok {
+				next = make(map[string]any)
+				node[key] = next
+			}
+			node = next
+		}
+		node[path[len(path)-1]] = true
+	}
+
+	return paths
+}
+
+// MaskSecretReferences replaces the secrets read from a file or an environment
+// variable with model.FakeSetting in the given config.
+func (s *Store) MaskSecretReferences(cfg *model.Config) {
+	s.configLock.RLock()
+	defer s.configLock.RUnlock()
+	maskSecretReferences(cfg, s.secretRefs)
+}
+
+// NewSecretReferences returns the settings of the given config referencing secrets the
+// current configuration doesn't reference, so that the references can be refused where
+// they aren't allowed.
+func (s *Store) NewSecretReferences(cfg *model.Config) []string {
+	s.configLock.RLock()
+	defer s.configLock.RUnlock()
+
+	var settings []string
+	refs := findSecretReferences(cfg)
+	for _, path := range getPaths(refs) {
+		if getSecretReference(refs, path) != getSecretReference(s.secretRefs, path) {
+			settings = append(settings, strings.Join(path, "."))
+		}
+	}
+	sort.Strings(settings)
+
+	return settings
 }
 
 // SetReadOnlyFF sets whether feature flags should be written out to
@@ -176,6 +243,7 @@ func (s *Store) Set(newCfg *model.Config) (*model.Config, *model.Config, error)
 	newCfg = newCfg.Clone()
 	oldCfg := s.config.Clone()
 	oldCfgNoEnv := s.configNoEnv
+	oldSecretRefs := s.secretRefs
 
 	// Setting defaults allows us to accept partial config objects.
 	newCfg.SetDefaults()
@@ -183,18 +251,34 @@ func (s *Store) Set(newCfg *model.Config) (*model.Config, *model.Config, error)
 	// Sometimes the config is received with "fake" data in sensitive fields. Apply the real
 	// data from the existing config as necessary.
 	desanitize(oldCfg, newCfg)
+	desanitizeSecretReferences(oldCfg, newCfg, oldSecretRefs)
 
 	// We apply back environment overrides since the input config may or
 	// may not have them applied.
 	newCfg = applyEnvironmentMap(newCfg, GetEnvironment())
 	fixConfig(newCfg)
 
+	// The input config may hold new secret references, the ones already resolved
+	// are restored below.
+	newSecretRefs := findSecretReferences(newCfg)
+	newCfg, err := applySecretReferences(newCfg, newSecretRefs)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to resolve secret references")
+	}
+
 	if err := newCfg.IsValid(); err != nil {
 		return nil, nil, errors.Wrap(err, "new configuration is invalid")
 	}
 
 	// We attempt to remove any environment override that may be present in the input config.
-	newCfgNoEnv := removeEnvOverrides(newCfg, oldCfgNoEnv, s.GetEnvironmentOverrides())
+	envOverrides := s.GetEnvironmentOverrides()
+	newCfgNoEnv := removeEnvOverrides(newCfg, oldCfgNoEnv, envOverrides)
+
+	// The secrets are never persisted, only the references to them are. The secrets
+	// left unchanged keep being read from their reference.
+	secretRefs := restoreSecretReferences(newCfgNoEnv, oldCfg, oldSecretRefs, envOverrides)
+	restoreSecretReferences(newCfgNoEnv, newCfg, newSecretRefs, envOverrides)
+	secretRefs = mergeSecretReferences(secretRefs, newSecretRefs)
 
 	// Don't store feature flags unless we are on MM cloud
 	// MM cloud uses config in the DB as a cache of the feature flag
@@ -228,6 +312,8 @@ func (s *Store) Set(newCfg *model.Config) (*model.Config, *model.Config, error)
 
 	s.configNoEnv = newCfgNoEnv
 	s.config = newCfg
+	s.secretRefs = secretRefs
+	s.watchSecretFiles()
 
 	newCfgCopy := newCfg.Clone()
 
@@ -291,6 +377,15 @@ func (s *Store) Load() error {
 
 	loadedCfg = applyEnvironmentMap(loadedCfg, GetEnvironment())
 	fixConfig(loadedCfg)
+
+	// The secret references are only resolved in the config in use, the one
+	// without the environment overrides is the one written back.
+	secretRefs := findSecretReferences(loadedCfg)
+	loadedCfg, err = applySecretReferences(loadedCfg, secretRefs)
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve secret references")
+	}
+
 	if appErr := loadedCfg.IsValid(); appErr != nil {
 		// Translating the error before displaying it in the console.
 		// Defaulting to english for server side language.
@@ -333,6 +428,8 @@ func (s *Store) Load() error {
 
 	s.config = loadedCfg
 	s.configNoEnv = loadedCfgNoEnv
+	s.secretRefs = secretRefs
+	s.watchSecretFiles()
 
 	loadedCfgCopy := loadedCfg.Clone()
 
@@ -345,6 +442,62 @@ func (s *Store) Load() error {
 	return nil
 }
 
+// watchSecretFiles watches the files the secret references point to, so that the
+// secrets are reloaded when they change. It must be called with the lock held.
+func (s *Store) watchSecretFiles() {
+	files := secretFiles(s.secretRefs)
+	if s.secretWatcher.watches(files) {
+		return
+	}
+
+	if err := s.secretWatcher.Close(); err != nil {
+		mlog.Warn("Failed to stop watching the secret files", mlog.Err(err))
+	}
+	s.secretWatcher = nil
+
+	if len(files) == 0 {
+		return
+	}
+
+	watcher, err := newSecretWatcher(files, s.reloadSecrets)
+	if err != nil {
+		mlog.Warn("Failed to watch the secret files, their changes require a restart", mlog.Err(err))
+		return
+	}
+	s.secretWatcher = watcher
+}
+
+// reloadSecrets resolves the secret references again, notifying the listeners when
+// a secret changed. Nothing is persisted since only the references are.
+func (s *Store) reloadSecrets() {
+	s.configLock.Lock()
+	defer s.configLock.Unlock()
+
+	if len(s.secretRefs) == 0 {
+		return
+	}
+
+	oldCfg := s.config
+	newCfg, err := applySecretReferences(oldCfg, s.secretRefs)
+	if err != nil {
+		// The file may be in the middle of its replacement, the secret in use is kept
+		// until it can be read again.
+		mlog.Warn("Failed to reload the secret references", mlog.Err(err))
+		return
+	}
+
+	hasChanged, err := equal(oldCfg, newCfg)
+	if err != nil || !hasChanged {
+		return
+	}
+
+	s.config = newCfg
+
+	s.configLock.Unlock()
+	s.invokeConfigListeners(oldCfg.Clone(), newCfg.Clone())
+	s.configLock.Lock()
+}
+
 // GetFile fetches the contents of a previously persisted configuration file.
 // If no such file exists, an empty byte array will be returned without error.
 func (s *Store) GetFile(name string) ([]byte, error) {
@@ -389,6 +542,10 @@ func (s *Store) String() string {
 func (s *Store) Close() error {
 	s.configLock.Lock()
 	defer s.configLock.Unlock()
+	if err := s.secretWatcher.Close(); err != nil {
+		mlog.Warn("Failed to stop watching the secret files", mlog.Err(err))
+	}
+	s.secretWatcher = nil
 	return s.backingStore.Close()
 }
 
@@ -89,6 +89,10 @@ func desanitize(actual, target *model.Config) {
 		*target.ServiceSettings.SplitKey = *actual.ServiceSettings.SplitKey
 	}
 
+	if *target.ExperimentalAuditSettings.StoreSigningKey == model.FakeSetting {
+		*target.ExperimentalAuditSettings.StoreSigningKey = *actual.ExperimentalAuditSettings.StoreSigningKey
+	}
+
 	for id, settings := range target.PluginSettings.Plugins {
 		for k, v := range settings {
 			if v == model.FakeSetting {
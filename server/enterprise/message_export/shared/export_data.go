@@ -446,7 +446,7 @@ func createdPostToExportEntry(post *model.MessageExport) PostExport {
 	}
 	return PostExport{
 		MessageExport: *post,
-		Message:       *post.PostMessage,
+		Message:       post.ExportedMessage(),
 		UserType:      userType,
 		PreviewsPost:  post.PreviewID(),
 	}
@@ -476,7 +476,7 @@ func editedOriginalMsgToExportEntry(post *model.MessageExport) PostExport {
 		MessageExport:  *post,
 		UpdateAt:       *post.PostUpdateAt,
 		UpdatedType:    EditedOriginalMsg,
-		Message:        *post.PostMessage,
+		Message:        post.ExportedMessage(),
 		UserType:       userType,
 		PreviewsPost:   post.PreviewID(),
 		EditedNewMsgId: *post.PostOriginalId,
@@ -492,7 +492,7 @@ func editedNewMsgToExportEntry(post *model.MessageExport) PostExport {
 		MessageExport: *post,
 		UpdateAt:      *post.PostUpdateAt,
 		UpdatedType:   EditedNewMsg,
-		Message:       *post.PostMessage,
+		Message:       post.ExportedMessage(),
 		UserType:      userType,
 		PreviewsPost:  post.PreviewID(),
 	}
@@ -507,7 +507,7 @@ func updatedPostToExportEntry(post *model.MessageExport) PostExport {
 		MessageExport: *post,
 		UpdateAt:      *post.PostUpdateAt,
 		UpdatedType:   UpdatedNoMsgChange,
-		Message:       *post.PostMessage,
+		Message:       post.ExportedMessage(),
 		UserType:      userType,
 		PreviewsPost:  post.PreviewID(),
 	}
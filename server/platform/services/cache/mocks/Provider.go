@@ -9,6 +9,8 @@ import (
 	cache "github.com/mattermost/mattermost/server/v8/platform/services/cache"
 
 	mock "github.com/stretchr/testify/mock"
+
+	throttled "github.com/throttled/throttled"
 )
 
 // Provider is an autogenerated mock type for the Provider type
@@ -92,6 +94,36 @@ func (_m *Provider) NewCache(opts *cache.CacheOptions) (cache.Cache, error) {
 	return r0, r1
 }
 
+// NewRateLimitStore provides a mock function with given fields: name, size
+func (_m *Provider) NewRateLimitStore(name string, size int) (throttled.GCRAStore, error) {
+	ret := _m.Called(name, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewRateLimitStore")
+	}
+
+	var r0 throttled.GCRAStore
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) (throttled.GCRAStore, error)); ok {
+		return rf(name, size)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) throttled.GCRAStore); ok {
+		r0 = rf(name, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(throttled.GCRAStore)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(name, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetMetrics provides a mock function with given fields: metrics
 func (_m *Provider) SetMetrics(metrics einterfaces.MetricsInterface) {
 	_m.Called(metrics)
@@ -5,12 +5,15 @@ package cache
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/v8/einterfaces"
 	"github.com/redis/rueidis"
+	"github.com/throttled/throttled"
+	"github.com/throttled/throttled/store/memstore"
 )
 
 // CacheOptions contains options for initializing a cache
@@ -38,6 +41,9 @@ type Provider interface {
 	Close() error
 	// Type returns what type of cache it generates.
 	Type() string
+	// NewRateLimitStore creates a store for rate limiters. Stores of a Redis provider are
+	// shared by all the nodes of a cluster, the others hold up to size keys in memory.
+	NewRateLimitStore(name string, size int) (throttled.GCRAStore, error)
 }
 
 type cacheProvider struct {
@@ -73,6 +79,11 @@ func (c *cacheProvider) Type() string {
 	return model.CacheTypeLRU
 }
 
+// NewRateLimitStore creates an in-memory store for rate limiters, local to this node.
+func (c *cacheProvider) NewRateLimitStore(name string, size int) (throttled.GCRAStore, error) {
+	return memstore.New(size)
+}
+
 type redisProvider struct {
 	client      rueidis.Client
 	cachePrefix string
@@ -139,6 +150,18 @@ func (r *redisProvider) Type() string {
 	return model.CacheTypeRedis
 }
 
+// NewRateLimitStore creates a store for rate limiters in Redis, shared by all the nodes of
+// the cluster. The size is ignored, keys expiring on their own.
+func (r *redisProvider) NewRateLimitStore(name string, size int) (throttled.GCRAStore, error) {
+	if name == "" {
+		return nil, errors.New("no name specified for rate limit store")
+	}
+	if r.cachePrefix != "" {
+		name = r.cachePrefix + ":" + name
+	}
+	return newRedisRateLimitStore(r.client, "ratelimit:"+name), nil
+}
+
 // Close releases any resources used by the cache provider.
 func (r *redisProvider) Close() error {
 	r.client.Close()
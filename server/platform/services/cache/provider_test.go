@@ -171,3 +171,34 @@ func TestConnectClose(t *testing.T) {
 	err = p.Close()
 	require.NoError(t, err)
 }
+
+func TestNewRateLimitStore(t *testing.T) {
+	p := NewProvider()
+
+	store, err := p.NewRateLimitStore("test", 10)
+	require.NoError(t, err)
+
+	value, _, err := store.GetWithTime("key")
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), value)
+
+	set, err := store.SetIfNotExistsWithTTL("key", 1, time.Minute)
+	require.NoError(t, err)
+	require.True(t, set)
+
+	set, err = store.SetIfNotExistsWithTTL("key", 2, time.Minute)
+	require.NoError(t, err)
+	require.False(t, set)
+
+	swapped, err := store.CompareAndSwapWithTTL("key", 2, 3, time.Minute)
+	require.NoError(t, err)
+	require.False(t, swapped)
+
+	swapped, err = store.CompareAndSwapWithTTL("key", 1, 3, time.Minute)
+	require.NoError(t, err)
+	require.True(t, swapped)
+
+	value, _, err = store.GetWithTime("key")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), value)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/rueidis"
+	"github.com/throttled/throttled"
+)
+
+// redisCompareAndSwapScript atomically replaces the value of a key, if it still holds the
+// expected one. Missing keys aren't created, as required by throttled.GCRAStore.
+var redisCompareAndSwapScript = rueidis.NewLuaScript(`
+local v = redis.call('get', KEYS[1])
+if v == false or v ~= ARGV[1] then
+  return 0
+end
+redis.call('set', KEYS[1], ARGV[2], 'PX', ARGV[3])
+return 1
+`)
+
+// redisRateLimitStore is a throttled.GCRAStore backed by Redis, letting the rate limiters
+// of all the nodes of a cluster share their state. Times are read from the Redis server,
+// so that the nodes share the same clock too.
+type redisRateLimitStore struct {
+	client rueidis.Client
+	prefix string
+}
+
+func newRedisRateLimitStore(client rueidis.Client, name string) *redisRateLimitStore {
+	return &redisRateLimitStore{
+		client: client,
+		prefix: name + ":",
+	}
+}
+
+// ttlMilliseconds converts a TTL to the milliseconds Redis expects, which must be positive.
+func ttlMilliseconds(ttl time.Duration) int64 {
+	return max(ttl.Milliseconds(), 1)
+}
+
+func (s *redisRateLimitStore) GetWithTime(key string) (int64, time.Time, error) {
+	resps := s.client.DoMulti(context.Background(),
+		s.client.B().Time().Build(),
+		s.client.B().Get().Key(s.prefix+key).Build(),
+	)
+
+	now, err := resps[0].AsStrSlice()
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("failed to get the time of the rate limit store: %w", err)
+	}
+	if len(now) != 2 {
+		return 0, time.Time{}, errors.New("unexpected reply to TIME from the rate limit store")
+	}
+	seconds, err := strconv.ParseInt(now[0], 10, 64)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("invalid time from the rate limit store: %w", err)
+	}
+	microseconds, err := strconv.ParseInt(now[1], 10, 64)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("invalid time from the rate limit store: %w", err)
+	}
+	t := time.Unix(seconds, microseconds*int64(time.Microsecond))
+
+	value, err := resps[1].AsInt64()
+	if rueidis.IsRedisNil(err) {
+		return -1, t, nil
+	} else if err != nil {
+		return 0, time.Time{}, fmt.Errorf("failed to get key %q from the rate limit store: %w", key, err)
+	}
+
+	return value, t, nil
+}
+
+func (s *redisRateLimitStore) SetIfNotExistsWithTTL(key string, value int64, ttl time.Duration) (bool, error) {
+	err := s.client.Do(context.Background(),
+		s.client.B().Set().
+			Key(s.prefix+key).
+			Value(strconv.FormatInt(value, 10)).
+			Nx().
+			PxMilliseconds(ttlMilliseconds(ttl)).
+			Build(),
+	).Error()
+	if rueidis.IsRedisNil(err) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to set key %q in the rate limit store: %w", key, err)
+	}
+
+	return true, nil
+}
+
+func (s *redisRateLimitStore) CompareAndSwapWithTTL(key string, old, new int64, ttl time.Duration) (bool, error) {
+	swapped, err := redisCompareAndSwapScript.Exec(context.Background(), s.client,
+		[]string{s.prefix + key},
+		[]string{strconv.FormatInt(old, 10), strconv.FormatInt(new, 10), strconv.FormatInt(ttlMilliseconds(ttl), 10)},
+	).AsInt64()
+	if err != nil {
+		return false, fmt.Errorf("failed to swap key %q in the rate limit store: %w", key, err)
+	}
+
+	return swapped == 1, nil
+}
+
+var _ throttled.GCRAStore = (*redisRateLimitStore)(nil)
@@ -30,6 +30,8 @@ func ExtractWithExtraExtractors(logger mlog.LoggerIFace, filename string, r io.R
 		enabledExtractors.Add(extraExtractor)
 	}
 	enabledExtractors.Add(&documentExtractor{})
+	enabledExtractors.Add(&spreadsheetExtractor{})
+	enabledExtractors.Add(&epubExtractor{})
 	enabledExtractors.Add(&pdfExtractor{})
 
 	if settings.ArchiveRecursion {
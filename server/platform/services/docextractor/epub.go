@@ -0,0 +1,147 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package docextractor
+
+import (
+	"archive/zip"
+	"errors"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+type epubExtractor struct{}
+
+func (ee *epubExtractor) Name() string {
+	return "epubExtractor"
+}
+
+func (ee *epubExtractor) Match(filename string) bool {
+	return strings.ToLower(path.Ext(filename)) == ".epub"
+}
+
+type epubContainer struct {
+	Rootfiles []struct {
+		FullPath string `xml:"full-path,attr"`
+	} `xml:"rootfiles>rootfile"`
+}
+
+type epubPackage struct {
+	Titles   []string `xml:"metadata>title"`
+	Creators []string `xml:"metadata>creator"`
+	Manifest []struct {
+		ID        string `xml:"id,attr"`
+		Href      string `xml:"href,attr"`
+		MediaType string `xml:"media-type,attr"`
+	} `xml:"manifest>item"`
+	Spine []struct {
+		IDRef string `xml:"idref,attr"`
+	} `xml:"spine>itemref"`
+}
+
+// Extract returns the book metadata followed by the text of every document
+// in the reading order defined by the spine.
+func (ee *epubExtractor) Extract(filename string, r io.ReadSeeker) (string, error) {
+	zr, err := openZip(r)
+	if err != nil {
+		return "", err
+	}
+
+	var container epubContainer
+	if err = decodeZipPart(zr, "META-INF/container.xml", &container); err != nil {
+		return "", err
+	}
+	if len(container.Rootfiles) == 0 {
+		return "", errors.New("epub container has no rootfile")
+	}
+	packagePath := container.Rootfiles[0].FullPath
+
+	var pkg epubPackage
+	if err = decodeZipPart(zr, packagePath, &pkg); err != nil {
+		return "", err
+	}
+
+	var text boundedText
+	for _, s := range append(pkg.Titles, pkg.Creators...) {
+		if err = text.write(strings.TrimSpace(s) + "\n"); err != nil {
+			return text.result(err)
+		}
+	}
+
+	hrefs := make(map[string]string, len(pkg.Manifest))
+	for _, item := range pkg.Manifest {
+		if item.MediaType == "application/xhtml+xml" || item.MediaType == "text/html" {
+			hrefs[item.ID] = item.Href
+		}
+	}
+
+	baseDir := path.Dir(packagePath)
+	for _, itemRef := range pkg.Spine {
+		href, ok := hrefs[itemRef.IDRef]
+		if !ok {
+			continue
+		}
+		if unescaped, unescapeErr := url.PathUnescape(href); unescapeErr == nil {
+			href = unescaped
+		}
+		if err = extractEPUBDocument(zr, path.Join(baseDir, href), &text); err != nil {
+			break
+		}
+	}
+	return text.result(err)
+}
+
+func extractEPUBDocument(zr *zip.Reader, name string, text *boundedText) error {
+	rc, err := openZipPart(zr, name)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	tokenizer := html.NewTokenizer(rc)
+	skip := 0
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			if tokenizer.Err() == io.EOF {
+				return text.write("\n")
+			}
+			return tokenizer.Err()
+		case html.StartTagToken:
+			name, _ := tokenizer.TagName()
+			switch string(name) {
+			case "script", "style", "head":
+				skip++
+			case "p", "div", "br", "li", "h1", "h2", "h3", "h4", "h5", "h6", "tr":
+				if err := text.write("\n"); err != nil {
+					return err
+				}
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			switch string(name) {
+			case "script", "style", "head":
+				if skip > 0 {
+					skip--
+				}
+			case "td", "th":
+				if err := text.write(" "); err != nil {
+					return err
+				}
+			}
+		case html.TextToken:
+			if skip > 0 {
+				continue
+			}
+			if s := strings.TrimSpace(string(tokenizer.Text())); s != "" {
+				if err := text.write(s + " "); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
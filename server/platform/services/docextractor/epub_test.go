@@ -0,0 +1,65 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package docextractor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractEPUB(t *testing.T) {
+	extractor := epubExtractor{}
+	require.True(t, extractor.Match("manual.epub"))
+	require.False(t, extractor.Match("manual.pdf"))
+
+	files := map[string]string{
+		"mimetype": "application/epub+zip",
+		"META-INF/container.xml": `<?xml version="1.0"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+  <rootfiles>
+    <rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/>
+  </rootfiles>
+</container>`,
+		"OEBPS/content.opf": `<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0">
+  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+    <dc:title>Warehouse Operations Manual</dc:title>
+    <dc:creator>Logistics Team</dc:creator>
+  </metadata>
+  <manifest>
+    <item id="ch2" href="text/chapter%202.xhtml" media-type="application/xhtml+xml"/>
+    <item id="ch1" href="text/chapter1.xhtml" media-type="application/xhtml+xml"/>
+    <item id="css" href="style.css" media-type="text/css"/>
+  </manifest>
+  <spine>
+    <itemref idref="ch1"/>
+    <itemref idref="css"/>
+    <itemref idref="ch2"/>
+  </spine>
+</package>`,
+		"OEBPS/text/chapter1.xhtml": `<html><head><title>Ignored title</title><style>p { color: red; }</style></head>
+<body><h1>Receiving</h1><p>Check every <b>pallet</b> on arrival.</p><script>alert(1)</script></body></html>`,
+		"OEBPS/text/chapter 2.xhtml": `<html><body><h1>Shipping</h1><table><tr><td>Carrier</td><td>Zone</td></tr></table></body></html>`,
+		"OEBPS/style.css":            `p { color: red; }`,
+	}
+
+	text, err := extractor.Extract("manual.epub", makeZip(t, files))
+	require.NoError(t, err)
+	require.Contains(t, text, "Warehouse Operations Manual")
+	require.Contains(t, text, "Logistics Team")
+	require.Contains(t, text, "Receiving")
+	require.Contains(t, text, "Check every pallet on arrival.")
+	require.Contains(t, text, "Carrier")
+	require.NotContains(t, text, "Ignored title")
+	require.NotContains(t, text, "color")
+	require.NotContains(t, text, "alert")
+	require.Less(t, strings.Index(text, "Receiving"), strings.Index(text, "Shipping"), "spine order should be respected")
+
+	t.Run("missing container", func(t *testing.T) {
+		_, err := extractor.Extract("manual.epub", makeZip(t, map[string]string{"mimetype": "application/epub+zip"}))
+		require.Error(t, err)
+	})
+}
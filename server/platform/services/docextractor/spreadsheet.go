@@ -0,0 +1,442 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package docextractor
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// maxExtractedTextSize bounds the amount of text produced by the
+	// spreadsheet and ebook extractors. Anything beyond this is truncated
+	// before being stored anyway.
+	maxExtractedTextSize = 1024 * 1024 // 1MB
+	// maxZipPartSize bounds the uncompressed size read from a single part
+	// of a zip based document, to protect against decompression bombs.
+	maxZipPartSize = 64 * 1024 * 1024 // 64MB
+	// maxCSVSize bounds the amount of data read from a CSV file.
+	maxCSVSize = 64 * 1024 * 1024 // 64MB
+)
+
+var errTextLimitReached = errors.New("extracted text limit reached")
+
+// boundedText accumulates extracted text up to maxExtractedTextSize.
+type boundedText struct {
+	strings.Builder
+}
+
+func (b *boundedText) write(s string) error {
+	if b.Len()+len(s) > maxExtractedTextSize {
+		end := maxExtractedTextSize - b.Len()
+		for end > 0 && !utf8.RuneStart(s[end]) {
+			end--
+		}
+		b.WriteString(s[:end])
+		return errTextLimitReached
+	}
+	b.WriteString(s)
+	return nil
+}
+
+// result returns the accumulated text, turning a reached limit into a
+// successful, truncated extraction.
+func (b *boundedText) result(err error) (string, error) {
+	if err != nil && !errors.Is(err, errTextLimitReached) {
+		return "", err
+	}
+	return strings.TrimSpace(b.String()), nil
+}
+
+func openZip(r io.ReadSeeker) (*zip.Reader, error) {
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	readerAt, ok := r.(io.ReaderAt)
+	if !ok {
+		return nil, errors.New("reader does not support random access")
+	}
+	return zip.NewReader(readerAt, size)
+}
+
+func findZipFile(zr *zip.Reader, name string) *zip.File {
+	for _, f := range zr.File {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// openZipPart opens the named part of a zip based document, bounding the
+// amount of uncompressed data that can be read from it.
+func openZipPart(zr *zip.Reader, name string) (io.ReadCloser, error) {
+	f := findZipFile(zr, name)
+	if f == nil {
+		return nil, fmt.Errorf("missing document part %q", name)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(rc, maxZipPartSize), rc}, nil
+}
+
+func decodeZipPart(zr *zip.Reader, name string, v any) error {
+	rc, err := openZipPart(zr, name)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	return xml.NewDecoder(rc).Decode(v)
+}
+
+func attr(se xml.StartElement, local string) string {
+	for _, a := range se.Attr {
+		if a.Name.Local == local {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+type spreadsheetExtractor struct{}
+
+var spreadsheetExtractorByExtension = map[string]func(io.ReadSeeker) (string, error){
+	"xlsx": extractXLSX,
+	"ods":  extractODS,
+	"csv":  extractCSV,
+}
+
+func (se *spreadsheetExtractor) Name() string {
+	return "spreadsheetExtractor"
+}
+
+func (se *spreadsheetExtractor) Match(filename string) bool {
+	extension := strings.ToLower(strings.TrimPrefix(path.Ext(filename), "."))
+	_, ok := spreadsheetExtractorByExtension[extension]
+	return ok
+}
+
+func (se *spreadsheetExtractor) Extract(filename string, r io.ReadSeeker) (string, error) {
+	extension := strings.ToLower(strings.TrimPrefix(path.Ext(filename), "."))
+	extract, ok := spreadsheetExtractorByExtension[extension]
+	if !ok {
+		return "", errors.New("unknown spreadsheet format")
+	}
+	return extract(r)
+}
+
+// extractCSV returns the records of a CSV file, one per line, with the
+// fields separated by tabs.
+func extractCSV(r io.ReadSeeker) (string, error) {
+	reader := csv.NewReader(io.LimitReader(r, maxCSVSize))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.ReuseRecord = true
+
+	var text boundedText
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return text.result(err)
+		}
+		if err := text.write(strings.Join(record, "\t") + "\n"); err != nil {
+			return text.result(err)
+		}
+	}
+	return text.result(nil)
+}
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		Name string `xml:"name,attr"`
+		RID  string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+// extractXLSX returns the text of every sheet of an Office Open XML
+// workbook, prefixed by the sheet name. Cells are separated by tabs and rows
+// by new lines.
+func extractXLSX(r io.ReadSeeker) (string, error) {
+	zr, err := openZip(r)
+	if err != nil {
+		return "", err
+	}
+
+	sharedStrings, err := readXLSXSharedStrings(zr)
+	if err != nil {
+		return "", err
+	}
+
+	var workbook xlsxWorkbook
+	if err = decodeZipPart(zr, "xl/workbook.xml", &workbook); err != nil {
+		return "", err
+	}
+	var rels xlsxRelationships
+	if err = decodeZipPart(zr, "xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return "", err
+	}
+	targets := make(map[string]string, len(rels.Relationships))
+	for _, rel := range rels.Relationships {
+		target := rel.Target
+		if strings.HasPrefix(target, "/") {
+			target = strings.TrimPrefix(target, "/")
+		} else {
+			target = path.Join("xl", target)
+		}
+		targets[rel.ID] = target
+	}
+
+	var text boundedText
+	for _, sheet := range workbook.Sheets {
+		target, ok := targets[sheet.RID]
+		if !ok {
+			continue
+		}
+		if err = text.write(sheet.Name + "\n"); err != nil {
+			break
+		}
+		if err = extractXLSXSheet(zr, target, sharedStrings, &text); err != nil {
+			break
+		}
+	}
+	return text.result(err)
+}
+
+func readXLSXSharedStrings(zr *zip.Reader) ([]string, error) {
+	if findZipFile(zr, "xl/sharedStrings.xml") == nil {
+		return nil, nil
+	}
+	rc, err := openZipPart(zr, "xl/sharedStrings.xml")
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var (
+		sharedStrings []string
+		current       strings.Builder
+		inText        bool
+		size          int
+	)
+	decoder := xml.NewDecoder(rc)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return sharedStrings, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "si":
+				current.Reset()
+			case "t":
+				inText = true
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "si":
+				// Strings beyond the text limit can never be written out.
+				if size < maxExtractedTextSize {
+					sharedStrings = append(sharedStrings, current.String())
+					size += current.Len()
+				} else {
+					sharedStrings = append(sharedStrings, "")
+				}
+			case "t":
+				inText = false
+			}
+		case xml.CharData:
+			if inText {
+				current.Write(t)
+			}
+		}
+	}
+}
+
+func extractXLSXSheet(zr *zip.Reader, name string, sharedStrings []string, text *boundedText) error {
+	rc, err := openZipPart(zr, name)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	var (
+		cellType   string
+		value      strings.Builder
+		inValue    bool
+		rowHasText bool
+	)
+	decoder := xml.NewDecoder(rc)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "row":
+				rowHasText = false
+			case "c":
+				cellType = attr(t, "t")
+				value.Reset()
+			case "v", "t":
+				inValue = true
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "v", "t":
+				inValue = false
+			case "c":
+				cell := value.String()
+				if cellType == "s" {
+					index, convErr := strconv.Atoi(cell)
+					if convErr != nil || index < 0 || index >= len(sharedStrings) {
+						continue
+					}
+					cell = sharedStrings[index]
+				}
+				if cell == "" {
+					continue
+				}
+				if rowHasText {
+					cell = "\t" + cell
+				}
+				rowHasText = true
+				if err := text.write(cell); err != nil {
+					return err
+				}
+			case "row":
+				if rowHasText {
+					if err := text.write("\n"); err != nil {
+						return err
+					}
+				}
+			}
+		case xml.CharData:
+			if inValue {
+				value.Write(t)
+			}
+		}
+	}
+}
+
+// extractODS returns the text of every table of an OpenDocument
+// spreadsheet, prefixed by the table name. Cells are separated by tabs and
+// rows by new lines.
+func extractODS(r io.ReadSeeker) (string, error) {
+	zr, err := openZip(r)
+	if err != nil {
+		return "", err
+	}
+	rc, err := openZipPart(zr, "content.xml")
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	var (
+		text       boundedText
+		cell       strings.Builder
+		inCell     bool
+		paragraphs int
+		rowHasText bool
+	)
+	decoder := xml.NewDecoder(rc)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return text.result(nil)
+		}
+		if err != nil {
+			return text.result(err)
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "table":
+				if err := text.write(attr(t, "name") + "\n"); err != nil {
+					return text.result(err)
+				}
+			case "table-row":
+				rowHasText = false
+			case "table-cell", "covered-table-cell":
+				inCell = true
+				paragraphs = 0
+				cell.Reset()
+			case "p":
+				if inCell && paragraphs > 0 {
+					cell.WriteString(" ")
+				}
+				paragraphs++
+			case "s", "tab", "line-break":
+				// Spacing elements have no character data of their own.
+				if inCell {
+					cell.WriteString(" ")
+				}
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "table-cell", "covered-table-cell":
+				inCell = false
+				// Repeated cells are written once: they only inflate the
+				// text without adding anything searchable.
+				value := strings.TrimSpace(cell.String())
+				if value == "" {
+					continue
+				}
+				if rowHasText {
+					value = "\t" + value
+				}
+				rowHasText = true
+				if err := text.write(value); err != nil {
+					return text.result(err)
+				}
+			case "table-row":
+				if rowHasText {
+					if err := text.write("\n"); err != nil {
+						return text.result(err)
+					}
+				}
+			}
+		case xml.CharData:
+			if inCell {
+				cell.Write(t)
+			}
+		}
+	}
+}
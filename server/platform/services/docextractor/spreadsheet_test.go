@@ -0,0 +1,146 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package docextractor
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func makeZip(t *testing.T, files map[string]string) *bytes.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestSpreadsheetExtractorMatch(t *testing.T) {
+	extractor := spreadsheetExtractor{}
+	require.True(t, extractor.Match("budget.xlsx"))
+	require.True(t, extractor.Match("budget.ODS"))
+	require.True(t, extractor.Match("export.csv"))
+	require.False(t, extractor.Match("budget.xls"))
+	require.False(t, extractor.Match("notes.txt"))
+}
+
+func TestExtractCSV(t *testing.T) {
+	extractor := spreadsheetExtractor{}
+
+	t.Run("records", func(t *testing.T) {
+		content := "name,amount\n\"Nguyen, Van A\",100\nshort\n"
+		text, err := extractor.Extract("export.csv", strings.NewReader(content))
+		require.NoError(t, err)
+		require.Equal(t, "name\tamount\nNguyen, Van A\t100\nshort", text)
+	})
+
+	t.Run("lazy quotes", func(t *testing.T) {
+		text, err := extractor.Extract("export.csv", strings.NewReader("a \"quoted\" value,b\n"))
+		require.NoError(t, err)
+		require.Equal(t, "a \"quoted\" value\tb", text)
+	})
+
+	t.Run("bounded", func(t *testing.T) {
+		content := strings.Repeat("invoice,12345678\n", maxExtractedTextSize/8)
+		text, err := extractor.Extract("export.csv", strings.NewReader(content))
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(text), maxExtractedTextSize)
+		require.True(t, strings.HasPrefix(text, "invoice\t12345678\n"))
+	})
+}
+
+func TestExtractXLSX(t *testing.T) {
+	extractor := spreadsheetExtractor{}
+
+	files := map[string]string{
+		"xl/workbook.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+  <sheets>
+    <sheet name="Revenue" sheetId="1" r:id="rId1"/>
+    <sheet name="Costs" sheetId="2" r:id="rId2"/>
+  </sheets>
+</workbook>`,
+		"xl/_rels/workbook.xml.rels": `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+  <Relationship Id="rId1" Type="worksheet" Target="worksheets/sheet1.xml"/>
+  <Relationship Id="rId2" Type="worksheet" Target="/xl/worksheets/sheet2.xml"/>
+</Relationships>`,
+		"xl/sharedStrings.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+  <si><t>Quarter</t></si>
+  <si><r><t>Total </t></r><r><t>revenue</t></r></si>
+  <si><t>Hanoi office</t></si>
+</sst>`,
+		"xl/worksheets/sheet1.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+  <sheetData>
+    <row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c></row>
+    <row r="2"><c r="A2"><v>1</v></c><c r="B2"><v>1500.5</v></c></row>
+    <row r="3"><c r="A3" t="inlineStr"><is><t>inline note</t></is></c><c r="B3" t="s"><v>99</v></c></row>
+    <row r="4"></row>
+  </sheetData>
+</worksheet>`,
+		"xl/worksheets/sheet2.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+  <sheetData>
+    <row r="1"><c r="A1" t="s"><v>2</v></c><c r="B1" t="str"><f>SUM(A1)</f><v>42</v></c></row>
+  </sheetData>
+</worksheet>`,
+	}
+
+	text, err := extractor.Extract("finance.xlsx", makeZip(t, files))
+	require.NoError(t, err)
+	require.Equal(t, "Revenue\nQuarter\tTotal revenue\n1\t1500.5\ninline note\nCosts\nHanoi office\t42", text)
+
+	t.Run("not a zip", func(t *testing.T) {
+		_, err := extractor.Extract("finance.xlsx", strings.NewReader("not a workbook"))
+		require.Error(t, err)
+	})
+}
+
+func TestExtractODS(t *testing.T) {
+	extractor := spreadsheetExtractor{}
+
+	files := map[string]string{
+		"content.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<office:document-content xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" xmlns:table="urn:oasis:names:tc:opendocument:xmlns:table:1.0" xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0">
+  <office:body>
+    <office:spreadsheet>
+      <table:table table:name="Budget">
+        <table:table-row>
+          <table:table-cell><text:p>Item</text:p></table:table-cell>
+          <table:table-cell table:number-columns-repeated="1000"/>
+          <table:table-cell><text:p>Amount</text:p></table:table-cell>
+        </table:table-row>
+        <table:table-row>
+          <table:table-cell><text:p>Travel</text:p><text:p>to Da<text:s/>Nang</text:p></table:table-cell>
+          <table:table-cell office:value-type="float" office:value="200"><text:p>200</text:p></table:table-cell>
+        </table:table-row>
+        <table:table-row table:number-rows-repeated="1048576"><table:table-cell/></table:table-row>
+      </table:table>
+    </office:spreadsheet>
+  </office:body>
+</office:document-content>`,
+	}
+
+	text, err := extractor.Extract("budget.ods", makeZip(t, files))
+	require.NoError(t, err)
+	require.Equal(t, "Budget\nItem\tAmount\nTravel to Da Nang\t200", text)
+
+	t.Run("missing content", func(t *testing.T) {
+		_, err := extractor.Extract("budget.ods", makeZip(t, map[string]string{"mimetype": "application/vnd.oasis.opendocument.spreadsheet"}))
+		require.Error(t, err)
+	})
+}
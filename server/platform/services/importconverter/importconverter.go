@@ -0,0 +1,563 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package importconverter holds the pieces shared by the converters that turn
+// exports from other products into bulk import archives, which are then
+// processed by the import_process job like any other bulk import.
+package importconverter
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/app/imports"
+	"github.com/mattermost/mattermost/server/v8/channels/utils"
+)
+
+// JSONLName is the name of the JSONL file within the converted archive.
+const JSONLName = "import.jsonl"
+
+// Reasons used in SkippedItem.
+const (
+	SkipReasonParseError         = "parse_error"
+	SkipReasonUnknownUser        = "unknown_user"
+	SkipReasonUnsupportedType    = "unsupported_type"
+	SkipReasonDeletedMessage     = "deleted_message"
+	SkipReasonEmptyMessage       = "empty_message"
+	SkipReasonMessageTruncated   = "message_truncated"
+	SkipReasonMissingFile        = "missing_file"
+	SkipReasonFileTooLarge       = "file_too_large"
+	SkipReasonMissingThreadRoot  = "missing_thread_root"
+	SkipReasonInvalidMembers     = "invalid_members"
+	SkipReasonInvalidEmojiName   = "invalid_emoji_name"
+	SkipReasonPropertyTruncated  = "property_truncated"
+	SkipReasonUnsupportedChannel = "unsupported_channel"
+)
+
+// Options configures the conversion of an export.
+type Options struct {
+	// Team is the name of the team channels are imported into. The team
+	// must exist before the import runs.
+	Team string
+	// MaxFileSize is the size above which attachments are skipped. Zero
+	// means attachments aren't limited by the converter.
+	MaxFileSize int64
+}
+
+// ConvertFunc converts the export read from zipReader into a bulk import
+// archive written to w.
+type ConvertFunc func(rctx request.CTX, zipReader *zip.Reader, opts Options, w io.Writer) (*Report, *model.AppError)
+
+// SkippedItem describes a part of an export that couldn't be carried over to
+// the bulk import, either in full or in part.
+type SkippedItem struct {
+	Type    string `json:"type"`
+	Channel string `json:"channel,omitempty"`
+	Id      string `json:"id,omitempty"`
+	Reason  string `json:"reason"`
+}
+
+// Report summarises the result of a conversion.
+type Report struct {
+	Channels       int           `json:"channels"`
+	DirectChannels int           `json:"direct_channels"`
+	Users          int           `json:"users"`
+	Posts          int           `json:"posts"`
+	Replies        int           `json:"replies"`
+	Reactions      int           `json:"reactions"`
+	Attachments    int           `json:"attachments"`
+	Skipped        []SkippedItem `json:"skipped"`
+}
+
+// NewReport returns an empty report.
+func NewReport() *Report {
+	return &Report{Skipped: []SkippedItem{}}
+}
+
+// Skip records an item that couldn't be converted.
+func (r *Report) Skip(itemType, channel, id, reason string) {
+	r.Skipped = append(r.Skipped, SkippedItem{Type: itemType, Channel: channel, Id: id, Reason: reason})
+}
+
+// Truncate returns value cut down to limit runes, recording the truncation.
+func (r *Report) Truncate(property, channel, id, value string, limit int) string {
+	if utf8.RuneCountInString(value) <= limit {
+		return value
+	}
+	r.Skip(property, channel, id, SkipReasonPropertyTruncated)
+	return truncateRunes(value, limit)
+}
+
+func truncateRunes(s string, i int) string {
+	runes := []rune(s)
+	if len(runes) > i {
+		return string(runes[:i])
+	}
+	return s
+}
+
+var invalidChannelNameCharacters = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// ChannelName derives a channel name from a display name, falling back to the
+// lowercased fallback when the display name has nothing usable.
+func ChannelName(displayName, fallback string) string {
+	name := strings.Trim(invalidChannelNameCharacters.ReplaceAllString(strings.ToLower(displayName), "-"), "-_")
+	if len(name) < 2 {
+		name = invalidChannelNameCharacters.ReplaceAllString(strings.ToLower(fallback), "-")
+	}
+	if len(name) > model.ChannelNameMaxLength {
+		name = name[:model.ChannelNameMaxLength]
+	}
+	return name
+}
+
+// Message is a message converted to the shape shared by posts, direct posts
+// and replies of the bulk import format.
+type Message struct {
+	// Id and ParentId are the identifiers used by the export, and are only
+	// used to rebuild threads.
+	Id       string
+	ParentId string
+
+	User        string
+	Type        string
+	Message     string
+	Props       model.StringInterface
+	CreateAt    int64
+	EditAt      int64
+	IsPinned    bool
+	Reactions   []imports.ReactionImportData
+	Attachments []imports.AttachmentImportData
+
+	replies []*Message
+}
+
+// Reaction returns the reaction of user to the message. Exports don't
+// usually tell when a reaction was added, so it's attributed to the time
+// of the message.
+func (m *Message) Reaction(user, emojiName string) imports.ReactionImportData {
+	return imports.ReactionImportData{
+		User:      model.NewPointer(user),
+		EmojiName: model.NewPointer(emojiName),
+		CreateAt:  model.NewPointer(m.CreateAt),
+	}
+}
+
+func (m *Message) isEmpty() bool {
+	return m.Message == "" && m.Type == "" && len(m.Attachments) == 0 && m.Props[model.PostPropsAttachments] == nil
+}
+
+func (m *Message) fields() (*string, *model.StringInterface, *int64, *[]imports.ReactionImportData, *[]imports.AttachmentImportData, *bool) {
+	var (
+		postType    *string
+		props       *model.StringInterface
+		editAt      *int64
+		reactions   *[]imports.ReactionImportData
+		attachments *[]imports.AttachmentImportData
+		isPinned    *bool
+	)
+	if m.Type != "" {
+		postType = model.NewPointer(m.Type)
+	}
+	if len(m.Props) > 0 {
+		props = &m.Props
+	}
+	if m.EditAt > 0 {
+		editAt = model.NewPointer(m.EditAt)
+	}
+	if len(m.Reactions) > 0 {
+		reactions = &m.Reactions
+	}
+	if len(m.Attachments) > 0 {
+		attachments = &m.Attachments
+	}
+	if m.IsPinned {
+		isPinned = model.NewPointer(true)
+	}
+	return postType, props, editAt, reactions, attachments, isPinned
+}
+
+func (m *Message) toReplies() *[]imports.ReplyImportData {
+	if len(m.replies) == 0 {
+		return nil
+	}
+	replies := make([]imports.ReplyImportData, 0, len(m.replies))
+	for _, reply := range m.replies {
+		postType, props, editAt, reactions, attachments, isPinned := reply.fields()
+		replies = append(replies, imports.ReplyImportData{
+			User:        model.NewPointer(reply.User),
+			Type:        postType,
+			Message:     model.NewPointer(reply.Message),
+			Props:       props,
+			CreateAt:    model.NewPointer(reply.CreateAt),
+			EditAt:      editAt,
+			Reactions:   reactions,
+			Attachments: attachments,
+			IsPinned:    isPinned,
+		})
+	}
+	return &replies
+}
+
+func (m *Message) toPost(team, channel string) *imports.PostImportData {
+	postType, props, editAt, reactions, attachments, isPinned := m.fields()
+	return &imports.PostImportData{
+		Team:        model.NewPointer(team),
+		Channel:     model.NewPointer(channel),
+		User:        model.NewPointer(m.User),
+		Type:        postType,
+		Message:     model.NewPointer(m.Message),
+		Props:       props,
+		CreateAt:    model.NewPointer(m.CreateAt),
+		EditAt:      editAt,
+		Reactions:   reactions,
+		Replies:     m.toReplies(),
+		Attachments: attachments,
+		IsPinned:    isPinned,
+	}
+}
+
+func (m *Message) toDirectPost(members []string) *imports.DirectPostImportData {
+	postType, props, editAt, reactions, attachments, isPinned := m.fields()
+	return &imports.DirectPostImportData{
+		ChannelMembers: &members,
+		User:           model.NewPointer(m.User),
+		Type:           postType,
+		Message:        model.NewPointer(m.Message),
+		Props:          props,
+		CreateAt:       model.NewPointer(m.CreateAt),
+		EditAt:         editAt,
+		Reactions:      reactions,
+		Replies:        m.toReplies(),
+		Attachments:    attachments,
+		IsPinned:       isPinned,
+	}
+}
+
+// Channel is a public or private channel to import.
+type Channel struct {
+	Id          string
+	Name        string
+	DisplayName string
+	Type        model.ChannelType
+	Header      string
+	Purpose     string
+}
+
+// User is a user to import, along with the channels they are a member of.
+type User struct {
+	Username  string
+	Email     string
+	FirstName string
+	LastName  string
+	Position  string
+	Deleted   bool
+	Channels  []imports.UserChannelImportData
+}
+
+// ChannelMembership returns the membership of a user to the named channel.
+func ChannelMembership(channel string, admin bool) imports.UserChannelImportData {
+	roles := model.ChannelUserRoleId
+	if admin {
+		roles += " " + model.ChannelAdminRoleId
+	}
+	return imports.UserChannelImportData{
+		Name:  model.NewPointer(channel),
+		Roles: model.NewPointer(roles),
+	}
+}
+
+// lineTypes is the order lines are written in. The bulk import processes
+// lines by type, so everything a line refers to must come before it.
+var lineTypes = []string{"channel", "user", "post", "direct_channel", "direct_post"}
+
+// Writer writes a bulk import archive. Attachments are written as they are
+// added while lines are held until Close, so that they can be ordered by
+// type.
+type Writer struct {
+	archive   *zip.Writer
+	generator string
+	opts      Options
+	report    *Report
+	lines     map[string][]*imports.LineImportData
+	copied    map[string]string
+}
+
+// NewWriter returns a Writer writing the archive to w. The generator is
+// recorded in the version line of the archive.
+func NewWriter(w io.Writer, generator string, opts Options, report *Report) *Writer {
+	return &Writer{
+		archive:   zip.NewWriter(w),
+		generator: generator,
+		opts:      opts,
+		report:    report,
+		lines:     make(map[string][]*imports.LineImportData),
+		copied:    make(map[string]string),
+	}
+}
+
+func (w *Writer) add(line *imports.LineImportData) {
+	w.lines[line.Type] = append(w.lines[line.Type], line)
+}
+
+// AddChannel adds a public or private channel to the team, returning its
+// name.
+func (w *Writer) AddChannel(channel Channel) string {
+	name := channel.Name
+	w.add(&imports.LineImportData{
+		Type: "channel",
+		Channel: &imports.ChannelImportData{
+			Team:        model.NewPointer(w.opts.Team),
+			Name:        model.NewPointer(name),
+			DisplayName: model.NewPointer(w.report.Truncate("channel_display_name", name, channel.Id, channel.DisplayName, model.ChannelDisplayNameMaxRunes)),
+			Type:        model.NewPointer(channel.Type),
+			Header:      model.NewPointer(w.report.Truncate("channel_header", name, channel.Id, channel.Header, model.ChannelHeaderMaxRunes)),
+			Purpose:     model.NewPointer(w.report.Truncate("channel_purpose", name, channel.Id, channel.Purpose, model.ChannelPurposeMaxRunes)),
+		},
+	})
+	w.report.Channels++
+	return name
+}
+
+// AddUser adds a user as a member of the team.
+func (w *Writer) AddUser(user User) {
+	email := user.Email
+	if email == "" {
+		// Same placeholder as the Slack importer, the user is expected to
+		// update it once logged in.
+		email = user.Username + "@example.com"
+	}
+
+	data := &imports.UserImportData{
+		Username:  model.NewPointer(user.Username),
+		Email:     model.NewPointer(strings.ToLower(email)),
+		FirstName: model.NewPointer(user.FirstName),
+		LastName:  model.NewPointer(user.LastName),
+		Position:  model.NewPointer(user.Position),
+		Teams: &[]imports.UserTeamImportData{{
+			Name:     model.NewPointer(w.opts.Team),
+			Roles:    model.NewPointer(model.TeamUserRoleId),
+			Channels: &user.Channels,
+		}},
+	}
+	if user.Deleted {
+		data.DeleteAt = model.NewPointer(model.GetMillis())
+	}
+
+	w.add(&imports.LineImportData{Type: "user", User: data})
+	w.report.Users++
+}
+
+// AddDirectChannel adds a direct or group message channel between the given
+// users. A single member stands for a conversation with oneself.
+func (w *Writer) AddDirectChannel(id string, members []string, header string) ([]string, bool) {
+	if len(members) == 1 {
+		members = []string{members[0], members[0]}
+	}
+	if len(members) != 2 && (len(members) < model.ChannelGroupMinUsers || len(members) > model.ChannelGroupMaxUsers) {
+		w.report.Skip("channel", "", id, SkipReasonInvalidMembers)
+		return nil, false
+	}
+
+	w.add(&imports.LineImportData{
+		Type: "direct_channel",
+		DirectChannel: &imports.DirectChannelImportData{
+			Members: &members,
+			Header:  model.NewPointer(w.report.Truncate("channel_header", "", id, header, model.ChannelHeaderMaxRunes)),
+		},
+	})
+	w.report.DirectChannels++
+	return members, true
+}
+
+// AddPosts adds the messages of a channel, rebuilding their threads.
+func (w *Writer) AddPosts(channel string, messages []*Message) {
+	for _, message := range w.threads(channel, messages) {
+		w.add(&imports.LineImportData{Type: "post", Post: message.toPost(w.opts.Team, channel)})
+	}
+}
+
+// AddDirectPosts adds the messages of a direct or group message channel
+// previously added with AddDirectChannel, rebuilding their threads.
+func (w *Writer) AddDirectPosts(id string, members []string, messages []*Message) {
+	for _, message := range w.threads(id, messages) {
+		w.add(&imports.LineImportData{Type: "direct_post", DirectPost: message.toDirectPost(members)})
+	}
+}
+
+// threads returns the messages that start a thread, or aren't part of one,
+// with the replies attached to them. Replies whose root is missing are kept
+// as messages of their own.
+func (w *Writer) threads(channel string, messages []*Message) []*Message {
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].CreateAt < messages[j].CreateAt
+	})
+
+	var roots []*Message
+	byId := make(map[string]*Message, len(messages))
+	for _, message := range messages {
+		if message.isEmpty() {
+			w.report.Skip("post", channel, message.Id, SkipReasonEmptyMessage)
+			continue
+		}
+		if utf8.RuneCountInString(message.Message) > model.PostMessageMaxRunesV2 {
+			w.report.Skip("post", channel, message.Id, SkipReasonMessageTruncated)
+			message.Message = truncateRunes(message.Message, model.PostMessageMaxRunesV2)
+		}
+		w.report.Reactions += len(message.Reactions)
+		w.report.Attachments += len(message.Attachments)
+
+		if message.ParentId != "" && message.ParentId != message.Id {
+			if root, ok := byId[message.ParentId]; ok {
+				root.replies = append(root.replies, message)
+				w.report.Replies++
+				continue
+			}
+			w.report.Skip("thread", channel, message.Id, SkipReasonMissingThreadRoot)
+		}
+
+		byId[message.Id] = message
+		roots = append(roots, message)
+		w.report.Posts++
+	}
+
+	return roots
+}
+
+// AddAttachment copies a file of the export into the archive, returning the
+// attachment referencing it. Files are identified by id so that a file
+// shared several times is only copied once.
+func (w *Writer) AddAttachment(channel, id string, file *zip.File) (imports.AttachmentImportData, bool) {
+	name := ""
+	if file != nil {
+		name = path.Base(file.Name)
+	}
+	return w.AddNamedAttachment(channel, id, name, file)
+}
+
+// AddNamedAttachment is like AddAttachment, for exports that store files
+// under a name other than their original one.
+func (w *Writer) AddNamedAttachment(channel, id, name string, file *zip.File) (imports.AttachmentImportData, bool) {
+	if attachmentPath, ok := w.copied[id]; ok {
+		return imports.AttachmentImportData{Path: model.NewPointer(attachmentPath)}, true
+	}
+
+	name = path.Base(name)
+	if file == nil || name == "" || name == "." || name == ".." || name == "/" {
+		w.report.Skip("attachment", channel, id, SkipReasonMissingFile)
+		return imports.AttachmentImportData{}, false
+	}
+	if w.opts.MaxFileSize > 0 && file.UncompressedSize64 > uint64(w.opts.MaxFileSize) {
+		w.report.Skip("attachment", channel, id, SkipReasonFileTooLarge)
+		return imports.AttachmentImportData{}, false
+	}
+
+	attachmentPath := path.Join("attachments", path.Base(id), name)
+	if err := w.copyFile(file, path.Join(model.ExportDataDir, attachmentPath)); err != nil {
+		w.report.Skip("attachment", channel, id, SkipReasonMissingFile)
+		return imports.AttachmentImportData{}, false
+	}
+
+	w.copied[id] = attachmentPath
+	return imports.AttachmentImportData{Path: model.NewPointer(attachmentPath)}, true
+}
+
+func (w *Writer) copyFile(file *zip.File, name string) error {
+	src, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := w.archive.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Store,
+		Modified: file.Modified,
+	})
+	if err != nil {
+		return err
+	}
+
+	var reader io.Reader = src
+	if w.opts.MaxFileSize > 0 {
+		reader = utils.NewLimitedReaderWithError(src, w.opts.MaxFileSize)
+	}
+	_, err = io.Copy(dst, reader)
+	return err
+}
+
+// Close writes the JSONL file and finishes the archive.
+func (w *Writer) Close() error {
+	jsonlWriter, err := w.archive.Create(JSONLName)
+	if err != nil {
+		return err
+	}
+
+	version := 1
+	encoder := json.NewEncoder(jsonlWriter)
+	if err := encoder.Encode(&imports.LineImportData{
+		Type:    "version",
+		Version: &version,
+		Info: &imports.VersionInfoImportData{
+			Generator: w.generator,
+			Version:   model.CurrentVersion,
+			Created:   time.Now().Format(time.RFC3339Nano),
+		},
+	}); err != nil {
+		return err
+	}
+
+	for _, lineType := range lineTypes {
+		for _, line := range w.lines[lineType] {
+			if err := encoder.Encode(line); err != nil {
+				return err
+			}
+		}
+	}
+
+	return w.archive.Close()
+}
+
+var (
+	systemEmojisByUnicode     map[string]string
+	systemEmojisByUnicodeOnce sync.Once
+)
+
+// EmojiNameForUnicode returns the name of the system emoji matching the given
+// unicode emoji, for exports that store reactions as characters.
+func EmojiNameForUnicode(emoji string) (string, bool) {
+	systemEmojisByUnicodeOnce.Do(func() {
+		systemEmojisByUnicode = make(map[string]string, len(model.SystemEmojis))
+		for name, code := range model.SystemEmojis {
+			// Several names can share a code point, pick one consistently.
+			if existing, ok := systemEmojisByUnicode[code]; !ok || name < existing {
+				systemEmojisByUnicode[code] = name
+			}
+		}
+	})
+
+	var codes, codesWithoutVariation []string
+	for _, r := range emoji {
+		code := strconv.FormatInt(int64(r), 16)
+		codes = append(codes, code)
+		if r != 0xfe0f {
+			codesWithoutVariation = append(codesWithoutVariation, code)
+		}
+	}
+	if name, ok := systemEmojisByUnicode[strings.Join(codes, "-")]; ok {
+		return name, true
+	}
+	name, ok := systemEmojisByUnicode[strings.Join(codesWithoutVariation, "-")]
+	return name, ok
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package importconverter
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelName(t *testing.T) {
+	assert.Equal(t, "general", ChannelName("General", "C1"))
+	assert.Equal(t, "secret-plans", ChannelName("Secret Plans!", "C1"))
+	assert.Equal(t, "c1a", ChannelName("日本", "C1A"))
+	assert.Equal(t, "19-abc-thread", ChannelName("", "19:ABC@thread"))
+	assert.Len(t, ChannelName(strings.Repeat("a", 100), "C1"), 64)
+}
+
+func TestEmojiNameForUnicode(t *testing.T) {
+	name, ok := EmojiNameForUnicode("🎉")
+	require.True(t, ok)
+	assert.Equal(t, "tada", name)
+
+	name, ok = EmojiNameForUnicode("❤️")
+	require.True(t, ok)
+	assert.Equal(t, "heart", name)
+
+	_, ok = EmojiNameForUnicode("not an emoji")
+	assert.False(t, ok)
+}
+
+func TestWriterThreads(t *testing.T) {
+	report := NewReport()
+	w := NewWriter(&bytes.Buffer{}, "test", Options{Team: "team"}, report)
+
+	roots := w.threads("town-square", []*Message{
+		{Id: "3", ParentId: "1", User: "bob", Message: "reply", CreateAt: 3},
+		{Id: "1", ParentId: "1", User: "alice", Message: "root", CreateAt: 1},
+		{Id: "2", User: "alice", CreateAt: 2},
+		{Id: "4", ParentId: "0", User: "bob", Message: "orphan", CreateAt: 4},
+	})
+
+	require.Len(t, roots, 2)
+	assert.Equal(t, "1", roots[0].Id)
+	require.Len(t, roots[0].replies, 1)
+	assert.Equal(t, "3", roots[0].replies[0].Id)
+	assert.Equal(t, "4", roots[1].Id)
+
+	assert.Equal(t, 2, report.Posts)
+	assert.Equal(t, 1, report.Replies)
+	assert.ElementsMatch(t, []SkippedItem{
+		{Type: "post", Channel: "town-square", Id: "2", Reason: SkipReasonEmptyMessage},
+		{Type: "thread", Channel: "town-square", Id: "4", Reason: SkipReasonMissingThreadRoot},
+	}, report.Skipped)
+}
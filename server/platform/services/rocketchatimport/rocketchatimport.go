@@ -0,0 +1,579 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package rocketchatimport converts Rocket.Chat exports into bulk import
+// archives.
+//
+// The export is a zip file of the collections of the Rocket.Chat MongoDB
+// database, as written by mongoexport either one document per line or with
+// --jsonArray, along with the uploaded files:
+//
+//	users.json                      users
+//	rocketchat_room.json            channels, direct and group messages
+//	rocketchat_subscription.json    room memberships
+//	rocketchat_message.json         messages
+//	rocketchat_uploads.json         uploaded files metadata
+//	uploads/<file id>               content of the uploaded files, as stored
+//	                                by the FileSystem storage type
+package rocketchatimport
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"maps"
+	"net/http"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/app/imports"
+	"github.com/mattermost/mattermost/server/v8/channels/utils"
+	"github.com/mattermost/mattermost/server/v8/platform/services/importconverter"
+)
+
+const rocketChatImportMaxFileSize = 1024 * 1024 * 70
+
+// mongoDate is a date in MongoDB extended JSON, either relaxed
+// ({"$date": "2024-01-01T00:00:00Z"}) or canonical
+// ({"$date": {"$numberLong": "1704067200000"}}).
+type mongoDate struct {
+	time.Time
+}
+
+func (d *mongoDate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var wrapper struct {
+		Date json.RawMessage `json:"$date"`
+	}
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return err
+	}
+	if wrapper.Date == nil {
+		return errors.New("missing $date")
+	}
+
+	var numberLong struct {
+		NumberLong string `json:"$numberLong"`
+	}
+	var millis int64
+	var s string
+	switch {
+	case json.Unmarshal(wrapper.Date, &s) == nil:
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return err
+		}
+		d.Time = t
+		return nil
+	case json.Unmarshal(wrapper.Date, &millis) == nil:
+	case json.Unmarshal(wrapper.Date, &numberLong) == nil && numberLong.NumberLong != "":
+		var err error
+		if millis, err = strconv.ParseInt(numberLong.NumberLong, 10, 64); err != nil {
+			return err
+		}
+	default:
+		return errors.New("invalid $date")
+	}
+	d.Time = time.UnixMilli(millis)
+	return nil
+}
+
+func (d *mongoDate) millis() int64 {
+	if d == nil || d.IsZero() {
+		return 0
+	}
+	return d.UnixMilli()
+}
+
+type rocketChatUserRef struct {
+	Id       string `json:"_id"`
+	Username string `json:"username"`
+}
+
+type rocketChatUser struct {
+	Id       string `json:"_id"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Active   *bool  `json:"active"`
+	Emails   []struct {
+		Address string `json:"address"`
+	} `json:"emails"`
+}
+
+type rocketChatRoom struct {
+	Id          string   `json:"_id"`
+	Type        string   `json:"t"`
+	Name        string   `json:"name"`
+	FName       string   `json:"fname"`
+	Topic       string   `json:"topic"`
+	Description string   `json:"description"`
+	UserIds     []string `json:"uids"`
+	Usernames   []string `json:"usernames"`
+}
+
+type rocketChatSubscription struct {
+	RoomId string            `json:"rid"`
+	User   rocketChatUserRef `json:"u"`
+	Roles  []string          `json:"roles"`
+}
+
+type rocketChatFileRef struct {
+	Id   string `json:"_id"`
+	Name string `json:"name"`
+}
+
+type rocketChatMessage struct {
+	Id        string              `json:"_id"`
+	RoomId    string              `json:"rid"`
+	Msg       string              `json:"msg"`
+	Type      string              `json:"t"`
+	Ts        mongoDate           `json:"ts"`
+	EditedAt  *mongoDate          `json:"editedAt"`
+	User      rocketChatUserRef   `json:"u"`
+	ThreadId  string              `json:"tmid"`
+	Pinned    bool                `json:"pinned"`
+	Hidden    bool                `json:"_hidden"`
+	Mentions  []rocketChatUserRef `json:"mentions"`
+	File      *rocketChatFileRef  `json:"file"`
+	Files     []rocketChatFileRef `json:"files"`
+	Reactions map[string]struct {
+		Usernames []string `json:"usernames"`
+	} `json:"reactions"`
+}
+
+type rocketChatUpload struct {
+	Id   string `json:"_id"`
+	Name string `json:"name"`
+}
+
+type rocketChatExport struct {
+	users         []rocketChatUser
+	rooms         []rocketChatRoom
+	subscriptions []rocketChatSubscription
+	messages      map[string][]rocketChatMessage
+	uploads       map[string]rocketChatUpload
+	files         map[string]*zip.File
+}
+
+type converter struct {
+	rctx      request.CTX
+	export    *rocketChatExport
+	report    *importconverter.Report
+	writer    *importconverter.Writer
+	usernames map[string]string
+	byName    map[string]string
+}
+
+// ConvertToBulkImport converts a Rocket.Chat export into a bulk import
+// archive, written to w, that can be processed by the import_process job.
+// Anything that can't be represented is listed in the returned report
+// instead of failing the conversion.
+func ConvertToBulkImport(rctx request.CTX, zipReader *zip.Reader, opts importconverter.Options, w io.Writer) (*importconverter.Report, *model.AppError) {
+	if opts.Team == "" {
+		return nil, model.NewAppError("ConvertToBulkImport", "api.rocketchatimport.bulk_import.team_missing.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	report := importconverter.NewReport()
+	export := readExport(zipReader, report)
+
+	c := &converter{
+		rctx:      rctx,
+		export:    export,
+		report:    report,
+		writer:    importconverter.NewWriter(w, "mattermost-rocketchat-converter", opts, report),
+		usernames: make(map[string]string, len(export.users)),
+		byName:    make(map[string]string, len(export.users)),
+	}
+	c.convert()
+
+	if err := c.writer.Close(); err != nil {
+		return nil, model.NewAppError("ConvertToBulkImport", "api.rocketchatimport.bulk_import.write.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return report, nil
+}
+
+// decodeCollection decodes a collection exported by mongoexport, either as
+// a JSON array or one document per line. Documents that can't be decoded
+// are skipped and reported through the returned error.
+func decodeCollection[T any](r io.Reader) ([]T, error) {
+	reader := bufio.NewReader(r)
+	first, err := peekNonSpace(reader)
+	if err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if first == '[' {
+		var raw []json.RawMessage
+		if err = json.NewDecoder(reader).Decode(&raw); err != nil {
+			return nil, err
+		}
+		items := make([]T, 0, len(raw))
+		var errs []error
+		for _, doc := range raw {
+			var item T
+			if err = json.Unmarshal(doc, &item); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			items = append(items, item)
+		}
+		return items, errors.Join(errs...)
+	}
+
+	var items []T
+	var errs []error
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), rocketChatImportMaxFileSize)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var item T
+		if err = json.Unmarshal(line, &item); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		items = append(items, item)
+	}
+	if err = scanner.Err(); err != nil {
+		errs = append(errs, err)
+	}
+	return items, errors.Join(errs...)
+}
+
+func peekNonSpace(reader *bufio.Reader) (byte, error) {
+	for {
+		b, err := reader.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		if b != ' ' && b != '\t' && b != '\r' && b != '\n' {
+			return b, reader.UnreadByte()
+		}
+	}
+}
+
+func readExport(zipReader *zip.Reader, report *importconverter.Report) *rocketChatExport {
+	export := &rocketChatExport{
+		messages: make(map[string][]rocketChatMessage),
+		uploads:  make(map[string]rocketChatUpload),
+		files:    make(map[string]*zip.File),
+	}
+
+	for _, file := range zipReader.File {
+		if dir, name := path.Split(file.Name); dir == "uploads/" && name != "" {
+			export.files[name] = file
+			continue
+		}
+
+		var decode func(io.Reader) error
+		switch file.Name {
+		case "users.json":
+			decode = func(r io.Reader) (err error) {
+				export.users, err = decodeCollection[rocketChatUser](r)
+				return err
+			}
+		case "rocketchat_room.json":
+			decode = func(r io.Reader) (err error) {
+				export.rooms, err = decodeCollection[rocketChatRoom](r)
+				return err
+			}
+		case "rocketchat_subscription.json":
+			decode = func(r io.Reader) (err error) {
+				export.subscriptions, err = decodeCollection[rocketChatSubscription](r)
+				return err
+			}
+		case "rocketchat_message.json":
+			decode = func(r io.Reader) error {
+				messages, err := decodeCollection[rocketChatMessage](r)
+				for _, message := range messages {
+					export.messages[message.RoomId] = append(export.messages[message.RoomId], message)
+				}
+				return err
+			}
+		case "rocketchat_uploads.json":
+			decode = func(r io.Reader) error {
+				uploads, err := decodeCollection[rocketChatUpload](r)
+				for _, upload := range uploads {
+					export.uploads[upload.Id] = upload
+				}
+				return err
+			}
+		default:
+			continue
+		}
+
+		fileReader, err := file.Open()
+		if err == nil {
+			// The decoders return whatever they managed to decode, so carry on
+			// and let the report point at the broken file.
+			err = decode(utils.NewLimitedReaderWithError(fileReader, rocketChatImportMaxFileSize))
+			fileReader.Close()
+		}
+		if err != nil {
+			reason := importconverter.SkipReasonParseError
+			if errors.Is(err, utils.ErrSizeLimitExceeded) {
+				reason = importconverter.SkipReasonFileTooLarge
+			}
+			report.Skip("file", "", file.Name, reason)
+		}
+	}
+
+	return export
+}
+
+func (c *converter) convert() {
+	for _, user := range c.export.users {
+		username := model.CleanUsername(c.rctx.Logger(), user.Username)
+		c.usernames[user.Id] = username
+		c.byName[user.Username] = username
+	}
+
+	memberships := make(map[string][]imports.UserChannelImportData)
+	members := make(map[string][]rocketChatSubscription)
+	for _, subscription := range c.export.subscriptions {
+		members[subscription.RoomId] = append(members[subscription.RoomId], subscription)
+	}
+
+	for _, room := range c.export.rooms {
+		switch room.Type {
+		case "c", "p":
+			channelType := model.ChannelTypeOpen
+			if room.Type == "p" {
+				channelType = model.ChannelTypePrivate
+			}
+			displayName := room.FName
+			if displayName == "" {
+				displayName = room.Name
+			}
+
+			name := c.writer.AddChannel(importconverter.Channel{
+				Id:          room.Id,
+				Name:        importconverter.ChannelName(room.Name, room.Id),
+				DisplayName: displayName,
+				Type:        channelType,
+				Header:      room.Topic,
+				Purpose:     room.Description,
+			})
+
+			for _, subscription := range members[room.Id] {
+				if _, ok := c.usernames[subscription.User.Id]; ok {
+					isOwner := false
+					for _, role := range subscription.Roles {
+						isOwner = isOwner || role == "owner"
+					}
+					memberships[subscription.User.Id] = append(memberships[subscription.User.Id], importconverter.ChannelMembership(name, isOwner))
+				}
+			}
+
+			c.writer.AddPosts(name, c.convertMessages(name, c.export.messages[room.Id]))
+		case "d":
+			usernames, ok := c.directChannelMembers(room)
+			if !ok {
+				c.report.Skip("channel", "", room.Id, importconverter.SkipReasonInvalidMembers)
+				continue
+			}
+			if usernames, ok = c.writer.AddDirectChannel(room.Id, usernames, room.Topic); !ok {
+				continue
+			}
+			c.writer.AddDirectPosts(room.Id, usernames, c.convertMessages(room.Id, c.export.messages[room.Id]))
+		default:
+			// Omnichannel and voice rooms have no equivalent.
+			c.report.Skip("channel", room.Name, room.Id, importconverter.SkipReasonUnsupportedChannel)
+		}
+	}
+
+	for _, user := range c.export.users {
+		// Bots and apps are created as regular users, deactivated so that
+		// they can't be logged in to.
+		deleted := user.Active != nil && !*user.Active || user.Type == "bot" || user.Type == "app"
+
+		firstName, lastName, _ := strings.Cut(user.Name, " ")
+		var email string
+		if len(user.Emails) > 0 {
+			email = user.Emails[0].Address
+		}
+		c.writer.AddUser(importconverter.User{
+			Username:  c.usernames[user.Id],
+			Email:     email,
+			FirstName: firstName,
+			LastName:  lastName,
+			Deleted:   deleted,
+			Channels:  memberships[user.Id],
+		})
+	}
+}
+
+// directChannelMembers returns the usernames of the members of a direct
+// message room, which lists them by id in recent versions and by username
+// in older ones.
+func (c *converter) directChannelMembers(room rocketChatRoom) ([]string, bool) {
+	lookup, ids := c.usernames, room.UserIds
+	if len(ids) == 0 {
+		lookup, ids = c.byName, room.Usernames
+	}
+
+	var usernames []string
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		username, ok := lookup[id]
+		if !ok {
+			return nil, false
+		}
+		if !seen[username] {
+			seen[username] = true
+			usernames = append(usernames, username)
+		}
+	}
+	return usernames, len(usernames) > 0
+}
+
+func (c *converter) convertMessages(channel string, messages []rocketChatMessage) []*importconverter.Message {
+	converted := make([]*importconverter.Message, 0, len(messages))
+	for _, message := range messages {
+		if m, ok := c.convertMessage(channel, message); ok {
+			converted = append(converted, m)
+		}
+	}
+	return converted
+}
+
+func (c *converter) convertMessage(channel string, message rocketChatMessage) (*importconverter.Message, bool) {
+	if message.Hidden {
+		// Hidden messages are previous versions of edited messages, or
+		// deleted ones.
+		c.report.Skip("post", channel, message.Id, importconverter.SkipReasonDeletedMessage)
+		return nil, false
+	}
+
+	converted := &importconverter.Message{
+		Id:       message.Id,
+		ParentId: message.ThreadId,
+		User:     c.usernames[message.User.Id],
+		Message:  c.convertMarkup(message),
+		CreateAt: message.Ts.millis(),
+		EditAt:   message.EditedAt.millis(),
+		IsPinned: message.Pinned,
+	}
+	if converted.User == "" {
+		converted.User = c.byName[message.User.Username]
+	}
+
+	switch message.Type {
+	case "":
+	case "uj", "ul":
+		converted.Type = model.PostTypeJoinChannel
+		if message.Type == "ul" {
+			converted.Type = model.PostTypeLeaveChannel
+		}
+		converted.Message = ""
+		converted.Props = model.StringInterface{"username": converted.User}
+	case "room_changed_topic":
+		converted.Type = model.PostTypeHeaderChange
+		converted.Message = ""
+		converted.Props = model.StringInterface{"username": converted.User, "new_header": message.Msg}
+	case "room_changed_description":
+		converted.Type = model.PostTypePurposeChange
+		converted.Message = ""
+		converted.Props = model.StringInterface{"username": converted.User, "new_purpose": message.Msg}
+	default:
+		c.report.Skip("post", channel, message.Id, importconverter.SkipReasonUnsupportedType)
+		return nil, false
+	}
+
+	if converted.User == "" {
+		c.report.Skip("post", channel, message.Id, importconverter.SkipReasonUnknownUser)
+		return nil, false
+	}
+
+	files := message.Files
+	if len(files) == 0 && message.File != nil {
+		files = []rocketChatFileRef{*message.File}
+	}
+	for _, file := range files {
+		name := file.Name
+		if upload, ok := c.export.uploads[file.Id]; ok && upload.Name != "" {
+			name = upload.Name
+		}
+		if attachment, ok := c.writer.AddNamedAttachment(channel, file.Id, name, c.export.files[file.Id]); ok {
+			converted.Attachments = append(converted.Attachments, attachment)
+		}
+	}
+
+	// Reactions are stored in a map, sort them so that conversions of the
+	// same export are identical.
+	for _, reaction := range slices.Sorted(maps.Keys(message.Reactions)) {
+		emojiName := strings.Trim(reaction, ":")
+		if emojiName == "" || utf8.RuneCountInString(emojiName) > model.EmojiNameMaxLength {
+			c.report.Skip("reaction", channel, message.Id, importconverter.SkipReasonInvalidEmojiName)
+			continue
+		}
+		for _, user := range message.Reactions[reaction].Usernames {
+			username, ok := c.byName[user]
+			if !ok {
+				c.report.Skip("reaction", channel, message.Id, importconverter.SkipReasonUnknownUser)
+				continue
+			}
+			converted.Reactions = append(converted.Reactions, converted.Reaction(username, emojiName))
+		}
+	}
+
+	return converted, true
+}
+
+var (
+	codeRegexp   = regexp.MustCompile("(?s)```.*?```|`[^`\n]*`")
+	boldRegexp   = regexp.MustCompile(`(^|[^*\w])\*([^*\s](?:[^*\n]*[^*\s])?)\*`)
+	strikeRegexp = regexp.MustCompile(`(^|[^~\w])~([^~\s](?:[^~\n]*[^~\s])?)~`)
+)
+
+// convertMarkup converts the Rocket.Chat message markup to Markdown, and
+// updates mentions of users whose username had to be changed.
+func (c *converter) convertMarkup(message rocketChatMessage) string {
+	text := message.Msg
+	var sb strings.Builder
+	last := 0
+	for _, loc := range codeRegexp.FindAllStringIndex(text, -1) {
+		sb.WriteString(convertInlineMarkup(text[last:loc[0]]))
+		sb.WriteString(text[loc[0]:loc[1]])
+		last = loc[1]
+	}
+	sb.WriteString(convertInlineMarkup(text[last:]))
+	text = sb.String()
+
+	for _, mention := range message.Mentions {
+		if username, ok := c.byName[mention.Username]; ok && username != mention.Username {
+			text = replaceMention(text, mention.Username, username)
+		}
+	}
+	return text
+}
+
+func convertInlineMarkup(text string) string {
+	text = boldRegexp.ReplaceAllString(text, "$1**$2**")
+	return strikeRegexp.ReplaceAllString(text, "$1~~$2~~")
+}
+
+func replaceMention(text, from, to string) string {
+	mentionRegexp := regexp.MustCompile(`(^|\W)@` + regexp.QuoteMeta(from) + `\b`)
+	return mentionRegexp.ReplaceAllString(text, "${1}@"+to)
+}
@@ -0,0 +1,282 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package rocketchatimport
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/app/imports"
+	"github.com/mattermost/mattermost/server/v8/platform/services/importconverter"
+)
+
+func makeRocketChatExport(t *testing.T, files map[string]string) *zip.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(files[name]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	return zr
+}
+
+func readBulkImport(t *testing.T, data []byte) (map[string][]imports.LineImportData, map[string]string) {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	lines := make(map[string][]imports.LineImportData)
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		if f.Name != importconverter.JSONLName {
+			content, err := io.ReadAll(rc)
+			require.NoError(t, err)
+			files[f.Name] = string(content)
+			rc.Close()
+			continue
+		}
+
+		scanner := bufio.NewScanner(rc)
+		for scanner.Scan() {
+			var line imports.LineImportData
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+			lines[line.Type] = append(lines[line.Type], line)
+		}
+		require.NoError(t, scanner.Err())
+		rc.Close()
+	}
+	return lines, files
+}
+
+func TestMongoDate(t *testing.T) {
+	expected := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC).UnixMilli()
+	for name, data := range map[string]string{
+		"relaxed":     `{"$date": "2024-01-01T10:00:00.000Z"}`,
+		"number":      `{"$date": 1704103200000}`,
+		"number long": `{"$date": {"$numberLong": "1704103200000"}}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var d mongoDate
+			require.NoError(t, json.Unmarshal([]byte(data), &d))
+			assert.Equal(t, expected, d.millis())
+		})
+	}
+
+	var d mongoDate
+	require.Error(t, json.Unmarshal([]byte(`{"date": 1}`), &d))
+	require.Error(t, json.Unmarshal([]byte(`{"$date": true}`), &d))
+}
+
+func TestConvertInlineMarkup(t *testing.T) {
+	for text, expected := range map[string]string{
+		"*bold* text":           "**bold** text",
+		"~struck~ and *bold*":   "~~struck~~ and **bold**",
+		"a*b*c":                 "a*b*c",
+		"* not bold":            "* not bold",
+		"_italic_ stays italic": "_italic_ stays italic",
+	} {
+		assert.Equal(t, expected, convertInlineMarkup(text), text)
+	}
+
+	c := &converter{byName: map[string]string{"john": "john"}}
+	assert.Equal(t, "**a** `*code*` **b**\n```\n*block*\n```", c.convertMarkup(rocketChatMessage{Msg: "*a* `*code*` *b*\n```\n*block*\n```"}))
+}
+
+func TestDecodeCollection(t *testing.T) {
+	type doc struct {
+		Id string `json:"_id"`
+	}
+
+	docs, err := decodeCollection[doc](bytes.NewBufferString(`{"_id": "1"}` + "\n\n" + `{"_id": "2"}`))
+	require.NoError(t, err)
+	assert.Equal(t, []doc{{"1"}, {"2"}}, docs)
+
+	docs, err = decodeCollection[doc](bytes.NewBufferString(` [{"_id": "1"}, {"_id": "2"}]`))
+	require.NoError(t, err)
+	assert.Equal(t, []doc{{"1"}, {"2"}}, docs)
+
+	docs, err = decodeCollection[doc](bytes.NewBufferString(`{"_id": "1"}` + "\n" + `{broken`))
+	require.Error(t, err)
+	assert.Equal(t, []doc{{"1"}}, docs)
+
+	docs, err = decodeCollection[doc](bytes.NewBufferString(""))
+	require.NoError(t, err)
+	assert.Empty(t, docs)
+}
+
+func TestConvertToBulkImport(t *testing.T) {
+	rctx := request.TestContext(t)
+
+	export := makeRocketChatExport(t, map[string]string{
+		"users.json": `{"_id": "u1", "username": "alice", "name": "Alice Smith", "emails": [{"address": "Alice@example.com"}], "active": true, "type": "user"}
+{"_id": "u2", "username": "bob", "name": "Bob", "emails": [{"address": "bob@example.com"}], "active": false, "type": "user"}
+{"_id": "u3", "username": "Carol.B", "name": "Carol", "type": "user"}
+{"_id": "rocket.cat", "username": "rocket.cat", "name": "Rocket.Cat", "type": "bot"}`,
+		"rocketchat_room.json": `[
+			{"_id": "r1", "t": "c", "name": "general", "fname": "General", "topic": "The topic", "description": "The description"},
+			{"_id": "r2", "t": "p", "name": "secret"},
+			{"_id": "r3", "t": "d", "uids": ["u1", "u2"], "usernames": ["alice", "bob"]},
+			{"_id": "r4", "t": "d", "usernames": ["alice", "bob", "Carol.B"]},
+			{"_id": "r5", "t": "d", "uids": ["u1", "ux"]},
+			{"_id": "r6", "t": "l", "name": "livechat"}
+		]`,
+		"rocketchat_subscription.json": `{"rid": "r1", "u": {"_id": "u1", "username": "alice"}, "roles": ["owner"]}
+{"rid": "r1", "u": {"_id": "u2", "username": "bob"}}
+{"rid": "r1", "u": {"_id": "ux", "username": "ghost"}}
+{"rid": "r2", "u": {"_id": "u1", "username": "alice"}}`,
+		"rocketchat_message.json": `{"_id": "m1", "rid": "r1", "msg": "*hello* @Carol.B", "ts": {"$date": "2024-01-01T10:00:00.000Z"}, "editedAt": {"$date": {"$numberLong": "1704103500000"}}, "u": {"_id": "u1", "username": "alice"}, "pinned": true, "mentions": [{"_id": "u3", "username": "Carol.B"}], "reactions": {":tada:": {"usernames": ["bob", "Carol.B"]}, ":+1:": {"usernames": ["ghost"]}}}
+{"_id": "m2", "rid": "r1", "msg": "", "ts": {"$date": 1704103260000}, "u": {"_id": "u2", "username": "bob"}, "tmid": "m1", "file": {"_id": "f1", "name": "notes.txt"}, "files": [{"_id": "f1", "name": "notes.txt"}, {"_id": "f2", "name": "missing.png"}]}
+{"_id": "m3", "rid": "r1", "msg": "old version", "ts": {"$date": "2024-01-01T10:00:00.000Z"}, "u": {"_id": "u1", "username": "alice"}, "_hidden": true}
+{"_id": "m4", "rid": "r1", "msg": "bob", "ts": {"$date": "2024-01-01T09:00:00.000Z"}, "u": {"_id": "u2", "username": "bob"}, "t": "uj"}
+{"_id": "m5", "rid": "r1", "msg": "New topic", "ts": {"$date": "2024-01-01T09:30:00.000Z"}, "u": {"_id": "u1", "username": "alice"}, "t": "room_changed_topic"}
+{"_id": "m6", "rid": "r1", "msg": "", "ts": {"$date": "2024-01-01T09:40:00.000Z"}, "u": {"_id": "u1", "username": "alice"}, "t": "discussion-created"}
+{"_id": "m7", "rid": "r1", "msg": "who", "ts": {"$date": "2024-01-01T09:50:00.000Z"}, "u": {"_id": "ux", "username": "ghost"}}
+{"_id": "m8", "rid": "r3", "msg": "~gone~", "ts": {"$date": "2024-01-02T10:00:00.000Z"}, "u": {"_id": "u2", "username": "bob"}}
+{broken`,
+		"rocketchat_uploads.json": `[{"_id": "f1", "name": "meeting notes.txt"}]`,
+		"uploads/f1":              "file content",
+	})
+
+	var out bytes.Buffer
+	report, appErr := ConvertToBulkImport(rctx, export, importconverter.Options{Team: "team"}, &out)
+	require.Nil(t, appErr)
+
+	assert.Equal(t, 2, report.Channels)
+	assert.Equal(t, 2, report.DirectChannels)
+	assert.Equal(t, 4, report.Users)
+	assert.Equal(t, 4, report.Posts)
+	assert.Equal(t, 1, report.Replies)
+	assert.Equal(t, 2, report.Reactions)
+	assert.Equal(t, 1, report.Attachments)
+	assert.ElementsMatch(t, []importconverter.SkippedItem{
+		{Type: "file", Id: "rocketchat_message.json", Reason: importconverter.SkipReasonParseError},
+		{Type: "channel", Id: "r5", Reason: importconverter.SkipReasonInvalidMembers},
+		{Type: "channel", Channel: "livechat", Id: "r6", Reason: importconverter.SkipReasonUnsupportedChannel},
+		{Type: "reaction", Channel: "general", Id: "m1", Reason: importconverter.SkipReasonUnknownUser},
+		{Type: "attachment", Channel: "general", Id: "f2", Reason: importconverter.SkipReasonMissingFile},
+		{Type: "post", Channel: "general", Id: "m3", Reason: importconverter.SkipReasonDeletedMessage},
+		{Type: "post", Channel: "general", Id: "m6", Reason: importconverter.SkipReasonUnsupportedType},
+		{Type: "post", Channel: "general", Id: "m7", Reason: importconverter.SkipReasonUnknownUser},
+	}, report.Skipped)
+
+	lines, files := readBulkImport(t, out.Bytes())
+	assert.Equal(t, map[string]string{"data/attachments/f1/meeting notes.txt": "file content"}, files)
+
+	for _, line := range lines["channel"] {
+		require.Nil(t, imports.ValidateChannelImportData(line.Channel))
+	}
+	for _, line := range lines["user"] {
+		require.Nil(t, imports.ValidateUserImportData(line.User))
+	}
+	for _, line := range lines["post"] {
+		require.Nil(t, imports.ValidatePostImportData(line.Post, model.PostMessageMaxRunesV2))
+	}
+	for _, line := range lines["direct_channel"] {
+		require.Nil(t, imports.ValidateDirectChannelImportData(line.DirectChannel))
+	}
+	for _, line := range lines["direct_post"] {
+		require.Nil(t, imports.ValidateDirectPostImportData(line.DirectPost, model.PostMessageMaxRunesV2))
+	}
+
+	t.Run("channels", func(t *testing.T) {
+		channels := lines["channel"]
+		require.Len(t, channels, 2)
+		general := channels[0].Channel
+		assert.Equal(t, "general", *general.Name)
+		assert.Equal(t, "General", *general.DisplayName)
+		assert.Equal(t, "The topic", *general.Header)
+		assert.Equal(t, "The description", *general.Purpose)
+		assert.Equal(t, model.ChannelTypeOpen, *general.Type)
+		assert.Equal(t, model.ChannelTypePrivate, *channels[1].Channel.Type)
+	})
+
+	t.Run("users", func(t *testing.T) {
+		users := lines["user"]
+		require.Len(t, users, 4)
+
+		alice := users[0].User
+		assert.Equal(t, "alice", *alice.Username)
+		assert.Equal(t, "alice@example.com", *alice.Email)
+		assert.Equal(t, "Alice", *alice.FirstName)
+		assert.Equal(t, "Smith", *alice.LastName)
+		assert.Nil(t, alice.DeleteAt)
+		channels := *(*alice.Teams)[0].Channels
+		require.Len(t, channels, 2)
+		assert.Equal(t, "channel_user channel_admin", *channels[0].Roles)
+		assert.Equal(t, "channel_user", *channels[1].Roles)
+
+		assert.NotNil(t, users[1].User.DeleteAt)
+		assert.Equal(t, "carol.b", *users[2].User.Username)
+		assert.NotNil(t, users[3].User.DeleteAt)
+	})
+
+	t.Run("posts", func(t *testing.T) {
+		posts := lines["post"]
+		require.Len(t, posts, 3)
+
+		join := posts[0].Post
+		assert.Equal(t, model.PostTypeJoinChannel, *join.Type)
+		assert.Equal(t, "bob", (*join.Props)["username"])
+
+		topic := posts[1].Post
+		assert.Equal(t, model.PostTypeHeaderChange, *topic.Type)
+		assert.Equal(t, "New topic", (*topic.Props)["new_header"])
+
+		root := posts[2].Post
+		assert.Equal(t, "**hello** @carol.b", *root.Message)
+		assert.EqualValues(t, 1704103200000, *root.CreateAt)
+		assert.EqualValues(t, 1704103500000, *root.EditAt)
+		assert.True(t, *root.IsPinned)
+		require.Len(t, *root.Reactions, 2)
+		assert.Equal(t, "tada", *(*root.Reactions)[0].EmojiName)
+		assert.Equal(t, "bob", *(*root.Reactions)[0].User)
+		assert.Equal(t, "carol.b", *(*root.Reactions)[1].User)
+
+		require.Len(t, *root.Replies, 1)
+		reply := (*root.Replies)[0]
+		assert.Equal(t, "bob", *reply.User)
+		require.Len(t, *reply.Attachments, 1)
+		assert.Equal(t, "attachments/f1/meeting notes.txt", *(*reply.Attachments)[0].Path)
+	})
+
+	t.Run("direct channels", func(t *testing.T) {
+		channels := lines["direct_channel"]
+		require.Len(t, channels, 2)
+		assert.Equal(t, []string{"alice", "bob"}, *channels[0].DirectChannel.Members)
+		assert.Equal(t, []string{"alice", "bob", "carol.b"}, *channels[1].DirectChannel.Members)
+
+		require.Len(t, lines["direct_post"], 1)
+		post := lines["direct_post"][0].DirectPost
+		assert.Equal(t, "bob", *post.User)
+		assert.Equal(t, "~~gone~~", *post.Message)
+	})
+
+	t.Run("missing team", func(t *testing.T) {
+		_, appErr := ConvertToBulkImport(rctx, export, importconverter.Options{}, io.Discard)
+		require.NotNil(t, appErr)
+	})
+}
@@ -0,0 +1,329 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package slackimport
+
+import (
+	"archive/zip"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/app/imports"
+	"github.com/mattermost/mattermost/server/v8/channels/utils"
+	"github.com/mattermost/mattermost/server/v8/platform/services/importconverter"
+)
+
+// bulkImportBotUsername is the user that bot messages are attributed to.
+// Slack exports don't carry enough information to recreate the bots
+// themselves, so the user is deactivated once imported.
+const bulkImportBotUsername = "slackimportbot"
+
+// slackExport holds the parsed content of a Slack export archive.
+type slackExport struct {
+	channels []slackChannel
+	users    []slackUser
+	posts    map[string][]slackPost
+	uploads  map[string]*zip.File
+}
+
+type bulkConverter struct {
+	rctx      request.CTX
+	export    *slackExport
+	report    *importconverter.Report
+	writer    *importconverter.Writer
+	usernames map[string]string
+	botUsed   bool
+}
+
+// ConvertToBulkImport converts a Slack export into a bulk import archive,
+// written to w, that can be processed by the import_process job.
+//
+// Threads, reactions, pinned messages, edits, channel topics and purposes,
+// direct and group messages are all carried over. Anything that can't be
+// represented is listed in the returned report instead of failing the
+// conversion.
+func ConvertToBulkImport(rctx request.CTX, zipReader *zip.Reader, opts importconverter.Options, w io.Writer) (*importconverter.Report, *model.AppError) {
+	if opts.Team == "" {
+		return nil, model.NewAppError("ConvertToBulkImport", "api.slackimport.bulk_import.team_missing.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	report := importconverter.NewReport()
+	export, appErr := slackReadExport(zipReader, report)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	c := &bulkConverter{
+		rctx:      rctx,
+		export:    export,
+		report:    report,
+		writer:    importconverter.NewWriter(w, "mattermost-slack-converter", opts, report),
+		usernames: make(map[string]string, len(export.users)),
+	}
+	c.convert()
+
+	if err := c.writer.Close(); err != nil {
+		return nil, model.NewAppError("ConvertToBulkImport", "api.slackimport.bulk_import.write.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return report, nil
+}
+
+func slackReadExport(zipReader *zip.Reader, report *importconverter.Report) (*slackExport, *model.AppError) {
+	export := &slackExport{
+		posts:   make(map[string][]slackPost),
+		uploads: make(map[string]*zip.File),
+	}
+
+	channelTypes := map[string]model.ChannelType{
+		"channels.json": model.ChannelTypeOpen,
+		"groups.json":   model.ChannelTypePrivate,
+		"dms.json":      model.ChannelTypeDirect,
+		"mpims.json":    model.ChannelTypeGroup,
+	}
+
+	for _, file := range zipReader.File {
+		spl := strings.Split(file.Name, "/")
+		if len(spl) == 3 && spl[0] == "__uploads" {
+			export.uploads[spl[1]] = file
+			continue
+		}
+
+		channelType, isChannels := channelTypes[file.Name]
+		isPosts := len(spl) == 2 && strings.HasSuffix(spl[1], ".json")
+		if !isChannels && !isPosts && file.Name != "users.json" {
+			continue
+		}
+
+		fileReader, err := file.Open()
+		if err != nil {
+			return nil, model.NewAppError("ConvertToBulkImport", "api.slackimport.slack_import.open.app_error", map[string]any{"Filename": file.Name}, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		reader := utils.NewLimitedReaderWithError(fileReader, slackImportMaxFileSize)
+		switch {
+		case isChannels:
+			var channels []slackChannel
+			channels, err = slackParseChannels(reader, channelType)
+			export.channels = append(export.channels, channels...)
+		case isPosts:
+			var posts []slackPost
+			posts, err = slackParsePosts(reader)
+			export.posts[spl[0]] = append(export.posts[spl[0]], posts...)
+		default:
+			export.users, err = slackParseUsers(reader)
+		}
+		fileReader.Close()
+
+		if err != nil {
+			// The parsers return whatever they managed to decode, so carry on
+			// and let the report point at the broken file.
+			reason := importconverter.SkipReasonParseError
+			if errors.Is(err, utils.ErrSizeLimitExceeded) {
+				reason = importconverter.SkipReasonFileTooLarge
+			}
+			report.Skip("file", "", file.Name, reason)
+		}
+	}
+
+	export.posts = slackConvertUserMentions(export.users, export.posts)
+	export.posts = slackConvertChannelMentions(export.channels, export.posts)
+	export.posts = slackConvertPostsMarkup(export.posts)
+
+	return export, nil
+}
+
+func (c *bulkConverter) convert() {
+	for _, user := range c.export.users {
+		c.usernames[user.Id] = model.CleanUsername(c.rctx.Logger(), user.Username)
+	}
+
+	memberships := make(map[string][]imports.UserChannelImportData)
+	for _, channel := range c.export.channels {
+		switch channel.Type {
+		case model.ChannelTypeOpen, model.ChannelTypePrivate:
+			name := c.writer.AddChannel(importconverter.Channel{
+				Id:          channel.Id,
+				Name:        importconverter.ChannelName(slackConvertChannelName(channel.Name, channel.Id), channel.Id),
+				DisplayName: channel.Name,
+				Type:        channel.Type,
+				Header:      channel.Topic.Value,
+				Purpose:     channel.Purpose.Value,
+			})
+
+			for _, member := range channel.Members {
+				if _, ok := c.usernames[member]; ok {
+					memberships[member] = append(memberships[member], importconverter.ChannelMembership(name, member == channel.Creator))
+				}
+			}
+
+			c.writer.AddPosts(name, c.convertPosts(name, c.export.posts[channel.Name]))
+		case model.ChannelTypeDirect, model.ChannelTypeGroup:
+			members, ok := c.directChannelMembers(channel)
+			if !ok {
+				continue
+			}
+			if members, ok = c.writer.AddDirectChannel(channel.Id, members, channel.Topic.Value); !ok {
+				continue
+			}
+
+			// Direct message folders are named after the channel id as they
+			// have no name of their own.
+			posts := c.export.posts[channel.Name]
+			if channel.Type == model.ChannelTypeDirect {
+				posts = c.export.posts[channel.Id]
+			}
+			c.writer.AddDirectPosts(channel.Id, members, c.convertPosts(channel.Id, posts))
+		default:
+			c.report.Skip("channel", channel.Name, channel.Id, importconverter.SkipReasonUnsupportedChannel)
+		}
+	}
+
+	for _, user := range c.export.users {
+		c.writer.AddUser(importconverter.User{
+			Username:  c.usernames[user.Id],
+			Email:     user.Profile.Email,
+			FirstName: user.Profile.FirstName,
+			LastName:  user.Profile.LastName,
+			Deleted:   user.Deleted,
+			Channels:  memberships[user.Id],
+		})
+	}
+	if c.botUsed {
+		c.writer.AddUser(importconverter.User{
+			Username: bulkImportBotUsername,
+			Email:    bulkImportBotUsername + "@localhost",
+			Deleted:  true,
+		})
+	}
+}
+
+// directChannelMembers returns the usernames of the members of a direct or
+// group message channel.
+func (c *bulkConverter) directChannelMembers(channel slackChannel) ([]string, bool) {
+	var members []string
+	seen := make(map[string]bool, len(channel.Members))
+	for _, member := range channel.Members {
+		if seen[member] {
+			continue
+		}
+		seen[member] = true
+		username, ok := c.usernames[member]
+		if !ok {
+			c.report.Skip("channel", channel.Name, channel.Id, importconverter.SkipReasonInvalidMembers)
+			return nil, false
+		}
+		members = append(members, username)
+	}
+	return members, true
+}
+
+func (c *bulkConverter) convertPosts(channel string, posts []slackPost) []*importconverter.Message {
+	messages := make([]*importconverter.Message, 0, len(posts))
+	for _, post := range posts {
+		if message, ok := c.convertPost(channel, post); ok {
+			messages = append(messages, message)
+		}
+	}
+	return messages
+}
+
+func (c *bulkConverter) convertPost(channel string, post slackPost) (*importconverter.Message, bool) {
+	message := &importconverter.Message{
+		Id:       post.TimeStamp,
+		ParentId: post.ThreadTS,
+		User:     c.usernames[post.User],
+		Message:  post.Text,
+		CreateAt: slackTimeStampMillis(post.TimeStamp),
+		IsPinned: len(post.PinnedTo) > 0,
+	}
+	if post.Edited != nil {
+		message.EditAt = slackTimeStampMillis(post.Edited.TimeStamp)
+	}
+
+	if post.Type != "message" {
+		c.report.Skip("post", channel, post.TimeStamp, importconverter.SkipReasonUnsupportedType)
+		return nil, false
+	}
+
+	switch post.SubType {
+	case "", "file_share", "thread_broadcast":
+	case "me_message":
+		message.Message = "*" + post.Text + "*"
+	case "file_comment":
+		if post.Comment == nil {
+			c.report.Skip("post", channel, post.TimeStamp, importconverter.SkipReasonEmptyMessage)
+			return nil, false
+		}
+		message.User = c.usernames[post.Comment.User]
+		message.Message = post.Comment.Comment
+	case "bot_message":
+		c.botUsed = true
+		message.User = bulkImportBotUsername
+		message.Props = model.StringInterface{
+			model.PostPropsFromWebhook:      "true",
+			model.PostPropsOverrideUsername: post.BotUsername,
+		}
+		if len(post.Attachments) > 0 {
+			message.Type = model.PostTypeSlackAttachment
+			message.Props[model.PostPropsAttachments] = post.Attachments
+		}
+	case "channel_join", "channel_leave":
+		message.Type = model.PostTypeJoinChannel
+		if post.SubType == "channel_leave" {
+			message.Type = model.PostTypeLeaveChannel
+		}
+		message.Props = model.StringInterface{"username": message.User}
+	case "channel_topic":
+		message.Type = model.PostTypeHeaderChange
+	case "channel_purpose":
+		message.Type = model.PostTypePurposeChange
+	case "channel_name":
+		message.Type = model.PostTypeDisplaynameChange
+	default:
+		c.report.Skip("post", channel, post.TimeStamp, importconverter.SkipReasonUnsupportedType)
+		return nil, false
+	}
+
+	if message.User == "" {
+		c.report.Skip("post", channel, post.TimeStamp, importconverter.SkipReasonUnknownUser)
+		return nil, false
+	}
+
+	files := post.Files
+	if post.File != nil {
+		files = append(files, post.File)
+	}
+	for _, file := range files {
+		if file == nil {
+			continue
+		}
+		if attachment, ok := c.writer.AddAttachment(channel, file.Id, c.export.uploads[file.Id]); ok {
+			message.Attachments = append(message.Attachments, attachment)
+		}
+	}
+
+	for _, reaction := range post.Reactions {
+		// Skin tones are a property of the user in Mattermost.
+		emojiName, _, _ := strings.Cut(reaction.Name, "::")
+		if emojiName == "" || utf8.RuneCountInString(emojiName) > model.EmojiNameMaxLength {
+			c.report.Skip("reaction", channel, post.TimeStamp, importconverter.SkipReasonInvalidEmojiName)
+			continue
+		}
+		for _, user := range reaction.Users {
+			username, ok := c.usernames[user]
+			if !ok {
+				c.report.Skip("reaction", channel, post.TimeStamp, importconverter.SkipReasonUnknownUser)
+				continue
+			}
+			message.Reactions = append(message.Reactions, message.Reaction(username, emojiName))
+		}
+	}
+
+	return message, true
+}
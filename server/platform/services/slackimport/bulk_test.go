@@ -0,0 +1,248 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package slackimport
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/app/imports"
+	"github.com/mattermost/mattermost/server/v8/platform/services/importconverter"
+)
+
+func makeSlackExport(t *testing.T, files map[string]string) *zip.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(files[name]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	return zr
+}
+
+func readBulkImport(t *testing.T, data []byte) ([]imports.LineImportData, map[string]string) {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	var lines []imports.LineImportData
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		if f.Name != importconverter.JSONLName {
+			content, err := io.ReadAll(rc)
+			require.NoError(t, err)
+			files[f.Name] = string(content)
+			rc.Close()
+			continue
+		}
+
+		scanner := bufio.NewScanner(rc)
+		for scanner.Scan() {
+			var line imports.LineImportData
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+			lines = append(lines, line)
+		}
+		require.NoError(t, scanner.Err())
+		rc.Close()
+	}
+	return lines, files
+}
+
+func TestSlackTimeStampMillis(t *testing.T) {
+	assert.EqualValues(t, 1469785419000, slackTimeStampMillis("1469785419"))
+	assert.EqualValues(t, 1469785419000, slackTimeStampMillis("1469785419.000033"))
+	assert.EqualValues(t, 1469785419123, slackTimeStampMillis("1469785419.123456"))
+	assert.EqualValues(t, 1469785419500, slackTimeStampMillis("1469785419.5"))
+}
+
+func TestConvertToBulkImport(t *testing.T) {
+	rctx := request.TestContext(t)
+
+	export := makeSlackExport(t, map[string]string{
+		"users.json": `[
+			{"id": "U1", "name": "alice", "profile": {"first_name": "Alice", "email": "Alice@example.com"}},
+			{"id": "U2", "name": "bob", "deleted": true, "profile": {"email": "bob@example.com"}},
+			{"id": "U3", "name": "carol", "profile": {}}
+		]`,
+		"channels.json": `[
+			{"id": "C1", "name": "general", "creator": "U1", "members": ["U1", "U2", "UX"],
+			 "topic": {"value": "The topic"}, "purpose": {"value": "The purpose"}}
+		]`,
+		"groups.json": `[{"id": "G1", "name": "secret", "members": ["U1"]}]`,
+		"dms.json":    `[{"id": "D1", "members": ["U1", "U2"]}, {"id": "D2", "members": ["U1", "UX"]}]`,
+		"mpims.json":  `[{"id": "G2", "name": "mpdm-alice--bob--carol-1", "members": ["U1", "U2", "U3"]}]`,
+		"general/2024-01-01.json": `[
+			{"type": "message", "user": "U1", "text": "root <@U2>", "ts": "1700000000.000100", "thread_ts": "1700000000.000100",
+			 "reactions": [{"name": "thumbsup::skin-tone-2", "users": ["U2", "UX"], "count": 2}],
+			 "pinned_to": ["C1"], "edited": {"user": "U1", "ts": "1700000100.000000"}},
+			{"type": "message", "user": "U2", "text": "reply", "ts": "1700000001.000200", "thread_ts": "1700000000.000100",
+			 "files": [{"id": "F1", "title": "a file"}, {"id": "F2", "title": "missing"}]},
+			{"type": "message", "user": "U1", "text": "orphan", "ts": "1700000002.000300", "thread_ts": "1600000000.000000"},
+			{"type": "message", "subtype": "bot_message", "bot_id": "B1", "username": "ci", "text": "build passed", "ts": "1700000003.000000"},
+			{"type": "message", "subtype": "huddle_thread", "user": "U1", "text": "", "ts": "1700000004.000000"},
+			{"type": "message", "user": "UX", "text": "who am I", "ts": "1700000005.000000"}
+		]`,
+		"D1/2024-01-01.json": `[
+			{"type": "message", "user": "U2", "text": "hello", "ts": "1700000010.000000"}
+		]`,
+		"__uploads/F1/report.txt": "file content",
+	})
+
+	var out bytes.Buffer
+	report, appErr := ConvertToBulkImport(rctx, export, importconverter.Options{Team: "team"}, &out)
+	require.Nil(t, appErr)
+
+	assert.Equal(t, 2, report.Channels)
+	assert.Equal(t, 2, report.DirectChannels)
+	assert.Equal(t, 4, report.Users)
+	assert.Equal(t, 4, report.Posts)
+	assert.Equal(t, 1, report.Replies)
+	assert.Equal(t, 1, report.Reactions)
+	assert.Equal(t, 1, report.Attachments)
+	assert.ElementsMatch(t, []importconverter.SkippedItem{
+		{Type: "reaction", Channel: "general", Id: "1700000000.000100", Reason: importconverter.SkipReasonUnknownUser},
+		{Type: "attachment", Channel: "general", Id: "F2", Reason: importconverter.SkipReasonMissingFile},
+		{Type: "thread", Channel: "general", Id: "1700000002.000300", Reason: importconverter.SkipReasonMissingThreadRoot},
+		{Type: "post", Channel: "general", Id: "1700000004.000000", Reason: importconverter.SkipReasonUnsupportedType},
+		{Type: "post", Channel: "general", Id: "1700000005.000000", Reason: importconverter.SkipReasonUnknownUser},
+		{Type: "channel", Id: "D2", Reason: importconverter.SkipReasonInvalidMembers},
+	}, report.Skipped)
+
+	lines, files := readBulkImport(t, out.Bytes())
+	assert.Equal(t, map[string]string{"data/attachments/F1/report.txt": "file content"}, files)
+
+	var types []string
+	byType := make(map[string][]imports.LineImportData)
+	for _, line := range lines {
+		if len(types) == 0 || types[len(types)-1] != line.Type {
+			types = append(types, line.Type)
+		}
+		byType[line.Type] = append(byType[line.Type], line)
+	}
+	assert.Equal(t, []string{"version", "channel", "user", "post", "direct_channel", "direct_post"}, types)
+
+	for _, line := range lines {
+		switch line.Type {
+		case "version":
+			require.Equal(t, 1, *line.Version)
+		case "channel":
+			require.Nil(t, imports.ValidateChannelImportData(line.Channel))
+		case "user":
+			require.Nil(t, imports.ValidateUserImportData(line.User))
+		case "post":
+			require.Nil(t, imports.ValidatePostImportData(line.Post, model.PostMessageMaxRunesV2))
+		case "direct_channel":
+			require.Nil(t, imports.ValidateDirectChannelImportData(line.DirectChannel))
+		case "direct_post":
+			require.Nil(t, imports.ValidateDirectPostImportData(line.DirectPost, model.PostMessageMaxRunesV2))
+		}
+	}
+
+	t.Run("channels", func(t *testing.T) {
+		general := byType["channel"][0].Channel
+		assert.Equal(t, "general", *general.Name)
+		assert.Equal(t, model.ChannelTypeOpen, *general.Type)
+		assert.Equal(t, "The topic", *general.Header)
+		assert.Equal(t, "The purpose", *general.Purpose)
+		assert.Equal(t, model.ChannelTypePrivate, *byType["channel"][1].Channel.Type)
+	})
+
+	t.Run("users", func(t *testing.T) {
+		users := byType["user"]
+		require.Len(t, users, 4)
+
+		alice := users[0].User
+		assert.Equal(t, "alice", *alice.Username)
+		assert.Equal(t, "alice@example.com", *alice.Email)
+		assert.Nil(t, alice.DeleteAt)
+		channels := *(*alice.Teams)[0].Channels
+		require.Len(t, channels, 2)
+		assert.Equal(t, "general", *channels[0].Name)
+		assert.Equal(t, "channel_user channel_admin", *channels[0].Roles)
+		assert.Equal(t, "secret", *channels[1].Name)
+		assert.Equal(t, "channel_user", *channels[1].Roles)
+
+		assert.NotNil(t, users[1].User.DeleteAt)
+		assert.Equal(t, "carol@example.com", *users[2].User.Email)
+
+		bot := users[3].User
+		assert.Equal(t, bulkImportBotUsername, *bot.Username)
+		assert.NotNil(t, bot.DeleteAt)
+	})
+
+	t.Run("posts", func(t *testing.T) {
+		posts := byType["post"]
+		require.Len(t, posts, 3)
+
+		root := posts[0].Post
+		assert.Equal(t, "alice", *root.User)
+		assert.Equal(t, "root @bob", *root.Message)
+		assert.EqualValues(t, 1700000000000, *root.CreateAt)
+		assert.EqualValues(t, 1700000100000, *root.EditAt)
+		assert.True(t, *root.IsPinned)
+		require.Len(t, *root.Reactions, 1)
+		assert.Equal(t, "thumbsup", *(*root.Reactions)[0].EmojiName)
+		assert.Equal(t, "bob", *(*root.Reactions)[0].User)
+
+		require.Len(t, *root.Replies, 1)
+		reply := (*root.Replies)[0]
+		assert.Equal(t, "bob", *reply.User)
+		assert.EqualValues(t, 1700000001000, *reply.CreateAt)
+		require.Len(t, *reply.Attachments, 1)
+		assert.Equal(t, "attachments/F1/report.txt", *(*reply.Attachments)[0].Path)
+
+		assert.Equal(t, "orphan", *posts[1].Post.Message)
+		assert.Nil(t, posts[1].Post.Replies)
+
+		bot := posts[2].Post
+		assert.Equal(t, bulkImportBotUsername, *bot.User)
+		assert.Equal(t, "ci", (*bot.Props)[model.PostPropsOverrideUsername])
+	})
+
+	t.Run("direct channels", func(t *testing.T) {
+		assert.Equal(t, []string{"alice", "bob"}, *byType["direct_channel"][0].DirectChannel.Members)
+		assert.Equal(t, []string{"alice", "bob", "carol"}, *byType["direct_channel"][1].DirectChannel.Members)
+
+		require.Len(t, byType["direct_post"], 1)
+		post := byType["direct_post"][0].DirectPost
+		assert.Equal(t, []string{"alice", "bob"}, *post.ChannelMembers)
+		assert.Equal(t, "bob", *post.User)
+		assert.Equal(t, "hello", *post.Message)
+	})
+
+	t.Run("missing team", func(t *testing.T) {
+		_, appErr := ConvertToBulkImport(rctx, export, importconverter.Options{}, io.Discard)
+		require.NotNil(t, appErr)
+	})
+
+	t.Run("attachment too large", func(t *testing.T) {
+		var out bytes.Buffer
+		report, appErr := ConvertToBulkImport(rctx, export, importconverter.Options{Team: "team", MaxFileSize: 4}, &out)
+		require.Nil(t, appErr)
+		assert.Zero(t, report.Attachments)
+		assert.Contains(t, report.Skipped, importconverter.SkippedItem{Type: "attachment", Channel: "general", Id: "F1", Reason: importconverter.SkipReasonFileTooLarge})
+	})
+}
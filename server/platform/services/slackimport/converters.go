@@ -22,6 +22,25 @@ func slackConvertTimeStamp(ts string) int64 {
 	return timeStamp * 1000 // Convert to milliseconds
 }
 
+// slackTimeStampMillis converts a Slack timestamp to milliseconds, keeping
+// the sub-second part so that messages sent within the same second keep
+// their order.
+func slackTimeStampMillis(ts string) int64 {
+	seconds, fraction, _ := strings.Cut(ts, ".")
+	millis := slackConvertTimeStamp(seconds)
+	if len(fraction) > 3 {
+		fraction = fraction[:3]
+	}
+	if fraction == "" {
+		return millis
+	}
+	fractionMillis, err := strconv.ParseInt(fraction+strings.Repeat("0", 3-len(fraction)), 10, 64)
+	if err != nil {
+		return millis
+	}
+	return millis + fractionMillis
+}
+
 func slackConvertChannelName(channelName string, channelId string) string {
 	newName := strings.Trim(channelName, "_-")
 	if len(newName) == 1 {
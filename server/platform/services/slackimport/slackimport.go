@@ -49,6 +49,7 @@ type slackProfile struct {
 type slackUser struct {
 	Id       string       `json:"id"`
 	Username string       `json:"name"`
+	Deleted  bool         `json:"deleted"`
 	Profile  slackProfile `json:"profile"`
 }
 
@@ -71,6 +72,20 @@ type slackPost struct {
 	File        *slackFile               `json:"file"`
 	Files       []*slackFile             `json:"files"`
 	Attachments []*model.SlackAttachment `json:"attachments"`
+	Edited      *slackEdited             `json:"edited"`
+	Reactions   []slackReaction          `json:"reactions"`
+	PinnedTo    []string                 `json:"pinned_to"`
+}
+
+type slackEdited struct {
+	User      string `json:"user"`
+	TimeStamp string `json:"ts"`
+}
+
+type slackReaction struct {
+	Name  string   `json:"name"`
+	Users []string `json:"users"`
+	Count int      `json:"count"`
 }
 
 var isValidChannelNameCharacters = regexp.MustCompile(`^[a-zA-Z0-9\-_]+$`).MatchString
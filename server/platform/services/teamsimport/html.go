@@ -0,0 +1,165 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package teamsimport
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+var blankLinesRegexp = regexp.MustCompile(`\n{3,}`)
+
+// htmlToMarkdown converts the HTML body of a Teams message to Markdown.
+// Mentions are replaced by the text found in mentions for their id, as the
+// mentioned name is shown in the body.
+func htmlToMarkdown(content string, mentions map[int]string) string {
+	var sb strings.Builder
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+
+	var (
+		href       []string
+		inPre      bool
+		inMention  bool
+		listDepth  int
+		blockQuote int
+	)
+
+	newLine := func() {
+		sb.WriteString("\n")
+		if blockQuote > 0 {
+			sb.WriteString(strings.Repeat("> ", blockQuote))
+		}
+	}
+
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			break
+		}
+
+		token := tokenizer.Token()
+		switch tokenType {
+		case html.StartTagToken, html.SelfClosingTagToken:
+			switch token.Data {
+			case "p", "div":
+				newLine()
+			case "br":
+				newLine()
+			case "b", "strong":
+				sb.WriteString("**")
+			case "i", "em":
+				sb.WriteString("*")
+			case "s", "strike", "del":
+				sb.WriteString("~~")
+			case "code":
+				if !inPre {
+					sb.WriteString("`")
+				}
+			case "pre":
+				inPre = true
+				newLine()
+				sb.WriteString("```")
+				newLine()
+			case "blockquote":
+				blockQuote++
+				newLine()
+			case "ul", "ol":
+				listDepth++
+			case "li":
+				newLine()
+				sb.WriteString(strings.Repeat("  ", max(listDepth-1, 0)) + "- ")
+			case "a":
+				href = append(href, attr(token, "href"))
+				sb.WriteString("[")
+			case "at":
+				id, err := strconv.Atoi(attr(token, "id"))
+				if mention, ok := mentions[id]; ok && err == nil {
+					sb.WriteString(mention)
+					inMention = true
+				}
+			case "emoji", "img":
+				// Teams renders emojis as images carrying the character
+				// in their alt text.
+				if alt := attr(token, "alt"); alt != "" {
+					sb.WriteString(alt)
+				}
+			}
+		case html.EndTagToken:
+			switch token.Data {
+			case "p", "div":
+				newLine()
+			case "b", "strong":
+				sb.WriteString("**")
+			case "i", "em":
+				sb.WriteString("*")
+			case "s", "strike", "del":
+				sb.WriteString("~~")
+			case "code":
+				if !inPre {
+					sb.WriteString("`")
+				}
+			case "pre":
+				inPre = false
+				newLine()
+				sb.WriteString("```")
+				newLine()
+			case "blockquote":
+				// Leave a blank line so that following text isn't a lazy
+				// continuation of the quote.
+				blockQuote = max(blockQuote-1, 0)
+				newLine()
+				newLine()
+			case "ul", "ol":
+				listDepth = max(listDepth-1, 0)
+				newLine()
+			case "a":
+				link := ""
+				if len(href) > 0 {
+					link, href = href[len(href)-1], href[:len(href)-1]
+				}
+				sb.WriteString("](" + link + ")")
+			case "at":
+				inMention = false
+			}
+		case html.TextToken:
+			if inMention {
+				continue
+			}
+			text := token.Data
+			if !inPre {
+				text = strings.Join(strings.Fields(text), " ")
+				if text == "" && token.Data != "" {
+					text = " "
+				} else if strings.TrimSpace(token.Data) != token.Data {
+					// Keep the separation from adjacent elements.
+					if strings.TrimLeft(token.Data, " \t\r\n") != token.Data {
+						text = " " + text
+					}
+					if strings.TrimRight(token.Data, " \t\r\n") != token.Data {
+						text += " "
+					}
+				}
+			}
+			sb.WriteString(strings.ReplaceAll(text, "\n", "\n"+strings.Repeat("> ", blockQuote)))
+		}
+	}
+
+	lines := strings.Split(sb.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.TrimSpace(blankLinesRegexp.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"))
+}
+
+func attr(token html.Token, key string) string {
+	for _, a := range token.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
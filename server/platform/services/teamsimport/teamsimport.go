@@ -0,0 +1,533 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package teamsimport converts Microsoft Teams exports into bulk import
+// archives.
+//
+// The export is a zip file of Microsoft Graph resources, as returned by the
+// Graph API, laid out as follows:
+//
+//	users.json                            users
+//	channels/<channel id>/channel.json    the channel
+//	channels/<channel id>/members.json    conversation members of the channel
+//	channels/<channel id>/messages.json   chat messages, with their replies
+//	channels/<channel id>/pinned.json     pinned chat messages
+//	chats/<chat id>/chat.json             the chat, with its members expanded
+//	chats/<chat id>/messages.json         chat messages
+//	chats/<chat id>/pinned.json           pinned chat messages
+//	files/<attachment id>/<file name>     content of reference attachments
+//
+// Collections can either be a JSON array or a Graph response with the items
+// in its value property. Replies can be nested in the replies property of
+// their root message or listed alongside it with replyToId set.
+package teamsimport
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"iter"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/app/imports"
+	"github.com/mattermost/mattermost/server/v8/channels/utils"
+	"github.com/mattermost/mattermost/server/v8/platform/services/importconverter"
+)
+
+const teamsImportMaxFileSize = 1024 * 1024 * 70
+
+type graphUser struct {
+	Id                string `json:"id"`
+	DisplayName       string `json:"displayName"`
+	GivenName         string `json:"givenName"`
+	Surname           string `json:"surname"`
+	Mail              string `json:"mail"`
+	UserPrincipalName string `json:"userPrincipalName"`
+	JobTitle          string `json:"jobTitle"`
+	AccountEnabled    *bool  `json:"accountEnabled"`
+}
+
+type graphChannel struct {
+	Id             string `json:"id"`
+	DisplayName    string `json:"displayName"`
+	Description    string `json:"description"`
+	MembershipType string `json:"membershipType"`
+}
+
+type graphMember struct {
+	UserId string   `json:"userId"`
+	Roles  []string `json:"roles"`
+}
+
+type graphChat struct {
+	Id       string        `json:"id"`
+	Topic    string        `json:"topic"`
+	ChatType string        `json:"chatType"`
+	Members  []graphMember `json:"members"`
+}
+
+type graphIdentity struct {
+	Id                       string `json:"id"`
+	DisplayName              string `json:"displayName"`
+	ConversationIdentityType string `json:"conversationIdentityType"`
+}
+
+type graphIdentitySet struct {
+	User         *graphIdentity `json:"user"`
+	Application  *graphIdentity `json:"application"`
+	Conversation *graphIdentity `json:"conversation"`
+}
+
+type graphMessageBody struct {
+	ContentType string `json:"contentType"`
+	Content     string `json:"content"`
+}
+
+type graphAttachment struct {
+	Id          string `json:"id"`
+	ContentType string `json:"contentType"`
+	Name        string `json:"name"`
+}
+
+type graphMention struct {
+	Id          int               `json:"id"`
+	MentionText string            `json:"mentionText"`
+	Mentioned   *graphIdentitySet `json:"mentioned"`
+}
+
+type graphReaction struct {
+	ReactionType string           `json:"reactionType"`
+	User         graphIdentitySet `json:"user"`
+}
+
+type graphMessage struct {
+	Id                 string            `json:"id"`
+	ReplyToId          string            `json:"replyToId"`
+	MessageType        string            `json:"messageType"`
+	CreatedDateTime    time.Time         `json:"createdDateTime"`
+	LastEditedDateTime *time.Time        `json:"lastEditedDateTime"`
+	DeletedDateTime    *time.Time        `json:"deletedDateTime"`
+	From               *graphIdentitySet `json:"from"`
+	Body               graphMessageBody  `json:"body"`
+	Attachments        []graphAttachment `json:"attachments"`
+	Mentions           []graphMention    `json:"mentions"`
+	Reactions          []graphReaction   `json:"reactions"`
+	Replies            []graphMessage    `json:"replies"`
+}
+
+type graphPinnedMessage struct {
+	Message struct {
+		Id string `json:"id"`
+	} `json:"message"`
+}
+
+// teamsConversation holds the content of a channel or chat directory.
+type teamsConversation struct {
+	channel  *graphChannel
+	chat     *graphChat
+	members  []graphMember
+	messages []graphMessage
+	pinned   map[string]bool
+}
+
+type teamsExport struct {
+	users    []graphUser
+	channels map[string]*teamsConversation
+	chats    map[string]*teamsConversation
+	files    map[string]*zip.File
+}
+
+// graphReactionEmojis maps the original Teams reactions to emoji names.
+// Other reactions are stored as the emoji character itself.
+var graphReactionEmojis = map[string]string{
+	"like":      "+1",
+	"heart":     "heart",
+	"laugh":     "laughing",
+	"surprised": "open_mouth",
+	"sad":       "cry",
+	"angry":     "angry",
+}
+
+type converter struct {
+	rctx      request.CTX
+	export    *teamsExport
+	report    *importconverter.Report
+	writer    *importconverter.Writer
+	usernames map[string]string
+}
+
+// ConvertToBulkImport converts a Microsoft Teams export into a bulk import
+// archive, written to w, that can be processed by the import_process job.
+// Anything that can't be represented is listed in the returned report
+// instead of failing the conversion.
+func ConvertToBulkImport(rctx request.CTX, zipReader *zip.Reader, opts importconverter.Options, w io.Writer) (*importconverter.Report, *model.AppError) {
+	if opts.Team == "" {
+		return nil, model.NewAppError("ConvertToBulkImport", "api.teamsimport.bulk_import.team_missing.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	report := importconverter.NewReport()
+	export, appErr := readExport(zipReader, report)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	c := &converter{
+		rctx:      rctx,
+		export:    export,
+		report:    report,
+		writer:    importconverter.NewWriter(w, "mattermost-teams-converter", opts, report),
+		usernames: make(map[string]string, len(export.users)),
+	}
+	c.convert()
+
+	if err := c.writer.Close(); err != nil {
+		return nil, model.NewAppError("ConvertToBulkImport", "api.teamsimport.bulk_import.write.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return report, nil
+}
+
+// decodeList decodes a collection, either as a JSON array or as a Graph
+// response holding the items in its value property.
+func decodeList[T any](r io.Reader) ([]T, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []T
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		err = json.Unmarshal(trimmed, &items)
+		return items, err
+	}
+
+	var response struct {
+		Value []T `json:"value"`
+	}
+	err = json.Unmarshal(data, &response)
+	return response.Value, err
+}
+
+func decodeFile(file *zip.File, decode func(io.Reader) error) error {
+	fileReader, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer fileReader.Close()
+
+	return decode(utils.NewLimitedReaderWithError(fileReader, teamsImportMaxFileSize))
+}
+
+func readExport(zipReader *zip.Reader, report *importconverter.Report) (*teamsExport, *model.AppError) {
+	export := &teamsExport{
+		channels: make(map[string]*teamsConversation),
+		chats:    make(map[string]*teamsConversation),
+		files:    make(map[string]*zip.File),
+	}
+
+	conversation := func(conversations map[string]*teamsConversation, id string) *teamsConversation {
+		if conversations[id] == nil {
+			conversations[id] = &teamsConversation{pinned: make(map[string]bool)}
+		}
+		return conversations[id]
+	}
+
+	for _, file := range zipReader.File {
+		spl := strings.Split(file.Name, "/")
+
+		var decode func(io.Reader) error
+		switch {
+		case file.Name == "users.json":
+			decode = func(r io.Reader) (err error) {
+				export.users, err = decodeList[graphUser](r)
+				return err
+			}
+		case len(spl) == 3 && spl[0] == "files":
+			export.files[spl[1]] = file
+		case len(spl) == 3 && (spl[0] == "channels" || spl[0] == "chats"):
+			conversations := export.channels
+			if spl[0] == "chats" {
+				conversations = export.chats
+			}
+			c := conversation(conversations, spl[1])
+
+			switch spl[2] {
+			case "channel.json":
+				decode = func(r io.Reader) error {
+					c.channel = &graphChannel{}
+					return json.NewDecoder(r).Decode(c.channel)
+				}
+			case "chat.json":
+				decode = func(r io.Reader) error {
+					c.chat = &graphChat{}
+					return json.NewDecoder(r).Decode(c.chat)
+				}
+			case "members.json":
+				decode = func(r io.Reader) (err error) {
+					c.members, err = decodeList[graphMember](r)
+					return err
+				}
+			case "messages.json":
+				decode = func(r io.Reader) error {
+					messages, err := decodeList[graphMessage](r)
+					c.messages = append(c.messages, messages...)
+					return err
+				}
+			case "pinned.json":
+				decode = func(r io.Reader) error {
+					pinned, err := decodeList[graphPinnedMessage](r)
+					for _, p := range pinned {
+						c.pinned[p.Message.Id] = true
+					}
+					return err
+				}
+			}
+		}
+
+		if decode == nil {
+			continue
+		}
+		if err := decodeFile(file, decode); err != nil {
+			reason := importconverter.SkipReasonParseError
+			if errors.Is(err, utils.ErrSizeLimitExceeded) {
+				reason = importconverter.SkipReasonFileTooLarge
+			}
+			report.Skip("file", "", file.Name, reason)
+		}
+	}
+
+	return export, nil
+}
+
+func (c *converter) convert() {
+	for _, user := range c.export.users {
+		name := user.UserPrincipalName
+		if name == "" {
+			name = user.Mail
+		}
+		name, _, _ = strings.Cut(name, "@")
+		c.usernames[user.Id] = model.CleanUsername(c.rctx.Logger(), name)
+	}
+
+	memberships := make(map[string][]imports.UserChannelImportData)
+	usedNames := make(map[string]bool)
+	for id, conversation := range sortedConversations(c.export.channels) {
+		if conversation.channel == nil {
+			c.report.Skip("channel", "", id, importconverter.SkipReasonParseError)
+			continue
+		}
+		channel := conversation.channel
+
+		channelType := model.ChannelTypeOpen
+		switch channel.MembershipType {
+		case "private":
+			channelType = model.ChannelTypePrivate
+		case "shared":
+			// Shared channels span several tenants, only the members of this
+			// tenant can be carried over.
+			channelType = model.ChannelTypePrivate
+		}
+
+		name := importconverter.ChannelName(channel.DisplayName, channel.Id)
+		for suffix := 2; usedNames[name]; suffix++ {
+			name = importconverter.ChannelName(channel.DisplayName+"-"+strconv.Itoa(suffix), channel.Id)
+		}
+		usedNames[name] = true
+
+		name = c.writer.AddChannel(importconverter.Channel{
+			Id:          channel.Id,
+			Name:        name,
+			DisplayName: channel.DisplayName,
+			Type:        channelType,
+			Purpose:     channel.Description,
+		})
+
+		for _, member := range conversation.members {
+			if _, ok := c.usernames[member.UserId]; ok {
+				isOwner := false
+				for _, role := range member.Roles {
+					isOwner = isOwner || role == "owner"
+				}
+				memberships[member.UserId] = append(memberships[member.UserId], importconverter.ChannelMembership(name, isOwner))
+			}
+		}
+
+		c.writer.AddPosts(name, c.convertMessages(name, conversation))
+	}
+
+	for id, conversation := range sortedConversations(c.export.chats) {
+		if conversation.chat == nil {
+			c.report.Skip("channel", "", id, importconverter.SkipReasonParseError)
+			continue
+		}
+
+		members := conversation.chat.Members
+		if len(members) == 0 {
+			members = conversation.members
+		}
+		var usernames []string
+		for _, member := range members {
+			username, ok := c.usernames[member.UserId]
+			if !ok {
+				usernames = nil
+				break
+			}
+			usernames = append(usernames, username)
+		}
+		if usernames == nil {
+			c.report.Skip("channel", "", id, importconverter.SkipReasonInvalidMembers)
+			continue
+		}
+
+		usernames, ok := c.writer.AddDirectChannel(id, usernames, conversation.chat.Topic)
+		if !ok {
+			continue
+		}
+		c.writer.AddDirectPosts(id, usernames, c.convertMessages(id, conversation))
+	}
+
+	for _, user := range c.export.users {
+		email := user.Mail
+		if email == "" && strings.Contains(user.UserPrincipalName, "@") {
+			email = user.UserPrincipalName
+		}
+		c.writer.AddUser(importconverter.User{
+			Username:  c.usernames[user.Id],
+			Email:     email,
+			FirstName: user.GivenName,
+			LastName:  user.Surname,
+			Position:  user.JobTitle,
+			Deleted:   user.AccountEnabled != nil && !*user.AccountEnabled,
+			Channels:  memberships[user.Id],
+		})
+	}
+}
+
+func (c *converter) convertMessages(channel string, conversation *teamsConversation) []*importconverter.Message {
+	var messages []*importconverter.Message
+	var add func(message graphMessage, parentId string)
+	add = func(message graphMessage, parentId string) {
+		if message.ReplyToId != "" {
+			parentId = message.ReplyToId
+		}
+		if converted, ok := c.convertMessage(channel, message, parentId, conversation.pinned[message.Id]); ok {
+			messages = append(messages, converted)
+		}
+		for _, reply := range message.Replies {
+			add(reply, message.Id)
+		}
+	}
+	for _, message := range conversation.messages {
+		add(message, "")
+	}
+	return messages
+}
+
+func (c *converter) convertMessage(channel string, message graphMessage, parentId string, isPinned bool) (*importconverter.Message, bool) {
+	if message.MessageType != "message" {
+		c.report.Skip("post", channel, message.Id, importconverter.SkipReasonUnsupportedType)
+		return nil, false
+	}
+	if message.DeletedDateTime != nil {
+		c.report.Skip("post", channel, message.Id, importconverter.SkipReasonDeletedMessage)
+		return nil, false
+	}
+
+	converted := &importconverter.Message{
+		Id:       message.Id,
+		ParentId: parentId,
+		CreateAt: message.CreatedDateTime.UnixMilli(),
+		IsPinned: isPinned,
+	}
+	if message.LastEditedDateTime != nil {
+		converted.EditAt = message.LastEditedDateTime.UnixMilli()
+	}
+
+	if message.From != nil && message.From.User != nil {
+		converted.User = c.usernames[message.From.User.Id]
+	}
+	if converted.User == "" {
+		c.report.Skip("post", channel, message.Id, importconverter.SkipReasonUnknownUser)
+		return nil, false
+	}
+
+	if strings.EqualFold(message.Body.ContentType, "html") {
+		mentions := make(map[int]string, len(message.Mentions))
+		for _, mention := range message.Mentions {
+			mentions[mention.Id] = c.mentionText(mention)
+		}
+		converted.Message = htmlToMarkdown(message.Body.Content, mentions)
+	} else {
+		converted.Message = strings.TrimSpace(message.Body.Content)
+	}
+
+	for _, attachment := range message.Attachments {
+		// Only files are carried over, cards and quoted messages are
+		// rendered by Teams itself.
+		if attachment.ContentType != "reference" {
+			c.report.Skip("attachment", channel, attachment.Id, importconverter.SkipReasonUnsupportedType)
+			continue
+		}
+		if fileAttachment, ok := c.writer.AddAttachment(channel, attachment.Id, c.export.files[attachment.Id]); ok {
+			converted.Attachments = append(converted.Attachments, fileAttachment)
+		}
+	}
+
+	for _, reaction := range message.Reactions {
+		emojiName, ok := graphReactionEmojis[reaction.ReactionType]
+		if !ok {
+			emojiName, ok = importconverter.EmojiNameForUnicode(reaction.ReactionType)
+		}
+		if !ok {
+			c.report.Skip("reaction", channel, message.Id, importconverter.SkipReasonInvalidEmojiName)
+			continue
+		}
+		var username string
+		if reaction.User.User != nil {
+			username = c.usernames[reaction.User.User.Id]
+		}
+		if username == "" {
+			c.report.Skip("reaction", channel, message.Id, importconverter.SkipReasonUnknownUser)
+			continue
+		}
+		converted.Reactions = append(converted.Reactions, converted.Reaction(username, emojiName))
+	}
+
+	return converted, true
+}
+
+// sortedConversations iterates over conversations ordered by id, so that
+// conversions of the same export are identical.
+func sortedConversations(conversations map[string]*teamsConversation) iter.Seq2[string, *teamsConversation] {
+	return func(yield func(string, *teamsConversation) bool) {
+		for _, id := range slices.Sorted(maps.Keys(conversations)) {
+			if !yield(id, conversations[id]) {
+				return
+			}
+		}
+	}
+}
+
+// mentionText returns the Mattermost mention matching a Teams mention.
+func (c *converter) mentionText(mention graphMention) string {
+	if mention.Mentioned != nil {
+		if mention.Mentioned.User != nil {
+			if username, ok := c.usernames[mention.Mentioned.User.Id]; ok {
+				return "@" + username
+			}
+		}
+		if mention.Mentioned.Conversation != nil {
+			return "@channel"
+		}
+	}
+	return mention.MentionText
+}
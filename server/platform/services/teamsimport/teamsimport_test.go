@@ -0,0 +1,261 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package teamsimport
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/app/imports"
+	"github.com/mattermost/mattermost/server/v8/platform/services/importconverter"
+)
+
+func makeTeamsExport(t *testing.T, files map[string]string) *zip.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(files[name]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	return zr
+}
+
+func readBulkImport(t *testing.T, data []byte) (map[string][]imports.LineImportData, map[string]string) {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	lines := make(map[string][]imports.LineImportData)
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		if f.Name != importconverter.JSONLName {
+			content, err := io.ReadAll(rc)
+			require.NoError(t, err)
+			files[f.Name] = string(content)
+			rc.Close()
+			continue
+		}
+
+		scanner := bufio.NewScanner(rc)
+		for scanner.Scan() {
+			var line imports.LineImportData
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+			lines[line.Type] = append(lines[line.Type], line)
+		}
+		require.NoError(t, scanner.Err())
+		rc.Close()
+	}
+	return lines, files
+}
+
+func TestHTMLToMarkdown(t *testing.T) {
+	for name, tc := range map[string]struct {
+		content  string
+		expected string
+	}{
+		"plain text":  {"hello world", "hello world"},
+		"paragraphs":  {"<p>one</p><p>two</p>", "one\n\ntwo"},
+		"line breaks": {"<div>one<br>two</div>", "one\ntwo"},
+		"formatting":  {"<p><b>bold</b>, <i>italic</i> and <s>struck</s> <code>code</code></p>", "**bold**, *italic* and ~~struck~~ `code`"},
+		"link":        {`<a href="https://example.com">site</a>`, "[site](https://example.com)"},
+		"list":        {"<ul><li>one</li><li>two</li></ul>", "- one\n- two"},
+		"code block":  {"<pre>a  b\nc</pre>", "```\na  b\nc\n```"},
+		"quote":       {"<blockquote>quoted</blockquote>after", "> quoted\n\nafter"},
+		"emoji":       {`<emoji alt="😀"></emoji> hi`, "😀 hi"},
+		"mention":     {`<p>hi <at id="0">Alice Smith</at>!</p>`, "hi @alice!"},
+		"entities":    {"a &amp; b &lt;c&gt;", "a & b <c>"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, htmlToMarkdown(tc.content, map[int]string{0: "@alice"}))
+		})
+	}
+}
+
+func TestConvertToBulkImport(t *testing.T) {
+	rctx := request.TestContext(t)
+
+	export := makeTeamsExport(t, map[string]string{
+		"users.json": `{"value": [
+			{"id": "u1", "displayName": "Alice Smith", "givenName": "Alice", "surname": "Smith", "mail": "Alice@example.com",
+			 "userPrincipalName": "alice@example.com", "jobTitle": "Engineer"},
+			{"id": "u2", "displayName": "Bob", "userPrincipalName": "bob.jones@example.com", "accountEnabled": false},
+			{"id": "u3", "displayName": "Carol", "userPrincipalName": "carol"}
+		]}`,
+		"channels/c1/channel.json": `{"id": "c1", "displayName": "General", "description": "Everything", "membershipType": "standard"}`,
+		"channels/c1/members.json": `[{"userId": "u1", "roles": ["owner"]}, {"userId": "u2", "roles": []}, {"userId": "ux"}]`,
+		"channels/c1/messages.json": `{"value": [
+			{"id": "m1", "messageType": "message", "createdDateTime": "2024-01-01T10:00:00Z", "lastEditedDateTime": "2024-01-01T10:05:00Z",
+			 "from": {"user": {"id": "u1"}},
+			 "body": {"contentType": "html", "content": "<p>Hi <at id=\"0\">Bob</at> and <at id=\"1\">General</at></p>"},
+			 "mentions": [{"id": 0, "mentionText": "Bob", "mentioned": {"user": {"id": "u2"}}},
+			              {"id": 1, "mentionText": "General", "mentioned": {"conversation": {"id": "c1"}}}],
+			 "reactions": [{"reactionType": "like", "user": {"user": {"id": "u2"}}},
+			               {"reactionType": "🎉", "user": {"user": {"id": "u3"}}},
+			               {"reactionType": "custom", "user": {"user": {"id": "u3"}}}],
+			 "replies": [
+				{"id": "m2", "replyToId": "m1", "messageType": "message", "createdDateTime": "2024-01-01T10:01:00Z",
+				 "from": {"user": {"id": "u2"}}, "body": {"contentType": "text", "content": "reply"},
+				 "attachments": [{"id": "a1", "contentType": "reference", "name": "notes.txt"},
+				                 {"id": "a2", "contentType": "application/vnd.microsoft.card.adaptive"}]}
+			 ]},
+			{"id": "m3", "replyToId": "m1", "messageType": "message", "createdDateTime": "2024-01-01T10:02:00Z",
+			 "from": {"user": {"id": "u1"}}, "body": {"contentType": "text", "content": "flat reply"}},
+			{"id": "m4", "messageType": "message", "createdDateTime": "2024-01-01T11:00:00Z", "deletedDateTime": "2024-01-02T00:00:00Z",
+			 "from": {"user": {"id": "u1"}}, "body": {"contentType": "text", "content": "gone"}},
+			{"id": "m5", "messageType": "systemEventMessage", "createdDateTime": "2024-01-01T12:00:00Z"},
+			{"id": "m6", "messageType": "message", "createdDateTime": "2024-01-01T13:00:00Z",
+			 "from": {"application": {"id": "app"}}, "body": {"contentType": "text", "content": "bot"}}
+		]}`,
+		"channels/c1/pinned.json":   `[{"message": {"id": "m1"}}]`,
+		"channels/c2/channel.json":  `{"id": "c2", "displayName": "Secret Plans!", "membershipType": "private"}`,
+		"channels/c2/members.json":  `[{"userId": "u1", "roles": ["owner"]}]`,
+		"channels/c3/channel.json":  `{"id": "c3", "displayName": "general", "membershipType": "standard"}`,
+		"chats/d1/chat.json":        `{"id": "d1", "chatType": "oneOnOne", "members": [{"userId": "u1"}, {"userId": "u2"}]}`,
+		"chats/d1/messages.json":    `[{"id": "d1m1", "messageType": "message", "createdDateTime": "2024-01-03T10:00:00Z", "from": {"user": {"id": "u2"}}, "body": {"contentType": "text", "content": "hello"}}]`,
+		"chats/d2/chat.json":        `{"id": "d2", "chatType": "group", "topic": "Lunch", "members": [{"userId": "u1"}, {"userId": "u2"}, {"userId": "u3"}]}`,
+		"chats/d3/chat.json":        `{"id": "d3", "chatType": "oneOnOne", "members": [{"userId": "u1"}, {"userId": "ux"}]}`,
+		"chats/d4/messages.json":    `not json`,
+		"files/a1/notes.txt":        "file content",
+		"files/unused/unused.txt":   "unused",
+		"channels/c9/unrelated.bin": "ignored",
+	})
+
+	var out bytes.Buffer
+	report, appErr := ConvertToBulkImport(rctx, export, importconverter.Options{Team: "team"}, &out)
+	require.Nil(t, appErr)
+
+	assert.Equal(t, 3, report.Channels)
+	assert.Equal(t, 2, report.DirectChannels)
+	assert.Equal(t, 3, report.Users)
+	assert.Equal(t, 2, report.Posts)
+	assert.Equal(t, 2, report.Replies)
+	assert.Equal(t, 2, report.Reactions)
+	assert.Equal(t, 1, report.Attachments)
+	assert.ElementsMatch(t, []importconverter.SkippedItem{
+		{Type: "reaction", Channel: "general", Id: "m1", Reason: importconverter.SkipReasonInvalidEmojiName},
+		{Type: "attachment", Channel: "general", Id: "a2", Reason: importconverter.SkipReasonUnsupportedType},
+		{Type: "post", Channel: "general", Id: "m4", Reason: importconverter.SkipReasonDeletedMessage},
+		{Type: "post", Channel: "general", Id: "m5", Reason: importconverter.SkipReasonUnsupportedType},
+		{Type: "post", Channel: "general", Id: "m6", Reason: importconverter.SkipReasonUnknownUser},
+		{Type: "channel", Id: "c9", Reason: importconverter.SkipReasonParseError},
+		{Type: "channel", Id: "d3", Reason: importconverter.SkipReasonInvalidMembers},
+		{Type: "channel", Id: "d4", Reason: importconverter.SkipReasonParseError},
+		{Type: "file", Id: "chats/d4/messages.json", Reason: importconverter.SkipReasonParseError},
+	}, report.Skipped)
+
+	lines, files := readBulkImport(t, out.Bytes())
+	assert.Equal(t, map[string]string{"data/attachments/a1/notes.txt": "file content"}, files)
+
+	for _, line := range lines["channel"] {
+		require.Nil(t, imports.ValidateChannelImportData(line.Channel))
+	}
+	for _, line := range lines["user"] {
+		require.Nil(t, imports.ValidateUserImportData(line.User))
+	}
+	for _, line := range lines["post"] {
+		require.Nil(t, imports.ValidatePostImportData(line.Post, model.PostMessageMaxRunesV2))
+	}
+	for _, line := range lines["direct_channel"] {
+		require.Nil(t, imports.ValidateDirectChannelImportData(line.DirectChannel))
+	}
+	for _, line := range lines["direct_post"] {
+		require.Nil(t, imports.ValidateDirectPostImportData(line.DirectPost, model.PostMessageMaxRunesV2))
+	}
+
+	t.Run("channels", func(t *testing.T) {
+		channels := lines["channel"]
+		require.Len(t, channels, 3)
+		assert.Equal(t, "general", *channels[0].Channel.Name)
+		assert.Equal(t, "Everything", *channels[0].Channel.Purpose)
+		assert.Equal(t, model.ChannelTypeOpen, *channels[0].Channel.Type)
+		assert.Equal(t, "secret-plans", *channels[1].Channel.Name)
+		assert.Equal(t, model.ChannelTypePrivate, *channels[1].Channel.Type)
+		assert.Equal(t, "general-2", *channels[2].Channel.Name)
+	})
+
+	t.Run("users", func(t *testing.T) {
+		users := lines["user"]
+		require.Len(t, users, 3)
+
+		alice := users[0].User
+		assert.Equal(t, "alice", *alice.Username)
+		assert.Equal(t, "alice@example.com", *alice.Email)
+		assert.Equal(t, "Alice", *alice.FirstName)
+		assert.Equal(t, "Smith", *alice.LastName)
+		assert.Equal(t, "Engineer", *alice.Position)
+		assert.Nil(t, alice.DeleteAt)
+		channels := *(*alice.Teams)[0].Channels
+		require.Len(t, channels, 2)
+		assert.Equal(t, "channel_user channel_admin", *channels[0].Roles)
+
+		bob := users[1].User
+		assert.Equal(t, "bob.jones", *bob.Username)
+		assert.Equal(t, "bob.jones@example.com", *bob.Email)
+		assert.NotNil(t, bob.DeleteAt)
+
+		assert.Equal(t, "carol@example.com", *users[2].User.Email)
+	})
+
+	t.Run("posts", func(t *testing.T) {
+		posts := lines["post"]
+		require.Len(t, posts, 1)
+
+		root := posts[0].Post
+		assert.Equal(t, "alice", *root.User)
+		assert.Equal(t, "Hi @bob.jones and @channel", *root.Message)
+		assert.EqualValues(t, 1704103200000, *root.CreateAt)
+		assert.EqualValues(t, 1704103500000, *root.EditAt)
+		assert.True(t, *root.IsPinned)
+		require.Len(t, *root.Reactions, 2)
+		assert.Equal(t, "+1", *(*root.Reactions)[0].EmojiName)
+		assert.Equal(t, "tada", *(*root.Reactions)[1].EmojiName)
+
+		require.Len(t, *root.Replies, 2)
+		reply := (*root.Replies)[0]
+		assert.Equal(t, "reply", *reply.Message)
+		require.Len(t, *reply.Attachments, 1)
+		assert.Equal(t, "attachments/a1/notes.txt", *(*reply.Attachments)[0].Path)
+		assert.Equal(t, "flat reply", *(*root.Replies)[1].Message)
+	})
+
+	t.Run("direct channels", func(t *testing.T) {
+		channels := lines["direct_channel"]
+		require.Len(t, channels, 2)
+		assert.Equal(t, []string{"alice", "bob.jones"}, *channels[0].DirectChannel.Members)
+		assert.Equal(t, []string{"alice", "bob.jones", "carol"}, *channels[1].DirectChannel.Members)
+		assert.Equal(t, "Lunch", *channels[1].DirectChannel.Header)
+
+		require.Len(t, lines["direct_post"], 1)
+		post := lines["direct_post"][0].DirectPost
+		assert.Equal(t, "bob.jones", *post.User)
+		assert.Equal(t, "hello", *post.Message)
+	})
+
+	t.Run("missing team", func(t *testing.T) {
+		_, appErr := ConvertToBulkImport(rctx, export, importconverter.Options{}, io.Discard)
+		require.NotNil(t, appErr)
+	})
+}
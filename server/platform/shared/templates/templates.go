@@ -5,9 +5,12 @@ package templates
 
 import (
 	"bytes"
+	"fmt"
 	"html/template"
 	"io"
+	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 
 	"github.com/fsnotify/fsnotify"
@@ -18,6 +21,7 @@ import (
 // Container represents a set of templates that can be render
 type Container struct {
 	templates *template.Template
+	directory string
 	mutex     sync.RWMutex
 	stop      chan struct{}
 	stopped   chan struct{}
@@ -29,6 +33,10 @@ type Container struct {
 type Data struct {
 	Props map[string]any
 	HTML  map[string]template.HTML
+
+	// Locale is the language the template is rendered in, for the callers
+	// that customize the templates per language.
+	Locale string
 }
 
 func GetTemplateDirectory() (string, bool) {
@@ -43,7 +51,7 @@ func NewFromTemplate(templates *template.Template) *Container {
 
 // New creates a new templates container scanning a directory.
 func New(directory string) (*Container, error) {
-	c := &Container{}
+	c := &Container{directory: directory}
 
 	htmlTemplates, err := template.ParseGlob(filepath.Join(directory, "*.html"))
 	if err != nil {
@@ -79,6 +87,7 @@ func NewWithWatcher(directory string) (*Container, <-chan error, error) {
 
 	c := &Container{
 		templates: htmlTemplates,
+		directory: directory,
 		watch:     true,
 		stop:      make(chan struct{}),
 		stopped:   make(chan struct{}),
@@ -147,3 +156,44 @@ func (c *Container) Render(w io.Writer, templateName string, data Data) error {
 
 	return nil
 }
+
+// Source returns the text of the template with the given name, as defined in
+// the file of the same name in the directory of the container.
+func (c *Container) Source(templateName string) (string, error) {
+	if c.directory == "" {
+		return "", fmt.Errorf("the templates weren't loaded from a directory")
+	}
+
+	data, err := os.ReadFile(filepath.Join(c.directory, filepath.Base(templateName)+".html"))
+	if err != nil {
+		return "", err
+	}
+
+	source := strings.TrimSpace(string(data))
+	source = strings.TrimPrefix(source, fmt.Sprintf("{{define %q}}", templateName))
+	source = strings.TrimSuffix(source, "{{end}}")
+
+	return strings.TrimSpace(source), nil
+}
+
+// WithOverrides creates a new templates container from the directory of this
+// one, replacing the text of some of the templates by the one given for their
+// name. The overrides may use the other templates of the directory.
+func (c *Container) WithOverrides(overrides map[string]string) (*Container, error) {
+	if c.directory == "" {
+		return nil, fmt.Errorf("the templates weren't loaded from a directory")
+	}
+
+	htmlTemplates, err := template.ParseGlob(filepath.Join(c.directory, "*.html"))
+	if err != nil {
+		return nil, err
+	}
+
+	for name, text := range overrides {
+		if _, err := htmlTemplates.New(name).Parse(text); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Container{templates: htmlTemplates, directory: c.directory}, nil
+}
@@ -112,3 +112,51 @@ func TestRenderUnknownTemplate(t *testing.T) {
 	assert.Error(t, mt.Render(buf, "foo", Data{}))
 	assert.Equal(t, "", buf.String())
 }
+
+func TestSource(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "foo.html"), []byte("{{define \"foo\"}}\n<p>{{ .Props.Bar }}</p>\n{{end}}\n"), 0600))
+
+	mt, err := New(dir)
+	require.NoError(t, err)
+
+	source, err := mt.Source("foo")
+	require.NoError(t, err)
+	assert.Equal(t, "<p>{{ .Props.Bar }}</p>", source)
+
+	_, err = mt.Source("bar")
+	assert.Error(t, err)
+
+	_, err = NewFromTemplate(template.New("")).Source("foo")
+	assert.Error(t, err)
+}
+
+func TestWithOverrides(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "foo.html"), []byte(`{{define "foo"}}foo{{template "footer" .}}{{end}}`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "footer.html"), []byte(`{{define "footer"}}-{{.Props.Bar}}{{end}}`), 0600))
+
+	mt, err := New(dir)
+	require.NoError(t, err)
+
+	data := Data{Props: map[string]any{"Bar": "bar"}}
+
+	// The templates can be overridden after they were rendered.
+	text, err := mt.RenderToString("foo", data)
+	require.NoError(t, err)
+	assert.Equal(t, "foo-bar", text)
+
+	overridden, err := mt.WithOverrides(map[string]string{"footer": "+{{.Props.Bar}}"})
+	require.NoError(t, err)
+
+	text, err = overridden.RenderToString("foo", data)
+	require.NoError(t, err)
+	assert.Equal(t, "foo+bar", text)
+
+	text, err = mt.RenderToString("foo", data)
+	require.NoError(t, err)
+	assert.Equal(t, "foo-bar", text)
+
+	_, err = mt.WithOverrides(map[string]string{"footer": "{{.Props.Bar"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,411 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package webauthn verifies the registration and assertion ceremonies of WebAuthn (FIDO2)
+// credentials, as described in https://www.w3.org/TR/webauthn-2/#sctn-rp-operations.
+//
+// Attestation statements are checked for consistency but not used to decide whether an
+// authenticator is trusted: only the "none" and "packed" formats are supported.
+package webauthn
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"slices"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	ceremonyCreate = "webauthn.create"
+	ceremonyGet    = "webauthn.get"
+
+	flagUserPresent            = 0x01
+	flagUserVerified           = 0x04
+	flagBackupEligible         = 0x08
+	flagAttestedCredentialData = 0x40
+	flagExtensionData          = 0x80
+
+	// COSE algorithm identifiers, from https://www.iana.org/assignments/cose/cose.xhtml.
+	AlgES256 = -7
+	AlgEdDSA = -8
+	AlgRS256 = -257
+)
+
+// SupportedAlgorithms lists the supported COSE algorithms, in order of preference.
+var SupportedAlgorithms = []int64{AlgES256, AlgEdDSA, AlgRS256}
+
+var (
+	// ErrVerification indicates that a response doesn't match the ceremony it answers.
+	ErrVerification = errors.New("webauthn verification failed")
+	// ErrSignCount indicates that the signature counter of a credential went backwards,
+	// which is a sign of a cloned authenticator.
+	ErrSignCount = errors.New("webauthn signature counter did not increase")
+)
+
+// RelyingParty identifies the server the credentials are scoped to.
+type RelyingParty struct {
+	// ID is the domain the credentials are scoped to.
+	ID string
+	// Origins are the origins allowed to run the ceremonies.
+	Origins []string
+}
+
+// Credential is a credential created by a registration ceremony.
+type Credential struct {
+	ID []byte
+	// PublicKey is the COSE encoded public key of the credential.
+	PublicKey      []byte
+	SignCount      uint32
+	AAGUID         []byte
+	UserVerified   bool
+	BackupEligible bool
+}
+
+type clientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+type authenticatorData struct {
+	rpIDHash  []byte
+	flags     byte
+	signCount uint32
+
+	aaguid       []byte
+	credentialID []byte
+	publicKey    []byte
+}
+
+// ChallengeFromClientData returns the challenge a client data JSON answers, before it's
+// verified, for the relying party to find the ceremony it belongs to.
+func ChallengeFromClientData(clientDataJSON []byte) ([]byte, error) {
+	var data clientData
+	if err := json.Unmarshal(clientDataJSON, &data); err != nil {
+		return nil, errors.Wrap(err, "failed to decode client data")
+	}
+
+	challenge, err := base64.RawURLEncoding.DecodeString(data.Challenge)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode challenge")
+	}
+
+	return challenge, nil
+}
+
+func (rp RelyingParty) verifyClientData(clientDataJSON []byte, ceremony string, challenge []byte) error {
+	var data clientData
+	if err := json.Unmarshal(clientDataJSON, &data); err != nil {
+		return errors.Wrap(err, "failed to decode client data")
+	}
+
+	if data.Type != ceremony {
+		return errors.Wrapf(ErrVerification, "unexpected ceremony %q", data.Type)
+	}
+
+	received, err := base64.RawURLEncoding.DecodeString(data.Challenge)
+	if err != nil || subtle.ConstantTimeCompare(received, challenge) != 1 {
+		return errors.Wrap(ErrVerification, "challenge mismatch")
+	}
+
+	if data.CrossOrigin || !slices.Contains(rp.Origins, data.Origin) {
+		return errors.Wrapf(ErrVerification, "unexpected origin %q", data.Origin)
+	}
+
+	return nil
+}
+
+func (rp RelyingParty) verifyAuthenticatorData(data *authenticatorData, requireUserVerification bool) error {
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if !bytes.Equal(data.rpIDHash, rpIDHash[:]) {
+		return errors.Wrap(ErrVerification, "relying party id mismatch")
+	}
+
+	if data.flags&flagUserPresent == 0 {
+		return errors.Wrap(ErrVerification, "user not present")
+	}
+
+	if requireUserVerification && data.flags&flagUserVerified == 0 {
+		return errors.Wrap(ErrVerification, "user not verified")
+	}
+
+	return nil
+}
+
+func parseAuthenticatorData(raw []byte) (*authenticatorData, error) {
+	if len(raw) < 37 {
+		return nil, errors.New("authenticator data too short")
+	}
+
+	data := &authenticatorData{
+		rpIDHash:  raw[:32],
+		flags:     raw[32],
+		signCount: binary.BigEndian.Uint32(raw[33:37]),
+	}
+	rest := raw[37:]
+
+	if data.flags&flagAttestedCredentialData != 0 {
+		if len(rest) < 18 {
+			return nil, errors.New("attested credential data too short")
+		}
+		data.aaguid = rest[:16]
+		idLength := int(binary.BigEndian.Uint16(rest[16:18]))
+		rest = rest[18:]
+		if len(rest) < idLength {
+			return nil, errors.New("credential id truncated")
+		}
+		data.credentialID = rest[:idLength]
+		rest = rest[idLength:]
+
+		_, after, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode credential public key")
+		}
+		data.publicKey = rest[:len(rest)-len(after)]
+		rest = after
+	}
+
+	if data.flags&flagExtensionData != 0 {
+		var err error
+		if _, rest, err = decodeCBOR(rest); err != nil {
+			return nil, errors.Wrap(err, "failed to decode extensions")
+		}
+	}
+
+	if len(rest) != 0 {
+		return nil, errors.New("unexpected trailing authenticator data")
+	}
+
+	return data, nil
+}
+
+// VerifyRegistration verifies the response to a registration ceremony, returning the created
+// credential.
+func (rp RelyingParty) VerifyRegistration(challenge, clientDataJSON, attestationObject []byte, requireUserVerification bool) (*Credential, error) {
+	if err := rp.verifyClientData(clientDataJSON, ceremonyCreate, challenge); err != nil {
+		return nil, err
+	}
+
+	decoded, rest, err := decodeCBOR(attestationObject)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode attestation object")
+	}
+	object, ok := decoded.(map[any]any)
+	if !ok || len(rest) != 0 {
+		return nil, errors.New("malformed attestation object")
+	}
+
+	format, _ := object["fmt"].(string)
+	statement, _ := object["attStmt"].(map[any]any)
+	rawAuthData, _ := object["authData"].([]byte)
+	if statement == nil || rawAuthData == nil {
+		return nil, errors.New("malformed attestation object")
+	}
+
+	authData, err := parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return nil, err
+	}
+	if err = rp.verifyAuthenticatorData(authData, requireUserVerification); err != nil {
+		return nil, err
+	}
+	if authData.publicKey == nil {
+		return nil, errors.Wrap(ErrVerification, "missing attested credential data")
+	}
+
+	publicKey, alg, err := parsePublicKey(authData.publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signed := append(append([]byte(nil), rawAuthData...), clientDataHash[:]...)
+
+	switch format {
+	case "none":
+		if len(statement) != 0 {
+			return nil, errors.Wrap(ErrVerification, "unexpected attestation statement")
+		}
+	case "packed":
+		if err = verifyPackedAttestation(statement, signed, publicKey, alg); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.Errorf("unsupported attestation format %q", format)
+	}
+
+	return &Credential{
+		ID:             append([]byte(nil), authData.credentialID...),
+		PublicKey:      append([]byte(nil), authData.publicKey...),
+		SignCount:      authData.signCount,
+		AAGUID:         append([]byte(nil), authData.aaguid...),
+		UserVerified:   authData.flags&flagUserVerified != 0,
+		BackupEligible: authData.flags&flagBackupEligible != 0,
+	}, nil
+}
+
+func verifyPackedAttestation(statement map[any]any, signed []byte, credentialKey crypto.PublicKey, credentialAlg int64) error {
+	alg, ok := statement["alg"].(int64)
+	if !ok {
+		return errors.Wrap(ErrVerification, "missing attestation algorithm")
+	}
+	sig, ok := statement["sig"].([]byte)
+	if !ok {
+		return errors.Wrap(ErrVerification, "missing attestation signature")
+	}
+
+	chain, ok := statement["x5c"].([]any)
+	if !ok {
+		// Self attestation, signed by the credential itself.
+		if alg != credentialAlg {
+			return errors.Wrap(ErrVerification, "attestation algorithm mismatch")
+		}
+		return verifySignature(credentialKey, alg, signed, sig)
+	}
+
+	if len(chain) == 0 {
+		return errors.Wrap(ErrVerification, "empty attestation certificate chain")
+	}
+	der, ok := chain[0].([]byte)
+	if !ok {
+		return errors.Wrap(ErrVerification, "malformed attestation certificate")
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse attestation certificate")
+	}
+
+	return verifySignature(cert.PublicKey, alg, signed, sig)
+}
+
+// VerifyAssertion verifies the response to an authentication ceremony with a credential
+// previously registered, returning the new signature counter of the credential.
+func (rp RelyingParty) VerifyAssertion(challenge, publicKey []byte, signCount uint32, clientDataJSON, rawAuthData, signature []byte, requireUserVerification bool) (uint32, error) {
+	if err := rp.verifyClientData(clientDataJSON, ceremonyGet, challenge); err != nil {
+		return 0, err
+	}
+
+	authData, err := parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return 0, err
+	}
+	if err = rp.verifyAuthenticatorData(authData, requireUserVerification); err != nil {
+		return 0, err
+	}
+
+	key, alg, err := parsePublicKey(publicKey)
+	if err != nil {
+		return 0, err
+	}
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signed := append(append([]byte(nil), rawAuthData...), clientDataHash[:]...)
+	if err = verifySignature(key, alg, signed, signature); err != nil {
+		return 0, err
+	}
+
+	// Authenticators without a counter always report zero, synced passkeys included.
+	if (signCount != 0 || authData.signCount != 0) && authData.signCount <= signCount {
+		return 0, ErrSignCount
+	}
+
+	return authData.signCount, nil
+}
+
+// ValidatePublicKey checks that a COSE encoded public key uses a supported algorithm.
+func ValidatePublicKey(publicKey []byte) error {
+	_, _, err := parsePublicKey(publicKey)
+	return err
+}
+
+func parsePublicKey(raw []byte) (crypto.PublicKey, int64, error) {
+	decoded, rest, err := decodeCBOR(raw)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to decode public key")
+	}
+	key, ok := decoded.(map[any]any)
+	if !ok || len(rest) != 0 {
+		return nil, 0, errors.New("malformed public key")
+	}
+
+	kty, _ := key[int64(1)].(int64)
+	alg, _ := key[int64(3)].(int64)
+
+	switch {
+	case kty == 2 && alg == AlgES256:
+		crv, _ := key[int64(-1)].(int64)
+		x, _ := key[int64(-2)].([]byte)
+		y, _ := key[int64(-3)].([]byte)
+		if crv != 1 || len(x) != 32 || len(y) != 32 {
+			return nil, 0, errors.New("malformed P-256 public key")
+		}
+		// Reject the points which aren't on the curve.
+		if _, err := ecdh.P256().NewPublicKey(append(append([]byte{4}, x...), y...)); err != nil {
+			return nil, 0, errors.Wrap(err, "invalid P-256 public key")
+		}
+		return &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}, alg, nil
+	case kty == 1 && alg == AlgEdDSA:
+		crv, _ := key[int64(-1)].(int64)
+		x, _ := key[int64(-2)].([]byte)
+		if crv != 6 || len(x) != ed25519.PublicKeySize {
+			return nil, 0, errors.New("malformed Ed25519 public key")
+		}
+		return ed25519.PublicKey(x), alg, nil
+	case kty == 3 && alg == AlgRS256:
+		n, _ := key[int64(-1)].([]byte)
+		e, _ := key[int64(-2)].([]byte)
+		if len(n) < 256 || len(e) == 0 || len(e) > 4 {
+			return nil, 0, errors.New("malformed RSA public key")
+		}
+		exponent := 0
+		for _, b := range e {
+			exponent = exponent<<8 | int(b)
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: exponent}, alg, nil
+	default:
+		return nil, 0, errors.Errorf("unsupported public key type %d with algorithm %d", kty, alg)
+	}
+}
+
+func verifySignature(key crypto.PublicKey, alg int64, signed, sig []byte) error {
+	var ok bool
+	switch alg {
+	case AlgES256:
+		pub, isECDSA := key.(*ecdsa.PublicKey)
+		digest := sha256.Sum256(signed)
+		ok = isECDSA && ecdsa.VerifyASN1(pub, digest[:], sig)
+	case AlgEdDSA:
+		pub, isEd25519 := key.(ed25519.PublicKey)
+		ok = isEd25519 && ed25519.Verify(pub, signed, sig)
+	case AlgRS256:
+		pub, isRSA := key.(*rsa.PublicKey)
+		digest := sha256.Sum256(signed)
+		ok = isRSA && rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], sig) == nil
+	default:
+		return errors.Errorf("unsupported signature algorithm %d", alg)
+	}
+
+	if !ok {
+		return errors.Wrap(ErrVerification, "invalid signature")
+	}
+
+	return nil
+}
@@ -0,0 +1,395 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRPID   = "chat.example.com"
+	testOrigin = "https://chat.example.com"
+)
+
+var testRP = RelyingParty{ID: testRPID, Origins: []string{testOrigin}}
+
+// cborMap is a CBOR map which keeps the order of its entries when encoded.
+type cborMap []cborEntry
+
+type cborEntry struct {
+	key   any
+	value any
+}
+
+func encodeCBORHead(major byte, n uint64) []byte {
+	switch {
+	case n < 24:
+		return []byte{major<<5 | byte(n)}
+	case n <= 0xff:
+		return []byte{major<<5 | 24, byte(n)}
+	case n <= 0xffff:
+		return binary.BigEndian.AppendUint16([]byte{major<<5 | 25}, uint16(n))
+	default:
+		return binary.BigEndian.AppendUint32([]byte{major<<5 | 26}, uint32(n))
+	}
+}
+
+func encodeCBOR(v any) []byte {
+	switch v := v.(type) {
+	case int:
+		if v < 0 {
+			return encodeCBORHead(1, uint64(-1-v))
+		}
+		return encodeCBORHead(0, uint64(v))
+	case []byte:
+		return append(encodeCBORHead(2, uint64(len(v))), v...)
+	case string:
+		return append(encodeCBORHead(3, uint64(len(v))), v...)
+	case []any:
+		out := encodeCBORHead(4, uint64(len(v)))
+		for _, item := range v {
+			out = append(out, encodeCBOR(item)...)
+		}
+		return out
+	case cborMap:
+		out := encodeCBORHead(5, uint64(len(v)))
+		for _, entry := range v {
+			out = append(out, encodeCBOR(entry.key)...)
+			out = append(out, encodeCBOR(entry.value)...)
+		}
+		return out
+	default:
+		panic("unsupported value")
+	}
+}
+
+func TestDecodeCBOR(t *testing.T) {
+	// Examples from appendix A of RFC 8949.
+	for _, tc := range []struct {
+		hex      string
+		expected any
+	}{
+		{"00", int64(0)},
+		{"17", int64(23)},
+		{"1864", int64(100)},
+		{"1903e8", int64(1000)},
+		{"1b000000e8d4a51000", int64(1000000000000)},
+		{"20", int64(-1)},
+		{"3903e7", int64(-1000)},
+		{"4401020304", []byte{1, 2, 3, 4}},
+		{"6449455446", "IETF"},
+		{"83010203", []any{int64(1), int64(2), int64(3)}},
+		{"a201020304", map[any]any{int64(1): int64(2), int64(3): int64(4)}},
+		{"a26161016162820203", map[any]any{"a": int64(1), "b": []any{int64(2), int64(3)}}},
+		{"f4", false},
+		{"f5", true},
+		{"f6", nil},
+		{"f93c00", 1.0},
+		{"f9c400", -4.0},
+		{"fa47c35000", 100000.0},
+		{"fb3ff199999999999a", 1.1},
+		{"c11a514b67b0", int64(1363896240)},
+	} {
+		t.Run(tc.hex, func(t *testing.T) {
+			data, err := hex.DecodeString(tc.hex)
+			require.NoError(t, err)
+
+			value, rest, err := decodeCBOR(data)
+			require.NoError(t, err)
+			assert.Empty(t, rest)
+			assert.Equal(t, tc.expected, value)
+		})
+	}
+
+	for name, data := range map[string]string{
+		"truncated integer":   "1a0000",
+		"truncated string":    "4401",
+		"truncated array":     "830102",
+		"indefinite length":   "5f42010243030405ff",
+		"unsupported map key": "a1f401",
+		"duplicate map key":   "a201020103",
+		"too deep":            strings.Repeat("81", maxCBORDepth+2) + "00",
+		"huge array":          "9b00000000ffffffff00",
+	} {
+		t.Run(name, func(t *testing.T) {
+			raw, err := hex.DecodeString(data)
+			require.NoError(t, err)
+
+			_, _, err = decodeCBOR(raw)
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("returns the following bytes", func(t *testing.T) {
+		value, rest, err := decodeCBOR([]byte{0x01, 0x02})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), value)
+		assert.Equal(t, []byte{0x02}, rest)
+	})
+}
+
+// testAuthenticator simulates an authenticator holding a single credential.
+type testAuthenticator struct {
+	credentialID []byte
+	signer       crypto.Signer
+	alg          int
+	signCount    uint32
+	flags        byte
+}
+
+func newTestAuthenticator(t *testing.T, alg int) *testAuthenticator {
+	auth := &testAuthenticator{
+		credentialID: []byte("credential-" + t.Name()),
+		alg:          alg,
+		flags:        flagUserPresent | flagUserVerified,
+	}
+
+	var err error
+	switch alg {
+	case AlgES256:
+		auth.signer, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case AlgEdDSA:
+		_, auth.signer, err = ed25519.GenerateKey(rand.Reader)
+	case AlgRS256:
+		auth.signer, err = rsa.GenerateKey(rand.Reader, 2048)
+	}
+	require.NoError(t, err)
+
+	return auth
+}
+
+func (a *testAuthenticator) publicKey() []byte {
+	switch pub := a.signer.Public().(type) {
+	case *ecdsa.PublicKey:
+		return encodeCBOR(cborMap{
+			{1, 2}, {3, AlgES256}, {-1, 1},
+			{-2, pub.X.FillBytes(make([]byte, 32))},
+			{-3, pub.Y.FillBytes(make([]byte, 32))},
+		})
+	case ed25519.PublicKey:
+		return encodeCBOR(cborMap{{1, 1}, {3, AlgEdDSA}, {-1, 6}, {-2, []byte(pub)}})
+	case *rsa.PublicKey:
+		return encodeCBOR(cborMap{{1, 3}, {3, AlgRS256}, {-1, pub.N.Bytes()}, {-2, big.NewInt(int64(pub.E)).Bytes()}})
+	}
+	panic("unsupported key")
+}
+
+func (a *testAuthenticator) authData(rpID string, attested bool) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	data := append(rpIDHash[:], a.flags)
+	data = binary.BigEndian.AppendUint32(data, a.signCount)
+	if attested {
+		data[32] |= flagAttestedCredentialData
+		data = append(data, make([]byte, 16)...)
+		data = binary.BigEndian.AppendUint16(data, uint16(len(a.credentialID)))
+		data = append(data, a.credentialID...)
+		data = append(data, a.publicKey()...)
+	}
+	return data
+}
+
+func sign(t *testing.T, signer crypto.Signer, data []byte) []byte {
+	opts := crypto.Hash(0)
+	if _, ok := signer.(ed25519.PrivateKey); !ok {
+		digest := sha256.Sum256(data)
+		data = digest[:]
+		opts = crypto.SHA256
+	}
+
+	sig, err := signer.Sign(rand.Reader, data, opts)
+	require.NoError(t, err)
+	return sig
+}
+
+func clientDataJSON(t *testing.T, ceremony string, challenge []byte, origin string) []byte {
+	data, err := json.Marshal(clientData{
+		Type:      ceremony,
+		Challenge: base64.RawURLEncoding.EncodeToString(challenge),
+		Origin:    origin,
+	})
+	require.NoError(t, err)
+	return data
+}
+
+func (a *testAuthenticator) attestationObject(t *testing.T, format string, clientDataJSON []byte, rpID string) []byte {
+	authData := a.authData(rpID, true)
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signed := append(append([]byte(nil), authData...), clientDataHash[:]...)
+
+	statement := cborMap{}
+	switch format {
+	case "packed":
+		statement = cborMap{{"alg", a.alg}, {"sig", sign(t, a.signer, signed)}}
+	case "packed-x5c":
+		format = "packed"
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "Test Authenticator"},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+		}
+		cert, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+		require.NoError(t, err)
+		statement = cborMap{{"alg", AlgES256}, {"sig", sign(t, key, signed)}, {"x5c", []any{cert}}}
+	}
+
+	return encodeCBOR(cborMap{{"fmt", format}, {"attStmt", statement}, {"authData", authData}})
+}
+
+func TestVerifyRegistration(t *testing.T) {
+	challenge := []byte("registration-challenge")
+
+	for name, alg := range map[string]int{"ES256": AlgES256, "EdDSA": AlgEdDSA, "RS256": AlgRS256} {
+		for _, format := range []string{"none", "packed", "packed-x5c"} {
+			t.Run(name+" "+format, func(t *testing.T) {
+				auth := newTestAuthenticator(t, alg)
+				auth.signCount = 1
+				clientData := clientDataJSON(t, ceremonyCreate, challenge, testOrigin)
+
+				credential, err := testRP.VerifyRegistration(challenge, clientData, auth.attestationObject(t, format, clientData, testRPID), true)
+				require.NoError(t, err)
+				assert.Equal(t, auth.credentialID, credential.ID)
+				assert.Equal(t, auth.publicKey(), credential.PublicKey)
+				assert.Equal(t, uint32(1), credential.SignCount)
+				assert.True(t, credential.UserVerified)
+				assert.NoError(t, ValidatePublicKey(credential.PublicKey))
+			})
+		}
+	}
+
+	t.Run("rejects invalid responses", func(t *testing.T) {
+		auth := newTestAuthenticator(t, AlgES256)
+
+		for name, tc := range map[string]struct {
+			ceremony string
+			origin   string
+			rpID     string
+			format   string
+		}{
+			"wrong ceremony": {ceremonyGet, testOrigin, testRPID, "none"},
+			"wrong origin":   {ceremonyCreate, "https://evil.example.com", testRPID, "none"},
+			"wrong rp id":    {ceremonyCreate, testOrigin, "evil.example.com", "none"},
+			"unknown format": {ceremonyCreate, testOrigin, testRPID, "fido-u2f"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				clientData := clientDataJSON(t, tc.ceremony, challenge, tc.origin)
+				_, err := testRP.VerifyRegistration(challenge, clientData, auth.attestationObject(t, tc.format, clientData, tc.rpID), false)
+				require.Error(t, err)
+			})
+		}
+
+		t.Run("wrong challenge", func(t *testing.T) {
+			clientData := clientDataJSON(t, ceremonyCreate, []byte("other"), testOrigin)
+			_, err := testRP.VerifyRegistration(challenge, clientData, auth.attestationObject(t, "none", clientData, testRPID), false)
+			require.ErrorIs(t, err, ErrVerification)
+		})
+
+		t.Run("bad self attestation signature", func(t *testing.T) {
+			clientData := clientDataJSON(t, ceremonyCreate, challenge, testOrigin)
+			object := auth.attestationObject(t, "packed", clientDataJSON(t, ceremonyCreate, []byte("other"), testOrigin), testRPID)
+			_, err := testRP.VerifyRegistration(challenge, clientData, object, false)
+			require.ErrorIs(t, err, ErrVerification)
+		})
+
+		t.Run("user verification required", func(t *testing.T) {
+			unverified := newTestAuthenticator(t, AlgES256)
+			unverified.flags = flagUserPresent
+			clientData := clientDataJSON(t, ceremonyCreate, challenge, testOrigin)
+			object := unverified.attestationObject(t, "none", clientData, testRPID)
+
+			_, err := testRP.VerifyRegistration(challenge, clientData, object, true)
+			require.ErrorIs(t, err, ErrVerification)
+
+			credential, err := testRP.VerifyRegistration(challenge, clientData, object, false)
+			require.NoError(t, err)
+			assert.False(t, credential.UserVerified)
+		})
+	})
+}
+
+func TestVerifyAssertion(t *testing.T) {
+	challenge := []byte("assertion-challenge")
+
+	verify := func(t *testing.T, auth *testAuthenticator, storedCount uint32, ceremony string) (uint32, error) {
+		t.Helper()
+		clientData := clientDataJSON(t, ceremony, challenge, testOrigin)
+		authData := auth.authData(testRPID, false)
+		clientDataHash := sha256.Sum256(clientData)
+		sig := sign(t, auth.signer, append(append([]byte(nil), authData...), clientDataHash[:]...))
+		return testRP.VerifyAssertion(challenge, auth.publicKey(), storedCount, clientData, authData, sig, true)
+	}
+
+	for name, alg := range map[string]int{"ES256": AlgES256, "EdDSA": AlgEdDSA, "RS256": AlgRS256} {
+		t.Run(name, func(t *testing.T) {
+			auth := newTestAuthenticator(t, alg)
+			auth.signCount = 5
+
+			count, err := verify(t, auth, 4, ceremonyGet)
+			require.NoError(t, err)
+			require.Equal(t, uint32(5), count)
+		})
+	}
+
+	t.Run("authenticators without counter", func(t *testing.T) {
+		count, err := verify(t, newTestAuthenticator(t, AlgES256), 0, ceremonyGet)
+		require.NoError(t, err)
+		require.Zero(t, count)
+	})
+
+	t.Run("counter going backwards", func(t *testing.T) {
+		auth := newTestAuthenticator(t, AlgES256)
+		auth.signCount = 3
+
+		_, err := verify(t, auth, 3, ceremonyGet)
+		require.ErrorIs(t, err, ErrSignCount)
+	})
+
+	t.Run("wrong ceremony", func(t *testing.T) {
+		_, err := verify(t, newTestAuthenticator(t, AlgES256), 0, ceremonyCreate)
+		require.ErrorIs(t, err, ErrVerification)
+	})
+
+	t.Run("signature of another credential", func(t *testing.T) {
+		auth := newTestAuthenticator(t, AlgES256)
+		other := newTestAuthenticator(t, AlgES256)
+
+		clientData := clientDataJSON(t, ceremonyGet, challenge, testOrigin)
+		authData := auth.authData(testRPID, false)
+		clientDataHash := sha256.Sum256(clientData)
+		sig := sign(t, other.signer, append(append([]byte(nil), authData...), clientDataHash[:]...))
+
+		_, err := testRP.VerifyAssertion(challenge, auth.publicKey(), 0, clientData, authData, sig, false)
+		require.ErrorIs(t, err, ErrVerification)
+	})
+}
+
+func TestChallengeFromClientData(t *testing.T) {
+	challenge, err := ChallengeFromClientData(clientDataJSON(t, ceremonyGet, []byte("challenge"), testOrigin))
+	require.NoError(t, err)
+	require.Equal(t, []byte("challenge"), challenge)
+
+	_, err = ChallengeFromClientData([]byte("{"))
+	require.Error(t, err)
+}
@@ -19,6 +19,8 @@ const (
 	AuditEventGetAudits                 = "getAudits"                 // get audit log entries
 	AuditEventGetUserAudits             = "getUserAudits"             // get audit log entries for specific user
 	AuditEventRemoveAuditLogCertificate = "removeAuditLogCertificate" // remove certificate used for audit log transmission
+	AuditEventSearchAuditLogs           = "searchAuditLogs"           // search audit logs kept in the database
+	AuditEventVerifyAuditLogs           = "verifyAuditLogs"           // verify chain of audit logs kept in the database
 )
 
 // Bots
@@ -112,6 +114,7 @@ const (
 	AuditEventLocalUpdateConfig    = "localUpdateConfig"    // update server configuration locally
 	AuditEventMigrateConfig        = "migrateConfig"        // migrate configs with file values from one store to another
 	AuditEventPatchConfig          = "patchConfig"          // update server configuration
+	AuditEventRollbackConfig       = "rollbackConfig"       // restore a previous revision of the server configuration
 	AuditEventUpdateConfig         = "updateConfig"         // update server configuration
 )
 
@@ -134,6 +137,13 @@ const (
 	AuditEventRemoveTeamsFromPolicy    = "removeTeamsFromPolicy"    // remove teams from data retention policy
 )
 
+// Email Templates
+const (
+	AuditEventDeleteEmailTemplate  = "deleteEmailTemplate"  // restore built-in email template
+	AuditEventPreviewEmailTemplate = "previewEmailTemplate" // send email template preview
+	AuditEventUpdateEmailTemplate  = "updateEmailTemplate"  // update email template
+)
+
 // Emojis
 const (
 	AuditEventCreateEmoji = "createEmoji" // create emoji
@@ -253,6 +263,12 @@ const (
 	AuditEventUpdatePost         = "updatePost"         // update post content
 )
 
+// Polls
+const (
+	AuditEventClosePoll  = "closePoll"  // close poll before its close time
+	AuditEventCreatePoll = "createPoll" // create poll and its post
+)
+
 // Preferences
 const (
 	AuditEventDeletePreferences = "deletePreferences" // delete user preferences
@@ -381,6 +397,7 @@ const (
 	AuditEventCreateUser                   = "createUser"                   // create user account
 	AuditEventCreateUserAccessToken        = "createUserAccessToken"        // create personal access token for user API access
 	AuditEventDeleteUser                   = "deleteUser"                   // delete user account
+	AuditEventDeleteWebAuthnCredential     = "deleteWebAuthnCredential"     // delete passkey or security key of user
 	AuditEventDemoteUserToGuest            = "demoteUserToGuest"            // demote regular user to guest account with limited permissions
 	AuditEventDisableUserAccessToken       = "disableUserAccessToken"       // disable user personal access token
 	AuditEventEnableUserAccessToken        = "enableUserAccessToken"        // enable user personal access token
@@ -388,15 +405,18 @@ const (
 	AuditEventLocalDeleteUser              = "localDeleteUser"              // delete user locally
 	AuditEventLocalPermanentDeleteAllUsers = "localPermanentDeleteAllUsers" // permanently delete all users locally
 	AuditEventLogin                        = "login"                        // user login to system
+	AuditEventLoginWithWebAuthn            = "loginWithWebAuthn"            // user passwordless login with passkey
 	AuditEventLogout                       = "logout"                       // user logout from system
 	AuditEventMigrateAuthToLdap            = "migrateAuthToLdap"            // migrate user authentication method to LDAP
 	AuditEventMigrateAuthToSaml            = "migrateAuthToSaml"            // migrate user authentication method to SAML
 	AuditEventPatchUser                    = "patchUser"                    // update user properties
 	AuditEventPromoteGuestToUser           = "promoteGuestToUser"           // promote guest account to regular user
+	AuditEventRegisterWebAuthnCredential   = "registerWebAuthnCredential"   // register passkey or security key for user
 	AuditEventResetPassword                = "resetPassword"                // reset user password
 	AuditEventResetPasswordFailedAttempts  = "resetPasswordFailedAttempts"  // reset failed password attempt counter
 	AuditEventRevokeAllSessionsAllUsers    = "revokeAllSessionsAllUsers"    // revoke all active sessions for all users
 	AuditEventRevokeAllSessionsForUser     = "revokeAllSessionsForUser"     // revoke all active sessions for specific user
+	AuditEventRevokeOtherSessionsForUser   = "revokeOtherSessionsForUser"   // revoke all active sessions for specific user but the current one
 	AuditEventRevokeSession                = "revokeSession"                // revoke specific user session
 	AuditEventRevokeUserAccessToken        = "revokeUserAccessToken"        // revoke user personal access token
 	AuditEventSendPasswordReset            = "sendPasswordReset"            // send password reset email to user
@@ -416,14 +436,20 @@ const (
 
 // Webhooks
 const (
-	AuditEventCreateIncomingHook      = "createIncomingHook"      // create incoming webhook
-	AuditEventCreateOutgoingHook      = "createOutgoingHook"      // create outgoing webhook
-	AuditEventDeleteIncomingHook      = "deleteIncomingHook"      // delete incoming webhook
-	AuditEventDeleteOutgoingHook      = "deleteOutgoingHook"      // delete outgoing webhook
-	AuditEventGetIncomingHook         = "getIncomingHook"         // get incoming webhook details
-	AuditEventGetOutgoingHook         = "getOutgoingHook"         // get outgoing webhook details
-	AuditEventLocalCreateIncomingHook = "localCreateIncomingHook" // create incoming webhook locally
-	AuditEventRegenOutgoingHookToken  = "regenOutgoingHookToken"  // regenerate authentication token
-	AuditEventUpdateIncomingHook      = "updateIncomingHook"      // update incoming webhook
-	AuditEventUpdateOutgoingHook      = "updateOutgoingHook"      // update outgoing webhook
+	AuditEventCreateEventSubscription      = "createEventSubscription"      // create server event subscription
+	AuditEventCreateIncomingHook           = "createIncomingHook"           // create incoming webhook
+	AuditEventCreateOutgoingHook           = "createOutgoingHook"           // create outgoing webhook
+	AuditEventDeleteEventSubscription      = "deleteEventSubscription"      // delete server event subscription
+	AuditEventDeleteIncomingHook           = "deleteIncomingHook"           // delete incoming webhook
+	AuditEventDeleteOutgoingHook           = "deleteOutgoingHook"           // delete outgoing webhook
+	AuditEventGetIncomingHook              = "getIncomingHook"              // get incoming webhook details
+	AuditEventGetOutgoingHook              = "getOutgoingHook"              // get outgoing webhook details
+	AuditEventLocalCreateIncomingHook      = "localCreateIncomingHook"      // create incoming webhook locally
+	AuditEventPatchEventSubscription       = "patchEventSubscription"       // update server event subscription
+	AuditEventRegenEventSubscriptionSecret = "regenEventSubscriptionSecret" // regenerate event subscription signing secret
+	AuditEventRegenOutgoingHookSecret      = "regenOutgoingHookSecret"      // regenerate signing secret
+	AuditEventRegenOutgoingHookToken       = "regenOutgoingHookToken"       // regenerate authentication token
+	AuditEventReplayOutgoingHookDelivery   = "replayOutgoingHookDelivery"   // send an outgoing webhook delivery again
+	AuditEventUpdateIncomingHook           = "updateIncomingHook"           // update incoming webhook
+	AuditEventUpdateOutgoingHook           = "updateOutgoingHook"           // update outgoing webhook
 )
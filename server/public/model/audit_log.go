@@ -0,0 +1,257 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"sort"
+)
+
+const (
+	AuditLogUserIdMaxLength     = 128
+	AuditLogEventNameMaxLength  = 128
+	AuditLogStatusMaxLength     = 16
+	AuditLogIpAddressMaxLength  = 64
+	AuditLogObjectTypeMaxLength = 64
+	AuditLogSigningKeyMinLength = 32
+
+	AuditLogVerificationHash     = "hash"
+	AuditLogVerificationPrevHash = "prev_hash"
+	AuditLogVerificationSequence = "sequence"
+	AuditLogVerificationCutoff   = "cutoff"
+)
+
+// AuditLog is an audit record kept in the database. The audit logs form a chain,
+// each one holding the hash of the previous one, so that an audit log changed or
+// removed after the fact breaks the chain. The hashes are keyed with the signing
+// key of the configuration, for the chain not to be computed again without it.
+type AuditLog struct {
+	Id         string      `json:"id"`
+	Sequence   int64       `json:"sequence"`
+	CreateAt   int64       `json:"create_at"`
+	EventName  string      `json:"event_name"`
+	Status     string      `json:"status"`
+	UserId     string      `json:"user_id"`
+	SessionId  string      `json:"session_id"`
+	IpAddress  string      `json:"ip_address"`
+	ObjectType string      `json:"object_type"`
+	ObjectIds  StringArray `json:"object_ids"`
+	// Record is the audit record as JSON. It is kept as text so that the hash of
+	// the audit log can be computed again from the very same bytes.
+	Record   string `json:"record"`
+	PrevHash string `json:"prev_hash"`
+	Hash     string `json:"hash"`
+}
+
+// AuditLogSearch filters the audit logs. The times are in milliseconds, and
+// either of them can be left to zero.
+type AuditLogSearch struct {
+	UserId    string `json:"user_id"`
+	EventName string `json:"event_name"`
+	ObjectId  string `json:"object_id"`
+	Since     int64  `json:"since"`
+	Until     int64  `json:"until"`
+	Page      int    `json:"page"`
+	PerPage   int    `json:"per_page"`
+}
+
+// AuditLogRetentionCutoff is the last audit log deleted for retention, which the
+// first audit log kept must follow. Its signature keeps it from being moved.
+type AuditLogRetentionCutoff struct {
+	Sequence  int64  `json:"sequence"`
+	Hash      string `json:"hash"`
+	Signature string `json:"signature"`
+}
+
+// AuditLogVerification is the outcome of checking the chain of the audit logs,
+// from the retention cutoff, if any, on.
+type AuditLogVerification struct {
+	Valid         bool  `json:"valid"`
+	Checked       int64 `json:"checked"`
+	FirstSequence int64 `json:"first_sequence"`
+	LastSequence  int64 `json:"last_sequence"`
+
+	// The first audit log breaking the chain, and what is wrong with it.
+	InvalidId       string `json:"invalid_id,omitempty"`
+	InvalidSequence int64  `json:"invalid_sequence,omitempty"`
+	Reason          string `json:"reason,omitempty"`
+}
+
+// NewAuditLog creates the audit log of an audit record. Its object ids are the
+// ids found in the parameters, the prior state and the resulting state of the
+// event, so that it can be found by any of the objects involved.
+func NewAuditLog(rec *AuditRecord) (*AuditLog, error) {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return nil, err
+	}
+
+	// The event data is read back from JSON to find the ids whatever the types
+	// of the values it was made of.
+	var decoded struct {
+		EventData map[string]any `json:"event"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+
+	ids := map[string]bool{}
+	for _, key := range []string{"parameters", "prior_state", "resulting_state"} {
+		collectAuditLogObjectIds(decoded.EventData[key], ids, 2)
+	}
+	objectIds := make(StringArray, 0, len(ids))
+	for id := range ids {
+		objectIds = append(objectIds, id)
+	}
+	sort.Strings(objectIds)
+
+	return &AuditLog{
+		EventName:  truncateAuditLogField(rec.EventName, AuditLogEventNameMaxLength),
+		Status:     truncateAuditLogField(rec.Status, AuditLogStatusMaxLength),
+		UserId:     truncateAuditLogField(rec.Actor.UserId, AuditLogUserIdMaxLength),
+		SessionId:  rec.Actor.SessionId,
+		IpAddress:  truncateAuditLogField(rec.Actor.IpAddress, AuditLogIpAddressMaxLength),
+		ObjectType: truncateAuditLogField(rec.EventData.ObjectType, AuditLogObjectTypeMaxLength),
+		ObjectIds:  objectIds,
+		Record:     string(data),
+	}, nil
+}
+
+func collectAuditLogObjectIds(value any, ids map[string]bool, depth int) {
+	switch v := value.(type) {
+	case string:
+		if IsValidId(v) {
+			ids[v] = true
+		}
+	case map[string]any:
+		if depth == 0 {
+			return
+		}
+		for _, item := range v {
+			collectAuditLogObjectIds(item, ids, depth-1)
+		}
+	case []any:
+		for _, item := range v {
+			collectAuditLogObjectIds(item, ids, depth)
+		}
+	}
+}
+
+func truncateAuditLogField(value string, maxLength int) string {
+	if len(value) > maxLength {
+		return value[:maxLength]
+	}
+	return value
+}
+
+func (o *AuditLog) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+
+	if o.ObjectIds == nil {
+		o.ObjectIds = StringArray{}
+	}
+}
+
+// ComputeHash returns the keyed hash of the audit log, which covers every field
+// but the hash itself, including the hash of the previous audit log.
+func (o *AuditLog) ComputeHash(key []byte) string {
+	// Marshalling an array of the fields always gives the same bytes for them.
+	data, _ := json.Marshal([]any{
+		o.Id,
+		o.Sequence,
+		o.CreateAt,
+		o.EventName,
+		o.Status,
+		o.UserId,
+		o.SessionId,
+		o.IpAddress,
+		o.ObjectType,
+		[]string(o.ObjectIds),
+		o.Record,
+		o.PrevHash,
+	})
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (o *AuditLog) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("AuditLog.IsValid", "model.audit_log.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if o.Sequence <= 0 {
+		return NewAppError("AuditLog.IsValid", "model.audit_log.is_valid.sequence.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("AuditLog.IsValid", "model.audit_log.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.EventName == "" || len(o.EventName) > AuditLogEventNameMaxLength {
+		return NewAppError("AuditLog.IsValid", "model.audit_log.is_valid.event_name.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if _, err := hex.DecodeString(o.Hash); err != nil || len(o.Hash) != sha256.Size*2 {
+		return NewAppError("AuditLog.IsValid", "model.audit_log.is_valid.hash.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// Verify checks the audit log follows the previous one of the chain with the
+// given key. The first audit log follows the retention cutoff, or an empty audit
+// log when none was deleted yet. It returns why it doesn't, if so.
+func (o *AuditLog) Verify(previous *AuditLog, key []byte) string {
+	if !hmac.Equal([]byte(o.Hash), []byte(o.ComputeHash(key))) {
+		return AuditLogVerificationHash
+	}
+
+	if o.Sequence != previous.Sequence+1 {
+		return AuditLogVerificationSequence
+	}
+
+	if o.PrevHash != previous.Hash {
+		return AuditLogVerificationPrevHash
+	}
+
+	return ""
+}
+
+// NewAuditLogRetentionCutoff creates the retention cutoff of the given audit log,
+// signed with the given key.
+func NewAuditLogRetentionCutoff(auditLog *AuditLog, key []byte) *AuditLogRetentionCutoff {
+	cutoff := &AuditLogRetentionCutoff{
+		Sequence: auditLog.Sequence,
+		Hash:     auditLog.Hash,
+	}
+	cutoff.Signature = cutoff.ComputeSignature(key)
+	return cutoff
+}
+
+// ComputeSignature returns the keyed hash of the retention cutoff. It is told
+// apart from the hash of an audit log, so that neither stands for the other.
+func (o *AuditLogRetentionCutoff) ComputeSignature(key []byte) string {
+	data, _ := json.Marshal([]any{"retention_cutoff", o.Sequence, o.Hash})
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the retention cutoff was signed with the given key.
+func (o *AuditLogRetentionCutoff) Verify(key []byte) bool {
+	return hmac.Equal([]byte(o.Signature), []byte(o.ComputeSignature(key)))
+}
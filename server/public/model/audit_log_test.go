@@ -0,0 +1,111 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuditLog(t *testing.T) {
+	channelID := NewId()
+	userID := NewId()
+	teamID := NewId()
+	actorID := NewId()
+
+	rec := &AuditRecord{
+		EventName: AuditEventRemoveChannelMember,
+		Status:    AuditStatusSuccess,
+		Actor:     AuditEventActor{UserId: actorID, SessionId: NewId(), IpAddress: "10.0.0.1"},
+		EventData: AuditEventData{
+			Parameters: map[string]any{
+				"channel_id": channelID,
+				"user_id":    userID,
+				"reason":     "not an id",
+			},
+			PriorState:  map[string]any{"channel": map[string]any{"id": channelID, "team_id": teamID}},
+			ResultState: map[string]any{},
+			ObjectType:  "channel",
+		},
+	}
+
+	auditLog, err := NewAuditLog(rec)
+	require.NoError(t, err)
+	assert.Equal(t, AuditEventRemoveChannelMember, auditLog.EventName)
+	assert.Equal(t, AuditStatusSuccess, auditLog.Status)
+	assert.Equal(t, actorID, auditLog.UserId)
+	assert.Equal(t, "10.0.0.1", auditLog.IpAddress)
+	assert.Equal(t, "channel", auditLog.ObjectType)
+	assert.ElementsMatch(t, []string{channelID, userID, teamID}, auditLog.ObjectIds)
+	assert.Contains(t, auditLog.Record, `"event_name":"removeChannelMember"`)
+}
+
+func TestAuditLogChain(t *testing.T) {
+	key := []byte(NewRandomString(AuditLogSigningKeyMinLength))
+
+	newAuditLog := func(previous *AuditLog) *AuditLog {
+		auditLog, err := NewAuditLog(&AuditRecord{EventName: AuditEventUpdateConfig, Status: AuditStatusSuccess})
+		require.NoError(t, err)
+		auditLog.PreSave()
+		auditLog.Sequence = previous.Sequence + 1
+		auditLog.PrevHash = previous.Hash
+		auditLog.Hash = auditLog.ComputeHash(key)
+		return auditLog
+	}
+
+	first := newAuditLog(&AuditLog{})
+	second := newAuditLog(first)
+	third := newAuditLog(second)
+
+	require.Nil(t, first.IsValid())
+	assert.Empty(t, first.Verify(&AuditLog{}, key))
+	assert.Empty(t, second.Verify(first, key))
+	assert.Empty(t, third.Verify(second, key))
+
+	t.Run("changed audit log", func(t *testing.T) {
+		changed := *second
+		changed.UserId = NewId()
+		assert.Equal(t, AuditLogVerificationHash, changed.Verify(first, key))
+
+		// Computing the hash again doesn't hide the change from the next audit log.
+		changed.Hash = changed.ComputeHash(key)
+		assert.Empty(t, changed.Verify(first, key))
+		assert.Equal(t, AuditLogVerificationPrevHash, third.Verify(&changed, key))
+	})
+
+	t.Run("audit log hashed without the key", func(t *testing.T) {
+		changed := *second
+		changed.UserId = NewId()
+		changed.Hash = changed.ComputeHash([]byte(NewRandomString(AuditLogSigningKeyMinLength)))
+		require.Nil(t, changed.IsValid())
+		assert.Equal(t, AuditLogVerificationHash, changed.Verify(first, key))
+	})
+
+	t.Run("removed audit log", func(t *testing.T) {
+		assert.Equal(t, AuditLogVerificationSequence, third.Verify(first, key))
+	})
+
+	t.Run("removed first audit log", func(t *testing.T) {
+		assert.Equal(t, AuditLogVerificationSequence, second.Verify(&AuditLog{}, key))
+	})
+}
+
+func TestAuditLogRetentionCutoff(t *testing.T) {
+	key := []byte(NewRandomString(AuditLogSigningKeyMinLength))
+
+	auditLog := &AuditLog{Id: NewId(), Sequence: 5, CreateAt: 1, EventName: AuditEventUpdateConfig}
+	auditLog.Hash = auditLog.ComputeHash(key)
+
+	cutoff := NewAuditLogRetentionCutoff(auditLog, key)
+	assert.Equal(t, auditLog.Sequence, cutoff.Sequence)
+	assert.Equal(t, auditLog.Hash, cutoff.Hash)
+	assert.True(t, cutoff.Verify(key))
+	assert.False(t, cutoff.Verify([]byte(NewRandomString(AuditLogSigningKeyMinLength))))
+
+	moved := *cutoff
+	moved.Sequence++
+	assert.False(t, moved.Verify(key))
+}
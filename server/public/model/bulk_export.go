@@ -13,4 +13,7 @@ type BulkExportOpts struct {
 	IncludeArchivedChannels bool
 	IncludeRolesAndSchemes  bool
 	CreateArchive           bool
+	// IncrementalSince, when set, restricts the export to the entities created, updated or
+	// deleted since this time in milliseconds, the latter being written as tombstone lines.
+	IncrementalSince int64
 }
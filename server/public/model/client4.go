@@ -406,6 +406,22 @@ func (c *Client4) outgoingWebhookRoute(hookID string) string {
 	return fmt.Sprintf(c.outgoingWebhooksRoute()+"/%v", hookID)
 }
 
+func (c *Client4) eventSubscriptionsRoute() string {
+	return "/hooks/events"
+}
+
+func (c *Client4) eventSubscriptionRoute(subscriptionID string) string {
+	return fmt.Sprintf(c.eventSubscriptionsRoute()+"/%v", subscriptionID)
+}
+
+func (c *Client4) pollsRoute() string {
+	return "/polls"
+}
+
+func (c *Client4) pollRoute(pollID string) string {
+	return fmt.Sprintf(c.pollsRoute()+"/%v", pollID)
+}
+
 func (c *Client4) preferencesRoute(userId string) string {
 	return c.userRoute(userId) + "/preferences"
 }
@@ -430,6 +446,18 @@ func (c *Client4) brandRoute() string {
 	return "/brand"
 }
 
+func (c *Client4) emailTemplatesRoute() string {
+	return "/email_templates"
+}
+
+func (c *Client4) emailTemplateRoute(name string) string {
+	return fmt.Sprintf(c.emailTemplatesRoute()+"/%v", name)
+}
+
+func (c *Client4) auditLogsRoute() string {
+	return "/audit_logs"
+}
+
 func (c *Client4) dataRetentionRoute() string {
 	return "/data_retention"
 }
@@ -1677,6 +1705,103 @@ func (c *Client4) GenerateMfaSecret(ctx context.Context, userId string) (*MfaSec
 	return &secret, BuildResponse(r), nil
 }
 
+// StartWebAuthnRegistration starts the registration of a passkey or security key for the
+// logged in user, returning the options to create it with.
+func (c *Client4) StartWebAuthnRegistration(ctx context.Context, userId string) (*WebAuthnCreationOptions, *Response, error) {
+	r, err := c.DoAPIPost(ctx, c.userRoute(userId)+"/webauthn/register/begin", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var options WebAuthnCreationOptions
+	if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+		return nil, nil, NewAppError("StartWebAuthnRegistration", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &options, BuildResponse(r), nil
+}
+
+// FinishWebAuthnRegistration saves the credential created with the options returned by
+// StartWebAuthnRegistration. The request must hold the password of the user, and their MFA
+// token if they use MFA.
+func (c *Client4) FinishWebAuthnRegistration(ctx context.Context, userId string, request *WebAuthnRegistrationRequest) (*WebAuthnCredential, *Response, error) {
+	buf, err := json.Marshal(request)
+	if err != nil {
+		return nil, nil, NewAppError("FinishWebAuthnRegistration", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(ctx, c.userRoute(userId)+"/webauthn/register/finish", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var saved WebAuthnCredential
+	if err := json.NewDecoder(r.Body).Decode(&saved); err != nil {
+		return nil, nil, NewAppError("FinishWebAuthnRegistration", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &saved, BuildResponse(r), nil
+}
+
+// GetWebAuthnCredentials returns the passkeys and security keys registered by a user.
+func (c *Client4) GetWebAuthnCredentials(ctx context.Context, userId string) ([]*WebAuthnCredential, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.userRoute(userId)+"/webauthn/credentials", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var credentials []*WebAuthnCredential
+	if err := json.NewDecoder(r.Body).Decode(&credentials); err != nil {
+		return nil, nil, NewAppError("GetWebAuthnCredentials", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return credentials, BuildResponse(r), nil
+}
+
+// DeleteWebAuthnCredential removes a passkey or security key of a user. Must be logged in as
+// the user or be a system administrator.
+func (c *Client4) DeleteWebAuthnCredential(ctx context.Context, userId, credentialId string) (*Response, error) {
+	r, err := c.DoAPIDelete(ctx, c.userRoute(userId)+"/webauthn/credentials/"+credentialId)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// StartWebAuthnLogin starts an authentication ceremony, for a second factor when a login id
+// is given or, when it's empty, for a passwordless login.
+func (c *Client4) StartWebAuthnLogin(ctx context.Context, loginId string) (*WebAuthnRequestOptions, *Response, error) {
+	r, err := c.DoAPIPost(ctx, c.usersRoute()+"/login/webauthn/begin", MapToJSON(map[string]string{"login_id": loginId}))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var options WebAuthnRequestOptions
+	if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+		return nil, nil, NewAppError("StartWebAuthnLogin", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &options, BuildResponse(r), nil
+}
+
+// LoginWithWebAuthn logs in with a passkey alone. To use a passkey as a second factor, pass
+// the JSON encoded assertion as the MFA token of LoginWithMFA instead.
+func (c *Client4) LoginWithWebAuthn(ctx context.Context, credential *WebAuthnAssertionResponse, deviceId string) (*User, *Response, error) {
+	buf, err := json.Marshal(WebAuthnLoginRequest{Credential: credential, DeviceId: deviceId})
+	if err != nil {
+		return nil, nil, NewAppError("LoginWithWebAuthn", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(ctx, c.usersRoute()+"/login/webauthn", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	c.AuthToken = r.Header.Get(HeaderToken)
+	c.AuthType = HeaderBearer
+
+	var user User
+	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+		return nil, nil, NewAppError("LoginWithWebAuthn", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &user, BuildResponse(r), nil
+}
+
 // UpdateUserPassword updates a user's password. Must be logged in as the user or be a system administrator.
 func (c *Client4) UpdateUserPassword(ctx context.Context, userId, currentPassword, newPassword string) (*Response, error) {
 	requestBody := map[string]string{"current_password": currentPassword, "new_password": newPassword}
@@ -1857,6 +1982,21 @@ func (c *Client4) GetSessions(ctx context.Context, userId, etag string) ([]*Sess
 	return list, BuildResponse(r), nil
 }
 
+// GetSessionDevices returns the sessions of a user along with the devices they
+// were created from.
+func (c *Client4) GetSessionDevices(ctx context.Context, userId string) ([]*SessionDevice, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.userRoute(userId)+"/sessions/devices", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*SessionDevice
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetSessionDevices", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
 // RevokeSession revokes a user session based on the provided user id and session id strings.
 func (c *Client4) RevokeSession(ctx context.Context, userId, sessionId string) (*Response, error) {
 	requestBody := map[string]string{"session_id": sessionId}
@@ -1878,6 +2018,17 @@ func (c *Client4) RevokeAllSessions(ctx context.Context, userId string) (*Respon
 	return BuildResponse(r), nil
 }
 
+// RevokeOtherSessions revokes all sessions of a user but the one of the client,
+// leaving the personal access tokens of the user working.
+func (c *Client4) RevokeOtherSessions(ctx context.Context, userId string) (*Response, error) {
+	r, err := c.DoAPIPost(ctx, c.userRoute(userId)+"/sessions/revoke/others", "")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // RevokeAllSessions revokes all sessions for all the users.
 func (c *Client4) RevokeSessionsFromAllUsers(ctx context.Context) (*Response, error) {
 	r, err := c.DoAPIPost(ctx, c.usersRoute()+"/sessions/revoke/all", "")
@@ -2049,6 +2200,27 @@ func (c *Client4) CreateUserAccessToken(ctx context.Context, userId, description
 	return &uat, BuildResponse(r), nil
 }
 
+// CreateUserAccessTokenWithOptions will generate a user access token that can be used in place
+// of a session token to access the REST API, with the expiry date and the scopes of the given
+// token. Must have the 'create_user_access_token' permission and if generating for another user,
+// must have the 'edit_other_users' permission. A non-blank description is required.
+func (c *Client4) CreateUserAccessTokenWithOptions(ctx context.Context, userId string, token *UserAccessToken) (*UserAccessToken, *Response, error) {
+	buf, err := json.Marshal(token)
+	if err != nil {
+		return nil, nil, NewAppError("CreateUserAccessTokenWithOptions", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(ctx, c.userRoute(userId)+"/tokens", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var uat UserAccessToken
+	if err := json.NewDecoder(r.Body).Decode(&uat); err != nil {
+		return nil, nil, NewAppError("CreateUserAccessTokenWithOptions", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &uat, BuildResponse(r), nil
+}
+
 // GetUserAccessTokens will get a page of access tokens' id, description, is_active
 // and the user_id in the system. The actual token will not be returned. Must have
 // the 'manage_system' permission.
@@ -2209,6 +2381,20 @@ func (c *Client4) GetUsersForReporting(ctx context.Context, options *UserReportO
 	return list, BuildResponse(r), nil
 }
 
+// GetPasswordHashReport returns how many users have their password hashed with each algorithm.
+func (c *Client4) GetPasswordHashReport(ctx context.Context) ([]*PasswordHashReport, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.reportsRoute()+"/users/password_hashes", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*PasswordHashReport
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetPasswordHashReport", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
 // Bots section
 
 // CreateBot creates a bot in the system based on the provided bot struct.
@@ -5134,6 +5320,57 @@ func (c *Client4) MigrateConfig(ctx context.Context, from, to string) (*Response
 	return BuildResponse(r), nil
 }
 
+// GetConfigRevisions returns a page of the revisions of the server configuration,
+// from the latest.
+func (c *Client4) GetConfigRevisions(ctx context.Context, page, perPage int) ([]*ConfigRevision, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(ctx, c.configRoute()+"/revisions"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*ConfigRevision
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetConfigRevisions", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// GetConfigRevisionDiff returns the settings changed by a revision of the server
+// configuration since the base revision, the current configuration when base is
+// ConfigRevisionBaseCurrent, or the previous revision when base is empty.
+func (c *Client4) GetConfigRevisionDiff(ctx context.Context, revisionID, base string) ([]*ConfigChange, *Response, error) {
+	query := ""
+	if base != "" {
+		query = "?base=" + url.QueryEscape(base)
+	}
+	r, err := c.DoAPIGet(ctx, c.configRoute()+"/revisions/"+revisionID+"/diff"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*ConfigChange
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetConfigRevisionDiff", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// RollbackConfig restores the server configuration of a revision, and returns the
+// configuration restored.
+func (c *Client4) RollbackConfig(ctx context.Context, revisionID string) (*Config, *Response, error) {
+	r, err := c.DoAPIPost(ctx, c.configRoute()+"/revisions/"+revisionID+"/rollback", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var cfg *Config
+	if err := json.NewDecoder(r.Body).Decode(&cfg); err != nil {
+		return nil, nil, NewAppError("RollbackConfig", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return cfg, BuildResponse(r), nil
+}
+
 // UploadLicenseFile will add a license file to the system.
 func (c *Client4) UploadLicenseFile(ctx context.Context, data []byte) (*Response, error) {
 	body := &bytes.Buffer{}
@@ -5462,6 +5699,69 @@ func (c *Client4) RegenOutgoingHookToken(ctx context.Context, hookId string) (*O
 	return &ow, BuildResponse(r), nil
 }
 
+// RegenOutgoingHookSecret regenerates the secret signing the requests of the outgoing webhook.
+func (c *Client4) RegenOutgoingHookSecret(ctx context.Context, hookId string) (*OutgoingWebhook, *Response, error) {
+	r, err := c.DoAPIPost(ctx, c.outgoingWebhookRoute(hookId)+"/regen_secret", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var ow OutgoingWebhook
+	if err := json.NewDecoder(r.Body).Decode(&ow); err != nil {
+		return nil, nil, NewAppError("RegenOutgoingHookSecret", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &ow, BuildResponse(r), nil
+}
+
+// GetOutgoingWebhookDeliveries returns a page of the deliveries of an outgoing webhook, newest first,
+// optionally filtered by status. Page counting starts at 0.
+func (c *Client4) GetOutgoingWebhookDeliveries(ctx context.Context, hookId string, status string, page int, perPage int) ([]*OutgoingWebhookDelivery, *Response, error) {
+	values := url.Values{}
+	values.Set("page", strconv.Itoa(page))
+	values.Set("per_page", strconv.Itoa(perPage))
+	if status != "" {
+		values.Set("status", status)
+	}
+	r, err := c.DoAPIGet(ctx, c.outgoingWebhookRoute(hookId)+"/deliveries?"+values.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var deliveries []*OutgoingWebhookDelivery
+	if err := json.NewDecoder(r.Body).Decode(&deliveries); err != nil {
+		return nil, nil, NewAppError("GetOutgoingWebhookDeliveries", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return deliveries, BuildResponse(r), nil
+}
+
+// GetOutgoingWebhookDelivery returns a delivery of an outgoing webhook.
+func (c *Client4) GetOutgoingWebhookDelivery(ctx context.Context, hookId, deliveryId string) (*OutgoingWebhookDelivery, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.outgoingWebhookRoute(hookId)+"/deliveries/"+deliveryId, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var delivery OutgoingWebhookDelivery
+	if err := json.NewDecoder(r.Body).Decode(&delivery); err != nil {
+		return nil, nil, NewAppError("GetOutgoingWebhookDelivery", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &delivery, BuildResponse(r), nil
+}
+
+// ReplayOutgoingWebhookDelivery sends a delivery of an outgoing webhook again and returns its outcome.
+func (c *Client4) ReplayOutgoingWebhookDelivery(ctx context.Context, hookId, deliveryId string) (*OutgoingWebhookDelivery, *Response, error) {
+	r, err := c.DoAPIPost(ctx, c.outgoingWebhookRoute(hookId)+"/deliveries/"+deliveryId+"/replay", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var delivery OutgoingWebhookDelivery
+	if err := json.NewDecoder(r.Body).Decode(&delivery); err != nil {
+		return nil, nil, NewAppError("ReplayOutgoingWebhookDelivery", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &delivery, BuildResponse(r), nil
+}
+
 // DeleteOutgoingWebhook delete the outgoing webhook on the system requested by Hook Id.
 func (c *Client4) DeleteOutgoingWebhook(ctx context.Context, hookId string) (*Response, error) {
 	r, err := c.DoAPIDelete(ctx, c.outgoingWebhookRoute(hookId))
@@ -5472,6 +5772,97 @@ func (c *Client4) DeleteOutgoingWebhook(ctx context.Context, hookId string) (*Re
 	return BuildResponse(r), nil
 }
 
+// Event Subscriptions Section
+
+// CreateEventSubscription creates a server-wide event subscription.
+func (c *Client4) CreateEventSubscription(ctx context.Context, subscription *EventSubscription) (*EventSubscription, *Response, error) {
+	buf, err := json.Marshal(subscription)
+	if err != nil {
+		return nil, nil, NewAppError("CreateEventSubscription", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(ctx, c.eventSubscriptionsRoute(), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var es EventSubscription
+	if err := json.NewDecoder(r.Body).Decode(&es); err != nil {
+		return nil, nil, NewAppError("CreateEventSubscription", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &es, BuildResponse(r), nil
+}
+
+// GetEventSubscriptions returns a page of the event subscriptions. Page counting starts at 0.
+func (c *Client4) GetEventSubscriptions(ctx context.Context, page int, perPage int) ([]*EventSubscription, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(ctx, c.eventSubscriptionsRoute()+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var subscriptions []*EventSubscription
+	if err := json.NewDecoder(r.Body).Decode(&subscriptions); err != nil {
+		return nil, nil, NewAppError("GetEventSubscriptions", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return subscriptions, BuildResponse(r), nil
+}
+
+// GetEventSubscription returns an event subscription.
+func (c *Client4) GetEventSubscription(ctx context.Context, subscriptionID string) (*EventSubscription, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.eventSubscriptionRoute(subscriptionID), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var es EventSubscription
+	if err := json.NewDecoder(r.Body).Decode(&es); err != nil {
+		return nil, nil, NewAppError("GetEventSubscription", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &es, BuildResponse(r), nil
+}
+
+// PatchEventSubscription partially updates an event subscription.
+func (c *Client4) PatchEventSubscription(ctx context.Context, subscriptionID string, patch *EventSubscriptionPatch) (*EventSubscription, *Response, error) {
+	buf, err := json.Marshal(patch)
+	if err != nil {
+		return nil, nil, NewAppError("PatchEventSubscription", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(ctx, c.eventSubscriptionRoute(subscriptionID)+"/patch", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var es EventSubscription
+	if err := json.NewDecoder(r.Body).Decode(&es); err != nil {
+		return nil, nil, NewAppError("PatchEventSubscription", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &es, BuildResponse(r), nil
+}
+
+// RegenEventSubscriptionSecret regenerates the secret the deliveries of an event subscription are signed with.
+func (c *Client4) RegenEventSubscriptionSecret(ctx context.Context, subscriptionID string) (*EventSubscription, *Response, error) {
+	r, err := c.DoAPIPost(ctx, c.eventSubscriptionRoute(subscriptionID)+"/regen_secret", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var es EventSubscription
+	if err := json.NewDecoder(r.Body).Decode(&es); err != nil {
+		return nil, nil, NewAppError("RegenEventSubscriptionSecret", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &es, BuildResponse(r), nil
+}
+
+// DeleteEventSubscription deletes an event subscription.
+func (c *Client4) DeleteEventSubscription(ctx context.Context, subscriptionID string) (*Response, error) {
+	r, err := c.DoAPIDelete(ctx, c.eventSubscriptionRoute(subscriptionID))
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // Preferences Section
 
 // GetPreferences returns the user's preferences.
@@ -6194,6 +6585,141 @@ func (c *Client4) UploadBrandImage(ctx context.Context, data []byte) (*Response,
 	return BuildResponse(rp), nil
 }
 
+// Email Templates Section
+
+// GetEmailTemplates lists the email templates of a locale, without their content.
+// The templates of the default server locale are listed when the locale is empty.
+func (c *Client4) GetEmailTemplates(ctx context.Context, locale string) ([]*EmailTemplate, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.emailTemplatesRoute()+"?locale="+url.QueryEscape(locale), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var emailTemplates []*EmailTemplate
+	if err := json.NewDecoder(r.Body).Decode(&emailTemplates); err != nil {
+		return nil, nil, NewAppError("GetEmailTemplates", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return emailTemplates, BuildResponse(r), nil
+}
+
+// GetEmailTemplate gets the current template of an email in a locale.
+func (c *Client4) GetEmailTemplate(ctx context.Context, name, locale string) (*EmailTemplate, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.emailTemplateRoute(name)+"?locale="+url.QueryEscape(locale), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var emailTemplate EmailTemplate
+	if err := json.NewDecoder(r.Body).Decode(&emailTemplate); err != nil {
+		return nil, nil, NewAppError("GetEmailTemplate", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &emailTemplate, BuildResponse(r), nil
+}
+
+// UpdateEmailTemplate replaces the template of an email in a locale.
+func (c *Client4) UpdateEmailTemplate(ctx context.Context, emailTemplate *EmailTemplate) (*EmailTemplate, *Response, error) {
+	buf, err := json.Marshal(emailTemplate)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateEmailTemplate", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(ctx, c.emailTemplateRoute(emailTemplate.Name)+"?locale="+url.QueryEscape(emailTemplate.Locale), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var remailTemplate EmailTemplate
+	if err := json.NewDecoder(r.Body).Decode(&remailTemplate); err != nil {
+		return nil, nil, NewAppError("UpdateEmailTemplate", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &remailTemplate, BuildResponse(r), nil
+}
+
+// DeleteEmailTemplate restores the built-in template of an email in a locale,
+// and returns it.
+func (c *Client4) DeleteEmailTemplate(ctx context.Context, name, locale string) (*EmailTemplate, *Response, error) {
+	r, err := c.DoAPIDelete(ctx, c.emailTemplateRoute(name)+"?locale="+url.QueryEscape(locale))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var emailTemplate EmailTemplate
+	if err := json.NewDecoder(r.Body).Decode(&emailTemplate); err != nil {
+		return nil, nil, NewAppError("DeleteEmailTemplate", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &emailTemplate, BuildResponse(r), nil
+}
+
+// PreviewEmailTemplate sends an email rendered from the template with sample data
+// to the current user, or to the given email address in local mode. The current
+// template is sent when the content is empty.
+func (c *Client4) PreviewEmailTemplate(ctx context.Context, emailTemplate *EmailTemplate, email string) (*Response, error) {
+	buf, err := json.Marshal(emailTemplate)
+	if err != nil {
+		return nil, NewAppError("PreviewEmailTemplate", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	values := url.Values{}
+	values.Set("locale", emailTemplate.Locale)
+	if email != "" {
+		values.Set("email", email)
+	}
+	r, err := c.DoAPIPostBytes(ctx, c.emailTemplateRoute(emailTemplate.Name)+"/preview?"+values.Encode(), buf)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// Audit Logs Section
+
+// SearchAuditLogs gets a page of the audit logs kept in the database matching the
+// search, from the latest.
+func (c *Client4) SearchAuditLogs(ctx context.Context, search *AuditLogSearch) ([]*AuditLog, *Response, error) {
+	values := url.Values{}
+	values.Set("page", strconv.Itoa(search.Page))
+	values.Set("per_page", strconv.Itoa(search.PerPage))
+	if search.UserId != "" {
+		values.Set("user_id", search.UserId)
+	}
+	if search.EventName != "" {
+		values.Set("event_name", search.EventName)
+	}
+	if search.ObjectId != "" {
+		values.Set("object_id", search.ObjectId)
+	}
+	if search.Since > 0 {
+		values.Set("since", strconv.FormatInt(search.Since, 10))
+	}
+	if search.Until > 0 {
+		values.Set("until", strconv.FormatInt(search.Until, 10))
+	}
+
+	r, err := c.DoAPIGet(ctx, c.auditLogsRoute()+"?"+values.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var auditLogs []*AuditLog
+	if err := json.NewDecoder(r.Body).Decode(&auditLogs); err != nil {
+		return nil, nil, NewAppError("SearchAuditLogs", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return auditLogs, BuildResponse(r), nil
+}
+
+// VerifyAuditLogs checks the chain of the audit logs kept in the database.
+func (c *Client4) VerifyAuditLogs(ctx context.Context) (*AuditLogVerification, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.auditLogsRoute()+"/verify", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var verification AuditLogVerification
+	if err := json.NewDecoder(r.Body).Decode(&verification); err != nil {
+		return nil, nil, NewAppError("VerifyAuditLogs", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &verification, BuildResponse(r), nil
+}
+
 // Logs Section
 
 // GetLogs page of logs as a string array.
@@ -8840,6 +9366,21 @@ func (c *Client4) DeleteImport(ctx context.Context, name string) (*Response, err
 	return BuildResponse(r), nil
 }
 
+// DownloadImportErrorReport downloads the error report of the validation of
+// an import file.
+func (c *Client4) DownloadImportErrorReport(ctx context.Context, name string, wr io.Writer) (int64, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.importRoute(name)+"/error_report", "")
+	if err != nil {
+		return 0, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	n, err := io.Copy(wr, r.Body)
+	if err != nil {
+		return n, BuildResponse(r), NewAppError("DownloadImportErrorReport", "model.client.copy.app_error", nil, "", r.StatusCode).Wrap(err)
+	}
+	return n, BuildResponse(r), nil
+}
+
 func (c *Client4) ListExports(ctx context.Context) ([]string, *Response, error) {
 	r, err := c.DoAPIGet(ctx, c.exportsRoute(), "")
 	if err != nil {
@@ -9861,3 +10402,65 @@ func (c *Client4) SearchChannelsForAccessControlPolicy(ctx context.Context, poli
 
 	return &channels, BuildResponse(r), nil
 }
+
+// Polls Section
+
+// CreatePoll creates a poll and posts it to its channel.
+func (c *Client4) CreatePoll(ctx context.Context, poll *Poll) (*Poll, *Response, error) {
+	buf, err := json.Marshal(poll)
+	if err != nil {
+		return nil, nil, NewAppError("CreatePoll", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(ctx, c.pollsRoute(), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var p Poll
+	if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+		return nil, nil, NewAppError("CreatePoll", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &p, BuildResponse(r), nil
+}
+
+// GetPoll returns a poll.
+func (c *Client4) GetPoll(ctx context.Context, pollID string) (*Poll, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.pollRoute(pollID), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var p Poll
+	if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+		return nil, nil, NewAppError("GetPoll", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &p, BuildResponse(r), nil
+}
+
+// GetPollResults returns the number of votes for each option of a poll.
+func (c *Client4) GetPollResults(ctx context.Context, pollID string) (*PollResults, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.pollRoute(pollID)+"/results", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var results PollResults
+	if err := json.NewDecoder(r.Body).Decode(&results); err != nil {
+		return nil, nil, NewAppError("GetPollResults", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &results, BuildResponse(r), nil
+}
+
+// ClosePoll stops a poll from accepting votes.
+func (c *Client4) ClosePoll(ctx context.Context, pollID string) (*Poll, *Response, error) {
+	r, err := c.DoAPIPost(ctx, c.pollRoute(pollID)+"/close", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var p Poll
+	if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+		return nil, nil, NewAppError("ClosePoll", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &p, BuildResponse(r), nil
+}
@@ -47,6 +47,15 @@ const (
 	PasswordMaximumLength = 72
 	PasswordMinimumLength = 5
 
+	// The Argon2id defaults follow the OWASP recommendations, and the maximums bound both
+	// the configured parameters and the ones read from stored hashes.
+	PasswordSettingsDefaultArgon2idMemory      = 19 * 1024 // In KiB
+	PasswordSettingsDefaultArgon2idIterations  = 2
+	PasswordSettingsDefaultArgon2idParallelism = 1
+	PasswordSettingsMaximumArgon2idMemory      = 1024 * 1024 // In KiB
+	PasswordSettingsMaximumArgon2idIterations  = 64
+	PasswordSettingsMaximumArgon2idParallelism = 255
+
 	ServiceGitlab = "gitlab"
 
 	ServiceGoogle    = "google"
@@ -108,20 +117,22 @@ const (
 
 	SitenameMaxLength = 30
 
-	ServiceSettingsDefaultSiteURL                = "http://localhost:8065"
-	ServiceSettingsDefaultTLSCertFile            = ""
-	ServiceSettingsDefaultTLSKeyFile             = ""
-	ServiceSettingsDefaultReadTimeout            = 300
-	ServiceSettingsDefaultWriteTimeout           = 300
-	ServiceSettingsDefaultIdleTimeout            = 60
-	ServiceSettingsDefaultMaxLoginAttempts       = 10
-	ServiceSettingsDefaultAllowCorsFrom          = ""
-	ServiceSettingsDefaultListenAndAddress       = ":8065"
-	ServiceSettingsDefaultGiphySdkKeyTest        = "s0glxvzVg9azvPipKxcPLpXV0q1x1fVP"
-	ServiceSettingsDefaultDeveloperFlags         = ""
-	ServiceSettingsDefaultUniqueReactionsPerPost = 50
-	ServiceSettingsDefaultMaxURLLength           = 2048
-	ServiceSettingsMaxUniqueReactionsPerPost     = 500
+	ServiceSettingsDefaultSiteURL                         = "http://localhost:8065"
+	ServiceSettingsDefaultTLSCertFile                     = ""
+	ServiceSettingsDefaultTLSKeyFile                      = ""
+	ServiceSettingsDefaultReadTimeout                     = 300
+	ServiceSettingsDefaultWriteTimeout                    = 300
+	ServiceSettingsDefaultIdleTimeout                     = 60
+	ServiceSettingsDefaultMaxLoginAttempts                = 10
+	ServiceSettingsDefaultMaxLoginAttemptsPerIP           = 0
+	ServiceSettingsDefaultLoginAttemptsPerIPWindowMinutes = 15
+	ServiceSettingsDefaultAllowCorsFrom                   = ""
+	ServiceSettingsDefaultListenAndAddress                = ":8065"
+	ServiceSettingsDefaultGiphySdkKeyTest                 = "s0glxvzVg9azvPipKxcPLpXV0q1x1fVP"
+	ServiceSettingsDefaultDeveloperFlags                  = ""
+	ServiceSettingsDefaultUniqueReactionsPerPost          = 50
+	ServiceSettingsDefaultMaxURLLength                    = 2048
+	ServiceSettingsMaxUniqueReactionsPerPost              = 500
 
 	TeamSettingsDefaultSiteName              = "Mattermost"
 	TeamSettingsDefaultMaxUsersPerTeam       = 50
@@ -340,6 +351,9 @@ type ServiceSettings struct {
 	WriteTimeout                        *int     `access:"environment_web_server,write_restrictable,cloud_restrictable"`
 	IdleTimeout                         *int     `access:"write_restrictable,cloud_restrictable"`
 	MaximumLoginAttempts                *int     `access:"authentication_password,write_restrictable,cloud_restrictable"`
+	MaximumLoginAttemptsPerIP           *int     `access:"authentication_password,write_restrictable,cloud_restrictable"` // Disabled when 0. Behind a proxy, needs TrustedProxyIPHeader to tell the clients apart.
+	LoginAttemptsPerIPWindowMinutes     *int     `access:"authentication_password,write_restrictable,cloud_restrictable"`
+	EnableNewSignInAlerts               *bool    `access:"authentication_password"`
 	GoroutineHealthThreshold            *int     `access:"write_restrictable,cloud_restrictable"` // telemetry: none
 	EnableOAuthServiceProvider          *bool    `access:"integrations_integration_management"`
 	EnableIncomingWebhooks              *bool    `access:"integrations_integration_management"`
@@ -362,6 +376,11 @@ type ServiceSettings struct {
 	AllowedUntrustedInternalConnections *string  `access:"environment_web_server,write_restrictable,cloud_restrictable"`
 	EnableMultifactorAuthentication     *bool    `access:"authentication_mfa"`
 	EnforceMultifactorAuthentication    *bool    `access:"authentication_mfa"`
+	EnableWebAuthn                      *bool    `access:"authentication_mfa"`
+	EnforceWebAuthn                     *bool    `access:"authentication_mfa"`
+	EnablePasswordlessLogin             *bool    `access:"authentication_mfa"`
+	WebAuthnRelyingPartyId              *string  `access:"authentication_mfa"`
+	WebAuthnUserVerification            *string  `access:"authentication_mfa"`
 	EnableUserAccessTokens              *bool    `access:"integrations_integration_management"`
 	AllowCorsFrom                       *string  `access:"integrations_cors,write_restrictable,cloud_restrictable"`
 	CorsExposedHeaders                  *string  `access:"integrations_cors,write_restrictable,cloud_restrictable"`
@@ -526,6 +545,26 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.EnforceMultifactorAuthentication = NewPointer(false)
 	}
 
+	if s.EnableWebAuthn == nil {
+		s.EnableWebAuthn = NewPointer(false)
+	}
+
+	if s.EnforceWebAuthn == nil {
+		s.EnforceWebAuthn = NewPointer(false)
+	}
+
+	if s.EnablePasswordlessLogin == nil {
+		s.EnablePasswordlessLogin = NewPointer(false)
+	}
+
+	if s.WebAuthnRelyingPartyId == nil {
+		s.WebAuthnRelyingPartyId = NewPointer("")
+	}
+
+	if s.WebAuthnUserVerification == nil {
+		s.WebAuthnUserVerification = NewPointer(WebAuthnUserVerificationPreferred)
+	}
+
 	if s.EnableUserAccessTokens == nil {
 		s.EnableUserAccessTokens = NewPointer(false)
 	}
@@ -610,6 +649,18 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.MaximumLoginAttempts = NewPointer(ServiceSettingsDefaultMaxLoginAttempts)
 	}
 
+	if s.MaximumLoginAttemptsPerIP == nil {
+		s.MaximumLoginAttemptsPerIP = NewPointer(ServiceSettingsDefaultMaxLoginAttemptsPerIP)
+	}
+
+	if s.LoginAttemptsPerIPWindowMinutes == nil {
+		s.LoginAttemptsPerIPWindowMinutes = NewPointer(ServiceSettingsDefaultLoginAttemptsPerIPWindowMinutes)
+	}
+
+	if s.EnableNewSignInAlerts == nil {
+		s.EnableNewSignInAlerts = NewPointer(true)
+	}
+
 	if s.Forward80To443 == nil {
 		s.Forward80To443 = NewPointer(false)
 	}
@@ -1550,6 +1601,12 @@ type ExperimentalAuditSettings struct {
 	FileMaxQueueSize    *int            `access:"experimental_features,write_restrictable,cloud_restrictable"`
 	AdvancedLoggingJSON json.RawMessage `access:"experimental_features"`
 	Certificate         *string         `access:"experimental_features"` // telemetry: none
+	StoreEnabled        *bool           `access:"experimental_features,write_restrictable,cloud_restrictable"`
+	StoreRetentionDays  *int            `access:"experimental_features,write_restrictable,cloud_restrictable"`
+	// StoreSigningKey keys the hashes chaining the audit logs kept in the database. It
+	// should be kept out of the database, through a secret reference, for an audit log
+	// changed there not to be signed again. Changing it breaks the chain.
+	StoreSigningKey *string `access:"experimental_features,write_restrictable,cloud_restrictable"` // telemetry: none
 }
 
 func (s *ExperimentalAuditSettings) isValid() *AppError {
@@ -1579,6 +1636,14 @@ func (s *ExperimentalAuditSettings) isValid() *AppError {
 		}
 	}
 
+	if *s.StoreRetentionDays < 0 {
+		return NewAppError("ExperimentalAuditSettings.isValid", "model.config.is_valid.experimental_audit_settings.store_retention_days_invalid", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.StoreEnabled && len(*s.StoreSigningKey) < AuditLogSigningKeyMinLength {
+		return NewAppError("ExperimentalAuditSettings.isValid", "model.config.is_valid.experimental_audit_settings.store_signing_key_invalid", map[string]any{"MinLength": AuditLogSigningKeyMinLength}, "", http.StatusBadRequest)
+	}
+
 	cfg := make(mlog.LoggerConfiguration)
 	err := json.Unmarshal(s.AdvancedLoggingJSON, &cfg)
 	if err != nil {
@@ -1629,6 +1694,18 @@ func (s *ExperimentalAuditSettings) SetDefaults() {
 	if s.Certificate == nil {
 		s.Certificate = NewPointer("")
 	}
+
+	if s.StoreEnabled == nil {
+		s.StoreEnabled = NewPointer(false)
+	}
+
+	if s.StoreRetentionDays == nil {
+		s.StoreRetentionDays = NewPointer(365) // 0 keeps the audit logs forever
+	}
+
+	if s.StoreSigningKey == nil {
+		s.StoreSigningKey = NewPointer("")
+	}
 }
 
 // GetAdvancedLoggingConfig returns the advanced logging config as a []byte.
@@ -1647,6 +1724,11 @@ type PasswordSettings struct {
 	Uppercase        *bool `access:"authentication_password"`
 	Symbol           *bool `access:"authentication_password"`
 	EnableForgotLink *bool `access:"authentication_password"`
+	// The Argon2id parameters new passwords are hashed with. Passwords hashed
+	// with other parameters are rehashed when their users log in.
+	Argon2idMemory      *int `access:"authentication_password"` // In KiB
+	Argon2idIterations  *int `access:"authentication_password"`
+	Argon2idParallelism *int `access:"authentication_password"`
 }
 
 func (s *PasswordSettings) SetDefaults() {
@@ -1673,6 +1755,39 @@ func (s *PasswordSettings) SetDefaults() {
 	if s.EnableForgotLink == nil {
 		s.EnableForgotLink = NewPointer(true)
 	}
+
+	if s.Argon2idMemory == nil {
+		s.Argon2idMemory = NewPointer(PasswordSettingsDefaultArgon2idMemory)
+	}
+
+	if s.Argon2idIterations == nil {
+		s.Argon2idIterations = NewPointer(PasswordSettingsDefaultArgon2idIterations)
+	}
+
+	if s.Argon2idParallelism == nil {
+		s.Argon2idParallelism = NewPointer(PasswordSettingsDefaultArgon2idParallelism)
+	}
+}
+
+func (s *PasswordSettings) isValid() *AppError {
+	if *s.MinimumLength < PasswordMinimumLength || *s.MinimumLength > PasswordMaximumLength {
+		return NewAppError("Config.IsValid", "model.config.is_valid.password_length.app_error", map[string]any{"MinLength": PasswordMinimumLength, "MaxLength": PasswordMaximumLength}, "", http.StatusBadRequest)
+	}
+
+	if *s.Argon2idParallelism < 1 || *s.Argon2idParallelism > PasswordSettingsMaximumArgon2idParallelism {
+		return NewAppError("Config.IsValid", "model.config.is_valid.password_argon2id_parallelism.app_error", map[string]any{"Max": PasswordSettingsMaximumArgon2idParallelism}, "", http.StatusBadRequest)
+	}
+
+	if *s.Argon2idIterations < 1 || *s.Argon2idIterations > PasswordSettingsMaximumArgon2idIterations {
+		return NewAppError("Config.IsValid", "model.config.is_valid.password_argon2id_iterations.app_error", map[string]any{"Max": PasswordSettingsMaximumArgon2idIterations}, "", http.StatusBadRequest)
+	}
+
+	// Argon2id needs at least 8 KiB of memory per thread
+	if *s.Argon2idMemory < 8**s.Argon2idParallelism || *s.Argon2idMemory > PasswordSettingsMaximumArgon2idMemory {
+		return NewAppError("Config.IsValid", "model.config.is_valid.password_argon2id_memory.app_error", map[string]any{"Min": 8 * *s.Argon2idParallelism, "Max": PasswordSettingsMaximumArgon2idMemory}, "", http.StatusBadRequest)
+	}
+
+	return nil
 }
 
 type FileSettings struct {
@@ -1687,6 +1802,7 @@ type FileSettings struct {
 	EnablePublicLink                   *bool   `access:"site_public_links,cloud_restrictable"`
 	ExtractContent                     *bool   `access:"environment_file_storage,write_restrictable"`
 	ArchiveRecursion                   *bool   `access:"environment_file_storage,write_restrictable"`
+	EnableWebPPreviews                 *bool   `access:"environment_file_storage"`
 	PublicLinkSalt                     *string `access:"site_public_links,cloud_restrictable"`                           // telemetry: none
 	InitialFont                        *string `access:"environment_file_storage,cloud_restrictable"`                    // telemetry: none
 	AmazonS3AccessKeyId                *string `access:"environment_file_storage,write_restrictable,cloud_restrictable"` // telemetry: none
@@ -1767,6 +1883,10 @@ func (s *FileSettings) SetDefaults(isUpdate bool) {
 		s.ArchiveRecursion = NewPointer(false)
 	}
 
+	if s.EnableWebPPreviews == nil {
+		s.EnableWebPPreviews = NewPointer(false)
+	}
+
 	if isUpdate {
 		// When updating an existing configuration, ensure link salt has been specified.
 		if s.PublicLinkSalt == nil || *s.PublicLinkSalt == "" {
@@ -1932,6 +2052,7 @@ type EmailSettings struct {
 	EnableEmailBatching               *bool   `access:"site_notifications"`
 	EmailBatchingBufferSize           *int    `access:"experimental_features"`
 	EmailBatchingInterval             *int    `access:"experimental_features"`
+	EnableEmailDigests                *bool   `access:"site_notifications"`
 	EnablePreviewModeBanner           *bool   `access:"site_notifications"`
 	SkipServerCertificateVerification *bool   `access:"environment_smtp,write_restrictable,cloud_restrictable"`
 	EmailNotificationContentsType     *string `access:"site_notifications"`
@@ -2045,6 +2166,10 @@ func (s *EmailSettings) SetDefaults(isUpdate bool) {
 		s.EmailBatchingInterval = NewPointer(EmailBatchingInterval)
 	}
 
+	if s.EnableEmailDigests == nil {
+		s.EnableEmailDigests = NewPointer(true)
+	}
+
 	if s.EnablePreviewModeBanner == nil {
 		s.EnablePreviewModeBanner = NewPointer(true)
 	}
@@ -2090,6 +2215,16 @@ type RateLimitSettings struct {
 	VaryByRemoteAddr *bool  `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
 	VaryByUser       *bool  `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
 	VaryByHeader     string `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
+	// Quotas of the routes more expensive or sensitive than the others, applied on top of
+	// the default one. Setting a rate to 0 disables the quota.
+	LoginPerMin         *int `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
+	LoginMaxBurst       *int `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
+	FileUploadPerMin    *int `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
+	FileUploadMaxBurst  *int `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
+	SearchPerMin        *int `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
+	SearchMaxBurst      *int `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
+	IntegrationPerMin   *int `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"` // Per bot or personal access token
+	IntegrationMaxBurst *int `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
 }
 
 func (s *RateLimitSettings) SetDefaults() {
@@ -2116,6 +2251,38 @@ func (s *RateLimitSettings) SetDefaults() {
 	if s.VaryByUser == nil {
 		s.VaryByUser = NewPointer(false)
 	}
+
+	if s.LoginPerMin == nil {
+		s.LoginPerMin = NewPointer(20)
+	}
+
+	if s.LoginMaxBurst == nil {
+		s.LoginMaxBurst = NewPointer(10)
+	}
+
+	if s.FileUploadPerMin == nil {
+		s.FileUploadPerMin = NewPointer(120)
+	}
+
+	if s.FileUploadMaxBurst == nil {
+		s.FileUploadMaxBurst = NewPointer(30)
+	}
+
+	if s.SearchPerMin == nil {
+		s.SearchPerMin = NewPointer(120)
+	}
+
+	if s.SearchMaxBurst == nil {
+		s.SearchMaxBurst = NewPointer(30)
+	}
+
+	if s.IntegrationPerMin == nil {
+		s.IntegrationPerMin = NewPointer(1200)
+	}
+
+	if s.IntegrationMaxBurst == nil {
+		s.IntegrationMaxBurst = NewPointer(200)
+	}
 }
 
 type PrivacySettings struct {
@@ -3921,8 +4088,8 @@ func (o *Config) IsValid() *AppError {
 		return appErr
 	}
 
-	if *o.PasswordSettings.MinimumLength < PasswordMinimumLength || *o.PasswordSettings.MinimumLength > PasswordMaximumLength {
-		return NewAppError("Config.IsValid", "model.config.is_valid.password_length.app_error", map[string]any{"MinLength": PasswordMinimumLength, "MaxLength": PasswordMaximumLength}, "", http.StatusBadRequest)
+	if appErr := o.PasswordSettings.isValid(); appErr != nil {
+		return appErr
 	}
 
 	if appErr := o.RateLimitSettings.isValid(); appErr != nil {
@@ -4170,6 +4337,17 @@ func (s *RateLimitSettings) isValid() *AppError {
 		return NewAppError("Config.IsValid", "model.config.is_valid.max_burst.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	for class, quota := range map[string][2]int{
+		"Login":       {*s.LoginPerMin, *s.LoginMaxBurst},
+		"FileUpload":  {*s.FileUploadPerMin, *s.FileUploadMaxBurst},
+		"Search":      {*s.SearchPerMin, *s.SearchMaxBurst},
+		"Integration": {*s.IntegrationPerMin, *s.IntegrationMaxBurst},
+	} {
+		if quota[0] < 0 || (quota[0] > 0 && quota[1] <= 0) {
+			return NewAppError("Config.IsValid", "model.config.is_valid.rate_limit_quota.app_error", map[string]any{"Name": class}, "", http.StatusBadRequest)
+		}
+	}
+
 	return nil
 }
 
@@ -4367,12 +4545,39 @@ func (s *ServiceSettings) isValid() *AppError {
 		return NewAppError("Config.IsValid", "model.config.is_valid.login_attempts.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if *s.MaximumLoginAttemptsPerIP < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.login_attempts_per_ip.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.LoginAttemptsPerIPWindowMinutes <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.login_attempts_per_ip_window.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	if *s.SiteURL != "" {
 		if _, err := url.ParseRequestURI(*s.SiteURL); err != nil {
 			return NewAppError("Config.IsValid", "model.config.is_valid.site_url.app_error", nil, "", http.StatusBadRequest).Wrap(err)
 		}
 	}
 
+	if *s.EnableWebAuthn {
+		// Credentials are scoped to the domain of the site URL, or a parent domain of it.
+		siteURL, err := url.Parse(*s.SiteURL)
+		if *s.SiteURL == "" || err != nil {
+			return NewAppError("Config.IsValid", "model.config.is_valid.site_url_webauthn.app_error", nil, "", http.StatusBadRequest)
+		}
+
+		host := siteURL.Hostname()
+		if rpID := *s.WebAuthnRelyingPartyId; rpID != "" && host != rpID && !strings.HasSuffix(host, "."+rpID) {
+			return NewAppError("Config.IsValid", "model.config.is_valid.webauthn_relying_party_id.app_error", nil, "", http.StatusBadRequest)
+		}
+	}
+
+	switch *s.WebAuthnUserVerification {
+	case WebAuthnUserVerificationRequired, WebAuthnUserVerificationPreferred, WebAuthnUserVerificationDiscouraged:
+	default:
+		return NewAppError("Config.IsValid", "model.config.is_valid.webauthn_user_verification.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	if *s.WebsocketURL != "" {
 		if _, err := url.ParseRequestURI(*s.WebsocketURL); err != nil {
 			return NewAppError("Config.IsValid", "model.config.is_valid.websocket_url.app_error", nil, "", http.StatusBadRequest).Wrap(err)
@@ -4747,6 +4952,10 @@ func (o *Config) Sanitize(pluginManifests []*Manifest, opts *SanitizeOptions) {
 		*o.CacheSettings.RedisPassword = FakeSetting
 	}
 
+	if o.ExperimentalAuditSettings.StoreSigningKey != nil {
+		*o.ExperimentalAuditSettings.StoreSigningKey = FakeSetting
+	}
+
 	o.PluginSettings.Sanitize(pluginManifests)
 }
 
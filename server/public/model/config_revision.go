@@ -0,0 +1,92 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	// ConfigRevisionsMaxCount is the number of revisions of the configuration
+	// kept, the oldest ones being deleted first.
+	ConfigRevisionsMaxCount = 200
+
+	// ConfigRevisionBaseCurrent compares a revision of the configuration with
+	// the current configuration rather than with another revision.
+	ConfigRevisionBaseCurrent = "current"
+)
+
+// ConfigRevision is a version of the configuration saved through the API, along
+// with who saved it. The revisions are ordered by CreateAt, which increases
+// strictly from one revision to the next.
+type ConfigRevision struct {
+	Id       string `json:"id"`
+	CreateAt int64  `json:"create_at"`
+
+	// UserId is the user who saved the configuration. It is empty for changes
+	// made in local mode and for the configuration found when the history
+	// was started.
+	UserId string `json:"user_id"`
+
+	// Value is the JSON of the configuration, without the environment
+	// overrides. It holds secrets, and is never sent to clients.
+	Value string `json:"-"`
+}
+
+// ConfigChange is a setting that differs between two configurations.
+type ConfigChange struct {
+	Path      string `json:"path"`
+	BaseVal   any    `json:"base_val"`
+	ActualVal any    `json:"actual_val"`
+}
+
+func (r *ConfigRevision) Auditable() map[string]any {
+	return map[string]any{
+		"id":        r.Id,
+		"create_at": r.CreateAt,
+		"user_id":   r.UserId,
+	}
+}
+
+func (r *ConfigRevision) PreSave() {
+	if r.Id == "" {
+		r.Id = NewId()
+	}
+
+	if r.CreateAt == 0 {
+		r.CreateAt = GetMillis()
+	}
+}
+
+func (r *ConfigRevision) IsValid() *AppError {
+	if !IsValidId(r.Id) {
+		return NewAppError("ConfigRevision.IsValid", "model.config_revision.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if r.CreateAt == 0 {
+		return NewAppError("ConfigRevision.IsValid", "model.config_revision.is_valid.create_at.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.UserId != "" && !IsValidId(r.UserId) {
+		return NewAppError("ConfigRevision.IsValid", "model.config_revision.is_valid.user_id.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.Value == "" || !json.Valid([]byte(r.Value)) {
+		return NewAppError("ConfigRevision.IsValid", "model.config_revision.is_valid.value.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// Config returns the configuration saved with the revision.
+func (r *ConfigRevision) Config() (*Config, error) {
+	var cfg Config
+	if err := json.Unmarshal([]byte(r.Value), &cfg); err != nil {
+		return nil, err
+	}
+	cfg.SetDefaults()
+
+	return &cfg, nil
+}
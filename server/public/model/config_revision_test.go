@@ -0,0 +1,44 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigRevisionIsValid(t *testing.T) {
+	cfg := &Config{}
+	cfg.SetDefaults()
+	cfg.TeamSettings.SiteName = NewPointer("revision")
+	value, err := json.Marshal(cfg)
+	require.NoError(t, err)
+
+	revision := ConfigRevision{Value: string(value)}
+	revision.PreSave()
+	require.Nil(t, revision.IsValid())
+
+	revision.UserId = "invalid"
+	appErr := revision.IsValid()
+	require.NotNil(t, appErr)
+	assert.Equal(t, "model.config_revision.is_valid.user_id.app_error", appErr.Id)
+
+	revision.UserId = NewId()
+	revision.Value = "{"
+	appErr = revision.IsValid()
+	require.NotNil(t, appErr)
+	assert.Equal(t, "model.config_revision.is_valid.value.app_error", appErr.Id)
+
+	revision.Value = string(value)
+	revisionCfg, err := revision.Config()
+	require.NoError(t, err)
+	assert.Equal(t, "revision", *revisionCfg.TeamSettings.SiteName)
+
+	data, err := json.Marshal(revision)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "revision\"", "the configuration must not be sent to clients")
+}
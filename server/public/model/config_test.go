@@ -13,6 +13,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/v8/channels/app/password/hashers"
 )
 
 func TestConfigDefaults(t *testing.T) {
@@ -337,6 +339,92 @@ func TestWranglerSettingsIsValid(t *testing.T) {
 	}
 }
 
+func TestPasswordSettingsArgon2idLimits(t *testing.T) {
+	// The hasher bounds the parameters read from stored hashes the same way.
+	assert.Equal(t, hashers.DefaultArgon2idMemory, PasswordSettingsDefaultArgon2idMemory)
+	assert.Equal(t, hashers.DefaultArgon2idIterations, PasswordSettingsDefaultArgon2idIterations)
+	assert.Equal(t, hashers.DefaultArgon2idParallelism, PasswordSettingsDefaultArgon2idParallelism)
+	assert.Equal(t, hashers.MaximumArgon2idMemory, PasswordSettingsMaximumArgon2idMemory)
+	assert.Equal(t, hashers.MaximumArgon2idIterations, PasswordSettingsMaximumArgon2idIterations)
+	assert.Equal(t, hashers.MaximumArgon2idParallelism, PasswordSettingsMaximumArgon2idParallelism)
+}
+
+func TestPasswordSettingsIsValid(t *testing.T) {
+	for name, test := range map[string]struct {
+		Settings      PasswordSettings
+		ExpectedError string
+	}{
+		"defaults": {},
+		"minimum length too short": {
+			Settings:      PasswordSettings{MinimumLength: NewPointer(PasswordMinimumLength - 1)},
+			ExpectedError: "model.config.is_valid.password_length.app_error",
+		},
+		"stronger Argon2id parameters": {
+			Settings: PasswordSettings{Argon2idMemory: NewPointer(64 * 1024), Argon2idIterations: NewPointer(3), Argon2idParallelism: NewPointer(4)},
+		},
+		"no Argon2id iterations": {
+			Settings:      PasswordSettings{Argon2idIterations: NewPointer(0)},
+			ExpectedError: "model.config.is_valid.password_argon2id_iterations.app_error",
+		},
+		"too much Argon2id parallelism": {
+			Settings:      PasswordSettings{Argon2idParallelism: NewPointer(PasswordSettingsMaximumArgon2idParallelism + 1)},
+			ExpectedError: "model.config.is_valid.password_argon2id_parallelism.app_error",
+		},
+		"too little Argon2id memory per thread": {
+			Settings:      PasswordSettings{Argon2idMemory: NewPointer(31), Argon2idParallelism: NewPointer(4)},
+			ExpectedError: "model.config.is_valid.password_argon2id_memory.app_error",
+		},
+		"too much Argon2id memory": {
+			Settings:      PasswordSettings{Argon2idMemory: NewPointer(PasswordSettingsMaximumArgon2idMemory + 1)},
+			ExpectedError: "model.config.is_valid.password_argon2id_memory.app_error",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.Settings.SetDefaults()
+
+			appErr := test.Settings.isValid()
+			if test.ExpectedError != "" {
+				require.NotNil(t, appErr)
+				assert.Equal(t, test.ExpectedError, appErr.Id)
+			} else {
+				assert.Nil(t, appErr)
+			}
+		})
+	}
+}
+
+func TestRateLimitSettingsIsValid(t *testing.T) {
+	for name, test := range map[string]struct {
+		Settings      RateLimitSettings
+		ExpectedError string
+	}{
+		"defaults": {},
+		"disabled quota class": {
+			Settings: RateLimitSettings{SearchPerMin: NewPointer(0), SearchMaxBurst: NewPointer(0)},
+		},
+		"negative quota class rate": {
+			Settings:      RateLimitSettings{LoginPerMin: NewPointer(-1)},
+			ExpectedError: "model.config.is_valid.rate_limit_quota.app_error",
+		},
+		"quota class without burst": {
+			Settings:      RateLimitSettings{IntegrationMaxBurst: NewPointer(0)},
+			ExpectedError: "model.config.is_valid.rate_limit_quota.app_error",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.Settings.SetDefaults()
+
+			appErr := test.Settings.isValid()
+			if test.ExpectedError != "" {
+				require.NotNil(t, appErr)
+				assert.Equal(t, test.ExpectedError, appErr.Id)
+			} else {
+				assert.Nil(t, appErr)
+			}
+		})
+	}
+}
+
 func TestConfigIsValidDefaultAlgorithms(t *testing.T) {
 	c1 := Config{}
 	c1.SetDefaults()
@@ -2265,6 +2353,28 @@ func TestExperimentalAuditSettingsIsValid(t *testing.T) {
 			},
 			ExpectError: true,
 		},
+		"negative store retention days": {
+			ExperimentalAuditSettings: ExperimentalAuditSettings{
+				StoreEnabled:       NewPointer(true),
+				StoreRetentionDays: NewPointer(-1),
+				StoreSigningKey:    NewPointer(NewRandomString(32)),
+			},
+			ExpectError: true,
+		},
+		"store enabled with a signing key": {
+			ExperimentalAuditSettings: ExperimentalAuditSettings{
+				StoreEnabled:    NewPointer(true),
+				StoreSigningKey: NewPointer(NewRandomString(32)),
+			},
+			ExpectError: false,
+		},
+		"store enabled with a short signing key": {
+			ExperimentalAuditSettings: ExperimentalAuditSettings{
+				StoreEnabled:    NewPointer(true),
+				StoreSigningKey: NewPointer("short"),
+			},
+			ExpectError: true,
+		},
 		"AdvancedLoggingJSON has JSON error ": {
 			ExperimentalAuditSettings: ExperimentalAuditSettings{
 				AdvancedLoggingJSON: json.RawMessage(`
@@ -0,0 +1,82 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"hash/fnv"
+	"strconv"
+	"time"
+)
+
+const (
+	EmailDigestDefaultHour    = 8
+	EmailDigestDefaultWeekday = time.Monday
+)
+
+// EmailDigestSchedule is when a user receives the email summarizing the activity they
+// missed, as set in their notification preferences.
+type EmailDigestSchedule struct {
+	Frequency string
+	Hour      int
+	Weekday   time.Weekday
+
+	// Minute spreads the digests of the users sharing the same hour over that hour.
+	Minute int
+}
+
+// NewEmailDigestSchedule reads the schedule of the digests of a user from their
+// notification preferences. It returns nil when the user didn't opt in.
+func NewEmailDigestSchedule(userID string, preferences Preferences) *EmailDigestSchedule {
+	schedule := &EmailDigestSchedule{
+		Hour:    EmailDigestDefaultHour,
+		Weekday: EmailDigestDefaultWeekday,
+	}
+
+	for _, preference := range preferences {
+		if preference.Category != PreferenceCategoryNotifications {
+			continue
+		}
+
+		switch preference.Name {
+		case PreferenceNameEmailDigest:
+			schedule.Frequency = preference.Value
+		case PreferenceNameEmailDigestHour:
+			if hour, err := strconv.Atoi(preference.Value); err == nil && hour >= 0 && hour < 24 {
+				schedule.Hour = hour
+			}
+		case PreferenceNameEmailDigestWeekday:
+			if weekday, err := strconv.Atoi(preference.Value); err == nil && weekday >= 0 && weekday < 7 {
+				schedule.Weekday = time.Weekday(weekday)
+			}
+		}
+	}
+
+	if schedule.Frequency != PreferenceEmailDigestDaily && schedule.Frequency != PreferenceEmailDigestWeekly {
+		return nil
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(userID))
+	schedule.Minute = int(h.Sum32() % 60)
+
+	return schedule
+}
+
+// LastDueAt returns the latest time, not after now, a digest was due. The hour and
+// weekday of the schedule are those of the location of now.
+func (s *EmailDigestSchedule) LastDueAt(now time.Time) time.Time {
+	dueAt := time.Date(now.Year(), now.Month(), now.Day(), s.Hour, s.Minute, 0, 0, now.Location())
+
+	days := 1
+	if s.Frequency == PreferenceEmailDigestWeekly {
+		days = 7
+		dueAt = dueAt.AddDate(0, 0, int(s.Weekday-dueAt.Weekday()))
+	}
+
+	for dueAt.After(now) {
+		dueAt = dueAt.AddDate(0, 0, -days)
+	}
+
+	return dueAt
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEmailDigestSchedule(t *testing.T) {
+	userID := NewId()
+
+	t.Run("not opted in", func(t *testing.T) {
+		assert.Nil(t, NewEmailDigestSchedule(userID, nil))
+		assert.Nil(t, NewEmailDigestSchedule(userID, Preferences{
+			{UserId: userID, Category: PreferenceCategoryNotifications, Name: PreferenceNameEmailDigest, Value: PreferenceEmailDigestOff},
+		}))
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		schedule := NewEmailDigestSchedule(userID, Preferences{
+			{UserId: userID, Category: PreferenceCategoryNotifications, Name: PreferenceNameEmailDigest, Value: PreferenceEmailDigestDaily},
+		})
+		require.NotNil(t, schedule)
+		assert.Equal(t, PreferenceEmailDigestDaily, schedule.Frequency)
+		assert.Equal(t, EmailDigestDefaultHour, schedule.Hour)
+		assert.Equal(t, EmailDigestDefaultWeekday, schedule.Weekday)
+		assert.GreaterOrEqual(t, schedule.Minute, 0)
+		assert.Less(t, schedule.Minute, 60)
+
+		// The minute of a user never changes.
+		assert.Equal(t, schedule.Minute, NewEmailDigestSchedule(userID, Preferences{
+			{UserId: userID, Category: PreferenceCategoryNotifications, Name: PreferenceNameEmailDigest, Value: PreferenceEmailDigestWeekly},
+		}).Minute)
+	})
+
+	t.Run("hour and weekday", func(t *testing.T) {
+		schedule := NewEmailDigestSchedule(userID, Preferences{
+			{UserId: userID, Category: PreferenceCategoryNotifications, Name: PreferenceNameEmailDigest, Value: PreferenceEmailDigestWeekly},
+			{UserId: userID, Category: PreferenceCategoryNotifications, Name: PreferenceNameEmailDigestHour, Value: "17"},
+			{UserId: userID, Category: PreferenceCategoryNotifications, Name: PreferenceNameEmailDigestWeekday, Value: "5"},
+		})
+		require.NotNil(t, schedule)
+		assert.Equal(t, 17, schedule.Hour)
+		assert.Equal(t, time.Friday, schedule.Weekday)
+	})
+
+	t.Run("invalid hour and weekday", func(t *testing.T) {
+		schedule := NewEmailDigestSchedule(userID, Preferences{
+			{UserId: userID, Category: PreferenceCategoryNotifications, Name: PreferenceNameEmailDigest, Value: PreferenceEmailDigestWeekly},
+			{UserId: userID, Category: PreferenceCategoryNotifications, Name: PreferenceNameEmailDigestHour, Value: "24"},
+			{UserId: userID, Category: PreferenceCategoryNotifications, Name: PreferenceNameEmailDigestWeekday, Value: "monday"},
+		})
+		require.NotNil(t, schedule)
+		assert.Equal(t, EmailDigestDefaultHour, schedule.Hour)
+		assert.Equal(t, EmailDigestDefaultWeekday, schedule.Weekday)
+	})
+}
+
+func TestEmailDigestScheduleLastDueAt(t *testing.T) {
+	loc, err := time.LoadLocation("America/Toronto")
+	require.NoError(t, err)
+
+	// Wednesday, March 4th 2026.
+	now := time.Date(2026, time.March, 4, 10, 30, 0, 0, loc)
+
+	t.Run("daily, later today", func(t *testing.T) {
+		schedule := &EmailDigestSchedule{Frequency: PreferenceEmailDigestDaily, Hour: 17, Minute: 15}
+		assert.Equal(t, time.Date(2026, time.March, 3, 17, 15, 0, 0, loc), schedule.LastDueAt(now))
+	})
+
+	t.Run("daily, earlier today", func(t *testing.T) {
+		schedule := &EmailDigestSchedule{Frequency: PreferenceEmailDigestDaily, Hour: 10, Minute: 30}
+		assert.Equal(t, time.Date(2026, time.March, 4, 10, 30, 0, 0, loc), schedule.LastDueAt(now))
+	})
+
+	t.Run("weekly, earlier this week", func(t *testing.T) {
+		schedule := &EmailDigestSchedule{Frequency: PreferenceEmailDigestWeekly, Hour: 8, Weekday: time.Monday}
+		assert.Equal(t, time.Date(2026, time.March, 2, 8, 0, 0, 0, loc), schedule.LastDueAt(now))
+	})
+
+	t.Run("weekly, later this week", func(t *testing.T) {
+		schedule := &EmailDigestSchedule{Frequency: PreferenceEmailDigestWeekly, Hour: 8, Weekday: time.Friday}
+		assert.Equal(t, time.Date(2026, time.February, 27, 8, 0, 0, 0, loc), schedule.LastDueAt(now))
+	})
+
+	t.Run("weekly, later today", func(t *testing.T) {
+		schedule := &EmailDigestSchedule{Frequency: PreferenceEmailDigestWeekly, Hour: 17, Weekday: time.Wednesday}
+		assert.Equal(t, time.Date(2026, time.February, 25, 17, 0, 0, 0, loc), schedule.LastDueAt(now))
+	})
+}
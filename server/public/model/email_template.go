@@ -0,0 +1,74 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"slices"
+)
+
+const (
+	EmailTemplateContentMaxLength = 256 * 1024
+	EmailTemplateLocaleMaxLength  = 5
+)
+
+// EmailTemplateNames lists the templates of the emails sent by the server that
+// can be customized. The email_footer and email_info templates are shared by
+// several of the others.
+var EmailTemplateNames = []string{
+	"cloud_welcome_email",
+	"deactivate_body",
+	"email_change_body",
+	"email_change_verify_body",
+	"email_footer",
+	"email_info",
+	"invite_body",
+	"ip_filters_changed",
+	"license_up_for_renewal",
+	"messages_notification",
+	"mfa_change_body",
+	"password_change_body",
+	"remove_expired_license",
+	"reset_body",
+	"signin_change_body",
+	"verify_body",
+	"welcome_body",
+}
+
+// EmailTemplate is the HTML template of an email in a language. Its content is
+// either the one the server ships with, or the one an admin replaced it with.
+type EmailTemplate struct {
+	Name       string `json:"name"`
+	Locale     string `json:"locale"`
+	Content    string `json:"content"`
+	Overridden bool   `json:"overridden"`
+}
+
+func (o *EmailTemplate) Auditable() map[string]any {
+	return map[string]any{
+		"name":       o.Name,
+		"locale":     o.Locale,
+		"overridden": o.Overridden,
+	}
+}
+
+func IsValidEmailTemplateName(name string) bool {
+	return slices.Contains(EmailTemplateNames, name)
+}
+
+func (o *EmailTemplate) IsValid() *AppError {
+	if !IsValidEmailTemplateName(o.Name) {
+		return NewAppError("EmailTemplate.IsValid", "model.email_template.is_valid.name.app_error", nil, "name="+o.Name, http.StatusBadRequest)
+	}
+
+	if o.Locale == "" || len(o.Locale) > EmailTemplateLocaleMaxLength {
+		return NewAppError("EmailTemplate.IsValid", "model.email_template.is_valid.locale.app_error", nil, "name="+o.Name, http.StatusBadRequest)
+	}
+
+	if o.Content == "" || len(o.Content) > EmailTemplateContentMaxLength {
+		return NewAppError("EmailTemplate.IsValid", "model.email_template.is_valid.content.app_error", map[string]any{"MaxLength": EmailTemplateContentMaxLength}, "name="+o.Name, http.StatusBadRequest)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailTemplateIsValid(t *testing.T) {
+	emailTemplate := EmailTemplate{
+		Name:    "reset_body",
+		Locale:  "vi",
+		Content: "<p>{{.Props.Title}}</p>",
+	}
+	require.Nil(t, emailTemplate.IsValid())
+
+	t.Run("name", func(t *testing.T) {
+		invalid := emailTemplate
+		invalid.Name = "unknown_body"
+		assert.NotNil(t, invalid.IsValid())
+
+		invalid.Name = ""
+		assert.NotNil(t, invalid.IsValid())
+	})
+
+	t.Run("locale", func(t *testing.T) {
+		invalid := emailTemplate
+		invalid.Locale = ""
+		assert.NotNil(t, invalid.IsValid())
+
+		invalid.Locale = "vietnamese"
+		assert.NotNil(t, invalid.IsValid())
+	})
+
+	t.Run("content", func(t *testing.T) {
+		invalid := emailTemplate
+		invalid.Content = ""
+		assert.NotNil(t, invalid.IsValid())
+
+		invalid.Content = strings.Repeat("a", EmailTemplateContentMaxLength+1)
+		assert.NotNil(t, invalid.IsValid())
+	})
+}
@@ -0,0 +1,238 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"slices"
+	"unicode/utf8"
+)
+
+// The event types of event subscriptions are named after the plugin hooks
+// fired for the same events.
+const (
+	EventTypeMessageHasBeenPosted   = "MessageHasBeenPosted"
+	EventTypeMessageHasBeenUpdated  = "MessageHasBeenUpdated"
+	EventTypeMessageHasBeenDeleted  = "MessageHasBeenDeleted"
+	EventTypeChannelHasBeenCreated  = "ChannelHasBeenCreated"
+	EventTypeUserHasJoinedChannel   = "UserHasJoinedChannel"
+	EventTypeUserHasLeftChannel     = "UserHasLeftChannel"
+	EventTypeUserHasJoinedTeam      = "UserHasJoinedTeam"
+	EventTypeUserHasLeftTeam        = "UserHasLeftTeam"
+	EventTypeUserHasBeenCreated     = "UserHasBeenCreated"
+	EventTypeUserHasBeenDeactivated = "UserHasBeenDeactivated"
+	EventTypeReactionHasBeenAdded   = "ReactionHasBeenAdded"
+	EventTypeReactionHasBeenRemoved = "ReactionHasBeenRemoved"
+
+	EventSubscriptionEventHeader = "X-Mattermost-Event"
+
+	EventSubscriptionDisplayNameMaxRunes = 64
+	EventSubscriptionDescriptionMaxRunes = 500
+	EventSubscriptionURLMaxLength        = 1024
+)
+
+// EventSubscriptionEventTypes lists the events a subscription can be made to.
+var EventSubscriptionEventTypes = []string{
+	EventTypeMessageHasBeenPosted,
+	EventTypeMessageHasBeenUpdated,
+	EventTypeMessageHasBeenDeleted,
+	EventTypeChannelHasBeenCreated,
+	EventTypeUserHasJoinedChannel,
+	EventTypeUserHasLeftChannel,
+	EventTypeUserHasJoinedTeam,
+	EventTypeUserHasLeftTeam,
+	EventTypeUserHasBeenCreated,
+	EventTypeUserHasBeenDeactivated,
+	EventTypeReactionHasBeenAdded,
+	EventTypeReactionHasBeenRemoved,
+}
+
+// EventSubscription sends the server events of the chosen types to a URL,
+// optionally only those happening in a team or a channel.
+type EventSubscription struct {
+	Id          string      `json:"id"`
+	CreateAt    int64       `json:"create_at"`
+	UpdateAt    int64       `json:"update_at"`
+	DeleteAt    int64       `json:"delete_at"`
+	CreatorId   string      `json:"creator_id"`
+	DisplayName string      `json:"display_name"`
+	Description string      `json:"description"`
+	URL         string      `json:"url"`
+	EventTypes  StringArray `json:"event_types"`
+	TeamId      string      `json:"team_id"`
+	ChannelId   string      `json:"channel_id"`
+	Secret      string      `json:"secret"`
+}
+
+// EventSubscriptionPatch holds the fields of an event subscription that can
+// be changed.
+type EventSubscriptionPatch struct {
+	DisplayName *string      `json:"display_name"`
+	Description *string      `json:"description"`
+	URL         *string      `json:"url"`
+	EventTypes  *StringArray `json:"event_types"`
+	TeamId      *string      `json:"team_id"`
+	ChannelId   *string      `json:"channel_id"`
+}
+
+// EventSubscriptionPayload is the body of the requests sent to the URL of an
+// event subscription. Data holds the object the event is about, as passed to
+// the plugin hook of the same name.
+type EventSubscriptionPayload struct {
+	Id             string `json:"id"`
+	SubscriptionId string `json:"subscription_id"`
+	Event          string `json:"event"`
+	Timestamp      int64  `json:"timestamp"`
+	TeamId         string `json:"team_id,omitempty"`
+	ChannelId      string `json:"channel_id,omitempty"`
+	UserId         string `json:"user_id,omitempty"`
+	ActorId        string `json:"actor_id,omitempty"`
+	Data           any    `json:"data"`
+}
+
+func (o *EventSubscription) Auditable() map[string]any {
+	return map[string]any{
+		"id":           o.Id,
+		"create_at":    o.CreateAt,
+		"update_at":    o.UpdateAt,
+		"delete_at":    o.DeleteAt,
+		"creator_id":   o.CreatorId,
+		"display_name": o.DisplayName,
+		"description":  o.Description,
+		"url":          o.URL,
+		"event_types":  o.EventTypes,
+		"team_id":      o.TeamId,
+		"channel_id":   o.ChannelId,
+	}
+}
+
+func (o *EventSubscription) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("EventSubscription.IsValid", "model.event_subscription.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("EventSubscription.IsValid", "model.event_subscription.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.UpdateAt == 0 {
+		return NewAppError("EventSubscription.IsValid", "model.event_subscription.is_valid.update_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.CreatorId) {
+		return NewAppError("EventSubscription.IsValid", "model.event_subscription.is_valid.creator_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(o.DisplayName) > EventSubscriptionDisplayNameMaxRunes {
+		return NewAppError("EventSubscription.IsValid", "model.event_subscription.is_valid.display_name.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(o.Description) > EventSubscriptionDescriptionMaxRunes {
+		return NewAppError("EventSubscription.IsValid", "model.event_subscription.is_valid.description.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if len(o.URL) > EventSubscriptionURLMaxLength || !IsValidHTTPURL(o.URL) {
+		return NewAppError("EventSubscription.IsValid", "model.event_subscription.is_valid.url.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if len(o.EventTypes) == 0 {
+		return NewAppError("EventSubscription.IsValid", "model.event_subscription.is_valid.event_types.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	for _, eventType := range o.EventTypes {
+		if !slices.Contains(EventSubscriptionEventTypes, eventType) {
+			return NewAppError("EventSubscription.IsValid", "model.event_subscription.is_valid.event_type.app_error", map[string]any{"EventType": eventType}, "id="+o.Id, http.StatusBadRequest)
+		}
+	}
+
+	if o.TeamId != "" && !IsValidId(o.TeamId) {
+		return NewAppError("EventSubscription.IsValid", "model.event_subscription.is_valid.team_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.ChannelId != "" && !IsValidId(o.ChannelId) {
+		return NewAppError("EventSubscription.IsValid", "model.event_subscription.is_valid.channel_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if len(o.Secret) > 64 {
+		return NewAppError("EventSubscription.IsValid", "model.event_subscription.is_valid.secret.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *EventSubscription) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	if o.Secret == "" {
+		o.Secret = NewRandomString(OutgoingWebhookSecretLength)
+	}
+
+	o.EventTypes = dedupeEventTypes(o.EventTypes)
+
+	o.CreateAt = GetMillis()
+	o.UpdateAt = o.CreateAt
+}
+
+func (o *EventSubscription) PreUpdate() {
+	o.EventTypes = dedupeEventTypes(o.EventTypes)
+	o.UpdateAt = GetMillis()
+}
+
+func (o *EventSubscription) Patch(patch *EventSubscriptionPatch) {
+	if patch.DisplayName != nil {
+		o.DisplayName = *patch.DisplayName
+	}
+
+	if patch.Description != nil {
+		o.Description = *patch.Description
+	}
+
+	if patch.URL != nil {
+		o.URL = *patch.URL
+	}
+
+	if patch.EventTypes != nil {
+		o.EventTypes = *patch.EventTypes
+	}
+
+	if patch.TeamId != nil {
+		o.TeamId = *patch.TeamId
+	}
+
+	if patch.ChannelId != nil {
+		o.ChannelId = *patch.ChannelId
+	}
+}
+
+// Matches returns whether an event of the given type, that happened in the
+// given team and channel, must be sent to the subscription. Events that don't
+// belong to any team or channel, such as the creation of a user, only match
+// the subscriptions without the corresponding filter.
+func (o *EventSubscription) Matches(eventType, teamID, channelID string) bool {
+	if !o.EventTypes.Contains(eventType) {
+		return false
+	}
+
+	if o.TeamId != "" && o.TeamId != teamID {
+		return false
+	}
+
+	if o.ChannelId != "" && o.ChannelId != channelID {
+		return false
+	}
+
+	return true
+}
+
+func dedupeEventTypes(eventTypes StringArray) StringArray {
+	result := make(StringArray, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if !result.Contains(eventType) {
+			result = append(result, eventType)
+		}
+	}
+	return result
+}
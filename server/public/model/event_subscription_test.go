@@ -0,0 +1,120 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventSubscriptionIsValid(t *testing.T) {
+	o := EventSubscription{}
+	require.NotNil(t, o.IsValid())
+
+	o.Id = NewId()
+	require.NotNil(t, o.IsValid())
+
+	o.CreateAt = GetMillis()
+	require.NotNil(t, o.IsValid())
+
+	o.UpdateAt = GetMillis()
+	require.NotNil(t, o.IsValid())
+
+	o.CreatorId = NewId()
+	require.NotNil(t, o.IsValid())
+
+	o.URL = "ftp://example.com"
+	require.NotNil(t, o.IsValid())
+
+	o.URL = "https://example.com/events"
+	appErr := o.IsValid()
+	require.NotNil(t, appErr)
+	assert.Equal(t, "model.event_subscription.is_valid.event_types.app_error", appErr.Id)
+
+	o.EventTypes = StringArray{EventTypeUserHasJoinedChannel, "Unknown"}
+	appErr = o.IsValid()
+	require.NotNil(t, appErr)
+	assert.Equal(t, "model.event_subscription.is_valid.event_type.app_error", appErr.Id)
+
+	o.EventTypes = StringArray{EventTypeUserHasJoinedChannel}
+	require.Nil(t, o.IsValid())
+
+	o.DisplayName = strings.Repeat("1", EventSubscriptionDisplayNameMaxRunes+1)
+	require.NotNil(t, o.IsValid())
+
+	o.DisplayName = strings.Repeat("1", EventSubscriptionDisplayNameMaxRunes)
+	require.Nil(t, o.IsValid())
+
+	o.Description = strings.Repeat("1", EventSubscriptionDescriptionMaxRunes+1)
+	require.NotNil(t, o.IsValid())
+
+	o.Description = ""
+	o.TeamId = "junk"
+	require.NotNil(t, o.IsValid())
+
+	o.TeamId = NewId()
+	o.ChannelId = "junk"
+	require.NotNil(t, o.IsValid())
+
+	o.ChannelId = NewId()
+	require.Nil(t, o.IsValid())
+
+	o.Secret = strings.Repeat("1", 65)
+	require.NotNil(t, o.IsValid())
+}
+
+func TestEventSubscriptionPreSave(t *testing.T) {
+	o := EventSubscription{
+		EventTypes: StringArray{EventTypeReactionHasBeenAdded, EventTypeReactionHasBeenAdded, EventTypeReactionHasBeenRemoved},
+	}
+	o.PreSave()
+
+	assert.True(t, IsValidId(o.Id))
+	assert.Len(t, o.Secret, OutgoingWebhookSecretLength)
+	assert.NotZero(t, o.CreateAt)
+	assert.Equal(t, o.CreateAt, o.UpdateAt)
+	assert.Equal(t, StringArray{EventTypeReactionHasBeenAdded, EventTypeReactionHasBeenRemoved}, o.EventTypes)
+}
+
+func TestEventSubscriptionPatch(t *testing.T) {
+	o := EventSubscription{
+		DisplayName: "name",
+		URL:         "https://example.com",
+		EventTypes:  StringArray{EventTypeUserHasBeenCreated},
+		TeamId:      NewId(),
+	}
+
+	o.Patch(&EventSubscriptionPatch{
+		URL:        NewPointer("https://example.com/other"),
+		EventTypes: &StringArray{EventTypeUserHasBeenDeactivated},
+		TeamId:     NewPointer(""),
+	})
+
+	assert.Equal(t, "name", o.DisplayName)
+	assert.Equal(t, "https://example.com/other", o.URL)
+	assert.Equal(t, StringArray{EventTypeUserHasBeenDeactivated}, o.EventTypes)
+	assert.Empty(t, o.TeamId)
+}
+
+func TestEventSubscriptionMatches(t *testing.T) {
+	teamID := NewId()
+	channelID := NewId()
+
+	all := EventSubscription{EventTypes: StringArray{EventTypeUserHasJoinedChannel}}
+	assert.True(t, all.Matches(EventTypeUserHasJoinedChannel, teamID, channelID))
+	assert.True(t, all.Matches(EventTypeUserHasJoinedChannel, "", channelID))
+	assert.False(t, all.Matches(EventTypeUserHasLeftChannel, teamID, channelID))
+
+	team := EventSubscription{EventTypes: StringArray{EventTypeUserHasJoinedChannel, EventTypeUserHasBeenCreated}, TeamId: teamID}
+	assert.True(t, team.Matches(EventTypeUserHasJoinedChannel, teamID, channelID))
+	assert.False(t, team.Matches(EventTypeUserHasJoinedChannel, NewId(), channelID))
+	assert.False(t, team.Matches(EventTypeUserHasBeenCreated, "", ""))
+
+	channel := EventSubscription{EventTypes: StringArray{EventTypeUserHasJoinedChannel}, ChannelId: channelID}
+	assert.True(t, channel.Matches(EventTypeUserHasJoinedChannel, teamID, channelID))
+	assert.False(t, channel.Matches(EventTypeUserHasJoinedChannel, teamID, NewId()))
+}
@@ -9,12 +9,28 @@ import (
 	"io"
 	"net/http"
 	"regexp"
+	"slices"
 )
 
 const (
 	DefaultWebhookUsername = "webhook"
+
+	// IncomingWebhookAdapterSlack is the default adapter, parsing the
+	// Slack-compatible payloads of IncomingWebhookRequest.
+	IncomingWebhookAdapterSlack        = ""
+	IncomingWebhookAdapterGitLab       = "gitlab"
+	IncomingWebhookAdapterAlertmanager = "alertmanager"
+	IncomingWebhookAdapterGrafana      = "grafana"
 )
 
+// IncomingWebhookAdapters are the payload formats incoming webhooks can parse.
+var IncomingWebhookAdapters = []string{
+	IncomingWebhookAdapterSlack,
+	IncomingWebhookAdapterGitLab,
+	IncomingWebhookAdapterAlertmanager,
+	IncomingWebhookAdapterGrafana,
+}
+
 type IncomingWebhook struct {
 	Id            string `json:"id"`
 	CreateAt      int64  `json:"create_at"`
@@ -28,6 +44,7 @@ type IncomingWebhook struct {
 	Username      string `json:"username"`
 	IconURL       string `json:"icon_url"`
 	ChannelLocked bool   `json:"channel_locked"`
+	Adapter       string `json:"adapter"`
 }
 
 func (o *IncomingWebhook) Auditable() map[string]any {
@@ -44,6 +61,7 @@ func (o *IncomingWebhook) Auditable() map[string]any {
 		"username":       o.Username,
 		"icon_url:":      o.IconURL,
 		"channel_locked": o.ChannelLocked,
+		"adapter":        o.Adapter,
 	}
 }
 
@@ -57,6 +75,10 @@ type IncomingWebhookRequest struct {
 	Type        string             `json:"type"`
 	IconEmoji   string             `json:"icon_emoji"`
 	Priority    *PostPriority      `json:"priority"`
+
+	// ThreadKey is set by the payload adapters so that the posts about the
+	// same alert group, pipeline or merge request are collapsed in a thread.
+	ThreadKey string `json:"-"`
 }
 
 type IncomingWebhooksWithCount struct {
@@ -105,6 +127,10 @@ func (o *IncomingWebhook) IsValid() *AppError {
 		return NewAppError("IncomingWebhook.IsValid", "model.incoming_hook.icon_url.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if !slices.Contains(IncomingWebhookAdapters, o.Adapter) {
+		return NewAppError("IncomingWebhook.IsValid", "model.incoming_hook.adapter.app_error", map[string]any{"Adapter": o.Adapter}, "", http.StatusBadRequest)
+	}
+
 	return nil
 }
 
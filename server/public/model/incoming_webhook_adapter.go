@@ -0,0 +1,567 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const (
+	incomingWebhookAdapterMaxCommits = 10
+	incomingWebhookAdapterMaxAlerts  = 20
+
+	gitLabNullSHA = "0000000000000000000000000000000000000000"
+
+	alertStatusFiring = "firing"
+
+	colorNeutral = "#8E8E8E"
+)
+
+// IncomingWebhookRequestFromAdapter parses the native payload of the service
+// an incoming webhook adapter is for, and renders it as a Slack-compatible
+// request with attachments.
+func IncomingWebhookRequestFromAdapter(adapter string, data io.Reader) (*IncomingWebhookRequest, *AppError) {
+	var (
+		req *IncomingWebhookRequest
+		err *AppError
+	)
+
+	switch adapter {
+	case IncomingWebhookAdapterSlack:
+		return IncomingWebhookRequestFromJSON(data)
+	case IncomingWebhookAdapterGitLab:
+		var event gitLabEvent
+		if jsonErr := json.NewDecoder(data).Decode(&event); jsonErr != nil {
+			return nil, NewAppError("IncomingWebhookRequestFromAdapter", "model.incoming_hook.parse_data.app_error", nil, "", http.StatusBadRequest).Wrap(jsonErr)
+		}
+		req, err = event.toIncomingWebhookRequest()
+	case IncomingWebhookAdapterAlertmanager:
+		var payload alertmanagerPayload
+		if jsonErr := json.NewDecoder(data).Decode(&payload); jsonErr != nil {
+			return nil, NewAppError("IncomingWebhookRequestFromAdapter", "model.incoming_hook.parse_data.app_error", nil, "", http.StatusBadRequest).Wrap(jsonErr)
+		}
+		req, err = payload.toIncomingWebhookRequest("")
+	case IncomingWebhookAdapterGrafana:
+		var payload grafanaPayload
+		if jsonErr := json.NewDecoder(data).Decode(&payload); jsonErr != nil {
+			return nil, NewAppError("IncomingWebhookRequestFromAdapter", "model.incoming_hook.parse_data.app_error", nil, "", http.StatusBadRequest).Wrap(jsonErr)
+		}
+		req, err = payload.toIncomingWebhookRequest()
+	default:
+		return nil, NewAppError("IncomingWebhookRequestFromAdapter", "model.incoming_hook.adapter.app_error", map[string]any{"Adapter": adapter}, "", http.StatusBadRequest)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	req.Attachments = StringifySlackFieldValue(req.Attachments)
+
+	return req, nil
+}
+
+// validHTTPURL returns the URL if it can be linked from an attachment, and
+// an empty string otherwise.
+func validHTTPURL(url string) string {
+	if url == "" || !IsValidHTTPURL(url) {
+		return ""
+	}
+	return url
+}
+
+func markdownLink(text, url string) string {
+	if validHTTPURL(url) == "" {
+		return text
+	}
+	return "[" + text + "](" + url + ")"
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+type gitLabUser struct {
+	Name      string `json:"name"`
+	Username  string `json:"username"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+type gitLabProject struct {
+	Id                int64  `json:"id"`
+	Name              string `json:"name"`
+	PathWithNamespace string `json:"path_with_namespace"`
+	WebURL            string `json:"web_url"`
+}
+
+type gitLabCommit struct {
+	Id      string `json:"id"`
+	Title   string `json:"title"`
+	Message string `json:"message"`
+	URL     string `json:"url"`
+	Author  struct {
+		Name string `json:"name"`
+	} `json:"author"`
+}
+
+type gitLabBuild struct {
+	Id     int64  `json:"id"`
+	Stage  string `json:"stage"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+type gitLabObjectAttributes struct {
+	Id           int64   `json:"id"`
+	Iid          int64   `json:"iid"`
+	Title        string  `json:"title"`
+	URL          string  `json:"url"`
+	State        string  `json:"state"`
+	Action       string  `json:"action"`
+	Ref          string  `json:"ref"`
+	Tag          bool    `json:"tag"`
+	Sha          string  `json:"sha"`
+	Status       string  `json:"status"`
+	Duration     float64 `json:"duration"`
+	SourceBranch string  `json:"source_branch"`
+	TargetBranch string  `json:"target_branch"`
+}
+
+// gitLabEvent holds the fields of the GitLab webhook events the adapter
+// renders. Push events describe their user with flat fields, the other
+// events with a user object.
+type gitLabEvent struct {
+	ObjectKind        string                 `json:"object_kind"`
+	Ref               string                 `json:"ref"`
+	Before            string                 `json:"before"`
+	After             string                 `json:"after"`
+	UserName          string                 `json:"user_name"`
+	UserAvatar        string                 `json:"user_avatar"`
+	TotalCommitsCount int                    `json:"total_commits_count"`
+	Commits           []gitLabCommit         `json:"commits"`
+	User              *gitLabUser            `json:"user"`
+	Project           gitLabProject          `json:"project"`
+	ObjectAttributes  gitLabObjectAttributes `json:"object_attributes"`
+	Commit            *gitLabCommit          `json:"commit"`
+	Builds            []gitLabBuild          `json:"builds"`
+}
+
+func (e *gitLabEvent) toIncomingWebhookRequest() (*IncomingWebhookRequest, *AppError) {
+	var (
+		attachment *SlackAttachment
+		threadKey  string
+	)
+
+	switch e.ObjectKind {
+	case "push", "tag_push":
+		attachment = e.pushAttachment()
+	case "pipeline":
+		attachment = e.pipelineAttachment()
+		threadKey = fmt.Sprintf("gitlab:pipeline:%d:%d", e.Project.Id, e.ObjectAttributes.Id)
+	case "merge_request":
+		attachment = e.issuableAttachment("Merge request", "!")
+		threadKey = fmt.Sprintf("gitlab:merge_request:%d:%d", e.Project.Id, e.ObjectAttributes.Iid)
+	case "issue":
+		attachment = e.issuableAttachment("Issue", "#")
+		threadKey = fmt.Sprintf("gitlab:issue:%d:%d", e.Project.Id, e.ObjectAttributes.Iid)
+	default:
+		return nil, NewAppError("IncomingWebhookRequestFromAdapter", "model.incoming_hook.adapter.unsupported_event.app_error", map[string]any{"Adapter": IncomingWebhookAdapterGitLab, "Event": e.ObjectKind}, "", http.StatusBadRequest)
+	}
+
+	if e.User != nil {
+		attachment.AuthorName = e.User.Name
+		attachment.AuthorIcon = validHTTPURL(e.User.AvatarURL)
+	} else {
+		attachment.AuthorName = e.UserName
+		attachment.AuthorIcon = validHTTPURL(e.UserAvatar)
+	}
+	attachment.Footer = e.Project.PathWithNamespace
+	attachment.Fallback = attachment.Title
+
+	return &IncomingWebhookRequest{
+		Attachments: []*SlackAttachment{attachment},
+		ThreadKey:   threadKey,
+	}, nil
+}
+
+func (e *gitLabEvent) pushAttachment() *SlackAttachment {
+	kind := "branch"
+	name := strings.TrimPrefix(e.Ref, "refs/heads/")
+	if e.ObjectKind == "tag_push" {
+		kind = "tag"
+		name = strings.TrimPrefix(e.Ref, "refs/tags/")
+	}
+
+	attachment := &SlackAttachment{Color: "#1F78D1"}
+	switch {
+	case e.After == gitLabNullSHA:
+		attachment.Title = fmt.Sprintf("[%s] %s %s deleted", e.Project.PathWithNamespace, kind, name)
+		attachment.TitleLink = validHTTPURL(e.Project.WebURL)
+		attachment.Color = colorNeutral
+		return attachment
+	case e.Before == gitLabNullSHA && kind == "tag":
+		attachment.Title = fmt.Sprintf("[%s] tag %s pushed", e.Project.PathWithNamespace, name)
+		attachment.TitleLink = validHTTPURL(e.Project.WebURL + "/-/tags/" + name)
+	case e.Before == gitLabNullSHA:
+		attachment.Title = fmt.Sprintf("[%s] new branch %s with %d commits", e.Project.PathWithNamespace, name, e.TotalCommitsCount)
+		attachment.TitleLink = validHTTPURL(e.Project.WebURL + "/-/tree/" + name)
+	default:
+		attachment.Title = fmt.Sprintf("[%s:%s] %d new commits", e.Project.PathWithNamespace, name, e.TotalCommitsCount)
+		if e.TotalCommitsCount == 1 {
+			attachment.Title = fmt.Sprintf("[%s:%s] 1 new commit", e.Project.PathWithNamespace, name)
+		}
+		attachment.TitleLink = validHTTPURL(e.Project.WebURL + "/-/compare/" + e.Before + "..." + e.After)
+	}
+
+	lines := make([]string, 0, len(e.Commits)+1)
+	for i, commit := range e.Commits {
+		if i == incomingWebhookAdapterMaxCommits {
+			break
+		}
+		title := commit.Title
+		if title == "" {
+			title, _, _ = strings.Cut(commit.Message, "\n")
+		}
+		lines = append(lines, fmt.Sprintf("%s %s - %s", markdownLink("`"+shortSHA(commit.Id)+"`", commit.URL), title, commit.Author.Name))
+	}
+	if more := e.TotalCommitsCount - min(len(e.Commits), incomingWebhookAdapterMaxCommits); more > 0 && len(lines) > 0 {
+		lines = append(lines, fmt.Sprintf("and %d more", more))
+	}
+	attachment.Text = strings.Join(lines, "\n")
+
+	return attachment
+}
+
+func (e *gitLabEvent) pipelineAttachment() *SlackAttachment {
+	attrs := e.ObjectAttributes
+
+	url := attrs.URL
+	if url == "" {
+		url = fmt.Sprintf("%s/-/pipelines/%d", e.Project.WebURL, attrs.Id)
+	}
+
+	attachment := &SlackAttachment{
+		Title:     fmt.Sprintf("[%s] Pipeline #%d %s", e.Project.PathWithNamespace, attrs.Id, attrs.Status),
+		TitleLink: validHTTPURL(url),
+		Color:     gitLabStatusColor(attrs.Status),
+	}
+
+	ref := attrs.Ref
+	if !attrs.Tag {
+		ref = markdownLink(attrs.Ref, e.Project.WebURL+"/-/tree/"+attrs.Ref)
+	}
+	attachment.Fields = append(attachment.Fields, &SlackAttachmentField{Title: "Ref", Value: ref, Short: true})
+
+	if e.Commit != nil {
+		attachment.Fields = append(attachment.Fields, &SlackAttachmentField{
+			Title: "Commit",
+			Value: markdownLink("`"+shortSHA(e.Commit.Id)+"`", e.Commit.URL) + " " + e.Commit.Title,
+			Short: true,
+		})
+	}
+
+	if attrs.Duration > 0 {
+		attachment.Fields = append(attachment.Fields, &SlackAttachmentField{Title: "Duration", Value: fmt.Sprintf("%ds", int64(attrs.Duration)), Short: true})
+	}
+
+	var failed []string
+	for _, build := range e.Builds {
+		if build.Status == "failed" {
+			failed = append(failed, markdownLink(build.Stage+": "+build.Name, fmt.Sprintf("%s/-/jobs/%d", e.Project.WebURL, build.Id)))
+		}
+	}
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		attachment.Fields = append(attachment.Fields, &SlackAttachmentField{Title: "Failed jobs", Value: strings.Join(failed, "\n")})
+	}
+
+	return attachment
+}
+
+func (e *gitLabEvent) issuableAttachment(kind, reference string) *SlackAttachment {
+	attrs := e.ObjectAttributes
+
+	action := attrs.Action
+	if action == "" {
+		action = attrs.State
+	}
+
+	attachment := &SlackAttachment{
+		Title:     fmt.Sprintf("[%s] %s %s%d %s: %s", e.Project.PathWithNamespace, kind, reference, attrs.Iid, action, attrs.Title),
+		TitleLink: validHTTPURL(attrs.URL),
+		Color:     "#1F78D1",
+	}
+
+	switch attrs.State {
+	case "merged":
+		attachment.Color = "good"
+	case "closed":
+		attachment.Color = colorNeutral
+	}
+
+	if attrs.SourceBranch != "" {
+		attachment.Fields = append(attachment.Fields, &SlackAttachmentField{Title: "Branches", Value: "`" + attrs.SourceBranch + "` → `" + attrs.TargetBranch + "`"})
+	}
+
+	return attachment
+}
+
+func gitLabStatusColor(status string) string {
+	switch status {
+	case "success":
+		return "good"
+	case "failed":
+		return "danger"
+	case "created", "waiting_for_resource", "preparing", "pending", "running", "manual", "scheduled":
+		return "warning"
+	default:
+		return colorNeutral
+	}
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 8 {
+		return sha[:8]
+	}
+	return sha
+}
+
+type alertmanagerAlert struct {
+	Status       string            `json:"status"`
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	GeneratorURL string            `json:"generatorURL"`
+	Fingerprint  string            `json:"fingerprint"`
+
+	// Grafana specific fields.
+	DashboardURL string `json:"dashboardURL"`
+	PanelURL     string `json:"panelURL"`
+	SilenceURL   string `json:"silenceURL"`
+	ValueString  string `json:"valueString"`
+}
+
+// alertmanagerPayload is the version 4 payload of the Alertmanager webhook
+// receiver, which Grafana alerting also sends.
+type alertmanagerPayload struct {
+	Receiver          string              `json:"receiver"`
+	Status            string              `json:"status"`
+	GroupKey          string              `json:"groupKey"`
+	TruncatedAlerts   int                 `json:"truncatedAlerts"`
+	GroupLabels       map[string]string   `json:"groupLabels"`
+	CommonLabels      map[string]string   `json:"commonLabels"`
+	CommonAnnotations map[string]string   `json:"commonAnnotations"`
+	ExternalURL       string              `json:"externalURL"`
+	Alerts            []alertmanagerAlert `json:"alerts"`
+}
+
+// threadKey returns the key the notifications of the same alert group are
+// collapsed by: the group key, or the fingerprint of the alert when the
+// sender doesn't group alerts.
+func (p *alertmanagerPayload) threadKey() string {
+	if p.GroupKey != "" {
+		return "alerts:" + p.GroupKey
+	}
+	if len(p.Alerts) > 0 && p.Alerts[0].Fingerprint != "" {
+		return "alert:" + p.Alerts[0].Fingerprint
+	}
+	return ""
+}
+
+func (p *alertmanagerPayload) toIncomingWebhookRequest(title string) (*IncomingWebhookRequest, *AppError) {
+	if len(p.Alerts) == 0 {
+		return nil, NewAppError("IncomingWebhookRequestFromAdapter", "model.incoming_hook.adapter.no_alerts.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	firing := 0
+	for _, alert := range p.Alerts {
+		if alert.Status == alertStatusFiring {
+			firing++
+		}
+	}
+
+	if title == "" {
+		var names []string
+		for _, key := range sortedKeys(p.GroupLabels) {
+			names = append(names, p.GroupLabels[key])
+		}
+		if len(names) == 0 {
+			names = append(names, p.CommonLabels["alertname"])
+		}
+
+		status := strings.ToUpper(p.Status)
+		if p.Status == alertStatusFiring {
+			status = fmt.Sprintf("%s:%d", status, firing+p.TruncatedAlerts)
+		}
+		title = fmt.Sprintf("[%s] %s", status, strings.Join(names, " "))
+	}
+
+	attachment := &SlackAttachment{
+		Fallback:  title,
+		Title:     title,
+		TitleLink: validHTTPURL(p.ExternalURL),
+		Color:     "good",
+		Footer:    p.Receiver,
+	}
+	if p.Status == alertStatusFiring {
+		attachment.Color = "danger"
+	}
+
+	var lines []string
+	if summary := p.CommonAnnotations["summary"]; summary != "" {
+		lines = append(lines, summary)
+	}
+	for i, alert := range p.Alerts {
+		if i == incomingWebhookAdapterMaxAlerts {
+			break
+		}
+		lines = append(lines, p.alertLine(&alert))
+	}
+	if more := len(p.Alerts) - min(len(p.Alerts), incomingWebhookAdapterMaxAlerts) + p.TruncatedAlerts; more > 0 {
+		lines = append(lines, fmt.Sprintf("and %d more", more))
+	}
+	attachment.Text = strings.Join(lines, "\n")
+
+	for _, key := range sortedKeys(p.CommonLabels) {
+		if key == "alertname" {
+			continue
+		}
+		attachment.Fields = append(attachment.Fields, &SlackAttachmentField{Title: key, Value: p.CommonLabels[key], Short: true})
+	}
+
+	return &IncomingWebhookRequest{
+		Attachments: []*SlackAttachment{attachment},
+		ThreadKey:   p.threadKey(),
+	}, nil
+}
+
+// alertLine renders an alert of the group as a line of the attachment text,
+// showing only the labels the alerts of the group don't have in common.
+func (p *alertmanagerPayload) alertLine(alert *alertmanagerAlert) string {
+	icon := ":white_check_mark:"
+	if alert.Status == alertStatusFiring {
+		icon = ":fire:"
+	}
+
+	name := alert.Annotations["summary"]
+	if name == "" || name == p.CommonAnnotations["summary"] {
+		name = alert.Labels["alertname"]
+	}
+
+	line := icon + " " + markdownLink(name, alert.GeneratorURL)
+	if description := alert.Annotations["description"]; description != "" && description != p.CommonAnnotations["description"] {
+		line += " - " + description
+	}
+
+	var labels []string
+	for _, key := range sortedKeys(alert.Labels) {
+		if _, ok := p.CommonLabels[key]; ok {
+			continue
+		}
+		labels = append(labels, "`"+key+"="+alert.Labels[key]+"`")
+	}
+	if len(labels) > 0 {
+		line += " " + strings.Join(labels, " ")
+	}
+
+	if alert.ValueString != "" {
+		line += " Value: `" + alert.ValueString + "`"
+	}
+
+	var links []string
+	if url := validHTTPURL(alert.DashboardURL); url != "" {
+		links = append(links, markdownLink("Dashboard", url))
+	}
+	if url := validHTTPURL(alert.PanelURL); url != "" {
+		links = append(links, markdownLink("Panel", url))
+	}
+	if url := validHTTPURL(alert.SilenceURL); url != "" && alert.Status == alertStatusFiring {
+		links = append(links, markdownLink("Silence", url))
+	}
+	if len(links) > 0 {
+		line += " (" + strings.Join(links, " | ") + ")"
+	}
+
+	return line
+}
+
+type grafanaEvalMatch struct {
+	Metric string            `json:"metric"`
+	Value  float64           `json:"value"`
+	Tags   map[string]string `json:"tags"`
+}
+
+// grafanaPayload is either a Grafana alerting payload, which extends the
+// Alertmanager one, or a legacy dashboard alert payload.
+type grafanaPayload struct {
+	alertmanagerPayload
+
+	Title   string `json:"title"`
+	Message string `json:"message"`
+	State   string `json:"state"`
+
+	// Legacy dashboard alert fields.
+	RuleId      int64              `json:"ruleId"`
+	RuleName    string             `json:"ruleName"`
+	RuleURL     string             `json:"ruleUrl"`
+	ImageURL    string             `json:"imageUrl"`
+	EvalMatches []grafanaEvalMatch `json:"evalMatches"`
+}
+
+func (p *grafanaPayload) toIncomingWebhookRequest() (*IncomingWebhookRequest, *AppError) {
+	if len(p.Alerts) > 0 {
+		return p.alertmanagerPayload.toIncomingWebhookRequest(p.Title)
+	}
+
+	if p.RuleName == "" && p.Title == "" {
+		return nil, NewAppError("IncomingWebhookRequestFromAdapter", "model.incoming_hook.adapter.no_alerts.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	title := p.Title
+	if title == "" {
+		title = p.RuleName
+	}
+
+	attachment := &SlackAttachment{
+		Fallback:  title,
+		Title:     title,
+		TitleLink: validHTTPURL(p.RuleURL),
+		ImageURL:  validHTTPURL(p.ImageURL),
+		Color:     colorNeutral,
+	}
+	switch p.State {
+	case "alerting":
+		attachment.Color = "danger"
+	case "ok":
+		attachment.Color = "good"
+	case "no_data", "pending":
+		attachment.Color = "warning"
+	}
+
+	var lines []string
+	if p.Message != "" {
+		lines = append(lines, p.Message)
+	}
+	for _, match := range p.EvalMatches {
+		lines = append(lines, fmt.Sprintf("%s: `%v`", match.Metric, match.Value))
+	}
+	attachment.Text = strings.Join(lines, "\n")
+
+	var threadKey string
+	if p.RuleId != 0 {
+		threadKey = fmt.Sprintf("grafana:rule:%d", p.RuleId)
+	}
+
+	return &IncomingWebhookRequest{
+		Attachments: []*SlackAttachment{attachment},
+		ThreadKey:   threadKey,
+	}, nil
+}
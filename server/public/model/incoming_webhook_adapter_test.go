@@ -0,0 +1,249 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncomingWebhookRequestFromAdapterGitLab(t *testing.T) {
+	t.Run("push", func(t *testing.T) {
+		payload := `{
+			"object_kind": "push",
+			"before": "95790bf891e76fee5e1747ab589903a6a1f80f22",
+			"after": "da1560886d4f094c3e6c9ef40349f7d38b5d27d7",
+			"ref": "refs/heads/main",
+			"user_name": "John Smith",
+			"user_avatar": "https://gitlab.example.com/uploads/user/avatar/1/index.jpg",
+			"project": {"id": 15, "name": "Diaspora", "path_with_namespace": "mike/diaspora", "web_url": "https://gitlab.example.com/mike/diaspora"},
+			"commits": [
+				{"id": "b6568db1bc1dcd7f8b4d5a946b0b91f9dacd7327", "message": "Update Catalan translation\n\nDetails", "url": "https://gitlab.example.com/mike/diaspora/-/commit/b6568db1", "author": {"name": "Jordi Mallach"}},
+				{"id": "da1560886d4f094c3e6c9ef40349f7d38b5d27d7", "title": "fixed readme", "message": "fixed readme", "url": "https://gitlab.example.com/mike/diaspora/-/commit/da156088", "author": {"name": "GitLab dev user"}}
+			],
+			"total_commits_count": 4
+		}`
+
+		req, appErr := IncomingWebhookRequestFromAdapter(IncomingWebhookAdapterGitLab, strings.NewReader(payload))
+		require.Nil(t, appErr)
+		require.Len(t, req.Attachments, 1)
+		assert.Empty(t, req.ThreadKey)
+
+		attachment := req.Attachments[0]
+		assert.Equal(t, "[mike/diaspora:main] 4 new commits", attachment.Title)
+		assert.Equal(t, "https://gitlab.example.com/mike/diaspora/-/compare/95790bf891e76fee5e1747ab589903a6a1f80f22...da1560886d4f094c3e6c9ef40349f7d38b5d27d7", attachment.TitleLink)
+		assert.Equal(t, "John Smith", attachment.AuthorName)
+		assert.Equal(t, "https://gitlab.example.com/uploads/user/avatar/1/index.jpg", attachment.AuthorIcon)
+		assert.Equal(t, "[`b6568db1`](https://gitlab.example.com/mike/diaspora/-/commit/b6568db1) Update Catalan translation - Jordi Mallach\n"+
+			"[`da156088`](https://gitlab.example.com/mike/diaspora/-/commit/da156088) fixed readme - GitLab dev user\n"+
+			"and 2 more", attachment.Text)
+		assert.NoError(t, attachment.IsValid())
+	})
+
+	t.Run("deleted branch", func(t *testing.T) {
+		payload := `{"object_kind": "push", "before": "95790bf891e76fee5e1747ab589903a6a1f80f22", "after": "0000000000000000000000000000000000000000", "ref": "refs/heads/feature", "project": {"path_with_namespace": "mike/diaspora"}}`
+
+		req, appErr := IncomingWebhookRequestFromAdapter(IncomingWebhookAdapterGitLab, strings.NewReader(payload))
+		require.Nil(t, appErr)
+		assert.Equal(t, "[mike/diaspora] branch feature deleted", req.Attachments[0].Title)
+		assert.Empty(t, req.Attachments[0].Text)
+	})
+
+	t.Run("pipeline", func(t *testing.T) {
+		payload := `{
+			"object_kind": "pipeline",
+			"object_attributes": {"id": 31, "ref": "main", "tag": false, "sha": "bcbb5ec396a2c0f828686f14fac9b80b780504f2", "status": "failed", "duration": 63.4},
+			"user": {"name": "Administrator", "username": "root", "avatar_url": "https://gitlab.example.com/root.png"},
+			"project": {"id": 1, "path_with_namespace": "gitlab-org/gitlab-test", "web_url": "https://gitlab.example.com/gitlab-org/gitlab-test"},
+			"commit": {"id": "bcbb5ec396a2c0f828686f14fac9b80b780504f2", "title": "test", "url": "https://gitlab.example.com/gitlab-org/gitlab-test/-/commit/bcbb5ec3"},
+			"builds": [
+				{"id": 380, "stage": "deploy", "name": "production", "status": "skipped"},
+				{"id": 377, "stage": "test", "name": "test-image", "status": "failed"},
+				{"id": 378, "stage": "test", "name": "test-build", "status": "success"}
+			]
+		}`
+
+		req, appErr := IncomingWebhookRequestFromAdapter(IncomingWebhookAdapterGitLab, strings.NewReader(payload))
+		require.Nil(t, appErr)
+		assert.Equal(t, "gitlab:pipeline:1:31", req.ThreadKey)
+
+		attachment := req.Attachments[0]
+		assert.Equal(t, "[gitlab-org/gitlab-test] Pipeline #31 failed", attachment.Title)
+		assert.Equal(t, "https://gitlab.example.com/gitlab-org/gitlab-test/-/pipelines/31", attachment.TitleLink)
+		assert.Equal(t, "danger", attachment.Color)
+		assert.Equal(t, "Administrator", attachment.AuthorName)
+		require.Len(t, attachment.Fields, 4)
+		assert.Equal(t, "[main](https://gitlab.example.com/gitlab-org/gitlab-test/-/tree/main)", attachment.Fields[0].Value)
+		assert.Equal(t, "63s", attachment.Fields[2].Value)
+		assert.Equal(t, "[test: test-image](https://gitlab.example.com/gitlab-org/gitlab-test/-/jobs/377)", attachment.Fields[3].Value)
+		assert.NoError(t, attachment.IsValid())
+	})
+
+	t.Run("merge request", func(t *testing.T) {
+		payload := `{
+			"object_kind": "merge_request",
+			"user": {"name": "Administrator"},
+			"project": {"id": 1, "path_with_namespace": "gitlabhq/gitlab-test"},
+			"object_attributes": {"id": 99, "iid": 1, "title": "MS-Viewport", "url": "https://gitlab.example.com/gitlabhq/gitlab-test/-/merge_requests/1", "state": "merged", "action": "merge", "source_branch": "ms-viewport", "target_branch": "master"}
+		}`
+
+		req, appErr := IncomingWebhookRequestFromAdapter(IncomingWebhookAdapterGitLab, strings.NewReader(payload))
+		require.Nil(t, appErr)
+		assert.Equal(t, "gitlab:merge_request:1:1", req.ThreadKey)
+		assert.Equal(t, "[gitlabhq/gitlab-test] Merge request !1 merge: MS-Viewport", req.Attachments[0].Title)
+		assert.Equal(t, "good", req.Attachments[0].Color)
+	})
+
+	t.Run("unsupported event", func(t *testing.T) {
+		_, appErr := IncomingWebhookRequestFromAdapter(IncomingWebhookAdapterGitLab, strings.NewReader(`{"object_kind": "wiki_page"}`))
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.incoming_hook.adapter.unsupported_event.app_error", appErr.Id)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+	})
+}
+
+const alertmanagerTestPayload = `{
+	"version": "4",
+	"groupKey": "{}:{alertname=\"HighLatency\"}",
+	"truncatedAlerts": 0,
+	"status": "%s",
+	"receiver": "mattermost",
+	"groupLabels": {"alertname": "HighLatency"},
+	"commonLabels": {"alertname": "HighLatency", "severity": "critical"},
+	"commonAnnotations": {"summary": "The API latency is high"},
+	"externalURL": "http://alertmanager.example.com:9093",
+	"alerts": [
+		{
+			"status": "%s",
+			"labels": {"alertname": "HighLatency", "severity": "critical", "instance": "api-1"},
+			"annotations": {"summary": "The API latency is high", "description": "p99 above 2s"},
+			"generatorURL": "http://prometheus.example.com/graph?g0.expr=latency",
+			"fingerprint": "c4ef4fd7b7f5d1e2"
+		},
+		{
+			"status": "resolved",
+			"labels": {"alertname": "HighLatency", "severity": "critical", "instance": "api-2"},
+			"annotations": {"summary": "The API latency is high"},
+			"fingerprint": "a1b2c3d4e5f60718"
+		}
+	]
+}`
+
+func TestIncomingWebhookRequestFromAdapterAlertmanager(t *testing.T) {
+	t.Run("firing", func(t *testing.T) {
+		payload := strings.NewReplacer("%s", "firing").Replace(alertmanagerTestPayload)
+
+		req, appErr := IncomingWebhookRequestFromAdapter(IncomingWebhookAdapterAlertmanager, strings.NewReader(payload))
+		require.Nil(t, appErr)
+		assert.Equal(t, `alerts:{}:{alertname="HighLatency"}`, req.ThreadKey)
+		require.Len(t, req.Attachments, 1)
+
+		attachment := req.Attachments[0]
+		assert.Equal(t, "[FIRING:1] HighLatency", attachment.Title)
+		assert.Equal(t, "http://alertmanager.example.com:9093", attachment.TitleLink)
+		assert.Equal(t, "danger", attachment.Color)
+		assert.Equal(t, "mattermost", attachment.Footer)
+		assert.Equal(t, "The API latency is high\n"+
+			":fire: [HighLatency](http://prometheus.example.com/graph?g0.expr=latency) - p99 above 2s `instance=api-1`\n"+
+			":white_check_mark: HighLatency `instance=api-2`", attachment.Text)
+		require.Len(t, attachment.Fields, 1)
+		assert.Equal(t, "severity", attachment.Fields[0].Title)
+		assert.Equal(t, "critical", attachment.Fields[0].Value)
+		assert.NoError(t, attachment.IsValid())
+	})
+
+	t.Run("resolved", func(t *testing.T) {
+		payload := strings.NewReplacer("%s", "resolved").Replace(alertmanagerTestPayload)
+
+		req, appErr := IncomingWebhookRequestFromAdapter(IncomingWebhookAdapterAlertmanager, strings.NewReader(payload))
+		require.Nil(t, appErr)
+		assert.Equal(t, `alerts:{}:{alertname="HighLatency"}`, req.ThreadKey, "the resolution should be collapsed in the thread of the alert group")
+		assert.Equal(t, "[RESOLVED] HighLatency", req.Attachments[0].Title)
+		assert.Equal(t, "good", req.Attachments[0].Color)
+	})
+
+	t.Run("no alerts", func(t *testing.T) {
+		_, appErr := IncomingWebhookRequestFromAdapter(IncomingWebhookAdapterAlertmanager, strings.NewReader(`{"status": "firing", "alerts": []}`))
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.incoming_hook.adapter.no_alerts.app_error", appErr.Id)
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		_, appErr := IncomingWebhookRequestFromAdapter(IncomingWebhookAdapterAlertmanager, strings.NewReader(`{"status":`))
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.incoming_hook.parse_data.app_error", appErr.Id)
+	})
+}
+
+func TestIncomingWebhookRequestFromAdapterGrafana(t *testing.T) {
+	t.Run("alerting", func(t *testing.T) {
+		payload := `{
+			"receiver": "mattermost",
+			"status": "firing",
+			"groupKey": "{}/{}:{alertname=\"CPU\"}",
+			"title": "[FIRING:1] CPU (web)",
+			"state": "alerting",
+			"externalURL": "https://grafana.example.com/",
+			"commonLabels": {"alertname": "CPU"},
+			"alerts": [{
+				"status": "firing",
+				"labels": {"alertname": "CPU", "host": "web"},
+				"annotations": {},
+				"fingerprint": "57c6d9296de2ad39",
+				"dashboardURL": "https://grafana.example.com/d/abc",
+				"panelURL": "https://grafana.example.com/d/abc?viewPanel=1",
+				"silenceURL": "https://grafana.example.com/alerting/silence/new",
+				"valueString": "[ var='B' labels={host=web} value=97 ]"
+			}]
+		}`
+
+		req, appErr := IncomingWebhookRequestFromAdapter(IncomingWebhookAdapterGrafana, strings.NewReader(payload))
+		require.Nil(t, appErr)
+		assert.Equal(t, `alerts:{}/{}:{alertname="CPU"}`, req.ThreadKey)
+
+		attachment := req.Attachments[0]
+		assert.Equal(t, "[FIRING:1] CPU (web)", attachment.Title)
+		assert.Equal(t, "danger", attachment.Color)
+		assert.Equal(t, ":fire: CPU `host=web` Value: `[ var='B' labels={host=web} value=97 ]` "+
+			"([Dashboard](https://grafana.example.com/d/abc) | [Panel](https://grafana.example.com/d/abc?viewPanel=1) | [Silence](https://grafana.example.com/alerting/silence/new))", attachment.Text)
+	})
+
+	t.Run("legacy alert", func(t *testing.T) {
+		payload := `{
+			"title": "[Alerting] Test notification",
+			"ruleId": 42,
+			"ruleName": "Test notification",
+			"ruleUrl": "https://grafana.example.com/d/abc",
+			"state": "alerting",
+			"imageUrl": "https://grafana.com/assets/img/blog/mixed_styles.png",
+			"message": "Someone is testing the alert notification within Grafana.",
+			"evalMatches": [{"metric": "High value", "value": 100}]
+		}`
+
+		req, appErr := IncomingWebhookRequestFromAdapter(IncomingWebhookAdapterGrafana, strings.NewReader(payload))
+		require.Nil(t, appErr)
+		assert.Equal(t, "grafana:rule:42", req.ThreadKey)
+
+		attachment := req.Attachments[0]
+		assert.Equal(t, "[Alerting] Test notification", attachment.Title)
+		assert.Equal(t, "https://grafana.example.com/d/abc", attachment.TitleLink)
+		assert.Equal(t, "https://grafana.com/assets/img/blog/mixed_styles.png", attachment.ImageURL)
+		assert.Equal(t, "danger", attachment.Color)
+		assert.Equal(t, "Someone is testing the alert notification within Grafana.\nHigh value: `100`", attachment.Text)
+	})
+}
+
+func TestIncomingWebhookRequestFromAdapterSlack(t *testing.T) {
+	req, appErr := IncomingWebhookRequestFromAdapter(IncomingWebhookAdapterSlack, strings.NewReader(`{"text": "hello"}`))
+	require.Nil(t, appErr)
+	assert.Equal(t, "hello", req.Text)
+
+	_, appErr = IncomingWebhookRequestFromAdapter("unknown", strings.NewReader(`{"text": "hello"}`))
+	require.NotNil(t, appErr)
+	assert.Equal(t, "model.incoming_hook.adapter.app_error", appErr.Id)
+}
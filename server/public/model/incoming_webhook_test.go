@@ -65,6 +65,12 @@ func TestIncomingWebhookIsValid(t *testing.T) {
 
 	o.IconURL = strings.Repeat("1", 1024)
 	require.Nil(t, o.IsValid())
+
+	o.Adapter = "jira"
+	require.NotNil(t, o.IsValid())
+
+	o.Adapter = IncomingWebhookAdapterGrafana
+	require.Nil(t, o.IsValid())
 }
 
 func TestIncomingWebhookPreSave(t *testing.T) {
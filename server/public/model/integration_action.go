@@ -51,6 +51,11 @@ const (
 	PostActionDataSourceChannels = "channels"
 )
 
+// PostActionBuiltInURLPrefix prefixes the integration URL of the post
+// actions handled by the server itself, such as the ones of reminders. It is
+// followed by the name of the built-in action.
+const PostActionBuiltInURLPrefix = "mattermost://actions/"
+
 type PostAction struct {
 	// A unique Action ID. If not set, generated automatically.
 	Id string `json:"id,omitempty"`
@@ -91,6 +96,12 @@ type PostAction struct {
 	Cookie      string                 `json:"cookie,omitempty" db:"-"`
 }
 
+// IsBuiltIn returns whether the action is handled by the server itself. Only
+// the posts of the system bot can have such actions.
+func (p *PostAction) IsBuiltIn() bool {
+	return p.Integration != nil && strings.HasPrefix(p.Integration.URL, PostActionBuiltInURLPrefix)
+}
+
 // IsValid validates the action and returns an error if it is invalid.
 func (p *PostAction) IsValid() error {
 	var multiErr *multierror.Error
@@ -150,7 +161,7 @@ func (p *PostAction) IsValid() error {
 		if p.Integration.URL == "" {
 			multiErr = multierror.Append(multiErr, fmt.Errorf("action must have an integration URL"))
 		}
-		if !(strings.HasPrefix(p.Integration.URL, "/plugins/") || strings.HasPrefix(p.Integration.URL, "plugins/") || IsValidHTTPURL(p.Integration.URL)) {
+		if !(strings.HasPrefix(p.Integration.URL, "/plugins/") || strings.HasPrefix(p.Integration.URL, "plugins/") || strings.HasPrefix(p.Integration.URL, PostActionBuiltInURLPrefix) || IsValidHTTPURL(p.Integration.URL)) {
 			multiErr = multierror.Append(multiErr, fmt.Errorf("action must have an valid integration URL"))
 		}
 	}
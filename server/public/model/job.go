@@ -44,6 +44,10 @@ const (
 	JobTypeDeleteDmsPreferencesMigration = "delete_dms_preferences_migration"
 	JobTypeMobileSessionMetadata         = "mobile_session_metadata"
 	JobTypeAccessControlSync             = "access_control_sync"
+	JobTypeReminders                     = "reminders"
+	JobTypePolls                         = "polls"
+	JobTypeEmailDigest                   = "email_digest"
+	JobTypeAuditLogRetention             = "audit_log_retention"
 
 	JobStatusPending         = "pending"
 	JobStatusInProgress      = "in_progress"
@@ -52,6 +56,11 @@ const (
 	JobStatusCancelRequested = "cancel_requested"
 	JobStatusCanceled        = "canceled"
 	JobStatusWarning         = "warning"
+
+	// ImportProcessJobStaleTimeoutMilliseconds is how long an import job in progress
+	// goes without activity before it's deemed orphaned by a server which stopped,
+	// and can be resumed.
+	ImportProcessJobStaleTimeoutMilliseconds = 10 * 60 * 1000
 )
 
 var AllJobTypes = [...]string{
@@ -76,6 +85,10 @@ var AllJobTypes = [...]string{
 	JobTypeCleanupDesktopTokens,
 	JobTypeRefreshMaterializedViews,
 	JobTypeMobileSessionMetadata,
+	JobTypeReminders,
+	JobTypePolls,
+	JobTypeEmailDigest,
+	JobTypeAuditLogRetention,
 }
 
 type Job struct {
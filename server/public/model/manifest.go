@@ -9,7 +9,9 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/blang/semver/v4"
 	"github.com/pkg/errors"
@@ -236,6 +238,131 @@ type ManifestServer struct {
 	// If your plugin is compiled for multiple platforms, consider bundling them together
 	// and using the Executables field instead.
 	Executable string `json:"executable" yaml:"executable"`
+
+	// Protocol is the protocol spoken by your executable, either "rpc", "grpc" or "wasm". It
+	// defaults to "rpc", the net/rpc protocol of plugins built with the Go plugin package.
+	// Plugins written in other languages use "grpc" and implement the services described in
+	// server/public/plugin/grpcproto/mattermost_plugin.proto. Plugins compiled to WebAssembly
+	// for WASI use "wasm" and run inside the server, restricted by the Sandbox.
+	Protocol string `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+
+	// Sandbox declares what a "wasm" plugin is allowed to do.
+	Sandbox *ManifestSandbox `json:"sandbox,omitempty" yaml:"sandbox,omitempty"`
+}
+
+const (
+	PluginProtocolRPC  = "rpc"
+	PluginProtocolGRPC = "grpc"
+	PluginProtocolWASM = "wasm"
+)
+
+// Capabilities grant WebAssembly plugins access to groups of plugin API methods. Logging and
+// reading the plugin configuration are always allowed.
+const (
+	PluginCapabilityKV           = "kv"
+	PluginCapabilityUsersRead    = "users:read"
+	PluginCapabilityTeamsRead    = "teams:read"
+	PluginCapabilityChannelsRead = "channels:read"
+	PluginCapabilityPostsRead    = "posts:read"
+	PluginCapabilityPostsWrite   = "posts:write"
+	PluginCapabilityCommands     = "commands"
+	PluginCapabilityWebSocket    = "websocket"
+	PluginCapabilityConfig       = "config"
+)
+
+var pluginCapabilities = []string{
+	PluginCapabilityKV,
+	PluginCapabilityUsersRead,
+	PluginCapabilityTeamsRead,
+	PluginCapabilityChannelsRead,
+	PluginCapabilityPostsRead,
+	PluginCapabilityPostsWrite,
+	PluginCapabilityCommands,
+	PluginCapabilityWebSocket,
+	PluginCapabilityConfig,
+}
+
+const (
+	PluginSandboxDefaultMemoryLimitMB = 64
+	PluginSandboxDefaultTimeLimitMs   = 5000
+)
+
+// ManifestSandbox restricts a WebAssembly plugin. Nothing is granted unless declared.
+type ManifestSandbox struct {
+	// Capabilities lists the groups of plugin API methods the plugin may call, such as "kv"
+	// or "posts:write".
+	Capabilities []string `json:"capabilities,omitempty" yaml:"capabilities,omitempty"`
+
+	// Channels restricts the "posts:write" capability to the channels with these ids.
+	Channels []string `json:"channels,omitempty" yaml:"channels,omitempty"`
+
+	// MemoryLimitMB is the maximum memory of an instance of the plugin. Defaults to 64MB.
+	MemoryLimitMB int `json:"memory_limit_mb,omitempty" yaml:"memory_limit_mb,omitempty"`
+
+	// TimeLimitMs is the maximum time a hook may run before its instance of the plugin is
+	// terminated. Defaults to 5 seconds.
+	TimeLimitMs int `json:"time_limit_ms,omitempty" yaml:"time_limit_ms,omitempty"`
+
+	// Filesystem grants read and write access to the data directory of the plugin bundle,
+	// mounted at /data.
+	Filesystem bool `json:"filesystem,omitempty" yaml:"filesystem,omitempty"`
+
+	// NetworkHosts grants outgoing HTTP requests to these hosts.
+	NetworkHosts []string `json:"network_hosts,omitempty" yaml:"network_hosts,omitempty"`
+}
+
+// HasCapability reports whether the capability is granted.
+func (s *ManifestSandbox) HasCapability(capability string) bool {
+	return s != nil && slices.Contains(s.Capabilities, capability)
+}
+
+// GetMemoryLimitMB returns the memory limit of an instance of the plugin, in megabytes.
+func (s *ManifestSandbox) GetMemoryLimitMB() int {
+	if s == nil || s.MemoryLimitMB == 0 {
+		return PluginSandboxDefaultMemoryLimitMB
+	}
+	return s.MemoryLimitMB
+}
+
+// GetTimeLimit returns the maximum time a hook may run.
+func (s *ManifestSandbox) GetTimeLimit() time.Duration {
+	if s == nil || s.TimeLimitMs == 0 {
+		return PluginSandboxDefaultTimeLimitMs * time.Millisecond
+	}
+	return time.Duration(s.TimeLimitMs) * time.Millisecond
+}
+
+func (s *ManifestSandbox) isValid() error {
+	for _, capability := range s.Capabilities {
+		if !slices.Contains(pluginCapabilities, capability) {
+			return errors.Errorf("unknown capability %q", capability)
+		}
+	}
+	for _, channelID := range s.Channels {
+		if !IsValidId(channelID) {
+			return errors.Errorf("invalid channel id %q", channelID)
+		}
+	}
+	if s.MemoryLimitMB < 0 {
+		return errors.New("memory limit must not be negative")
+	}
+	if s.TimeLimitMs < 0 {
+		return errors.New("time limit must not be negative")
+	}
+	for _, host := range s.NetworkHosts {
+		if strings.TrimSpace(host) == "" || strings.ContainsAny(host, "/:") {
+			return errors.Errorf("invalid network host %q", host)
+		}
+	}
+	return nil
+}
+
+// GetProtocol returns the protocol spoken by the server executable, defaulting to PluginProtocolRPC.
+func (s *ManifestServer) GetProtocol() string {
+	if s == nil || s.Protocol == "" {
+		return PluginProtocolRPC
+	}
+	return s.Protocol
 }
 
 type ManifestWebapp struct {
@@ -345,6 +472,22 @@ func (m *Manifest) IsValid() error {
 		}
 	}
 
+	if m.Server != nil {
+		protocol := m.Server.GetProtocol()
+		if protocol != PluginProtocolRPC && protocol != PluginProtocolGRPC && protocol != PluginProtocolWASM {
+			return errors.Errorf("invalid server protocol %q", protocol)
+		}
+
+		if m.Server.Sandbox != nil {
+			if protocol != PluginProtocolWASM {
+				return errors.New("a sandbox is only supported by wasm plugins")
+			}
+			if err := m.Server.Sandbox.isValid(); err != nil {
+				return errors.Wrap(err, "invalid sandbox")
+			}
+		}
+	}
+
 	if m.SettingsSchema != nil {
 		err := m.SettingsSchema.isValid()
 		if err != nil {
@@ -31,7 +31,19 @@ func TestIsValid(t *testing.T) {
 		{"SettingSchema error", &Manifest{Id: "com.company.test", Name: "some name", HomepageURL: "http://someurl.com", SupportURL: "http://someotherurl.com", Version: "5.10.0", MinServerVersion: "5.10.8", SettingsSchema: &PluginSettingsSchema{
 			Settings: []*PluginSetting{{Type: "Invalid"}},
 		}}, true},
+		{"Invalid server protocol", &Manifest{Id: "com.company.test", Name: "some name", Server: &ManifestServer{Executable: "plugin.py", Protocol: "http"}}, true},
 		{"Minimal valid manifest", &Manifest{Id: "com.company.test", Name: "some name"}, false},
+		{"gRPC server protocol", &Manifest{Id: "com.company.test", Name: "some name", Server: &ManifestServer{Executable: "plugin.py", Protocol: PluginProtocolGRPC}}, false},
+		{"Sandbox without wasm protocol", &Manifest{Id: "com.company.test", Name: "some name", Server: &ManifestServer{Executable: "backend.exe", Sandbox: &ManifestSandbox{}}}, true},
+		{"Sandbox with unknown capability", &Manifest{Id: "com.company.test", Name: "some name", Server: &ManifestServer{Executable: "plugin.wasm", Protocol: PluginProtocolWASM, Sandbox: &ManifestSandbox{Capabilities: []string{"root"}}}}, true},
+		{"Sandbox with invalid channel", &Manifest{Id: "com.company.test", Name: "some name", Server: &ManifestServer{Executable: "plugin.wasm", Protocol: PluginProtocolWASM, Sandbox: &ManifestSandbox{Capabilities: []string{PluginCapabilityPostsWrite}, Channels: []string{"town-square"}}}}, true},
+		{"Sandbox with negative memory limit", &Manifest{Id: "com.company.test", Name: "some name", Server: &ManifestServer{Executable: "plugin.wasm", Protocol: PluginProtocolWASM, Sandbox: &ManifestSandbox{MemoryLimitMB: -1}}}, true},
+		{"Sandbox with invalid network host", &Manifest{Id: "com.company.test", Name: "some name", Server: &ManifestServer{Executable: "plugin.wasm", Protocol: PluginProtocolWASM, Sandbox: &ManifestSandbox{NetworkHosts: []string{"https://example.com"}}}}, true},
+		{"wasm server protocol", &Manifest{Id: "com.company.test", Name: "some name", Server: &ManifestServer{Executable: "plugin.wasm", Protocol: PluginProtocolWASM, Sandbox: &ManifestSandbox{
+			Capabilities: []string{PluginCapabilityKV, PluginCapabilityPostsWrite},
+			Channels:     []string{NewId()},
+			NetworkHosts: []string{"api.example.com"},
+		}}}, false},
 		{"Happy case", &Manifest{
 			Id:               "com.company.test",
 			Name:             "thename",
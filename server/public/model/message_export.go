@@ -52,3 +52,20 @@ func (m *MessageExport) PreviewID() string {
 	}
 	return previewID
 }
+
+// ExportedMessage returns the message of the post or, for a poll, its
+// question and results as kept in the post's poll_results prop.
+func (m *MessageExport) ExportedMessage() string {
+	message := SafeDereference(m.PostMessage)
+	if SafeDereference(m.PostType) != PostTypePoll || m.PostProps == nil {
+		return message
+	}
+
+	props := map[string]any{}
+	if json.Unmarshal([]byte(*m.PostProps), &props) == nil {
+		if results, ok := props[PostPropsPollResults].(string); ok && results != "" {
+			return results
+		}
+	}
+	return message
+}
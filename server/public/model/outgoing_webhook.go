@@ -29,6 +29,7 @@ type OutgoingWebhook struct {
 	ContentType  string      `json:"content_type"`
 	Username     string      `json:"username"`
 	IconURL      string      `json:"icon_url"`
+	Secret       string      `json:"secret"`
 }
 
 func (o *OutgoingWebhook) Auditable() map[string]any {
@@ -170,6 +171,10 @@ func (o *OutgoingWebhook) IsValid() *AppError {
 		return NewAppError("OutgoingWebhook.IsValid", "model.outgoing_hook.icon_url.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if len(o.Secret) > 64 {
+		return NewAppError("OutgoingWebhook.IsValid", "model.outgoing_hook.is_valid.secret.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -182,6 +187,10 @@ func (o *OutgoingWebhook) PreSave() {
 		o.Token = NewId()
 	}
 
+	if o.Secret == "" {
+		o.Secret = NewRandomString(OutgoingWebhookSecretLength)
+	}
+
 	o.CreateAt = GetMillis()
 	o.UpdateAt = o.CreateAt
 }
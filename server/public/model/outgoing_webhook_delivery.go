@@ -0,0 +1,135 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"unicode/utf8"
+)
+
+const (
+	OutgoingWebhookDeliveryStatusPending   = "pending"
+	OutgoingWebhookDeliveryStatusDelivered = "delivered"
+	OutgoingWebhookDeliveryStatusFailed    = "failed"
+
+	OutgoingWebhookSignatureHeader = "X-Mattermost-Signature"
+	OutgoingWebhookDeliveryHeader  = "X-Mattermost-Delivery"
+
+	OutgoingWebhookSecretLength = 32
+
+	outgoingWebhookDeliveryErrorMaxRunes = 1024
+)
+
+// OutgoingWebhookDelivery is a request sent, or still to be sent, to one of
+// the callback URLs of an outgoing webhook.
+type OutgoingWebhookDelivery struct {
+	Id            string `json:"id"`
+	HookId        string `json:"hook_id"`
+	PostId        string `json:"post_id"`
+	ChannelId     string `json:"channel_id"`
+	URL           string `json:"url"`
+	ContentType   string `json:"content_type"`
+	Payload       string `json:"payload"`
+	Status        string `json:"status"`
+	Attempts      int    `json:"attempts"`
+	CreateAt      int64  `json:"create_at"`
+	UpdateAt      int64  `json:"update_at"`
+	LastAttemptAt int64  `json:"last_attempt_at"`
+	NextAttemptAt int64  `json:"next_attempt_at"`
+	ResponseCode  int    `json:"response_code"`
+	Error         string `json:"error"`
+	// DeliveredAt is the time of the first successful attempt, whose response
+	// is the only one posted to the channel.
+	DeliveredAt int64 `json:"delivered_at"`
+}
+
+func (o *OutgoingWebhookDelivery) Auditable() map[string]any {
+	return map[string]any{
+		"id":              o.Id,
+		"hook_id":         o.HookId,
+		"post_id":         o.PostId,
+		"channel_id":      o.ChannelId,
+		"url":             o.URL,
+		"status":          o.Status,
+		"attempts":        o.Attempts,
+		"create_at":       o.CreateAt,
+		"last_attempt_at": o.LastAttemptAt,
+		"response_code":   o.ResponseCode,
+		"delivered_at":    o.DeliveredAt,
+	}
+}
+
+func (o *OutgoingWebhookDelivery) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	if o.Status == "" {
+		o.Status = OutgoingWebhookDeliveryStatusPending
+	}
+
+	o.CreateAt = GetMillis()
+	o.UpdateAt = o.CreateAt
+}
+
+func (o *OutgoingWebhookDelivery) PreUpdate() {
+	o.UpdateAt = GetMillis()
+
+	if utf8.RuneCountInString(o.Error) > outgoingWebhookDeliveryErrorMaxRunes {
+		o.Error = string([]rune(o.Error)[:outgoingWebhookDeliveryErrorMaxRunes])
+	}
+}
+
+func (o *OutgoingWebhookDelivery) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("OutgoingWebhookDelivery.IsValid", "model.outgoing_hook_delivery.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.HookId) {
+		return NewAppError("OutgoingWebhookDelivery.IsValid", "model.outgoing_hook_delivery.is_valid.hook_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.PostId) {
+		return NewAppError("OutgoingWebhookDelivery.IsValid", "model.outgoing_hook_delivery.is_valid.post_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.ChannelId) {
+		return NewAppError("OutgoingWebhookDelivery.IsValid", "model.outgoing_hook_delivery.is_valid.channel_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidHTTPURL(o.URL) {
+		return NewAppError("OutgoingWebhookDelivery.IsValid", "model.outgoing_hook_delivery.is_valid.url.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	switch o.Status {
+	case OutgoingWebhookDeliveryStatusPending, OutgoingWebhookDeliveryStatusDelivered, OutgoingWebhookDeliveryStatusFailed:
+	default:
+		return NewAppError("OutgoingWebhookDelivery.IsValid", "model.outgoing_hook_delivery.is_valid.status.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("OutgoingWebhookDelivery.IsValid", "model.outgoing_hook_delivery.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// SignOutgoingWebhookPayload returns the value of the signature header of a
+// webhook request sent at the given time, in seconds. The signature is the
+// hex encoded HMAC-SHA256 of the timestamp and the body joined by a dot, so
+// receivers can reject replayed requests.
+func SignOutgoingWebhookPayload(secret string, timestamp int64, body []byte) string {
+	ts := strconv.FormatInt(timestamp, 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
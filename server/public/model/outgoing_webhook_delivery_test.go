@@ -0,0 +1,67 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutgoingWebhookDeliveryIsValid(t *testing.T) {
+	o := OutgoingWebhookDelivery{}
+	assert.NotNil(t, o.IsValid(), "empty declaration should be invalid")
+
+	o.Id = NewId()
+	assert.NotNil(t, o.IsValid(), "missing hook id should be invalid")
+
+	o.HookId = NewId()
+	assert.NotNil(t, o.IsValid(), "missing post id should be invalid")
+
+	o.PostId = NewId()
+	assert.NotNil(t, o.IsValid(), "missing channel id should be invalid")
+
+	o.ChannelId = NewId()
+	assert.NotNil(t, o.IsValid(), "missing url should be invalid")
+
+	o.URL = "ftp://example.com"
+	assert.NotNil(t, o.IsValid(), "non http url should be invalid")
+
+	o.URL = "https://example.com/hook"
+	assert.NotNil(t, o.IsValid(), "missing status should be invalid")
+
+	o.Status = "unknown"
+	assert.NotNil(t, o.IsValid(), "unknown status should be invalid")
+
+	o.Status = OutgoingWebhookDeliveryStatusFailed
+	assert.NotNil(t, o.IsValid(), "missing create at should be invalid")
+
+	o.CreateAt = GetMillis()
+	assert.Nil(t, o.IsValid())
+}
+
+func TestOutgoingWebhookDeliveryPreSave(t *testing.T) {
+	o := OutgoingWebhookDelivery{}
+	o.PreSave()
+	assert.True(t, IsValidId(o.Id))
+	assert.Equal(t, OutgoingWebhookDeliveryStatusPending, o.Status)
+	assert.NotZero(t, o.CreateAt)
+	assert.Equal(t, o.CreateAt, o.UpdateAt)
+}
+
+func TestOutgoingWebhookDeliveryPreUpdate(t *testing.T) {
+	o := OutgoingWebhookDelivery{Error: strings.Repeat("é", 2000)}
+	o.PreUpdate()
+	assert.NotZero(t, o.UpdateAt)
+	assert.Equal(t, strings.Repeat("é", 1024), o.Error)
+}
+
+func TestSignOutgoingWebhookPayload(t *testing.T) {
+	signature := SignOutgoingWebhookPayload("secret", 1700000000, []byte(`{"text":"hello"}`))
+	assert.Equal(t, "t=1700000000,v1=1898b1f7ee8ff2fe446237422bd9b3afcdb1fff758351d6ee4236bc6f1530852", signature)
+
+	assert.NotEqual(t, signature, SignOutgoingWebhookPayload("other", 1700000000, []byte(`{"text":"hello"}`)))
+	assert.NotEqual(t, signature, SignOutgoingWebhookPayload("secret", 1700000001, []byte(`{"text":"hello"}`)))
+}
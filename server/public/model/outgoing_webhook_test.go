@@ -83,6 +83,12 @@ func TestOutgoingWebhookIsValid(t *testing.T) {
 
 	o.IconURL = strings.Repeat("1", 1024)
 	assert.Nilf(t, o.IsValid(), "IconURL length %d should be valid", len(o.IconURL))
+
+	o.Secret = strings.Repeat("1", 65)
+	assert.NotNilf(t, o.IsValid(), "Secret length %d should be invalid, max length 64", len(o.Secret))
+
+	o.Secret = strings.Repeat("1", 64)
+	assert.Nilf(t, o.IsValid(), "Secret length %d should be valid", len(o.Secret))
 }
 
 func TestOutgoingWebhookPayloadToFormValues(t *testing.T) {
@@ -121,6 +127,11 @@ func TestOutgoingWebhookPayloadToFormValues(t *testing.T) {
 func TestOutgoingWebhookPreSave(t *testing.T) {
 	o := OutgoingWebhook{}
 	o.PreSave()
+	assert.Len(t, o.Secret, OutgoingWebhookSecretLength)
+
+	o = OutgoingWebhook{Secret: "secret"}
+	o.PreSave()
+	assert.Equal(t, "secret", o.Secret)
 }
 
 func TestOutgoingWebhookPreUpdate(t *testing.T) {
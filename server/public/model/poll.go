@@ -0,0 +1,269 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	PollQuestionMaxRunes = 500
+	PollOptionMaxRunes   = 200
+	PollOptionsMin       = 2
+	PollOptionsMax       = 20
+
+	// PollPostActionName is the name of the built-in post action the votes
+	// and the closing of the polls are handled by.
+	PollPostActionName = "poll"
+
+	PollPostActionVote  = "vote"
+	PollPostActionClose = "close"
+
+	PostPropsPollId = "poll_id"
+	// PostPropsPollResults holds the results of a poll as plain text, for
+	// the exports that only see the post.
+	PostPropsPollResults = "poll_results"
+)
+
+type PollOption struct {
+	Id   string `json:"id"`
+	Text string `json:"text"`
+}
+
+type PollOptions []*PollOption
+
+// Value converts PollOptions to database value
+func (o PollOptions) Value() (driver.Value, error) {
+	j, err := json.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return string(j), nil
+}
+
+// Scan converts database column value to PollOptions
+func (o *PollOptions) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	buf, ok := value.([]byte)
+	if ok {
+		return json.Unmarshal(buf, o)
+	}
+
+	str, ok := value.(string)
+	if ok {
+		return json.Unmarshal([]byte(str), o)
+	}
+
+	return errors.New("received value is neither a byte slice nor string")
+}
+
+// Poll is a question the members of a channel vote on by clicking the buttons
+// of the post it is shown in.
+type Poll struct {
+	Id        string      `json:"id"`
+	CreateAt  int64       `json:"create_at"`
+	UpdateAt  int64       `json:"update_at"`
+	DeleteAt  int64       `json:"delete_at"`
+	CreatorId string      `json:"creator_id"`
+	ChannelId string      `json:"channel_id"`
+	PostId    string      `json:"post_id"`
+	Question  string      `json:"question"`
+	Options   PollOptions `json:"options"`
+
+	// Anonymous polls only show how many users voted for each option, not
+	// who did.
+	Anonymous      bool `json:"anonymous"`
+	MultipleChoice bool `json:"multiple_choice"`
+
+	// CloseAt is when the poll closes on its own, or 0 if it stays open
+	// until its creator closes it.
+	CloseAt  int64 `json:"close_at"`
+	ClosedAt int64 `json:"closed_at"`
+}
+
+// PollVote is the vote of a user for an option of a poll. Users can have a
+// single vote per poll, unless it is multiple choice.
+type PollVote struct {
+	PollId   string `json:"poll_id"`
+	OptionId string `json:"option_id"`
+	UserId   string `json:"user_id"`
+	CreateAt int64  `json:"create_at"`
+}
+
+// PollResults are the number of votes for each option of a poll and, unless
+// it is anonymous, the users who voted for them.
+type PollResults struct {
+	PollId      string              `json:"poll_id"`
+	Counts      map[string]int      `json:"counts"`
+	Voters      map[string][]string `json:"voters,omitempty"`
+	TotalVoters int                 `json:"total_voters"`
+}
+
+func (p *Poll) Auditable() map[string]any {
+	return map[string]any{
+		"id":              p.Id,
+		"create_at":       p.CreateAt,
+		"creator_id":      p.CreatorId,
+		"channel_id":      p.ChannelId,
+		"post_id":         p.PostId,
+		"anonymous":       p.Anonymous,
+		"multiple_choice": p.MultipleChoice,
+		"close_at":        p.CloseAt,
+		"closed_at":       p.ClosedAt,
+	}
+}
+
+func (p *Poll) IsValid() *AppError {
+	if !IsValidId(p.Id) {
+		return NewAppError("Poll.IsValid", "model.poll.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if p.CreateAt == 0 {
+		return NewAppError("Poll.IsValid", "model.poll.is_valid.create_at.app_error", nil, "id="+p.Id, http.StatusBadRequest)
+	}
+
+	if p.UpdateAt == 0 {
+		return NewAppError("Poll.IsValid", "model.poll.is_valid.update_at.app_error", nil, "id="+p.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(p.CreatorId) {
+		return NewAppError("Poll.IsValid", "model.poll.is_valid.creator_id.app_error", nil, "id="+p.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(p.ChannelId) {
+		return NewAppError("Poll.IsValid", "model.poll.is_valid.channel_id.app_error", nil, "id="+p.Id, http.StatusBadRequest)
+	}
+
+	if p.PostId != "" && !IsValidId(p.PostId) {
+		return NewAppError("Poll.IsValid", "model.poll.is_valid.post_id.app_error", nil, "id="+p.Id, http.StatusBadRequest)
+	}
+
+	if strings.TrimSpace(p.Question) == "" || utf8.RuneCountInString(p.Question) > PollQuestionMaxRunes {
+		return NewAppError("Poll.IsValid", "model.poll.is_valid.question.app_error", map[string]any{"Max": PollQuestionMaxRunes}, "id="+p.Id, http.StatusBadRequest)
+	}
+
+	if len(p.Options) < PollOptionsMin || len(p.Options) > PollOptionsMax {
+		return NewAppError("Poll.IsValid", "model.poll.is_valid.options.app_error", map[string]any{"Min": PollOptionsMin, "Max": PollOptionsMax}, "id="+p.Id, http.StatusBadRequest)
+	}
+
+	ids := make(map[string]bool, len(p.Options))
+	for _, option := range p.Options {
+		if option == nil || !IsValidId(option.Id) || ids[option.Id] {
+			return NewAppError("Poll.IsValid", "model.poll.is_valid.option_id.app_error", nil, "id="+p.Id, http.StatusBadRequest)
+		}
+		ids[option.Id] = true
+
+		if strings.TrimSpace(option.Text) == "" || utf8.RuneCountInString(option.Text) > PollOptionMaxRunes {
+			return NewAppError("Poll.IsValid", "model.poll.is_valid.option_text.app_error", map[string]any{"Max": PollOptionMaxRunes}, "id="+p.Id, http.StatusBadRequest)
+		}
+	}
+
+	if p.CloseAt < 0 || p.ClosedAt < 0 {
+		return NewAppError("Poll.IsValid", "model.poll.is_valid.close_at.app_error", nil, "id="+p.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (p *Poll) PreSave() {
+	if p.Id == "" {
+		p.Id = NewId()
+	}
+
+	for _, option := range p.Options {
+		if option != nil && option.Id == "" {
+			option.Id = NewId()
+		}
+	}
+
+	if p.CreateAt == 0 {
+		p.CreateAt = GetMillis()
+	}
+	p.UpdateAt = p.CreateAt
+}
+
+func (p *Poll) PreUpdate() {
+	p.UpdateAt = GetMillis()
+}
+
+// IsClosed returns whether the poll no longer accepts votes at the given
+// time.
+func (p *Poll) IsClosed(now int64) bool {
+	return p.ClosedAt != 0 || (p.CloseAt != 0 && p.CloseAt <= now)
+}
+
+// Option returns the option of the poll with the given id, or nil.
+func (p *Poll) Option(id string) *PollOption {
+	for _, option := range p.Options {
+		if option.Id == id {
+			return option
+		}
+	}
+	return nil
+}
+
+// Results counts the given votes for the poll. Who voted is left out of the
+// results of anonymous polls.
+func (p *Poll) Results(votes []*PollVote) *PollResults {
+	results := &PollResults{
+		PollId: p.Id,
+		Counts: make(map[string]int, len(p.Options)),
+	}
+	if !p.Anonymous {
+		results.Voters = make(map[string][]string, len(p.Options))
+	}
+
+	for _, option := range p.Options {
+		results.Counts[option.Id] = 0
+	}
+
+	voters := map[string]bool{}
+	for _, vote := range votes {
+		if _, ok := results.Counts[vote.OptionId]; !ok {
+			continue
+		}
+		results.Counts[vote.OptionId]++
+		if results.Voters != nil {
+			results.Voters[vote.OptionId] = append(results.Voters[vote.OptionId], vote.UserId)
+		}
+		voters[vote.UserId] = true
+	}
+	results.TotalVoters = len(voters)
+
+	return results
+}
+
+// ResultsText renders the results of the poll as plain text, as kept in the
+// props of its post for compliance exports. The voters are shown by the
+// usernames they map to, if any.
+func (p *Poll) ResultsText(results *PollResults, usernames map[string]string) string {
+	var sb strings.Builder
+	sb.WriteString(p.Question)
+	for _, option := range p.Options {
+		sb.WriteString(fmt.Sprintf("\n- %s: %d", option.Text, results.Counts[option.Id]))
+
+		voters := make([]string, 0, len(results.Voters[option.Id]))
+		for _, userID := range results.Voters[option.Id] {
+			if username, ok := usernames[userID]; ok {
+				voters = append(voters, "@"+username)
+			} else {
+				voters = append(voters, userID)
+			}
+		}
+		if len(voters) > 0 {
+			sb.WriteString(" (" + strings.Join(voters, ", ") + ")")
+		}
+	}
+	return sb.String()
+}
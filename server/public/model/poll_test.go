@@ -0,0 +1,99 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newValidPoll() *Poll {
+	poll := &Poll{
+		CreatorId: NewId(),
+		ChannelId: NewId(),
+		Question:  "Where do we go for lunch?",
+		Options:   PollOptions{{Text: "Pizza"}, {Text: "Sushi"}},
+	}
+	poll.PreSave()
+	return poll
+}
+
+func TestPollIsValid(t *testing.T) {
+	require.Nil(t, newValidPoll().IsValid())
+
+	for name, tc := range map[string]struct {
+		update      func(*Poll)
+		expectedErr string
+	}{
+		"no question":          {func(p *Poll) { p.Question = " " }, "model.poll.is_valid.question.app_error"},
+		"question too long":    {func(p *Poll) { p.Question = strings.Repeat("a", PollQuestionMaxRunes+1) }, "model.poll.is_valid.question.app_error"},
+		"single option":        {func(p *Poll) { p.Options = p.Options[:1] }, "model.poll.is_valid.options.app_error"},
+		"duplicated option id": {func(p *Poll) { p.Options[1].Id = p.Options[0].Id }, "model.poll.is_valid.option_id.app_error"},
+		"empty option":         {func(p *Poll) { p.Options[1].Text = "" }, "model.poll.is_valid.option_text.app_error"},
+		"invalid post id":      {func(p *Poll) { p.PostId = "post" }, "model.poll.is_valid.post_id.app_error"},
+		"invalid channel id":   {func(p *Poll) { p.ChannelId = "" }, "model.poll.is_valid.channel_id.app_error"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			poll := newValidPoll()
+			tc.update(poll)
+			appErr := poll.IsValid()
+			require.NotNil(t, appErr)
+			assert.Equal(t, tc.expectedErr, appErr.Id)
+		})
+	}
+}
+
+func TestPollIsClosed(t *testing.T) {
+	poll := newValidPoll()
+	assert.False(t, poll.IsClosed(GetMillis()))
+
+	poll.CloseAt = 1000
+	assert.False(t, poll.IsClosed(999))
+	assert.True(t, poll.IsClosed(1000))
+
+	poll.CloseAt = 0
+	poll.ClosedAt = 500
+	assert.True(t, poll.IsClosed(0))
+}
+
+func TestPollResults(t *testing.T) {
+	poll := newValidPoll()
+	pizza, sushi := poll.Options[0].Id, poll.Options[1].Id
+	userID1, userID2 := NewId(), NewId()
+
+	votes := []*PollVote{
+		{PollId: poll.Id, OptionId: pizza, UserId: userID1},
+		{PollId: poll.Id, OptionId: sushi, UserId: userID1},
+		{PollId: poll.Id, OptionId: pizza, UserId: userID2},
+		{PollId: poll.Id, OptionId: NewId(), UserId: userID2},
+	}
+
+	results := poll.Results(votes)
+	assert.Equal(t, map[string]int{pizza: 2, sushi: 1}, results.Counts)
+	assert.Equal(t, []string{userID1, userID2}, results.Voters[pizza])
+	assert.Equal(t, 2, results.TotalVoters)
+	assert.Equal(t, "Where do we go for lunch?\n- Pizza: 2 (@alice, "+userID2+")\n- Sushi: 1 (@alice)", poll.ResultsText(results, map[string]string{userID1: "alice"}))
+
+	poll.Anonymous = true
+	results = poll.Results(votes)
+	assert.Nil(t, results.Voters)
+	assert.Equal(t, "Where do we go for lunch?\n- Pizza: 2\n- Sushi: 1", poll.ResultsText(results, map[string]string{userID1: "alice"}))
+}
+
+func TestMessageExportExportedMessage(t *testing.T) {
+	props := `{"poll_id":"` + NewId() + `","poll_results":"Lunch?\n- Pizza: 1"}`
+
+	export := &MessageExport{
+		PostMessage: NewPointer("Lunch?"),
+		PostType:    NewPointer(PostTypePoll),
+		PostProps:   &props,
+	}
+	assert.Equal(t, "Lunch?\n- Pizza: 1", export.ExportedMessage())
+
+	export.PostType = NewPointer(PostTypeDefault)
+	assert.Equal(t, "Lunch?", export.ExportedMessage())
+}
@@ -54,6 +54,7 @@ const (
 	PostTypeMe                   = "me"
 	PostCustomTypePrefix         = "custom_"
 	PostTypeReminder             = "reminder"
+	PostTypePoll                 = "poll"
 
 	PostFileidsMaxRunes   = 300
 	PostFilenamesMaxRunes = 4000
@@ -363,6 +364,9 @@ type CreatePostFlags struct {
 	TriggerWebhooks   bool
 	SetOnline         bool
 	ForceNotification bool
+	// AllowBuiltInPostActions is set by the server for the posts it adds
+	// built-in post actions to. Other posts can't have such actions.
+	AllowBuiltInPostActions bool
 }
 
 type GetPostsSinceOptions struct {
@@ -499,6 +503,7 @@ func (o *Post) IsValid(maxPostSize int) *AppError {
 		PostTypeChangeChannelPrivacy,
 		PostTypeAddBotTeamsChannels,
 		PostTypeReminder,
+		PostTypePoll,
 		PostTypeMe,
 		PostTypeWrangler,
 		PostTypeGMConvertedToChannel:
@@ -917,6 +922,19 @@ func (o *Post) Attachments() []*SlackAttachment {
 	return ret
 }
 
+// HasBuiltInPostActions returns whether any action of the attachments of the
+// post is handled by the server itself.
+func (o *Post) HasBuiltInPostActions() bool {
+	for _, attachment := range o.Attachments() {
+		for _, action := range attachment.Actions {
+			if action != nil && action.IsBuiltIn() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (o *Post) AttachmentsEqual(input *Post) bool {
 	attachments := o.Attachments()
 	inputAttachments := input.Attachments()
@@ -1107,6 +1125,9 @@ func (o *Post) CleanPost() *Post {
 type UpdatePostOptions struct {
 	SafeUpdate    bool
 	IsRestorePost bool
+	// AllowBuiltInPostActions is set by the server when it changes the
+	// built-in post actions of a post.
+	AllowBuiltInPostActions bool
 }
 
 func DefaultUpdatePostOptions() *UpdatePostOptions {
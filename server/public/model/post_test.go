@@ -892,6 +892,26 @@ func TestPostPatchDisableMentionHighlights(t *testing.T) {
 	})
 }
 
+func TestPostHasBuiltInPostActions(t *testing.T) {
+	p := &Post{}
+	assert.False(t, p.HasBuiltInPostActions())
+
+	p.AddProp(PostPropsAttachments, []any{
+		map[string]any{"actions": []any{
+			map[string]any{"id": "test1", "integration": map[string]any{"url": "https://example.com/action"}},
+		}},
+	})
+	assert.False(t, p.HasBuiltInPostActions())
+
+	p.AddProp(PostPropsAttachments, []any{
+		map[string]any{"actions": []any{
+			map[string]any{"id": "test1", "integration": map[string]any{"url": "https://example.com/action"}},
+			map[string]any{"id": "test2", "integration": map[string]any{"url": PostActionBuiltInURLPrefix + ReminderPostActionName}},
+		}},
+	})
+	assert.True(t, p.HasBuiltInPostActions())
+}
+
 func TestPostAttachments(t *testing.T) {
 	p := &Post{
 		Props: map[string]any{
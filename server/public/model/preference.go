@@ -65,6 +65,10 @@ const (
 	// PreferenceCategoryNotifications is used to store the user's notification settings.
 	// Possible Name values are:
 	// - PreferenceNameEmailInterval
+	// - PreferenceNameEmailDigest
+	// - PreferenceNameEmailDigestHour
+	// - PreferenceNameEmailDigestWeekday
+	// - PreferenceNameEmailDigestLastSentAt
 	PreferenceCategoryNotifications = "notifications"
 
 	// Deprecated: PreferenceRecommendedNextSteps is not used anymore.
@@ -115,6 +119,15 @@ const (
 	PreferenceEmailIntervalHourAsSeconds     = "3600"
 	PreferenceCloudUserEphemeralInfo         = "cloud_user_ephemeral_info"
 
+	PreferenceNameEmailDigest           = "email_digest"
+	PreferenceNameEmailDigestHour       = "email_digest_hour"
+	PreferenceNameEmailDigestWeekday    = "email_digest_weekday"
+	PreferenceNameEmailDigestLastSentAt = "email_digest_last_sent_at"
+
+	PreferenceEmailDigestOff    = "off"
+	PreferenceEmailDigestDaily  = "daily"
+	PreferenceEmailDigestWeekly = "weekly"
+
 	PreferenceNameRecommendedNextStepsHide = "hide"
 )
 
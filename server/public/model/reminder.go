@@ -0,0 +1,145 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	ReminderTargetUser    = "user"
+	ReminderTargetChannel = "channel"
+
+	ReminderMessageMaxRunes   = 1000
+	ReminderRepeatMaxLength   = 64
+	ReminderTimeZoneMaxLength = 64
+
+	// ReminderPostActionName is the name of the built-in post action the
+	// snooze and complete actions of the reminders are handled by.
+	ReminderPostActionName = "reminder"
+
+	ReminderPostActionComplete = "complete"
+	ReminderPostActionSnooze   = "snooze"
+
+	PostPropsReminderId = "reminder_id"
+
+	// ReminderTimeLayout is the layout reminder times are shown to users
+	// with.
+	ReminderTimeLayout = "Monday, January 2 at 3:04 PM MST"
+)
+
+// Reminder is a message the system bot sends to a user or to a channel at a
+// given time, once or repeatedly, as set with the /remind command.
+type Reminder struct {
+	Id         string `json:"id"`
+	CreateAt   int64  `json:"create_at"`
+	UpdateAt   int64  `json:"update_at"`
+	DeleteAt   int64  `json:"delete_at"`
+	CreatorId  string `json:"creator_id"`
+	TargetType string `json:"target_type"`
+	TargetId   string `json:"target_id"`
+	Message    string `json:"message"`
+
+	// TimeZone is the time zone the repeat rule is applied in.
+	TimeZone string `json:"time_zone"`
+
+	// Repeat is the canonical form of the repeat rule, as returned by
+	// ParseReminderTime, or empty for reminders firing once.
+	Repeat string `json:"repeat"`
+
+	// NextFireAt is when the reminder fires next, or 0 when it is waiting
+	// to be snoozed or completed.
+	NextFireAt int64 `json:"next_fire_at"`
+	LastFireAt int64 `json:"last_fire_at"`
+	CompleteAt int64 `json:"complete_at"`
+}
+
+func (r *Reminder) Auditable() map[string]any {
+	return map[string]any{
+		"id":           r.Id,
+		"create_at":    r.CreateAt,
+		"delete_at":    r.DeleteAt,
+		"creator_id":   r.CreatorId,
+		"target_type":  r.TargetType,
+		"target_id":    r.TargetId,
+		"repeat":       r.Repeat,
+		"next_fire_at": r.NextFireAt,
+	}
+}
+
+func (r *Reminder) IsValid() *AppError {
+	if !IsValidId(r.Id) {
+		return NewAppError("Reminder.IsValid", "model.reminder.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if r.CreateAt == 0 {
+		return NewAppError("Reminder.IsValid", "model.reminder.is_valid.create_at.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.UpdateAt == 0 {
+		return NewAppError("Reminder.IsValid", "model.reminder.is_valid.update_at.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(r.CreatorId) {
+		return NewAppError("Reminder.IsValid", "model.reminder.is_valid.creator_id.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.TargetType != ReminderTargetUser && r.TargetType != ReminderTargetChannel {
+		return NewAppError("Reminder.IsValid", "model.reminder.is_valid.target_type.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(r.TargetId) {
+		return NewAppError("Reminder.IsValid", "model.reminder.is_valid.target_id.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.Message == "" || utf8.RuneCountInString(r.Message) > ReminderMessageMaxRunes {
+		return NewAppError("Reminder.IsValid", "model.reminder.is_valid.message.app_error", map[string]any{"Max": ReminderMessageMaxRunes}, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if len(r.TimeZone) > ReminderTimeZoneMaxLength {
+		return NewAppError("Reminder.IsValid", "model.reminder.is_valid.time_zone.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if len(r.Repeat) > ReminderRepeatMaxLength || (r.Repeat != "" && !isValidReminderRepeat(r.Repeat)) {
+		return NewAppError("Reminder.IsValid", "model.reminder.is_valid.repeat.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (r *Reminder) PreSave() {
+	if r.Id == "" {
+		r.Id = NewId()
+	}
+
+	r.CreateAt = GetMillis()
+	r.UpdateAt = r.CreateAt
+}
+
+func (r *Reminder) PreUpdate() {
+	r.UpdateAt = GetMillis()
+}
+
+// IsActive returns whether the reminder still has to fire, or to be snoozed
+// or completed.
+func (r *Reminder) IsActive() bool {
+	return r.DeleteAt == 0 && r.CompleteAt == 0
+}
+
+// Location returns the location of the time zone of the reminder, or UTC if
+// it isn't set or unknown.
+func (r *Reminder) Location() *time.Location {
+	if r.TimeZone == "" {
+		return time.UTC
+	}
+
+	loc, err := time.LoadLocation(r.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
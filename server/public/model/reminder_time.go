@@ -0,0 +1,593 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// reminderDefaultHour is the hour reminders fire at when only their day
+	// is given, such as in "tomorrow" or "every monday".
+	reminderDefaultHour = 9
+
+	// ReminderMinRepeatInterval is the shortest interval a reminder can
+	// repeat at.
+	ReminderMinRepeatInterval = 5 * time.Minute
+
+	// ReminderMaxRepeatInterval is the longest interval a reminder can
+	// repeat at, months counting as 30 days.
+	ReminderMaxRepeatInterval = 366 * 24 * time.Hour
+)
+
+var (
+	reminderClockRegex           = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(am|pm|a\.m\.|p\.m\.)?$`)
+	reminderCompactDurationRegex = regexp.MustCompile(`^(?:\d+(?:mins|min|m|hrs|hr|h|d|w))+$`)
+	reminderCompactPartRegex     = regexp.MustCompile(`(\d+)(mins|min|m|hrs|hr|h|d|w)`)
+	reminderDateRegex            = regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})$`)
+	reminderDayOfMonthRegex      = regexp.MustCompile(`^(\d{1,2})(st|nd|rd|th)?$`)
+
+	reminderUnits = map[string]string{
+		"m": "minute", "min": "minute", "mins": "minute", "minute": "minute", "minutes": "minute",
+		"h": "hour", "hr": "hour", "hrs": "hour", "hour": "hour", "hours": "hour",
+		"d": "day", "day": "day", "days": "day",
+		"w": "week", "wk": "week", "wks": "week", "week": "week", "weeks": "week",
+		"month": "month", "months": "month",
+	}
+
+	reminderNumbers = map[string]int{
+		"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5,
+		"six": 6, "seven": 7, "eight": 8, "nine": 9, "ten": 10, "fifteen": 15,
+		"twenty": 20, "thirty": 30, "forty-five": 45,
+	}
+
+	reminderWeekdays = map[string]time.Weekday{
+		"sunday": time.Sunday, "sun": time.Sunday,
+		"monday": time.Monday, "mon": time.Monday,
+		"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+		"wednesday": time.Wednesday, "wed": time.Wednesday,
+		"thursday": time.Thursday, "thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday,
+		"friday": time.Friday, "fri": time.Friday,
+		"saturday": time.Saturday, "sat": time.Saturday,
+	}
+
+	reminderMonths = map[string]time.Month{
+		"january": time.January, "jan": time.January,
+		"february": time.February, "feb": time.February,
+		"march": time.March, "mar": time.March,
+		"april": time.April, "apr": time.April,
+		"may":  time.May,
+		"june": time.June, "jun": time.June,
+		"july": time.July, "jul": time.July,
+		"august": time.August, "aug": time.August,
+		"september": time.September, "sep": time.September, "sept": time.September,
+		"october": time.October, "oct": time.October,
+		"november": time.November, "nov": time.November,
+		"december": time.December, "dec": time.December,
+	}
+
+	errReminderTime = errors.New("unable to understand the reminder time")
+)
+
+// ParseReminderTime parses when a reminder fires, such as "in 2 hours",
+// "at 3pm", "tomorrow at 9:30", "on friday", "on december 24" or
+// "every weekday at 9am", relative to now and in its location. It returns
+// the first time the reminder fires and, for repeating reminders, the
+// canonical form of the repeat rule to pass to NextReminderTime.
+func ParseReminderTime(when string, now time.Time) (time.Time, string, error) {
+	tokens := reminderTokens(when)
+	if len(tokens) == 0 {
+		return time.Time{}, "", errReminderTime
+	}
+
+	switch tokens[0] {
+	case "in":
+		duration, rest, ok := parseReminderDuration(tokens[1:])
+		if !ok || len(rest) > 0 {
+			return time.Time{}, "", errReminderTime
+		}
+		return duration.addTo(now).Truncate(time.Second), "", nil
+	case "every":
+		repeat, err := parseReminderRepeat(tokens[1:])
+		if err != nil {
+			return time.Time{}, "", err
+		}
+		return repeat.first(now), repeat.String(), nil
+	default:
+		at, err := parseReminderAt(tokens, now)
+		if err != nil {
+			return time.Time{}, "", err
+		}
+		return at, "", nil
+	}
+}
+
+// NextReminderTime returns the first time after now a repeating reminder
+// fires, given the time it last fired at and its repeat rule.
+func NextReminderTime(repeat string, last, now time.Time) (time.Time, error) {
+	tokens := reminderTokens(repeat)
+	if len(tokens) == 0 || tokens[0] != "every" {
+		return time.Time{}, errReminderTime
+	}
+
+	rule, err := parseReminderRepeat(tokens[1:])
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	next := rule.next(last.In(now.Location()))
+	for !next.After(now) {
+		next = rule.next(next)
+	}
+
+	return next, nil
+}
+
+// isValidReminderRepeat returns whether repeat is a repeat rule that
+// NextReminderTime accepts.
+func isValidReminderRepeat(repeat string) bool {
+	tokens := reminderTokens(repeat)
+	if len(tokens) == 0 || tokens[0] != "every" {
+		return false
+	}
+	_, err := parseReminderRepeat(tokens[1:])
+	return err == nil
+}
+
+func reminderTokens(s string) []string {
+	fields := strings.Fields(strings.ToLower(s))
+	tokens := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if field = strings.TrimRight(field, ",.!;"); field != "" {
+			tokens = append(tokens, field)
+		}
+	}
+	return tokens
+}
+
+func reminderNumber(token string) (int, bool) {
+	if n, ok := reminderNumbers[token]; ok {
+		return n, true
+	}
+	n, err := strconv.Atoi(token)
+	if err != nil || n <= 0 {
+		return 0, false
+	}
+	return n, true
+}
+
+type reminderDuration struct {
+	months   int
+	days     int
+	duration time.Duration
+}
+
+func (d *reminderDuration) add(n int, unit string) {
+	switch unit {
+	case "minute":
+		d.duration += time.Duration(n) * time.Minute
+	case "hour":
+		d.duration += time.Duration(n) * time.Hour
+	case "day":
+		d.days += n
+	case "week":
+		d.days += 7 * n
+	case "month":
+		d.months += n
+	}
+}
+
+func (d *reminderDuration) addTo(t time.Time) time.Time {
+	return t.AddDate(0, d.months, d.days).Add(d.duration)
+}
+
+// parseReminderDuration parses durations such as "2 hours", "an hour and
+// 30 minutes" or "1h30m", and returns the tokens following them.
+func parseReminderDuration(tokens []string) (reminderDuration, []string, bool) {
+	var (
+		duration reminderDuration
+		found    bool
+	)
+
+	for len(tokens) > 0 {
+		if tokens[0] == "and" && found {
+			tokens = tokens[1:]
+			continue
+		}
+
+		if reminderCompactDurationRegex.MatchString(tokens[0]) {
+			for _, m := range reminderCompactPartRegex.FindAllStringSubmatch(tokens[0], -1) {
+				n, _ := strconv.Atoi(m[1])
+				duration.add(n, reminderUnits[m[2]])
+			}
+			tokens = tokens[1:]
+			found = true
+			continue
+		}
+
+		n, ok := reminderNumber(tokens[0])
+		if !ok || len(tokens) < 2 {
+			break
+		}
+		unit, ok := reminderUnits[tokens[1]]
+		if !ok {
+			break
+		}
+		duration.add(n, unit)
+		tokens = tokens[2:]
+		found = true
+	}
+
+	return duration, tokens, found
+}
+
+// parseReminderClock parses a time of day such as "3pm", "3:30 pm", "15:30"
+// or "noon". Unless lenient, a bare hour isn't considered a time of day.
+func parseReminderClock(tokens []string, lenient bool) (int, int, []string, bool) {
+	if len(tokens) == 0 {
+		return 0, 0, nil, false
+	}
+
+	switch tokens[0] {
+	case "noon", "midday":
+		return 12, 0, tokens[1:], true
+	case "midnight":
+		return 0, 0, tokens[1:], true
+	}
+
+	clock, rest := tokens[0], tokens[1:]
+	if len(rest) > 0 && (rest[0] == "am" || rest[0] == "pm" || rest[0] == "a.m." || rest[0] == "p.m.") {
+		clock += rest[0]
+		rest = rest[1:]
+	}
+
+	m := reminderClockRegex.FindStringSubmatch(clock)
+	if m == nil || (!lenient && m[2] == "" && m[3] == "") {
+		return 0, 0, nil, false
+	}
+
+	hour, _ := strconv.Atoi(m[1])
+	minute := 0
+	if m[2] != "" {
+		minute, _ = strconv.Atoi(m[2])
+	}
+	if minute > 59 {
+		return 0, 0, nil, false
+	}
+
+	switch strings.ReplaceAll(m[3], ".", "") {
+	case "am":
+		if hour < 1 || hour > 12 {
+			return 0, 0, nil, false
+		}
+		if hour == 12 {
+			hour = 0
+		}
+	case "pm":
+		if hour < 1 || hour > 12 {
+			return 0, 0, nil, false
+		}
+		if hour != 12 {
+			hour += 12
+		}
+	default:
+		if hour > 23 {
+			return 0, 0, nil, false
+		}
+	}
+
+	return hour, minute, rest, true
+}
+
+const (
+	reminderDayNone = iota
+	reminderDayToday
+	reminderDayTomorrow
+	reminderDayWeekday
+	reminderDayDate
+)
+
+type reminderDay struct {
+	kind    int
+	weekday time.Weekday
+	next    bool
+	year    int
+	month   time.Month
+	day     int
+}
+
+// parseReminderAt parses a day and a time of day in any order, such as
+// "at 3pm", "tomorrow at 9", "at 10:30 on friday" or "on 2025-12-24".
+func parseReminderAt(tokens []string, now time.Time) (time.Time, error) {
+	var (
+		day          reminderDay
+		hasClock     bool
+		hour, minute = reminderDefaultHour, 0
+	)
+
+	setDay := func(d reminderDay) bool {
+		if day.kind != reminderDayNone {
+			return false
+		}
+		day = d
+		return true
+	}
+
+	for len(tokens) > 0 {
+		token := tokens[0]
+
+		if token == "at" {
+			h, m, rest, ok := parseReminderClock(tokens[1:], true)
+			if !ok || hasClock {
+				return time.Time{}, errReminderTime
+			}
+			hour, minute, hasClock, tokens = h, m, true, rest
+			continue
+		}
+
+		if token == "on" {
+			tokens = tokens[1:]
+			continue
+		}
+
+		var ok bool
+		switch weekday, isWeekday := reminderWeekdays[token]; {
+		case token == "today":
+			ok = setDay(reminderDay{kind: reminderDayToday})
+			tokens = tokens[1:]
+		case token == "tomorrow":
+			ok = setDay(reminderDay{kind: reminderDayTomorrow})
+			tokens = tokens[1:]
+		case token == "next" && len(tokens) > 1:
+			if weekday, isWeekday = reminderWeekdays[tokens[1]]; isWeekday {
+				ok = setDay(reminderDay{kind: reminderDayWeekday, weekday: weekday, next: true})
+			}
+			tokens = tokens[2:]
+		case isWeekday:
+			ok = setDay(reminderDay{kind: reminderDayWeekday, weekday: weekday})
+			tokens = tokens[1:]
+		case reminderDateRegex.MatchString(token):
+			m := reminderDateRegex.FindStringSubmatch(token)
+			year, _ := strconv.Atoi(m[1])
+			month, _ := strconv.Atoi(m[2])
+			dayOfMonth, _ := strconv.Atoi(m[3])
+			ok = setDay(reminderDay{kind: reminderDayDate, year: year, month: time.Month(month), day: dayOfMonth})
+			tokens = tokens[1:]
+		default:
+			if month, isMonth := reminderMonths[token]; isMonth && len(tokens) > 1 {
+				if m := reminderDayOfMonthRegex.FindStringSubmatch(tokens[1]); m != nil {
+					dayOfMonth, _ := strconv.Atoi(m[1])
+					d := reminderDay{kind: reminderDayDate, month: month, day: dayOfMonth}
+					tokens = tokens[2:]
+					if len(tokens) > 0 && len(tokens[0]) == 4 {
+						if year, err := strconv.Atoi(tokens[0]); err == nil {
+							d.year = year
+							tokens = tokens[1:]
+						}
+					}
+					ok = setDay(d)
+				}
+				break
+			}
+
+			h, m, rest, isClock := parseReminderClock(tokens, false)
+			if isClock && !hasClock {
+				hour, minute, hasClock, tokens, ok = h, m, true, rest, true
+			}
+		}
+		if !ok {
+			return time.Time{}, errReminderTime
+		}
+	}
+
+	if day.kind == reminderDayNone && !hasClock {
+		return time.Time{}, errReminderTime
+	}
+
+	loc := now.Location()
+	at := func(year int, month time.Month, dayOfMonth int) time.Time {
+		return time.Date(year, month, dayOfMonth, hour, minute, 0, 0, loc)
+	}
+	year, month, today := now.Date()
+
+	var t time.Time
+	switch day.kind {
+	case reminderDayNone:
+		if t = at(year, month, today); !t.After(now) {
+			t = at(year, month, today+1)
+		}
+	case reminderDayToday:
+		t = at(year, month, today)
+	case reminderDayTomorrow:
+		t = at(year, month, today+1)
+	case reminderDayWeekday:
+		diff := (int(day.weekday) - int(now.Weekday()) + 7) % 7
+		if diff == 0 && day.next {
+			diff = 7
+		}
+		if t = at(year, month, today+diff); !t.After(now) {
+			t = at(year, month, today+diff+7)
+		}
+	case reminderDayDate:
+		if day.year == 0 {
+			if t = at(year, day.month, day.day); !t.After(now) {
+				t = at(year+1, day.month, day.day)
+			}
+		} else {
+			t = at(day.year, day.month, day.day)
+		}
+		if t.Month() != day.month || t.Day() != day.day {
+			return time.Time{}, errReminderTime
+		}
+	}
+
+	if !t.After(now) {
+		return time.Time{}, fmt.Errorf("%w: the time is in the past", errReminderTime)
+	}
+
+	return t, nil
+}
+
+// reminderRepeat is a repeat rule, either an interval in minutes or hours,
+// or a number of days, weeks or months, every weekday, or a day of the week,
+// at a time of day.
+type reminderRepeat struct {
+	count  int
+	unit   string
+	hour   int
+	minute int
+}
+
+func parseReminderRepeat(tokens []string) (*reminderRepeat, error) {
+	repeat := &reminderRepeat{count: 1, hour: reminderDefaultHour}
+
+	if len(tokens) > 1 {
+		if n, ok := reminderNumber(tokens[0]); ok && tokens[0] != "a" && tokens[0] != "an" {
+			repeat.count = n
+			tokens = tokens[1:]
+		}
+	}
+	if len(tokens) == 0 {
+		return nil, errReminderTime
+	}
+
+	if unit, ok := reminderUnits[tokens[0]]; ok {
+		repeat.unit = unit
+	} else if tokens[0] == "weekday" || tokens[0] == "weekdays" {
+		repeat.unit = "weekday"
+	} else if weekday, ok := reminderWeekdays[tokens[0]]; ok {
+		repeat.unit = strings.ToLower(weekday.String())
+	} else {
+		return nil, errReminderTime
+	}
+	tokens = tokens[1:]
+
+	switch repeat.unit {
+	case "minute", "hour":
+		if len(tokens) > 0 {
+			return nil, errReminderTime
+		}
+		if repeat.count > int(ReminderMaxRepeatInterval/repeat.unitInterval()) {
+			return nil, fmt.Errorf("%w: reminders can't repeat less often than once a year", errReminderTime)
+		}
+		if repeat.interval() < ReminderMinRepeatInterval {
+			return nil, fmt.Errorf("%w: reminders can't repeat more often than every %s", errReminderTime, ReminderMinRepeatInterval)
+		}
+		return repeat, nil
+	case "day", "week", "month":
+		if repeat.count > int(ReminderMaxRepeatInterval/repeat.unitInterval()) {
+			return nil, fmt.Errorf("%w: reminders can't repeat less often than once a year", errReminderTime)
+		}
+	default:
+		if repeat.count != 1 {
+			return nil, errReminderTime
+		}
+	}
+
+	if len(tokens) > 0 {
+		lenient := tokens[0] == "at"
+		if lenient {
+			tokens = tokens[1:]
+		}
+		hour, minute, rest, ok := parseReminderClock(tokens, lenient)
+		if !ok || len(rest) > 0 {
+			return nil, errReminderTime
+		}
+		repeat.hour, repeat.minute = hour, minute
+	}
+
+	return repeat, nil
+}
+
+// unitInterval returns the duration of the unit of the rule, months counting
+// as 30 days.
+func (r *reminderRepeat) unitInterval() time.Duration {
+	switch r.unit {
+	case "minute":
+		return time.Minute
+	case "hour":
+		return time.Hour
+	case "week":
+		return 7 * 24 * time.Hour
+	case "month":
+		return 30 * 24 * time.Hour
+	default:
+		return 24 * time.Hour
+	}
+}
+
+func (r *reminderRepeat) interval() time.Duration {
+	return time.Duration(r.count) * r.unitInterval()
+}
+
+func (r *reminderRepeat) at(t time.Time, days int) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day+days, r.hour, r.minute, 0, 0, t.Location())
+}
+
+func (r *reminderRepeat) first(now time.Time) time.Time {
+	switch r.unit {
+	case "minute", "hour":
+		return now.Add(r.interval()).Truncate(time.Minute)
+	case "day", "week", "month":
+		if t := r.at(now, 0); t.After(now) {
+			return t
+		}
+		return r.at(now, 1)
+	case "weekday":
+		t := r.at(now, 0)
+		for !t.After(now) || t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+			t = r.at(t, 1)
+		}
+		return t
+	default:
+		t := r.at(now, 0)
+		for !t.After(now) || strings.ToLower(t.Weekday().String()) != r.unit {
+			t = r.at(t, 1)
+		}
+		return t
+	}
+}
+
+func (r *reminderRepeat) next(last time.Time) time.Time {
+	switch r.unit {
+	case "minute", "hour":
+		return last.Add(r.interval())
+	case "day":
+		return r.at(last, r.count)
+	case "week":
+		return r.at(last, 7*r.count)
+	case "month":
+		year, month, day := last.Date()
+		return time.Date(year, month+time.Month(r.count), day, r.hour, r.minute, 0, 0, last.Location())
+	case "weekday":
+		t := r.at(last, 1)
+		for t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+			t = r.at(t, 1)
+		}
+		return t
+	default:
+		return r.at(last, 7)
+	}
+}
+
+// String returns the canonical form of the repeat rule, which
+// parseReminderRepeat parses back.
+func (r *reminderRepeat) String() string {
+	every := "every " + r.unit
+	if r.count > 1 {
+		every = fmt.Sprintf("every %d %ss", r.count, r.unit)
+	}
+
+	if r.unit == "minute" || r.unit == "hour" {
+		return every
+	}
+
+	return fmt.Sprintf("%s at %02d:%02d", every, r.hour, r.minute)
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReminderTime(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	// A Wednesday.
+	now := time.Date(2024, time.March, 13, 14, 20, 30, 0, loc)
+	at := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2024, month, day, hour, minute, 0, 0, loc)
+	}
+
+	for name, tc := range map[string]struct {
+		when           string
+		expectedTime   time.Time
+		expectedRepeat string
+	}{
+		"in minutes":              {"in 30 minutes", now.Add(30 * time.Minute), ""},
+		"in an hour":              {"in an hour", now.Add(time.Hour), ""},
+		"in compound durations":   {"in 1 hour and 15 mins", now.Add(75 * time.Minute), ""},
+		"in compact durations":    {"in 1h30m", now.Add(90 * time.Minute), ""},
+		"in days":                 {"in 2 days", now.AddDate(0, 0, 2), ""},
+		"in weeks":                {"in a week", now.AddDate(0, 0, 7), ""},
+		"at a later time":         {"at 3pm", at(time.March, 13, 15, 0), ""},
+		"at an earlier time":      {"at 9:30 am", at(time.March, 14, 9, 30), ""},
+		"at a 24 hour time":       {"at 17:45", at(time.March, 13, 17, 45), ""},
+		"at noon":                 {"at noon", at(time.March, 14, 12, 0), ""},
+		"at a bare hour":          {"at 16", at(time.March, 13, 16, 0), ""},
+		"tomorrow":                {"tomorrow", at(time.March, 14, 9, 0), ""},
+		"tomorrow at":             {"tomorrow at 10pm", at(time.March, 14, 22, 0), ""},
+		"at tomorrow":             {"at 8am tomorrow", at(time.March, 14, 8, 0), ""},
+		"today":                   {"today at 6pm", at(time.March, 13, 18, 0), ""},
+		"on a weekday":            {"on friday", at(time.March, 15, 9, 0), ""},
+		"on the same weekday":     {"wednesday at 2pm", at(time.March, 20, 14, 0), ""},
+		"on the next weekday":     {"next wednesday at 5pm", at(time.March, 20, 17, 0), ""},
+		"on a weekday with clock": {"on mon 3:15pm", at(time.March, 18, 15, 15), ""},
+		"on a date":               {"on 2024-04-01 at 8:00", at(time.April, 1, 8, 0), ""},
+		"on a month day":          {"on december 24th", at(time.December, 24, 9, 0), ""},
+		"on a past month day":     {"jan 2 at noon", time.Date(2025, time.January, 2, 12, 0, 0, 0, loc), ""},
+		"with punctuation":        {"Tomorrow, at 7PM.", at(time.March, 14, 19, 0), ""},
+		"every day":               {"every day", at(time.March, 14, 9, 0), "every day at 09:00"},
+		"every day at":            {"every day at 3pm", at(time.March, 13, 15, 0), "every day at 15:00"},
+		"every weekday":           {"every weekday at 8:30am", at(time.March, 14, 8, 30), "every weekday at 08:30"},
+		"every monday":            {"every monday", at(time.March, 18, 9, 0), "every monday at 09:00"},
+		"every two weeks":         {"every 2 weeks at 10am", at(time.March, 14, 10, 0), "every 2 weeks at 10:00"},
+		"every hour":              {"every hour", at(time.March, 13, 15, 20), "every hour"},
+		"every 30 minutes":        {"every 30 minutes", at(time.March, 13, 14, 50), "every 30 minutes"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			actualTime, actualRepeat, err := ParseReminderTime(tc.when, now)
+			require.NoError(t, err)
+			assert.True(t, tc.expectedTime.Equal(actualTime), "expected %s, got %s", tc.expectedTime, actualTime)
+			assert.Equal(t, tc.expectedRepeat, actualRepeat)
+		})
+	}
+
+	for name, when := range map[string]string{
+		"empty":                    "",
+		"unknown words":            "whenever you feel like it",
+		"in without a duration":    "in a while",
+		"trailing words":           "in 5 minutes please",
+		"two days":                 "tomorrow on friday",
+		"two clocks":               "at 3pm at 4pm",
+		"invalid clock":            "at 25:00",
+		"invalid meridiem clock":   "at 13pm",
+		"in the past today":        "today at 9am",
+		"past date":                "on 2023-01-01",
+		"invalid date":             "on february 30",
+		"too frequent":             "every 2 minutes",
+		"too rare":                 "every 13 months",
+		"too rare interval":        "every 10000 hours",
+		"overflowing interval":     "every 9000000000000 months",
+		"every without a unit":     "every",
+		"every weekday with count": "every 2 mondays",
+		"every hour at":            "every hour at 3pm",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := ParseReminderTime(when, now)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNextReminderTime(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	at := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2024, month, day, hour, minute, 0, 0, loc)
+	}
+
+	for name, tc := range map[string]struct {
+		repeat   string
+		last     time.Time
+		now      time.Time
+		expected time.Time
+	}{
+		"every day": {
+			"every day at 09:00", at(time.March, 13, 9, 0), at(time.March, 13, 9, 1), at(time.March, 14, 9, 0),
+		},
+		"every day across a daylight saving change": {
+			"every day at 09:00", at(time.March, 30, 9, 0), at(time.March, 30, 9, 1), at(time.March, 31, 9, 0),
+		},
+		"every day after downtime": {
+			"every day at 09:00", at(time.March, 10, 9, 0), at(time.March, 13, 12, 0), at(time.March, 14, 9, 0),
+		},
+		"every weekday skips the weekend": {
+			"every weekday at 08:30", at(time.March, 15, 8, 30), at(time.March, 15, 8, 31), at(time.March, 18, 8, 30),
+		},
+		"every friday": {
+			"every friday at 17:00", at(time.March, 15, 17, 0), at(time.March, 15, 17, 0), at(time.March, 22, 17, 0),
+		},
+		"every month": {
+			"every month at 10:00", at(time.January, 15, 10, 0), at(time.January, 15, 10, 0), at(time.February, 15, 10, 0),
+		},
+		"every 2 hours": {
+			"every 2 hours", at(time.March, 13, 9, 0), at(time.March, 13, 12, 0), at(time.March, 13, 13, 0),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			actual, err := NextReminderTime(tc.repeat, tc.last, tc.now)
+			require.NoError(t, err)
+			assert.True(t, tc.expected.Equal(actual), "expected %s, got %s", tc.expected, actual)
+		})
+	}
+
+	t.Run("invalid repeat", func(t *testing.T) {
+		_, err := NextReminderTime("tomorrow", at(time.March, 13, 9, 0), at(time.March, 13, 9, 0))
+		assert.Error(t, err)
+	})
+}
@@ -73,6 +73,15 @@ func (options *ReportingBaseOptions) IsValid() *AppError {
 	return nil
 }
 
+// PasswordHashReport is the number of users whose password is hashed with an algorithm.
+type PasswordHashReport struct {
+	Algorithm string `json:"algorithm"`
+	// Latest is whether the passwords are hashed with the algorithm and parameters new
+	// passwords are hashed with. The others are rehashed when their users log in.
+	Latest bool  `json:"latest"`
+	Count  int64 `json:"count"`
+}
+
 type UserReportQuery struct {
 	User
 	UserPostStats
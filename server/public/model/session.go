@@ -16,12 +16,14 @@ const (
 	SessionCookieUser                     = "MMUSERID"
 	SessionCookieCsrf                     = "MMCSRF"
 	SessionCookieCloudUrl                 = "MMCLOUDURL"
+	SessionCookieDevice                   = "MMDEVICEID"
 	SessionCacheSize                      = 35000
 	SessionPropPlatform                   = "platform"
 	SessionPropOs                         = "os"
 	SessionPropBrowser                    = "browser"
 	SessionPropType                       = "type"
 	SessionPropUserAccessTokenId          = "user_access_token_id"
+	SessionPropUserAccessTokenScopes      = "user_access_token_scopes"
 	SessionPropIsBot                      = "is_bot"
 	SessionPropIsBotValue                 = "true"
 	SessionPropOAuthAppID                 = "oauth_app_id"
@@ -29,6 +31,8 @@ const (
 	SessionPropLastRemovedDeviceId        = "last_removed_device_id"
 	SessionPropDeviceNotificationDisabled = "device_notification_disabled"
 	SessionPropMobileVersion              = "mobile_version"
+	SessionPropDeviceFingerprint          = "device_fingerprint"
+	SessionPropIPAddress                  = "ip_address"
 	SessionTypeUserAccessToken            = "UserAccessToken"
 	SessionTypeCloudKey                   = "CloudKey"
 	SessionTypeRemoteclusterToken         = "RemoteClusterToken"
@@ -244,6 +248,12 @@ func (s *Session) IsUserAccessToken() bool {
 	return false
 }
 
+// GetUserAccessTokenScopes returns the scopes restricting the permissions of a session
+// authenticated by a personal access token, if any.
+func (s *Session) GetUserAccessTokenScopes() []string {
+	return strings.Fields(s.Props[SessionPropUserAccessTokenScopes])
+}
+
 // Returns true when session is authenticated as a bot, by personal access token, or is an OAuth app.
 // Does not indicate other forms of integrations e.g. webhooks, slash commands, etc.
 func (s *Session) IsIntegration() bool {
@@ -12,6 +12,9 @@ const (
 	MaxTokenExipryTime = 1000 * 60 * 60 * 48 // 48 hour
 	TokenTypeOAuth     = "oauth"
 	TokenTypeSaml      = "saml"
+
+	TokenTypeWebAuthnRegistration = "webauthn_registration"
+	TokenTypeWebAuthnLogin        = "webauthn_login"
 )
 
 type Token struct {
@@ -4,15 +4,153 @@
 package model
 
 import (
+	"maps"
 	"net/http"
+	"slices"
+	"strings"
+	"sync"
 )
 
+const (
+	UserAccessTokenMaxScopes = 50
+
+	// UserAccessTokenExpiryNotifyMillis is how long before their expiry the owners of the
+	// tokens are notified.
+	UserAccessTokenExpiryNotifyMillis = 7 * 24 * 60 * 60 * 1000
+)
+
+// userAccessTokenScopePermissions maps the scopes a token may be restricted to, without their
+// optional channel, to the permissions they grant. Scopes of the resources in
+// userAccessTokenChannelScopes may be restricted to a single channel, such as
+// write:posts:<channel_id>. It is built on first use, once the permissions are initialized.
+var userAccessTokenScopePermissions = sync.OnceValue(func() map[string][]*Permission {
+	return map[string][]*Permission{
+		"read:posts": {
+			PermissionReadChannel,
+			PermissionReadChannelContent,
+			PermissionReadPublicChannel,
+		},
+		"write:posts": {
+			PermissionReadChannel,
+			PermissionReadChannelContent,
+			PermissionReadPublicChannel,
+			PermissionCreatePost,
+			PermissionCreatePostPublic,
+			PermissionEditPost,
+			PermissionDeletePost,
+			PermissionAddReaction,
+			PermissionRemoveReaction,
+			PermissionUploadFile,
+			PermissionUseChannelMentions,
+			PermissionUseGroupMentions,
+		},
+		"read:channels": {
+			PermissionReadChannel,
+			PermissionReadPublicChannel,
+			PermissionListTeamChannels,
+			PermissionViewTeam,
+		},
+		"write:channels": {
+			PermissionReadChannel,
+			PermissionReadPublicChannel,
+			PermissionListTeamChannels,
+			PermissionViewTeam,
+			PermissionJoinPublicChannels,
+			PermissionCreatePublicChannel,
+			PermissionCreatePrivateChannel,
+			PermissionCreateDirectChannel,
+			PermissionCreateGroupChannel,
+			PermissionManagePublicChannelProperties,
+			PermissionManagePrivateChannelProperties,
+			PermissionManagePublicChannelMembers,
+			PermissionManagePrivateChannelMembers,
+		},
+		"read:users": {
+			PermissionViewMembers,
+			PermissionViewTeam,
+			PermissionListUsersWithoutTeam,
+			PermissionListPublicTeams,
+			PermissionListPrivateTeams,
+		},
+		"manage:webhooks": {
+			PermissionManageIncomingWebhooks,
+			PermissionManageOutgoingWebhooks,
+			PermissionManageOthersIncomingWebhooks,
+			PermissionManageOthersOutgoingWebhooks,
+		},
+		"manage:commands": {
+			PermissionManageSlashCommands,
+			PermissionManageOthersSlashCommands,
+		},
+	}
+})
+
+var userAccessTokenChannelScopes = map[string]bool{
+	"posts":    true,
+	"channels": true,
+}
+
+// UserAccessTokenScopes returns the scopes tokens may be restricted to, without their
+// optional channel.
+func UserAccessTokenScopes() []string {
+	return slices.Sorted(maps.Keys(userAccessTokenScopePermissions()))
+}
+
+// parseUserAccessTokenScope splits a scope into its action and resource, such as
+// write:posts, and its optional channel id.
+func parseUserAccessTokenScope(scope string) (string, string, bool) {
+	parts := strings.Split(scope, ":")
+	switch len(parts) {
+	case 2:
+		if _, ok := userAccessTokenScopePermissions()[scope]; ok {
+			return scope, "", true
+		}
+	case 3:
+		base := parts[0] + ":" + parts[1]
+		if _, ok := userAccessTokenScopePermissions()[base]; ok && userAccessTokenChannelScopes[parts[1]] && IsValidId(parts[2]) {
+			return base, parts[2], true
+		}
+	}
+	return "", "", false
+}
+
+// IsValidUserAccessTokenScope checks that a scope is of the form <action>:<resource>, or
+// <action>:<resource>:<channel_id> for the resources scoped by channel.
+func IsValidUserAccessTokenScope(scope string) bool {
+	_, _, ok := parseUserAccessTokenScope(scope)
+	return ok
+}
+
+// UserAccessTokenScopesAllow checks whether any of the scopes grants the permission. An
+// empty channel id only matches the scopes not restricted to a channel.
+func UserAccessTokenScopesAllow(scopes []string, channelID string, permission *Permission) bool {
+	for _, scope := range scopes {
+		base, scopeChannelID, ok := parseUserAccessTokenScope(scope)
+		if !ok || (scopeChannelID != "" && scopeChannelID != channelID) {
+			continue
+		}
+		for _, granted := range userAccessTokenScopePermissions()[base] {
+			if granted.Id == permission.Id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 type UserAccessToken struct {
 	Id          string `json:"id"`
 	Token       string `json:"token,omitempty"`
 	UserId      string `json:"user_id"`
 	Description string `json:"description"`
 	IsActive    bool   `json:"is_active"`
+	// ExpiresAt is the time after which the token can't be used, or 0 if it never expires.
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+	// Scopes restrict the permissions of the token, which has all the ones of its user
+	// when there are none.
+	Scopes     StringArray `json:"scopes,omitempty"`
+	LastUsedAt int64       `json:"last_used_at,omitempty"`
+	LastUsedIP string      `json:"last_used_ip,omitempty"`
 }
 
 func (t *UserAccessToken) IsValid() *AppError {
@@ -32,10 +170,36 @@ func (t *UserAccessToken) IsValid() *AppError {
 		return NewAppError("UserAccessToken.IsValid", "model.user_access_token.is_valid.description.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if t.ExpiresAt < 0 {
+		return NewAppError("UserAccessToken.IsValid", "model.user_access_token.is_valid.expires_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if len(t.Scopes) > UserAccessTokenMaxScopes {
+		return NewAppError("UserAccessToken.IsValid", "model.user_access_token.is_valid.scopes.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	for _, scope := range t.Scopes {
+		if !IsValidUserAccessTokenScope(scope) {
+			return NewAppError("UserAccessToken.IsValid", "model.user_access_token.is_valid.scope.app_error", map[string]any{"Scope": scope}, "", http.StatusBadRequest)
+		}
+	}
+
 	return nil
 }
 
+// IsExpired returns true if the token has an expiry date which is past.
+func (t *UserAccessToken) IsExpired() bool {
+	return t.ExpiresAt > 0 && t.ExpiresAt <= GetMillis()
+}
+
+// IsScoped returns true if the permissions of the token are restricted by scopes.
+func (t *UserAccessToken) IsScoped() bool {
+	return len(t.Scopes) > 0
+}
+
 func (t *UserAccessToken) PreSave() {
 	t.Id = NewId()
 	t.IsActive = true
+	t.LastUsedAt = 0
+	t.LastUsedIP = ""
 }
@@ -30,3 +30,52 @@ func TestUserAccessTokenIsValid(t *testing.T) {
 	appErr = ad.IsValid()
 	require.False(t, appErr == nil || appErr.Id != "model.user_access_token.is_valid.description.app_error")
 }
+
+func TestUserAccessTokenIsValidScopes(t *testing.T) {
+	token := UserAccessToken{
+		Id:     NewId(),
+		Token:  NewId(),
+		UserId: NewId(),
+		Scopes: StringArray{"read:posts", "write:posts:" + NewId(), "manage:webhooks"},
+	}
+	require.Nil(t, token.IsValid())
+
+	for _, scope := range []string{"", "read", "delete:posts", "manage:webhooks:" + NewId(), "write:posts:invalid", "write:posts:" + NewId() + ":extra"} {
+		token.Scopes = StringArray{scope}
+		appErr := token.IsValid()
+		require.NotNil(t, appErr, scope)
+		require.Equal(t, "model.user_access_token.is_valid.scope.app_error", appErr.Id)
+	}
+
+	token.Scopes = nil
+	token.ExpiresAt = -1
+	appErr := token.IsValid()
+	require.NotNil(t, appErr)
+	require.Equal(t, "model.user_access_token.is_valid.expires_at.app_error", appErr.Id)
+}
+
+func TestUserAccessTokenIsExpired(t *testing.T) {
+	token := UserAccessToken{}
+	require.False(t, token.IsExpired())
+
+	token.ExpiresAt = GetMillis() + 60*1000
+	require.False(t, token.IsExpired())
+
+	token.ExpiresAt = GetMillis() - 1
+	require.True(t, token.IsExpired())
+}
+
+func TestUserAccessTokenScopesAllow(t *testing.T) {
+	channelID := NewId()
+	scopes := []string{"read:posts", "write:posts:" + channelID}
+
+	require.True(t, UserAccessTokenScopesAllow(scopes, "", PermissionReadChannelContent))
+	require.True(t, UserAccessTokenScopesAllow(scopes, NewId(), PermissionReadChannelContent))
+	require.True(t, UserAccessTokenScopesAllow(scopes, channelID, PermissionCreatePost))
+	require.False(t, UserAccessTokenScopesAllow(scopes, NewId(), PermissionCreatePost))
+	require.False(t, UserAccessTokenScopesAllow(scopes, "", PermissionCreatePost))
+	require.False(t, UserAccessTokenScopesAllow(scopes, channelID, PermissionManageSystem))
+	require.False(t, UserAccessTokenScopesAllow(nil, "", PermissionReadChannelContent))
+
+	require.True(t, UserAccessTokenScopesAllow([]string{"manage:webhooks"}, "", PermissionManageIncomingWebhooks))
+}
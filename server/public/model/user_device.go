@@ -0,0 +1,100 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	UserDeviceFingerprintLength  = 64
+	UserDeviceFieldMaxLength     = 64
+	UserDeviceIPAddressMaxLength = 64
+)
+
+// UserDevice is a device a user logged in from, identified by a fingerprint of
+// an identifier kept by the device. It is used to alert the users logging in
+// from a device they never used before. The platform, operating system and
+// browser are only kept to describe the device to the user.
+type UserDevice struct {
+	UserId        string `json:"user_id"`
+	Fingerprint   string `json:"fingerprint"`
+	Platform      string `json:"platform"`
+	Os            string `json:"os"`
+	Browser       string `json:"browser"`
+	FirstSeenAt   int64  `json:"first_seen_at"`
+	LastSeenAt    int64  `json:"last_seen_at"`
+	LastIPAddress string `json:"last_ip_address"`
+}
+
+// NewUserDeviceFingerprint returns the fingerprint of the device with the given
+// identifier, which is the device cookie of browsers or the device id of mobile
+// apps. The identifier is hashed so that it is never stored as is.
+func NewUserDeviceFingerprint(deviceID string) string {
+	sum := sha256.Sum256([]byte(deviceID))
+	return hex.EncodeToString(sum[:])
+}
+
+func (d *UserDevice) PreSave() {
+	if d.FirstSeenAt == 0 {
+		d.FirstSeenAt = GetMillis()
+	}
+
+	if d.LastSeenAt == 0 {
+		d.LastSeenAt = d.FirstSeenAt
+	}
+
+	d.Platform = SanitizeUnicode(d.Platform)
+	d.Os = SanitizeUnicode(d.Os)
+	d.Browser = SanitizeUnicode(d.Browser)
+}
+
+func (d *UserDevice) IsValid() *AppError {
+	if !IsValidId(d.UserId) {
+		return NewAppError("UserDevice.IsValid", "model.user_device.is_valid.user_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if len(d.Fingerprint) != UserDeviceFingerprintLength {
+		return NewAppError("UserDevice.IsValid", "model.user_device.is_valid.fingerprint.app_error", nil, "user_id="+d.UserId, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(d.Platform) > UserDeviceFieldMaxLength ||
+		utf8.RuneCountInString(d.Os) > UserDeviceFieldMaxLength ||
+		utf8.RuneCountInString(d.Browser) > UserDeviceFieldMaxLength {
+		return NewAppError("UserDevice.IsValid", "model.user_device.is_valid.name.app_error", nil, "user_id="+d.UserId, http.StatusBadRequest)
+	}
+
+	if d.FirstSeenAt == 0 || d.LastSeenAt < d.FirstSeenAt {
+		return NewAppError("UserDevice.IsValid", "model.user_device.is_valid.seen_at.app_error", nil, "user_id="+d.UserId, http.StatusBadRequest)
+	}
+
+	if len(d.LastIPAddress) > UserDeviceIPAddressMaxLength {
+		return NewAppError("UserDevice.IsValid", "model.user_device.is_valid.ip_address.app_error", nil, "user_id="+d.UserId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// SessionDevice describes a session of a user together with the device it was
+// created from, so that users can review where they are logged in and log out
+// of the devices they don't recognize.
+type SessionDevice struct {
+	SessionId string `json:"session_id"`
+	IsCurrent bool   `json:"is_current"`
+	IsMobile  bool   `json:"is_mobile"`
+	Platform  string `json:"platform"`
+	Os        string `json:"os"`
+	Browser   string `json:"browser"`
+	IPAddress string `json:"ip_address"`
+
+	// FirstSeenAt is when the user first logged in from the device, which may
+	// be earlier than the creation of the session.
+	FirstSeenAt    int64 `json:"first_seen_at"`
+	LastActivityAt int64 `json:"last_activity_at"`
+	CreateAt       int64 `json:"create_at"`
+	ExpiresAt      int64 `json:"expires_at"`
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUserDeviceFingerprint(t *testing.T) {
+	deviceID := NewId()
+	fingerprint := NewUserDeviceFingerprint(deviceID)
+	assert.Len(t, fingerprint, UserDeviceFingerprintLength)
+	assert.NotEqual(t, deviceID, fingerprint)
+	assert.Equal(t, fingerprint, NewUserDeviceFingerprint(deviceID))
+	assert.NotEqual(t, fingerprint, NewUserDeviceFingerprint(NewId()))
+}
+
+func TestUserDeviceIsValid(t *testing.T) {
+	device := UserDevice{Fingerprint: NewUserDeviceFingerprint(NewId()), Platform: "Macintosh", Os: "Mac OS", Browser: "Safari"}
+	device.PreSave()
+	require.NotZero(t, device.FirstSeenAt)
+	require.Equal(t, device.FirstSeenAt, device.LastSeenAt)
+
+	appErr := device.IsValid()
+	require.NotNil(t, appErr)
+	require.Equal(t, "model.user_device.is_valid.user_id.app_error", appErr.Id)
+
+	device.UserId = NewId()
+	require.Nil(t, device.IsValid())
+
+	device.Browser = strings.Repeat("b", UserDeviceFieldMaxLength+1)
+	appErr = device.IsValid()
+	require.NotNil(t, appErr)
+	require.Equal(t, "model.user_device.is_valid.name.app_error", appErr.Id)
+
+	device.Browser = "Safari"
+	device.LastSeenAt = device.FirstSeenAt - 1
+	appErr = device.IsValid()
+	require.NotNil(t, appErr)
+	require.Equal(t, "model.user_device.is_valid.seen_at.app_error", appErr.Id)
+
+	device.LastSeenAt = device.FirstSeenAt
+	device.Fingerprint = "short"
+	appErr = device.IsValid()
+	require.NotNil(t, appErr)
+	require.Equal(t, "model.user_device.is_valid.fingerprint.app_error", appErr.Id)
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	WebAuthnCredentialNameMaxRunes = 64
+	// WebAuthnCredentialIdMaxLength is the maximum length of the base64url encoded credential
+	// ids, which are at most 1023 bytes long.
+	WebAuthnCredentialIdMaxLength = 1364
+	WebAuthnCredentialsMaxPerUser = 20
+
+	// WebAuthnCeremonyTimeout is how long users have to complete a registration or an
+	// authentication ceremony, in milliseconds.
+	WebAuthnCeremonyTimeout = 5 * 60 * 1000
+
+	WebAuthnUserVerificationRequired    = "required"
+	WebAuthnUserVerificationPreferred   = "preferred"
+	WebAuthnUserVerificationDiscouraged = "discouraged"
+
+	WebAuthnCredentialTypePublicKey = "public-key"
+)
+
+// WebAuthnCredential is a passkey or a security key registered by a user, which can be used
+// as a second factor or, when enabled, to log in without a password.
+type WebAuthnCredential struct {
+	Id     string `json:"id"`
+	UserId string `json:"user_id"`
+	// CredentialId is the base64url encoded id the authenticator gave to the credential.
+	CredentialId string `json:"credential_id"`
+	// PublicKey is the COSE encoded public key of the credential.
+	PublicKey []byte `json:"-"`
+	SignCount int64  `json:"-"`
+	// AAGUID identifies the model of the authenticator, as a hex string.
+	AAGUID     string `json:"aaguid"`
+	Name       string `json:"name"`
+	CreateAt   int64  `json:"create_at"`
+	LastUsedAt int64  `json:"last_used_at"`
+}
+
+func (c *WebAuthnCredential) Auditable() map[string]any {
+	return map[string]any{
+		"id":            c.Id,
+		"user_id":       c.UserId,
+		"credential_id": c.CredentialId,
+		"aaguid":        c.AAGUID,
+		"name":          c.Name,
+		"create_at":     c.CreateAt,
+	}
+}
+
+func (c *WebAuthnCredential) PreSave() {
+	if c.Id == "" {
+		c.Id = NewId()
+	}
+
+	if c.CreateAt == 0 {
+		c.CreateAt = GetMillis()
+	}
+}
+
+func (c *WebAuthnCredential) IsValid() *AppError {
+	if !IsValidId(c.Id) {
+		return NewAppError("WebAuthnCredential.IsValid", "model.webauthn_credential.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(c.UserId) {
+		return NewAppError("WebAuthnCredential.IsValid", "model.webauthn_credential.is_valid.user_id.app_error", nil, "id="+c.Id, http.StatusBadRequest)
+	}
+
+	if c.CredentialId == "" || len(c.CredentialId) > WebAuthnCredentialIdMaxLength {
+		return NewAppError("WebAuthnCredential.IsValid", "model.webauthn_credential.is_valid.credential_id.app_error", nil, "id="+c.Id, http.StatusBadRequest)
+	}
+
+	if len(c.PublicKey) == 0 {
+		return NewAppError("WebAuthnCredential.IsValid", "model.webauthn_credential.is_valid.public_key.app_error", nil, "id="+c.Id, http.StatusBadRequest)
+	}
+
+	if c.Name == "" || utf8.RuneCountInString(c.Name) > WebAuthnCredentialNameMaxRunes {
+		return NewAppError("WebAuthnCredential.IsValid", "model.webauthn_credential.is_valid.name.app_error", map[string]any{"MaxLength": WebAuthnCredentialNameMaxRunes}, "id="+c.Id, http.StatusBadRequest)
+	}
+
+	if c.CreateAt == 0 {
+		return NewAppError("WebAuthnCredential.IsValid", "model.webauthn_credential.is_valid.create_at.app_error", nil, "id="+c.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// The types below follow the JSON serialization of the WebAuthn Level 3 specification, so
+// that browsers can pass them to PublicKeyCredential.parseCreationOptionsFromJSON and
+// PublicKeyCredential.parseRequestOptionsFromJSON, and send back the result of
+// PublicKeyCredential.toJSON. Binary values are base64url encoded without padding.
+
+type WebAuthnRelyingPartyEntity struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type WebAuthnUserEntity struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+type WebAuthnCredentialParameter struct {
+	Type string `json:"type"`
+	Alg  int64  `json:"alg"`
+}
+
+type WebAuthnCredentialDescriptor struct {
+	Type string `json:"type"`
+	Id   string `json:"id"`
+}
+
+type WebAuthnAuthenticatorSelection struct {
+	ResidentKey        string `json:"residentKey"`
+	RequireResidentKey bool   `json:"requireResidentKey"`
+	UserVerification   string `json:"userVerification"`
+}
+
+// WebAuthnCreationOptions are the options of a registration ceremony.
+type WebAuthnCreationOptions struct {
+	Challenge              string                         `json:"challenge"`
+	RelyingParty           WebAuthnRelyingPartyEntity     `json:"rp"`
+	User                   WebAuthnUserEntity             `json:"user"`
+	PubKeyCredParams       []WebAuthnCredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                          `json:"timeout"`
+	ExcludeCredentials     []WebAuthnCredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection WebAuthnAuthenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                         `json:"attestation"`
+}
+
+// WebAuthnRequestOptions are the options of an authentication ceremony. AllowCredentials is
+// empty for passwordless logins, letting users pick any of their passkeys.
+type WebAuthnRequestOptions struct {
+	Challenge        string                         `json:"challenge"`
+	Timeout          int64                          `json:"timeout"`
+	RelyingPartyId   string                         `json:"rpId"`
+	AllowCredentials []WebAuthnCredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                         `json:"userVerification"`
+}
+
+type WebAuthnAttestationResponse struct {
+	ClientDataJSON    string `json:"clientDataJSON"`
+	AttestationObject string `json:"attestationObject"`
+}
+
+// WebAuthnRegistrationResponse is the credential created by a registration ceremony.
+type WebAuthnRegistrationResponse struct {
+	Id       string                      `json:"id"`
+	RawId    string                      `json:"rawId"`
+	Type     string                      `json:"type"`
+	Response WebAuthnAttestationResponse `json:"response"`
+}
+
+type WebAuthnAuthenticatorAssertionResponse struct {
+	ClientDataJSON    string `json:"clientDataJSON"`
+	AuthenticatorData string `json:"authenticatorData"`
+	Signature         string `json:"signature"`
+	UserHandle        string `json:"userHandle,omitempty"`
+}
+
+// WebAuthnAssertionResponse is the signature produced by an authentication ceremony. When
+// used as a second factor, it's sent JSON encoded in place of the MFA token of the login
+// request.
+type WebAuthnAssertionResponse struct {
+	Id       string                                 `json:"id"`
+	RawId    string                                 `json:"rawId"`
+	Type     string                                 `json:"type"`
+	Response WebAuthnAuthenticatorAssertionResponse `json:"response"`
+}
+
+// WebAuthnRegistrationRequest is the body of the request finishing the registration of a
+// credential. Like changing a password, it requires the user to authenticate again.
+type WebAuthnRegistrationRequest struct {
+	Name       string                        `json:"name"`
+	Credential *WebAuthnRegistrationResponse `json:"credential"`
+	Password   string                        `json:"password"`
+	MfaToken   string                        `json:"mfa_token"`
+}
+
+// WebAuthnLoginRequest is the body of a passwordless login request.
+type WebAuthnLoginRequest struct {
+	Credential *WebAuthnAssertionResponse `json:"credential"`
+	DeviceId   string                     `json:"device_id"`
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newValidWebAuthnCredential() *WebAuthnCredential {
+	credential := &WebAuthnCredential{
+		UserId:       NewId(),
+		CredentialId: "Y3JlZGVudGlhbC1pZA",
+		PublicKey:    []byte{0xa1, 0x01, 0x02},
+		Name:         "Security key",
+	}
+	credential.PreSave()
+	return credential
+}
+
+func TestWebAuthnCredentialIsValid(t *testing.T) {
+	require.Nil(t, newValidWebAuthnCredential().IsValid())
+
+	for name, tc := range map[string]struct {
+		update      func(*WebAuthnCredential)
+		expectedErr string
+	}{
+		"invalid id":             {func(c *WebAuthnCredential) { c.Id = "invalid" }, "model.webauthn_credential.is_valid.id.app_error"},
+		"invalid user id":        {func(c *WebAuthnCredential) { c.UserId = "" }, "model.webauthn_credential.is_valid.user_id.app_error"},
+		"missing credential id":  {func(c *WebAuthnCredential) { c.CredentialId = "" }, "model.webauthn_credential.is_valid.credential_id.app_error"},
+		"credential id too long": {func(c *WebAuthnCredential) { c.CredentialId = strings.Repeat("a", WebAuthnCredentialIdMaxLength+1) }, "model.webauthn_credential.is_valid.credential_id.app_error"},
+		"missing public key":     {func(c *WebAuthnCredential) { c.PublicKey = nil }, "model.webauthn_credential.is_valid.public_key.app_error"},
+		"missing name":           {func(c *WebAuthnCredential) { c.Name = "" }, "model.webauthn_credential.is_valid.name.app_error"},
+		"name too long":          {func(c *WebAuthnCredential) { c.Name = strings.Repeat("é", WebAuthnCredentialNameMaxRunes+1) }, "model.webauthn_credential.is_valid.name.app_error"},
+		"missing create at":      {func(c *WebAuthnCredential) { c.CreateAt = 0 }, "model.webauthn_credential.is_valid.create_at.app_error"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			credential := newValidWebAuthnCredential()
+			tc.update(credential)
+
+			appErr := credential.IsValid()
+			require.NotNil(t, appErr)
+			require.Equal(t, tc.expectedErr, appErr.Id)
+		})
+	}
+}
@@ -0,0 +1,453 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Code generated by "make pluginapi"
+// DO NOT EDIT
+
+package plugin
+
+import (
+	saml2 "github.com/mattermost/gosaml2"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func (g *hooksGRPCClient) OnDeactivate() error {
+	_returns := &Z_OnDeactivateReturns{}
+	if g.implemented[OnDeactivateID] {
+		if err := g.runHook("OnDeactivate", []any{}, _returns); err != nil {
+			g.log.Error("gRPC call OnDeactivate to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A
+}
+
+func (g *hooksGRPCClient) OnConfigurationChange() error {
+	_returns := &Z_OnConfigurationChangeReturns{}
+	if g.implemented[OnConfigurationChangeID] {
+		if err := g.runHook("OnConfigurationChange", []any{}, _returns); err != nil {
+			g.log.Error("gRPC call OnConfigurationChange to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A
+}
+
+func (g *hooksGRPCClient) ExecuteCommand(c *Context, args *model.CommandArgs) (*model.CommandResponse, *model.AppError) {
+	_returns := &Z_ExecuteCommandReturns{}
+	if g.implemented[ExecuteCommandID] {
+		if err := g.runHook("ExecuteCommand", []any{c, args}, _returns); err != nil {
+			g.log.Error("gRPC call ExecuteCommand to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A, _returns.B
+}
+
+func (g *hooksGRPCClient) UserHasBeenCreated(c *Context, user *model.User) {
+	_returns := &Z_UserHasBeenCreatedReturns{}
+	if g.implemented[UserHasBeenCreatedID] {
+		if err := g.runHook("UserHasBeenCreated", []any{c, user}, _returns); err != nil {
+			g.log.Error("gRPC call UserHasBeenCreated to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) UserWillLogIn(c *Context, user *model.User) string {
+	_returns := &Z_UserWillLogInReturns{}
+	if g.implemented[UserWillLogInID] {
+		if err := g.runHook("UserWillLogIn", []any{c, user}, _returns); err != nil {
+			g.log.Error("gRPC call UserWillLogIn to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A
+}
+
+func (g *hooksGRPCClient) UserHasLoggedIn(c *Context, user *model.User) {
+	_returns := &Z_UserHasLoggedInReturns{}
+	if g.implemented[UserHasLoggedInID] {
+		if err := g.runHook("UserHasLoggedIn", []any{c, user}, _returns); err != nil {
+			g.log.Error("gRPC call UserHasLoggedIn to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) MessageHasBeenPosted(c *Context, post *model.Post) {
+	_returns := &Z_MessageHasBeenPostedReturns{}
+	if g.implemented[MessageHasBeenPostedID] {
+		if err := g.runHook("MessageHasBeenPosted", []any{c, post}, _returns); err != nil {
+			g.log.Error("gRPC call MessageHasBeenPosted to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) MessageHasBeenUpdated(c *Context, newPost, oldPost *model.Post) {
+	_returns := &Z_MessageHasBeenUpdatedReturns{}
+	if g.implemented[MessageHasBeenUpdatedID] {
+		if err := g.runHook("MessageHasBeenUpdated", []any{c, newPost, oldPost}, _returns); err != nil {
+			g.log.Error("gRPC call MessageHasBeenUpdated to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) MessageHasBeenDeleted(c *Context, post *model.Post) {
+	_returns := &Z_MessageHasBeenDeletedReturns{}
+	if g.implemented[MessageHasBeenDeletedID] {
+		if err := g.runHook("MessageHasBeenDeleted", []any{c, post}, _returns); err != nil {
+			g.log.Error("gRPC call MessageHasBeenDeleted to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) ChannelWillBeCreated(c *Context, channel *model.Channel) (*model.Channel, string) {
+	_returns := &Z_ChannelWillBeCreatedReturns{}
+	if g.implemented[ChannelWillBeCreatedID] {
+		if err := g.runHook("ChannelWillBeCreated", []any{c, channel}, _returns); err != nil {
+			g.log.Error("gRPC call ChannelWillBeCreated to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A, _returns.B
+}
+
+func (g *hooksGRPCClient) ChannelHasBeenCreated(c *Context, channel *model.Channel) {
+	_returns := &Z_ChannelHasBeenCreatedReturns{}
+	if g.implemented[ChannelHasBeenCreatedID] {
+		if err := g.runHook("ChannelHasBeenCreated", []any{c, channel}, _returns); err != nil {
+			g.log.Error("gRPC call ChannelHasBeenCreated to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) ChannelHasBeenUpdated(c *Context, newChannel, oldChannel *model.Channel) {
+	_returns := &Z_ChannelHasBeenUpdatedReturns{}
+	if g.implemented[ChannelHasBeenUpdatedID] {
+		if err := g.runHook("ChannelHasBeenUpdated", []any{c, newChannel, oldChannel}, _returns); err != nil {
+			g.log.Error("gRPC call ChannelHasBeenUpdated to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) ChannelHasBeenArchived(c *Context, channel *model.Channel, actor *model.User) {
+	_returns := &Z_ChannelHasBeenArchivedReturns{}
+	if g.implemented[ChannelHasBeenArchivedID] {
+		if err := g.runHook("ChannelHasBeenArchived", []any{c, channel, actor}, _returns); err != nil {
+			g.log.Error("gRPC call ChannelHasBeenArchived to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) ChannelHasBeenRestored(c *Context, channel *model.Channel, actor *model.User) {
+	_returns := &Z_ChannelHasBeenRestoredReturns{}
+	if g.implemented[ChannelHasBeenRestoredID] {
+		if err := g.runHook("ChannelHasBeenRestored", []any{c, channel, actor}, _returns); err != nil {
+			g.log.Error("gRPC call ChannelHasBeenRestored to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) ChannelHasBeenDeleted(c *Context, channel *model.Channel) {
+	_returns := &Z_ChannelHasBeenDeletedReturns{}
+	if g.implemented[ChannelHasBeenDeletedID] {
+		if err := g.runHook("ChannelHasBeenDeleted", []any{c, channel}, _returns); err != nil {
+			g.log.Error("gRPC call ChannelHasBeenDeleted to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) UserHasJoinedChannel(c *Context, channelMember *model.ChannelMember, actor *model.User) {
+	_returns := &Z_UserHasJoinedChannelReturns{}
+	if g.implemented[UserHasJoinedChannelID] {
+		if err := g.runHook("UserHasJoinedChannel", []any{c, channelMember, actor}, _returns); err != nil {
+			g.log.Error("gRPC call UserHasJoinedChannel to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) UserHasLeftChannel(c *Context, channelMember *model.ChannelMember, actor *model.User) {
+	_returns := &Z_UserHasLeftChannelReturns{}
+	if g.implemented[UserHasLeftChannelID] {
+		if err := g.runHook("UserHasLeftChannel", []any{c, channelMember, actor}, _returns); err != nil {
+			g.log.Error("gRPC call UserHasLeftChannel to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) UserHasJoinedTeam(c *Context, teamMember *model.TeamMember, actor *model.User) {
+	_returns := &Z_UserHasJoinedTeamReturns{}
+	if g.implemented[UserHasJoinedTeamID] {
+		if err := g.runHook("UserHasJoinedTeam", []any{c, teamMember, actor}, _returns); err != nil {
+			g.log.Error("gRPC call UserHasJoinedTeam to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) UserHasLeftTeam(c *Context, teamMember *model.TeamMember, actor *model.User) {
+	_returns := &Z_UserHasLeftTeamReturns{}
+	if g.implemented[UserHasLeftTeamID] {
+		if err := g.runHook("UserHasLeftTeam", []any{c, teamMember, actor}, _returns); err != nil {
+			g.log.Error("gRPC call UserHasLeftTeam to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) TeamHasBeenCreated(c *Context, team *model.Team) {
+	_returns := &Z_TeamHasBeenCreatedReturns{}
+	if g.implemented[TeamHasBeenCreatedID] {
+		if err := g.runHook("TeamHasBeenCreated", []any{c, team}, _returns); err != nil {
+			g.log.Error("gRPC call TeamHasBeenCreated to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) TeamHasBeenUpdated(c *Context, newTeam, oldTeam *model.Team) {
+	_returns := &Z_TeamHasBeenUpdatedReturns{}
+	if g.implemented[TeamHasBeenUpdatedID] {
+		if err := g.runHook("TeamHasBeenUpdated", []any{c, newTeam, oldTeam}, _returns); err != nil {
+			g.log.Error("gRPC call TeamHasBeenUpdated to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) UserHasJoinedGroup(c *Context, groupMember *model.GroupMember, actor *model.User) {
+	_returns := &Z_UserHasJoinedGroupReturns{}
+	if g.implemented[UserHasJoinedGroupID] {
+		if err := g.runHook("UserHasJoinedGroup", []any{c, groupMember, actor}, _returns); err != nil {
+			g.log.Error("gRPC call UserHasJoinedGroup to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) UserHasLeftGroup(c *Context, groupMember *model.GroupMember, actor *model.User) {
+	_returns := &Z_UserHasLeftGroupReturns{}
+	if g.implemented[UserHasLeftGroupID] {
+		if err := g.runHook("UserHasLeftGroup", []any{c, groupMember, actor}, _returns); err != nil {
+			g.log.Error("gRPC call UserHasLeftGroup to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) ReactionHasBeenAdded(c *Context, reaction *model.Reaction) {
+	_returns := &Z_ReactionHasBeenAddedReturns{}
+	if g.implemented[ReactionHasBeenAddedID] {
+		if err := g.runHook("ReactionHasBeenAdded", []any{c, reaction}, _returns); err != nil {
+			g.log.Error("gRPC call ReactionHasBeenAdded to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) ReactionHasBeenRemoved(c *Context, reaction *model.Reaction) {
+	_returns := &Z_ReactionHasBeenRemovedReturns{}
+	if g.implemented[ReactionHasBeenRemovedID] {
+		if err := g.runHook("ReactionHasBeenRemoved", []any{c, reaction}, _returns); err != nil {
+			g.log.Error("gRPC call ReactionHasBeenRemoved to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) OnPluginClusterEvent(c *Context, ev model.PluginClusterEvent) {
+	_returns := &Z_OnPluginClusterEventReturns{}
+	if g.implemented[OnPluginClusterEventID] {
+		if err := g.runHook("OnPluginClusterEvent", []any{c, ev}, _returns); err != nil {
+			g.log.Error("gRPC call OnPluginClusterEvent to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) OnWebSocketConnect(webConnID, userID string) {
+	_returns := &Z_OnWebSocketConnectReturns{}
+	if g.implemented[OnWebSocketConnectID] {
+		if err := g.runHook("OnWebSocketConnect", []any{webConnID, userID}, _returns); err != nil {
+			g.log.Error("gRPC call OnWebSocketConnect to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) OnWebSocketDisconnect(webConnID, userID string) {
+	_returns := &Z_OnWebSocketDisconnectReturns{}
+	if g.implemented[OnWebSocketDisconnectID] {
+		if err := g.runHook("OnWebSocketDisconnect", []any{webConnID, userID}, _returns); err != nil {
+			g.log.Error("gRPC call OnWebSocketDisconnect to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) WebSocketMessageHasBeenPosted(webConnID, userID string, req *model.WebSocketRequest) {
+	_returns := &Z_WebSocketMessageHasBeenPostedReturns{}
+	if g.implemented[WebSocketMessageHasBeenPostedID] {
+		if err := g.runHook("WebSocketMessageHasBeenPosted", []any{webConnID, userID, req}, _returns); err != nil {
+			g.log.Error("gRPC call WebSocketMessageHasBeenPosted to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) RunDataRetention(nowTime, batchSize int64) (int64, error) {
+	_returns := &Z_RunDataRetentionReturns{}
+	if g.implemented[RunDataRetentionID] {
+		if err := g.runHook("RunDataRetention", []any{nowTime, batchSize}, _returns); err != nil {
+			g.log.Error("gRPC call RunDataRetention to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A, _returns.B
+}
+
+func (g *hooksGRPCClient) OnInstall(c *Context, event model.OnInstallEvent) error {
+	_returns := &Z_OnInstallReturns{}
+	if g.implemented[OnInstallID] {
+		if err := g.runHook("OnInstall", []any{c, event}, _returns); err != nil {
+			g.log.Error("gRPC call OnInstall to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A
+}
+
+func (g *hooksGRPCClient) OnSendDailyTelemetry() {
+	_returns := &Z_OnSendDailyTelemetryReturns{}
+	if g.implemented[OnSendDailyTelemetryID] {
+		if err := g.runHook("OnSendDailyTelemetry", []any{}, _returns); err != nil {
+			g.log.Error("gRPC call OnSendDailyTelemetry to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) OnCloudLimitsUpdated(limits *model.ProductLimits) {
+	_returns := &Z_OnCloudLimitsUpdatedReturns{}
+	if g.implemented[OnCloudLimitsUpdatedID] {
+		if err := g.runHook("OnCloudLimitsUpdated", []any{limits}, _returns); err != nil {
+			g.log.Error("gRPC call OnCloudLimitsUpdated to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) ConfigurationWillBeSaved(newCfg *model.Config) (*model.Config, error) {
+	_returns := &Z_ConfigurationWillBeSavedReturns{}
+	if g.implemented[ConfigurationWillBeSavedID] {
+		if err := g.runHook("ConfigurationWillBeSaved", []any{newCfg}, _returns); err != nil {
+			g.log.Error("gRPC call ConfigurationWillBeSaved to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A, _returns.B
+}
+
+func (g *hooksGRPCClient) EmailNotificationWillBeSent(emailNotification *model.EmailNotification) (*model.EmailNotificationContent, string) {
+	_returns := &Z_EmailNotificationWillBeSentReturns{}
+	if g.implemented[EmailNotificationWillBeSentID] {
+		if err := g.runHook("EmailNotificationWillBeSent", []any{emailNotification}, _returns); err != nil {
+			g.log.Error("gRPC call EmailNotificationWillBeSent to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A, _returns.B
+}
+
+func (g *hooksGRPCClient) NotificationWillBePushed(pushNotification *model.PushNotification, userID string) (*model.PushNotification, string) {
+	_returns := &Z_NotificationWillBePushedReturns{}
+	if g.implemented[NotificationWillBePushedID] {
+		if err := g.runHook("NotificationWillBePushed", []any{pushNotification, userID}, _returns); err != nil {
+			g.log.Error("gRPC call NotificationWillBePushed to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A, _returns.B
+}
+
+func (g *hooksGRPCClient) UserHasBeenDeactivated(c *Context, user *model.User) {
+	_returns := &Z_UserHasBeenDeactivatedReturns{}
+	if g.implemented[UserHasBeenDeactivatedID] {
+		if err := g.runHook("UserHasBeenDeactivated", []any{c, user}, _returns); err != nil {
+			g.log.Error("gRPC call UserHasBeenDeactivated to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) OnSharedChannelsSyncMsg(msg *model.SyncMsg, rc *model.RemoteCluster) (model.SyncResponse, error) {
+	_returns := &Z_OnSharedChannelsSyncMsgReturns{}
+	if g.implemented[OnSharedChannelsSyncMsgID] {
+		if err := g.runHook("OnSharedChannelsSyncMsg", []any{msg, rc}, _returns); err != nil {
+			g.log.Error("gRPC call OnSharedChannelsSyncMsg to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A, _returns.B
+}
+
+func (g *hooksGRPCClient) OnSharedChannelsPing(rc *model.RemoteCluster) bool {
+	_returns := &Z_OnSharedChannelsPingReturns{}
+	if g.implemented[OnSharedChannelsPingID] {
+		if err := g.runHook("OnSharedChannelsPing", []any{rc}, _returns); err != nil {
+			g.log.Error("gRPC call OnSharedChannelsPing to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A
+}
+
+func (g *hooksGRPCClient) PreferencesHaveChanged(c *Context, preferences []model.Preference) {
+	_returns := &Z_PreferencesHaveChangedReturns{}
+	if g.implemented[PreferencesHaveChangedID] {
+		if err := g.runHook("PreferencesHaveChanged", []any{c, preferences}, _returns); err != nil {
+			g.log.Error("gRPC call PreferencesHaveChanged to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (g *hooksGRPCClient) OnSharedChannelsAttachmentSyncMsg(fi *model.FileInfo, post *model.Post, rc *model.RemoteCluster) error {
+	_returns := &Z_OnSharedChannelsAttachmentSyncMsgReturns{}
+	if g.implemented[OnSharedChannelsAttachmentSyncMsgID] {
+		if err := g.runHook("OnSharedChannelsAttachmentSyncMsg", []any{fi, post, rc}, _returns); err != nil {
+			g.log.Error("gRPC call OnSharedChannelsAttachmentSyncMsg to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A
+}
+
+func (g *hooksGRPCClient) OnSharedChannelsProfileImageSyncMsg(user *model.User, rc *model.RemoteCluster) error {
+	_returns := &Z_OnSharedChannelsProfileImageSyncMsgReturns{}
+	if g.implemented[OnSharedChannelsProfileImageSyncMsgID] {
+		if err := g.runHook("OnSharedChannelsProfileImageSyncMsg", []any{user, rc}, _returns); err != nil {
+			g.log.Error("gRPC call OnSharedChannelsProfileImageSyncMsg to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A
+}
+
+func (g *hooksGRPCClient) GenerateSupportData(c *Context) ([]*model.FileData, error) {
+	_returns := &Z_GenerateSupportDataReturns{}
+	if g.implemented[GenerateSupportDataID] {
+		if err := g.runHook("GenerateSupportData", []any{c}, _returns); err != nil {
+			g.log.Error("gRPC call GenerateSupportData to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A, _returns.B
+}
+
+func (g *hooksGRPCClient) OnSAMLLogin(c *Context, user *model.User, assertion *saml2.AssertionInfo) error {
+	_returns := &Z_OnSAMLLoginReturns{}
+	if g.implemented[OnSAMLLoginID] {
+		if err := g.runHook("OnSAMLLogin", []any{c, user, assertion}, _returns); err != nil {
+			g.log.Error("gRPC call OnSAMLLogin to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A
+}
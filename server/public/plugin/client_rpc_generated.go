@@ -325,6 +325,42 @@ func (s *hooksRPCServer) MessageHasBeenDeleted(args *Z_MessageHasBeenDeletedArgs
 	return nil
 }
 
+func init() {
+	hookNameToId["ChannelWillBeCreated"] = ChannelWillBeCreatedID
+}
+
+type Z_ChannelWillBeCreatedArgs struct {
+	A *Context
+	B *model.Channel
+}
+
+type Z_ChannelWillBeCreatedReturns struct {
+	A *model.Channel
+	B string
+}
+
+func (g *hooksRPCClient) ChannelWillBeCreated(c *Context, channel *model.Channel) (*model.Channel, string) {
+	_args := &Z_ChannelWillBeCreatedArgs{c, channel}
+	_returns := &Z_ChannelWillBeCreatedReturns{}
+	if g.implemented[ChannelWillBeCreatedID] {
+		if err := g.client.Call("Plugin.ChannelWillBeCreated", _args, _returns); err != nil {
+			g.log.Error("RPC call ChannelWillBeCreated to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A, _returns.B
+}
+
+func (s *hooksRPCServer) ChannelWillBeCreated(args *Z_ChannelWillBeCreatedArgs, returns *Z_ChannelWillBeCreatedReturns) error {
+	if hook, ok := s.impl.(interface {
+		ChannelWillBeCreated(c *Context, channel *model.Channel) (*model.Channel, string)
+	}); ok {
+		returns.A, returns.B = hook.ChannelWillBeCreated(args.A, args.B)
+	} else {
+		return encodableError(fmt.Errorf("Hook ChannelWillBeCreated called but not implemented."))
+	}
+	return nil
+}
+
 func init() {
 	hookNameToId["ChannelHasBeenCreated"] = ChannelHasBeenCreatedID
 }
@@ -359,6 +395,145 @@ func (s *hooksRPCServer) ChannelHasBeenCreated(args *Z_ChannelHasBeenCreatedArgs
 	return nil
 }
 
+func init() {
+	hookNameToId["ChannelHasBeenUpdated"] = ChannelHasBeenUpdatedID
+}
+
+type Z_ChannelHasBeenUpdatedArgs struct {
+	A *Context
+	B *model.Channel
+	C *model.Channel
+}
+
+type Z_ChannelHasBeenUpdatedReturns struct {
+}
+
+func (g *hooksRPCClient) ChannelHasBeenUpdated(c *Context, newChannel, oldChannel *model.Channel) {
+	_args := &Z_ChannelHasBeenUpdatedArgs{c, newChannel, oldChannel}
+	_returns := &Z_ChannelHasBeenUpdatedReturns{}
+	if g.implemented[ChannelHasBeenUpdatedID] {
+		if err := g.client.Call("Plugin.ChannelHasBeenUpdated", _args, _returns); err != nil {
+			g.log.Error("RPC call ChannelHasBeenUpdated to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) ChannelHasBeenUpdated(args *Z_ChannelHasBeenUpdatedArgs, returns *Z_ChannelHasBeenUpdatedReturns) error {
+	if hook, ok := s.impl.(interface {
+		ChannelHasBeenUpdated(c *Context, newChannel, oldChannel *model.Channel)
+	}); ok {
+		hook.ChannelHasBeenUpdated(args.A, args.B, args.C)
+	} else {
+		return encodableError(fmt.Errorf("Hook ChannelHasBeenUpdated called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["ChannelHasBeenArchived"] = ChannelHasBeenArchivedID
+}
+
+type Z_ChannelHasBeenArchivedArgs struct {
+	A *Context
+	B *model.Channel
+	C *model.User
+}
+
+type Z_ChannelHasBeenArchivedReturns struct {
+}
+
+func (g *hooksRPCClient) ChannelHasBeenArchived(c *Context, channel *model.Channel, actor *model.User) {
+	_args := &Z_ChannelHasBeenArchivedArgs{c, channel, actor}
+	_returns := &Z_ChannelHasBeenArchivedReturns{}
+	if g.implemented[ChannelHasBeenArchivedID] {
+		if err := g.client.Call("Plugin.ChannelHasBeenArchived", _args, _returns); err != nil {
+			g.log.Error("RPC call ChannelHasBeenArchived to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) ChannelHasBeenArchived(args *Z_ChannelHasBeenArchivedArgs, returns *Z_ChannelHasBeenArchivedReturns) error {
+	if hook, ok := s.impl.(interface {
+		ChannelHasBeenArchived(c *Context, channel *model.Channel, actor *model.User)
+	}); ok {
+		hook.ChannelHasBeenArchived(args.A, args.B, args.C)
+	} else {
+		return encodableError(fmt.Errorf("Hook ChannelHasBeenArchived called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["ChannelHasBeenRestored"] = ChannelHasBeenRestoredID
+}
+
+type Z_ChannelHasBeenRestoredArgs struct {
+	A *Context
+	B *model.Channel
+	C *model.User
+}
+
+type Z_ChannelHasBeenRestoredReturns struct {
+}
+
+func (g *hooksRPCClient) ChannelHasBeenRestored(c *Context, channel *model.Channel, actor *model.User) {
+	_args := &Z_ChannelHasBeenRestoredArgs{c, channel, actor}
+	_returns := &Z_ChannelHasBeenRestoredReturns{}
+	if g.implemented[ChannelHasBeenRestoredID] {
+		if err := g.client.Call("Plugin.ChannelHasBeenRestored", _args, _returns); err != nil {
+			g.log.Error("RPC call ChannelHasBeenRestored to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) ChannelHasBeenRestored(args *Z_ChannelHasBeenRestoredArgs, returns *Z_ChannelHasBeenRestoredReturns) error {
+	if hook, ok := s.impl.(interface {
+		ChannelHasBeenRestored(c *Context, channel *model.Channel, actor *model.User)
+	}); ok {
+		hook.ChannelHasBeenRestored(args.A, args.B, args.C)
+	} else {
+		return encodableError(fmt.Errorf("Hook ChannelHasBeenRestored called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["ChannelHasBeenDeleted"] = ChannelHasBeenDeletedID
+}
+
+type Z_ChannelHasBeenDeletedArgs struct {
+	A *Context
+	B *model.Channel
+}
+
+type Z_ChannelHasBeenDeletedReturns struct {
+}
+
+func (g *hooksRPCClient) ChannelHasBeenDeleted(c *Context, channel *model.Channel) {
+	_args := &Z_ChannelHasBeenDeletedArgs{c, channel}
+	_returns := &Z_ChannelHasBeenDeletedReturns{}
+	if g.implemented[ChannelHasBeenDeletedID] {
+		if err := g.client.Call("Plugin.ChannelHasBeenDeleted", _args, _returns); err != nil {
+			g.log.Error("RPC call ChannelHasBeenDeleted to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) ChannelHasBeenDeleted(args *Z_ChannelHasBeenDeletedArgs, returns *Z_ChannelHasBeenDeletedReturns) error {
+	if hook, ok := s.impl.(interface {
+		ChannelHasBeenDeleted(c *Context, channel *model.Channel)
+	}); ok {
+		hook.ChannelHasBeenDeleted(args.A, args.B)
+	} else {
+		return encodableError(fmt.Errorf("Hook ChannelHasBeenDeleted called but not implemented."))
+	}
+	return nil
+}
+
 func init() {
 	hookNameToId["UserHasJoinedChannel"] = UserHasJoinedChannelID
 }
@@ -499,6 +674,145 @@ func (s *hooksRPCServer) UserHasLeftTeam(args *Z_UserHasLeftTeamArgs, returns *Z
 	return nil
 }
 
+func init() {
+	hookNameToId["TeamHasBeenCreated"] = TeamHasBeenCreatedID
+}
+
+type Z_TeamHasBeenCreatedArgs struct {
+	A *Context
+	B *model.Team
+}
+
+type Z_TeamHasBeenCreatedReturns struct {
+}
+
+func (g *hooksRPCClient) TeamHasBeenCreated(c *Context, team *model.Team) {
+	_args := &Z_TeamHasBeenCreatedArgs{c, team}
+	_returns := &Z_TeamHasBeenCreatedReturns{}
+	if g.implemented[TeamHasBeenCreatedID] {
+		if err := g.client.Call("Plugin.TeamHasBeenCreated", _args, _returns); err != nil {
+			g.log.Error("RPC call TeamHasBeenCreated to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) TeamHasBeenCreated(args *Z_TeamHasBeenCreatedArgs, returns *Z_TeamHasBeenCreatedReturns) error {
+	if hook, ok := s.impl.(interface {
+		TeamHasBeenCreated(c *Context, team *model.Team)
+	}); ok {
+		hook.TeamHasBeenCreated(args.A, args.B)
+	} else {
+		return encodableError(fmt.Errorf("Hook TeamHasBeenCreated called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["TeamHasBeenUpdated"] = TeamHasBeenUpdatedID
+}
+
+type Z_TeamHasBeenUpdatedArgs struct {
+	A *Context
+	B *model.Team
+	C *model.Team
+}
+
+type Z_TeamHasBeenUpdatedReturns struct {
+}
+
+func (g *hooksRPCClient) TeamHasBeenUpdated(c *Context, newTeam, oldTeam *model.Team) {
+	_args := &Z_TeamHasBeenUpdatedArgs{c, newTeam, oldTeam}
+	_returns := &Z_TeamHasBeenUpdatedReturns{}
+	if g.implemented[TeamHasBeenUpdatedID] {
+		if err := g.client.Call("Plugin.TeamHasBeenUpdated", _args, _returns); err != nil {
+			g.log.Error("RPC call TeamHasBeenUpdated to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) TeamHasBeenUpdated(args *Z_TeamHasBeenUpdatedArgs, returns *Z_TeamHasBeenUpdatedReturns) error {
+	if hook, ok := s.impl.(interface {
+		TeamHasBeenUpdated(c *Context, newTeam, oldTeam *model.Team)
+	}); ok {
+		hook.TeamHasBeenUpdated(args.A, args.B, args.C)
+	} else {
+		return encodableError(fmt.Errorf("Hook TeamHasBeenUpdated called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["UserHasJoinedGroup"] = UserHasJoinedGroupID
+}
+
+type Z_UserHasJoinedGroupArgs struct {
+	A *Context
+	B *model.GroupMember
+	C *model.User
+}
+
+type Z_UserHasJoinedGroupReturns struct {
+}
+
+func (g *hooksRPCClient) UserHasJoinedGroup(c *Context, groupMember *model.GroupMember, actor *model.User) {
+	_args := &Z_UserHasJoinedGroupArgs{c, groupMember, actor}
+	_returns := &Z_UserHasJoinedGroupReturns{}
+	if g.implemented[UserHasJoinedGroupID] {
+		if err := g.client.Call("Plugin.UserHasJoinedGroup", _args, _returns); err != nil {
+			g.log.Error("RPC call UserHasJoinedGroup to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) UserHasJoinedGroup(args *Z_UserHasJoinedGroupArgs, returns *Z_UserHasJoinedGroupReturns) error {
+	if hook, ok := s.impl.(interface {
+		UserHasJoinedGroup(c *Context, groupMember *model.GroupMember, actor *model.User)
+	}); ok {
+		hook.UserHasJoinedGroup(args.A, args.B, args.C)
+	} else {
+		return encodableError(fmt.Errorf("Hook UserHasJoinedGroup called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["UserHasLeftGroup"] = UserHasLeftGroupID
+}
+
+type Z_UserHasLeftGroupArgs struct {
+	A *Context
+	B *model.GroupMember
+	C *model.User
+}
+
+type Z_UserHasLeftGroupReturns struct {
+}
+
+func (g *hooksRPCClient) UserHasLeftGroup(c *Context, groupMember *model.GroupMember, actor *model.User) {
+	_args := &Z_UserHasLeftGroupArgs{c, groupMember, actor}
+	_returns := &Z_UserHasLeftGroupReturns{}
+	if g.implemented[UserHasLeftGroupID] {
+		if err := g.client.Call("Plugin.UserHasLeftGroup", _args, _returns); err != nil {
+			g.log.Error("RPC call UserHasLeftGroup to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) UserHasLeftGroup(args *Z_UserHasLeftGroupArgs, returns *Z_UserHasLeftGroupReturns) error {
+	if hook, ok := s.impl.(interface {
+		UserHasLeftGroup(c *Context, groupMember *model.GroupMember, actor *model.User)
+	}); ok {
+		hook.UserHasLeftGroup(args.A, args.B, args.C)
+	} else {
+		return encodableError(fmt.Errorf("Hook UserHasLeftGroup called but not implemented."))
+	}
+	return nil
+}
+
 func init() {
 	hookNameToId["ReactionHasBeenAdded"] = ReactionHasBeenAddedID
 }
@@ -0,0 +1,638 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/grpcproto"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// The gRPC transport lets plugins written in any language implement the Hooks and call the API
+// described in grpcproto/mattermost_plugin.proto. Its messages are generated from the Hooks and
+// API interfaces, and converted from and to the arguments and results of the Go methods by
+// encodeGRPCMessage and decodeGRPCMessage.
+//
+// The database driver is not available to gRPC plugins.
+
+// hooksGRPCPlugin implements the hashicorp/go-plugin/plugin.GRPCPlugin interface to connect to
+// the hooks of a plugin speaking the gRPC protocol.
+type hooksGRPCPlugin struct {
+	plugin.NetRPCUnsupportedPlugin
+	apiImpl API
+	log     *mlog.Logger
+}
+
+func (p *hooksGRPCPlugin) GRPCServer(_ *plugin.GRPCBroker, _ *grpc.Server) error {
+	return errors.New("serving plugin hooks over gRPC is not supported by the Go plugin package")
+}
+
+func (p *hooksGRPCPlugin) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, conn *grpc.ClientConn) (any, error) {
+	return newHooksGRPCClient(conn, p.log, p.apiImpl), nil
+}
+
+type hooksGRPCClient struct {
+	conn        grpc.ClientConnInterface
+	client      grpcproto.HooksClient
+	log         *mlog.Logger
+	apiImpl     API
+	implemented [TotalHooksID]bool
+	doneWg      sync.WaitGroup
+
+	// localAPI is set when the plugin calls the API in-process rather than through the API service.
+	localAPI bool
+
+	apiServerLock sync.Mutex
+	apiServer     *grpc.Server
+	apiServerDir  string
+}
+
+func newHooksGRPCClient(conn grpc.ClientConnInterface, log *mlog.Logger, apiImpl API) *hooksGRPCClient {
+	return &hooksGRPCClient{
+		conn:    conn,
+		client:  grpcproto.NewHooksClient(conn),
+		log:     log,
+		apiImpl: apiImpl,
+	}
+}
+
+type apiGRPCServer struct {
+	impl API
+
+	// allowed, when set, restricts the API methods the plugin may call.
+	allowed func(method string) bool
+}
+
+var (
+	_ plugin.GRPCPlugin = &hooksGRPCPlugin{}
+	_ Hooks             = &hooksGRPCClient{}
+
+	errorType    = reflect.TypeOf((*error)(nil)).Elem()
+	appErrorType = reflect.TypeOf((*model.AppError)(nil))
+	readerType   = reflect.TypeOf((*io.Reader)(nil)).Elem()
+)
+
+// toGRPCError converts an error returned by a hook or an API method to its gRPC message.
+func toGRPCError(err error) *grpcproto.Error {
+	if err == nil {
+		return nil
+	}
+	if appErr, ok := err.(*model.AppError); ok {
+		return &grpcproto.Error{
+			Message:       appErr.Message,
+			Id:            appErr.Id,
+			DetailedError: appErr.DetailedError,
+			StatusCode:    int32(appErr.StatusCode),
+			Where:         appErr.Where,
+		}
+	}
+	return &grpcproto.Error{Message: err.Error()}
+}
+
+// fromGRPCError converts an error received from a plugin, returning a *model.AppError when an id is set.
+func fromGRPCError(grpcErr *grpcproto.Error) error {
+	if grpcErr == nil {
+		return nil
+	}
+	if grpcErr.Id != "" {
+		return appErrorFromGRPC(grpcErr)
+	}
+	return errors.New(grpcErr.Message)
+}
+
+func appErrorFromGRPC(grpcErr *grpcproto.Error) *model.AppError {
+	statusCode := int(grpcErr.StatusCode)
+	if statusCode == 0 {
+		statusCode = http.StatusInternalServerError
+	}
+	return &model.AppError{
+		Id:            grpcErr.Id,
+		Message:       grpcErr.Message,
+		DetailedError: grpcErr.DetailedError,
+		StatusCode:    statusCode,
+		Where:         grpcErr.Where,
+	}
+}
+
+// grpcMessage returns a new message of the given type of the gRPC protocol.
+func grpcMessage(desc protoreflect.MessageDescriptor) (protoreflect.Message, error) {
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(desc.FullName())
+	if err != nil {
+		return nil, err
+	}
+	return messageType.New(), nil
+}
+
+// isGRPCJSONField returns whether the field holds the JSON representation of a Go value.
+func isGRPCJSONField(field protoreflect.FieldDescriptor) bool {
+	return field.Kind() == protoreflect.StringKind && strings.HasSuffix(string(field.Name()), "_json")
+}
+
+// encodeGRPCMessage sets the fields of a request or a response from the arguments or results
+// of a call, in order. Errors are set in the error field.
+func encodeGRPCMessage(msg protoreflect.Message, values []reflect.Value) error {
+	fields := msg.Descriptor().Fields()
+	next := 0
+	for _, value := range values {
+		if value.IsValid() && (value.Type() == errorType || value.Type() == appErrorType) {
+			if !value.IsNil() {
+				grpcErr := toGRPCError(value.Interface().(error))
+				msg.Set(fields.ByName("error"), protoreflect.ValueOfMessage(grpcErr.ProtoReflect()))
+			}
+			continue
+		}
+
+		if next >= fields.Len() {
+			return fmt.Errorf("unexpected value %d", next)
+		}
+		field := fields.Get(next)
+		next++
+		if !value.IsValid() {
+			continue
+		}
+		if err := setGRPCField(msg, field, value); err != nil {
+			return errors.Wrapf(err, "failed to encode %s", field.Name())
+		}
+	}
+
+	return nil
+}
+
+func setGRPCField(msg protoreflect.Message, field protoreflect.FieldDescriptor, value reflect.Value) error {
+	if field.IsList() {
+		list := msg.Mutable(field).List()
+		for i := 0; i < value.Len(); i++ {
+			list.Append(protoreflect.ValueOfString(value.Index(i).String()))
+		}
+		return nil
+	}
+
+	switch {
+	case isGRPCJSONField(field):
+		b, err := json.Marshal(value.Interface())
+		if err != nil {
+			return err
+		}
+		msg.Set(field, protoreflect.ValueOfString(string(b)))
+	case field.Kind() == protoreflect.StringKind:
+		msg.Set(field, protoreflect.ValueOfString(value.String()))
+	case field.Kind() == protoreflect.BoolKind:
+		msg.Set(field, protoreflect.ValueOfBool(value.Bool()))
+	case field.Kind() == protoreflect.Int64Kind:
+		msg.Set(field, protoreflect.ValueOfInt64(value.Int()))
+	case field.Kind() == protoreflect.Int32Kind:
+		msg.Set(field, protoreflect.ValueOfInt32(int32(value.Int())))
+	case field.Kind() == protoreflect.DoubleKind:
+		msg.Set(field, protoreflect.ValueOfFloat64(value.Float()))
+	case field.Kind() == protoreflect.BytesKind:
+		if reader, ok := value.Interface().(io.Reader); ok {
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return err
+			}
+			msg.Set(field, protoreflect.ValueOfBytes(b))
+			return nil
+		}
+		msg.Set(field, protoreflect.ValueOfBytes(value.Bytes()))
+	default:
+		return fmt.Errorf("unsupported field kind %s", field.Kind())
+	}
+	return nil
+}
+
+// decodeGRPCMessage returns the values of the given types from the fields of a request or a
+// response, in order. Values of type error are set from the error field.
+func decodeGRPCMessage(msg protoreflect.Message, types []reflect.Type) ([]reflect.Value, error) {
+	fields := msg.Descriptor().Fields()
+	var grpcErr *grpcproto.Error
+	if field := fields.ByName("error"); field != nil && msg.Has(field) {
+		grpcErr = msg.Get(field).Message().Interface().(*grpcproto.Error)
+	}
+
+	values := make([]reflect.Value, len(types))
+	next := 0
+	for i, t := range types {
+		values[i] = reflect.New(t).Elem()
+		switch t {
+		case errorType:
+			if err := fromGRPCError(grpcErr); err != nil {
+				values[i].Set(reflect.ValueOf(err))
+			}
+			continue
+		case appErrorType:
+			if grpcErr != nil {
+				values[i].Set(reflect.ValueOf(appErrorFromGRPC(grpcErr)))
+			}
+			continue
+		}
+
+		if next >= fields.Len() {
+			return nil, fmt.Errorf("unexpected value %d", next)
+		}
+		field := fields.Get(next)
+		next++
+
+		if err := getGRPCField(msg, field, values[i]); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode %s", field.Name())
+		}
+	}
+
+	return values, nil
+}
+
+func getGRPCField(msg protoreflect.Message, field protoreflect.FieldDescriptor, value reflect.Value) error {
+	if field.IsList() {
+		list := msg.Get(field).List()
+		if list.Len() == 0 {
+			return nil
+		}
+		value.Set(reflect.MakeSlice(value.Type(), list.Len(), list.Len()))
+		for i := 0; i < list.Len(); i++ {
+			value.Index(i).SetString(list.Get(i).String())
+		}
+		return nil
+	}
+
+	fieldValue := msg.Get(field)
+	switch {
+	case isGRPCJSONField(field):
+		if fieldValue.String() == "" {
+			return nil
+		}
+		return json.Unmarshal([]byte(fieldValue.String()), value.Addr().Interface())
+	case field.Kind() == protoreflect.StringKind:
+		value.SetString(fieldValue.String())
+	case field.Kind() == protoreflect.BoolKind:
+		value.SetBool(fieldValue.Bool())
+	case field.Kind() == protoreflect.Int64Kind, field.Kind() == protoreflect.Int32Kind:
+		value.SetInt(fieldValue.Int())
+	case field.Kind() == protoreflect.DoubleKind:
+		value.SetFloat(fieldValue.Float())
+	case field.Kind() == protoreflect.BytesKind:
+		if value.Type() == readerType {
+			value.Set(reflect.ValueOf(bytes.NewReader(fieldValue.Bytes())))
+			return nil
+		}
+		if b := fieldValue.Bytes(); b != nil {
+			value.SetBytes(b)
+		}
+	default:
+		return fmt.Errorf("unsupported field kind %s", field.Kind())
+	}
+	return nil
+}
+
+// runHook calls the hook of the given name with the arguments, setting the fields of the
+// returns struct from its results.
+func (g *hooksGRPCClient) runHook(name string, args []any, returns any) error {
+	method := grpcproto.File_mattermost_plugin_proto.Services().ByName("Hooks").Methods().ByName(protoreflect.Name(name))
+	if method == nil {
+		return fmt.Errorf("hook %s not found", name)
+	}
+	req, err := grpcMessage(method.Input())
+	if err != nil {
+		return err
+	}
+	resp, err := grpcMessage(method.Output())
+	if err != nil {
+		return err
+	}
+
+	values := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		values[i] = reflect.ValueOf(arg)
+	}
+	if err = encodeGRPCMessage(req, values); err != nil {
+		return errors.Wrap(err, "failed to encode arguments")
+	}
+
+	if err = g.conn.Invoke(context.Background(), "/"+grpcproto.Hooks_ServiceDesc.ServiceName+"/"+name, req.Interface(), resp.Interface()); err != nil {
+		return err
+	}
+
+	returnsValue := reflect.ValueOf(returns).Elem()
+	types := make([]reflect.Type, returnsValue.NumField())
+	for i := range types {
+		types[i] = returnsValue.Field(i).Type()
+	}
+	results, err := decodeGRPCMessage(resp, types)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode results")
+	}
+	for i, result := range results {
+		returnsValue.Field(i).Set(result)
+	}
+	return nil
+}
+
+func (g *hooksGRPCClient) Implemented() ([]string, error) {
+	resp, err := g.client.Implemented(context.Background(), &grpcproto.ImplementedRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, hookName := range resp.Hooks {
+		if hookId, ok := hookNameToId[hookName]; ok {
+			g.implemented[hookId] = true
+		}
+	}
+	return resp.Hooks, nil
+}
+
+// OnActivate starts serving the API on a unix socket in a private directory before activating the
+// plugin, unless the plugin calls the API in-process.
+func (g *hooksGRPCClient) OnActivate() error {
+	req := &grpcproto.OnActivateRequest{}
+	if !g.localAPI {
+		target, err := g.serveAPI()
+		if err != nil {
+			return err
+		}
+		req.ApiTarget = target
+	}
+
+	resp, err := g.client.OnActivate(context.Background(), req)
+	if err != nil {
+		g.log.Error("gRPC call OnActivate to plugin failed.", mlog.Err(err))
+		return nil
+	}
+	return fromGRPCError(resp.Error)
+}
+
+func (g *hooksGRPCClient) serveAPI() (string, error) {
+	dir, err := os.MkdirTemp("", "mattermost-plugin-api")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create API socket directory")
+	}
+	socketPath := filepath.Join(dir, "api.sock")
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		os.RemoveAll(dir)
+		return "", errors.Wrap(err, "failed to listen on API socket")
+	}
+
+	server := grpc.NewServer()
+	(&apiGRPCServer{impl: g.apiImpl}).register(server)
+
+	g.apiServerLock.Lock()
+	g.apiServer = server
+	g.apiServerDir = dir
+	g.apiServerLock.Unlock()
+
+	g.doneWg.Add(1)
+	go func() {
+		defer g.doneWg.Done()
+		if err := server.Serve(listener); err != nil {
+			g.log.Error("Plugin API gRPC server stopped.", mlog.Err(err))
+		}
+	}()
+
+	return "unix://" + socketPath, nil
+}
+
+// shutdown stops serving the API to the plugin and releases the resources of the client.
+func (g *hooksGRPCClient) shutdown() {
+	g.apiServerLock.Lock()
+	defer g.apiServerLock.Unlock()
+
+	if g.apiServer != nil {
+		g.apiServer.Stop()
+		os.RemoveAll(g.apiServerDir)
+		g.apiServer = nil
+	}
+
+	// The connection to other plugins is closed by the go-plugin client.
+	if wasmClient, ok := g.conn.(*wasmHooksClient); ok {
+		if err := wasmClient.Close(); err != nil {
+			g.log.Warn("Failed to close plugin client.", mlog.Err(err))
+		}
+	}
+}
+
+func (g *hooksGRPCClient) serveHTTP(call func(context.Context, *grpcproto.HTTPRequest, ...grpc.CallOption) (*grpcproto.HTTPResponse, error), c *Context, w http.ResponseWriter, r *http.Request) {
+	req := &grpcproto.HTTPRequest{
+		Method: r.Method,
+		Url:    r.URL.RequestURI(),
+	}
+
+	pluginContext, err := json.Marshal(c)
+	if err != nil {
+		g.log.Error("Failed to encode plugin context.", mlog.Err(err))
+		http.Error(w, "500 internal server error", http.StatusInternalServerError)
+		return
+	}
+	req.ContextJson = string(pluginContext)
+	if r.Body != nil {
+		if req.Body, err = io.ReadAll(r.Body); err != nil {
+			http.Error(w, "400 bad request", http.StatusBadRequest)
+			return
+		}
+	}
+	for name, values := range r.Header {
+		req.Headers = append(req.Headers, &grpcproto.HTTPHeader{Name: name, Values: values})
+	}
+
+	resp, err := call(r.Context(), req)
+	if err != nil {
+		g.log.Error("gRPC call ServeHTTP to plugin failed.", mlog.Err(err))
+		http.Error(w, "500 internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	for _, header := range resp.Headers {
+		for _, value := range header.Values {
+			w.Header().Add(header.Name, value)
+		}
+	}
+	statusCode := int(resp.StatusCode)
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+	w.WriteHeader(statusCode)
+	if _, err := w.Write(resp.Body); err != nil {
+		g.log.Warn("Failed to write plugin response.", mlog.Err(err))
+	}
+}
+
+func (g *hooksGRPCClient) ServeHTTP(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !g.implemented[ServeHTTPID] {
+		http.NotFound(w, r)
+		return
+	}
+	g.serveHTTP(g.client.ServeHTTP, c, w, r)
+}
+
+func (g *hooksGRPCClient) ServeMetrics(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !g.implemented[ServeMetricsID] {
+		http.NotFound(w, r)
+		return
+	}
+	g.serveHTTP(g.client.ServeMetrics, c, w, r)
+}
+
+// FileWillBeUploaded sends the content of the file as a byte array argument. The plugin returns
+// the replacement content, if any, as a third result.
+func (g *hooksGRPCClient) FileWillBeUploaded(c *Context, info *model.FileInfo, file io.Reader, output io.Writer) (*model.FileInfo, string) {
+	if !g.implemented[FileWillBeUploadedID] {
+		return info, ""
+	}
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		g.log.Error("Failed to read uploaded file for plugin.", mlog.Err(err))
+		return info, ""
+	}
+
+	_returns := &struct {
+		A *model.FileInfo
+		B string
+		C []byte
+	}{A: info}
+	if err := g.runHook("FileWillBeUploaded", []any{c, info, data}, _returns); err != nil {
+		g.log.Error("gRPC call FileWillBeUploaded to plugin failed.", mlog.Err(err))
+		return info, ""
+	}
+
+	if len(_returns.C) > 0 {
+		if _, err := output.Write(_returns.C); err != nil {
+			g.log.Error("Error writing replacement file.", mlog.Err(err))
+		}
+	}
+	return _returns.A, _returns.B
+}
+
+func (g *hooksGRPCClient) MessageWillBePosted(c *Context, post *model.Post) (*model.Post, string) {
+	_returns := &Z_MessageWillBePostedReturns{A: post}
+	if g.implemented[MessageWillBePostedID] {
+		if err := g.runHook("MessageWillBePosted", []any{c, post}, _returns); err != nil {
+			g.log.Error("gRPC call MessageWillBePosted to plugin failed.", mlog.Err(err))
+			return post, ""
+		}
+	}
+	return _returns.A, _returns.B
+}
+
+func (g *hooksGRPCClient) MessageWillBeUpdated(c *Context, newPost, oldPost *model.Post) (*model.Post, string) {
+	if g.implemented[MessageWillBeUpdatedID] {
+		_returns := &Z_MessageWillBeUpdatedReturns{}
+		if err := g.runHook("MessageWillBeUpdated", []any{c, newPost, oldPost}, _returns); err != nil {
+			g.log.Error("gRPC call MessageWillBeUpdated to plugin failed.", mlog.Err(err))
+			return newPost, ""
+		}
+		return _returns.A, _returns.B
+	}
+	return newPost, ""
+}
+
+func (g *hooksGRPCClient) MessagesWillBeConsumed(posts []*model.Post) []*model.Post {
+	_returns := &Z_MessagesWillBeConsumedReturns{}
+	if g.implemented[MessagesWillBeConsumedID] {
+		if err := g.runHook("MessagesWillBeConsumed", []any{posts}, _returns); err != nil {
+			g.log.Error("gRPC call MessagesWillBeConsumed to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A
+}
+
+// register registers the API service on the gRPC server. Its methods call the API method of the
+// same name.
+func (s *apiGRPCServer) register(server grpc.ServiceRegistrar) {
+	desc := grpc.ServiceDesc{
+		ServiceName: grpcproto.API_ServiceDesc.ServiceName,
+		HandlerType: (*any)(nil),
+		Metadata:    grpcproto.API_ServiceDesc.Metadata,
+	}
+	for _, method := range grpcproto.API_ServiceDesc.Methods {
+		methodName := method.MethodName
+		desc.Methods = append(desc.Methods, grpc.MethodDesc{
+			MethodName: methodName,
+			Handler: func(_ any, ctx context.Context, dec func(any) error, _ grpc.UnaryServerInterceptor) (any, error) {
+				return s.call(ctx, methodName, func(req proto.Message) error { return dec(req) })
+			},
+		})
+	}
+	server.RegisterService(&desc, s)
+}
+
+// call calls the API method of the given name with the request read by decode. LoadPluginConfiguration
+// returns the configuration as its result since the destination of the call can't be shared with the
+// plugin.
+func (s *apiGRPCServer) call(_ context.Context, methodName string, decode func(req proto.Message) error) (proto.Message, error) {
+	if s.allowed != nil && !s.allowed(methodName) {
+		return nil, status.Errorf(codes.PermissionDenied, "API %s not allowed", methodName)
+	}
+
+	method := grpcproto.File_mattermost_plugin_proto.Services().ByName("API").Methods().ByName(protoreflect.Name(methodName))
+	if method == nil {
+		return nil, status.Errorf(codes.Unimplemented, "API %s not found", methodName)
+	}
+	req, err := grpcMessage(method.Input())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err = decode(req.Interface()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "API %s: %s", methodName, err.Error())
+	}
+
+	if methodName == "LoadPluginConfiguration" {
+		var config any
+		err = s.impl.LoadPluginConfiguration(&config)
+		b, jsonErr := json.Marshal(config)
+		if jsonErr != nil {
+			return nil, status.Error(codes.Internal, jsonErr.Error())
+		}
+		return &grpcproto.LoadPluginConfigurationResponse{ConfigurationJson: string(b), Error: toGRPCError(err)}, nil
+	}
+
+	fn := reflect.ValueOf(s.impl).MethodByName(methodName)
+	if !fn.IsValid() {
+		return nil, status.Errorf(codes.Unimplemented, "API %s not found", methodName)
+	}
+	fnType := fn.Type()
+	types := make([]reflect.Type, fnType.NumIn())
+	for i := range types {
+		types[i] = fnType.In(i)
+	}
+	args, err := decodeGRPCMessage(req, types)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "API %s: %s", methodName, err.Error())
+	}
+
+	var results []reflect.Value
+	if fnType.IsVariadic() {
+		results = fn.CallSlice(args)
+	} else {
+		results = fn.Call(args)
+	}
+
+	resp, err := grpcMessage(method.Output())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := encodeGRPCMessage(resp, results); err != nil {
+		return nil, status.Errorf(codes.Internal, "API %s: %s", methodName, err.Error())
+	}
+	return resp.Interface(), nil
+}
@@ -0,0 +1,260 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/grpcproto"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// testGRPCPlugin implements the plugin side of the gRPC protocol without the help of the Go
+// plugin package, as a plugin written in another language would.
+type testGRPCPlugin struct {
+	grpcproto.UnimplementedHooksServer
+	t   *testing.T
+	api grpcproto.APIClient
+}
+
+func (p *testGRPCPlugin) Implemented(context.Context, *grpcproto.ImplementedRequest) (*grpcproto.ImplementedResponse, error) {
+	return &grpcproto.ImplementedResponse{Hooks: []string{"OnActivate", "MessageWillBePosted", "ExecuteCommand", "RunDataRetention", "ServeHTTP"}}, nil
+}
+
+func (p *testGRPCPlugin) OnActivate(ctx context.Context, req *grpcproto.OnActivateRequest) (*grpcproto.OnActivateResponse, error) {
+	conn, err := grpc.NewClient(req.ApiTarget, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	p.api = grpcproto.NewAPIClient(conn)
+
+	resp, err := p.api.KVSet(ctx, &grpcproto.KVSetRequest{Key: "activated", Value: []byte("true")})
+	if err != nil {
+		return nil, err
+	}
+	return &grpcproto.OnActivateResponse{Error: resp.Error}, nil
+}
+
+func (p *testGRPCPlugin) MessageWillBePosted(_ context.Context, req *grpcproto.MessageWillBePostedRequest) (*grpcproto.MessageWillBePostedResponse, error) {
+	var post map[string]any
+	require.NoError(p.t, json.Unmarshal([]byte(req.PostJson), &post))
+	post["message"] = strings.ToUpper(post["message"].(string))
+	b, err := json.Marshal(post)
+	require.NoError(p.t, err)
+	return &grpcproto.MessageWillBePostedResponse{Result_1Json: string(b)}, nil
+}
+
+func (p *testGRPCPlugin) ExecuteCommand(context.Context, *grpcproto.ExecuteCommandRequest) (*grpcproto.ExecuteCommandResponse, error) {
+	return &grpcproto.ExecuteCommandResponse{
+		Error: &grpcproto.Error{Id: "plugin.test.app_error", Message: "command failed", StatusCode: http.StatusTeapot},
+	}, nil
+}
+
+func (p *testGRPCPlugin) RunDataRetention(ctx context.Context, _ *grpcproto.RunDataRetentionRequest) (*grpcproto.RunDataRetentionResponse, error) {
+	value, err := p.api.KVGet(ctx, &grpcproto.KVGetRequest{Key: "activated"})
+	if err != nil {
+		return nil, err
+	}
+	resp := &grpcproto.RunDataRetentionResponse{Result: 42}
+	if string(value.Result) != "true" {
+		resp.Error = &grpcproto.Error{Message: "not activated"}
+	}
+	return resp, nil
+}
+
+func (p *testGRPCPlugin) ServeHTTP(_ context.Context, req *grpcproto.HTTPRequest) (*grpcproto.HTTPResponse, error) {
+	var c Context
+	require.NoError(p.t, json.Unmarshal([]byte(req.ContextJson), &c))
+	return &grpcproto.HTTPResponse{
+		StatusCode: http.StatusCreated,
+		Headers:    []*grpcproto.HTTPHeader{{Name: "X-Request-Id", Values: []string{c.RequestId}}},
+		Body:       []byte(req.Method + " " + req.Url + " " + string(req.Body)),
+	}, nil
+}
+
+type testGRPCAPI struct {
+	API
+	kv map[string][]byte
+}
+
+func (api *testGRPCAPI) KVSet(key string, value []byte) *model.AppError {
+	api.kv[key] = value
+	return nil
+}
+
+func (api *testGRPCAPI) KVGet(key string) ([]byte, *model.AppError) {
+	return api.kv[key], nil
+}
+
+func (api *testGRPCAPI) GetPluginConfig() map[string]any {
+	return map[string]any{"Enabled": true}
+}
+
+func newTestGRPCClient(t *testing.T) (*hooksGRPCClient, *testGRPCAPI) {
+	t.Helper()
+
+	listener, err := net.Listen("unix", filepath.Join(t.TempDir(), "plugin.sock"))
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	grpcproto.RegisterHooksServer(server, &testGRPCPlugin{t: t})
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("unix://"+listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	api := &testGRPCAPI{kv: map[string][]byte{}}
+	client := newHooksGRPCClient(conn, mlog.CreateConsoleTestLogger(t), api)
+	t.Cleanup(func() {
+		client.shutdown()
+		client.doneWg.Wait()
+	})
+
+	impl, err := client.Implemented()
+	require.NoError(t, err)
+	require.Contains(t, impl, "MessageWillBePosted")
+	require.NoError(t, client.OnActivate())
+
+	return client, api
+}
+
+func TestHooksGRPCClient(t *testing.T) {
+	client, api := newTestGRPCClient(t)
+
+	t.Run("OnActivate serves the API", func(t *testing.T) {
+		assert.Equal(t, []byte("true"), api.kv["activated"])
+	})
+
+	t.Run("hook results", func(t *testing.T) {
+		post, rejectionReason := client.MessageWillBePosted(&Context{}, &model.Post{Id: model.NewId(), Message: "hello"})
+		require.NotNil(t, post)
+		assert.Equal(t, "HELLO", post.Message)
+		assert.Empty(t, rejectionReason)
+
+		count, err := client.RunDataRetention(0, 100)
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), count)
+	})
+
+	t.Run("hook errors", func(t *testing.T) {
+		resp, appErr := client.ExecuteCommand(&Context{}, &model.CommandArgs{Command: "/test"})
+		assert.Nil(t, resp)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "plugin.test.app_error", appErr.Id)
+		assert.Equal(t, http.StatusTeapot, appErr.StatusCode)
+
+		delete(api.kv, "activated")
+		_, err := client.RunDataRetention(0, 100)
+		require.EqualError(t, err, "not activated")
+	})
+
+	t.Run("hooks not implemented", func(t *testing.T) {
+		newPost := &model.Post{Message: "new"}
+		post, rejectionReason := client.MessageWillBeUpdated(&Context{}, newPost, &model.Post{})
+		assert.Same(t, newPost, post)
+		assert.Empty(t, rejectionReason)
+
+		w := httptest.NewRecorder()
+		client.ServeMetrics(&Context{}, w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("ServeHTTP", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/plugins/test/hello?name=world", strings.NewReader("body"))
+		client.ServeHTTP(&Context{RequestId: "request"}, w, r)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, "request", w.Header().Get("X-Request-Id"))
+		assert.Equal(t, "POST /plugins/test/hello?name=world body", w.Body.String())
+	})
+}
+
+func TestAPIGRPCServer(t *testing.T) {
+	server := &apiGRPCServer{impl: &testGRPCAPI{kv: map[string][]byte{"key": []byte("value")}}}
+
+	call := func(method string, req proto.Message) (proto.Message, error) {
+		return server.call(context.Background(), method, func(m proto.Message) error {
+			proto.Merge(m, req)
+			return nil
+		})
+	}
+
+	resp, err := call("KVGet", &grpcproto.KVGetRequest{Key: "key"})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), resp.(*grpcproto.KVGetResponse).Result)
+	assert.Nil(t, resp.(*grpcproto.KVGetResponse).Error)
+
+	resp, err = call("GetPluginConfig", &grpcproto.GetPluginConfigRequest{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"Enabled": true}`, resp.(*grpcproto.GetPluginConfigResponse).ResultJson)
+
+	_, err = server.call(context.Background(), "KVGet", func(proto.Message) error { return errors.New("invalid") })
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = call("recordTime", &grpcproto.KVGetRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestGRPCMessages(t *testing.T) {
+	t.Run("encode and decode", func(t *testing.T) {
+		post := &model.Post{Id: model.NewId(), Message: "hello"}
+		req := &grpcproto.MessageWillBeUpdatedRequest{}
+		require.NoError(t, encodeGRPCMessage(req.ProtoReflect(), []reflect.Value{
+			reflect.ValueOf(&Context{RequestId: "request"}),
+			reflect.ValueOf(post),
+			reflect.ValueOf((*model.Post)(nil)),
+		}))
+		assert.JSONEq(t, `null`, req.OldPostJson)
+
+		values, err := decodeGRPCMessage(req.ProtoReflect(), []reflect.Type{
+			reflect.TypeOf(&Context{}),
+			reflect.TypeOf(post),
+			reflect.TypeOf(post),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "request", values[0].Interface().(*Context).RequestId)
+		assert.Equal(t, post, values[1].Interface())
+		assert.Nil(t, values[2].Interface())
+	})
+
+	t.Run("scalars and errors", func(t *testing.T) {
+		resp := &grpcproto.GetUsersByUsernamesRequest{}
+		require.NoError(t, encodeGRPCMessage(resp.ProtoReflect(), []reflect.Value{reflect.ValueOf([]string{"a", "b"})}))
+		assert.Equal(t, []string{"a", "b"}, resp.Usernames)
+
+		countResp := &grpcproto.RunDataRetentionResponse{}
+		require.NoError(t, encodeGRPCMessage(countResp.ProtoReflect(), []reflect.Value{
+			reflect.ValueOf(int64(42)),
+			reflect.ValueOf(model.NewAppError("where", "id", nil, "", http.StatusTeapot)).Convert(errorType),
+		}))
+		assert.Equal(t, int64(42), countResp.Result)
+		assert.Equal(t, "id", countResp.Error.Id)
+
+		values, err := decodeGRPCMessage(countResp.ProtoReflect(), []reflect.Type{reflect.TypeOf(int64(0)), errorType})
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), values[0].Int())
+		var appErr *model.AppError
+		require.ErrorAs(t, values[1].Interface().(error), &appErr)
+		assert.Equal(t, http.StatusTeapot, appErr.StatusCode)
+	})
+}
@@ -0,0 +1,11108 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Code generated by "make pluginapi"
+// DO NOT EDIT
+
+// This file describes the gRPC protocol spoken by server plugins whose manifest
+// sets "server": {"protocol": "grpc"}. It allows plugins to be written in any
+// language with gRPC support.
+//
+// The plugin is started by the server like any other plugin and must follow the
+// hashicorp/go-plugin handshake:
+//
+//   - Exit unless the MATTERMOST_PLUGIN environment variable equals
+//     "Securely message teams, anywhere.".
+//   - Serve the Hooks service below, as well as the grpc.health.v1.Health
+//     service reporting SERVING for the "plugin" service.
+//   - Print "1|1|tcp|127.0.0.1:<port>|grpc" (or "1|1|unix|<path>|grpc") on a
+//     single line to stdout once listening.
+//
+// The messages of the hooks and API methods are generated from the Hooks and
+// API interfaces of server/public/plugin/hooks.go and api.go:
+//
+//   - Request fields are the arguments of the method, in order. Unnamed
+//     results are the "result" field, or "result_1", "result_2"... when there
+//     are several.
+//   - Strings, booleans, integers and byte slices have their protobuf type.
+//     Other values, such as model types, are strings holding their
+//     encoding/json representation and their field name ends with "_json".
+//   - Results of type error or *model.AppError are reported in the error field.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: mattermost_plugin.proto
+
+package grpcproto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Hooks_Implemented_FullMethodName                         = "/mattermost.plugin.v1.Hooks/Implemented"
+	Hooks_OnActivate_FullMethodName                          = "/mattermost.plugin.v1.Hooks/OnActivate"
+	Hooks_ServeHTTP_FullMethodName                           = "/mattermost.plugin.v1.Hooks/ServeHTTP"
+	Hooks_ServeMetrics_FullMethodName                        = "/mattermost.plugin.v1.Hooks/ServeMetrics"
+	Hooks_OnDeactivate_FullMethodName                        = "/mattermost.plugin.v1.Hooks/OnDeactivate"
+	Hooks_OnConfigurationChange_FullMethodName               = "/mattermost.plugin.v1.Hooks/OnConfigurationChange"
+	Hooks_ExecuteCommand_FullMethodName                      = "/mattermost.plugin.v1.Hooks/ExecuteCommand"
+	Hooks_UserHasBeenCreated_FullMethodName                  = "/mattermost.plugin.v1.Hooks/UserHasBeenCreated"
+	Hooks_UserWillLogIn_FullMethodName                       = "/mattermost.plugin.v1.Hooks/UserWillLogIn"
+	Hooks_UserHasLoggedIn_FullMethodName                     = "/mattermost.plugin.v1.Hooks/UserHasLoggedIn"
+	Hooks_MessageWillBePosted_FullMethodName                 = "/mattermost.plugin.v1.Hooks/MessageWillBePosted"
+	Hooks_MessageWillBeUpdated_FullMethodName                = "/mattermost.plugin.v1.Hooks/MessageWillBeUpdated"
+	Hooks_MessageHasBeenPosted_FullMethodName                = "/mattermost.plugin.v1.Hooks/MessageHasBeenPosted"
+	Hooks_MessageHasBeenUpdated_FullMethodName               = "/mattermost.plugin.v1.Hooks/MessageHasBeenUpdated"
+	Hooks_MessagesWillBeConsumed_FullMethodName              = "/mattermost.plugin.v1.Hooks/MessagesWillBeConsumed"
+	Hooks_MessageHasBeenDeleted_FullMethodName               = "/mattermost.plugin.v1.Hooks/MessageHasBeenDeleted"
+	Hooks_ChannelWillBeCreated_FullMethodName                = "/mattermost.plugin.v1.Hooks/ChannelWillBeCreated"
+	Hooks_ChannelHasBeenCreated_FullMethodName               = "/mattermost.plugin.v1.Hooks/ChannelHasBeenCreated"
+	Hooks_ChannelHasBeenUpdated_FullMethodName               = "/mattermost.plugin.v1.Hooks/ChannelHasBeenUpdated"
+	Hooks_ChannelHasBeenArchived_FullMethodName              = "/mattermost.plugin.v1.Hooks/ChannelHasBeenArchived"
+	Hooks_ChannelHasBeenRestored_FullMethodName              = "/mattermost.plugin.v1.Hooks/ChannelHasBeenRestored"
+	Hooks_ChannelHasBeenDeleted_FullMethodName               = "/mattermost.plugin.v1.Hooks/ChannelHasBeenDeleted"
+	Hooks_UserHasJoinedChannel_FullMethodName                = "/mattermost.plugin.v1.Hooks/UserHasJoinedChannel"
+	Hooks_UserHasLeftChannel_FullMethodName                  = "/mattermost.plugin.v1.Hooks/UserHasLeftChannel"
+	Hooks_UserHasJoinedTeam_FullMethodName                   = "/mattermost.plugin.v1.Hooks/UserHasJoinedTeam"
+	Hooks_UserHasLeftTeam_FullMethodName                     = "/mattermost.plugin.v1.Hooks/UserHasLeftTeam"
+	Hooks_TeamHasBeenCreated_FullMethodName                  = "/mattermost.plugin.v1.Hooks/TeamHasBeenCreated"
+	Hooks_TeamHasBeenUpdated_FullMethodName                  = "/mattermost.plugin.v1.Hooks/TeamHasBeenUpdated"
+	Hooks_UserHasJoinedGroup_FullMethodName                  = "/mattermost.plugin.v1.Hooks/UserHasJoinedGroup"
+	Hooks_UserHasLeftGroup_FullMethodName                    = "/mattermost.plugin.v1.Hooks/UserHasLeftGroup"
+	Hooks_FileWillBeUploaded_FullMethodName                  = "/mattermost.plugin.v1.Hooks/FileWillBeUploaded"
+	Hooks_ReactionHasBeenAdded_FullMethodName                = "/mattermost.plugin.v1.Hooks/ReactionHasBeenAdded"
+	Hooks_ReactionHasBeenRemoved_FullMethodName              = "/mattermost.plugin.v1.Hooks/ReactionHasBeenRemoved"
+	Hooks_OnPluginClusterEvent_FullMethodName                = "/mattermost.plugin.v1.Hooks/OnPluginClusterEvent"
+	Hooks_OnWebSocketConnect_FullMethodName                  = "/mattermost.plugin.v1.Hooks/OnWebSocketConnect"
+	Hooks_OnWebSocketDisconnect_FullMethodName               = "/mattermost.plugin.v1.Hooks/OnWebSocketDisconnect"
+	Hooks_WebSocketMessageHasBeenPosted_FullMethodName       = "/mattermost.plugin.v1.Hooks/WebSocketMessageHasBeenPosted"
+	Hooks_RunDataRetention_FullMethodName                    = "/mattermost.plugin.v1.Hooks/RunDataRetention"
+	Hooks_OnInstall_FullMethodName                           = "/mattermost.plugin.v1.Hooks/OnInstall"
+	Hooks_OnSendDailyTelemetry_FullMethodName                = "/mattermost.plugin.v1.Hooks/OnSendDailyTelemetry"
+	Hooks_OnCloudLimitsUpdated_FullMethodName                = "/mattermost.plugin.v1.Hooks/OnCloudLimitsUpdated"
+	Hooks_ConfigurationWillBeSaved_FullMethodName            = "/mattermost.plugin.v1.Hooks/ConfigurationWillBeSaved"
+	Hooks_EmailNotificationWillBeSent_FullMethodName         = "/mattermost.plugin.v1.Hooks/EmailNotificationWillBeSent"
+	Hooks_NotificationWillBePushed_FullMethodName            = "/mattermost.plugin.v1.Hooks/NotificationWillBePushed"
+	Hooks_UserHasBeenDeactivated_FullMethodName              = "/mattermost.plugin.v1.Hooks/UserHasBeenDeactivated"
+	Hooks_OnSharedChannelsSyncMsg_FullMethodName             = "/mattermost.plugin.v1.Hooks/OnSharedChannelsSyncMsg"
+	Hooks_OnSharedChannelsPing_FullMethodName                = "/mattermost.plugin.v1.Hooks/OnSharedChannelsPing"
+	Hooks_PreferencesHaveChanged_FullMethodName              = "/mattermost.plugin.v1.Hooks/PreferencesHaveChanged"
+	Hooks_OnSharedChannelsAttachmentSyncMsg_FullMethodName   = "/mattermost.plugin.v1.Hooks/OnSharedChannelsAttachmentSyncMsg"
+	Hooks_OnSharedChannelsProfileImageSyncMsg_FullMethodName = "/mattermost.plugin.v1.Hooks/OnSharedChannelsProfileImageSyncMsg"
+	Hooks_GenerateSupportData_FullMethodName                 = "/mattermost.plugin.v1.Hooks/GenerateSupportData"
+	Hooks_OnSAMLLogin_FullMethodName                         = "/mattermost.plugin.v1.Hooks/OnSAMLLogin"
+)
+
+// HooksClient is the client API for Hooks service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Hooks is served by the plugin and called by the server.
+type HooksClient interface {
+	// Implemented returns the names of the hooks implemented by the plugin.
+	Implemented(ctx context.Context, in *ImplementedRequest, opts ...grpc.CallOption) (*ImplementedResponse, error)
+	// OnActivate is called once the API service is listening, before any other
+	// hook but Implemented. A returned error stops the plugin.
+	OnActivate(ctx context.Context, in *OnActivateRequest, opts ...grpc.CallOption) (*OnActivateResponse, error)
+	// ServeHTTP handles a request sent to /plugins/{id}.
+	ServeHTTP(ctx context.Context, in *HTTPRequest, opts ...grpc.CallOption) (*HTTPResponse, error)
+	// ServeMetrics handles a request sent to /plugins/{id}/metrics on the
+	// metrics listener.
+	ServeMetrics(ctx context.Context, in *HTTPRequest, opts ...grpc.CallOption) (*HTTPResponse, error)
+	OnDeactivate(ctx context.Context, in *OnDeactivateRequest, opts ...grpc.CallOption) (*OnDeactivateResponse, error)
+	OnConfigurationChange(ctx context.Context, in *OnConfigurationChangeRequest, opts ...grpc.CallOption) (*OnConfigurationChangeResponse, error)
+	ExecuteCommand(ctx context.Context, in *ExecuteCommandRequest, opts ...grpc.CallOption) (*ExecuteCommandResponse, error)
+	UserHasBeenCreated(ctx context.Context, in *UserHasBeenCreatedRequest, opts ...grpc.CallOption) (*UserHasBeenCreatedResponse, error)
+	UserWillLogIn(ctx context.Context, in *UserWillLogInRequest, opts ...grpc.CallOption) (*UserWillLogInResponse, error)
+	UserHasLoggedIn(ctx context.Context, in *UserHasLoggedInRequest, opts ...grpc.CallOption) (*UserHasLoggedInResponse, error)
+	MessageWillBePosted(ctx context.Context, in *MessageWillBePostedRequest, opts ...grpc.CallOption) (*MessageWillBePostedResponse, error)
+	MessageWillBeUpdated(ctx context.Context, in *MessageWillBeUpdatedRequest, opts ...grpc.CallOption) (*MessageWillBeUpdatedResponse, error)
+	MessageHasBeenPosted(ctx context.Context, in *MessageHasBeenPostedRequest, opts ...grpc.CallOption) (*MessageHasBeenPostedResponse, error)
+	MessageHasBeenUpdated(ctx context.Context, in *MessageHasBeenUpdatedRequest, opts ...grpc.CallOption) (*MessageHasBeenUpdatedResponse, error)
+	MessagesWillBeConsumed(ctx context.Context, in *MessagesWillBeConsumedRequest, opts ...grpc.CallOption) (*MessagesWillBeConsumedResponse, error)
+	MessageHasBeenDeleted(ctx context.Context, in *MessageHasBeenDeletedRequest, opts ...grpc.CallOption) (*MessageHasBeenDeletedResponse, error)
+	ChannelWillBeCreated(ctx context.Context, in *ChannelWillBeCreatedRequest, opts ...grpc.CallOption) (*ChannelWillBeCreatedResponse, error)
+	ChannelHasBeenCreated(ctx context.Context, in *ChannelHasBeenCreatedRequest, opts ...grpc.CallOption) (*ChannelHasBeenCreatedResponse, error)
+	ChannelHasBeenUpdated(ctx context.Context, in *ChannelHasBeenUpdatedRequest, opts ...grpc.CallOption) (*ChannelHasBeenUpdatedResponse, error)
+	ChannelHasBeenArchived(ctx context.Context, in *ChannelHasBeenArchivedRequest, opts ...grpc.CallOption) (*ChannelHasBeenArchivedResponse, error)
+	ChannelHasBeenRestored(ctx context.Context, in *ChannelHasBeenRestoredRequest, opts ...grpc.CallOption) (*ChannelHasBeenRestoredResponse, error)
+	ChannelHasBeenDeleted(ctx context.Context, in *ChannelHasBeenDeletedRequest, opts ...grpc.CallOption) (*ChannelHasBeenDeletedResponse, error)
+	UserHasJoinedChannel(ctx context.Context, in *UserHasJoinedChannelRequest, opts ...grpc.CallOption) (*UserHasJoinedChannelResponse, error)
+	UserHasLeftChannel(ctx context.Context, in *UserHasLeftChannelRequest, opts ...grpc.CallOption) (*UserHasLeftChannelResponse, error)
+	UserHasJoinedTeam(ctx context.Context, in *UserHasJoinedTeamRequest, opts ...grpc.CallOption) (*UserHasJoinedTeamResponse, error)
+	UserHasLeftTeam(ctx context.Context, in *UserHasLeftTeamRequest, opts ...grpc.CallOption) (*UserHasLeftTeamResponse, error)
+	TeamHasBeenCreated(ctx context.Context, in *TeamHasBeenCreatedRequest, opts ...grpc.CallOption) (*TeamHasBeenCreatedResponse, error)
+	TeamHasBeenUpdated(ctx context.Context, in *TeamHasBeenUpdatedRequest, opts ...grpc.CallOption) (*TeamHasBeenUpdatedResponse, error)
+	UserHasJoinedGroup(ctx context.Context, in *UserHasJoinedGroupRequest, opts ...grpc.CallOption) (*UserHasJoinedGroupResponse, error)
+	UserHasLeftGroup(ctx context.Context, in *UserHasLeftGroupRequest, opts ...grpc.CallOption) (*UserHasLeftGroupResponse, error)
+	FileWillBeUploaded(ctx context.Context, in *FileWillBeUploadedRequest, opts ...grpc.CallOption) (*FileWillBeUploadedResponse, error)
+	ReactionHasBeenAdded(ctx context.Context, in *ReactionHasBeenAddedRequest, opts ...grpc.CallOption) (*ReactionHasBeenAddedResponse, error)
+	ReactionHasBeenRemoved(ctx context.Context, in *ReactionHasBeenRemovedRequest, opts ...grpc.CallOption) (*ReactionHasBeenRemovedResponse, error)
+	OnPluginClusterEvent(ctx context.Context, in *OnPluginClusterEventRequest, opts ...grpc.CallOption) (*OnPluginClusterEventResponse, error)
+	OnWebSocketConnect(ctx context.Context, in *OnWebSocketConnectRequest, opts ...grpc.CallOption) (*OnWebSocketConnectResponse, error)
+	OnWebSocketDisconnect(ctx context.Context, in *OnWebSocketDisconnectRequest, opts ...grpc.CallOption) (*OnWebSocketDisconnectResponse, error)
+	WebSocketMessageHasBeenPosted(ctx context.Context, in *WebSocketMessageHasBeenPostedRequest, opts ...grpc.CallOption) (*WebSocketMessageHasBeenPostedResponse, error)
+	RunDataRetention(ctx context.Context, in *RunDataRetentionRequest, opts ...grpc.CallOption) (*RunDataRetentionResponse, error)
+	OnInstall(ctx context.Context, in *OnInstallRequest, opts ...grpc.CallOption) (*OnInstallResponse, error)
+	OnSendDailyTelemetry(ctx context.Context, in *OnSendDailyTelemetryRequest, opts ...grpc.CallOption) (*OnSendDailyTelemetryResponse, error)
+	OnCloudLimitsUpdated(ctx context.Context, in *OnCloudLimitsUpdatedRequest, opts ...grpc.CallOption) (*OnCloudLimitsUpdatedResponse, error)
+	ConfigurationWillBeSaved(ctx context.Context, in *ConfigurationWillBeSavedRequest, opts ...grpc.CallOption) (*ConfigurationWillBeSavedResponse, error)
+	EmailNotificationWillBeSent(ctx context.Context, in *EmailNotificationWillBeSentRequest, opts ...grpc.CallOption) (*EmailNotificationWillBeSentResponse, error)
+	NotificationWillBePushed(ctx context.Context, in *NotificationWillBePushedRequest, opts ...grpc.CallOption) (*NotificationWillBePushedResponse, error)
+	UserHasBeenDeactivated(ctx context.Context, in *UserHasBeenDeactivatedRequest, opts ...grpc.CallOption) (*UserHasBeenDeactivatedResponse, error)
+	OnSharedChannelsSyncMsg(ctx context.Context, in *OnSharedChannelsSyncMsgRequest, opts ...grpc.CallOption) (*OnSharedChannelsSyncMsgResponse, error)
+	OnSharedChannelsPing(ctx context.Context, in *OnSharedChannelsPingRequest, opts ...grpc.CallOption) (*OnSharedChannelsPingResponse, error)
+	PreferencesHaveChanged(ctx context.Context, in *PreferencesHaveChangedRequest, opts ...grpc.CallOption) (*PreferencesHaveChangedResponse, error)
+	OnSharedChannelsAttachmentSyncMsg(ctx context.Context, in *OnSharedChannelsAttachmentSyncMsgRequest, opts ...grpc.CallOption) (*OnSharedChannelsAttachmentSyncMsgResponse, error)
+	OnSharedChannelsProfileImageSyncMsg(ctx context.Context, in *OnSharedChannelsProfileImageSyncMsgRequest, opts ...grpc.CallOption) (*OnSharedChannelsProfileImageSyncMsgResponse, error)
+	GenerateSupportData(ctx context.Context, in *GenerateSupportDataRequest, opts ...grpc.CallOption) (*GenerateSupportDataResponse, error)
+	OnSAMLLogin(ctx context.Context, in *OnSAMLLoginRequest, opts ...grpc.CallOption) (*OnSAMLLoginResponse, error)
+}
+
+type hooksClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHooksClient(cc grpc.ClientConnInterface) HooksClient {
+	return &hooksClient{cc}
+}
+
+func (c *hooksClient) Implemented(ctx context.Context, in *ImplementedRequest, opts ...grpc.CallOption) (*ImplementedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImplementedResponse)
+	err := c.cc.Invoke(ctx, Hooks_Implemented_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) OnActivate(ctx context.Context, in *OnActivateRequest, opts ...grpc.CallOption) (*OnActivateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnActivateResponse)
+	err := c.cc.Invoke(ctx, Hooks_OnActivate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) ServeHTTP(ctx context.Context, in *HTTPRequest, opts ...grpc.CallOption) (*HTTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HTTPResponse)
+	err := c.cc.Invoke(ctx, Hooks_ServeHTTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) ServeMetrics(ctx context.Context, in *HTTPRequest, opts ...grpc.CallOption) (*HTTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HTTPResponse)
+	err := c.cc.Invoke(ctx, Hooks_ServeMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) OnDeactivate(ctx context.Context, in *OnDeactivateRequest, opts ...grpc.CallOption) (*OnDeactivateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnDeactivateResponse)
+	err := c.cc.Invoke(ctx, Hooks_OnDeactivate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) OnConfigurationChange(ctx context.Context, in *OnConfigurationChangeRequest, opts ...grpc.CallOption) (*OnConfigurationChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnConfigurationChangeResponse)
+	err := c.cc.Invoke(ctx, Hooks_OnConfigurationChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) ExecuteCommand(ctx context.Context, in *ExecuteCommandRequest, opts ...grpc.CallOption) (*ExecuteCommandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExecuteCommandResponse)
+	err := c.cc.Invoke(ctx, Hooks_ExecuteCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) UserHasBeenCreated(ctx context.Context, in *UserHasBeenCreatedRequest, opts ...grpc.CallOption) (*UserHasBeenCreatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserHasBeenCreatedResponse)
+	err := c.cc.Invoke(ctx, Hooks_UserHasBeenCreated_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) UserWillLogIn(ctx context.Context, in *UserWillLogInRequest, opts ...grpc.CallOption) (*UserWillLogInResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserWillLogInResponse)
+	err := c.cc.Invoke(ctx, Hooks_UserWillLogIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) UserHasLoggedIn(ctx context.Context, in *UserHasLoggedInRequest, opts ...grpc.CallOption) (*UserHasLoggedInResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserHasLoggedInResponse)
+	err := c.cc.Invoke(ctx, Hooks_UserHasLoggedIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) MessageWillBePosted(ctx context.Context, in *MessageWillBePostedRequest, opts ...grpc.CallOption) (*MessageWillBePostedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageWillBePostedResponse)
+	err := c.cc.Invoke(ctx, Hooks_MessageWillBePosted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) MessageWillBeUpdated(ctx context.Context, in *MessageWillBeUpdatedRequest, opts ...grpc.CallOption) (*MessageWillBeUpdatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageWillBeUpdatedResponse)
+	err := c.cc.Invoke(ctx, Hooks_MessageWillBeUpdated_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) MessageHasBeenPosted(ctx context.Context, in *MessageHasBeenPostedRequest, opts ...grpc.CallOption) (*MessageHasBeenPostedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageHasBeenPostedResponse)
+	err := c.cc.Invoke(ctx, Hooks_MessageHasBeenPosted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) MessageHasBeenUpdated(ctx context.Context, in *MessageHasBeenUpdatedRequest, opts ...grpc.CallOption) (*MessageHasBeenUpdatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageHasBeenUpdatedResponse)
+	err := c.cc.Invoke(ctx, Hooks_MessageHasBeenUpdated_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) MessagesWillBeConsumed(ctx context.Context, in *MessagesWillBeConsumedRequest, opts ...grpc.CallOption) (*MessagesWillBeConsumedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessagesWillBeConsumedResponse)
+	err := c.cc.Invoke(ctx, Hooks_MessagesWillBeConsumed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) MessageHasBeenDeleted(ctx context.Context, in *MessageHasBeenDeletedRequest, opts ...grpc.CallOption) (*MessageHasBeenDeletedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageHasBeenDeletedResponse)
+	err := c.cc.Invoke(ctx, Hooks_MessageHasBeenDeleted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) ChannelWillBeCreated(ctx context.Context, in *ChannelWillBeCreatedRequest, opts ...grpc.CallOption) (*ChannelWillBeCreatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChannelWillBeCreatedResponse)
+	err := c.cc.Invoke(ctx, Hooks_ChannelWillBeCreated_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) ChannelHasBeenCreated(ctx context.Context, in *ChannelHasBeenCreatedRequest, opts ...grpc.CallOption) (*ChannelHasBeenCreatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChannelHasBeenCreatedResponse)
+	err := c.cc.Invoke(ctx, Hooks_ChannelHasBeenCreated_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) ChannelHasBeenUpdated(ctx context.Context, in *ChannelHasBeenUpdatedRequest, opts ...grpc.CallOption) (*ChannelHasBeenUpdatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChannelHasBeenUpdatedResponse)
+	err := c.cc.Invoke(ctx, Hooks_ChannelHasBeenUpdated_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) ChannelHasBeenArchived(ctx context.Context, in *ChannelHasBeenArchivedRequest, opts ...grpc.CallOption) (*ChannelHasBeenArchivedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChannelHasBeenArchivedResponse)
+	err := c.cc.Invoke(ctx, Hooks_ChannelHasBeenArchived_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) ChannelHasBeenRestored(ctx context.Context, in *ChannelHasBeenRestoredRequest, opts ...grpc.CallOption) (*ChannelHasBeenRestoredResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChannelHasBeenRestoredResponse)
+	err := c.cc.Invoke(ctx, Hooks_ChannelHasBeenRestored_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) ChannelHasBeenDeleted(ctx context.Context, in *ChannelHasBeenDeletedRequest, opts ...grpc.CallOption) (*ChannelHasBeenDeletedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChannelHasBeenDeletedResponse)
+	err := c.cc.Invoke(ctx, Hooks_ChannelHasBeenDeleted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) UserHasJoinedChannel(ctx context.Context, in *UserHasJoinedChannelRequest, opts ...grpc.CallOption) (*UserHasJoinedChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserHasJoinedChannelResponse)
+	err := c.cc.Invoke(ctx, Hooks_UserHasJoinedChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) UserHasLeftChannel(ctx context.Context, in *UserHasLeftChannelRequest, opts ...grpc.CallOption) (*UserHasLeftChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserHasLeftChannelResponse)
+	err := c.cc.Invoke(ctx, Hooks_UserHasLeftChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) UserHasJoinedTeam(ctx context.Context, in *UserHasJoinedTeamRequest, opts ...grpc.CallOption) (*UserHasJoinedTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserHasJoinedTeamResponse)
+	err := c.cc.Invoke(ctx, Hooks_UserHasJoinedTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) UserHasLeftTeam(ctx context.Context, in *UserHasLeftTeamRequest, opts ...grpc.CallOption) (*UserHasLeftTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserHasLeftTeamResponse)
+	err := c.cc.Invoke(ctx, Hooks_UserHasLeftTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) TeamHasBeenCreated(ctx context.Context, in *TeamHasBeenCreatedRequest, opts ...grpc.CallOption) (*TeamHasBeenCreatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamHasBeenCreatedResponse)
+	err := c.cc.Invoke(ctx, Hooks_TeamHasBeenCreated_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) TeamHasBeenUpdated(ctx context.Context, in *TeamHasBeenUpdatedRequest, opts ...grpc.CallOption) (*TeamHasBeenUpdatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamHasBeenUpdatedResponse)
+	err := c.cc.Invoke(ctx, Hooks_TeamHasBeenUpdated_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) UserHasJoinedGroup(ctx context.Context, in *UserHasJoinedGroupRequest, opts ...grpc.CallOption) (*UserHasJoinedGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserHasJoinedGroupResponse)
+	err := c.cc.Invoke(ctx, Hooks_UserHasJoinedGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) UserHasLeftGroup(ctx context.Context, in *UserHasLeftGroupRequest, opts ...grpc.CallOption) (*UserHasLeftGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserHasLeftGroupResponse)
+	err := c.cc.Invoke(ctx, Hooks_UserHasLeftGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) FileWillBeUploaded(ctx context.Context, in *FileWillBeUploadedRequest, opts ...grpc.CallOption) (*FileWillBeUploadedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileWillBeUploadedResponse)
+	err := c.cc.Invoke(ctx, Hooks_FileWillBeUploaded_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) ReactionHasBeenAdded(ctx context.Context, in *ReactionHasBeenAddedRequest, opts ...grpc.CallOption) (*ReactionHasBeenAddedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactionHasBeenAddedResponse)
+	err := c.cc.Invoke(ctx, Hooks_ReactionHasBeenAdded_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) ReactionHasBeenRemoved(ctx context.Context, in *ReactionHasBeenRemovedRequest, opts ...grpc.CallOption) (*ReactionHasBeenRemovedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactionHasBeenRemovedResponse)
+	err := c.cc.Invoke(ctx, Hooks_ReactionHasBeenRemoved_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) OnPluginClusterEvent(ctx context.Context, in *OnPluginClusterEventRequest, opts ...grpc.CallOption) (*OnPluginClusterEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnPluginClusterEventResponse)
+	err := c.cc.Invoke(ctx, Hooks_OnPluginClusterEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) OnWebSocketConnect(ctx context.Context, in *OnWebSocketConnectRequest, opts ...grpc.CallOption) (*OnWebSocketConnectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnWebSocketConnectResponse)
+	err := c.cc.Invoke(ctx, Hooks_OnWebSocketConnect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) OnWebSocketDisconnect(ctx context.Context, in *OnWebSocketDisconnectRequest, opts ...grpc.CallOption) (*OnWebSocketDisconnectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnWebSocketDisconnectResponse)
+	err := c.cc.Invoke(ctx, Hooks_OnWebSocketDisconnect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) WebSocketMessageHasBeenPosted(ctx context.Context, in *WebSocketMessageHasBeenPostedRequest, opts ...grpc.CallOption) (*WebSocketMessageHasBeenPostedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebSocketMessageHasBeenPostedResponse)
+	err := c.cc.Invoke(ctx, Hooks_WebSocketMessageHasBeenPosted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) RunDataRetention(ctx context.Context, in *RunDataRetentionRequest, opts ...grpc.CallOption) (*RunDataRetentionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunDataRetentionResponse)
+	err := c.cc.Invoke(ctx, Hooks_RunDataRetention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) OnInstall(ctx context.Context, in *OnInstallRequest, opts ...grpc.CallOption) (*OnInstallResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnInstallResponse)
+	err := c.cc.Invoke(ctx, Hooks_OnInstall_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) OnSendDailyTelemetry(ctx context.Context, in *OnSendDailyTelemetryRequest, opts ...grpc.CallOption) (*OnSendDailyTelemetryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnSendDailyTelemetryResponse)
+	err := c.cc.Invoke(ctx, Hooks_OnSendDailyTelemetry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) OnCloudLimitsUpdated(ctx context.Context, in *OnCloudLimitsUpdatedRequest, opts ...grpc.CallOption) (*OnCloudLimitsUpdatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnCloudLimitsUpdatedResponse)
+	err := c.cc.Invoke(ctx, Hooks_OnCloudLimitsUpdated_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) ConfigurationWillBeSaved(ctx context.Context, in *ConfigurationWillBeSavedRequest, opts ...grpc.CallOption) (*ConfigurationWillBeSavedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfigurationWillBeSavedResponse)
+	err := c.cc.Invoke(ctx, Hooks_ConfigurationWillBeSaved_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) EmailNotificationWillBeSent(ctx context.Context, in *EmailNotificationWillBeSentRequest, opts ...grpc.CallOption) (*EmailNotificationWillBeSentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmailNotificationWillBeSentResponse)
+	err := c.cc.Invoke(ctx, Hooks_EmailNotificationWillBeSent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) NotificationWillBePushed(ctx context.Context, in *NotificationWillBePushedRequest, opts ...grpc.CallOption) (*NotificationWillBePushedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationWillBePushedResponse)
+	err := c.cc.Invoke(ctx, Hooks_NotificationWillBePushed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) UserHasBeenDeactivated(ctx context.Context, in *UserHasBeenDeactivatedRequest, opts ...grpc.CallOption) (*UserHasBeenDeactivatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserHasBeenDeactivatedResponse)
+	err := c.cc.Invoke(ctx, Hooks_UserHasBeenDeactivated_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) OnSharedChannelsSyncMsg(ctx context.Context, in *OnSharedChannelsSyncMsgRequest, opts ...grpc.CallOption) (*OnSharedChannelsSyncMsgResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnSharedChannelsSyncMsgResponse)
+	err := c.cc.Invoke(ctx, Hooks_OnSharedChannelsSyncMsg_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) OnSharedChannelsPing(ctx context.Context, in *OnSharedChannelsPingRequest, opts ...grpc.CallOption) (*OnSharedChannelsPingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnSharedChannelsPingResponse)
+	err := c.cc.Invoke(ctx, Hooks_OnSharedChannelsPing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) PreferencesHaveChanged(ctx context.Context, in *PreferencesHaveChangedRequest, opts ...grpc.CallOption) (*PreferencesHaveChangedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreferencesHaveChangedResponse)
+	err := c.cc.Invoke(ctx, Hooks_PreferencesHaveChanged_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) OnSharedChannelsAttachmentSyncMsg(ctx context.Context, in *OnSharedChannelsAttachmentSyncMsgRequest, opts ...grpc.CallOption) (*OnSharedChannelsAttachmentSyncMsgResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnSharedChannelsAttachmentSyncMsgResponse)
+	err := c.cc.Invoke(ctx, Hooks_OnSharedChannelsAttachmentSyncMsg_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) OnSharedChannelsProfileImageSyncMsg(ctx context.Context, in *OnSharedChannelsProfileImageSyncMsgRequest, opts ...grpc.CallOption) (*OnSharedChannelsProfileImageSyncMsgResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnSharedChannelsProfileImageSyncMsgResponse)
+	err := c.cc.Invoke(ctx, Hooks_OnSharedChannelsProfileImageSyncMsg_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) GenerateSupportData(ctx context.Context, in *GenerateSupportDataRequest, opts ...grpc.CallOption) (*GenerateSupportDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateSupportDataResponse)
+	err := c.cc.Invoke(ctx, Hooks_GenerateSupportData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) OnSAMLLogin(ctx context.Context, in *OnSAMLLoginRequest, opts ...grpc.CallOption) (*OnSAMLLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnSAMLLoginResponse)
+	err := c.cc.Invoke(ctx, Hooks_OnSAMLLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HooksServer is the server API for Hooks service.
+// All implementations must embed UnimplementedHooksServer
+// for forward compatibility.
+//
+// Hooks is served by the plugin and called by the server.
+type HooksServer interface {
+	// Implemented returns the names of the hooks implemented by the plugin.
+	Implemented(context.Context, *ImplementedRequest) (*ImplementedResponse, error)
+	// OnActivate is called once the API service is listening, before any other
+	// hook but Implemented. A returned error stops the plugin.
+	OnActivate(context.Context, *OnActivateRequest) (*OnActivateResponse, error)
+	// ServeHTTP handles a request sent to /plugins/{id}.
+	ServeHTTP(context.Context, *HTTPRequest) (*HTTPResponse, error)
+	// ServeMetrics handles a request sent to /plugins/{id}/metrics on the
+	// metrics listener.
+	ServeMetrics(context.Context, *HTTPRequest) (*HTTPResponse, error)
+	OnDeactivate(context.Context, *OnDeactivateRequest) (*OnDeactivateResponse, error)
+	OnConfigurationChange(context.Context, *OnConfigurationChangeRequest) (*OnConfigurationChangeResponse, error)
+	ExecuteCommand(context.Context, *ExecuteCommandRequest) (*ExecuteCommandResponse, error)
+	UserHasBeenCreated(context.Context, *UserHasBeenCreatedRequest) (*UserHasBeenCreatedResponse, error)
+	UserWillLogIn(context.Context, *UserWillLogInRequest) (*UserWillLogInResponse, error)
+	UserHasLoggedIn(context.Context, *UserHasLoggedInRequest) (*UserHasLoggedInResponse, error)
+	MessageWillBePosted(context.Context, *MessageWillBePostedRequest) (*MessageWillBePostedResponse, error)
+	MessageWillBeUpdated(context.Context, *MessageWillBeUpdatedRequest) (*MessageWillBeUpdatedResponse, error)
+	MessageHasBeenPosted(context.Context, *MessageHasBeenPostedRequest) (*MessageHasBeenPostedResponse, error)
+	MessageHasBeenUpdated(context.Context, *MessageHasBeenUpdatedRequest) (*MessageHasBeenUpdatedResponse, error)
+	MessagesWillBeConsumed(context.Context, *MessagesWillBeConsumedRequest) (*MessagesWillBeConsumedResponse, error)
+	MessageHasBeenDeleted(context.Context, *MessageHasBeenDeletedRequest) (*MessageHasBeenDeletedResponse, error)
+	ChannelWillBeCreated(context.Context, *ChannelWillBeCreatedRequest) (*ChannelWillBeCreatedResponse, error)
+	ChannelHasBeenCreated(context.Context, *ChannelHasBeenCreatedRequest) (*ChannelHasBeenCreatedResponse, error)
+	ChannelHasBeenUpdated(context.Context, *ChannelHasBeenUpdatedRequest) (*ChannelHasBeenUpdatedResponse, error)
+	ChannelHasBeenArchived(context.Context, *ChannelHasBeenArchivedRequest) (*ChannelHasBeenArchivedResponse, error)
+	ChannelHasBeenRestored(context.Context, *ChannelHasBeenRestoredRequest) (*ChannelHasBeenRestoredResponse, error)
+	ChannelHasBeenDeleted(context.Context, *ChannelHasBeenDeletedRequest) (*ChannelHasBeenDeletedResponse, error)
+	UserHasJoinedChannel(context.Context, *UserHasJoinedChannelRequest) (*UserHasJoinedChannelResponse, error)
+	UserHasLeftChannel(context.Context, *UserHasLeftChannelRequest) (*UserHasLeftChannelResponse, error)
+	UserHasJoinedTeam(context.Context, *UserHasJoinedTeamRequest) (*UserHasJoinedTeamResponse, error)
+	UserHasLeftTeam(context.Context, *UserHasLeftTeamRequest) (*UserHasLeftTeamResponse, error)
+	TeamHasBeenCreated(context.Context, *TeamHasBeenCreatedRequest) (*TeamHasBeenCreatedResponse, error)
+	TeamHasBeenUpdated(context.Context, *TeamHasBeenUpdatedRequest) (*TeamHasBeenUpdatedResponse, error)
+	UserHasJoinedGroup(context.Context, *UserHasJoinedGroupRequest) (*UserHasJoinedGroupResponse, error)
+	UserHasLeftGroup(context.Context, *UserHasLeftGroupRequest) (*UserHasLeftGroupResponse, error)
+	FileWillBeUploaded(context.Context, *FileWillBeUploadedRequest) (*FileWillBeUploadedResponse, error)
+	ReactionHasBeenAdded(context.Context, *ReactionHasBeenAddedRequest) (*ReactionHasBeenAddedResponse, error)
+	ReactionHasBeenRemoved(context.Context, *ReactionHasBeenRemovedRequest) (*ReactionHasBeenRemovedResponse, error)
+	OnPluginClusterEvent(context.Context, *OnPluginClusterEventRequest) (*OnPluginClusterEventResponse, error)
+	OnWebSocketConnect(context.Context, *OnWebSocketConnectRequest) (*OnWebSocketConnectResponse, error)
+	OnWebSocketDisconnect(context.Context, *OnWebSocketDisconnectRequest) (*OnWebSocketDisconnectResponse, error)
+	WebSocketMessageHasBeenPosted(context.Context, *WebSocketMessageHasBeenPostedRequest) (*WebSocketMessageHasBeenPostedResponse, error)
+	RunDataRetention(context.Context, *RunDataRetentionRequest) (*RunDataRetentionResponse, error)
+	OnInstall(context.Context, *OnInstallRequest) (*OnInstallResponse, error)
+	OnSendDailyTelemetry(context.Context, *OnSendDailyTelemetryRequest) (*OnSendDailyTelemetryResponse, error)
+	OnCloudLimitsUpdated(context.Context, *OnCloudLimitsUpdatedRequest) (*OnCloudLimitsUpdatedResponse, error)
+	ConfigurationWillBeSaved(context.Context, *ConfigurationWillBeSavedRequest) (*ConfigurationWillBeSavedResponse, error)
+	EmailNotificationWillBeSent(context.Context, *EmailNotificationWillBeSentRequest) (*EmailNotificationWillBeSentResponse, error)
+	NotificationWillBePushed(context.Context, *NotificationWillBePushedRequest) (*NotificationWillBePushedResponse, error)
+	UserHasBeenDeactivated(context.Context, *UserHasBeenDeactivatedRequest) (*UserHasBeenDeactivatedResponse, error)
+	OnSharedChannelsSyncMsg(context.Context, *OnSharedChannelsSyncMsgRequest) (*OnSharedChannelsSyncMsgResponse, error)
+	OnSharedChannelsPing(context.Context, *OnSharedChannelsPingRequest) (*OnSharedChannelsPingResponse, error)
+	PreferencesHaveChanged(context.Context, *PreferencesHaveChangedRequest) (*PreferencesHaveChangedResponse, error)
+	OnSharedChannelsAttachmentSyncMsg(context.Context, *OnSharedChannelsAttachmentSyncMsgRequest) (*OnSharedChannelsAttachmentSyncMsgResponse, error)
+	OnSharedChannelsProfileImageSyncMsg(context.Context, *OnSharedChannelsProfileImageSyncMsgRequest) (*OnSharedChannelsProfileImageSyncMsgResponse, error)
+	GenerateSupportData(context.Context, *GenerateSupportDataRequest) (*GenerateSupportDataResponse, error)
+	OnSAMLLogin(context.Context, *OnSAMLLoginRequest) (*OnSAMLLoginResponse, error)
+	mustEmbedUnimplementedHooksServer()
+}
+
+// UnimplementedHooksServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHooksServer struct{}
+
+func (UnimplementedHooksServer) Implemented(context.Context, *ImplementedRequest) (*ImplementedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Implemented not implemented")
+}
+func (UnimplementedHooksServer) OnActivate(context.Context, *OnActivateRequest) (*OnActivateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnActivate not implemented")
+}
+func (UnimplementedHooksServer) ServeHTTP(context.Context, *HTTPRequest) (*HTTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServeHTTP not implemented")
+}
+func (UnimplementedHooksServer) ServeMetrics(context.Context, *HTTPRequest) (*HTTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServeMetrics not implemented")
+}
+func (UnimplementedHooksServer) OnDeactivate(context.Context, *OnDeactivateRequest) (*OnDeactivateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnDeactivate not implemented")
+}
+func (UnimplementedHooksServer) OnConfigurationChange(context.Context, *OnConfigurationChangeRequest) (*OnConfigurationChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnConfigurationChange not implemented")
+}
+func (UnimplementedHooksServer) ExecuteCommand(context.Context, *ExecuteCommandRequest) (*ExecuteCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteCommand not implemented")
+}
+func (UnimplementedHooksServer) UserHasBeenCreated(context.Context, *UserHasBeenCreatedRequest) (*UserHasBeenCreatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserHasBeenCreated not implemented")
+}
+func (UnimplementedHooksServer) UserWillLogIn(context.Context, *UserWillLogInRequest) (*UserWillLogInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserWillLogIn not implemented")
+}
+func (UnimplementedHooksServer) UserHasLoggedIn(context.Context, *UserHasLoggedInRequest) (*UserHasLoggedInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserHasLoggedIn not implemented")
+}
+func (UnimplementedHooksServer) MessageWillBePosted(context.Context, *MessageWillBePostedRequest) (*MessageWillBePostedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageWillBePosted not implemented")
+}
+func (UnimplementedHooksServer) MessageWillBeUpdated(context.Context, *MessageWillBeUpdatedRequest) (*MessageWillBeUpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageWillBeUpdated not implemented")
+}
+func (UnimplementedHooksServer) MessageHasBeenPosted(context.Context, *MessageHasBeenPostedRequest) (*MessageHasBeenPostedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageHasBeenPosted not implemented")
+}
+func (UnimplementedHooksServer) MessageHasBeenUpdated(context.Context, *MessageHasBeenUpdatedRequest) (*MessageHasBeenUpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageHasBeenUpdated not implemented")
+}
+func (UnimplementedHooksServer) MessagesWillBeConsumed(context.Context, *MessagesWillBeConsumedRequest) (*MessagesWillBeConsumedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessagesWillBeConsumed not implemented")
+}
+func (UnimplementedHooksServer) MessageHasBeenDeleted(context.Context, *MessageHasBeenDeletedRequest) (*MessageHasBeenDeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageHasBeenDeleted not implemented")
+}
+func (UnimplementedHooksServer) ChannelWillBeCreated(context.Context, *ChannelWillBeCreatedRequest) (*ChannelWillBeCreatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelWillBeCreated not implemented")
+}
+func (UnimplementedHooksServer) ChannelHasBeenCreated(context.Context, *ChannelHasBeenCreatedRequest) (*ChannelHasBeenCreatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelHasBeenCreated not implemented")
+}
+func (UnimplementedHooksServer) ChannelHasBeenUpdated(context.Context, *ChannelHasBeenUpdatedRequest) (*ChannelHasBeenUpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelHasBeenUpdated not implemented")
+}
+func (UnimplementedHooksServer) ChannelHasBeenArchived(context.Context, *ChannelHasBeenArchivedRequest) (*ChannelHasBeenArchivedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelHasBeenArchived not implemented")
+}
+func (UnimplementedHooksServer) ChannelHasBeenRestored(context.Context, *ChannelHasBeenRestoredRequest) (*ChannelHasBeenRestoredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelHasBeenRestored not implemented")
+}
+func (UnimplementedHooksServer) ChannelHasBeenDeleted(context.Context, *ChannelHasBeenDeletedRequest) (*ChannelHasBeenDeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelHasBeenDeleted not implemented")
+}
+func (UnimplementedHooksServer) UserHasJoinedChannel(context.Context, *UserHasJoinedChannelRequest) (*UserHasJoinedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserHasJoinedChannel not implemented")
+}
+func (UnimplementedHooksServer) UserHasLeftChannel(context.Context, *UserHasLeftChannelRequest) (*UserHasLeftChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserHasLeftChannel not implemented")
+}
+func (UnimplementedHooksServer) UserHasJoinedTeam(context.Context, *UserHasJoinedTeamRequest) (*UserHasJoinedTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserHasJoinedTeam not implemented")
+}
+func (UnimplementedHooksServer) UserHasLeftTeam(context.Context, *UserHasLeftTeamRequest) (*UserHasLeftTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserHasLeftTeam not implemented")
+}
+func (UnimplementedHooksServer) TeamHasBeenCreated(context.Context, *TeamHasBeenCreatedRequest) (*TeamHasBeenCreatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TeamHasBeenCreated not implemented")
+}
+func (UnimplementedHooksServer) TeamHasBeenUpdated(context.Context, *TeamHasBeenUpdatedRequest) (*TeamHasBeenUpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TeamHasBeenUpdated not implemented")
+}
+func (UnimplementedHooksServer) UserHasJoinedGroup(context.Context, *UserHasJoinedGroupRequest) (*UserHasJoinedGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserHasJoinedGroup not implemented")
+}
+func (UnimplementedHooksServer) UserHasLeftGroup(context.Context, *UserHasLeftGroupRequest) (*UserHasLeftGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserHasLeftGroup not implemented")
+}
+func (UnimplementedHooksServer) FileWillBeUploaded(context.Context, *FileWillBeUploadedRequest) (*FileWillBeUploadedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FileWillBeUploaded not implemented")
+}
+func (UnimplementedHooksServer) ReactionHasBeenAdded(context.Context, *ReactionHasBeenAddedRequest) (*ReactionHasBeenAddedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactionHasBeenAdded not implemented")
+}
+func (UnimplementedHooksServer) ReactionHasBeenRemoved(context.Context, *ReactionHasBeenRemovedRequest) (*ReactionHasBeenRemovedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactionHasBeenRemoved not implemented")
+}
+func (UnimplementedHooksServer) OnPluginClusterEvent(context.Context, *OnPluginClusterEventRequest) (*OnPluginClusterEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnPluginClusterEvent not implemented")
+}
+func (UnimplementedHooksServer) OnWebSocketConnect(context.Context, *OnWebSocketConnectRequest) (*OnWebSocketConnectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnWebSocketConnect not implemented")
+}
+func (UnimplementedHooksServer) OnWebSocketDisconnect(context.Context, *OnWebSocketDisconnectRequest) (*OnWebSocketDisconnectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnWebSocketDisconnect not implemented")
+}
+func (UnimplementedHooksServer) WebSocketMessageHasBeenPosted(context.Context, *WebSocketMessageHasBeenPostedRequest) (*WebSocketMessageHasBeenPostedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebSocketMessageHasBeenPosted not implemented")
+}
+func (UnimplementedHooksServer) RunDataRetention(context.Context, *RunDataRetentionRequest) (*RunDataRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunDataRetention not implemented")
+}
+func (UnimplementedHooksServer) OnInstall(context.Context, *OnInstallRequest) (*OnInstallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnInstall not implemented")
+}
+func (UnimplementedHooksServer) OnSendDailyTelemetry(context.Context, *OnSendDailyTelemetryRequest) (*OnSendDailyTelemetryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnSendDailyTelemetry not implemented")
+}
+func (UnimplementedHooksServer) OnCloudLimitsUpdated(context.Context, *OnCloudLimitsUpdatedRequest) (*OnCloudLimitsUpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnCloudLimitsUpdated not implemented")
+}
+func (UnimplementedHooksServer) ConfigurationWillBeSaved(context.Context, *ConfigurationWillBeSavedRequest) (*ConfigurationWillBeSavedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigurationWillBeSaved not implemented")
+}
+func (UnimplementedHooksServer) EmailNotificationWillBeSent(context.Context, *EmailNotificationWillBeSentRequest) (*EmailNotificationWillBeSentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmailNotificationWillBeSent not implemented")
+}
+func (UnimplementedHooksServer) NotificationWillBePushed(context.Context, *NotificationWillBePushedRequest) (*NotificationWillBePushedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotificationWillBePushed not implemented")
+}
+func (UnimplementedHooksServer) UserHasBeenDeactivated(context.Context, *UserHasBeenDeactivatedRequest) (*UserHasBeenDeactivatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserHasBeenDeactivated not implemented")
+}
+func (UnimplementedHooksServer) OnSharedChannelsSyncMsg(context.Context, *OnSharedChannelsSyncMsgRequest) (*OnSharedChannelsSyncMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnSharedChannelsSyncMsg not implemented")
+}
+func (UnimplementedHooksServer) OnSharedChannelsPing(context.Context, *OnSharedChannelsPingRequest) (*OnSharedChannelsPingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnSharedChannelsPing not implemented")
+}
+func (UnimplementedHooksServer) PreferencesHaveChanged(context.Context, *PreferencesHaveChangedRequest) (*PreferencesHaveChangedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreferencesHaveChanged not implemented")
+}
+func (UnimplementedHooksServer) OnSharedChannelsAttachmentSyncMsg(context.Context, *OnSharedChannelsAttachmentSyncMsgRequest) (*OnSharedChannelsAttachmentSyncMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnSharedChannelsAttachmentSyncMsg not implemented")
+}
+func (UnimplementedHooksServer) OnSharedChannelsProfileImageSyncMsg(context.Context, *OnSharedChannelsProfileImageSyncMsgRequest) (*OnSharedChannelsProfileImageSyncMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnSharedChannelsProfileImageSyncMsg not implemented")
+}
+func (UnimplementedHooksServer) GenerateSupportData(context.Context, *GenerateSupportDataRequest) (*GenerateSupportDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateSupportData not implemented")
+}
+func (UnimplementedHooksServer) OnSAMLLogin(context.Context, *OnSAMLLoginRequest) (*OnSAMLLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnSAMLLogin not implemented")
+}
+func (UnimplementedHooksServer) mustEmbedUnimplementedHooksServer() {}
+func (UnimplementedHooksServer) testEmbeddedByValue()               {}
+
+// UnsafeHooksServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HooksServer will
+// result in compilation errors.
+type UnsafeHooksServer interface {
+	mustEmbedUnimplementedHooksServer()
+}
+
+func RegisterHooksServer(s grpc.ServiceRegistrar, srv HooksServer) {
+	// If the following call pancis, it indicates UnimplementedHooksServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Hooks_ServiceDesc, srv)
+}
+
+func _Hooks_Implemented_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImplementedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).Implemented(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_Implemented_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).Implemented(ctx, req.(*ImplementedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_OnActivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnActivateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).OnActivate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_OnActivate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).OnActivate(ctx, req.(*OnActivateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_ServeHTTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HTTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).ServeHTTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_ServeHTTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).ServeHTTP(ctx, req.(*HTTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_ServeMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HTTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).ServeMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_ServeMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).ServeMetrics(ctx, req.(*HTTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_OnDeactivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnDeactivateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).OnDeactivate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_OnDeactivate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).OnDeactivate(ctx, req.(*OnDeactivateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_OnConfigurationChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnConfigurationChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).OnConfigurationChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_OnConfigurationChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).OnConfigurationChange(ctx, req.(*OnConfigurationChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_ExecuteCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).ExecuteCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_ExecuteCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).ExecuteCommand(ctx, req.(*ExecuteCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_UserHasBeenCreated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserHasBeenCreatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).UserHasBeenCreated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_UserHasBeenCreated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).UserHasBeenCreated(ctx, req.(*UserHasBeenCreatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_UserWillLogIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserWillLogInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).UserWillLogIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_UserWillLogIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).UserWillLogIn(ctx, req.(*UserWillLogInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_UserHasLoggedIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserHasLoggedInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).UserHasLoggedIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_UserHasLoggedIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).UserHasLoggedIn(ctx, req.(*UserHasLoggedInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_MessageWillBePosted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageWillBePostedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).MessageWillBePosted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_MessageWillBePosted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).MessageWillBePosted(ctx, req.(*MessageWillBePostedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_MessageWillBeUpdated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageWillBeUpdatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).MessageWillBeUpdated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_MessageWillBeUpdated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).MessageWillBeUpdated(ctx, req.(*MessageWillBeUpdatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_MessageHasBeenPosted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageHasBeenPostedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).MessageHasBeenPosted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_MessageHasBeenPosted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).MessageHasBeenPosted(ctx, req.(*MessageHasBeenPostedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_MessageHasBeenUpdated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageHasBeenUpdatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).MessageHasBeenUpdated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_MessageHasBeenUpdated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).MessageHasBeenUpdated(ctx, req.(*MessageHasBeenUpdatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_MessagesWillBeConsumed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessagesWillBeConsumedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).MessagesWillBeConsumed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_MessagesWillBeConsumed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).MessagesWillBeConsumed(ctx, req.(*MessagesWillBeConsumedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_MessageHasBeenDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageHasBeenDeletedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).MessageHasBeenDeleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_MessageHasBeenDeleted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).MessageHasBeenDeleted(ctx, req.(*MessageHasBeenDeletedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_ChannelWillBeCreated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelWillBeCreatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).ChannelWillBeCreated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_ChannelWillBeCreated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).ChannelWillBeCreated(ctx, req.(*ChannelWillBeCreatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_ChannelHasBeenCreated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelHasBeenCreatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).ChannelHasBeenCreated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_ChannelHasBeenCreated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).ChannelHasBeenCreated(ctx, req.(*ChannelHasBeenCreatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_ChannelHasBeenUpdated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelHasBeenUpdatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).ChannelHasBeenUpdated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_ChannelHasBeenUpdated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).ChannelHasBeenUpdated(ctx, req.(*ChannelHasBeenUpdatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_ChannelHasBeenArchived_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelHasBeenArchivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).ChannelHasBeenArchived(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_ChannelHasBeenArchived_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).ChannelHasBeenArchived(ctx, req.(*ChannelHasBeenArchivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_ChannelHasBeenRestored_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelHasBeenRestoredRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).ChannelHasBeenRestored(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_ChannelHasBeenRestored_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).ChannelHasBeenRestored(ctx, req.(*ChannelHasBeenRestoredRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_ChannelHasBeenDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelHasBeenDeletedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).ChannelHasBeenDeleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_ChannelHasBeenDeleted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).ChannelHasBeenDeleted(ctx, req.(*ChannelHasBeenDeletedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_UserHasJoinedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserHasJoinedChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).UserHasJoinedChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_UserHasJoinedChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).UserHasJoinedChannel(ctx, req.(*UserHasJoinedChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_UserHasLeftChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserHasLeftChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).UserHasLeftChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_UserHasLeftChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).UserHasLeftChannel(ctx, req.(*UserHasLeftChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_UserHasJoinedTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserHasJoinedTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).UserHasJoinedTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_UserHasJoinedTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).UserHasJoinedTeam(ctx, req.(*UserHasJoinedTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_UserHasLeftTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserHasLeftTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).UserHasLeftTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_UserHasLeftTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).UserHasLeftTeam(ctx, req.(*UserHasLeftTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_TeamHasBeenCreated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeamHasBeenCreatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).TeamHasBeenCreated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_TeamHasBeenCreated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).TeamHasBeenCreated(ctx, req.(*TeamHasBeenCreatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_TeamHasBeenUpdated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeamHasBeenUpdatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).TeamHasBeenUpdated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_TeamHasBeenUpdated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).TeamHasBeenUpdated(ctx, req.(*TeamHasBeenUpdatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_UserHasJoinedGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserHasJoinedGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).UserHasJoinedGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_UserHasJoinedGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).UserHasJoinedGroup(ctx, req.(*UserHasJoinedGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_UserHasLeftGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserHasLeftGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).UserHasLeftGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_UserHasLeftGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).UserHasLeftGroup(ctx, req.(*UserHasLeftGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_FileWillBeUploaded_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileWillBeUploadedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).FileWillBeUploaded(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_FileWillBeUploaded_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).FileWillBeUploaded(ctx, req.(*FileWillBeUploadedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_ReactionHasBeenAdded_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactionHasBeenAddedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).ReactionHasBeenAdded(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_ReactionHasBeenAdded_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).ReactionHasBeenAdded(ctx, req.(*ReactionHasBeenAddedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_ReactionHasBeenRemoved_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactionHasBeenRemovedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).ReactionHasBeenRemoved(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_ReactionHasBeenRemoved_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).ReactionHasBeenRemoved(ctx, req.(*ReactionHasBeenRemovedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_OnPluginClusterEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnPluginClusterEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).OnPluginClusterEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_OnPluginClusterEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).OnPluginClusterEvent(ctx, req.(*OnPluginClusterEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_OnWebSocketConnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnWebSocketConnectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).OnWebSocketConnect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_OnWebSocketConnect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).OnWebSocketConnect(ctx, req.(*OnWebSocketConnectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_OnWebSocketDisconnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnWebSocketDisconnectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).OnWebSocketDisconnect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_OnWebSocketDisconnect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).OnWebSocketDisconnect(ctx, req.(*OnWebSocketDisconnectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_WebSocketMessageHasBeenPosted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebSocketMessageHasBeenPostedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).WebSocketMessageHasBeenPosted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_WebSocketMessageHasBeenPosted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).WebSocketMessageHasBeenPosted(ctx, req.(*WebSocketMessageHasBeenPostedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_RunDataRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunDataRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).RunDataRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_RunDataRetention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).RunDataRetention(ctx, req.(*RunDataRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_OnInstall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnInstallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).OnInstall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_OnInstall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).OnInstall(ctx, req.(*OnInstallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_OnSendDailyTelemetry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnSendDailyTelemetryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).OnSendDailyTelemetry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_OnSendDailyTelemetry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).OnSendDailyTelemetry(ctx, req.(*OnSendDailyTelemetryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_OnCloudLimitsUpdated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnCloudLimitsUpdatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).OnCloudLimitsUpdated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_OnCloudLimitsUpdated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).OnCloudLimitsUpdated(ctx, req.(*OnCloudLimitsUpdatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_ConfigurationWillBeSaved_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigurationWillBeSavedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).ConfigurationWillBeSaved(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_ConfigurationWillBeSaved_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).ConfigurationWillBeSaved(ctx, req.(*ConfigurationWillBeSavedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_EmailNotificationWillBeSent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmailNotificationWillBeSentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).EmailNotificationWillBeSent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_EmailNotificationWillBeSent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).EmailNotificationWillBeSent(ctx, req.(*EmailNotificationWillBeSentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_NotificationWillBePushed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationWillBePushedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).NotificationWillBePushed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_NotificationWillBePushed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).NotificationWillBePushed(ctx, req.(*NotificationWillBePushedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_UserHasBeenDeactivated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserHasBeenDeactivatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).UserHasBeenDeactivated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_UserHasBeenDeactivated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).UserHasBeenDeactivated(ctx, req.(*UserHasBeenDeactivatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_OnSharedChannelsSyncMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnSharedChannelsSyncMsgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).OnSharedChannelsSyncMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_OnSharedChannelsSyncMsg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).OnSharedChannelsSyncMsg(ctx, req.(*OnSharedChannelsSyncMsgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_OnSharedChannelsPing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnSharedChannelsPingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).OnSharedChannelsPing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_OnSharedChannelsPing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).OnSharedChannelsPing(ctx, req.(*OnSharedChannelsPingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_PreferencesHaveChanged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreferencesHaveChangedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).PreferencesHaveChanged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_PreferencesHaveChanged_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).PreferencesHaveChanged(ctx, req.(*PreferencesHaveChangedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_OnSharedChannelsAttachmentSyncMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnSharedChannelsAttachmentSyncMsgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).OnSharedChannelsAttachmentSyncMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_OnSharedChannelsAttachmentSyncMsg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).OnSharedChannelsAttachmentSyncMsg(ctx, req.(*OnSharedChannelsAttachmentSyncMsgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_OnSharedChannelsProfileImageSyncMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnSharedChannelsProfileImageSyncMsgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).OnSharedChannelsProfileImageSyncMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_OnSharedChannelsProfileImageSyncMsg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).OnSharedChannelsProfileImageSyncMsg(ctx, req.(*OnSharedChannelsProfileImageSyncMsgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_GenerateSupportData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateSupportDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).GenerateSupportData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_GenerateSupportData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).GenerateSupportData(ctx, req.(*GenerateSupportDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_OnSAMLLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnSAMLLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).OnSAMLLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_OnSAMLLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).OnSAMLLogin(ctx, req.(*OnSAMLLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Hooks_ServiceDesc is the grpc.ServiceDesc for Hooks service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Hooks_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mattermost.plugin.v1.Hooks",
+	HandlerType: (*HooksServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Implemented",
+			Handler:    _Hooks_Implemented_Handler,
+		},
+		{
+			MethodName: "OnActivate",
+			Handler:    _Hooks_OnActivate_Handler,
+		},
+		{
+			MethodName: "ServeHTTP",
+			Handler:    _Hooks_ServeHTTP_Handler,
+		},
+		{
+			MethodName: "ServeMetrics",
+			Handler:    _Hooks_ServeMetrics_Handler,
+		},
+		{
+			MethodName: "OnDeactivate",
+			Handler:    _Hooks_OnDeactivate_Handler,
+		},
+		{
+			MethodName: "OnConfigurationChange",
+			Handler:    _Hooks_OnConfigurationChange_Handler,
+		},
+		{
+			MethodName: "ExecuteCommand",
+			Handler:    _Hooks_ExecuteCommand_Handler,
+		},
+		{
+			MethodName: "UserHasBeenCreated",
+			Handler:    _Hooks_UserHasBeenCreated_Handler,
+		},
+		{
+			MethodName: "UserWillLogIn",
+			Handler:    _Hooks_UserWillLogIn_Handler,
+		},
+		{
+			MethodName: "UserHasLoggedIn",
+			Handler:    _Hooks_UserHasLoggedIn_Handler,
+		},
+		{
+			MethodName: "MessageWillBePosted",
+			Handler:    _Hooks_MessageWillBePosted_Handler,
+		},
+		{
+			MethodName: "MessageWillBeUpdated",
+			Handler:    _Hooks_MessageWillBeUpdated_Handler,
+		},
+		{
+			MethodName: "MessageHasBeenPosted",
+			Handler:    _Hooks_MessageHasBeenPosted_Handler,
+		},
+		{
+			MethodName: "MessageHasBeenUpdated",
+			Handler:    _Hooks_MessageHasBeenUpdated_Handler,
+		},
+		{
+			MethodName: "MessagesWillBeConsumed",
+			Handler:    _Hooks_MessagesWillBeConsumed_Handler,
+		},
+		{
+			MethodName: "MessageHasBeenDeleted",
+			Handler:    _Hooks_MessageHasBeenDeleted_Handler,
+		},
+		{
+			MethodName: "ChannelWillBeCreated",
+			Handler:    _Hooks_ChannelWillBeCreated_Handler,
+		},
+		{
+			MethodName: "ChannelHasBeenCreated",
+			Handler:    _Hooks_ChannelHasBeenCreated_Handler,
+		},
+		{
+			MethodName: "ChannelHasBeenUpdated",
+			Handler:    _Hooks_ChannelHasBeenUpdated_Handler,
+		},
+		{
+			MethodName: "ChannelHasBeenArchived",
+			Handler:    _Hooks_ChannelHasBeenArchived_Handler,
+		},
+		{
+			MethodName: "ChannelHasBeenRestored",
+			Handler:    _Hooks_ChannelHasBeenRestored_Handler,
+		},
+		{
+			MethodName: "ChannelHasBeenDeleted",
+			Handler:    _Hooks_ChannelHasBeenDeleted_Handler,
+		},
+		{
+			MethodName: "UserHasJoinedChannel",
+			Handler:    _Hooks_UserHasJoinedChannel_Handler,
+		},
+		{
+			MethodName: "UserHasLeftChannel",
+			Handler:    _Hooks_UserHasLeftChannel_Handler,
+		},
+		{
+			MethodName: "UserHasJoinedTeam",
+			Handler:    _Hooks_UserHasJoinedTeam_Handler,
+		},
+		{
+			MethodName: "UserHasLeftTeam",
+			Handler:    _Hooks_UserHasLeftTeam_Handler,
+		},
+		{
+			MethodName: "TeamHasBeenCreated",
+			Handler:    _Hooks_TeamHasBeenCreated_Handler,
+		},
+		{
+			MethodName: "TeamHasBeenUpdated",
+			Handler:    _Hooks_TeamHasBeenUpdated_Handler,
+		},
+		{
+			MethodName: "UserHasJoinedGroup",
+			Handler:    _Hooks_UserHasJoinedGroup_Handler,
+		},
+		{
+			MethodName: "UserHasLeftGroup",
+			Handler:    _Hooks_UserHasLeftGroup_Handler,
+		},
+		{
+			MethodName: "FileWillBeUploaded",
+			Handler:    _Hooks_FileWillBeUploaded_Handler,
+		},
+		{
+			MethodName: "ReactionHasBeenAdded",
+			Handler:    _Hooks_ReactionHasBeenAdded_Handler,
+		},
+		{
+			MethodName: "ReactionHasBeenRemoved",
+			Handler:    _Hooks_ReactionHasBeenRemoved_Handler,
+		},
+		{
+			MethodName: "OnPluginClusterEvent",
+			Handler:    _Hooks_OnPluginClusterEvent_Handler,
+		},
+		{
+			MethodName: "OnWebSocketConnect",
+			Handler:    _Hooks_OnWebSocketConnect_Handler,
+		},
+		{
+			MethodName: "OnWebSocketDisconnect",
+			Handler:    _Hooks_OnWebSocketDisconnect_Handler,
+		},
+		{
+			MethodName: "WebSocketMessageHasBeenPosted",
+			Handler:    _Hooks_WebSocketMessageHasBeenPosted_Handler,
+		},
+		{
+			MethodName: "RunDataRetention",
+			Handler:    _Hooks_RunDataRetention_Handler,
+		},
+		{
+			MethodName: "OnInstall",
+			Handler:    _Hooks_OnInstall_Handler,
+		},
+		{
+			MethodName: "OnSendDailyTelemetry",
+			Handler:    _Hooks_OnSendDailyTelemetry_Handler,
+		},
+		{
+			MethodName: "OnCloudLimitsUpdated",
+			Handler:    _Hooks_OnCloudLimitsUpdated_Handler,
+		},
+		{
+			MethodName: "ConfigurationWillBeSaved",
+			Handler:    _Hooks_ConfigurationWillBeSaved_Handler,
+		},
+		{
+			MethodName: "EmailNotificationWillBeSent",
+			Handler:    _Hooks_EmailNotificationWillBeSent_Handler,
+		},
+		{
+			MethodName: "NotificationWillBePushed",
+			Handler:    _Hooks_NotificationWillBePushed_Handler,
+		},
+		{
+			MethodName: "UserHasBeenDeactivated",
+			Handler:    _Hooks_UserHasBeenDeactivated_Handler,
+		},
+		{
+			MethodName: "OnSharedChannelsSyncMsg",
+			Handler:    _Hooks_OnSharedChannelsSyncMsg_Handler,
+		},
+		{
+			MethodName: "OnSharedChannelsPing",
+			Handler:    _Hooks_OnSharedChannelsPing_Handler,
+		},
+		{
+			MethodName: "PreferencesHaveChanged",
+			Handler:    _Hooks_PreferencesHaveChanged_Handler,
+		},
+		{
+			MethodName: "OnSharedChannelsAttachmentSyncMsg",
+			Handler:    _Hooks_OnSharedChannelsAttachmentSyncMsg_Handler,
+		},
+		{
+			MethodName: "OnSharedChannelsProfileImageSyncMsg",
+			Handler:    _Hooks_OnSharedChannelsProfileImageSyncMsg_Handler,
+		},
+		{
+			MethodName: "GenerateSupportData",
+			Handler:    _Hooks_GenerateSupportData_Handler,
+		},
+		{
+			MethodName: "OnSAMLLogin",
+			Handler:    _Hooks_OnSAMLLogin_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mattermost_plugin.proto",
+}
+
+const (
+	API_LoadPluginConfiguration_FullMethodName           = "/mattermost.plugin.v1.API/LoadPluginConfiguration"
+	API_RegisterCommand_FullMethodName                   = "/mattermost.plugin.v1.API/RegisterCommand"
+	API_UnregisterCommand_FullMethodName                 = "/mattermost.plugin.v1.API/UnregisterCommand"
+	API_ExecuteSlashCommand_FullMethodName               = "/mattermost.plugin.v1.API/ExecuteSlashCommand"
+	API_GetConfig_FullMethodName                         = "/mattermost.plugin.v1.API/GetConfig"
+	API_GetUnsanitizedConfig_FullMethodName              = "/mattermost.plugin.v1.API/GetUnsanitizedConfig"
+	API_SaveConfig_FullMethodName                        = "/mattermost.plugin.v1.API/SaveConfig"
+	API_GetPluginConfig_FullMethodName                   = "/mattermost.plugin.v1.API/GetPluginConfig"
+	API_SavePluginConfig_FullMethodName                  = "/mattermost.plugin.v1.API/SavePluginConfig"
+	API_GetBundlePath_FullMethodName                     = "/mattermost.plugin.v1.API/GetBundlePath"
+	API_GetLicense_FullMethodName                        = "/mattermost.plugin.v1.API/GetLicense"
+	API_IsEnterpriseReady_FullMethodName                 = "/mattermost.plugin.v1.API/IsEnterpriseReady"
+	API_GetServerVersion_FullMethodName                  = "/mattermost.plugin.v1.API/GetServerVersion"
+	API_GetSystemInstallDate_FullMethodName              = "/mattermost.plugin.v1.API/GetSystemInstallDate"
+	API_GetDiagnosticId_FullMethodName                   = "/mattermost.plugin.v1.API/GetDiagnosticId"
+	API_GetTelemetryId_FullMethodName                    = "/mattermost.plugin.v1.API/GetTelemetryId"
+	API_CreateUser_FullMethodName                        = "/mattermost.plugin.v1.API/CreateUser"
+	API_DeleteUser_FullMethodName                        = "/mattermost.plugin.v1.API/DeleteUser"
+	API_GetUsers_FullMethodName                          = "/mattermost.plugin.v1.API/GetUsers"
+	API_GetUsersByIds_FullMethodName                     = "/mattermost.plugin.v1.API/GetUsersByIds"
+	API_GetUser_FullMethodName                           = "/mattermost.plugin.v1.API/GetUser"
+	API_GetUserByEmail_FullMethodName                    = "/mattermost.plugin.v1.API/GetUserByEmail"
+	API_GetUserByUsername_FullMethodName                 = "/mattermost.plugin.v1.API/GetUserByUsername"
+	API_GetUsersByUsernames_FullMethodName               = "/mattermost.plugin.v1.API/GetUsersByUsernames"
+	API_GetUsersInTeam_FullMethodName                    = "/mattermost.plugin.v1.API/GetUsersInTeam"
+	API_GetPreferenceForUser_FullMethodName              = "/mattermost.plugin.v1.API/GetPreferenceForUser"
+	API_GetPreferencesForUser_FullMethodName             = "/mattermost.plugin.v1.API/GetPreferencesForUser"
+	API_UpdatePreferencesForUser_FullMethodName          = "/mattermost.plugin.v1.API/UpdatePreferencesForUser"
+	API_DeletePreferencesForUser_FullMethodName          = "/mattermost.plugin.v1.API/DeletePreferencesForUser"
+	API_GetSession_FullMethodName                        = "/mattermost.plugin.v1.API/GetSession"
+	API_CreateSession_FullMethodName                     = "/mattermost.plugin.v1.API/CreateSession"
+	API_ExtendSessionExpiry_FullMethodName               = "/mattermost.plugin.v1.API/ExtendSessionExpiry"
+	API_RevokeSession_FullMethodName                     = "/mattermost.plugin.v1.API/RevokeSession"
+	API_CreateUserAccessToken_FullMethodName             = "/mattermost.plugin.v1.API/CreateUserAccessToken"
+	API_RevokeUserAccessToken_FullMethodName             = "/mattermost.plugin.v1.API/RevokeUserAccessToken"
+	API_GetTeamIcon_FullMethodName                       = "/mattermost.plugin.v1.API/GetTeamIcon"
+	API_SetTeamIcon_FullMethodName                       = "/mattermost.plugin.v1.API/SetTeamIcon"
+	API_RemoveTeamIcon_FullMethodName                    = "/mattermost.plugin.v1.API/RemoveTeamIcon"
+	API_UpdateUser_FullMethodName                        = "/mattermost.plugin.v1.API/UpdateUser"
+	API_GetUserStatus_FullMethodName                     = "/mattermost.plugin.v1.API/GetUserStatus"
+	API_GetUserStatusesByIds_FullMethodName              = "/mattermost.plugin.v1.API/GetUserStatusesByIds"
+	API_UpdateUserStatus_FullMethodName                  = "/mattermost.plugin.v1.API/UpdateUserStatus"
+	API_SetUserStatusTimedDND_FullMethodName             = "/mattermost.plugin.v1.API/SetUserStatusTimedDND"
+	API_UpdateUserActive_FullMethodName                  = "/mattermost.plugin.v1.API/UpdateUserActive"
+	API_UpdateUserCustomStatus_FullMethodName            = "/mattermost.plugin.v1.API/UpdateUserCustomStatus"
+	API_RemoveUserCustomStatus_FullMethodName            = "/mattermost.plugin.v1.API/RemoveUserCustomStatus"
+	API_GetUsersInChannel_FullMethodName                 = "/mattermost.plugin.v1.API/GetUsersInChannel"
+	API_GetLDAPUserAttributes_FullMethodName             = "/mattermost.plugin.v1.API/GetLDAPUserAttributes"
+	API_CreateTeam_FullMethodName                        = "/mattermost.plugin.v1.API/CreateTeam"
+	API_DeleteTeam_FullMethodName                        = "/mattermost.plugin.v1.API/DeleteTeam"
+	API_GetTeams_FullMethodName                          = "/mattermost.plugin.v1.API/GetTeams"
+	API_GetTeam_FullMethodName                           = "/mattermost.plugin.v1.API/GetTeam"
+	API_GetTeamByName_FullMethodName                     = "/mattermost.plugin.v1.API/GetTeamByName"
+	API_GetTeamsUnreadForUser_FullMethodName             = "/mattermost.plugin.v1.API/GetTeamsUnreadForUser"
+	API_UpdateTeam_FullMethodName                        = "/mattermost.plugin.v1.API/UpdateTeam"
+	API_SearchTeams_FullMethodName                       = "/mattermost.plugin.v1.API/SearchTeams"
+	API_GetTeamsForUser_FullMethodName                   = "/mattermost.plugin.v1.API/GetTeamsForUser"
+	API_CreateTeamMember_FullMethodName                  = "/mattermost.plugin.v1.API/CreateTeamMember"
+	API_CreateTeamMembers_FullMethodName                 = "/mattermost.plugin.v1.API/CreateTeamMembers"
+	API_CreateTeamMembersGracefully_FullMethodName       = "/mattermost.plugin.v1.API/CreateTeamMembersGracefully"
+	API_DeleteTeamMember_FullMethodName                  = "/mattermost.plugin.v1.API/DeleteTeamMember"
+	API_GetTeamMembers_FullMethodName                    = "/mattermost.plugin.v1.API/GetTeamMembers"
+	API_GetTeamMember_FullMethodName                     = "/mattermost.plugin.v1.API/GetTeamMember"
+	API_GetTeamMembersForUser_FullMethodName             = "/mattermost.plugin.v1.API/GetTeamMembersForUser"
+	API_UpdateTeamMemberRoles_FullMethodName             = "/mattermost.plugin.v1.API/UpdateTeamMemberRoles"
+	API_CreateChannel_FullMethodName                     = "/mattermost.plugin.v1.API/CreateChannel"
+	API_DeleteChannel_FullMethodName                     = "/mattermost.plugin.v1.API/DeleteChannel"
+	API_GetPublicChannelsForTeam_FullMethodName          = "/mattermost.plugin.v1.API/GetPublicChannelsForTeam"
+	API_GetChannel_FullMethodName                        = "/mattermost.plugin.v1.API/GetChannel"
+	API_GetChannelByName_FullMethodName                  = "/mattermost.plugin.v1.API/GetChannelByName"
+	API_GetChannelByNameForTeamName_FullMethodName       = "/mattermost.plugin.v1.API/GetChannelByNameForTeamName"
+	API_GetChannelsForTeamForUser_FullMethodName         = "/mattermost.plugin.v1.API/GetChannelsForTeamForUser"
+	API_GetChannelStats_FullMethodName                   = "/mattermost.plugin.v1.API/GetChannelStats"
+	API_GetDirectChannel_FullMethodName                  = "/mattermost.plugin.v1.API/GetDirectChannel"
+	API_GetGroupChannel_FullMethodName                   = "/mattermost.plugin.v1.API/GetGroupChannel"
+	API_UpdateChannel_FullMethodName                     = "/mattermost.plugin.v1.API/UpdateChannel"
+	API_SearchChannels_FullMethodName                    = "/mattermost.plugin.v1.API/SearchChannels"
+	API_CreateChannelSidebarCategory_FullMethodName      = "/mattermost.plugin.v1.API/CreateChannelSidebarCategory"
+	API_GetChannelSidebarCategories_FullMethodName       = "/mattermost.plugin.v1.API/GetChannelSidebarCategories"
+	API_UpdateChannelSidebarCategories_FullMethodName    = "/mattermost.plugin.v1.API/UpdateChannelSidebarCategories"
+	API_SearchUsers_FullMethodName                       = "/mattermost.plugin.v1.API/SearchUsers"
+	API_SearchPostsInTeam_FullMethodName                 = "/mattermost.plugin.v1.API/SearchPostsInTeam"
+	API_SearchPostsInTeamForUser_FullMethodName          = "/mattermost.plugin.v1.API/SearchPostsInTeamForUser"
+	API_AddChannelMember_FullMethodName                  = "/mattermost.plugin.v1.API/AddChannelMember"
+	API_AddUserToChannel_FullMethodName                  = "/mattermost.plugin.v1.API/AddUserToChannel"
+	API_GetChannelMember_FullMethodName                  = "/mattermost.plugin.v1.API/GetChannelMember"
+	API_GetChannelMembers_FullMethodName                 = "/mattermost.plugin.v1.API/GetChannelMembers"
+	API_GetChannelMembersByIds_FullMethodName            = "/mattermost.plugin.v1.API/GetChannelMembersByIds"
+	API_GetChannelMembersForUser_FullMethodName          = "/mattermost.plugin.v1.API/GetChannelMembersForUser"
+	API_UpdateChannelMemberRoles_FullMethodName          = "/mattermost.plugin.v1.API/UpdateChannelMemberRoles"
+	API_UpdateChannelMemberNotifications_FullMethodName  = "/mattermost.plugin.v1.API/UpdateChannelMemberNotifications"
+	API_PatchChannelMembersNotifications_FullMethodName  = "/mattermost.plugin.v1.API/PatchChannelMembersNotifications"
+	API_GetGroup_FullMethodName                          = "/mattermost.plugin.v1.API/GetGroup"
+	API_GetGroupByName_FullMethodName                    = "/mattermost.plugin.v1.API/GetGroupByName"
+	API_GetGroupMemberUsers_FullMethodName               = "/mattermost.plugin.v1.API/GetGroupMemberUsers"
+	API_GetGroupsBySource_FullMethodName                 = "/mattermost.plugin.v1.API/GetGroupsBySource"
+	API_GetGroupsForUser_FullMethodName                  = "/mattermost.plugin.v1.API/GetGroupsForUser"
+	API_DeleteChannelMember_FullMethodName               = "/mattermost.plugin.v1.API/DeleteChannelMember"
+	API_CreatePost_FullMethodName                        = "/mattermost.plugin.v1.API/CreatePost"
+	API_AddReaction_FullMethodName                       = "/mattermost.plugin.v1.API/AddReaction"
+	API_RemoveReaction_FullMethodName                    = "/mattermost.plugin.v1.API/RemoveReaction"
+	API_GetReactions_FullMethodName                      = "/mattermost.plugin.v1.API/GetReactions"
+	API_SendEphemeralPost_FullMethodName                 = "/mattermost.plugin.v1.API/SendEphemeralPost"
+	API_UpdateEphemeralPost_FullMethodName               = "/mattermost.plugin.v1.API/UpdateEphemeralPost"
+	API_DeleteEphemeralPost_FullMethodName               = "/mattermost.plugin.v1.API/DeleteEphemeralPost"
+	API_DeletePost_FullMethodName                        = "/mattermost.plugin.v1.API/DeletePost"
+	API_GetPostThread_FullMethodName                     = "/mattermost.plugin.v1.API/GetPostThread"
+	API_GetPost_FullMethodName                           = "/mattermost.plugin.v1.API/GetPost"
+	API_GetPostsSince_FullMethodName                     = "/mattermost.plugin.v1.API/GetPostsSince"
+	API_GetPostsAfter_FullMethodName                     = "/mattermost.plugin.v1.API/GetPostsAfter"
+	API_GetPostsBefore_FullMethodName                    = "/mattermost.plugin.v1.API/GetPostsBefore"
+	API_GetPostsForChannel_FullMethodName                = "/mattermost.plugin.v1.API/GetPostsForChannel"
+	API_GetTeamStats_FullMethodName                      = "/mattermost.plugin.v1.API/GetTeamStats"
+	API_UpdatePost_FullMethodName                        = "/mattermost.plugin.v1.API/UpdatePost"
+	API_GetProfileImage_FullMethodName                   = "/mattermost.plugin.v1.API/GetProfileImage"
+	API_SetProfileImage_FullMethodName                   = "/mattermost.plugin.v1.API/SetProfileImage"
+	API_GetEmojiList_FullMethodName                      = "/mattermost.plugin.v1.API/GetEmojiList"
+	API_GetEmojiByName_FullMethodName                    = "/mattermost.plugin.v1.API/GetEmojiByName"
+	API_GetEmoji_FullMethodName                          = "/mattermost.plugin.v1.API/GetEmoji"
+	API_CopyFileInfos_FullMethodName                     = "/mattermost.plugin.v1.API/CopyFileInfos"
+	API_GetFileInfo_FullMethodName                       = "/mattermost.plugin.v1.API/GetFileInfo"
+	API_SetFileSearchableContent_FullMethodName          = "/mattermost.plugin.v1.API/SetFileSearchableContent"
+	API_GetFileInfos_FullMethodName                      = "/mattermost.plugin.v1.API/GetFileInfos"
+	API_GetFile_FullMethodName                           = "/mattermost.plugin.v1.API/GetFile"
+	API_GetFileLink_FullMethodName                       = "/mattermost.plugin.v1.API/GetFileLink"
+	API_ReadFile_FullMethodName                          = "/mattermost.plugin.v1.API/ReadFile"
+	API_GetEmojiImage_FullMethodName                     = "/mattermost.plugin.v1.API/GetEmojiImage"
+	API_UploadFile_FullMethodName                        = "/mattermost.plugin.v1.API/UploadFile"
+	API_OpenInteractiveDialog_FullMethodName             = "/mattermost.plugin.v1.API/OpenInteractiveDialog"
+	API_GetPlugins_FullMethodName                        = "/mattermost.plugin.v1.API/GetPlugins"
+	API_EnablePlugin_FullMethodName                      = "/mattermost.plugin.v1.API/EnablePlugin"
+	API_DisablePlugin_FullMethodName                     = "/mattermost.plugin.v1.API/DisablePlugin"
+	API_RemovePlugin_FullMethodName                      = "/mattermost.plugin.v1.API/RemovePlugin"
+	API_GetPluginStatus_FullMethodName                   = "/mattermost.plugin.v1.API/GetPluginStatus"
+	API_InstallPlugin_FullMethodName                     = "/mattermost.plugin.v1.API/InstallPlugin"
+	API_KVSet_FullMethodName                             = "/mattermost.plugin.v1.API/KVSet"
+	API_KVCompareAndSet_FullMethodName                   = "/mattermost.plugin.v1.API/KVCompareAndSet"
+	API_KVCompareAndDelete_FullMethodName                = "/mattermost.plugin.v1.API/KVCompareAndDelete"
+	API_KVSetWithOptions_FullMethodName                  = "/mattermost.plugin.v1.API/KVSetWithOptions"
+	API_KVSetWithExpiry_FullMethodName                   = "/mattermost.plugin.v1.API/KVSetWithExpiry"
+	API_KVGet_FullMethodName                             = "/mattermost.plugin.v1.API/KVGet"
+	API_KVDelete_FullMethodName                          = "/mattermost.plugin.v1.API/KVDelete"
+	API_KVDeleteAll_FullMethodName                       = "/mattermost.plugin.v1.API/KVDeleteAll"
+	API_KVList_FullMethodName                            = "/mattermost.plugin.v1.API/KVList"
+	API_PublishWebSocketEvent_FullMethodName             = "/mattermost.plugin.v1.API/PublishWebSocketEvent"
+	API_HasPermissionTo_FullMethodName                   = "/mattermost.plugin.v1.API/HasPermissionTo"
+	API_HasPermissionToTeam_FullMethodName               = "/mattermost.plugin.v1.API/HasPermissionToTeam"
+	API_HasPermissionToChannel_FullMethodName            = "/mattermost.plugin.v1.API/HasPermissionToChannel"
+	API_RolesGrantPermission_FullMethodName              = "/mattermost.plugin.v1.API/RolesGrantPermission"
+	API_LogDebug_FullMethodName                          = "/mattermost.plugin.v1.API/LogDebug"
+	API_LogInfo_FullMethodName                           = "/mattermost.plugin.v1.API/LogInfo"
+	API_LogError_FullMethodName                          = "/mattermost.plugin.v1.API/LogError"
+	API_LogWarn_FullMethodName                           = "/mattermost.plugin.v1.API/LogWarn"
+	API_SendMail_FullMethodName                          = "/mattermost.plugin.v1.API/SendMail"
+	API_CreateBot_FullMethodName                         = "/mattermost.plugin.v1.API/CreateBot"
+	API_PatchBot_FullMethodName                          = "/mattermost.plugin.v1.API/PatchBot"
+	API_GetBot_FullMethodName                            = "/mattermost.plugin.v1.API/GetBot"
+	API_GetBots_FullMethodName                           = "/mattermost.plugin.v1.API/GetBots"
+	API_UpdateBotActive_FullMethodName                   = "/mattermost.plugin.v1.API/UpdateBotActive"
+	API_PermanentDeleteBot_FullMethodName                = "/mattermost.plugin.v1.API/PermanentDeleteBot"
+	API_PublishUserTyping_FullMethodName                 = "/mattermost.plugin.v1.API/PublishUserTyping"
+	API_CreateCommand_FullMethodName                     = "/mattermost.plugin.v1.API/CreateCommand"
+	API_ListCommands_FullMethodName                      = "/mattermost.plugin.v1.API/ListCommands"
+	API_ListCustomCommands_FullMethodName                = "/mattermost.plugin.v1.API/ListCustomCommands"
+	API_ListPluginCommands_FullMethodName                = "/mattermost.plugin.v1.API/ListPluginCommands"
+	API_ListBuiltInCommands_FullMethodName               = "/mattermost.plugin.v1.API/ListBuiltInCommands"
+	API_GetCommand_FullMethodName                        = "/mattermost.plugin.v1.API/GetCommand"
+	API_UpdateCommand_FullMethodName                     = "/mattermost.plugin.v1.API/UpdateCommand"
+	API_DeleteCommand_FullMethodName                     = "/mattermost.plugin.v1.API/DeleteCommand"
+	API_CreateOAuthApp_FullMethodName                    = "/mattermost.plugin.v1.API/CreateOAuthApp"
+	API_GetOAuthApp_FullMethodName                       = "/mattermost.plugin.v1.API/GetOAuthApp"
+	API_UpdateOAuthApp_FullMethodName                    = "/mattermost.plugin.v1.API/UpdateOAuthApp"
+	API_DeleteOAuthApp_FullMethodName                    = "/mattermost.plugin.v1.API/DeleteOAuthApp"
+	API_PublishPluginClusterEvent_FullMethodName         = "/mattermost.plugin.v1.API/PublishPluginClusterEvent"
+	API_RequestTrialLicense_FullMethodName               = "/mattermost.plugin.v1.API/RequestTrialLicense"
+	API_GetCloudLimits_FullMethodName                    = "/mattermost.plugin.v1.API/GetCloudLimits"
+	API_EnsureBotUser_FullMethodName                     = "/mattermost.plugin.v1.API/EnsureBotUser"
+	API_RegisterCollectionAndTopic_FullMethodName        = "/mattermost.plugin.v1.API/RegisterCollectionAndTopic"
+	API_CreateUploadSession_FullMethodName               = "/mattermost.plugin.v1.API/CreateUploadSession"
+	API_UploadData_FullMethodName                        = "/mattermost.plugin.v1.API/UploadData"
+	API_GetUploadSession_FullMethodName                  = "/mattermost.plugin.v1.API/GetUploadSession"
+	API_SendPushNotification_FullMethodName              = "/mattermost.plugin.v1.API/SendPushNotification"
+	API_UpdateUserAuth_FullMethodName                    = "/mattermost.plugin.v1.API/UpdateUserAuth"
+	API_RegisterPluginForSharedChannels_FullMethodName   = "/mattermost.plugin.v1.API/RegisterPluginForSharedChannels"
+	API_UnregisterPluginForSharedChannels_FullMethodName = "/mattermost.plugin.v1.API/UnregisterPluginForSharedChannels"
+	API_ShareChannel_FullMethodName                      = "/mattermost.plugin.v1.API/ShareChannel"
+	API_UpdateSharedChannel_FullMethodName               = "/mattermost.plugin.v1.API/UpdateSharedChannel"
+	API_UnshareChannel_FullMethodName                    = "/mattermost.plugin.v1.API/UnshareChannel"
+	API_UpdateSharedChannelCursor_FullMethodName         = "/mattermost.plugin.v1.API/UpdateSharedChannelCursor"
+	API_SyncSharedChannel_FullMethodName                 = "/mattermost.plugin.v1.API/SyncSharedChannel"
+	API_InviteRemoteToChannel_FullMethodName             = "/mattermost.plugin.v1.API/InviteRemoteToChannel"
+	API_UninviteRemoteFromChannel_FullMethodName         = "/mattermost.plugin.v1.API/UninviteRemoteFromChannel"
+	API_UpsertGroupMember_FullMethodName                 = "/mattermost.plugin.v1.API/UpsertGroupMember"
+	API_UpsertGroupMembers_FullMethodName                = "/mattermost.plugin.v1.API/UpsertGroupMembers"
+	API_GetGroupByRemoteID_FullMethodName                = "/mattermost.plugin.v1.API/GetGroupByRemoteID"
+	API_CreateGroup_FullMethodName                       = "/mattermost.plugin.v1.API/CreateGroup"
+	API_UpdateGroup_FullMethodName                       = "/mattermost.plugin.v1.API/UpdateGroup"
+	API_DeleteGroup_FullMethodName                       = "/mattermost.plugin.v1.API/DeleteGroup"
+	API_RestoreGroup_FullMethodName                      = "/mattermost.plugin.v1.API/RestoreGroup"
+	API_DeleteGroupMember_FullMethodName                 = "/mattermost.plugin.v1.API/DeleteGroupMember"
+	API_GetGroupSyncable_FullMethodName                  = "/mattermost.plugin.v1.API/GetGroupSyncable"
+	API_GetGroupSyncables_FullMethodName                 = "/mattermost.plugin.v1.API/GetGroupSyncables"
+	API_UpsertGroupSyncable_FullMethodName               = "/mattermost.plugin.v1.API/UpsertGroupSyncable"
+	API_UpdateGroupSyncable_FullMethodName               = "/mattermost.plugin.v1.API/UpdateGroupSyncable"
+	API_DeleteGroupSyncable_FullMethodName               = "/mattermost.plugin.v1.API/DeleteGroupSyncable"
+	API_UpdateUserRoles_FullMethodName                   = "/mattermost.plugin.v1.API/UpdateUserRoles"
+	API_GetPluginID_FullMethodName                       = "/mattermost.plugin.v1.API/GetPluginID"
+	API_GetGroups_FullMethodName                         = "/mattermost.plugin.v1.API/GetGroups"
+	API_CreateDefaultSyncableMemberships_FullMethodName  = "/mattermost.plugin.v1.API/CreateDefaultSyncableMemberships"
+	API_DeleteGroupConstrainedMemberships_FullMethodName = "/mattermost.plugin.v1.API/DeleteGroupConstrainedMemberships"
+	API_CreatePropertyField_FullMethodName               = "/mattermost.plugin.v1.API/CreatePropertyField"
+	API_GetPropertyField_FullMethodName                  = "/mattermost.plugin.v1.API/GetPropertyField"
+	API_GetPropertyFields_FullMethodName                 = "/mattermost.plugin.v1.API/GetPropertyFields"
+	API_UpdatePropertyField_FullMethodName               = "/mattermost.plugin.v1.API/UpdatePropertyField"
+	API_DeletePropertyField_FullMethodName               = "/mattermost.plugin.v1.API/DeletePropertyField"
+	API_SearchPropertyFields_FullMethodName              = "/mattermost.plugin.v1.API/SearchPropertyFields"
+	API_CountPropertyFields_FullMethodName               = "/mattermost.plugin.v1.API/CountPropertyFields"
+	API_CountPropertyFieldsForTarget_FullMethodName      = "/mattermost.plugin.v1.API/CountPropertyFieldsForTarget"
+	API_CreatePropertyValue_FullMethodName               = "/mattermost.plugin.v1.API/CreatePropertyValue"
+	API_GetPropertyValue_FullMethodName                  = "/mattermost.plugin.v1.API/GetPropertyValue"
+	API_GetPropertyValues_FullMethodName                 = "/mattermost.plugin.v1.API/GetPropertyValues"
+	API_UpdatePropertyValue_FullMethodName               = "/mattermost.plugin.v1.API/UpdatePropertyValue"
+	API_UpsertPropertyValue_FullMethodName               = "/mattermost.plugin.v1.API/UpsertPropertyValue"
+	API_DeletePropertyValue_FullMethodName               = "/mattermost.plugin.v1.API/DeletePropertyValue"
+	API_SearchPropertyValues_FullMethodName              = "/mattermost.plugin.v1.API/SearchPropertyValues"
+	API_RegisterPropertyGroup_FullMethodName             = "/mattermost.plugin.v1.API/RegisterPropertyGroup"
+	API_GetPropertyGroup_FullMethodName                  = "/mattermost.plugin.v1.API/GetPropertyGroup"
+	API_GetPropertyFieldByName_FullMethodName            = "/mattermost.plugin.v1.API/GetPropertyFieldByName"
+	API_UpdatePropertyFields_FullMethodName              = "/mattermost.plugin.v1.API/UpdatePropertyFields"
+	API_UpdatePropertyValues_FullMethodName              = "/mattermost.plugin.v1.API/UpdatePropertyValues"
+	API_UpsertPropertyValues_FullMethodName              = "/mattermost.plugin.v1.API/UpsertPropertyValues"
+	API_DeletePropertyValuesForTarget_FullMethodName     = "/mattermost.plugin.v1.API/DeletePropertyValuesForTarget"
+	API_DeletePropertyValuesForField_FullMethodName      = "/mattermost.plugin.v1.API/DeletePropertyValuesForField"
+	API_LogAuditRec_FullMethodName                       = "/mattermost.plugin.v1.API/LogAuditRec"
+	API_LogAuditRecWithLevel_FullMethodName              = "/mattermost.plugin.v1.API/LogAuditRecWithLevel"
+)
+
+// APIClient is the client API for API service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// API is served by the server, at the target given in OnActivateRequest, and
+// called by the plugin.
+type APIClient interface {
+	// LoadPluginConfiguration returns the configuration of the plugin.
+	LoadPluginConfiguration(ctx context.Context, in *LoadPluginConfigurationRequest, opts ...grpc.CallOption) (*LoadPluginConfigurationResponse, error)
+	RegisterCommand(ctx context.Context, in *RegisterCommandRequest, opts ...grpc.CallOption) (*RegisterCommandResponse, error)
+	UnregisterCommand(ctx context.Context, in *UnregisterCommandRequest, opts ...grpc.CallOption) (*UnregisterCommandResponse, error)
+	ExecuteSlashCommand(ctx context.Context, in *ExecuteSlashCommandRequest, opts ...grpc.CallOption) (*ExecuteSlashCommandResponse, error)
+	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
+	GetUnsanitizedConfig(ctx context.Context, in *GetUnsanitizedConfigRequest, opts ...grpc.CallOption) (*GetUnsanitizedConfigResponse, error)
+	SaveConfig(ctx context.Context, in *SaveConfigRequest, opts ...grpc.CallOption) (*SaveConfigResponse, error)
+	GetPluginConfig(ctx context.Context, in *GetPluginConfigRequest, opts ...grpc.CallOption) (*GetPluginConfigResponse, error)
+	SavePluginConfig(ctx context.Context, in *SavePluginConfigRequest, opts ...grpc.CallOption) (*SavePluginConfigResponse, error)
+	GetBundlePath(ctx context.Context, in *GetBundlePathRequest, opts ...grpc.CallOption) (*GetBundlePathResponse, error)
+	GetLicense(ctx context.Context, in *GetLicenseRequest, opts ...grpc.CallOption) (*GetLicenseResponse, error)
+	IsEnterpriseReady(ctx context.Context, in *IsEnterpriseReadyRequest, opts ...grpc.CallOption) (*IsEnterpriseReadyResponse, error)
+	GetServerVersion(ctx context.Context, in *GetServerVersionRequest, opts ...grpc.CallOption) (*GetServerVersionResponse, error)
+	GetSystemInstallDate(ctx context.Context, in *GetSystemInstallDateRequest, opts ...grpc.CallOption) (*GetSystemInstallDateResponse, error)
+	GetDiagnosticId(ctx context.Context, in *GetDiagnosticIdRequest, opts ...grpc.CallOption) (*GetDiagnosticIdResponse, error)
+	GetTelemetryId(ctx context.Context, in *GetTelemetryIdRequest, opts ...grpc.CallOption) (*GetTelemetryIdResponse, error)
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	GetUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*GetUsersResponse, error)
+	GetUsersByIds(ctx context.Context, in *GetUsersByIdsRequest, opts ...grpc.CallOption) (*GetUsersByIdsResponse, error)
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	GetUserByEmail(ctx context.Context, in *GetUserByEmailRequest, opts ...grpc.CallOption) (*GetUserByEmailResponse, error)
+	GetUserByUsername(ctx context.Context, in *GetUserByUsernameRequest, opts ...grpc.CallOption) (*GetUserByUsernameResponse, error)
+	GetUsersByUsernames(ctx context.Context, in *GetUsersByUsernamesRequest, opts ...grpc.CallOption) (*GetUsersByUsernamesResponse, error)
+	GetUsersInTeam(ctx context.Context, in *GetUsersInTeamRequest, opts ...grpc.CallOption) (*GetUsersInTeamResponse, error)
+	GetPreferenceForUser(ctx context.Context, in *GetPreferenceForUserRequest, opts ...grpc.CallOption) (*GetPreferenceForUserResponse, error)
+	GetPreferencesForUser(ctx context.Context, in *GetPreferencesForUserRequest, opts ...grpc.CallOption) (*GetPreferencesForUserResponse, error)
+	UpdatePreferencesForUser(ctx context.Context, in *UpdatePreferencesForUserRequest, opts ...grpc.CallOption) (*UpdatePreferencesForUserResponse, error)
+	DeletePreferencesForUser(ctx context.Context, in *DeletePreferencesForUserRequest, opts ...grpc.CallOption) (*DeletePreferencesForUserResponse, error)
+	GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*GetSessionResponse, error)
+	CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*CreateSessionResponse, error)
+	ExtendSessionExpiry(ctx context.Context, in *ExtendSessionExpiryRequest, opts ...grpc.CallOption) (*ExtendSessionExpiryResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	CreateUserAccessToken(ctx context.Context, in *CreateUserAccessTokenRequest, opts ...grpc.CallOption) (*CreateUserAccessTokenResponse, error)
+	RevokeUserAccessToken(ctx context.Context, in *RevokeUserAccessTokenRequest, opts ...grpc.CallOption) (*RevokeUserAccessTokenResponse, error)
+	GetTeamIcon(ctx context.Context, in *GetTeamIconRequest, opts ...grpc.CallOption) (*GetTeamIconResponse, error)
+	SetTeamIcon(ctx context.Context, in *SetTeamIconRequest, opts ...grpc.CallOption) (*SetTeamIconResponse, error)
+	RemoveTeamIcon(ctx context.Context, in *RemoveTeamIconRequest, opts ...grpc.CallOption) (*RemoveTeamIconResponse, error)
+	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
+	GetUserStatus(ctx context.Context, in *GetUserStatusRequest, opts ...grpc.CallOption) (*GetUserStatusResponse, error)
+	GetUserStatusesByIds(ctx context.Context, in *GetUserStatusesByIdsRequest, opts ...grpc.CallOption) (*GetUserStatusesByIdsResponse, error)
+	UpdateUserStatus(ctx context.Context, in *UpdateUserStatusRequest, opts ...grpc.CallOption) (*UpdateUserStatusResponse, error)
+	SetUserStatusTimedDND(ctx context.Context, in *SetUserStatusTimedDNDRequest, opts ...grpc.CallOption) (*SetUserStatusTimedDNDResponse, error)
+	UpdateUserActive(ctx context.Context, in *UpdateUserActiveRequest, opts ...grpc.CallOption) (*UpdateUserActiveResponse, error)
+	UpdateUserCustomStatus(ctx context.Context, in *UpdateUserCustomStatusRequest, opts ...grpc.CallOption) (*UpdateUserCustomStatusResponse, error)
+	RemoveUserCustomStatus(ctx context.Context, in *RemoveUserCustomStatusRequest, opts ...grpc.CallOption) (*RemoveUserCustomStatusResponse, error)
+	GetUsersInChannel(ctx context.Context, in *GetUsersInChannelRequest, opts ...grpc.CallOption) (*GetUsersInChannelResponse, error)
+	GetLDAPUserAttributes(ctx context.Context, in *GetLDAPUserAttributesRequest, opts ...grpc.CallOption) (*GetLDAPUserAttributesResponse, error)
+	CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error)
+	DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (*DeleteTeamResponse, error)
+	GetTeams(ctx context.Context, in *GetTeamsRequest, opts ...grpc.CallOption) (*GetTeamsResponse, error)
+	GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*GetTeamResponse, error)
+	GetTeamByName(ctx context.Context, in *GetTeamByNameRequest, opts ...grpc.CallOption) (*GetTeamByNameResponse, error)
+	GetTeamsUnreadForUser(ctx context.Context, in *GetTeamsUnreadForUserRequest, opts ...grpc.CallOption) (*GetTeamsUnreadForUserResponse, error)
+	UpdateTeam(ctx context.Context, in *UpdateTeamRequest, opts ...grpc.CallOption) (*UpdateTeamResponse, error)
+	SearchTeams(ctx context.Context, in *SearchTeamsRequest, opts ...grpc.CallOption) (*SearchTeamsResponse, error)
+	GetTeamsForUser(ctx context.Context, in *GetTeamsForUserRequest, opts ...grpc.CallOption) (*GetTeamsForUserResponse, error)
+	CreateTeamMember(ctx context.Context, in *CreateTeamMemberRequest, opts ...grpc.CallOption) (*CreateTeamMemberResponse, error)
+	CreateTeamMembers(ctx context.Context, in *CreateTeamMembersRequest, opts ...grpc.CallOption) (*CreateTeamMembersResponse, error)
+	CreateTeamMembersGracefully(ctx context.Context, in *CreateTeamMembersGracefullyRequest, opts ...grpc.CallOption) (*CreateTeamMembersGracefullyResponse, error)
+	DeleteTeamMember(ctx context.Context, in *DeleteTeamMemberRequest, opts ...grpc.CallOption) (*DeleteTeamMemberResponse, error)
+	GetTeamMembers(ctx context.Context, in *GetTeamMembersRequest, opts ...grpc.CallOption) (*GetTeamMembersResponse, error)
+	GetTeamMember(ctx context.Context, in *GetTeamMemberRequest, opts ...grpc.CallOption) (*GetTeamMemberResponse, error)
+	GetTeamMembersForUser(ctx context.Context, in *GetTeamMembersForUserRequest, opts ...grpc.CallOption) (*GetTeamMembersForUserResponse, error)
+	UpdateTeamMemberRoles(ctx context.Context, in *UpdateTeamMemberRolesRequest, opts ...grpc.CallOption) (*UpdateTeamMemberRolesResponse, error)
+	CreateChannel(ctx context.Context, in *CreateChannelRequest, opts ...grpc.CallOption) (*CreateChannelResponse, error)
+	DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*DeleteChannelResponse, error)
+	GetPublicChannelsForTeam(ctx context.Context, in *GetPublicChannelsForTeamRequest, opts ...grpc.CallOption) (*GetPublicChannelsForTeamResponse, error)
+	GetChannel(ctx context.Context, in *GetChannelRequest, opts ...grpc.CallOption) (*GetChannelResponse, error)
+	GetChannelByName(ctx context.Context, in *GetChannelByNameRequest, opts ...grpc.CallOption) (*GetChannelByNameResponse, error)
+	GetChannelByNameForTeamName(ctx context.Context, in *GetChannelByNameForTeamNameRequest, opts ...grpc.CallOption) (*GetChannelByNameForTeamNameResponse, error)
+	GetChannelsForTeamForUser(ctx context.Context, in *GetChannelsForTeamForUserRequest, opts ...grpc.CallOption) (*GetChannelsForTeamForUserResponse, error)
+	GetChannelStats(ctx context.Context, in *GetChannelStatsRequest, opts ...grpc.CallOption) (*GetChannelStatsResponse, error)
+	GetDirectChannel(ctx context.Context, in *GetDirectChannelRequest, opts ...grpc.CallOption) (*GetDirectChannelResponse, error)
+	GetGroupChannel(ctx context.Context, in *GetGroupChannelRequest, opts ...grpc.CallOption) (*GetGroupChannelResponse, error)
+	UpdateChannel(ctx context.Context, in *UpdateChannelRequest, opts ...grpc.CallOption) (*UpdateChannelResponse, error)
+	SearchChannels(ctx context.Context, in *SearchChannelsRequest, opts ...grpc.CallOption) (*SearchChannelsResponse, error)
+	CreateChannelSidebarCategory(ctx context.Context, in *CreateChannelSidebarCategoryRequest, opts ...grpc.CallOption) (*CreateChannelSidebarCategoryResponse, error)
+	GetChannelSidebarCategories(ctx context.Context, in *GetChannelSidebarCategoriesRequest, opts ...grpc.CallOption) (*GetChannelSidebarCategoriesResponse, error)
+	UpdateChannelSidebarCategories(ctx context.Context, in *UpdateChannelSidebarCategoriesRequest, opts ...grpc.CallOption) (*UpdateChannelSidebarCategoriesResponse, error)
+	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
+	SearchPostsInTeam(ctx context.Context, in *SearchPostsInTeamRequest, opts ...grpc.CallOption) (*SearchPostsInTeamResponse, error)
+	SearchPostsInTeamForUser(ctx context.Context, in *SearchPostsInTeamForUserRequest, opts ...grpc.CallOption) (*SearchPostsInTeamForUserResponse, error)
+	AddChannelMember(ctx context.Context, in *AddChannelMemberRequest, opts ...grpc.CallOption) (*AddChannelMemberResponse, error)
+	AddUserToChannel(ctx context.Context, in *AddUserToChannelRequest, opts ...grpc.CallOption) (*AddUserToChannelResponse, error)
+	GetChannelMember(ctx context.Context, in *GetChannelMemberRequest, opts ...grpc.CallOption) (*GetChannelMemberResponse, error)
+	GetChannelMembers(ctx context.Context, in *GetChannelMembersRequest, opts ...grpc.CallOption) (*GetChannelMembersResponse, error)
+	GetChannelMembersByIds(ctx context.Context, in *GetChannelMembersByIdsRequest, opts ...grpc.CallOption) (*GetChannelMembersByIdsResponse, error)
+	GetChannelMembersForUser(ctx context.Context, in *GetChannelMembersForUserRequest, opts ...grpc.CallOption) (*GetChannelMembersForUserResponse, error)
+	UpdateChannelMemberRoles(ctx context.Context, in *UpdateChannelMemberRolesRequest, opts ...grpc.CallOption) (*UpdateChannelMemberRolesResponse, error)
+	UpdateChannelMemberNotifications(ctx context.Context, in *UpdateChannelMemberNotificationsRequest, opts ...grpc.CallOption) (*UpdateChannelMemberNotificationsResponse, error)
+	PatchChannelMembersNotifications(ctx context.Context, in *PatchChannelMembersNotificationsRequest, opts ...grpc.CallOption) (*PatchChannelMembersNotificationsResponse, error)
+	GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GetGroupResponse, error)
+	GetGroupByName(ctx context.Context, in *GetGroupByNameRequest, opts ...grpc.CallOption) (*GetGroupByNameResponse, error)
+	GetGroupMemberUsers(ctx context.Context, in *GetGroupMemberUsersRequest, opts ...grpc.CallOption) (*GetGroupMemberUsersResponse, error)
+	GetGroupsBySource(ctx context.Context, in *GetGroupsBySourceRequest, opts ...grpc.CallOption) (*GetGroupsBySourceResponse, error)
+	GetGroupsForUser(ctx context.Context, in *GetGroupsForUserRequest, opts ...grpc.CallOption) (*GetGroupsForUserResponse, error)
+	DeleteChannelMember(ctx context.Context, in *DeleteChannelMemberRequest, opts ...grpc.CallOption) (*DeleteChannelMemberResponse, error)
+	CreatePost(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*CreatePostResponse, error)
+	AddReaction(ctx context.Context, in *AddReactionRequest, opts ...grpc.CallOption) (*AddReactionResponse, error)
+	RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*RemoveReactionResponse, error)
+	GetReactions(ctx context.Context, in *GetReactionsRequest, opts ...grpc.CallOption) (*GetReactionsResponse, error)
+	SendEphemeralPost(ctx context.Context, in *SendEphemeralPostRequest, opts ...grpc.CallOption) (*SendEphemeralPostResponse, error)
+	UpdateEphemeralPost(ctx context.Context, in *UpdateEphemeralPostRequest, opts ...grpc.CallOption) (*UpdateEphemeralPostResponse, error)
+	DeleteEphemeralPost(ctx context.Context, in *DeleteEphemeralPostRequest, opts ...grpc.CallOption) (*DeleteEphemeralPostResponse, error)
+	DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error)
+	GetPostThread(ctx context.Context, in *GetPostThreadRequest, opts ...grpc.CallOption) (*GetPostThreadResponse, error)
+	GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*GetPostResponse, error)
+	GetPostsSince(ctx context.Context, in *GetPostsSinceRequest, opts ...grpc.CallOption) (*GetPostsSinceResponse, error)
+	GetPostsAfter(ctx context.Context, in *GetPostsAfterRequest, opts ...grpc.CallOption) (*GetPostsAfterResponse, error)
+	GetPostsBefore(ctx context.Context, in *GetPostsBeforeRequest, opts ...grpc.CallOption) (*GetPostsBeforeResponse, error)
+	GetPostsForChannel(ctx context.Context, in *GetPostsForChannelRequest, opts ...grpc.CallOption) (*GetPostsForChannelResponse, error)
+	GetTeamStats(ctx context.Context, in *GetTeamStatsRequest, opts ...grpc.CallOption) (*GetTeamStatsResponse, error)
+	UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*UpdatePostResponse, error)
+	GetProfileImage(ctx context.Context, in *GetProfileImageRequest, opts ...grpc.CallOption) (*GetProfileImageResponse, error)
+	SetProfileImage(ctx context.Context, in *SetProfileImageRequest, opts ...grpc.CallOption) (*SetProfileImageResponse, error)
+	GetEmojiList(ctx context.Context, in *GetEmojiListRequest, opts ...grpc.CallOption) (*GetEmojiListResponse, error)
+	GetEmojiByName(ctx context.Context, in *GetEmojiByNameRequest, opts ...grpc.CallOption) (*GetEmojiByNameResponse, error)
+	GetEmoji(ctx context.Context, in *GetEmojiRequest, opts ...grpc.CallOption) (*GetEmojiResponse, error)
+	CopyFileInfos(ctx context.Context, in *CopyFileInfosRequest, opts ...grpc.CallOption) (*CopyFileInfosResponse, error)
+	GetFileInfo(ctx context.Context, in *GetFileInfoRequest, opts ...grpc.CallOption) (*GetFileInfoResponse, error)
+	SetFileSearchableContent(ctx context.Context, in *SetFileSearchableContentRequest, opts ...grpc.CallOption) (*SetFileSearchableContentResponse, error)
+	GetFileInfos(ctx context.Context, in *GetFileInfosRequest, opts ...grpc.CallOption) (*GetFileInfosResponse, error)
+	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*GetFileResponse, error)
+	GetFileLink(ctx context.Context, in *GetFileLinkRequest, opts ...grpc.CallOption) (*GetFileLinkResponse, error)
+	ReadFile(ctx context.Context, in *ReadFileRequest, opts ...grpc.CallOption) (*ReadFileResponse, error)
+	GetEmojiImage(ctx context.Context, in *GetEmojiImageRequest, opts ...grpc.CallOption) (*GetEmojiImageResponse, error)
+	UploadFile(ctx context.Context, in *UploadFileRequest, opts ...grpc.CallOption) (*UploadFileResponse, error)
+	OpenInteractiveDialog(ctx context.Context, in *OpenInteractiveDialogRequest, opts ...grpc.CallOption) (*OpenInteractiveDialogResponse, error)
+	GetPlugins(ctx context.Context, in *GetPluginsRequest, opts ...grpc.CallOption) (*GetPluginsResponse, error)
+	EnablePlugin(ctx context.Context, in *EnablePluginRequest, opts ...grpc.CallOption) (*EnablePluginResponse, error)
+	DisablePlugin(ctx context.Context, in *DisablePluginRequest, opts ...grpc.CallOption) (*DisablePluginResponse, error)
+	RemovePlugin(ctx context.Context, in *RemovePluginRequest, opts ...grpc.CallOption) (*RemovePluginResponse, error)
+	GetPluginStatus(ctx context.Context, in *GetPluginStatusRequest, opts ...grpc.CallOption) (*GetPluginStatusResponse, error)
+	InstallPlugin(ctx context.Context, in *InstallPluginRequest, opts ...grpc.CallOption) (*InstallPluginResponse, error)
+	KVSet(ctx context.Context, in *KVSetRequest, opts ...grpc.CallOption) (*KVSetResponse, error)
+	KVCompareAndSet(ctx context.Context, in *KVCompareAndSetRequest, opts ...grpc.CallOption) (*KVCompareAndSetResponse, error)
+	KVCompareAndDelete(ctx context.Context, in *KVCompareAndDeleteRequest, opts ...grpc.CallOption) (*KVCompareAndDeleteResponse, error)
+	KVSetWithOptions(ctx context.Context, in *KVSetWithOptionsRequest, opts ...grpc.CallOption) (*KVSetWithOptionsResponse, error)
+	KVSetWithExpiry(ctx context.Context, in *KVSetWithExpiryRequest, opts ...grpc.CallOption) (*KVSetWithExpiryResponse, error)
+	KVGet(ctx context.Context, in *KVGetRequest, opts ...grpc.CallOption) (*KVGetResponse, error)
+	KVDelete(ctx context.Context, in *KVDeleteRequest, opts ...grpc.CallOption) (*KVDeleteResponse, error)
+	KVDeleteAll(ctx context.Context, in *KVDeleteAllRequest, opts ...grpc.CallOption) (*KVDeleteAllResponse, error)
+	KVList(ctx context.Context, in *KVListRequest, opts ...grpc.CallOption) (*KVListResponse, error)
+	PublishWebSocketEvent(ctx context.Context, in *PublishWebSocketEventRequest, opts ...grpc.CallOption) (*PublishWebSocketEventResponse, error)
+	HasPermissionTo(ctx context.Context, in *HasPermissionToRequest, opts ...grpc.CallOption) (*HasPermissionToResponse, error)
+	HasPermissionToTeam(ctx context.Context, in *HasPermissionToTeamRequest, opts ...grpc.CallOption) (*HasPermissionToTeamResponse, error)
+	HasPermissionToChannel(ctx context.Context, in *HasPermissionToChannelRequest, opts ...grpc.CallOption) (*HasPermissionToChannelResponse, error)
+	RolesGrantPermission(ctx context.Context, in *RolesGrantPermissionRequest, opts ...grpc.CallOption) (*RolesGrantPermissionResponse, error)
+	LogDebug(ctx context.Context, in *LogDebugRequest, opts ...grpc.CallOption) (*LogDebugResponse, error)
+	LogInfo(ctx context.Context, in *LogInfoRequest, opts ...grpc.CallOption) (*LogInfoResponse, error)
+	LogError(ctx context.Context, in *LogErrorRequest, opts ...grpc.CallOption) (*LogErrorResponse, error)
+	LogWarn(ctx context.Context, in *LogWarnRequest, opts ...grpc.CallOption) (*LogWarnResponse, error)
+	SendMail(ctx context.Context, in *SendMailRequest, opts ...grpc.CallOption) (*SendMailResponse, error)
+	CreateBot(ctx context.Context, in *CreateBotRequest, opts ...grpc.CallOption) (*CreateBotResponse, error)
+	PatchBot(ctx context.Context, in *PatchBotRequest, opts ...grpc.CallOption) (*PatchBotResponse, error)
+	GetBot(ctx context.Context, in *GetBotRequest, opts ...grpc.CallOption) (*GetBotResponse, error)
+	GetBots(ctx context.Context, in *GetBotsRequest, opts ...grpc.CallOption) (*GetBotsResponse, error)
+	UpdateBotActive(ctx context.Context, in *UpdateBotActiveRequest, opts ...grpc.CallOption) (*UpdateBotActiveResponse, error)
+	PermanentDeleteBot(ctx context.Context, in *PermanentDeleteBotRequest, opts ...grpc.CallOption) (*PermanentDeleteBotResponse, error)
+	PublishUserTyping(ctx context.Context, in *PublishUserTypingRequest, opts ...grpc.CallOption) (*PublishUserTypingResponse, error)
+	CreateCommand(ctx context.Context, in *CreateCommandRequest, opts ...grpc.CallOption) (*CreateCommandResponse, error)
+	ListCommands(ctx context.Context, in *ListCommandsRequest, opts ...grpc.CallOption) (*ListCommandsResponse, error)
+	ListCustomCommands(ctx context.Context, in *ListCustomCommandsRequest, opts ...grpc.CallOption) (*ListCustomCommandsResponse, error)
+	ListPluginCommands(ctx context.Context, in *ListPluginCommandsRequest, opts ...grpc.CallOption) (*ListPluginCommandsResponse, error)
+	ListBuiltInCommands(ctx context.Context, in *ListBuiltInCommandsRequest, opts ...grpc.CallOption) (*ListBuiltInCommandsResponse, error)
+	GetCommand(ctx context.Context, in *GetCommandRequest, opts ...grpc.CallOption) (*GetCommandResponse, error)
+	UpdateCommand(ctx context.Context, in *UpdateCommandRequest, opts ...grpc.CallOption) (*UpdateCommandResponse, error)
+	DeleteCommand(ctx context.Context, in *DeleteCommandRequest, opts ...grpc.CallOption) (*DeleteCommandResponse, error)
+	CreateOAuthApp(ctx context.Context, in *CreateOAuthAppRequest, opts ...grpc.CallOption) (*CreateOAuthAppResponse, error)
+	GetOAuthApp(ctx context.Context, in *GetOAuthAppRequest, opts ...grpc.CallOption) (*GetOAuthAppResponse, error)
+	UpdateOAuthApp(ctx context.Context, in *UpdateOAuthAppRequest, opts ...grpc.CallOption) (*UpdateOAuthAppResponse, error)
+	DeleteOAuthApp(ctx context.Context, in *DeleteOAuthAppRequest, opts ...grpc.CallOption) (*DeleteOAuthAppResponse, error)
+	PublishPluginClusterEvent(ctx context.Context, in *PublishPluginClusterEventRequest, opts ...grpc.CallOption) (*PublishPluginClusterEventResponse, error)
+	RequestTrialLicense(ctx context.Context, in *RequestTrialLicenseRequest, opts ...grpc.CallOption) (*RequestTrialLicenseResponse, error)
+	GetCloudLimits(ctx context.Context, in *GetCloudLimitsRequest, opts ...grpc.CallOption) (*GetCloudLimitsResponse, error)
+	EnsureBotUser(ctx context.Context, in *EnsureBotUserRequest, opts ...grpc.CallOption) (*EnsureBotUserResponse, error)
+	RegisterCollectionAndTopic(ctx context.Context, in *RegisterCollectionAndTopicRequest, opts ...grpc.CallOption) (*RegisterCollectionAndTopicResponse, error)
+	CreateUploadSession(ctx context.Context, in *CreateUploadSessionRequest, opts ...grpc.CallOption) (*CreateUploadSessionResponse, error)
+	UploadData(ctx context.Context, in *UploadDataRequest, opts ...grpc.CallOption) (*UploadDataResponse, error)
+	GetUploadSession(ctx context.Context, in *GetUploadSessionRequest, opts ...grpc.CallOption) (*GetUploadSessionResponse, error)
+	SendPushNotification(ctx context.Context, in *SendPushNotificationRequest, opts ...grpc.CallOption) (*SendPushNotificationResponse, error)
+	UpdateUserAuth(ctx context.Context, in *UpdateUserAuthRequest, opts ...grpc.CallOption) (*UpdateUserAuthResponse, error)
+	RegisterPluginForSharedChannels(ctx context.Context, in *RegisterPluginForSharedChannelsRequest, opts ...grpc.CallOption) (*RegisterPluginForSharedChannelsResponse, error)
+	UnregisterPluginForSharedChannels(ctx context.Context, in *UnregisterPluginForSharedChannelsRequest, opts ...grpc.CallOption) (*UnregisterPluginForSharedChannelsResponse, error)
+	ShareChannel(ctx context.Context, in *ShareChannelRequest, opts ...grpc.CallOption) (*ShareChannelResponse, error)
+	UpdateSharedChannel(ctx context.Context, in *UpdateSharedChannelRequest, opts ...grpc.CallOption) (*UpdateSharedChannelResponse, error)
+	UnshareChannel(ctx context.Context, in *UnshareChannelRequest, opts ...grpc.CallOption) (*UnshareChannelResponse, error)
+	UpdateSharedChannelCursor(ctx context.Context, in *UpdateSharedChannelCursorRequest, opts ...grpc.CallOption) (*UpdateSharedChannelCursorResponse, error)
+	SyncSharedChannel(ctx context.Context, in *SyncSharedChannelRequest, opts ...grpc.CallOption) (*SyncSharedChannelResponse, error)
+	InviteRemoteToChannel(ctx context.Context, in *InviteRemoteToChannelRequest, opts ...grpc.CallOption) (*InviteRemoteToChannelResponse, error)
+	UninviteRemoteFromChannel(ctx context.Context, in *UninviteRemoteFromChannelRequest, opts ...grpc.CallOption) (*UninviteRemoteFromChannelResponse, error)
+	UpsertGroupMember(ctx context.Context, in *UpsertGroupMemberRequest, opts ...grpc.CallOption) (*UpsertGroupMemberResponse, error)
+	UpsertGroupMembers(ctx context.Context, in *UpsertGroupMembersRequest, opts ...grpc.CallOption) (*UpsertGroupMembersResponse, error)
+	GetGroupByRemoteID(ctx context.Context, in *GetGroupByRemoteIDRequest, opts ...grpc.CallOption) (*GetGroupByRemoteIDResponse, error)
+	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error)
+	UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*UpdateGroupResponse, error)
+	DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error)
+	RestoreGroup(ctx context.Context, in *RestoreGroupRequest, opts ...grpc.CallOption) (*RestoreGroupResponse, error)
+	DeleteGroupMember(ctx context.Context, in *DeleteGroupMemberRequest, opts ...grpc.CallOption) (*DeleteGroupMemberResponse, error)
+	GetGroupSyncable(ctx context.Context, in *GetGroupSyncableRequest, opts ...grpc.CallOption) (*GetGroupSyncableResponse, error)
+	GetGroupSyncables(ctx context.Context, in *GetGroupSyncablesRequest, opts ...grpc.CallOption) (*GetGroupSyncablesResponse, error)
+	UpsertGroupSyncable(ctx context.Context, in *UpsertGroupSyncableRequest, opts ...grpc.CallOption) (*UpsertGroupSyncableResponse, error)
+	UpdateGroupSyncable(ctx context.Context, in *UpdateGroupSyncableRequest, opts ...grpc.CallOption) (*UpdateGroupSyncableResponse, error)
+	DeleteGroupSyncable(ctx context.Context, in *DeleteGroupSyncableRequest, opts ...grpc.CallOption) (*DeleteGroupSyncableResponse, error)
+	UpdateUserRoles(ctx context.Context, in *UpdateUserRolesRequest, opts ...grpc.CallOption) (*UpdateUserRolesResponse, error)
+	GetPluginID(ctx context.Context, in *GetPluginIDRequest, opts ...grpc.CallOption) (*GetPluginIDResponse, error)
+	GetGroups(ctx context.Context, in *GetGroupsRequest, opts ...grpc.CallOption) (*GetGroupsResponse, error)
+	CreateDefaultSyncableMemberships(ctx context.Context, in *CreateDefaultSyncableMembershipsRequest, opts ...grpc.CallOption) (*CreateDefaultSyncableMembershipsResponse, error)
+	DeleteGroupConstrainedMemberships(ctx context.Context, in *DeleteGroupConstrainedMembershipsRequest, opts ...grpc.CallOption) (*DeleteGroupConstrainedMembershipsResponse, error)
+	CreatePropertyField(ctx context.Context, in *CreatePropertyFieldRequest, opts ...grpc.CallOption) (*CreatePropertyFieldResponse, error)
+	GetPropertyField(ctx context.Context, in *GetPropertyFieldRequest, opts ...grpc.CallOption) (*GetPropertyFieldResponse, error)
+	GetPropertyFields(ctx context.Context, in *GetPropertyFieldsRequest, opts ...grpc.CallOption) (*GetPropertyFieldsResponse, error)
+	UpdatePropertyField(ctx context.Context, in *UpdatePropertyFieldRequest, opts ...grpc.CallOption) (*UpdatePropertyFieldResponse, error)
+	DeletePropertyField(ctx context.Context, in *DeletePropertyFieldRequest, opts ...grpc.CallOption) (*DeletePropertyFieldResponse, error)
+	SearchPropertyFields(ctx context.Context, in *SearchPropertyFieldsRequest, opts ...grpc.CallOption) (*SearchPropertyFieldsResponse, error)
+	CountPropertyFields(ctx context.Context, in *CountPropertyFieldsRequest, opts ...grpc.CallOption) (*CountPropertyFieldsResponse, error)
+	CountPropertyFieldsForTarget(ctx context.Context, in *CountPropertyFieldsForTargetRequest, opts ...grpc.CallOption) (*CountPropertyFieldsForTargetResponse, error)
+	CreatePropertyValue(ctx context.Context, in *CreatePropertyValueRequest, opts ...grpc.CallOption) (*CreatePropertyValueResponse, error)
+	GetPropertyValue(ctx context.Context, in *GetPropertyValueRequest, opts ...grpc.CallOption) (*GetPropertyValueResponse, error)
+	GetPropertyValues(ctx context.Context, in *GetPropertyValuesRequest, opts ...grpc.CallOption) (*GetPropertyValuesResponse, error)
+	UpdatePropertyValue(ctx context.Context, in *UpdatePropertyValueRequest, opts ...grpc.CallOption) (*UpdatePropertyValueResponse, error)
+	UpsertPropertyValue(ctx context.Context, in *UpsertPropertyValueRequest, opts ...grpc.CallOption) (*UpsertPropertyValueResponse, error)
+	DeletePropertyValue(ctx context.Context, in *DeletePropertyValueRequest, opts ...grpc.CallOption) (*DeletePropertyValueResponse, error)
+	SearchPropertyValues(ctx context.Context, in *SearchPropertyValuesRequest, opts ...grpc.CallOption) (*SearchPropertyValuesResponse, error)
+	RegisterPropertyGroup(ctx context.Context, in *RegisterPropertyGroupRequest, opts ...grpc.CallOption) (*RegisterPropertyGroupResponse, error)
+	GetPropertyGroup(ctx context.Context, in *GetPropertyGroupRequest, opts ...grpc.CallOption) (*GetPropertyGroupResponse, error)
+	GetPropertyFieldByName(ctx context.Context, in *GetPropertyFieldByNameRequest, opts ...grpc.CallOption) (*GetPropertyFieldByNameResponse, error)
+	UpdatePropertyFields(ctx context.Context, in *UpdatePropertyFieldsRequest, opts ...grpc.CallOption) (*UpdatePropertyFieldsResponse, error)
+	UpdatePropertyValues(ctx context.Context, in *UpdatePropertyValuesRequest, opts ...grpc.CallOption) (*UpdatePropertyValuesResponse, error)
+	UpsertPropertyValues(ctx context.Context, in *UpsertPropertyValuesRequest, opts ...grpc.CallOption) (*UpsertPropertyValuesResponse, error)
+	DeletePropertyValuesForTarget(ctx context.Context, in *DeletePropertyValuesForTargetRequest, opts ...grpc.CallOption) (*DeletePropertyValuesForTargetResponse, error)
+	DeletePropertyValuesForField(ctx context.Context, in *DeletePropertyValuesForFieldRequest, opts ...grpc.CallOption) (*DeletePropertyValuesForFieldResponse, error)
+	LogAuditRec(ctx context.Context, in *LogAuditRecRequest, opts ...grpc.CallOption) (*LogAuditRecResponse, error)
+	LogAuditRecWithLevel(ctx context.Context, in *LogAuditRecWithLevelRequest, opts ...grpc.CallOption) (*LogAuditRecWithLevelResponse, error)
+}
+
+type aPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAPIClient(cc grpc.ClientConnInterface) APIClient {
+	return &aPIClient{cc}
+}
+
+func (c *aPIClient) LoadPluginConfiguration(ctx context.Context, in *LoadPluginConfigurationRequest, opts ...grpc.CallOption) (*LoadPluginConfigurationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoadPluginConfigurationResponse)
+	err := c.cc.Invoke(ctx, API_LoadPluginConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RegisterCommand(ctx context.Context, in *RegisterCommandRequest, opts ...grpc.CallOption) (*RegisterCommandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterCommandResponse)
+	err := c.cc.Invoke(ctx, API_RegisterCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UnregisterCommand(ctx context.Context, in *UnregisterCommandRequest, opts ...grpc.CallOption) (*UnregisterCommandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnregisterCommandResponse)
+	err := c.cc.Invoke(ctx, API_UnregisterCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ExecuteSlashCommand(ctx context.Context, in *ExecuteSlashCommandRequest, opts ...grpc.CallOption) (*ExecuteSlashCommandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExecuteSlashCommandResponse)
+	err := c.cc.Invoke(ctx, API_ExecuteSlashCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConfigResponse)
+	err := c.cc.Invoke(ctx, API_GetConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetUnsanitizedConfig(ctx context.Context, in *GetUnsanitizedConfigRequest, opts ...grpc.CallOption) (*GetUnsanitizedConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUnsanitizedConfigResponse)
+	err := c.cc.Invoke(ctx, API_GetUnsanitizedConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SaveConfig(ctx context.Context, in *SaveConfigRequest, opts ...grpc.CallOption) (*SaveConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveConfigResponse)
+	err := c.cc.Invoke(ctx, API_SaveConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetPluginConfig(ctx context.Context, in *GetPluginConfigRequest, opts ...grpc.CallOption) (*GetPluginConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPluginConfigResponse)
+	err := c.cc.Invoke(ctx, API_GetPluginConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SavePluginConfig(ctx context.Context, in *SavePluginConfigRequest, opts ...grpc.CallOption) (*SavePluginConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavePluginConfigResponse)
+	err := c.cc.Invoke(ctx, API_SavePluginConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetBundlePath(ctx context.Context, in *GetBundlePathRequest, opts ...grpc.CallOption) (*GetBundlePathResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBundlePathResponse)
+	err := c.cc.Invoke(ctx, API_GetBundlePath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetLicense(ctx context.Context, in *GetLicenseRequest, opts ...grpc.CallOption) (*GetLicenseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLicenseResponse)
+	err := c.cc.Invoke(ctx, API_GetLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) IsEnterpriseReady(ctx context.Context, in *IsEnterpriseReadyRequest, opts ...grpc.CallOption) (*IsEnterpriseReadyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsEnterpriseReadyResponse)
+	err := c.cc.Invoke(ctx, API_IsEnterpriseReady_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetServerVersion(ctx context.Context, in *GetServerVersionRequest, opts ...grpc.CallOption) (*GetServerVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServerVersionResponse)
+	err := c.cc.Invoke(ctx, API_GetServerVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetSystemInstallDate(ctx context.Context, in *GetSystemInstallDateRequest, opts ...grpc.CallOption) (*GetSystemInstallDateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSystemInstallDateResponse)
+	err := c.cc.Invoke(ctx, API_GetSystemInstallDate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetDiagnosticId(ctx context.Context, in *GetDiagnosticIdRequest, opts ...grpc.CallOption) (*GetDiagnosticIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDiagnosticIdResponse)
+	err := c.cc.Invoke(ctx, API_GetDiagnosticId_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetTelemetryId(ctx context.Context, in *GetTelemetryIdRequest, opts ...grpc.CallOption) (*GetTelemetryIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTelemetryIdResponse)
+	err := c.cc.Invoke(ctx, API_GetTelemetryId_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUserResponse)
+	err := c.cc.Invoke(ctx, API_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, API_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*GetUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsersResponse)
+	err := c.cc.Invoke(ctx, API_GetUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetUsersByIds(ctx context.Context, in *GetUsersByIdsRequest, opts ...grpc.CallOption) (*GetUsersByIdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsersByIdsResponse)
+	err := c.cc.Invoke(ctx, API_GetUsersByIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, API_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetUserByEmail(ctx context.Context, in *GetUserByEmailRequest, opts ...grpc.CallOption) (*GetUserByEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserByEmailResponse)
+	err := c.cc.Invoke(ctx, API_GetUserByEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetUserByUsername(ctx context.Context, in *GetUserByUsernameRequest, opts ...grpc.CallOption) (*GetUserByUsernameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserByUsernameResponse)
+	err := c.cc.Invoke(ctx, API_GetUserByUsername_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetUsersByUsernames(ctx context.Context, in *GetUsersByUsernamesRequest, opts ...grpc.CallOption) (*GetUsersByUsernamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsersByUsernamesResponse)
+	err := c.cc.Invoke(ctx, API_GetUsersByUsernames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetUsersInTeam(ctx context.Context, in *GetUsersInTeamRequest, opts ...grpc.CallOption) (*GetUsersInTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsersInTeamResponse)
+	err := c.cc.Invoke(ctx, API_GetUsersInTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetPreferenceForUser(ctx context.Context, in *GetPreferenceForUserRequest, opts ...grpc.CallOption) (*GetPreferenceForUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPreferenceForUserResponse)
+	err := c.cc.Invoke(ctx, API_GetPreferenceForUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetPreferencesForUser(ctx context.Context, in *GetPreferencesForUserRequest, opts ...grpc.CallOption) (*GetPreferencesForUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPreferencesForUserResponse)
+	err := c.cc.Invoke(ctx, API_GetPreferencesForUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdatePreferencesForUser(ctx context.Context, in *UpdatePreferencesForUserRequest, opts ...grpc.CallOption) (*UpdatePreferencesForUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePreferencesForUserResponse)
+	err := c.cc.Invoke(ctx, API_UpdatePreferencesForUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeletePreferencesForUser(ctx context.Context, in *DeletePreferencesForUserRequest, opts ...grpc.CallOption) (*DeletePreferencesForUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePreferencesForUserResponse)
+	err := c.cc.Invoke(ctx, API_DeletePreferencesForUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*GetSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSessionResponse)
+	err := c.cc.Invoke(ctx, API_GetSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*CreateSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSessionResponse)
+	err := c.cc.Invoke(ctx, API_CreateSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ExtendSessionExpiry(ctx context.Context, in *ExtendSessionExpiryRequest, opts ...grpc.CallOption) (*ExtendSessionExpiryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendSessionExpiryResponse)
+	err := c.cc.Invoke(ctx, API_ExtendSessionExpiry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, API_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreateUserAccessToken(ctx context.Context, in *CreateUserAccessTokenRequest, opts ...grpc.CallOption) (*CreateUserAccessTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUserAccessTokenResponse)
+	err := c.cc.Invoke(ctx, API_CreateUserAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RevokeUserAccessToken(ctx context.Context, in *RevokeUserAccessTokenRequest, opts ...grpc.CallOption) (*RevokeUserAccessTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeUserAccessTokenResponse)
+	err := c.cc.Invoke(ctx, API_RevokeUserAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetTeamIcon(ctx context.Context, in *GetTeamIconRequest, opts ...grpc.CallOption) (*GetTeamIconResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamIconResponse)
+	err := c.cc.Invoke(ctx, API_GetTeamIcon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SetTeamIcon(ctx context.Context, in *SetTeamIconRequest, opts ...grpc.CallOption) (*SetTeamIconResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTeamIconResponse)
+	err := c.cc.Invoke(ctx, API_SetTeamIcon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RemoveTeamIcon(ctx context.Context, in *RemoveTeamIconRequest, opts ...grpc.CallOption) (*RemoveTeamIconResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveTeamIconResponse)
+	err := c.cc.Invoke(ctx, API_RemoveTeamIcon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserResponse)
+	err := c.cc.Invoke(ctx, API_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetUserStatus(ctx context.Context, in *GetUserStatusRequest, opts ...grpc.CallOption) (*GetUserStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserStatusResponse)
+	err := c.cc.Invoke(ctx, API_GetUserStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetUserStatusesByIds(ctx context.Context, in *GetUserStatusesByIdsRequest, opts ...grpc.CallOption) (*GetUserStatusesByIdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserStatusesByIdsResponse)
+	err := c.cc.Invoke(ctx, API_GetUserStatusesByIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateUserStatus(ctx context.Context, in *UpdateUserStatusRequest, opts ...grpc.CallOption) (*UpdateUserStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserStatusResponse)
+	err := c.cc.Invoke(ctx, API_UpdateUserStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SetUserStatusTimedDND(ctx context.Context, in *SetUserStatusTimedDNDRequest, opts ...grpc.CallOption) (*SetUserStatusTimedDNDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserStatusTimedDNDResponse)
+	err := c.cc.Invoke(ctx, API_SetUserStatusTimedDND_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateUserActive(ctx context.Context, in *UpdateUserActiveRequest, opts ...grpc.CallOption) (*UpdateUserActiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserActiveResponse)
+	err := c.cc.Invoke(ctx, API_UpdateUserActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateUserCustomStatus(ctx context.Context, in *UpdateUserCustomStatusRequest, opts ...grpc.CallOption) (*UpdateUserCustomStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserCustomStatusResponse)
+	err := c.cc.Invoke(ctx, API_UpdateUserCustomStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RemoveUserCustomStatus(ctx context.Context, in *RemoveUserCustomStatusRequest, opts ...grpc.CallOption) (*RemoveUserCustomStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveUserCustomStatusResponse)
+	err := c.cc.Invoke(ctx, API_RemoveUserCustomStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetUsersInChannel(ctx context.Context, in *GetUsersInChannelRequest, opts ...grpc.CallOption) (*GetUsersInChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsersInChannelResponse)
+	err := c.cc.Invoke(ctx, API_GetUsersInChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetLDAPUserAttributes(ctx context.Context, in *GetLDAPUserAttributesRequest, opts ...grpc.CallOption) (*GetLDAPUserAttributesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLDAPUserAttributesResponse)
+	err := c.cc.Invoke(ctx, API_GetLDAPUserAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTeamResponse)
+	err := c.cc.Invoke(ctx, API_CreateTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (*DeleteTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTeamResponse)
+	err := c.cc.Invoke(ctx, API_DeleteTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetTeams(ctx context.Context, in *GetTeamsRequest, opts ...grpc.CallOption) (*GetTeamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamsResponse)
+	err := c.cc.Invoke(ctx, API_GetTeams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*GetTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamResponse)
+	err := c.cc.Invoke(ctx, API_GetTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetTeamByName(ctx context.Context, in *GetTeamByNameRequest, opts ...grpc.CallOption) (*GetTeamByNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamByNameResponse)
+	err := c.cc.Invoke(ctx, API_GetTeamByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetTeamsUnreadForUser(ctx context.Context, in *GetTeamsUnreadForUserRequest, opts ...grpc.CallOption) (*GetTeamsUnreadForUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamsUnreadForUserResponse)
+	err := c.cc.Invoke(ctx, API_GetTeamsUnreadForUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateTeam(ctx context.Context, in *UpdateTeamRequest, opts ...grpc.CallOption) (*UpdateTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTeamResponse)
+	err := c.cc.Invoke(ctx, API_UpdateTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SearchTeams(ctx context.Context, in *SearchTeamsRequest, opts ...grpc.CallOption) (*SearchTeamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchTeamsResponse)
+	err := c.cc.Invoke(ctx, API_SearchTeams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetTeamsForUser(ctx context.Context, in *GetTeamsForUserRequest, opts ...grpc.CallOption) (*GetTeamsForUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamsForUserResponse)
+	err := c.cc.Invoke(ctx, API_GetTeamsForUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreateTeamMember(ctx context.Context, in *CreateTeamMemberRequest, opts ...grpc.CallOption) (*CreateTeamMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTeamMemberResponse)
+	err := c.cc.Invoke(ctx, API_CreateTeamMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreateTeamMembers(ctx context.Context, in *CreateTeamMembersRequest, opts ...grpc.CallOption) (*CreateTeamMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTeamMembersResponse)
+	err := c.cc.Invoke(ctx, API_CreateTeamMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreateTeamMembersGracefully(ctx context.Context, in *CreateTeamMembersGracefullyRequest, opts ...grpc.CallOption) (*CreateTeamMembersGracefullyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTeamMembersGracefullyResponse)
+	err := c.cc.Invoke(ctx, API_CreateTeamMembersGracefully_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteTeamMember(ctx context.Context, in *DeleteTeamMemberRequest, opts ...grpc.CallOption) (*DeleteTeamMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTeamMemberResponse)
+	err := c.cc.Invoke(ctx, API_DeleteTeamMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetTeamMembers(ctx context.Context, in *GetTeamMembersRequest, opts ...grpc.CallOption) (*GetTeamMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamMembersResponse)
+	err := c.cc.Invoke(ctx, API_GetTeamMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetTeamMember(ctx context.Context, in *GetTeamMemberRequest, opts ...grpc.CallOption) (*GetTeamMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamMemberResponse)
+	err := c.cc.Invoke(ctx, API_GetTeamMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetTeamMembersForUser(ctx context.Context, in *GetTeamMembersForUserRequest, opts ...grpc.CallOption) (*GetTeamMembersForUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamMembersForUserResponse)
+	err := c.cc.Invoke(ctx, API_GetTeamMembersForUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateTeamMemberRoles(ctx context.Context, in *UpdateTeamMemberRolesRequest, opts ...grpc.CallOption) (*UpdateTeamMemberRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTeamMemberRolesResponse)
+	err := c.cc.Invoke(ctx, API_UpdateTeamMemberRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreateChannel(ctx context.Context, in *CreateChannelRequest, opts ...grpc.CallOption) (*CreateChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateChannelResponse)
+	err := c.cc.Invoke(ctx, API_CreateChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*DeleteChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteChannelResponse)
+	err := c.cc.Invoke(ctx, API_DeleteChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetPublicChannelsForTeam(ctx context.Context, in *GetPublicChannelsForTeamRequest, opts ...grpc.CallOption) (*GetPublicChannelsForTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicChannelsForTeamResponse)
+	err := c.cc.Invoke(ctx, API_GetPublicChannelsForTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetChannel(ctx context.Context, in *GetChannelRequest, opts ...grpc.CallOption) (*GetChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChannelResponse)
+	err := c.cc.Invoke(ctx, API_GetChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetChannelByName(ctx context.Context, in *GetChannelByNameRequest, opts ...grpc.CallOption) (*GetChannelByNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChannelByNameResponse)
+	err := c.cc.Invoke(ctx, API_GetChannelByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetChannelByNameForTeamName(ctx context.Context, in *GetChannelByNameForTeamNameRequest, opts ...grpc.CallOption) (*GetChannelByNameForTeamNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChannelByNameForTeamNameResponse)
+	err := c.cc.Invoke(ctx, API_GetChannelByNameForTeamName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetChannelsForTeamForUser(ctx context.Context, in *GetChannelsForTeamForUserRequest, opts ...grpc.CallOption) (*GetChannelsForTeamForUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChannelsForTeamForUserResponse)
+	err := c.cc.Invoke(ctx, API_GetChannelsForTeamForUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetChannelStats(ctx context.Context, in *GetChannelStatsRequest, opts ...grpc.CallOption) (*GetChannelStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChannelStatsResponse)
+	err := c.cc.Invoke(ctx, API_GetChannelStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetDirectChannel(ctx context.Context, in *GetDirectChannelRequest, opts ...grpc.CallOption) (*GetDirectChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDirectChannelResponse)
+	err := c.cc.Invoke(ctx, API_GetDirectChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetGroupChannel(ctx context.Context, in *GetGroupChannelRequest, opts ...grpc.CallOption) (*GetGroupChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupChannelResponse)
+	err := c.cc.Invoke(ctx, API_GetGroupChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateChannel(ctx context.Context, in *UpdateChannelRequest, opts ...grpc.CallOption) (*UpdateChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateChannelResponse)
+	err := c.cc.Invoke(ctx, API_UpdateChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SearchChannels(ctx context.Context, in *SearchChannelsRequest, opts ...grpc.CallOption) (*SearchChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchChannelsResponse)
+	err := c.cc.Invoke(ctx, API_SearchChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreateChannelSidebarCategory(ctx context.Context, in *CreateChannelSidebarCategoryRequest, opts ...grpc.CallOption) (*CreateChannelSidebarCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateChannelSidebarCategoryResponse)
+	err := c.cc.Invoke(ctx, API_CreateChannelSidebarCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetChannelSidebarCategories(ctx context.Context, in *GetChannelSidebarCategoriesRequest, opts ...grpc.CallOption) (*GetChannelSidebarCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChannelSidebarCategoriesResponse)
+	err := c.cc.Invoke(ctx, API_GetChannelSidebarCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateChannelSidebarCategories(ctx context.Context, in *UpdateChannelSidebarCategoriesRequest, opts ...grpc.CallOption) (*UpdateChannelSidebarCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateChannelSidebarCategoriesResponse)
+	err := c.cc.Invoke(ctx, API_UpdateChannelSidebarCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUsersResponse)
+	err := c.cc.Invoke(ctx, API_SearchUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SearchPostsInTeam(ctx context.Context, in *SearchPostsInTeamRequest, opts ...grpc.CallOption) (*SearchPostsInTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPostsInTeamResponse)
+	err := c.cc.Invoke(ctx, API_SearchPostsInTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SearchPostsInTeamForUser(ctx context.Context, in *SearchPostsInTeamForUserRequest, opts ...grpc.CallOption) (*SearchPostsInTeamForUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPostsInTeamForUserResponse)
+	err := c.cc.Invoke(ctx, API_SearchPostsInTeamForUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) AddChannelMember(ctx context.Context, in *AddChannelMemberRequest, opts ...grpc.CallOption) (*AddChannelMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddChannelMemberResponse)
+	err := c.cc.Invoke(ctx, API_AddChannelMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) AddUserToChannel(ctx context.Context, in *AddUserToChannelRequest, opts ...grpc.CallOption) (*AddUserToChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddUserToChannelResponse)
+	err := c.cc.Invoke(ctx, API_AddUserToChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetChannelMember(ctx context.Context, in *GetChannelMemberRequest, opts ...grpc.CallOption) (*GetChannelMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChannelMemberResponse)
+	err := c.cc.Invoke(ctx, API_GetChannelMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetChannelMembers(ctx context.Context, in *GetChannelMembersRequest, opts ...grpc.CallOption) (*GetChannelMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChannelMembersResponse)
+	err := c.cc.Invoke(ctx, API_GetChannelMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetChannelMembersByIds(ctx context.Context, in *GetChannelMembersByIdsRequest, opts ...grpc.CallOption) (*GetChannelMembersByIdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChannelMembersByIdsResponse)
+	err := c.cc.Invoke(ctx, API_GetChannelMembersByIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetChannelMembersForUser(ctx context.Context, in *GetChannelMembersForUserRequest, opts ...grpc.CallOption) (*GetChannelMembersForUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChannelMembersForUserResponse)
+	err := c.cc.Invoke(ctx, API_GetChannelMembersForUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateChannelMemberRoles(ctx context.Context, in *UpdateChannelMemberRolesRequest, opts ...grpc.CallOption) (*UpdateChannelMemberRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateChannelMemberRolesResponse)
+	err := c.cc.Invoke(ctx, API_UpdateChannelMemberRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateChannelMemberNotifications(ctx context.Context, in *UpdateChannelMemberNotificationsRequest, opts ...grpc.CallOption) (*UpdateChannelMemberNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateChannelMemberNotificationsResponse)
+	err := c.cc.Invoke(ctx, API_UpdateChannelMemberNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) PatchChannelMembersNotifications(ctx context.Context, in *PatchChannelMembersNotificationsRequest, opts ...grpc.CallOption) (*PatchChannelMembersNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatchChannelMembersNotificationsResponse)
+	err := c.cc.Invoke(ctx, API_PatchChannelMembersNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GetGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupResponse)
+	err := c.cc.Invoke(ctx, API_GetGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetGroupByName(ctx context.Context, in *GetGroupByNameRequest, opts ...grpc.CallOption) (*GetGroupByNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupByNameResponse)
+	err := c.cc.Invoke(ctx, API_GetGroupByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetGroupMemberUsers(ctx context.Context, in *GetGroupMemberUsersRequest, opts ...grpc.CallOption) (*GetGroupMemberUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupMemberUsersResponse)
+	err := c.cc.Invoke(ctx, API_GetGroupMemberUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetGroupsBySource(ctx context.Context, in *GetGroupsBySourceRequest, opts ...grpc.CallOption) (*GetGroupsBySourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupsBySourceResponse)
+	err := c.cc.Invoke(ctx, API_GetGroupsBySource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetGroupsForUser(ctx context.Context, in *GetGroupsForUserRequest, opts ...grpc.CallOption) (*GetGroupsForUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupsForUserResponse)
+	err := c.cc.Invoke(ctx, API_GetGroupsForUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteChannelMember(ctx context.Context, in *DeleteChannelMemberRequest, opts ...grpc.CallOption) (*DeleteChannelMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteChannelMemberResponse)
+	err := c.cc.Invoke(ctx, API_DeleteChannelMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreatePost(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*CreatePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePostResponse)
+	err := c.cc.Invoke(ctx, API_CreatePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) AddReaction(ctx context.Context, in *AddReactionRequest, opts ...grpc.CallOption) (*AddReactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddReactionResponse)
+	err := c.cc.Invoke(ctx, API_AddReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*RemoveReactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveReactionResponse)
+	err := c.cc.Invoke(ctx, API_RemoveReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetReactions(ctx context.Context, in *GetReactionsRequest, opts ...grpc.CallOption) (*GetReactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReactionsResponse)
+	err := c.cc.Invoke(ctx, API_GetReactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SendEphemeralPost(ctx context.Context, in *SendEphemeralPostRequest, opts ...grpc.CallOption) (*SendEphemeralPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendEphemeralPostResponse)
+	err := c.cc.Invoke(ctx, API_SendEphemeralPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateEphemeralPost(ctx context.Context, in *UpdateEphemeralPostRequest, opts ...grpc.CallOption) (*UpdateEphemeralPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEphemeralPostResponse)
+	err := c.cc.Invoke(ctx, API_UpdateEphemeralPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteEphemeralPost(ctx context.Context, in *DeleteEphemeralPostRequest, opts ...grpc.CallOption) (*DeleteEphemeralPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEphemeralPostResponse)
+	err := c.cc.Invoke(ctx, API_DeleteEphemeralPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePostResponse)
+	err := c.cc.Invoke(ctx, API_DeletePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetPostThread(ctx context.Context, in *GetPostThreadRequest, opts ...grpc.CallOption) (*GetPostThreadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostThreadResponse)
+	err := c.cc.Invoke(ctx, API_GetPostThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*GetPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostResponse)
+	err := c.cc.Invoke(ctx, API_GetPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetPostsSince(ctx context.Context, in *GetPostsSinceRequest, opts ...grpc.CallOption) (*GetPostsSinceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostsSinceResponse)
+	err := c.cc.Invoke(ctx, API_GetPostsSince_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetPostsAfter(ctx context.Context, in *GetPostsAfterRequest, opts ...grpc.CallOption) (*GetPostsAfterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostsAfterResponse)
+	err := c.cc.Invoke(ctx, API_GetPostsAfter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetPostsBefore(ctx context.Context, in *GetPostsBeforeRequest, opts ...grpc.CallOption) (*GetPostsBeforeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostsBeforeResponse)
+	err := c.cc.Invoke(ctx, API_GetPostsBefore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetPostsForChannel(ctx context.Context, in *GetPostsForChannelRequest, opts ...grpc.CallOption) (*GetPostsForChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostsForChannelResponse)
+	err := c.cc.Invoke(ctx, API_GetPostsForChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetTeamStats(ctx context.Context, in *GetTeamStatsRequest, opts ...grpc.CallOption) (*GetTeamStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamStatsResponse)
+	err := c.cc.Invoke(ctx, API_GetTeamStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*UpdatePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePostResponse)
+	err := c.cc.Invoke(ctx, API_UpdatePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetProfileImage(ctx context.Context, in *GetProfileImageRequest, opts ...grpc.CallOption) (*GetProfileImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProfileImageResponse)
+	err := c.cc.Invoke(ctx, API_GetProfileImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SetProfileImage(ctx context.Context, in *SetProfileImageRequest, opts ...grpc.CallOption) (*SetProfileImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProfileImageResponse)
+	err := c.cc.Invoke(ctx, API_SetProfileImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetEmojiList(ctx context.Context, in *GetEmojiListRequest, opts ...grpc.CallOption) (*GetEmojiListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEmojiListResponse)
+	err := c.cc.Invoke(ctx, API_GetEmojiList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetEmojiByName(ctx context.Context, in *GetEmojiByNameRequest, opts ...grpc.CallOption) (*GetEmojiByNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEmojiByNameResponse)
+	err := c.cc.Invoke(ctx, API_GetEmojiByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetEmoji(ctx context.Context, in *GetEmojiRequest, opts ...grpc.CallOption) (*GetEmojiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEmojiResponse)
+	err := c.cc.Invoke(ctx, API_GetEmoji_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CopyFileInfos(ctx context.Context, in *CopyFileInfosRequest, opts ...grpc.CallOption) (*CopyFileInfosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CopyFileInfosResponse)
+	err := c.cc.Invoke(ctx, API_CopyFileInfos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetFileInfo(ctx context.Context, in *GetFileInfoRequest, opts ...grpc.CallOption) (*GetFileInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFileInfoResponse)
+	err := c.cc.Invoke(ctx, API_GetFileInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SetFileSearchableContent(ctx context.Context, in *SetFileSearchableContentRequest, opts ...grpc.CallOption) (*SetFileSearchableContentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFileSearchableContentResponse)
+	err := c.cc.Invoke(ctx, API_SetFileSearchableContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetFileInfos(ctx context.Context, in *GetFileInfosRequest, opts ...grpc.CallOption) (*GetFileInfosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFileInfosResponse)
+	err := c.cc.Invoke(ctx, API_GetFileInfos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*GetFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFileResponse)
+	err := c.cc.Invoke(ctx, API_GetFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetFileLink(ctx context.Context, in *GetFileLinkRequest, opts ...grpc.CallOption) (*GetFileLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFileLinkResponse)
+	err := c.cc.Invoke(ctx, API_GetFileLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ReadFile(ctx context.Context, in *ReadFileRequest, opts ...grpc.CallOption) (*ReadFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadFileResponse)
+	err := c.cc.Invoke(ctx, API_ReadFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetEmojiImage(ctx context.Context, in *GetEmojiImageRequest, opts ...grpc.CallOption) (*GetEmojiImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEmojiImageResponse)
+	err := c.cc.Invoke(ctx, API_GetEmojiImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UploadFile(ctx context.Context, in *UploadFileRequest, opts ...grpc.CallOption) (*UploadFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadFileResponse)
+	err := c.cc.Invoke(ctx, API_UploadFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) OpenInteractiveDialog(ctx context.Context, in *OpenInteractiveDialogRequest, opts ...grpc.CallOption) (*OpenInteractiveDialogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OpenInteractiveDialogResponse)
+	err := c.cc.Invoke(ctx, API_OpenInteractiveDialog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetPlugins(ctx context.Context, in *GetPluginsRequest, opts ...grpc.CallOption) (*GetPluginsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPluginsResponse)
+	err := c.cc.Invoke(ctx, API_GetPlugins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) EnablePlugin(ctx context.Context, in *EnablePluginRequest, opts ...grpc.CallOption) (*EnablePluginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnablePluginResponse)
+	err := c.cc.Invoke(ctx, API_EnablePlugin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DisablePlugin(ctx context.Context, in *DisablePluginRequest, opts ...grpc.CallOption) (*DisablePluginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisablePluginResponse)
+	err := c.cc.Invoke(ctx, API_DisablePlugin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RemovePlugin(ctx context.Context, in *RemovePluginRequest, opts ...grpc.CallOption) (*RemovePluginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemovePluginResponse)
+	err := c.cc.Invoke(ctx, API_RemovePlugin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetPluginStatus(ctx context.Context, in *GetPluginStatusRequest, opts ...grpc.CallOption) (*GetPluginStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPluginStatusResponse)
+	err := c.cc.Invoke(ctx, API_GetPluginStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) InstallPlugin(ctx context.Context, in *InstallPluginRequest, opts ...grpc.CallOption) (*InstallPluginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstallPluginResponse)
+	err := c.cc.Invoke(ctx, API_InstallPlugin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) KVSet(ctx context.Context, in *KVSetRequest, opts ...grpc.CallOption) (*KVSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KVSetResponse)
+	err := c.cc.Invoke(ctx, API_KVSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) KVCompareAndSet(ctx context.Context, in *KVCompareAndSetRequest, opts ...grpc.CallOption) (*KVCompareAndSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KVCompareAndSetResponse)
+	err := c.cc.Invoke(ctx, API_KVCompareAndSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) KVCompareAndDelete(ctx context.Context, in *KVCompareAndDeleteRequest, opts ...grpc.CallOption) (*KVCompareAndDeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KVCompareAndDeleteResponse)
+	err := c.cc.Invoke(ctx, API_KVCompareAndDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) KVSetWithOptions(ctx context.Context, in *KVSetWithOptionsRequest, opts ...grpc.CallOption) (*KVSetWithOptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KVSetWithOptionsResponse)
+	err := c.cc.Invoke(ctx, API_KVSetWithOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) KVSetWithExpiry(ctx context.Context, in *KVSetWithExpiryRequest, opts ...grpc.CallOption) (*KVSetWithExpiryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KVSetWithExpiryResponse)
+	err := c.cc.Invoke(ctx, API_KVSetWithExpiry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) KVGet(ctx context.Context, in *KVGetRequest, opts ...grpc.CallOption) (*KVGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KVGetResponse)
+	err := c.cc.Invoke(ctx, API_KVGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) KVDelete(ctx context.Context, in *KVDeleteRequest, opts ...grpc.CallOption) (*KVDeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KVDeleteResponse)
+	err := c.cc.Invoke(ctx, API_KVDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) KVDeleteAll(ctx context.Context, in *KVDeleteAllRequest, opts ...grpc.CallOption) (*KVDeleteAllResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KVDeleteAllResponse)
+	err := c.cc.Invoke(ctx, API_KVDeleteAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) KVList(ctx context.Context, in *KVListRequest, opts ...grpc.CallOption) (*KVListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KVListResponse)
+	err := c.cc.Invoke(ctx, API_KVList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) PublishWebSocketEvent(ctx context.Context, in *PublishWebSocketEventRequest, opts ...grpc.CallOption) (*PublishWebSocketEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishWebSocketEventResponse)
+	err := c.cc.Invoke(ctx, API_PublishWebSocketEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) HasPermissionTo(ctx context.Context, in *HasPermissionToRequest, opts ...grpc.CallOption) (*HasPermissionToResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HasPermissionToResponse)
+	err := c.cc.Invoke(ctx, API_HasPermissionTo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) HasPermissionToTeam(ctx context.Context, in *HasPermissionToTeamRequest, opts ...grpc.CallOption) (*HasPermissionToTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HasPermissionToTeamResponse)
+	err := c.cc.Invoke(ctx, API_HasPermissionToTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) HasPermissionToChannel(ctx context.Context, in *HasPermissionToChannelRequest, opts ...grpc.CallOption) (*HasPermissionToChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HasPermissionToChannelResponse)
+	err := c.cc.Invoke(ctx, API_HasPermissionToChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RolesGrantPermission(ctx context.Context, in *RolesGrantPermissionRequest, opts ...grpc.CallOption) (*RolesGrantPermissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RolesGrantPermissionResponse)
+	err := c.cc.Invoke(ctx, API_RolesGrantPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) LogDebug(ctx context.Context, in *LogDebugRequest, opts ...grpc.CallOption) (*LogDebugResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogDebugResponse)
+	err := c.cc.Invoke(ctx, API_LogDebug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) LogInfo(ctx context.Context, in *LogInfoRequest, opts ...grpc.CallOption) (*LogInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogInfoResponse)
+	err := c.cc.Invoke(ctx, API_LogInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) LogError(ctx context.Context, in *LogErrorRequest, opts ...grpc.CallOption) (*LogErrorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogErrorResponse)
+	err := c.cc.Invoke(ctx, API_LogError_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) LogWarn(ctx context.Context, in *LogWarnRequest, opts ...grpc.CallOption) (*LogWarnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogWarnResponse)
+	err := c.cc.Invoke(ctx, API_LogWarn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SendMail(ctx context.Context, in *SendMailRequest, opts ...grpc.CallOption) (*SendMailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendMailResponse)
+	err := c.cc.Invoke(ctx, API_SendMail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreateBot(ctx context.Context, in *CreateBotRequest, opts ...grpc.CallOption) (*CreateBotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBotResponse)
+	err := c.cc.Invoke(ctx, API_CreateBot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) PatchBot(ctx context.Context, in *PatchBotRequest, opts ...grpc.CallOption) (*PatchBotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatchBotResponse)
+	err := c.cc.Invoke(ctx, API_PatchBot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetBot(ctx context.Context, in *GetBotRequest, opts ...grpc.CallOption) (*GetBotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBotResponse)
+	err := c.cc.Invoke(ctx, API_GetBot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetBots(ctx context.Context, in *GetBotsRequest, opts ...grpc.CallOption) (*GetBotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBotsResponse)
+	err := c.cc.Invoke(ctx, API_GetBots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateBotActive(ctx context.Context, in *UpdateBotActiveRequest, opts ...grpc.CallOption) (*UpdateBotActiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBotActiveResponse)
+	err := c.cc.Invoke(ctx, API_UpdateBotActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) PermanentDeleteBot(ctx context.Context, in *PermanentDeleteBotRequest, opts ...grpc.CallOption) (*PermanentDeleteBotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PermanentDeleteBotResponse)
+	err := c.cc.Invoke(ctx, API_PermanentDeleteBot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) PublishUserTyping(ctx context.Context, in *PublishUserTypingRequest, opts ...grpc.CallOption) (*PublishUserTypingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishUserTypingResponse)
+	err := c.cc.Invoke(ctx, API_PublishUserTyping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreateCommand(ctx context.Context, in *CreateCommandRequest, opts ...grpc.CallOption) (*CreateCommandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCommandResponse)
+	err := c.cc.Invoke(ctx, API_CreateCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListCommands(ctx context.Context, in *ListCommandsRequest, opts ...grpc.CallOption) (*ListCommandsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommandsResponse)
+	err := c.cc.Invoke(ctx, API_ListCommands_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListCustomCommands(ctx context.Context, in *ListCustomCommandsRequest, opts ...grpc.CallOption) (*ListCustomCommandsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCustomCommandsResponse)
+	err := c.cc.Invoke(ctx, API_ListCustomCommands_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListPluginCommands(ctx context.Context, in *ListPluginCommandsRequest, opts ...grpc.CallOption) (*ListPluginCommandsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPluginCommandsResponse)
+	err := c.cc.Invoke(ctx, API_ListPluginCommands_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListBuiltInCommands(ctx context.Context, in *ListBuiltInCommandsRequest, opts ...grpc.CallOption) (*ListBuiltInCommandsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBuiltInCommandsResponse)
+	err := c.cc.Invoke(ctx, API_ListBuiltInCommands_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetCommand(ctx context.Context, in *GetCommandRequest, opts ...grpc.CallOption) (*GetCommandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommandResponse)
+	err := c.cc.Invoke(ctx, API_GetCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateCommand(ctx context.Context, in *UpdateCommandRequest, opts ...grpc.CallOption) (*UpdateCommandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCommandResponse)
+	err := c.cc.Invoke(ctx, API_UpdateCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteCommand(ctx context.Context, in *DeleteCommandRequest, opts ...grpc.CallOption) (*DeleteCommandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCommandResponse)
+	err := c.cc.Invoke(ctx, API_DeleteCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreateOAuthApp(ctx context.Context, in *CreateOAuthAppRequest, opts ...grpc.CallOption) (*CreateOAuthAppResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOAuthAppResponse)
+	err := c.cc.Invoke(ctx, API_CreateOAuthApp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetOAuthApp(ctx context.Context, in *GetOAuthAppRequest, opts ...grpc.CallOption) (*GetOAuthAppResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOAuthAppResponse)
+	err := c.cc.Invoke(ctx, API_GetOAuthApp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateOAuthApp(ctx context.Context, in *UpdateOAuthAppRequest, opts ...grpc.CallOption) (*UpdateOAuthAppResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateOAuthAppResponse)
+	err := c.cc.Invoke(ctx, API_UpdateOAuthApp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteOAuthApp(ctx context.Context, in *DeleteOAuthAppRequest, opts ...grpc.CallOption) (*DeleteOAuthAppResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOAuthAppResponse)
+	err := c.cc.Invoke(ctx, API_DeleteOAuthApp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) PublishPluginClusterEvent(ctx context.Context, in *PublishPluginClusterEventRequest, opts ...grpc.CallOption) (*PublishPluginClusterEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishPluginClusterEventResponse)
+	err := c.cc.Invoke(ctx, API_PublishPluginClusterEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RequestTrialLicense(ctx context.Context, in *RequestTrialLicenseRequest, opts ...grpc.CallOption) (*RequestTrialLicenseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestTrialLicenseResponse)
+	err := c.cc.Invoke(ctx, API_RequestTrialLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetCloudLimits(ctx context.Context, in *GetCloudLimitsRequest, opts ...grpc.CallOption) (*GetCloudLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCloudLimitsResponse)
+	err := c.cc.Invoke(ctx, API_GetCloudLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) EnsureBotUser(ctx context.Context, in *EnsureBotUserRequest, opts ...grpc.CallOption) (*EnsureBotUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnsureBotUserResponse)
+	err := c.cc.Invoke(ctx, API_EnsureBotUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RegisterCollectionAndTopic(ctx context.Context, in *RegisterCollectionAndTopicRequest, opts ...grpc.CallOption) (*RegisterCollectionAndTopicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterCollectionAndTopicResponse)
+	err := c.cc.Invoke(ctx, API_RegisterCollectionAndTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreateUploadSession(ctx context.Context, in *CreateUploadSessionRequest, opts ...grpc.CallOption) (*CreateUploadSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUploadSessionResponse)
+	err := c.cc.Invoke(ctx, API_CreateUploadSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UploadData(ctx context.Context, in *UploadDataRequest, opts ...grpc.CallOption) (*UploadDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadDataResponse)
+	err := c.cc.Invoke(ctx, API_UploadData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetUploadSession(ctx context.Context, in *GetUploadSessionRequest, opts ...grpc.CallOption) (*GetUploadSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUploadSessionResponse)
+	err := c.cc.Invoke(ctx, API_GetUploadSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SendPushNotification(ctx context.Context, in *SendPushNotificationRequest, opts ...grpc.CallOption) (*SendPushNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendPushNotificationResponse)
+	err := c.cc.Invoke(ctx, API_SendPushNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateUserAuth(ctx context.Context, in *UpdateUserAuthRequest, opts ...grpc.CallOption) (*UpdateUserAuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserAuthResponse)
+	err := c.cc.Invoke(ctx, API_UpdateUserAuth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RegisterPluginForSharedChannels(ctx context.Context, in *RegisterPluginForSharedChannelsRequest, opts ...grpc.CallOption) (*RegisterPluginForSharedChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterPluginForSharedChannelsResponse)
+	err := c.cc.Invoke(ctx, API_RegisterPluginForSharedChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UnregisterPluginForSharedChannels(ctx context.Context, in *UnregisterPluginForSharedChannelsRequest, opts ...grpc.CallOption) (*UnregisterPluginForSharedChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnregisterPluginForSharedChannelsResponse)
+	err := c.cc.Invoke(ctx, API_UnregisterPluginForSharedChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ShareChannel(ctx context.Context, in *ShareChannelRequest, opts ...grpc.CallOption) (*ShareChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareChannelResponse)
+	err := c.cc.Invoke(ctx, API_ShareChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateSharedChannel(ctx context.Context, in *UpdateSharedChannelRequest, opts ...grpc.CallOption) (*UpdateSharedChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSharedChannelResponse)
+	err := c.cc.Invoke(ctx, API_UpdateSharedChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UnshareChannel(ctx context.Context, in *UnshareChannelRequest, opts ...grpc.CallOption) (*UnshareChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnshareChannelResponse)
+	err := c.cc.Invoke(ctx, API_UnshareChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateSharedChannelCursor(ctx context.Context, in *UpdateSharedChannelCursorRequest, opts ...grpc.CallOption) (*UpdateSharedChannelCursorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSharedChannelCursorResponse)
+	err := c.cc.Invoke(ctx, API_UpdateSharedChannelCursor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SyncSharedChannel(ctx context.Context, in *SyncSharedChannelRequest, opts ...grpc.CallOption) (*SyncSharedChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncSharedChannelResponse)
+	err := c.cc.Invoke(ctx, API_SyncSharedChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) InviteRemoteToChannel(ctx context.Context, in *InviteRemoteToChannelRequest, opts ...grpc.CallOption) (*InviteRemoteToChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteRemoteToChannelResponse)
+	err := c.cc.Invoke(ctx, API_InviteRemoteToChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UninviteRemoteFromChannel(ctx context.Context, in *UninviteRemoteFromChannelRequest, opts ...grpc.CallOption) (*UninviteRemoteFromChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UninviteRemoteFromChannelResponse)
+	err := c.cc.Invoke(ctx, API_UninviteRemoteFromChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpsertGroupMember(ctx context.Context, in *UpsertGroupMemberRequest, opts ...grpc.CallOption) (*UpsertGroupMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertGroupMemberResponse)
+	err := c.cc.Invoke(ctx, API_UpsertGroupMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpsertGroupMembers(ctx context.Context, in *UpsertGroupMembersRequest, opts ...grpc.CallOption) (*UpsertGroupMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertGroupMembersResponse)
+	err := c.cc.Invoke(ctx, API_UpsertGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetGroupByRemoteID(ctx context.Context, in *GetGroupByRemoteIDRequest, opts ...grpc.CallOption) (*GetGroupByRemoteIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupByRemoteIDResponse)
+	err := c.cc.Invoke(ctx, API_GetGroupByRemoteID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGroupResponse)
+	err := c.cc.Invoke(ctx, API_CreateGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*UpdateGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGroupResponse)
+	err := c.cc.Invoke(ctx, API_UpdateGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGroupResponse)
+	err := c.cc.Invoke(ctx, API_DeleteGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RestoreGroup(ctx context.Context, in *RestoreGroupRequest, opts ...grpc.CallOption) (*RestoreGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreGroupResponse)
+	err := c.cc.Invoke(ctx, API_RestoreGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteGroupMember(ctx context.Context, in *DeleteGroupMemberRequest, opts ...grpc.CallOption) (*DeleteGroupMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGroupMemberResponse)
+	err := c.cc.Invoke(ctx, API_DeleteGroupMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetGroupSyncable(ctx context.Context, in *GetGroupSyncableRequest, opts ...grpc.CallOption) (*GetGroupSyncableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupSyncableResponse)
+	err := c.cc.Invoke(ctx, API_GetGroupSyncable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetGroupSyncables(ctx context.Context, in *GetGroupSyncablesRequest, opts ...grpc.CallOption) (*GetGroupSyncablesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupSyncablesResponse)
+	err := c.cc.Invoke(ctx, API_GetGroupSyncables_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpsertGroupSyncable(ctx context.Context, in *UpsertGroupSyncableRequest, opts ...grpc.CallOption) (*UpsertGroupSyncableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertGroupSyncableResponse)
+	err := c.cc.Invoke(ctx, API_UpsertGroupSyncable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateGroupSyncable(ctx context.Context, in *UpdateGroupSyncableRequest, opts ...grpc.CallOption) (*UpdateGroupSyncableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGroupSyncableResponse)
+	err := c.cc.Invoke(ctx, API_UpdateGroupSyncable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteGroupSyncable(ctx context.Context, in *DeleteGroupSyncableRequest, opts ...grpc.CallOption) (*DeleteGroupSyncableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGroupSyncableResponse)
+	err := c.cc.Invoke(ctx, API_DeleteGroupSyncable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateUserRoles(ctx context.Context, in *UpdateUserRolesRequest, opts ...grpc.CallOption) (*UpdateUserRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserRolesResponse)
+	err := c.cc.Invoke(ctx, API_UpdateUserRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetPluginID(ctx context.Context, in *GetPluginIDRequest, opts ...grpc.CallOption) (*GetPluginIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPluginIDResponse)
+	err := c.cc.Invoke(ctx, API_GetPluginID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetGroups(ctx context.Context, in *GetGroupsRequest, opts ...grpc.CallOption) (*GetGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupsResponse)
+	err := c.cc.Invoke(ctx, API_GetGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreateDefaultSyncableMemberships(ctx context.Context, in *CreateDefaultSyncableMembershipsRequest, opts ...grpc.CallOption) (*CreateDefaultSyncableMembershipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateDefaultSyncableMembershipsResponse)
+	err := c.cc.Invoke(ctx, API_CreateDefaultSyncableMemberships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteGroupConstrainedMemberships(ctx context.Context, in *DeleteGroupConstrainedMembershipsRequest, opts ...grpc.CallOption) (*DeleteGroupConstrainedMembershipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGroupConstrainedMembershipsResponse)
+	err := c.cc.Invoke(ctx, API_DeleteGroupConstrainedMemberships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreatePropertyField(ctx context.Context, in *CreatePropertyFieldRequest, opts ...grpc.CallOption) (*CreatePropertyFieldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePropertyFieldResponse)
+	err := c.cc.Invoke(ctx, API_CreatePropertyField_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetPropertyField(ctx context.Context, in *GetPropertyFieldRequest, opts ...grpc.CallOption) (*GetPropertyFieldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPropertyFieldResponse)
+	err := c.cc.Invoke(ctx, API_GetPropertyField_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetPropertyFields(ctx context.Context, in *GetPropertyFieldsRequest, opts ...grpc.CallOption) (*GetPropertyFieldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPropertyFieldsResponse)
+	err := c.cc.Invoke(ctx, API_GetPropertyFields_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdatePropertyField(ctx context.Context, in *UpdatePropertyFieldRequest, opts ...grpc.CallOption) (*UpdatePropertyFieldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePropertyFieldResponse)
+	err := c.cc.Invoke(ctx, API_UpdatePropertyField_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeletePropertyField(ctx context.Context, in *DeletePropertyFieldRequest, opts ...grpc.CallOption) (*DeletePropertyFieldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePropertyFieldResponse)
+	err := c.cc.Invoke(ctx, API_DeletePropertyField_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SearchPropertyFields(ctx context.Context, in *SearchPropertyFieldsRequest, opts ...grpc.CallOption) (*SearchPropertyFieldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPropertyFieldsResponse)
+	err := c.cc.Invoke(ctx, API_SearchPropertyFields_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CountPropertyFields(ctx context.Context, in *CountPropertyFieldsRequest, opts ...grpc.CallOption) (*CountPropertyFieldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountPropertyFieldsResponse)
+	err := c.cc.Invoke(ctx, API_CountPropertyFields_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CountPropertyFieldsForTarget(ctx context.Context, in *CountPropertyFieldsForTargetRequest, opts ...grpc.CallOption) (*CountPropertyFieldsForTargetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountPropertyFieldsForTargetResponse)
+	err := c.cc.Invoke(ctx, API_CountPropertyFieldsForTarget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreatePropertyValue(ctx context.Context, in *CreatePropertyValueRequest, opts ...grpc.CallOption) (*CreatePropertyValueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePropertyValueResponse)
+	err := c.cc.Invoke(ctx, API_CreatePropertyValue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetPropertyValue(ctx context.Context, in *GetPropertyValueRequest, opts ...grpc.CallOption) (*GetPropertyValueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPropertyValueResponse)
+	err := c.cc.Invoke(ctx, API_GetPropertyValue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetPropertyValues(ctx context.Context, in *GetPropertyValuesRequest, opts ...grpc.CallOption) (*GetPropertyValuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPropertyValuesResponse)
+	err := c.cc.Invoke(ctx, API_GetPropertyValues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdatePropertyValue(ctx context.Context, in *UpdatePropertyValueRequest, opts ...grpc.CallOption) (*UpdatePropertyValueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePropertyValueResponse)
+	err := c.cc.Invoke(ctx, API_UpdatePropertyValue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpsertPropertyValue(ctx context.Context, in *UpsertPropertyValueRequest, opts ...grpc.CallOption) (*UpsertPropertyValueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertPropertyValueResponse)
+	err := c.cc.Invoke(ctx, API_UpsertPropertyValue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeletePropertyValue(ctx context.Context, in *DeletePropertyValueRequest, opts ...grpc.CallOption) (*DeletePropertyValueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePropertyValueResponse)
+	err := c.cc.Invoke(ctx, API_DeletePropertyValue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SearchPropertyValues(ctx context.Context, in *SearchPropertyValuesRequest, opts ...grpc.CallOption) (*SearchPropertyValuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPropertyValuesResponse)
+	err := c.cc.Invoke(ctx, API_SearchPropertyValues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RegisterPropertyGroup(ctx context.Context, in *RegisterPropertyGroupRequest, opts ...grpc.CallOption) (*RegisterPropertyGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterPropertyGroupResponse)
+	err := c.cc.Invoke(ctx, API_RegisterPropertyGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetPropertyGroup(ctx context.Context, in *GetPropertyGroupRequest, opts ...grpc.CallOption) (*GetPropertyGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPropertyGroupResponse)
+	err := c.cc.Invoke(ctx, API_GetPropertyGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetPropertyFieldByName(ctx context.Context, in *GetPropertyFieldByNameRequest, opts ...grpc.CallOption) (*GetPropertyFieldByNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPropertyFieldByNameResponse)
+	err := c.cc.Invoke(ctx, API_GetPropertyFieldByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdatePropertyFields(ctx context.Context, in *UpdatePropertyFieldsRequest, opts ...grpc.CallOption) (*UpdatePropertyFieldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePropertyFieldsResponse)
+	err := c.cc.Invoke(ctx, API_UpdatePropertyFields_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdatePropertyValues(ctx context.Context, in *UpdatePropertyValuesRequest, opts ...grpc.CallOption) (*UpdatePropertyValuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePropertyValuesResponse)
+	err := c.cc.Invoke(ctx, API_UpdatePropertyValues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpsertPropertyValues(ctx context.Context, in *UpsertPropertyValuesRequest, opts ...grpc.CallOption) (*UpsertPropertyValuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertPropertyValuesResponse)
+	err := c.cc.Invoke(ctx, API_UpsertPropertyValues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeletePropertyValuesForTarget(ctx context.Context, in *DeletePropertyValuesForTargetRequest, opts ...grpc.CallOption) (*DeletePropertyValuesForTargetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePropertyValuesForTargetResponse)
+	err := c.cc.Invoke(ctx, API_DeletePropertyValuesForTarget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeletePropertyValuesForField(ctx context.Context, in *DeletePropertyValuesForFieldRequest, opts ...grpc.CallOption) (*DeletePropertyValuesForFieldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePropertyValuesForFieldResponse)
+	err := c.cc.Invoke(ctx, API_DeletePropertyValuesForField_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) LogAuditRec(ctx context.Context, in *LogAuditRecRequest, opts ...grpc.CallOption) (*LogAuditRecResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogAuditRecResponse)
+	err := c.cc.Invoke(ctx, API_LogAuditRec_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) LogAuditRecWithLevel(ctx context.Context, in *LogAuditRecWithLevelRequest, opts ...grpc.CallOption) (*LogAuditRecWithLevelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogAuditRecWithLevelResponse)
+	err := c.cc.Invoke(ctx, API_LogAuditRecWithLevel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// APIServer is the server API for API service.
+// All implementations must embed UnimplementedAPIServer
+// for forward compatibility.
+//
+// API is served by the server, at the target given in OnActivateRequest, and
+// called by the plugin.
+type APIServer interface {
+	// LoadPluginConfiguration returns the configuration of the plugin.
+	LoadPluginConfiguration(context.Context, *LoadPluginConfigurationRequest) (*LoadPluginConfigurationResponse, error)
+	RegisterCommand(context.Context, *RegisterCommandRequest) (*RegisterCommandResponse, error)
+	UnregisterCommand(context.Context, *UnregisterCommandRequest) (*UnregisterCommandResponse, error)
+	ExecuteSlashCommand(context.Context, *ExecuteSlashCommandRequest) (*ExecuteSlashCommandResponse, error)
+	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
+	GetUnsanitizedConfig(context.Context, *GetUnsanitizedConfigRequest) (*GetUnsanitizedConfigResponse, error)
+	SaveConfig(context.Context, *SaveConfigRequest) (*SaveConfigResponse, error)
+	GetPluginConfig(context.Context, *GetPluginConfigRequest) (*GetPluginConfigResponse, error)
+	SavePluginConfig(context.Context, *SavePluginConfigRequest) (*SavePluginConfigResponse, error)
+	GetBundlePath(context.Context, *GetBundlePathRequest) (*GetBundlePathResponse, error)
+	GetLicense(context.Context, *GetLicenseRequest) (*GetLicenseResponse, error)
+	IsEnterpriseReady(context.Context, *IsEnterpriseReadyRequest) (*IsEnterpriseReadyResponse, error)
+	GetServerVersion(context.Context, *GetServerVersionRequest) (*GetServerVersionResponse, error)
+	GetSystemInstallDate(context.Context, *GetSystemInstallDateRequest) (*GetSystemInstallDateResponse, error)
+	GetDiagnosticId(context.Context, *GetDiagnosticIdRequest) (*GetDiagnosticIdResponse, error)
+	GetTelemetryId(context.Context, *GetTelemetryIdRequest) (*GetTelemetryIdResponse, error)
+	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	GetUsers(context.Context, *GetUsersRequest) (*GetUsersResponse, error)
+	GetUsersByIds(context.Context, *GetUsersByIdsRequest) (*GetUsersByIdsResponse, error)
+	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	GetUserByEmail(context.Context, *GetUserByEmailRequest) (*GetUserByEmailResponse, error)
+	GetUserByUsername(context.Context, *GetUserByUsernameRequest) (*GetUserByUsernameResponse, error)
+	GetUsersByUsernames(context.Context, *GetUsersByUsernamesRequest) (*GetUsersByUsernamesResponse, error)
+	GetUsersInTeam(context.Context, *GetUsersInTeamRequest) (*GetUsersInTeamResponse, error)
+	GetPreferenceForUser(context.Context, *GetPreferenceForUserRequest) (*GetPreferenceForUserResponse, error)
+	GetPreferencesForUser(context.Context, *GetPreferencesForUserRequest) (*GetPreferencesForUserResponse, error)
+	UpdatePreferencesForUser(context.Context, *UpdatePreferencesForUserRequest) (*UpdatePreferencesForUserResponse, error)
+	DeletePreferencesForUser(context.Context, *DeletePreferencesForUserRequest) (*DeletePreferencesForUserResponse, error)
+	GetSession(context.Context, *GetSessionRequest) (*GetSessionResponse, error)
+	CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error)
+	ExtendSessionExpiry(context.Context, *ExtendSessionExpiryRequest) (*ExtendSessionExpiryResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	CreateUserAccessToken(context.Context, *CreateUserAccessTokenRequest) (*CreateUserAccessTokenResponse, error)
+	RevokeUserAccessToken(context.Context, *RevokeUserAccessTokenRequest) (*RevokeUserAccessTokenResponse, error)
+	GetTeamIcon(context.Context, *GetTeamIconRequest) (*GetTeamIconResponse, error)
+	SetTeamIcon(context.Context, *SetTeamIconRequest) (*SetTeamIconResponse, error)
+	RemoveTeamIcon(context.Context, *RemoveTeamIconRequest) (*RemoveTeamIconResponse, error)
+	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	GetUserStatus(context.Context, *GetUserStatusRequest) (*GetUserStatusResponse, error)
+	GetUserStatusesByIds(context.Context, *GetUserStatusesByIdsRequest) (*GetUserStatusesByIdsResponse, error)
+	UpdateUserStatus(context.Context, *UpdateUserStatusRequest) (*UpdateUserStatusResponse, error)
+	SetUserStatusTimedDND(context.Context, *SetUserStatusTimedDNDRequest) (*SetUserStatusTimedDNDResponse, error)
+	UpdateUserActive(context.Context, *UpdateUserActiveRequest) (*UpdateUserActiveResponse, error)
+	UpdateUserCustomStatus(context.Context, *UpdateUserCustomStatusRequest) (*UpdateUserCustomStatusResponse, error)
+	RemoveUserCustomStatus(context.Context, *RemoveUserCustomStatusRequest) (*RemoveUserCustomStatusResponse, error)
+	GetUsersInChannel(context.Context, *GetUsersInChannelRequest) (*GetUsersInChannelResponse, error)
+	GetLDAPUserAttributes(context.Context, *GetLDAPUserAttributesRequest) (*GetLDAPUserAttributesResponse, error)
+	CreateTeam(context.Context, *CreateTeamRequest) (*CreateTeamResponse, error)
+	DeleteTeam(context.Context, *DeleteTeamRequest) (*DeleteTeamResponse, error)
+	GetTeams(context.Context, *GetTeamsRequest) (*GetTeamsResponse, error)
+	GetTeam(context.Context, *GetTeamRequest) (*GetTeamResponse, error)
+	GetTeamByName(context.Context, *GetTeamByNameRequest) (*GetTeamByNameResponse, error)
+	GetTeamsUnreadForUser(context.Context, *GetTeamsUnreadForUserRequest) (*GetTeamsUnreadForUserResponse, error)
+	UpdateTeam(context.Context, *UpdateTeamRequest) (*UpdateTeamResponse, error)
+	SearchTeams(context.Context, *SearchTeamsRequest) (*SearchTeamsResponse, error)
+	GetTeamsForUser(context.Context, *GetTeamsForUserRequest) (*GetTeamsForUserResponse, error)
+	CreateTeamMember(context.Context, *CreateTeamMemberRequest) (*CreateTeamMemberResponse, error)
+	CreateTeamMembers(context.Context, *CreateTeamMembersRequest) (*CreateTeamMembersResponse, error)
+	CreateTeamMembersGracefully(context.Context, *CreateTeamMembersGracefullyRequest) (*CreateTeamMembersGracefullyResponse, error)
+	DeleteTeamMember(context.Context, *DeleteTeamMemberRequest) (*DeleteTeamMemberResponse, error)
+	GetTeamMembers(context.Context, *GetTeamMembersRequest) (*GetTeamMembersResponse, error)
+	GetTeamMember(context.Context, *GetTeamMemberRequest) (*GetTeamMemberResponse, error)
+	GetTeamMembersForUser(context.Context, *GetTeamMembersForUserRequest) (*GetTeamMembersForUserResponse, error)
+	UpdateTeamMemberRoles(context.Context, *UpdateTeamMemberRolesRequest) (*UpdateTeamMemberRolesResponse, error)
+	CreateChannel(context.Context, *CreateChannelRequest) (*CreateChannelResponse, error)
+	DeleteChannel(context.Context, *DeleteChannelRequest) (*DeleteChannelResponse, error)
+	GetPublicChannelsForTeam(context.Context, *GetPublicChannelsForTeamRequest) (*GetPublicChannelsForTeamResponse, error)
+	GetChannel(context.Context, *GetChannelRequest) (*GetChannelResponse, error)
+	GetChannelByName(context.Context, *GetChannelByNameRequest) (*GetChannelByNameResponse, error)
+	GetChannelByNameForTeamName(context.Context, *GetChannelByNameForTeamNameRequest) (*GetChannelByNameForTeamNameResponse, error)
+	GetChannelsForTeamForUser(context.Context, *GetChannelsForTeamForUserRequest) (*GetChannelsForTeamForUserResponse, error)
+	GetChannelStats(context.Context, *GetChannelStatsRequest) (*GetChannelStatsResponse, error)
+	GetDirectChannel(context.Context, *GetDirectChannelRequest) (*GetDirectChannelResponse, error)
+	GetGroupChannel(context.Context, *GetGroupChannelRequest) (*GetGroupChannelResponse, error)
+	UpdateChannel(context.Context, *UpdateChannelRequest) (*UpdateChannelResponse, error)
+	SearchChannels(context.Context, *SearchChannelsRequest) (*SearchChannelsResponse, error)
+	CreateChannelSidebarCategory(context.Context, *CreateChannelSidebarCategoryRequest) (*CreateChannelSidebarCategoryResponse, error)
+	GetChannelSidebarCategories(context.Context, *GetChannelSidebarCategoriesRequest) (*GetChannelSidebarCategoriesResponse, error)
+	UpdateChannelSidebarCategories(context.Context, *UpdateChannelSidebarCategoriesRequest) (*UpdateChannelSidebarCategoriesResponse, error)
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
+	SearchPostsInTeam(context.Context, *SearchPostsInTeamRequest) (*SearchPostsInTeamResponse, error)
+	SearchPostsInTeamForUser(context.Context, *SearchPostsInTeamForUserRequest) (*SearchPostsInTeamForUserResponse, error)
+	AddChannelMember(context.Context, *AddChannelMemberRequest) (*AddChannelMemberResponse, error)
+	AddUserToChannel(context.Context, *AddUserToChannelRequest) (*AddUserToChannelResponse, error)
+	GetChannelMember(context.Context, *GetChannelMemberRequest) (*GetChannelMemberResponse, error)
+	GetChannelMembers(context.Context, *GetChannelMembersRequest) (*GetChannelMembersResponse, error)
+	GetChannelMembersByIds(context.Context, *GetChannelMembersByIdsRequest) (*GetChannelMembersByIdsResponse, error)
+	GetChannelMembersForUser(context.Context, *GetChannelMembersForUserRequest) (*GetChannelMembersForUserResponse, error)
+	UpdateChannelMemberRoles(context.Context, *UpdateChannelMemberRolesRequest) (*UpdateChannelMemberRolesResponse, error)
+	UpdateChannelMemberNotifications(context.Context, *UpdateChannelMemberNotificationsRequest) (*UpdateChannelMemberNotificationsResponse, error)
+	PatchChannelMembersNotifications(context.Context, *PatchChannelMembersNotificationsRequest) (*PatchChannelMembersNotificationsResponse, error)
+	GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error)
+	GetGroupByName(context.Context, *GetGroupByNameRequest) (*GetGroupByNameResponse, error)
+	GetGroupMemberUsers(context.Context, *GetGroupMemberUsersRequest) (*GetGroupMemberUsersResponse, error)
+	GetGroupsBySource(context.Context, *GetGroupsBySourceRequest) (*GetGroupsBySourceResponse, error)
+	GetGroupsForUser(context.Context, *GetGroupsForUserRequest) (*GetGroupsForUserResponse, error)
+	DeleteChannelMember(context.Context, *DeleteChannelMemberRequest) (*DeleteChannelMemberResponse, error)
+	CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error)
+	AddReaction(context.Context, *AddReactionRequest) (*AddReactionResponse, error)
+	RemoveReaction(context.Context, *RemoveReactionRequest) (*RemoveReactionResponse, error)
+	GetReactions(context.Context, *GetReactionsRequest) (*GetReactionsResponse, error)
+	SendEphemeralPost(context.Context, *SendEphemeralPostRequest) (*SendEphemeralPostResponse, error)
+	UpdateEphemeralPost(context.Context, *UpdateEphemeralPostRequest) (*UpdateEphemeralPostResponse, error)
+	DeleteEphemeralPost(context.Context, *DeleteEphemeralPostRequest) (*DeleteEphemeralPostResponse, error)
+	DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error)
+	GetPostThread(context.Context, *GetPostThreadRequest) (*GetPostThreadResponse, error)
+	GetPost(context.Context, *GetPostRequest) (*GetPostResponse, error)
+	GetPostsSince(context.Context, *GetPostsSinceRequest) (*GetPostsSinceResponse, error)
+	GetPostsAfter(context.Context, *GetPostsAfterRequest) (*GetPostsAfterResponse, error)
+	GetPostsBefore(context.Context, *GetPostsBeforeRequest) (*GetPostsBeforeResponse, error)
+	GetPostsForChannel(context.Context, *GetPostsForChannelRequest) (*GetPostsForChannelResponse, error)
+	GetTeamStats(context.Context, *GetTeamStatsRequest) (*GetTeamStatsResponse, error)
+	UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error)
+	GetProfileImage(context.Context, *GetProfileImageRequest) (*GetProfileImageResponse, error)
+	SetProfileImage(context.Context, *SetProfileImageRequest) (*SetProfileImageResponse, error)
+	GetEmojiList(context.Context, *GetEmojiListRequest) (*GetEmojiListResponse, error)
+	GetEmojiByName(context.Context, *GetEmojiByNameRequest) (*GetEmojiByNameResponse, error)
+	GetEmoji(context.Context, *GetEmojiRequest) (*GetEmojiResponse, error)
+	CopyFileInfos(context.Context, *CopyFileInfosRequest) (*CopyFileInfosResponse, error)
+	GetFileInfo(context.Context, *GetFileInfoRequest) (*GetFileInfoResponse, error)
+	SetFileSearchableContent(context.Context, *SetFileSearchableContentRequest) (*SetFileSearchableContentResponse, error)
+	GetFileInfos(context.Context, *GetFileInfosRequest) (*GetFileInfosResponse, error)
+	GetFile(context.Context, *GetFileRequest) (*GetFileResponse, error)
+	GetFileLink(context.Context, *GetFileLinkRequest) (*GetFileLinkResponse, error)
+	ReadFile(context.Context, *ReadFileRequest) (*ReadFileResponse, error)
+	GetEmojiImage(context.Context, *GetEmojiImageRequest) (*GetEmojiImageResponse, error)
+	UploadFile(context.Context, *UploadFileRequest) (*UploadFileResponse, error)
+	OpenInteractiveDialog(context.Context, *OpenInteractiveDialogRequest) (*OpenInteractiveDialogResponse, error)
+	GetPlugins(context.Context, *GetPluginsRequest) (*GetPluginsResponse, error)
+	EnablePlugin(context.Context, *EnablePluginRequest) (*EnablePluginResponse, error)
+	DisablePlugin(context.Context, *DisablePluginRequest) (*DisablePluginResponse, error)
+	RemovePlugin(context.Context, *RemovePluginRequest) (*RemovePluginResponse, error)
+	GetPluginStatus(context.Context, *GetPluginStatusRequest) (*GetPluginStatusResponse, error)
+	InstallPlugin(context.Context, *InstallPluginRequest) (*InstallPluginResponse, error)
+	KVSet(context.Context, *KVSetRequest) (*KVSetResponse, error)
+	KVCompareAndSet(context.Context, *KVCompareAndSetRequest) (*KVCompareAndSetResponse, error)
+	KVCompareAndDelete(context.Context, *KVCompareAndDeleteRequest) (*KVCompareAndDeleteResponse, error)
+	KVSetWithOptions(context.Context, *KVSetWithOptionsRequest) (*KVSetWithOptionsResponse, error)
+	KVSetWithExpiry(context.Context, *KVSetWithExpiryRequest) (*KVSetWithExpiryResponse, error)
+	KVGet(context.Context, *KVGetRequest) (*KVGetResponse, error)
+	KVDelete(context.Context, *KVDeleteRequest) (*KVDeleteResponse, error)
+	KVDeleteAll(context.Context, *KVDeleteAllRequest) (*KVDeleteAllResponse, error)
+	KVList(context.Context, *KVListRequest) (*KVListResponse, error)
+	PublishWebSocketEvent(context.Context, *PublishWebSocketEventRequest) (*PublishWebSocketEventResponse, error)
+	HasPermissionTo(context.Context, *HasPermissionToRequest) (*HasPermissionToResponse, error)
+	HasPermissionToTeam(context.Context, *HasPermissionToTeamRequest) (*HasPermissionToTeamResponse, error)
+	HasPermissionToChannel(context.Context, *HasPermissionToChannelRequest) (*HasPermissionToChannelResponse, error)
+	RolesGrantPermission(context.Context, *RolesGrantPermissionRequest) (*RolesGrantPermissionResponse, error)
+	LogDebug(context.Context, *LogDebugRequest) (*LogDebugResponse, error)
+	LogInfo(context.Context, *LogInfoRequest) (*LogInfoResponse, error)
+	LogError(context.Context, *LogErrorRequest) (*LogErrorResponse, error)
+	LogWarn(context.Context, *LogWarnRequest) (*LogWarnResponse, error)
+	SendMail(context.Context, *SendMailRequest) (*SendMailResponse, error)
+	CreateBot(context.Context, *CreateBotRequest) (*CreateBotResponse, error)
+	PatchBot(context.Context, *PatchBotRequest) (*PatchBotResponse, error)
+	GetBot(context.Context, *GetBotRequest) (*GetBotResponse, error)
+	GetBots(context.Context, *GetBotsRequest) (*GetBotsResponse, error)
+	UpdateBotActive(context.Context, *UpdateBotActiveRequest) (*UpdateBotActiveResponse, error)
+	PermanentDeleteBot(context.Context, *PermanentDeleteBotRequest) (*PermanentDeleteBotResponse, error)
+	PublishUserTyping(context.Context, *PublishUserTypingRequest) (*PublishUserTypingResponse, error)
+	CreateCommand(context.Context, *CreateCommandRequest) (*CreateCommandResponse, error)
+	ListCommands(context.Context, *ListCommandsRequest) (*ListCommandsResponse, error)
+	ListCustomCommands(context.Context, *ListCustomCommandsRequest) (*ListCustomCommandsResponse, error)
+	ListPluginCommands(context.Context, *ListPluginCommandsRequest) (*ListPluginCommandsResponse, error)
+	ListBuiltInCommands(context.Context, *ListBuiltInCommandsRequest) (*ListBuiltInCommandsResponse, error)
+	GetCommand(context.Context, *GetCommandRequest) (*GetCommandResponse, error)
+	UpdateCommand(context.Context, *UpdateCommandRequest) (*UpdateCommandResponse, error)
+	DeleteCommand(context.Context, *DeleteCommandRequest) (*DeleteCommandResponse, error)
+	CreateOAuthApp(context.Context, *CreateOAuthAppRequest) (*CreateOAuthAppResponse, error)
+	GetOAuthApp(context.Context, *GetOAuthAppRequest) (*GetOAuthAppResponse, error)
+	UpdateOAuthApp(context.Context, *UpdateOAuthAppRequest) (*UpdateOAuthAppResponse, error)
+	DeleteOAuthApp(context.Context, *DeleteOAuthAppRequest) (*DeleteOAuthAppResponse, error)
+	PublishPluginClusterEvent(context.Context, *PublishPluginClusterEventRequest) (*PublishPluginClusterEventResponse, error)
+	RequestTrialLicense(context.Context, *RequestTrialLicenseRequest) (*RequestTrialLicenseResponse, error)
+	GetCloudLimits(context.Context, *GetCloudLimitsRequest) (*GetCloudLimitsResponse, error)
+	EnsureBotUser(context.Context, *EnsureBotUserRequest) (*EnsureBotUserResponse, error)
+	RegisterCollectionAndTopic(context.Context, *RegisterCollectionAndTopicRequest) (*RegisterCollectionAndTopicResponse, error)
+	CreateUploadSession(context.Context, *CreateUploadSessionRequest) (*CreateUploadSessionResponse, error)
+	UploadData(context.Context, *UploadDataRequest) (*UploadDataResponse, error)
+	GetUploadSession(context.Context, *GetUploadSessionRequest) (*GetUploadSessionResponse, error)
+	SendPushNotification(context.Context, *SendPushNotificationRequest) (*SendPushNotificationResponse, error)
+	UpdateUserAuth(context.Context, *UpdateUserAuthRequest) (*UpdateUserAuthResponse, error)
+	RegisterPluginForSharedChannels(context.Context, *RegisterPluginForSharedChannelsRequest) (*RegisterPluginForSharedChannelsResponse, error)
+	UnregisterPluginForSharedChannels(context.Context, *UnregisterPluginForSharedChannelsRequest) (*UnregisterPluginForSharedChannelsResponse, error)
+	ShareChannel(context.Context, *ShareChannelRequest) (*ShareChannelResponse, error)
+	UpdateSharedChannel(context.Context, *UpdateSharedChannelRequest) (*UpdateSharedChannelResponse, error)
+	UnshareChannel(context.Context, *UnshareChannelRequest) (*UnshareChannelResponse, error)
+	UpdateSharedChannelCursor(context.Context, *UpdateSharedChannelCursorRequest) (*UpdateSharedChannelCursorResponse, error)
+	SyncSharedChannel(context.Context, *SyncSharedChannelRequest) (*SyncSharedChannelResponse, error)
+	InviteRemoteToChannel(context.Context, *InviteRemoteToChannelRequest) (*InviteRemoteToChannelResponse, error)
+	UninviteRemoteFromChannel(context.Context, *UninviteRemoteFromChannelRequest) (*UninviteRemoteFromChannelResponse, error)
+	UpsertGroupMember(context.Context, *UpsertGroupMemberRequest) (*UpsertGroupMemberResponse, error)
+	UpsertGroupMembers(context.Context, *UpsertGroupMembersRequest) (*UpsertGroupMembersResponse, error)
+	GetGroupByRemoteID(context.Context, *GetGroupByRemoteIDRequest) (*GetGroupByRemoteIDResponse, error)
+	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
+	UpdateGroup(context.Context, *UpdateGroupRequest) (*UpdateGroupResponse, error)
+	DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
+	RestoreGroup(context.Context, *RestoreGroupRequest) (*RestoreGroupResponse, error)
+	DeleteGroupMember(context.Context, *DeleteGroupMemberRequest) (*DeleteGroupMemberResponse, error)
+	GetGroupSyncable(context.Context, *GetGroupSyncableRequest) (*GetGroupSyncableResponse, error)
+	GetGroupSyncables(context.Context, *GetGroupSyncablesRequest) (*GetGroupSyncablesResponse, error)
+	UpsertGroupSyncable(context.Context, *UpsertGroupSyncableRequest) (*UpsertGroupSyncableResponse, error)
+	UpdateGroupSyncable(context.Context, *UpdateGroupSyncableRequest) (*UpdateGroupSyncableResponse, error)
+	DeleteGroupSyncable(context.Context, *DeleteGroupSyncableRequest) (*DeleteGroupSyncableResponse, error)
+	UpdateUserRoles(context.Context, *UpdateUserRolesRequest) (*UpdateUserRolesResponse, error)
+	GetPluginID(context.Context, *GetPluginIDRequest) (*GetPluginIDResponse, error)
+	GetGroups(context.Context, *GetGroupsRequest) (*GetGroupsResponse, error)
+	CreateDefaultSyncableMemberships(context.Context, *CreateDefaultSyncableMembershipsRequest) (*CreateDefaultSyncableMembershipsResponse, error)
+	DeleteGroupConstrainedMemberships(context.Context, *DeleteGroupConstrainedMembershipsRequest) (*DeleteGroupConstrainedMembershipsResponse, error)
+	CreatePropertyField(context.Context, *CreatePropertyFieldRequest) (*CreatePropertyFieldResponse, error)
+	GetPropertyField(context.Context, *GetPropertyFieldRequest) (*GetPropertyFieldResponse, error)
+	GetPropertyFields(context.Context, *GetPropertyFieldsRequest) (*GetPropertyFieldsResponse, error)
+	UpdatePropertyField(context.Context, *UpdatePropertyFieldRequest) (*UpdatePropertyFieldResponse, error)
+	DeletePropertyField(context.Context, *DeletePropertyFieldRequest) (*DeletePropertyFieldResponse, error)
+	SearchPropertyFields(context.Context, *SearchPropertyFieldsRequest) (*SearchPropertyFieldsResponse, error)
+	CountPropertyFields(context.Context, *CountPropertyFieldsRequest) (*CountPropertyFieldsResponse, error)
+	CountPropertyFieldsForTarget(context.Context, *CountPropertyFieldsForTargetRequest) (*CountPropertyFieldsForTargetResponse, error)
+	CreatePropertyValue(context.Context, *CreatePropertyValueRequest) (*CreatePropertyValueResponse, error)
+	GetPropertyValue(context.Context, *GetPropertyValueRequest) (*GetPropertyValueResponse, error)
+	GetPropertyValues(context.Context, *GetPropertyValuesRequest) (*GetPropertyValuesResponse, error)
+	UpdatePropertyValue(context.Context, *UpdatePropertyValueRequest) (*UpdatePropertyValueResponse, error)
+	UpsertPropertyValue(context.Context, *UpsertPropertyValueRequest) (*UpsertPropertyValueResponse, error)
+	DeletePropertyValue(context.Context, *DeletePropertyValueRequest) (*DeletePropertyValueResponse, error)
+	SearchPropertyValues(context.Context, *SearchPropertyValuesRequest) (*SearchPropertyValuesResponse, error)
+	RegisterPropertyGroup(context.Context, *RegisterPropertyGroupRequest) (*RegisterPropertyGroupResponse, error)
+	GetPropertyGroup(context.Context, *GetPropertyGroupRequest) (*GetPropertyGroupResponse, error)
+	GetPropertyFieldByName(context.Context, *GetPropertyFieldByNameRequest) (*GetPropertyFieldByNameResponse, error)
+	UpdatePropertyFields(context.Context, *UpdatePropertyFieldsRequest) (*UpdatePropertyFieldsResponse, error)
+	UpdatePropertyValues(context.Context, *UpdatePropertyValuesRequest) (*UpdatePropertyValuesResponse, error)
+	UpsertPropertyValues(context.Context, *UpsertPropertyValuesRequest) (*UpsertPropertyValuesResponse, error)
+	DeletePropertyValuesForTarget(context.Context, *DeletePropertyValuesForTargetRequest) (*DeletePropertyValuesForTargetResponse, error)
+	DeletePropertyValuesForField(context.Context, *DeletePropertyValuesForFieldRequest) (*DeletePropertyValuesForFieldResponse, error)
+	LogAuditRec(context.Context, *LogAuditRecRequest) (*LogAuditRecResponse, error)
+	LogAuditRecWithLevel(context.Context, *LogAuditRecWithLevelRequest) (*LogAuditRecWithLevelResponse, error)
+	mustEmbedUnimplementedAPIServer()
+}
+
+// UnimplementedAPIServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAPIServer struct{}
+
+func (UnimplementedAPIServer) LoadPluginConfiguration(context.Context, *LoadPluginConfigurationRequest) (*LoadPluginConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadPluginConfiguration not implemented")
+}
+func (UnimplementedAPIServer) RegisterCommand(context.Context, *RegisterCommandRequest) (*RegisterCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCommand not implemented")
+}
+func (UnimplementedAPIServer) UnregisterCommand(context.Context, *UnregisterCommandRequest) (*UnregisterCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterCommand not implemented")
+}
+func (UnimplementedAPIServer) ExecuteSlashCommand(context.Context, *ExecuteSlashCommandRequest) (*ExecuteSlashCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteSlashCommand not implemented")
+}
+func (UnimplementedAPIServer) GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (UnimplementedAPIServer) GetUnsanitizedConfig(context.Context, *GetUnsanitizedConfigRequest) (*GetUnsanitizedConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnsanitizedConfig not implemented")
+}
+func (UnimplementedAPIServer) SaveConfig(context.Context, *SaveConfigRequest) (*SaveConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveConfig not implemented")
+}
+func (UnimplementedAPIServer) GetPluginConfig(context.Context, *GetPluginConfigRequest) (*GetPluginConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPluginConfig not implemented")
+}
+func (UnimplementedAPIServer) SavePluginConfig(context.Context, *SavePluginConfigRequest) (*SavePluginConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SavePluginConfig not implemented")
+}
+func (UnimplementedAPIServer) GetBundlePath(context.Context, *GetBundlePathRequest) (*GetBundlePathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBundlePath not implemented")
+}
+func (UnimplementedAPIServer) GetLicense(context.Context, *GetLicenseRequest) (*GetLicenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLicense not implemented")
+}
+func (UnimplementedAPIServer) IsEnterpriseReady(context.Context, *IsEnterpriseReadyRequest) (*IsEnterpriseReadyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsEnterpriseReady not implemented")
+}
+func (UnimplementedAPIServer) GetServerVersion(context.Context, *GetServerVersionRequest) (*GetServerVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServerVersion not implemented")
+}
+func (UnimplementedAPIServer) GetSystemInstallDate(context.Context, *GetSystemInstallDateRequest) (*GetSystemInstallDateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSystemInstallDate not implemented")
+}
+func (UnimplementedAPIServer) GetDiagnosticId(context.Context, *GetDiagnosticIdRequest) (*GetDiagnosticIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiagnosticId not implemented")
+}
+func (UnimplementedAPIServer) GetTelemetryId(context.Context, *GetTelemetryIdRequest) (*GetTelemetryIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTelemetryId not implemented")
+}
+func (UnimplementedAPIServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedAPIServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedAPIServer) GetUsers(context.Context, *GetUsersRequest) (*GetUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsers not implemented")
+}
+func (UnimplementedAPIServer) GetUsersByIds(context.Context, *GetUsersByIdsRequest) (*GetUsersByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersByIds not implemented")
+}
+func (UnimplementedAPIServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedAPIServer) GetUserByEmail(context.Context, *GetUserByEmailRequest) (*GetUserByEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByEmail not implemented")
+}
+func (UnimplementedAPIServer) GetUserByUsername(context.Context, *GetUserByUsernameRequest) (*GetUserByUsernameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByUsername not implemented")
+}
+func (UnimplementedAPIServer) GetUsersByUsernames(context.Context, *GetUsersByUsernamesRequest) (*GetUsersByUsernamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersByUsernames not implemented")
+}
+func (UnimplementedAPIServer) GetUsersInTeam(context.Context, *GetUsersInTeamRequest) (*GetUsersInTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersInTeam not implemented")
+}
+func (UnimplementedAPIServer) GetPreferenceForUser(context.Context, *GetPreferenceForUserRequest) (*GetPreferenceForUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreferenceForUser not implemented")
+}
+func (UnimplementedAPIServer) GetPreferencesForUser(context.Context, *GetPreferencesForUserRequest) (*GetPreferencesForUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreferencesForUser not implemented")
+}
+func (UnimplementedAPIServer) UpdatePreferencesForUser(context.Context, *UpdatePreferencesForUserRequest) (*UpdatePreferencesForUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePreferencesForUser not implemented")
+}
+func (UnimplementedAPIServer) DeletePreferencesForUser(context.Context, *DeletePreferencesForUserRequest) (*DeletePreferencesForUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePreferencesForUser not implemented")
+}
+func (UnimplementedAPIServer) GetSession(context.Context, *GetSessionRequest) (*GetSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSession not implemented")
+}
+func (UnimplementedAPIServer) CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
+}
+func (UnimplementedAPIServer) ExtendSessionExpiry(context.Context, *ExtendSessionExpiryRequest) (*ExtendSessionExpiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendSessionExpiry not implemented")
+}
+func (UnimplementedAPIServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedAPIServer) CreateUserAccessToken(context.Context, *CreateUserAccessTokenRequest) (*CreateUserAccessTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUserAccessToken not implemented")
+}
+func (UnimplementedAPIServer) RevokeUserAccessToken(context.Context, *RevokeUserAccessTokenRequest) (*RevokeUserAccessTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserAccessToken not implemented")
+}
+func (UnimplementedAPIServer) GetTeamIcon(context.Context, *GetTeamIconRequest) (*GetTeamIconResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamIcon not implemented")
+}
+func (UnimplementedAPIServer) SetTeamIcon(context.Context, *SetTeamIconRequest) (*SetTeamIconResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTeamIcon not implemented")
+}
+func (UnimplementedAPIServer) RemoveTeamIcon(context.Context, *RemoveTeamIconRequest) (*RemoveTeamIconResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTeamIcon not implemented")
+}
+func (UnimplementedAPIServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedAPIServer) GetUserStatus(context.Context, *GetUserStatusRequest) (*GetUserStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserStatus not implemented")
+}
+func (UnimplementedAPIServer) GetUserStatusesByIds(context.Context, *GetUserStatusesByIdsRequest) (*GetUserStatusesByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserStatusesByIds not implemented")
+}
+func (UnimplementedAPIServer) UpdateUserStatus(context.Context, *UpdateUserStatusRequest) (*UpdateUserStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserStatus not implemented")
+}
+func (UnimplementedAPIServer) SetUserStatusTimedDND(context.Context, *SetUserStatusTimedDNDRequest) (*SetUserStatusTimedDNDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserStatusTimedDND not implemented")
+}
+func (UnimplementedAPIServer) UpdateUserActive(context.Context, *UpdateUserActiveRequest) (*UpdateUserActiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserActive not implemented")
+}
+func (UnimplementedAPIServer) UpdateUserCustomStatus(context.Context, *UpdateUserCustomStatusRequest) (*UpdateUserCustomStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserCustomStatus not implemented")
+}
+func (UnimplementedAPIServer) RemoveUserCustomStatus(context.Context, *RemoveUserCustomStatusRequest) (*RemoveUserCustomStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserCustomStatus not implemented")
+}
+func (UnimplementedAPIServer) GetUsersInChannel(context.Context, *GetUsersInChannelRequest) (*GetUsersInChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersInChannel not implemented")
+}
+func (UnimplementedAPIServer) GetLDAPUserAttributes(context.Context, *GetLDAPUserAttributesRequest) (*GetLDAPUserAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLDAPUserAttributes not implemented")
+}
+func (UnimplementedAPIServer) CreateTeam(context.Context, *CreateTeamRequest) (*CreateTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeam not implemented")
+}
+func (UnimplementedAPIServer) DeleteTeam(context.Context, *DeleteTeamRequest) (*DeleteTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeam not implemented")
+}
+func (UnimplementedAPIServer) GetTeams(context.Context, *GetTeamsRequest) (*GetTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeams not implemented")
+}
+func (UnimplementedAPIServer) GetTeam(context.Context, *GetTeamRequest) (*GetTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeam not implemented")
+}
+func (UnimplementedAPIServer) GetTeamByName(context.Context, *GetTeamByNameRequest) (*GetTeamByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamByName not implemented")
+}
+func (UnimplementedAPIServer) GetTeamsUnreadForUser(context.Context, *GetTeamsUnreadForUserRequest) (*GetTeamsUnreadForUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamsUnreadForUser not implemented")
+}
+func (UnimplementedAPIServer) UpdateTeam(context.Context, *UpdateTeamRequest) (*UpdateTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTeam not implemented")
+}
+func (UnimplementedAPIServer) SearchTeams(context.Context, *SearchTeamsRequest) (*SearchTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTeams not implemented")
+}
+func (UnimplementedAPIServer) GetTeamsForUser(context.Context, *GetTeamsForUserRequest) (*GetTeamsForUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamsForUser not implemented")
+}
+func (UnimplementedAPIServer) CreateTeamMember(context.Context, *CreateTeamMemberRequest) (*CreateTeamMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeamMember not implemented")
+}
+func (UnimplementedAPIServer) CreateTeamMembers(context.Context, *CreateTeamMembersRequest) (*CreateTeamMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeamMembers not implemented")
+}
+func (UnimplementedAPIServer) CreateTeamMembersGracefully(context.Context, *CreateTeamMembersGracefullyRequest) (*CreateTeamMembersGracefullyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeamMembersGracefully not implemented")
+}
+func (UnimplementedAPIServer) DeleteTeamMember(context.Context, *DeleteTeamMemberRequest) (*DeleteTeamMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeamMember not implemented")
+}
+func (UnimplementedAPIServer) GetTeamMembers(context.Context, *GetTeamMembersRequest) (*GetTeamMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamMembers not implemented")
+}
+func (UnimplementedAPIServer) GetTeamMember(context.Context, *GetTeamMemberRequest) (*GetTeamMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamMember not implemented")
+}
+func (UnimplementedAPIServer) GetTeamMembersForUser(context.Context, *GetTeamMembersForUserRequest) (*GetTeamMembersForUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamMembersForUser not implemented")
+}
+func (UnimplementedAPIServer) UpdateTeamMemberRoles(context.Context, *UpdateTeamMemberRolesRequest) (*UpdateTeamMemberRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTeamMemberRoles not implemented")
+}
+func (UnimplementedAPIServer) CreateChannel(context.Context, *CreateChannelRequest) (*CreateChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChannel not implemented")
+}
+func (UnimplementedAPIServer) DeleteChannel(context.Context, *DeleteChannelRequest) (*DeleteChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChannel not implemented")
+}
+func (UnimplementedAPIServer) GetPublicChannelsForTeam(context.Context, *GetPublicChannelsForTeamRequest) (*GetPublicChannelsForTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicChannelsForTeam not implemented")
+}
+func (UnimplementedAPIServer) GetChannel(context.Context, *GetChannelRequest) (*GetChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannel not implemented")
+}
+func (UnimplementedAPIServer) GetChannelByName(context.Context, *GetChannelByNameRequest) (*GetChannelByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelByName not implemented")
+}
+func (UnimplementedAPIServer) GetChannelByNameForTeamName(context.Context, *GetChannelByNameForTeamNameRequest) (*GetChannelByNameForTeamNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelByNameForTeamName not implemented")
+}
+func (UnimplementedAPIServer) GetChannelsForTeamForUser(context.Context, *GetChannelsForTeamForUserRequest) (*GetChannelsForTeamForUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelsForTeamForUser not implemented")
+}
+func (UnimplementedAPIServer) GetChannelStats(context.Context, *GetChannelStatsRequest) (*GetChannelStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelStats not implemented")
+}
+func (UnimplementedAPIServer) GetDirectChannel(context.Context, *GetDirectChannelRequest) (*GetDirectChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDirectChannel not implemented")
+}
+func (UnimplementedAPIServer) GetGroupChannel(context.Context, *GetGroupChannelRequest) (*GetGroupChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupChannel not implemented")
+}
+func (UnimplementedAPIServer) UpdateChannel(context.Context, *UpdateChannelRequest) (*UpdateChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannel not implemented")
+}
+func (UnimplementedAPIServer) SearchChannels(context.Context, *SearchChannelsRequest) (*SearchChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchChannels not implemented")
+}
+func (UnimplementedAPIServer) CreateChannelSidebarCategory(context.Context, *CreateChannelSidebarCategoryRequest) (*CreateChannelSidebarCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChannelSidebarCategory not implemented")
+}
+func (UnimplementedAPIServer) GetChannelSidebarCategories(context.Context, *GetChannelSidebarCategoriesRequest) (*GetChannelSidebarCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelSidebarCategories not implemented")
+}
+func (UnimplementedAPIServer) UpdateChannelSidebarCategories(context.Context, *UpdateChannelSidebarCategoriesRequest) (*UpdateChannelSidebarCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelSidebarCategories not implemented")
+}
+func (UnimplementedAPIServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
+func (UnimplementedAPIServer) SearchPostsInTeam(context.Context, *SearchPostsInTeamRequest) (*SearchPostsInTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPostsInTeam not implemented")
+}
+func (UnimplementedAPIServer) SearchPostsInTeamForUser(context.Context, *SearchPostsInTeamForUserRequest) (*SearchPostsInTeamForUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPostsInTeamForUser not implemented")
+}
+func (UnimplementedAPIServer) AddChannelMember(context.Context, *AddChannelMemberRequest) (*AddChannelMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddChannelMember not implemented")
+}
+func (UnimplementedAPIServer) AddUserToChannel(context.Context, *AddUserToChannelRequest) (*AddUserToChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUserToChannel not implemented")
+}
+func (UnimplementedAPIServer) GetChannelMember(context.Context, *GetChannelMemberRequest) (*GetChannelMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelMember not implemented")
+}
+func (UnimplementedAPIServer) GetChannelMembers(context.Context, *GetChannelMembersRequest) (*GetChannelMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelMembers not implemented")
+}
+func (UnimplementedAPIServer) GetChannelMembersByIds(context.Context, *GetChannelMembersByIdsRequest) (*GetChannelMembersByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelMembersByIds not implemented")
+}
+func (UnimplementedAPIServer) GetChannelMembersForUser(context.Context, *GetChannelMembersForUserRequest) (*GetChannelMembersForUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelMembersForUser not implemented")
+}
+func (UnimplementedAPIServer) UpdateChannelMemberRoles(context.Context, *UpdateChannelMemberRolesRequest) (*UpdateChannelMemberRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelMemberRoles not implemented")
+}
+func (UnimplementedAPIServer) UpdateChannelMemberNotifications(context.Context, *UpdateChannelMemberNotificationsRequest) (*UpdateChannelMemberNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelMemberNotifications not implemented")
+}
+func (UnimplementedAPIServer) PatchChannelMembersNotifications(context.Context, *PatchChannelMembersNotificationsRequest) (*PatchChannelMembersNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchChannelMembersNotifications not implemented")
+}
+func (UnimplementedAPIServer) GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroup not implemented")
+}
+func (UnimplementedAPIServer) GetGroupByName(context.Context, *GetGroupByNameRequest) (*GetGroupByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupByName not implemented")
+}
+func (UnimplementedAPIServer) GetGroupMemberUsers(context.Context, *GetGroupMemberUsersRequest) (*GetGroupMemberUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupMemberUsers not implemented")
+}
+func (UnimplementedAPIServer) GetGroupsBySource(context.Context, *GetGroupsBySourceRequest) (*GetGroupsBySourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupsBySource not implemented")
+}
+func (UnimplementedAPIServer) GetGroupsForUser(context.Context, *GetGroupsForUserRequest) (*GetGroupsForUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupsForUser not implemented")
+}
+func (UnimplementedAPIServer) DeleteChannelMember(context.Context, *DeleteChannelMemberRequest) (*DeleteChannelMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChannelMember not implemented")
+}
+func (UnimplementedAPIServer) CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
+}
+func (UnimplementedAPIServer) AddReaction(context.Context, *AddReactionRequest) (*AddReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReaction not implemented")
+}
+func (UnimplementedAPIServer) RemoveReaction(context.Context, *RemoveReactionRequest) (*RemoveReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReaction not implemented")
+}
+func (UnimplementedAPIServer) GetReactions(context.Context, *GetReactionsRequest) (*GetReactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReactions not implemented")
+}
+func (UnimplementedAPIServer) SendEphemeralPost(context.Context, *SendEphemeralPostRequest) (*SendEphemeralPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEphemeralPost not implemented")
+}
+func (UnimplementedAPIServer) UpdateEphemeralPost(context.Context, *UpdateEphemeralPostRequest) (*UpdateEphemeralPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEphemeralPost not implemented")
+}
+func (UnimplementedAPIServer) DeleteEphemeralPost(context.Context, *DeleteEphemeralPostRequest) (*DeleteEphemeralPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEphemeralPost not implemented")
+}
+func (UnimplementedAPIServer) DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+}
+func (UnimplementedAPIServer) GetPostThread(context.Context, *GetPostThreadRequest) (*GetPostThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostThread not implemented")
+}
+func (UnimplementedAPIServer) GetPost(context.Context, *GetPostRequest) (*GetPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPost not implemented")
+}
+func (UnimplementedAPIServer) GetPostsSince(context.Context, *GetPostsSinceRequest) (*GetPostsSinceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostsSince not implemented")
+}
+func (UnimplementedAPIServer) GetPostsAfter(context.Context, *GetPostsAfterRequest) (*GetPostsAfterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostsAfter not implemented")
+}
+func (UnimplementedAPIServer) GetPostsBefore(context.Context, *GetPostsBeforeRequest) (*GetPostsBeforeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostsBefore not implemented")
+}
+func (UnimplementedAPIServer) GetPostsForChannel(context.Context, *GetPostsForChannelRequest) (*GetPostsForChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostsForChannel not implemented")
+}
+func (UnimplementedAPIServer) GetTeamStats(context.Context, *GetTeamStatsRequest) (*GetTeamStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamStats not implemented")
+}
+func (UnimplementedAPIServer) UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
+}
+func (UnimplementedAPIServer) GetProfileImage(context.Context, *GetProfileImageRequest) (*GetProfileImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfileImage not implemented")
+}
+func (UnimplementedAPIServer) SetProfileImage(context.Context, *SetProfileImageRequest) (*SetProfileImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProfileImage not implemented")
+}
+func (UnimplementedAPIServer) GetEmojiList(context.Context, *GetEmojiListRequest) (*GetEmojiListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmojiList not implemented")
+}
+func (UnimplementedAPIServer) GetEmojiByName(context.Context, *GetEmojiByNameRequest) (*GetEmojiByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmojiByName not implemented")
+}
+func (UnimplementedAPIServer) GetEmoji(context.Context, *GetEmojiRequest) (*GetEmojiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmoji not implemented")
+}
+func (UnimplementedAPIServer) CopyFileInfos(context.Context, *CopyFileInfosRequest) (*CopyFileInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyFileInfos not implemented")
+}
+func (UnimplementedAPIServer) GetFileInfo(context.Context, *GetFileInfoRequest) (*GetFileInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileInfo not implemented")
+}
+func (UnimplementedAPIServer) SetFileSearchableContent(context.Context, *SetFileSearchableContentRequest) (*SetFileSearchableContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFileSearchableContent not implemented")
+}
+func (UnimplementedAPIServer) GetFileInfos(context.Context, *GetFileInfosRequest) (*GetFileInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileInfos not implemented")
+}
+func (UnimplementedAPIServer) GetFile(context.Context, *GetFileRequest) (*GetFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFile not implemented")
+}
+func (UnimplementedAPIServer) GetFileLink(context.Context, *GetFileLinkRequest) (*GetFileLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileLink not implemented")
+}
+func (UnimplementedAPIServer) ReadFile(context.Context, *ReadFileRequest) (*ReadFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadFile not implemented")
+}
+func (UnimplementedAPIServer) GetEmojiImage(context.Context, *GetEmojiImageRequest) (*GetEmojiImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmojiImage not implemented")
+}
+func (UnimplementedAPIServer) UploadFile(context.Context, *UploadFileRequest) (*UploadFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadFile not implemented")
+}
+func (UnimplementedAPIServer) OpenInteractiveDialog(context.Context, *OpenInteractiveDialogRequest) (*OpenInteractiveDialogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenInteractiveDialog not implemented")
+}
+func (UnimplementedAPIServer) GetPlugins(context.Context, *GetPluginsRequest) (*GetPluginsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlugins not implemented")
+}
+func (UnimplementedAPIServer) EnablePlugin(context.Context, *EnablePluginRequest) (*EnablePluginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnablePlugin not implemented")
+}
+func (UnimplementedAPIServer) DisablePlugin(context.Context, *DisablePluginRequest) (*DisablePluginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisablePlugin not implemented")
+}
+func (UnimplementedAPIServer) RemovePlugin(context.Context, *RemovePluginRequest) (*RemovePluginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePlugin not implemented")
+}
+func (UnimplementedAPIServer) GetPluginStatus(context.Context, *GetPluginStatusRequest) (*GetPluginStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPluginStatus not implemented")
+}
+func (UnimplementedAPIServer) InstallPlugin(context.Context, *InstallPluginRequest) (*InstallPluginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallPlugin not implemented")
+}
+func (UnimplementedAPIServer) KVSet(context.Context, *KVSetRequest) (*KVSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KVSet not implemented")
+}
+func (UnimplementedAPIServer) KVCompareAndSet(context.Context, *KVCompareAndSetRequest) (*KVCompareAndSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KVCompareAndSet not implemented")
+}
+func (UnimplementedAPIServer) KVCompareAndDelete(context.Context, *KVCompareAndDeleteRequest) (*KVCompareAndDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KVCompareAndDelete not implemented")
+}
+func (UnimplementedAPIServer) KVSetWithOptions(context.Context, *KVSetWithOptionsRequest) (*KVSetWithOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KVSetWithOptions not implemented")
+}
+func (UnimplementedAPIServer) KVSetWithExpiry(context.Context, *KVSetWithExpiryRequest) (*KVSetWithExpiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KVSetWithExpiry not implemented")
+}
+func (UnimplementedAPIServer) KVGet(context.Context, *KVGetRequest) (*KVGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KVGet not implemented")
+}
+func (UnimplementedAPIServer) KVDelete(context.Context, *KVDeleteRequest) (*KVDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KVDelete not implemented")
+}
+func (UnimplementedAPIServer) KVDeleteAll(context.Context, *KVDeleteAllRequest) (*KVDeleteAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KVDeleteAll not implemented")
+}
+func (UnimplementedAPIServer) KVList(context.Context, *KVListRequest) (*KVListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KVList not implemented")
+}
+func (UnimplementedAPIServer) PublishWebSocketEvent(context.Context, *PublishWebSocketEventRequest) (*PublishWebSocketEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishWebSocketEvent not implemented")
+}
+func (UnimplementedAPIServer) HasPermissionTo(context.Context, *HasPermissionToRequest) (*HasPermissionToResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasPermissionTo not implemented")
+}
+func (UnimplementedAPIServer) HasPermissionToTeam(context.Context, *HasPermissionToTeamRequest) (*HasPermissionToTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasPermissionToTeam not implemented")
+}
+func (UnimplementedAPIServer) HasPermissionToChannel(context.Context, *HasPermissionToChannelRequest) (*HasPermissionToChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasPermissionToChannel not implemented")
+}
+func (UnimplementedAPIServer) RolesGrantPermission(context.Context, *RolesGrantPermissionRequest) (*RolesGrantPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RolesGrantPermission not implemented")
+}
+func (UnimplementedAPIServer) LogDebug(context.Context, *LogDebugRequest) (*LogDebugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogDebug not implemented")
+}
+func (UnimplementedAPIServer) LogInfo(context.Context, *LogInfoRequest) (*LogInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogInfo not implemented")
+}
+func (UnimplementedAPIServer) LogError(context.Context, *LogErrorRequest) (*LogErrorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogError not implemented")
+}
+func (UnimplementedAPIServer) LogWarn(context.Context, *LogWarnRequest) (*LogWarnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogWarn not implemented")
+}
+func (UnimplementedAPIServer) SendMail(context.Context, *SendMailRequest) (*SendMailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMail not implemented")
+}
+func (UnimplementedAPIServer) CreateBot(context.Context, *CreateBotRequest) (*CreateBotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBot not implemented")
+}
+func (UnimplementedAPIServer) PatchBot(context.Context, *PatchBotRequest) (*PatchBotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchBot not implemented")
+}
+func (UnimplementedAPIServer) GetBot(context.Context, *GetBotRequest) (*GetBotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBot not implemented")
+}
+func (UnimplementedAPIServer) GetBots(context.Context, *GetBotsRequest) (*GetBotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBots not implemented")
+}
+func (UnimplementedAPIServer) UpdateBotActive(context.Context, *UpdateBotActiveRequest) (*UpdateBotActiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBotActive not implemented")
+}
+func (UnimplementedAPIServer) PermanentDeleteBot(context.Context, *PermanentDeleteBotRequest) (*PermanentDeleteBotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PermanentDeleteBot not implemented")
+}
+func (UnimplementedAPIServer) PublishUserTyping(context.Context, *PublishUserTypingRequest) (*PublishUserTypingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishUserTyping not implemented")
+}
+func (UnimplementedAPIServer) CreateCommand(context.Context, *CreateCommandRequest) (*CreateCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCommand not implemented")
+}
+func (UnimplementedAPIServer) ListCommands(context.Context, *ListCommandsRequest) (*ListCommandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommands not implemented")
+}
+func (UnimplementedAPIServer) ListCustomCommands(context.Context, *ListCustomCommandsRequest) (*ListCustomCommandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCustomCommands not implemented")
+}
+func (UnimplementedAPIServer) ListPluginCommands(context.Context, *ListPluginCommandsRequest) (*ListPluginCommandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPluginCommands not implemented")
+}
+func (UnimplementedAPIServer) ListBuiltInCommands(context.Context, *ListBuiltInCommandsRequest) (*ListBuiltInCommandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBuiltInCommands not implemented")
+}
+func (UnimplementedAPIServer) GetCommand(context.Context, *GetCommandRequest) (*GetCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommand not implemented")
+}
+func (UnimplementedAPIServer) UpdateCommand(context.Context, *UpdateCommandRequest) (*UpdateCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCommand not implemented")
+}
+func (UnimplementedAPIServer) DeleteCommand(context.Context, *DeleteCommandRequest) (*DeleteCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCommand not implemented")
+}
+func (UnimplementedAPIServer) CreateOAuthApp(context.Context, *CreateOAuthAppRequest) (*CreateOAuthAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOAuthApp not implemented")
+}
+func (UnimplementedAPIServer) GetOAuthApp(context.Context, *GetOAuthAppRequest) (*GetOAuthAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOAuthApp not implemented")
+}
+func (UnimplementedAPIServer) UpdateOAuthApp(context.Context, *UpdateOAuthAppRequest) (*UpdateOAuthAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOAuthApp not implemented")
+}
+func (UnimplementedAPIServer) DeleteOAuthApp(context.Context, *DeleteOAuthAppRequest) (*DeleteOAuthAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOAuthApp not implemented")
+}
+func (UnimplementedAPIServer) PublishPluginClusterEvent(context.Context, *PublishPluginClusterEventRequest) (*PublishPluginClusterEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishPluginClusterEvent not implemented")
+}
+func (UnimplementedAPIServer) RequestTrialLicense(context.Context, *RequestTrialLicenseRequest) (*RequestTrialLicenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestTrialLicense not implemented")
+}
+func (UnimplementedAPIServer) GetCloudLimits(context.Context, *GetCloudLimitsRequest) (*GetCloudLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCloudLimits not implemented")
+}
+func (UnimplementedAPIServer) EnsureBotUser(context.Context, *EnsureBotUserRequest) (*EnsureBotUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnsureBotUser not implemented")
+}
+func (UnimplementedAPIServer) RegisterCollectionAndTopic(context.Context, *RegisterCollectionAndTopicRequest) (*RegisterCollectionAndTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCollectionAndTopic not implemented")
+}
+func (UnimplementedAPIServer) CreateUploadSession(context.Context, *CreateUploadSessionRequest) (*CreateUploadSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUploadSession not implemented")
+}
+func (UnimplementedAPIServer) UploadData(context.Context, *UploadDataRequest) (*UploadDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadData not implemented")
+}
+func (UnimplementedAPIServer) GetUploadSession(context.Context, *GetUploadSessionRequest) (*GetUploadSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUploadSession not implemented")
+}
+func (UnimplementedAPIServer) SendPushNotification(context.Context, *SendPushNotificationRequest) (*SendPushNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPushNotification not implemented")
+}
+func (UnimplementedAPIServer) UpdateUserAuth(context.Context, *UpdateUserAuthRequest) (*UpdateUserAuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserAuth not implemented")
+}
+func (UnimplementedAPIServer) RegisterPluginForSharedChannels(context.Context, *RegisterPluginForSharedChannelsRequest) (*RegisterPluginForSharedChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPluginForSharedChannels not implemented")
+}
+func (UnimplementedAPIServer) UnregisterPluginForSharedChannels(context.Context, *UnregisterPluginForSharedChannelsRequest) (*UnregisterPluginForSharedChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterPluginForSharedChannels not implemented")
+}
+func (UnimplementedAPIServer) ShareChannel(context.Context, *ShareChannelRequest) (*ShareChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareChannel not implemented")
+}
+func (UnimplementedAPIServer) UpdateSharedChannel(context.Context, *UpdateSharedChannelRequest) (*UpdateSharedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSharedChannel not implemented")
+}
+func (UnimplementedAPIServer) UnshareChannel(context.Context, *UnshareChannelRequest) (*UnshareChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshareChannel not implemented")
+}
+func (UnimplementedAPIServer) UpdateSharedChannelCursor(context.Context, *UpdateSharedChannelCursorRequest) (*UpdateSharedChannelCursorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSharedChannelCursor not implemented")
+}
+func (UnimplementedAPIServer) SyncSharedChannel(context.Context, *SyncSharedChannelRequest) (*SyncSharedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncSharedChannel not implemented")
+}
+func (UnimplementedAPIServer) InviteRemoteToChannel(context.Context, *InviteRemoteToChannelRequest) (*InviteRemoteToChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteRemoteToChannel not implemented")
+}
+func (UnimplementedAPIServer) UninviteRemoteFromChannel(context.Context, *UninviteRemoteFromChannelRequest) (*UninviteRemoteFromChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UninviteRemoteFromChannel not implemented")
+}
+func (UnimplementedAPIServer) UpsertGroupMember(context.Context, *UpsertGroupMemberRequest) (*UpsertGroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertGroupMember not implemented")
+}
+func (UnimplementedAPIServer) UpsertGroupMembers(context.Context, *UpsertGroupMembersRequest) (*UpsertGroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertGroupMembers not implemented")
+}
+func (UnimplementedAPIServer) GetGroupByRemoteID(context.Context, *GetGroupByRemoteIDRequest) (*GetGroupByRemoteIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupByRemoteID not implemented")
+}
+func (UnimplementedAPIServer) CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedAPIServer) UpdateGroup(context.Context, *UpdateGroupRequest) (*UpdateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
+}
+func (UnimplementedAPIServer) DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+}
+func (UnimplementedAPIServer) RestoreGroup(context.Context, *RestoreGroupRequest) (*RestoreGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreGroup not implemented")
+}
+func (UnimplementedAPIServer) DeleteGroupMember(context.Context, *DeleteGroupMemberRequest) (*DeleteGroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroupMember not implemented")
+}
+func (UnimplementedAPIServer) GetGroupSyncable(context.Context, *GetGroupSyncableRequest) (*GetGroupSyncableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupSyncable not implemented")
+}
+func (UnimplementedAPIServer) GetGroupSyncables(context.Context, *GetGroupSyncablesRequest) (*GetGroupSyncablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupSyncables not implemented")
+}
+func (UnimplementedAPIServer) UpsertGroupSyncable(context.Context, *UpsertGroupSyncableRequest) (*UpsertGroupSyncableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertGroupSyncable not implemented")
+}
+func (UnimplementedAPIServer) UpdateGroupSyncable(context.Context, *UpdateGroupSyncableRequest) (*UpdateGroupSyncableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupSyncable not implemented")
+}
+func (UnimplementedAPIServer) DeleteGroupSyncable(context.Context, *DeleteGroupSyncableRequest) (*DeleteGroupSyncableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroupSyncable not implemented")
+}
+func (UnimplementedAPIServer) UpdateUserRoles(context.Context, *UpdateUserRolesRequest) (*UpdateUserRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserRoles not implemented")
+}
+func (UnimplementedAPIServer) GetPluginID(context.Context, *GetPluginIDRequest) (*GetPluginIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPluginID not implemented")
+}
+func (UnimplementedAPIServer) GetGroups(context.Context, *GetGroupsRequest) (*GetGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroups not implemented")
+}
+func (UnimplementedAPIServer) CreateDefaultSyncableMemberships(context.Context, *CreateDefaultSyncableMembershipsRequest) (*CreateDefaultSyncableMembershipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDefaultSyncableMemberships not implemented")
+}
+func (UnimplementedAPIServer) DeleteGroupConstrainedMemberships(context.Context, *DeleteGroupConstrainedMembershipsRequest) (*DeleteGroupConstrainedMembershipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroupConstrainedMemberships not implemented")
+}
+func (UnimplementedAPIServer) CreatePropertyField(context.Context, *CreatePropertyFieldRequest) (*CreatePropertyFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePropertyField not implemented")
+}
+func (UnimplementedAPIServer) GetPropertyField(context.Context, *GetPropertyFieldRequest) (*GetPropertyFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPropertyField not implemented")
+}
+func (UnimplementedAPIServer) GetPropertyFields(context.Context, *GetPropertyFieldsRequest) (*GetPropertyFieldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPropertyFields not implemented")
+}
+func (UnimplementedAPIServer) UpdatePropertyField(context.Context, *UpdatePropertyFieldRequest) (*UpdatePropertyFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePropertyField not implemented")
+}
+func (UnimplementedAPIServer) DeletePropertyField(context.Context, *DeletePropertyFieldRequest) (*DeletePropertyFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePropertyField not implemented")
+}
+func (UnimplementedAPIServer) SearchPropertyFields(context.Context, *SearchPropertyFieldsRequest) (*SearchPropertyFieldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPropertyFields not implemented")
+}
+func (UnimplementedAPIServer) CountPropertyFields(context.Context, *CountPropertyFieldsRequest) (*CountPropertyFieldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountPropertyFields not implemented")
+}
+func (UnimplementedAPIServer) CountPropertyFieldsForTarget(context.Context, *CountPropertyFieldsForTargetRequest) (*CountPropertyFieldsForTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountPropertyFieldsForTarget not implemented")
+}
+func (UnimplementedAPIServer) CreatePropertyValue(context.Context, *CreatePropertyValueRequest) (*CreatePropertyValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePropertyValue not implemented")
+}
+func (UnimplementedAPIServer) GetPropertyValue(context.Context, *GetPropertyValueRequest) (*GetPropertyValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPropertyValue not implemented")
+}
+func (UnimplementedAPIServer) GetPropertyValues(context.Context, *GetPropertyValuesRequest) (*GetPropertyValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPropertyValues not implemented")
+}
+func (UnimplementedAPIServer) UpdatePropertyValue(context.Context, *UpdatePropertyValueRequest) (*UpdatePropertyValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePropertyValue not implemented")
+}
+func (UnimplementedAPIServer) UpsertPropertyValue(context.Context, *UpsertPropertyValueRequest) (*UpsertPropertyValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertPropertyValue not implemented")
+}
+func (UnimplementedAPIServer) DeletePropertyValue(context.Context, *DeletePropertyValueRequest) (*DeletePropertyValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePropertyValue not implemented")
+}
+func (UnimplementedAPIServer) SearchPropertyValues(context.Context, *SearchPropertyValuesRequest) (*SearchPropertyValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPropertyValues not implemented")
+}
+func (UnimplementedAPIServer) RegisterPropertyGroup(context.Context, *RegisterPropertyGroupRequest) (*RegisterPropertyGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPropertyGroup not implemented")
+}
+func (UnimplementedAPIServer) GetPropertyGroup(context.Context, *GetPropertyGroupRequest) (*GetPropertyGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPropertyGroup not implemented")
+}
+func (UnimplementedAPIServer) GetPropertyFieldByName(context.Context, *GetPropertyFieldByNameRequest) (*GetPropertyFieldByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPropertyFieldByName not implemented")
+}
+func (UnimplementedAPIServer) UpdatePropertyFields(context.Context, *UpdatePropertyFieldsRequest) (*UpdatePropertyFieldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePropertyFields not implemented")
+}
+func (UnimplementedAPIServer) UpdatePropertyValues(context.Context, *UpdatePropertyValuesRequest) (*UpdatePropertyValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePropertyValues not implemented")
+}
+func (UnimplementedAPIServer) UpsertPropertyValues(context.Context, *UpsertPropertyValuesRequest) (*UpsertPropertyValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertPropertyValues not implemented")
+}
+func (UnimplementedAPIServer) DeletePropertyValuesForTarget(context.Context, *DeletePropertyValuesForTargetRequest) (*DeletePropertyValuesForTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePropertyValuesForTarget not implemented")
+}
+func (UnimplementedAPIServer) DeletePropertyValuesForField(context.Context, *DeletePropertyValuesForFieldRequest) (*DeletePropertyValuesForFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePropertyValuesForField not implemented")
+}
+func (UnimplementedAPIServer) LogAuditRec(context.Context, *LogAuditRecRequest) (*LogAuditRecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogAuditRec not implemented")
+}
+func (UnimplementedAPIServer) LogAuditRecWithLevel(context.Context, *LogAuditRecWithLevelRequest) (*LogAuditRecWithLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogAuditRecWithLevel not implemented")
+}
+func (UnimplementedAPIServer) mustEmbedUnimplementedAPIServer() {}
+func (UnimplementedAPIServer) testEmbeddedByValue()             {}
+
+// UnsafeAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to APIServer will
+// result in compilation errors.
+type UnsafeAPIServer interface {
+	mustEmbedUnimplementedAPIServer()
+}
+
+func RegisterAPIServer(s grpc.ServiceRegistrar, srv APIServer) {
+	// If the following call pancis, it indicates UnimplementedAPIServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&API_ServiceDesc, srv)
+}
+
+func _API_LoadPluginConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadPluginConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).LoadPluginConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_LoadPluginConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).LoadPluginConfiguration(ctx, req.(*LoadPluginConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RegisterCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RegisterCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_RegisterCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RegisterCommand(ctx, req.(*RegisterCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UnregisterCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UnregisterCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UnregisterCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UnregisterCommand(ctx, req.(*UnregisterCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ExecuteSlashCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteSlashCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ExecuteSlashCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ExecuteSlashCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ExecuteSlashCommand(ctx, req.(*ExecuteSlashCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetConfig(ctx, req.(*GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetUnsanitizedConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnsanitizedConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetUnsanitizedConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetUnsanitizedConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetUnsanitizedConfig(ctx, req.(*GetUnsanitizedConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SaveConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SaveConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SaveConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SaveConfig(ctx, req.(*SaveConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetPluginConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPluginConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPluginConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetPluginConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPluginConfig(ctx, req.(*GetPluginConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SavePluginConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavePluginConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SavePluginConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SavePluginConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SavePluginConfig(ctx, req.(*SavePluginConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetBundlePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBundlePathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetBundlePath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetBundlePath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetBundlePath(ctx, req.(*GetBundlePathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetLicense(ctx, req.(*GetLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_IsEnterpriseReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsEnterpriseReadyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).IsEnterpriseReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_IsEnterpriseReady_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).IsEnterpriseReady(ctx, req.(*IsEnterpriseReadyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetServerVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetServerVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetServerVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetServerVersion(ctx, req.(*GetServerVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetSystemInstallDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSystemInstallDateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetSystemInstallDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetSystemInstallDate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetSystemInstallDate(ctx, req.(*GetSystemInstallDateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetDiagnosticId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiagnosticIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetDiagnosticId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetDiagnosticId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetDiagnosticId(ctx, req.(*GetDiagnosticIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetTelemetryId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTelemetryIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetTelemetryId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetTelemetryId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetTelemetryId(ctx, req.(*GetTelemetryIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetUsers(ctx, req.(*GetUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetUsersByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetUsersByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetUsersByIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetUsersByIds(ctx, req.(*GetUsersByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetUserByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetUserByEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetUserByEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetUserByEmail(ctx, req.(*GetUserByEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetUserByUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByUsernameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetUserByUsername(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetUserByUsername_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetUserByUsername(ctx, req.(*GetUserByUsernameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetUsersByUsernames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersByUsernamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetUsersByUsernames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetUsersByUsernames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetUsersByUsernames(ctx, req.(*GetUsersByUsernamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetUsersInTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersInTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetUsersInTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetUsersInTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetUsersInTeam(ctx, req.(*GetUsersInTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetPreferenceForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreferenceForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPreferenceForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetPreferenceForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPreferenceForUser(ctx, req.(*GetPreferenceForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetPreferencesForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreferencesForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPreferencesForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetPreferencesForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPreferencesForUser(ctx, req.(*GetPreferencesForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdatePreferencesForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePreferencesForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdatePreferencesForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdatePreferencesForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdatePreferencesForUser(ctx, req.(*UpdatePreferencesForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeletePreferencesForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePreferencesForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeletePreferencesForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeletePreferencesForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeletePreferencesForUser(ctx, req.(*DeletePreferencesForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetSession(ctx, req.(*GetSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateSession(ctx, req.(*CreateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ExtendSessionExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendSessionExpiryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ExtendSessionExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ExtendSessionExpiry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ExtendSessionExpiry(ctx, req.(*ExtendSessionExpiryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateUserAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateUserAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateUserAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateUserAccessToken(ctx, req.(*CreateUserAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RevokeUserAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RevokeUserAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_RevokeUserAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RevokeUserAccessToken(ctx, req.(*RevokeUserAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetTeamIcon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamIconRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetTeamIcon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetTeamIcon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetTeamIcon(ctx, req.(*GetTeamIconRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SetTeamIcon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTeamIconRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetTeamIcon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SetTeamIcon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetTeamIcon(ctx, req.(*SetTeamIconRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RemoveTeamIcon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTeamIconRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RemoveTeamIcon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_RemoveTeamIcon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RemoveTeamIcon(ctx, req.(*RemoveTeamIconRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetUserStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetUserStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetUserStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetUserStatus(ctx, req.(*GetUserStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetUserStatusesByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserStatusesByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetUserStatusesByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetUserStatusesByIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetUserStatusesByIds(ctx, req.(*GetUserStatusesByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateUserStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateUserStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateUserStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateUserStatus(ctx, req.(*UpdateUserStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SetUserStatusTimedDND_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserStatusTimedDNDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetUserStatusTimedDND(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SetUserStatusTimedDND_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetUserStatusTimedDND(ctx, req.(*SetUserStatusTimedDNDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateUserActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateUserActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateUserActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateUserActive(ctx, req.(*UpdateUserActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateUserCustomStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserCustomStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateUserCustomStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateUserCustomStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateUserCustomStatus(ctx, req.(*UpdateUserCustomStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RemoveUserCustomStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveUserCustomStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RemoveUserCustomStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_RemoveUserCustomStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RemoveUserCustomStatus(ctx, req.(*RemoveUserCustomStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetUsersInChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersInChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetUsersInChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetUsersInChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetUsersInChannel(ctx, req.(*GetUsersInChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetLDAPUserAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLDAPUserAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetLDAPUserAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetLDAPUserAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetLDAPUserAttributes(ctx, req.(*GetLDAPUserAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateTeam(ctx, req.(*CreateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeleteTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteTeam(ctx, req.(*DeleteTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetTeams(ctx, req.(*GetTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetTeam(ctx, req.(*GetTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetTeamByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetTeamByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetTeamByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetTeamByName(ctx, req.(*GetTeamByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetTeamsUnreadForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamsUnreadForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetTeamsUnreadForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetTeamsUnreadForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetTeamsUnreadForUser(ctx, req.(*GetTeamsUnreadForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateTeam(ctx, req.(*UpdateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SearchTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SearchTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SearchTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SearchTeams(ctx, req.(*SearchTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetTeamsForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamsForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetTeamsForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetTeamsForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetTeamsForUser(ctx, req.(*GetTeamsForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateTeamMember(ctx, req.(*CreateTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateTeamMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateTeamMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateTeamMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateTeamMembers(ctx, req.(*CreateTeamMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateTeamMembersGracefully_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamMembersGracefullyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateTeamMembersGracefully(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateTeamMembersGracefully_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateTeamMembersGracefully(ctx, req.(*CreateTeamMembersGracefullyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeleteTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteTeamMember(ctx, req.(*DeleteTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetTeamMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetTeamMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetTeamMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetTeamMembers(ctx, req.(*GetTeamMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetTeamMember(ctx, req.(*GetTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetTeamMembersForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamMembersForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetTeamMembersForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetTeamMembersForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetTeamMembersForUser(ctx, req.(*GetTeamMembersForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateTeamMemberRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTeamMemberRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateTeamMemberRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateTeamMemberRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateTeamMemberRoles(ctx, req.(*UpdateTeamMemberRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateChannel(ctx, req.(*CreateChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeleteChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteChannel(ctx, req.(*DeleteChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetPublicChannelsForTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicChannelsForTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPublicChannelsForTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetPublicChannelsForTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPublicChannelsForTeam(ctx, req.(*GetPublicChannelsForTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetChannel(ctx, req.(*GetChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetChannelByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetChannelByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetChannelByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetChannelByName(ctx, req.(*GetChannelByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetChannelByNameForTeamName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelByNameForTeamNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetChannelByNameForTeamName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetChannelByNameForTeamName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetChannelByNameForTeamName(ctx, req.(*GetChannelByNameForTeamNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetChannelsForTeamForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelsForTeamForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetChannelsForTeamForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetChannelsForTeamForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetChannelsForTeamForUser(ctx, req.(*GetChannelsForTeamForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetChannelStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetChannelStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetChannelStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetChannelStats(ctx, req.(*GetChannelStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetDirectChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDirectChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetDirectChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetDirectChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetDirectChannel(ctx, req.(*GetDirectChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetGroupChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetGroupChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetGroupChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetGroupChannel(ctx, req.(*GetGroupChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateChannel(ctx, req.(*UpdateChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SearchChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SearchChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SearchChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SearchChannels(ctx, req.(*SearchChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateChannelSidebarCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChannelSidebarCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateChannelSidebarCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateChannelSidebarCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateChannelSidebarCategory(ctx, req.(*CreateChannelSidebarCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetChannelSidebarCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelSidebarCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetChannelSidebarCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetChannelSidebarCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetChannelSidebarCategories(ctx, req.(*GetChannelSidebarCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateChannelSidebarCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChannelSidebarCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateChannelSidebarCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateChannelSidebarCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateChannelSidebarCategories(ctx, req.(*UpdateChannelSidebarCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SearchUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SearchUsers(ctx, req.(*SearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SearchPostsInTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPostsInTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SearchPostsInTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SearchPostsInTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SearchPostsInTeam(ctx, req.(*SearchPostsInTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SearchPostsInTeamForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPostsInTeamForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SearchPostsInTeamForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SearchPostsInTeamForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SearchPostsInTeamForUser(ctx, req.(*SearchPostsInTeamForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_AddChannelMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddChannelMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).AddChannelMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_AddChannelMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).AddChannelMember(ctx, req.(*AddChannelMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_AddUserToChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUserToChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).AddUserToChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_AddUserToChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).AddUserToChannel(ctx, req.(*AddUserToChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetChannelMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetChannelMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetChannelMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetChannelMember(ctx, req.(*GetChannelMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetChannelMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetChannelMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetChannelMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetChannelMembers(ctx, req.(*GetChannelMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetChannelMembersByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelMembersByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetChannelMembersByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetChannelMembersByIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetChannelMembersByIds(ctx, req.(*GetChannelMembersByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetChannelMembersForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelMembersForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetChannelMembersForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetChannelMembersForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetChannelMembersForUser(ctx, req.(*GetChannelMembersForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateChannelMemberRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChannelMemberRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateChannelMemberRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateChannelMemberRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateChannelMemberRoles(ctx, req.(*UpdateChannelMemberRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateChannelMemberNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChannelMemberNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateChannelMemberNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateChannelMemberNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateChannelMemberNotifications(ctx, req.(*UpdateChannelMemberNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_PatchChannelMembersNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchChannelMembersNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).PatchChannelMembersNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_PatchChannelMembersNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).PatchChannelMembersNotifications(ctx, req.(*PatchChannelMembersNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetGroup(ctx, req.(*GetGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetGroupByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetGroupByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetGroupByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetGroupByName(ctx, req.(*GetGroupByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetGroupMemberUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupMemberUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetGroupMemberUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetGroupMemberUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetGroupMemberUsers(ctx, req.(*GetGroupMemberUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetGroupsBySource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupsBySourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetGroupsBySource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetGroupsBySource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetGroupsBySource(ctx, req.(*GetGroupsBySourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetGroupsForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupsForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetGroupsForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetGroupsForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetGroupsForUser(ctx, req.(*GetGroupsForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteChannelMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChannelMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteChannelMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeleteChannelMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteChannelMember(ctx, req.(*DeleteChannelMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreatePost(ctx, req.(*CreatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_AddReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).AddReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_AddReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).AddReaction(ctx, req.(*AddReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RemoveReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RemoveReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_RemoveReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RemoveReaction(ctx, req.(*RemoveReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetReactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetReactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetReactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetReactions(ctx, req.(*GetReactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SendEphemeralPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendEphemeralPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SendEphemeralPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SendEphemeralPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SendEphemeralPost(ctx, req.(*SendEphemeralPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateEphemeralPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEphemeralPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateEphemeralPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateEphemeralPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateEphemeralPost(ctx, req.(*UpdateEphemeralPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteEphemeralPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEphemeralPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteEphemeralPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeleteEphemeralPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteEphemeralPost(ctx, req.(*DeleteEphemeralPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeletePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeletePost(ctx, req.(*DeletePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetPostThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPostThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetPostThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPostThread(ctx, req.(*GetPostThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPost(ctx, req.(*GetPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetPostsSince_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsSinceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPostsSince(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetPostsSince_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPostsSince(ctx, req.(*GetPostsSinceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetPostsAfter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsAfterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPostsAfter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetPostsAfter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPostsAfter(ctx, req.(*GetPostsAfterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetPostsBefore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsBeforeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPostsBefore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetPostsBefore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPostsBefore(ctx, req.(*GetPostsBeforeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetPostsForChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsForChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPostsForChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetPostsForChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPostsForChannel(ctx, req.(*GetPostsForChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetTeamStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetTeamStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetTeamStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetTeamStats(ctx, req.(*GetTeamStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdatePost(ctx, req.(*UpdatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetProfileImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetProfileImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetProfileImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetProfileImage(ctx, req.(*GetProfileImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SetProfileImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProfileImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetProfileImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SetProfileImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetProfileImage(ctx, req.(*SetProfileImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetEmojiList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmojiListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetEmojiList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetEmojiList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetEmojiList(ctx, req.(*GetEmojiListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetEmojiByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmojiByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetEmojiByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetEmojiByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetEmojiByName(ctx, req.(*GetEmojiByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetEmoji_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmojiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetEmoji(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetEmoji_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetEmoji(ctx, req.(*GetEmojiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CopyFileInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyFileInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CopyFileInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CopyFileInfos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CopyFileInfos(ctx, req.(*CopyFileInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetFileInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetFileInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetFileInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetFileInfo(ctx, req.(*GetFileInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SetFileSearchableContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFileSearchableContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetFileSearchableContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SetFileSearchableContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetFileSearchableContent(ctx, req.(*SetFileSearchableContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetFileInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetFileInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetFileInfos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetFileInfos(ctx, req.(*GetFileInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetFile(ctx, req.(*GetFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetFileLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetFileLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetFileLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetFileLink(ctx, req.(*GetFileLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ReadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ReadFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ReadFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ReadFile(ctx, req.(*ReadFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetEmojiImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmojiImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetEmojiImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetEmojiImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetEmojiImage(ctx, req.(*GetEmojiImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UploadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UploadFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UploadFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UploadFile(ctx, req.(*UploadFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_OpenInteractiveDialog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenInteractiveDialogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).OpenInteractiveDialog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_OpenInteractiveDialog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).OpenInteractiveDialog(ctx, req.(*OpenInteractiveDialogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetPlugins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPluginsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPlugins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetPlugins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPlugins(ctx, req.(*GetPluginsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_EnablePlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnablePluginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).EnablePlugin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_EnablePlugin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).EnablePlugin(ctx, req.(*EnablePluginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DisablePlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisablePluginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DisablePlugin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DisablePlugin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DisablePlugin(ctx, req.(*DisablePluginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RemovePlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePluginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RemovePlugin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_RemovePlugin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RemovePlugin(ctx, req.(*RemovePluginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetPluginStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPluginStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPluginStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetPluginStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPluginStatus(ctx, req.(*GetPluginStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_InstallPlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstallPluginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InstallPlugin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_InstallPlugin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InstallPlugin(ctx, req.(*InstallPluginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_KVSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).KVSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_KVSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).KVSet(ctx, req.(*KVSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_KVCompareAndSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVCompareAndSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).KVCompareAndSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_KVCompareAndSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).KVCompareAndSet(ctx, req.(*KVCompareAndSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_KVCompareAndDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVCompareAndDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).KVCompareAndDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_KVCompareAndDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).KVCompareAndDelete(ctx, req.(*KVCompareAndDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_KVSetWithOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVSetWithOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).KVSetWithOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_KVSetWithOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).KVSetWithOptions(ctx, req.(*KVSetWithOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_KVSetWithExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVSetWithExpiryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).KVSetWithExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_KVSetWithExpiry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).KVSetWithExpiry(ctx, req.(*KVSetWithExpiryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_KVGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).KVGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_KVGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).KVGet(ctx, req.(*KVGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_KVDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).KVDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_KVDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).KVDelete(ctx, req.(*KVDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_KVDeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVDeleteAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).KVDeleteAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_KVDeleteAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).KVDeleteAll(ctx, req.(*KVDeleteAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_KVList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).KVList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_KVList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).KVList(ctx, req.(*KVListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_PublishWebSocketEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishWebSocketEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).PublishWebSocketEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_PublishWebSocketEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).PublishWebSocketEvent(ctx, req.(*PublishWebSocketEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_HasPermissionTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HasPermissionToRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).HasPermissionTo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_HasPermissionTo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).HasPermissionTo(ctx, req.(*HasPermissionToRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_HasPermissionToTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HasPermissionToTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).HasPermissionToTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_HasPermissionToTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).HasPermissionToTeam(ctx, req.(*HasPermissionToTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_HasPermissionToChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HasPermissionToChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).HasPermissionToChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_HasPermissionToChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).HasPermissionToChannel(ctx, req.(*HasPermissionToChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RolesGrantPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolesGrantPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RolesGrantPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_RolesGrantPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RolesGrantPermission(ctx, req.(*RolesGrantPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_LogDebug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogDebugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).LogDebug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_LogDebug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).LogDebug(ctx, req.(*LogDebugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_LogInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).LogInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_LogInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).LogInfo(ctx, req.(*LogInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_LogError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogErrorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).LogError(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_LogError_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).LogError(ctx, req.(*LogErrorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_LogWarn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogWarnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).LogWarn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_LogWarn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).LogWarn(ctx, req.(*LogWarnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SendMail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SendMail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SendMail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SendMail(ctx, req.(*SendMailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateBot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateBot(ctx, req.(*CreateBotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_PatchBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchBotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).PatchBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_PatchBot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).PatchBot(ctx, req.(*PatchBotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetBot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetBot(ctx, req.(*GetBotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetBots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetBots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetBots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetBots(ctx, req.(*GetBotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateBotActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBotActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateBotActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateBotActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateBotActive(ctx, req.(*UpdateBotActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_PermanentDeleteBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermanentDeleteBotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).PermanentDeleteBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_PermanentDeleteBot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).PermanentDeleteBot(ctx, req.(*PermanentDeleteBotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_PublishUserTyping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishUserTypingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).PublishUserTyping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_PublishUserTyping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).PublishUserTyping(ctx, req.(*PublishUserTypingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateCommand(ctx, req.(*CreateCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ListCommands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListCommands(ctx, req.(*ListCommandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListCustomCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCustomCommandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListCustomCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ListCustomCommands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListCustomCommands(ctx, req.(*ListCustomCommandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListPluginCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPluginCommandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListPluginCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ListPluginCommands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListPluginCommands(ctx, req.(*ListPluginCommandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListBuiltInCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBuiltInCommandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListBuiltInCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ListBuiltInCommands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListBuiltInCommands(ctx, req.(*ListBuiltInCommandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetCommand(ctx, req.(*GetCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateCommand(ctx, req.(*UpdateCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeleteCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteCommand(ctx, req.(*DeleteCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateOAuthApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOAuthAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateOAuthApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateOAuthApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateOAuthApp(ctx, req.(*CreateOAuthAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetOAuthApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOAuthAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetOAuthApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetOAuthApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetOAuthApp(ctx, req.(*GetOAuthAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateOAuthApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOAuthAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateOAuthApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateOAuthApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateOAuthApp(ctx, req.(*UpdateOAuthAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteOAuthApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOAuthAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteOAuthApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeleteOAuthApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteOAuthApp(ctx, req.(*DeleteOAuthAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_PublishPluginClusterEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishPluginClusterEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).PublishPluginClusterEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_PublishPluginClusterEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).PublishPluginClusterEvent(ctx, req.(*PublishPluginClusterEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RequestTrialLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestTrialLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RequestTrialLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_RequestTrialLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RequestTrialLicense(ctx, req.(*RequestTrialLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetCloudLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCloudLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetCloudLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetCloudLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetCloudLimits(ctx, req.(*GetCloudLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_EnsureBotUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnsureBotUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).EnsureBotUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_EnsureBotUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).EnsureBotUser(ctx, req.(*EnsureBotUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RegisterCollectionAndTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterCollectionAndTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RegisterCollectionAndTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_RegisterCollectionAndTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RegisterCollectionAndTopic(ctx, req.(*RegisterCollectionAndTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateUploadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUploadSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateUploadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateUploadSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateUploadSession(ctx, req.(*CreateUploadSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UploadData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UploadData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UploadData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UploadData(ctx, req.(*UploadDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetUploadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUploadSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetUploadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetUploadSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetUploadSession(ctx, req.(*GetUploadSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SendPushNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendPushNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SendPushNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SendPushNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SendPushNotification(ctx, req.(*SendPushNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateUserAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateUserAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateUserAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateUserAuth(ctx, req.(*UpdateUserAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RegisterPluginForSharedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterPluginForSharedChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RegisterPluginForSharedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_RegisterPluginForSharedChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RegisterPluginForSharedChannels(ctx, req.(*RegisterPluginForSharedChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UnregisterPluginForSharedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterPluginForSharedChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UnregisterPluginForSharedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UnregisterPluginForSharedChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UnregisterPluginForSharedChannels(ctx, req.(*UnregisterPluginForSharedChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ShareChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ShareChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ShareChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ShareChannel(ctx, req.(*ShareChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateSharedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSharedChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateSharedChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateSharedChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateSharedChannel(ctx, req.(*UpdateSharedChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UnshareChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UnshareChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UnshareChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UnshareChannel(ctx, req.(*UnshareChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateSharedChannelCursor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSharedChannelCursorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateSharedChannelCursor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateSharedChannelCursor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateSharedChannelCursor(ctx, req.(*UpdateSharedChannelCursorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SyncSharedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncSharedChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SyncSharedChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SyncSharedChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SyncSharedChannel(ctx, req.(*SyncSharedChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_InviteRemoteToChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteRemoteToChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InviteRemoteToChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_InviteRemoteToChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InviteRemoteToChannel(ctx, req.(*InviteRemoteToChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UninviteRemoteFromChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UninviteRemoteFromChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UninviteRemoteFromChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UninviteRemoteFromChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UninviteRemoteFromChannel(ctx, req.(*UninviteRemoteFromChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpsertGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertGroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpsertGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpsertGroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpsertGroupMember(ctx, req.(*UpsertGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpsertGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpsertGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpsertGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpsertGroupMembers(ctx, req.(*UpsertGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetGroupByRemoteID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupByRemoteIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetGroupByRemoteID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetGroupByRemoteID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetGroupByRemoteID(ctx, req.(*GetGroupByRemoteIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateGroup(ctx, req.(*UpdateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeleteGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteGroup(ctx, req.(*DeleteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RestoreGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RestoreGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_RestoreGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RestoreGroup(ctx, req.(*RestoreGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeleteGroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteGroupMember(ctx, req.(*DeleteGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetGroupSyncable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupSyncableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetGroupSyncable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetGroupSyncable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetGroupSyncable(ctx, req.(*GetGroupSyncableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetGroupSyncables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupSyncablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetGroupSyncables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetGroupSyncables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetGroupSyncables(ctx, req.(*GetGroupSyncablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpsertGroupSyncable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertGroupSyncableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpsertGroupSyncable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpsertGroupSyncable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpsertGroupSyncable(ctx, req.(*UpsertGroupSyncableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateGroupSyncable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupSyncableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateGroupSyncable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateGroupSyncable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateGroupSyncable(ctx, req.(*UpdateGroupSyncableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteGroupSyncable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupSyncableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteGroupSyncable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeleteGroupSyncable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteGroupSyncable(ctx, req.(*DeleteGroupSyncableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateUserRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateUserRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateUserRoles(ctx, req.(*UpdateUserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetPluginID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPluginIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPluginID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetPluginID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPluginID(ctx, req.(*GetPluginIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetGroups(ctx, req.(*GetGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateDefaultSyncableMemberships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDefaultSyncableMembershipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateDefaultSyncableMemberships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateDefaultSyncableMemberships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateDefaultSyncableMemberships(ctx, req.(*CreateDefaultSyncableMembershipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteGroupConstrainedMemberships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupConstrainedMembershipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteGroupConstrainedMemberships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeleteGroupConstrainedMemberships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteGroupConstrainedMemberships(ctx, req.(*DeleteGroupConstrainedMembershipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreatePropertyField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePropertyFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreatePropertyField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreatePropertyField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreatePropertyField(ctx, req.(*CreatePropertyFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetPropertyField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPropertyFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPropertyField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetPropertyField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPropertyField(ctx, req.(*GetPropertyFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetPropertyFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPropertyFieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPropertyFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetPropertyFields_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPropertyFields(ctx, req.(*GetPropertyFieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdatePropertyField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePropertyFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdatePropertyField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdatePropertyField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdatePropertyField(ctx, req.(*UpdatePropertyFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeletePropertyField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePropertyFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeletePropertyField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeletePropertyField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeletePropertyField(ctx, req.(*DeletePropertyFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SearchPropertyFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPropertyFieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SearchPropertyFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SearchPropertyFields_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SearchPropertyFields(ctx, req.(*SearchPropertyFieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CountPropertyFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountPropertyFieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CountPropertyFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CountPropertyFields_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CountPropertyFields(ctx, req.(*CountPropertyFieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CountPropertyFieldsForTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountPropertyFieldsForTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CountPropertyFieldsForTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CountPropertyFieldsForTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CountPropertyFieldsForTarget(ctx, req.(*CountPropertyFieldsForTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreatePropertyValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePropertyValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreatePropertyValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreatePropertyValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreatePropertyValue(ctx, req.(*CreatePropertyValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetPropertyValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPropertyValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPropertyValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetPropertyValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPropertyValue(ctx, req.(*GetPropertyValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetPropertyValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPropertyValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPropertyValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetPropertyValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPropertyValues(ctx, req.(*GetPropertyValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdatePropertyValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePropertyValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdatePropertyValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdatePropertyValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdatePropertyValue(ctx, req.(*UpdatePropertyValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpsertPropertyValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertPropertyValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpsertPropertyValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpsertPropertyValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpsertPropertyValue(ctx, req.(*UpsertPropertyValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeletePropertyValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePropertyValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeletePropertyValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeletePropertyValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeletePropertyValue(ctx, req.(*DeletePropertyValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SearchPropertyValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPropertyValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SearchPropertyValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SearchPropertyValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SearchPropertyValues(ctx, req.(*SearchPropertyValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RegisterPropertyGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterPropertyGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RegisterPropertyGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_RegisterPropertyGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RegisterPropertyGroup(ctx, req.(*RegisterPropertyGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetPropertyGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPropertyGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPropertyGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetPropertyGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPropertyGroup(ctx, req.(*GetPropertyGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetPropertyFieldByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPropertyFieldByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPropertyFieldByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetPropertyFieldByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPropertyFieldByName(ctx, req.(*GetPropertyFieldByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdatePropertyFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePropertyFieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdatePropertyFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdatePropertyFields_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdatePropertyFields(ctx, req.(*UpdatePropertyFieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdatePropertyValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePropertyValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdatePropertyValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdatePropertyValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdatePropertyValues(ctx, req.(*UpdatePropertyValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpsertPropertyValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertPropertyValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpsertPropertyValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpsertPropertyValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpsertPropertyValues(ctx, req.(*UpsertPropertyValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeletePropertyValuesForTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePropertyValuesForTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeletePropertyValuesForTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeletePropertyValuesForTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeletePropertyValuesForTarget(ctx, req.(*DeletePropertyValuesForTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeletePropertyValuesForField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePropertyValuesForFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeletePropertyValuesForField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeletePropertyValuesForField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeletePropertyValuesForField(ctx, req.(*DeletePropertyValuesForFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_LogAuditRec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogAuditRecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).LogAuditRec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_LogAuditRec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).LogAuditRec(ctx, req.(*LogAuditRecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_LogAuditRecWithLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogAuditRecWithLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).LogAuditRecWithLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_LogAuditRecWithLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).LogAuditRecWithLevel(ctx, req.(*LogAuditRecWithLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// API_ServiceDesc is the grpc.ServiceDesc for API service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var API_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mattermost.plugin.v1.API",
+	HandlerType: (*APIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LoadPluginConfiguration",
+			Handler:    _API_LoadPluginConfiguration_Handler,
+		},
+		{
+			MethodName: "RegisterCommand",
+			Handler:    _API_RegisterCommand_Handler,
+		},
+		{
+			MethodName: "UnregisterCommand",
+			Handler:    _API_UnregisterCommand_Handler,
+		},
+		{
+			MethodName: "ExecuteSlashCommand",
+			Handler:    _API_ExecuteSlashCommand_Handler,
+		},
+		{
+			MethodName: "GetConfig",
+			Handler:    _API_GetConfig_Handler,
+		},
+		{
+			MethodName: "GetUnsanitizedConfig",
+			Handler:    _API_GetUnsanitizedConfig_Handler,
+		},
+		{
+			MethodName: "SaveConfig",
+			Handler:    _API_SaveConfig_Handler,
+		},
+		{
+			MethodName: "GetPluginConfig",
+			Handler:    _API_GetPluginConfig_Handler,
+		},
+		{
+			MethodName: "SavePluginConfig",
+			Handler:    _API_SavePluginConfig_Handler,
+		},
+		{
+			MethodName: "GetBundlePath",
+			Handler:    _API_GetBundlePath_Handler,
+		},
+		{
+			MethodName: "GetLicense",
+			Handler:    _API_GetLicense_Handler,
+		},
+		{
+			MethodName: "IsEnterpriseReady",
+			Handler:    _API_IsEnterpriseReady_Handler,
+		},
+		{
+			MethodName: "GetServerVersion",
+			Handler:    _API_GetServerVersion_Handler,
+		},
+		{
+			MethodName: "GetSystemInstallDate",
+			Handler:    _API_GetSystemInstallDate_Handler,
+		},
+		{
+			MethodName: "GetDiagnosticId",
+			Handler:    _API_GetDiagnosticId_Handler,
+		},
+		{
+			MethodName: "GetTelemetryId",
+			Handler:    _API_GetTelemetryId_Handler,
+		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _API_CreateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _API_DeleteUser_Handler,
+		},
+		{
+			MethodName: "GetUsers",
+			Handler:    _API_GetUsers_Handler,
+		},
+		{
+			MethodName: "GetUsersByIds",
+			Handler:    _API_GetUsersByIds_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _API_GetUser_Handler,
+		},
+		{
+			MethodName: "GetUserByEmail",
+			Handler:    _API_GetUserByEmail_Handler,
+		},
+		{
+			MethodName: "GetUserByUsername",
+			Handler:    _API_GetUserByUsername_Handler,
+		},
+		{
+			MethodName: "GetUsersByUsernames",
+			Handler:    _API_GetUsersByUsernames_Handler,
+		},
+		{
+			MethodName: "GetUsersInTeam",
+			Handler:    _API_GetUsersInTeam_Handler,
+		},
+		{
+			MethodName: "GetPreferenceForUser",
+			Handler:    _API_GetPreferenceForUser_Handler,
+		},
+		{
+			MethodName: "GetPreferencesForUser",
+			Handler:    _API_GetPreferencesForUser_Handler,
+		},
+		{
+			MethodName: "UpdatePreferencesForUser",
+			Handler:    _API_UpdatePreferencesForUser_Handler,
+		},
+		{
+			MethodName: "DeletePreferencesForUser",
+			Handler:    _API_DeletePreferencesForUser_Handler,
+		},
+		{
+			MethodName: "GetSession",
+			Handler:    _API_GetSession_Handler,
+		},
+		{
+			MethodName: "CreateSession",
+			Handler:    _API_CreateSession_Handler,
+		},
+		{
+			MethodName: "ExtendSessionExpiry",
+			Handler:    _API_ExtendSessionExpiry_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _API_RevokeSession_Handler,
+		},
+		{
+			MethodName: "CreateUserAccessToken",
+			Handler:    _API_CreateUserAccessToken_Handler,
+		},
+		{
+			MethodName: "RevokeUserAccessToken",
+			Handler:    _API_RevokeUserAccessToken_Handler,
+		},
+		{
+			MethodName: "GetTeamIcon",
+			Handler:    _API_GetTeamIcon_Handler,
+		},
+		{
+			MethodName: "SetTeamIcon",
+			Handler:    _API_SetTeamIcon_Handler,
+		},
+		{
+			MethodName: "RemoveTeamIcon",
+			Handler:    _API_RemoveTeamIcon_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _API_UpdateUser_Handler,
+		},
+		{
+			MethodName: "GetUserStatus",
+			Handler:    _API_GetUserStatus_Handler,
+		},
+		{
+			MethodName: "GetUserStatusesByIds",
+			Handler:    _API_GetUserStatusesByIds_Handler,
+		},
+		{
+			MethodName: "UpdateUserStatus",
+			Handler:    _API_UpdateUserStatus_Handler,
+		},
+		{
+			MethodName: "SetUserStatusTimedDND",
+			Handler:    _API_SetUserStatusTimedDND_Handler,
+		},
+		{
+			MethodName: "UpdateUserActive",
+			Handler:    _API_UpdateUserActive_Handler,
+		},
+		{
+			MethodName: "UpdateUserCustomStatus",
+			Handler:    _API_UpdateUserCustomStatus_Handler,
+		},
+		{
+			MethodName: "RemoveUserCustomStatus",
+			Handler:    _API_RemoveUserCustomStatus_Handler,
+		},
+		{
+			MethodName: "GetUsersInChannel",
+			Handler:    _API_GetUsersInChannel_Handler,
+		},
+		{
+			MethodName: "GetLDAPUserAttributes",
+			Handler:    _API_GetLDAPUserAttributes_Handler,
+		},
+		{
+			MethodName: "CreateTeam",
+			Handler:    _API_CreateTeam_Handler,
+		},
+		{
+			MethodName: "DeleteTeam",
+			Handler:    _API_DeleteTeam_Handler,
+		},
+		{
+			MethodName: "GetTeams",
+			Handler:    _API_GetTeams_Handler,
+		},
+		{
+			MethodName: "GetTeam",
+			Handler:    _API_GetTeam_Handler,
+		},
+		{
+			MethodName: "GetTeamByName",
+			Handler:    _API_GetTeamByName_Handler,
+		},
+		{
+			MethodName: "GetTeamsUnreadForUser",
+			Handler:    _API_GetTeamsUnreadForUser_Handler,
+		},
+		{
+			MethodName: "UpdateTeam",
+			Handler:    _API_UpdateTeam_Handler,
+		},
+		{
+			MethodName: "SearchTeams",
+			Handler:    _API_SearchTeams_Handler,
+		},
+		{
+			MethodName: "GetTeamsForUser",
+			Handler:    _API_GetTeamsForUser_Handler,
+		},
+		{
+			MethodName: "CreateTeamMember",
+			Handler:    _API_CreateTeamMember_Handler,
+		},
+		{
+			MethodName: "CreateTeamMembers",
+			Handler:    _API_CreateTeamMembers_Handler,
+		},
+		{
+			MethodName: "CreateTeamMembersGracefully",
+			Handler:    _API_CreateTeamMembersGracefully_Handler,
+		},
+		{
+			MethodName: "DeleteTeamMember",
+			Handler:    _API_DeleteTeamMember_Handler,
+		},
+		{
+			MethodName: "GetTeamMembers",
+			Handler:    _API_GetTeamMembers_Handler,
+		},
+		{
+			MethodName: "GetTeamMember",
+			Handler:    _API_GetTeamMember_Handler,
+		},
+		{
+			MethodName: "GetTeamMembersForUser",
+			Handler:    _API_GetTeamMembersForUser_Handler,
+		},
+		{
+			MethodName: "UpdateTeamMemberRoles",
+			Handler:    _API_UpdateTeamMemberRoles_Handler,
+		},
+		{
+			MethodName: "CreateChannel",
+			Handler:    _API_CreateChannel_Handler,
+		},
+		{
+			MethodName: "DeleteChannel",
+			Handler:    _API_DeleteChannel_Handler,
+		},
+		{
+			MethodName: "GetPublicChannelsForTeam",
+			Handler:    _API_GetPublicChannelsForTeam_Handler,
+		},
+		{
+			MethodName: "GetChannel",
+			Handler:    _API_GetChannel_Handler,
+		},
+		{
+			MethodName: "GetChannelByName",
+			Handler:    _API_GetChannelByName_Handler,
+		},
+		{
+			MethodName: "GetChannelByNameForTeamName",
+			Handler:    _API_GetChannelByNameForTeamName_Handler,
+		},
+		{
+			MethodName: "GetChannelsForTeamForUser",
+			Handler:    _API_GetChannelsForTeamForUser_Handler,
+		},
+		{
+			MethodName: "GetChannelStats",
+			Handler:    _API_GetChannelStats_Handler,
+		},
+		{
+			MethodName: "GetDirectChannel",
+			Handler:    _API_GetDirectChannel_Handler,
+		},
+		{
+			MethodName: "GetGroupChannel",
+			Handler:    _API_GetGroupChannel_Handler,
+		},
+		{
+			MethodName: "UpdateChannel",
+			Handler:    _API_UpdateChannel_Handler,
+		},
+		{
+			MethodName: "SearchChannels",
+			Handler:    _API_SearchChannels_Handler,
+		},
+		{
+			MethodName: "CreateChannelSidebarCategory",
+			Handler:    _API_CreateChannelSidebarCategory_Handler,
+		},
+		{
+			MethodName: "GetChannelSidebarCategories",
+			Handler:    _API_GetChannelSidebarCategories_Handler,
+		},
+		{
+			MethodName: "UpdateChannelSidebarCategories",
+			Handler:    _API_UpdateChannelSidebarCategories_Handler,
+		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _API_SearchUsers_Handler,
+		},
+		{
+			MethodName: "SearchPostsInTeam",
+			Handler:    _API_SearchPostsInTeam_Handler,
+		},
+		{
+			MethodName: "SearchPostsInTeamForUser",
+			Handler:    _API_SearchPostsInTeamForUser_Handler,
+		},
+		{
+			MethodName: "AddChannelMember",
+			Handler:    _API_AddChannelMember_Handler,
+		},
+		{
+			MethodName: "AddUserToChannel",
+			Handler:    _API_AddUserToChannel_Handler,
+		},
+		{
+			MethodName: "GetChannelMember",
+			Handler:    _API_GetChannelMember_Handler,
+		},
+		{
+			MethodName: "GetChannelMembers",
+			Handler:    _API_GetChannelMembers_Handler,
+		},
+		{
+			MethodName: "GetChannelMembersByIds",
+			Handler:    _API_GetChannelMembersByIds_Handler,
+		},
+		{
+			MethodName: "GetChannelMembersForUser",
+			Handler:    _API_GetChannelMembersForUser_Handler,
+		},
+		{
+			MethodName: "UpdateChannelMemberRoles",
+			Handler:    _API_UpdateChannelMemberRoles_Handler,
+		},
+		{
+			MethodName: "UpdateChannelMemberNotifications",
+			Handler:    _API_UpdateChannelMemberNotifications_Handler,
+		},
+		{
+			MethodName: "PatchChannelMembersNotifications",
+			Handler:    _API_PatchChannelMembersNotifications_Handler,
+		},
+		{
+			MethodName: "GetGroup",
+			Handler:    _API_GetGroup_Handler,
+		},
+		{
+			MethodName: "GetGroupByName",
+			Handler:    _API_GetGroupByName_Handler,
+		},
+		{
+			MethodName: "GetGroupMemberUsers",
+			Handler:    _API_GetGroupMemberUsers_Handler,
+		},
+		{
+			MethodName: "GetGroupsBySource",
+			Handler:    _API_GetGroupsBySource_Handler,
+		},
+		{
+			MethodName: "GetGroupsForUser",
+			Handler:    _API_GetGroupsForUser_Handler,
+		},
+		{
+			MethodName: "DeleteChannelMember",
+			Handler:    _API_DeleteChannelMember_Handler,
+		},
+		{
+			MethodName: "CreatePost",
+			Handler:    _API_CreatePost_Handler,
+		},
+		{
+			MethodName: "AddReaction",
+			Handler:    _API_AddReaction_Handler,
+		},
+		{
+			MethodName: "RemoveReaction",
+			Handler:    _API_RemoveReaction_Handler,
+		},
+		{
+			MethodName: "GetReactions",
+			Handler:    _API_GetReactions_Handler,
+		},
+		{
+			MethodName: "SendEphemeralPost",
+			Handler:    _API_SendEphemeralPost_Handler,
+		},
+		{
+			MethodName: "UpdateEphemeralPost",
+			Handler:    _API_UpdateEphemeralPost_Handler,
+		},
+		{
+			MethodName: "DeleteEphemeralPost",
+			Handler:    _API_DeleteEphemeralPost_Handler,
+		},
+		{
+			MethodName: "DeletePost",
+			Handler:    _API_DeletePost_Handler,
+		},
+		{
+			MethodName: "GetPostThread",
+			Handler:    _API_GetPostThread_Handler,
+		},
+		{
+			MethodName: "GetPost",
+			Handler:    _API_GetPost_Handler,
+		},
+		{
+			MethodName: "GetPostsSince",
+			Handler:    _API_GetPostsSince_Handler,
+		},
+		{
+			MethodName: "GetPostsAfter",
+			Handler:    _API_GetPostsAfter_Handler,
+		},
+		{
+			MethodName: "GetPostsBefore",
+			Handler:    _API_GetPostsBefore_Handler,
+		},
+		{
+			MethodName: "GetPostsForChannel",
+			Handler:    _API_GetPostsForChannel_Handler,
+		},
+		{
+			MethodName: "GetTeamStats",
+			Handler:    _API_GetTeamStats_Handler,
+		},
+		{
+			MethodName: "UpdatePost",
+			Handler:    _API_UpdatePost_Handler,
+		},
+		{
+			MethodName: "GetProfileImage",
+			Handler:    _API_GetProfileImage_Handler,
+		},
+		{
+			MethodName: "SetProfileImage",
+			Handler:    _API_SetProfileImage_Handler,
+		},
+		{
+			MethodName: "GetEmojiList",
+			Handler:    _API_GetEmojiList_Handler,
+		},
+		{
+			MethodName: "GetEmojiByName",
+			Handler:    _API_GetEmojiByName_Handler,
+		},
+		{
+			MethodName: "GetEmoji",
+			Handler:    _API_GetEmoji_Handler,
+		},
+		{
+			MethodName: "CopyFileInfos",
+			Handler:    _API_CopyFileInfos_Handler,
+		},
+		{
+			MethodName: "GetFileInfo",
+			Handler:    _API_GetFileInfo_Handler,
+		},
+		{
+			MethodName: "SetFileSearchableContent",
+			Handler:    _API_SetFileSearchableContent_Handler,
+		},
+		{
+			MethodName: "GetFileInfos",
+			Handler:    _API_GetFileInfos_Handler,
+		},
+		{
+			MethodName: "GetFile",
+			Handler:    _API_GetFile_Handler,
+		},
+		{
+			MethodName: "GetFileLink",
+			Handler:    _API_GetFileLink_Handler,
+		},
+		{
+			MethodName: "ReadFile",
+			Handler:    _API_ReadFile_Handler,
+		},
+		{
+			MethodName: "GetEmojiImage",
+			Handler:    _API_GetEmojiImage_Handler,
+		},
+		{
+			MethodName: "UploadFile",
+			Handler:    _API_UploadFile_Handler,
+		},
+		{
+			MethodName: "OpenInteractiveDialog",
+			Handler:    _API_OpenInteractiveDialog_Handler,
+		},
+		{
+			MethodName: "GetPlugins",
+			Handler:    _API_GetPlugins_Handler,
+		},
+		{
+			MethodName: "EnablePlugin",
+			Handler:    _API_EnablePlugin_Handler,
+		},
+		{
+			MethodName: "DisablePlugin",
+			Handler:    _API_DisablePlugin_Handler,
+		},
+		{
+			MethodName: "RemovePlugin",
+			Handler:    _API_RemovePlugin_Handler,
+		},
+		{
+			MethodName: "GetPluginStatus",
+			Handler:    _API_GetPluginStatus_Handler,
+		},
+		{
+			MethodName: "InstallPlugin",
+			Handler:    _API_InstallPlugin_Handler,
+		},
+		{
+			MethodName: "KVSet",
+			Handler:    _API_KVSet_Handler,
+		},
+		{
+			MethodName: "KVCompareAndSet",
+			Handler:    _API_KVCompareAndSet_Handler,
+		},
+		{
+			MethodName: "KVCompareAndDelete",
+			Handler:    _API_KVCompareAndDelete_Handler,
+		},
+		{
+			MethodName: "KVSetWithOptions",
+			Handler:    _API_KVSetWithOptions_Handler,
+		},
+		{
+			MethodName: "KVSetWithExpiry",
+			Handler:    _API_KVSetWithExpiry_Handler,
+		},
+		{
+			MethodName: "KVGet",
+			Handler:    _API_KVGet_Handler,
+		},
+		{
+			MethodName: "KVDelete",
+			Handler:    _API_KVDelete_Handler,
+		},
+		{
+			MethodName: "KVDeleteAll",
+			Handler:    _API_KVDeleteAll_Handler,
+		},
+		{
+			MethodName: "KVList",
+			Handler:    _API_KVList_Handler,
+		},
+		{
+			MethodName: "PublishWebSocketEvent",
+			Handler:    _API_PublishWebSocketEvent_Handler,
+		},
+		{
+			MethodName: "HasPermissionTo",
+			Handler:    _API_HasPermissionTo_Handler,
+		},
+		{
+			MethodName: "HasPermissionToTeam",
+			Handler:    _API_HasPermissionToTeam_Handler,
+		},
+		{
+			MethodName: "HasPermissionToChannel",
+			Handler:    _API_HasPermissionToChannel_Handler,
+		},
+		{
+			MethodName: "RolesGrantPermission",
+			Handler:    _API_RolesGrantPermission_Handler,
+		},
+		{
+			MethodName: "LogDebug",
+			Handler:    _API_LogDebug_Handler,
+		},
+		{
+			MethodName: "LogInfo",
+			Handler:    _API_LogInfo_Handler,
+		},
+		{
+			MethodName: "LogError",
+			Handler:    _API_LogError_Handler,
+		},
+		{
+			MethodName: "LogWarn",
+			Handler:    _API_LogWarn_Handler,
+		},
+		{
+			MethodName: "SendMail",
+			Handler:    _API_SendMail_Handler,
+		},
+		{
+			MethodName: "CreateBot",
+			Handler:    _API_CreateBot_Handler,
+		},
+		{
+			MethodName: "PatchBot",
+			Handler:    _API_PatchBot_Handler,
+		},
+		{
+			MethodName: "GetBot",
+			Handler:    _API_GetBot_Handler,
+		},
+		{
+			MethodName: "GetBots",
+			Handler:    _API_GetBots_Handler,
+		},
+		{
+			MethodName: "UpdateBotActive",
+			Handler:    _API_UpdateBotActive_Handler,
+		},
+		{
+			MethodName: "PermanentDeleteBot",
+			Handler:    _API_PermanentDeleteBot_Handler,
+		},
+		{
+			MethodName: "PublishUserTyping",
+			Handler:    _API_PublishUserTyping_Handler,
+		},
+		{
+			MethodName: "CreateCommand",
+			Handler:    _API_CreateCommand_Handler,
+		},
+		{
+			MethodName: "ListCommands",
+			Handler:    _API_ListCommands_Handler,
+		},
+		{
+			MethodName: "ListCustomCommands",
+			Handler:    _API_ListCustomCommands_Handler,
+		},
+		{
+			MethodName: "ListPluginCommands",
+			Handler:    _API_ListPluginCommands_Handler,
+		},
+		{
+			MethodName: "ListBuiltInCommands",
+			Handler:    _API_ListBuiltInCommands_Handler,
+		},
+		{
+			MethodName: "GetCommand",
+			Handler:    _API_GetCommand_Handler,
+		},
+		{
+			MethodName: "UpdateCommand",
+			Handler:    _API_UpdateCommand_Handler,
+		},
+		{
+			MethodName: "DeleteCommand",
+			Handler:    _API_DeleteCommand_Handler,
+		},
+		{
+			MethodName: "CreateOAuthApp",
+			Handler:    _API_CreateOAuthApp_Handler,
+		},
+		{
+			MethodName: "GetOAuthApp",
+			Handler:    _API_GetOAuthApp_Handler,
+		},
+		{
+			MethodName: "UpdateOAuthApp",
+			Handler:    _API_UpdateOAuthApp_Handler,
+		},
+		{
+			MethodName: "DeleteOAuthApp",
+			Handler:    _API_DeleteOAuthApp_Handler,
+		},
+		{
+			MethodName: "PublishPluginClusterEvent",
+			Handler:    _API_PublishPluginClusterEvent_Handler,
+		},
+		{
+			MethodName: "RequestTrialLicense",
+			Handler:    _API_RequestTrialLicense_Handler,
+		},
+		{
+			MethodName: "GetCloudLimits",
+			Handler:    _API_GetCloudLimits_Handler,
+		},
+		{
+			MethodName: "EnsureBotUser",
+			Handler:    _API_EnsureBotUser_Handler,
+		},
+		{
+			MethodName: "RegisterCollectionAndTopic",
+			Handler:    _API_RegisterCollectionAndTopic_Handler,
+		},
+		{
+			MethodName: "CreateUploadSession",
+			Handler:    _API_CreateUploadSession_Handler,
+		},
+		{
+			MethodName: "UploadData",
+			Handler:    _API_UploadData_Handler,
+		},
+		{
+			MethodName: "GetUploadSession",
+			Handler:    _API_GetUploadSession_Handler,
+		},
+		{
+			MethodName: "SendPushNotification",
+			Handler:    _API_SendPushNotification_Handler,
+		},
+		{
+			MethodName: "UpdateUserAuth",
+			Handler:    _API_UpdateUserAuth_Handler,
+		},
+		{
+			MethodName: "RegisterPluginForSharedChannels",
+			Handler:    _API_RegisterPluginForSharedChannels_Handler,
+		},
+		{
+			MethodName: "UnregisterPluginForSharedChannels",
+			Handler:    _API_UnregisterPluginForSharedChannels_Handler,
+		},
+		{
+			MethodName: "ShareChannel",
+			Handler:    _API_ShareChannel_Handler,
+		},
+		{
+			MethodName: "UpdateSharedChannel",
+			Handler:    _API_UpdateSharedChannel_Handler,
+		},
+		{
+			MethodName: "UnshareChannel",
+			Handler:    _API_UnshareChannel_Handler,
+		},
+		{
+			MethodName: "UpdateSharedChannelCursor",
+			Handler:    _API_UpdateSharedChannelCursor_Handler,
+		},
+		{
+			MethodName: "SyncSharedChannel",
+			Handler:    _API_SyncSharedChannel_Handler,
+		},
+		{
+			MethodName: "InviteRemoteToChannel",
+			Handler:    _API_InviteRemoteToChannel_Handler,
+		},
+		{
+			MethodName: "UninviteRemoteFromChannel",
+			Handler:    _API_UninviteRemoteFromChannel_Handler,
+		},
+		{
+			MethodName: "UpsertGroupMember",
+			Handler:    _API_UpsertGroupMember_Handler,
+		},
+		{
+			MethodName: "UpsertGroupMembers",
+			Handler:    _API_UpsertGroupMembers_Handler,
+		},
+		{
+			MethodName: "GetGroupByRemoteID",
+			Handler:    _API_GetGroupByRemoteID_Handler,
+		},
+		{
+			MethodName: "CreateGroup",
+			Handler:    _API_CreateGroup_Handler,
+		},
+		{
+			MethodName: "UpdateGroup",
+			Handler:    _API_UpdateGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _API_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "RestoreGroup",
+			Handler:    _API_RestoreGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroupMember",
+			Handler:    _API_DeleteGroupMember_Handler,
+		},
+		{
+			MethodName: "GetGroupSyncable",
+			Handler:    _API_GetGroupSyncable_Handler,
+		},
+		{
+			MethodName: "GetGroupSyncables",
+			Handler:    _API_GetGroupSyncables_Handler,
+		},
+		{
+			MethodName: "UpsertGroupSyncable",
+			Handler:    _API_UpsertGroupSyncable_Handler,
+		},
+		{
+			MethodName: "UpdateGroupSyncable",
+			Handler:    _API_UpdateGroupSyncable_Handler,
+		},
+		{
+			MethodName: "DeleteGroupSyncable",
+			Handler:    _API_DeleteGroupSyncable_Handler,
+		},
+		{
+			MethodName: "UpdateUserRoles",
+			Handler:    _API_UpdateUserRoles_Handler,
+		},
+		{
+			MethodName: "GetPluginID",
+			Handler:    _API_GetPluginID_Handler,
+		},
+		{
+			MethodName: "GetGroups",
+			Handler:    _API_GetGroups_Handler,
+		},
+		{
+			MethodName: "CreateDefaultSyncableMemberships",
+			Handler:    _API_CreateDefaultSyncableMemberships_Handler,
+		},
+		{
+			MethodName: "DeleteGroupConstrainedMemberships",
+			Handler:    _API_DeleteGroupConstrainedMemberships_Handler,
+		},
+		{
+			MethodName: "CreatePropertyField",
+			Handler:    _API_CreatePropertyField_Handler,
+		},
+		{
+			MethodName: "GetPropertyField",
+			Handler:    _API_GetPropertyField_Handler,
+		},
+		{
+			MethodName: "GetPropertyFields",
+			Handler:    _API_GetPropertyFields_Handler,
+		},
+		{
+			MethodName: "UpdatePropertyField",
+			Handler:    _API_UpdatePropertyField_Handler,
+		},
+		{
+			MethodName: "DeletePropertyField",
+			Handler:    _API_DeletePropertyField_Handler,
+		},
+		{
+			MethodName: "SearchPropertyFields",
+			Handler:    _API_SearchPropertyFields_Handler,
+		},
+		{
+			MethodName: "CountPropertyFields",
+			Handler:    _API_CountPropertyFields_Handler,
+		},
+		{
+			MethodName: "CountPropertyFieldsForTarget",
+			Handler:    _API_CountPropertyFieldsForTarget_Handler,
+		},
+		{
+			MethodName: "CreatePropertyValue",
+			Handler:    _API_CreatePropertyValue_Handler,
+		},
+		{
+			MethodName: "GetPropertyValue",
+			Handler:    _API_GetPropertyValue_Handler,
+		},
+		{
+			MethodName: "GetPropertyValues",
+			Handler:    _API_GetPropertyValues_Handler,
+		},
+		{
+			MethodName: "UpdatePropertyValue",
+			Handler:    _API_UpdatePropertyValue_Handler,
+		},
+		{
+			MethodName: "UpsertPropertyValue",
+			Handler:    _API_UpsertPropertyValue_Handler,
+		},
+		{
+			MethodName: "DeletePropertyValue",
+			Handler:    _API_DeletePropertyValue_Handler,
+		},
+		{
+			MethodName: "SearchPropertyValues",
+			Handler:    _API_SearchPropertyValues_Handler,
+		},
+		{
+			MethodName: "RegisterPropertyGroup",
+			Handler:    _API_RegisterPropertyGroup_Handler,
+		},
+		{
+			MethodName: "GetPropertyGroup",
+			Handler:    _API_GetPropertyGroup_Handler,
+		},
+		{
+			MethodName: "GetPropertyFieldByName",
+			Handler:    _API_GetPropertyFieldByName_Handler,
+		},
+		{
+			MethodName: "UpdatePropertyFields",
+			Handler:    _API_UpdatePropertyFields_Handler,
+		},
+		{
+			MethodName: "UpdatePropertyValues",
+			Handler:    _API_UpdatePropertyValues_Handler,
+		},
+		{
+			MethodName: "UpsertPropertyValues",
+			Handler:    _API_UpsertPropertyValues_Handler,
+		},
+		{
+			MethodName: "DeletePropertyValuesForTarget",
+			Handler:    _API_DeletePropertyValuesForTarget_Handler,
+		},
+		{
+			MethodName: "DeletePropertyValuesForField",
+			Handler:    _API_DeletePropertyValuesForField_Handler,
+		},
+		{
+			MethodName: "LogAuditRec",
+			Handler:    _API_LogAuditRec_Handler,
+		},
+		{
+			MethodName: "LogAuditRecWithLevel",
+			Handler:    _API_LogAuditRecWithLevel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mattermost_plugin.proto",
+}
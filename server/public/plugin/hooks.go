@@ -64,6 +64,15 @@ const (
 	GenerateSupportDataID                     = 45
 	OnSAMLLoginID                             = 46
 	EmailNotificationWillBeSentID             = 47
+	ChannelWillBeCreatedID                    = 48
+	ChannelHasBeenUpdatedID                   = 49
+	ChannelHasBeenArchivedID                  = 50
+	ChannelHasBeenRestoredID                  = 51
+	ChannelHasBeenDeletedID                   = 52
+	TeamHasBeenCreatedID                      = 53
+	TeamHasBeenUpdatedID                      = 54
+	UserHasJoinedGroupID                      = 55
+	UserHasLeftGroupID                        = 56
 	TotalHooksID                              = iota
 )
 
@@ -197,11 +206,47 @@ type Hooks interface {
 	// Minimum server version: 9.1
 	MessageHasBeenDeleted(c *Context, post *model.Post)
 
+	// ChannelWillBeCreated is invoked when a public or private channel is created, before it is
+	// committed to the database. It is not invoked for direct and group message channels.
+	//
+	// To reject the channel, return an non-empty string describing why the channel was rejected.
+	// To modify the channel, return the replacement, non-nil *model.Channel and an empty string.
+	// To allow the channel without modification, return a nil *model.Channel and an empty string.
+	//
+	// If you don't need to modify or reject channels, use ChannelHasBeenCreated instead.
+	//
+	// Minimum server version: 11.1
+	ChannelWillBeCreated(c *Context, channel *model.Channel) (*model.Channel, string)
+
 	// ChannelHasBeenCreated is invoked after the channel has been committed to the database.
 	//
 	// Minimum server version: 5.2
 	ChannelHasBeenCreated(c *Context, channel *model.Channel)
 
+	// ChannelHasBeenUpdated is invoked after the changes to a channel, such as its name, purpose,
+	// header, type or privacy, have been committed to the database.
+	//
+	// Minimum server version: 11.1
+	ChannelHasBeenUpdated(c *Context, newChannel, oldChannel *model.Channel)
+
+	// ChannelHasBeenArchived is invoked after the channel has been archived.
+	// If actor is not nil, the channel was archived by the actor.
+	//
+	// Minimum server version: 11.1
+	ChannelHasBeenArchived(c *Context, channel *model.Channel, actor *model.User)
+
+	// ChannelHasBeenRestored is invoked after an archived channel has been restored.
+	// If actor is not nil, the channel was restored by the actor.
+	//
+	// Minimum server version: 11.1
+	ChannelHasBeenRestored(c *Context, channel *model.Channel, actor *model.User)
+
+	// ChannelHasBeenDeleted is invoked after the channel and its content have been permanently
+	// deleted from the database.
+	//
+	// Minimum server version: 11.1
+	ChannelHasBeenDeleted(c *Context, channel *model.Channel)
+
 	// UserHasJoinedChannel is invoked after the membership has been committed to the database.
 	// If actor is not nil, the user was invited to the channel by the actor.
 	//
@@ -226,6 +271,29 @@ type Hooks interface {
 	// Minimum server version: 5.2
 	UserHasLeftTeam(c *Context, teamMember *model.TeamMember, actor *model.User)
 
+	// TeamHasBeenCreated is invoked after the team has been committed to the database.
+	//
+	// Minimum server version: 11.1
+	TeamHasBeenCreated(c *Context, team *model.Team)
+
+	// TeamHasBeenUpdated is invoked after the changes to a team, including its privacy and scheme,
+	// have been committed to the database.
+	//
+	// Minimum server version: 11.1
+	TeamHasBeenUpdated(c *Context, newTeam, oldTeam *model.Team)
+
+	// UserHasJoinedGroup is invoked after the group membership has been committed to the database.
+	// If actor is not nil, the user was added to the group by the actor.
+	//
+	// Minimum server version: 11.1
+	UserHasJoinedGroup(c *Context, groupMember *model.GroupMember, actor *model.User)
+
+	// UserHasLeftGroup is invoked after the group membership has been removed from the database.
+	// If actor is not nil, the user was removed from the group by the actor.
+	//
+	// Minimum server version: 11.1
+	UserHasLeftGroup(c *Context, groupMember *model.GroupMember, actor *model.User)
+
 	// FileWillBeUploaded is invoked when a file is uploaded, but before it is committed to backing store.
 	// Read from file to retrieve the body of the uploaded file.
 	//
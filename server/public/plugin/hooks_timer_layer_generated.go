@@ -127,12 +127,43 @@ func (hooks *hooksTimerLayer) MessageHasBeenDeleted(c *Context, post *model.Post
 	hooks.recordTime(startTime, "MessageHasBeenDeleted", true)
 }
 
+func (hooks *hooksTimerLayer) ChannelWillBeCreated(c *Context, channel *model.Channel) (*model.Channel, string) {
+	startTime := timePkg.Now()
+	_returnsA, _returnsB := hooks.hooksImpl.ChannelWillBeCreated(c, channel)
+	hooks.recordTime(startTime, "ChannelWillBeCreated", true)
+	return _returnsA, _returnsB
+}
+
 func (hooks *hooksTimerLayer) ChannelHasBeenCreated(c *Context, channel *model.Channel) {
 	startTime := timePkg.Now()
 	hooks.hooksImpl.ChannelHasBeenCreated(c, channel)
 	hooks.recordTime(startTime, "ChannelHasBeenCreated", true)
 }
 
+func (hooks *hooksTimerLayer) ChannelHasBeenUpdated(c *Context, newChannel, oldChannel *model.Channel) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.ChannelHasBeenUpdated(c, newChannel, oldChannel)
+	hooks.recordTime(startTime, "ChannelHasBeenUpdated", true)
+}
+
+func (hooks *hooksTimerLayer) ChannelHasBeenArchived(c *Context, channel *model.Channel, actor *model.User) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.ChannelHasBeenArchived(c, channel, actor)
+	hooks.recordTime(startTime, "ChannelHasBeenArchived", true)
+}
+
+func (hooks *hooksTimerLayer) ChannelHasBeenRestored(c *Context, channel *model.Channel, actor *model.User) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.ChannelHasBeenRestored(c, channel, actor)
+	hooks.recordTime(startTime, "ChannelHasBeenRestored", true)
+}
+
+func (hooks *hooksTimerLayer) ChannelHasBeenDeleted(c *Context, channel *model.Channel) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.ChannelHasBeenDeleted(c, channel)
+	hooks.recordTime(startTime, "ChannelHasBeenDeleted", true)
+}
+
 func (hooks *hooksTimerLayer) UserHasJoinedChannel(c *Context, channelMember *model.ChannelMember, actor *model.User) {
 	startTime := timePkg.Now()
 	hooks.hooksImpl.UserHasJoinedChannel(c, channelMember, actor)
@@ -157,6 +188,30 @@ func (hooks *hooksTimerLayer) UserHasLeftTeam(c *Context, teamMember *model.Team
 	hooks.recordTime(startTime, "UserHasLeftTeam", true)
 }
 
+func (hooks *hooksTimerLayer) TeamHasBeenCreated(c *Context, team *model.Team) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.TeamHasBeenCreated(c, team)
+	hooks.recordTime(startTime, "TeamHasBeenCreated", true)
+}
+
+func (hooks *hooksTimerLayer) TeamHasBeenUpdated(c *Context, newTeam, oldTeam *model.Team) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.TeamHasBeenUpdated(c, newTeam, oldTeam)
+	hooks.recordTime(startTime, "TeamHasBeenUpdated", true)
+}
+
+func (hooks *hooksTimerLayer) UserHasJoinedGroup(c *Context, groupMember *model.GroupMember, actor *model.User) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.UserHasJoinedGroup(c, groupMember, actor)
+	hooks.recordTime(startTime, "UserHasJoinedGroup", true)
+}
+
+func (hooks *hooksTimerLayer) UserHasLeftGroup(c *Context, groupMember *model.GroupMember, actor *model.User) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.UserHasLeftGroup(c, groupMember, actor)
+	hooks.recordTime(startTime, "UserHasLeftGroup", true)
+}
+
 func (hooks *hooksTimerLayer) FileWillBeUploaded(c *Context, info *model.FileInfo, file io.Reader, output io.Writer) (*model.FileInfo, string) {
 	startTime := timePkg.Now()
 	_returnsA, _returnsB := hooks.hooksImpl.FileWillBeUploaded(c, info, file, output)
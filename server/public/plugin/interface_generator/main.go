@@ -17,6 +17,7 @@ import (
 	"slices"
 	"strings"
 	"text/template"
+	"unicode"
 
 	"github.com/pkg/errors"
 	"golang.org/x/tools/imports"
@@ -390,6 +391,34 @@ func (s *apiRPCServer) {{.Name}}(args *{{.Name | obscure}}Args, returns *{{.Name
 {{end}}
 `
 
+var hooksGRPCTemplate = `// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Code generated by "make pluginapi"
+// DO NOT EDIT
+
+package plugin
+
+import (
+	saml2 "github.com/mattermost/gosaml2"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+{{range .HooksMethods}}
+
+func (g *hooksGRPCClient) {{.Name}}{{funcStyle .Params}} {{funcStyle .Return}} {
+	_returns := &{{.Name | obscure}}Returns{}
+	if g.implemented[{{.Name}}ID] {
+		if err := g.runHook("{{.Name}}", []any{ {{valuesOnly .Params}} }, _returns); err != nil {
+			g.log.Error("gRPC call {{.Name}} to plugin failed.", mlog.Err(err))
+		}
+	}
+	{{ if .Return }} return {{destruct "_returns." .Return}} {{ end }}
+}
+{{end}}
+`
+
 var apiTimerLayerTemplate = `// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
 // See LICENSE.txt for license information.
 
@@ -503,11 +532,6 @@ func generateHooksGlue(info *PluginInterfaceInfo) {
 		},
 	}
 
-	hooksTemplate, err := template.New("hooks").Funcs(templateFunctions).Parse(hooksTemplate)
-	if err != nil {
-		panic(err)
-	}
-
 	templateParams := HooksTemplateParams{}
 	for _, hook := range info.Hooks {
 		templateParams.HooksMethods = append(templateParams.HooksMethods, MethodParams{
@@ -523,19 +547,32 @@ func generateHooksGlue(info *PluginInterfaceInfo) {
 			Return: api.Results,
 		})
 	}
-	templateResult := &bytes.Buffer{}
-	err = hooksTemplate.Execute(templateResult, &templateParams)
-	if err != nil {
-		panic(err)
-	}
 
-	formatted, err := imports.Process("", templateResult.Bytes(), nil)
-	if err != nil {
-		panic(err)
+	pluginTemplates := map[string]string{
+		"client_rpc_generated.go":  hooksTemplate,
+		"client_grpc_generated.go": hooksGRPCTemplate,
 	}
 
-	if err := os.WriteFile(filepath.Join(getPluginPackageDir(), "client_rpc_generated.go"), formatted, 0664); err != nil {
-		panic(err)
+	for fileName, presetTemplate := range pluginTemplates {
+		parsedTemplate, err := template.New("hooks").Funcs(templateFunctions).Parse(presetTemplate)
+		if err != nil {
+			panic(err)
+		}
+
+		templateResult := &bytes.Buffer{}
+		err = parsedTemplate.Execute(templateResult, &templateParams)
+		if err != nil {
+			panic(err)
+		}
+
+		formatted, err := imports.Process("", templateResult.Bytes(), nil)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := os.WriteFile(filepath.Join(getPluginPackageDir(), fileName), formatted, 0664); err != nil {
+			panic(err)
+		}
 	}
 }
 
@@ -597,6 +634,293 @@ func generatePluginTimerLayer(info *PluginInterfaceInfo) {
 	}
 }
 
+// Hooks and API methods of the gRPC protocol whose messages are declared by protoTemplate rather
+// than generated from their signature.
+var (
+	protoExcludedHooks = []string{"Implemented", "OnActivate", "ServeHTTP", "ServeMetrics"}
+	protoExcludedAPI   = []string{"LoadPluginConfiguration", "PluginHTTP"}
+)
+
+var protoTemplate = `// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Code generated by "make pluginapi"
+// DO NOT EDIT
+
+// This file describes the gRPC protocol spoken by server plugins whose manifest
+// sets "server": {"protocol": "grpc"}. It allows plugins to be written in any
+// language with gRPC support.
+//
+// The plugin is started by the server like any other plugin and must follow the
+// hashicorp/go-plugin handshake:
+//
+//   - Exit unless the MATTERMOST_PLUGIN environment variable equals
+//     "Securely message teams, anywhere.".
+//   - Serve the Hooks service below, as well as the grpc.health.v1.Health
+//     service reporting SERVING for the "plugin" service.
+//   - Print "1|1|tcp|127.0.0.1:<port>|grpc" (or "1|1|unix|<path>|grpc") on a
+//     single line to stdout once listening.
+//
+// The messages of the hooks and API methods are generated from the Hooks and
+// API interfaces of server/public/plugin/hooks.go and api.go:
+//
+//   - Request fields are the arguments of the method, in order. Unnamed
+//     results are the "result" field, or "result_1", "result_2"... when there
+//     are several.
+//   - Strings, booleans, integers and byte slices have their protobuf type.
+//     Other values, such as model types, are strings holding their
+//     encoding/json representation and their field name ends with "_json".
+//   - Results of type error or *model.AppError are reported in the error field.
+
+syntax = "proto3";
+
+package mattermost.plugin.v1;
+
+option go_package = "github.com/mattermost/mattermost/server/public/plugin/grpcproto";
+
+// Hooks is served by the plugin and called by the server.
+service Hooks {
+  // Implemented returns the names of the hooks implemented by the plugin.
+  rpc Implemented(ImplementedRequest) returns (ImplementedResponse);
+
+  // OnActivate is called once the API service is listening, before any other
+  // hook but Implemented. A returned error stops the plugin.
+  rpc OnActivate(OnActivateRequest) returns (OnActivateResponse);
+
+  // ServeHTTP handles a request sent to /plugins/{id}.
+  rpc ServeHTTP(HTTPRequest) returns (HTTPResponse);
+
+  // ServeMetrics handles a request sent to /plugins/{id}/metrics on the
+  // metrics listener.
+  rpc ServeMetrics(HTTPRequest) returns (HTTPResponse);
+{{range .Hooks}}
+  rpc {{.Name}}({{.Name}}Request) returns ({{.Name}}Response);
+{{- end}}
+}
+
+// API is served by the server, at the target given in OnActivateRequest, and
+// called by the plugin.
+service API {
+  // LoadPluginConfiguration returns the configuration of the plugin.
+  rpc LoadPluginConfiguration(LoadPluginConfigurationRequest) returns (LoadPluginConfigurationResponse);
+{{range .API}}
+  rpc {{.Name}}({{.Name}}Request) returns ({{.Name}}Response);
+{{- end}}
+}
+
+message ImplementedRequest {}
+
+message ImplementedResponse {
+  repeated string hooks = 1;
+}
+
+message OnActivateRequest {
+  // gRPC target of the API service, such as unix:///tmp/plugin/api.sock.
+  string api_target = 1;
+}
+
+message OnActivateResponse {
+  Error error = 1;
+}
+
+message LoadPluginConfigurationRequest {}
+
+message LoadPluginConfigurationResponse {
+  // JSON object of the configuration of the plugin.
+  string configuration_json = 1;
+  Error error = 2;
+}
+
+// Error is an error or, when id is set, a model.AppError.
+message Error {
+  string message = 1;
+  string id = 2;
+  string detailed_error = 3;
+  int32 status_code = 4;
+  string where = 5;
+}
+
+message HTTPHeader {
+  string name = 1;
+  repeated string values = 2;
+}
+
+message HTTPRequest {
+  // JSON object of the plugin.Context of the request.
+  string context_json = 1;
+  string method = 2;
+  // Path and query of the request, such as /plugins/com.example/api/v1?x=1.
+  string url = 3;
+  repeated HTTPHeader headers = 4;
+  bytes body = 5;
+}
+
+message HTTPResponse {
+  int32 status_code = 1;
+  repeated HTTPHeader headers = 2;
+  bytes body = 3;
+}
+{{range .Hooks}}{{template "messages" .}}{{end}}
+{{- range .API}}{{template "messages" .}}{{end}}
+{{- define "messages"}}
+message {{.Name}}Request {{template "fields" .Request}}
+
+message {{.Name}}Response {{template "fields" .Response}}
+{{end}}
+{{- define "fields"}}{{if not .}}{}{{else}}{
+{{- range .}}
+  {{.}}
+{{- end}}
+}{{end}}{{end}}`
+
+type ProtoMethod struct {
+	Name     string
+	Request  []string
+	Response []string
+}
+
+type ProtoTemplateParams struct {
+	Hooks []ProtoMethod
+	API   []ProtoMethod
+}
+
+// protoFieldName converts the name of a Go parameter, such as userID, to the name of a
+// protobuf field, such as user_id.
+func protoFieldName(name string) string {
+	if name == "c" {
+		return "context"
+	}
+	name = strings.NewReplacer("IDs", "Ids", "URLs", "Urls").Replace(name)
+
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+			(i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			b.WriteRune('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// protoFieldType returns the protobuf type of a Go type, or an empty string for values encoded
+// as JSON.
+func protoFieldType(typeName string) string {
+	switch typeName {
+	case "string", "bool", "int32", "int64":
+		return typeName
+	case "int":
+		return "int64"
+	case "float64":
+		return "double"
+	case "[]byte", "io.Reader":
+		return "bytes"
+	case "[]string":
+		return "repeated string"
+	}
+	return ""
+}
+
+func protoFields(fieldList *ast.FieldList, fileset *token.FileSet, isResult bool) (fields []string, writers []string, hasError bool) {
+	if fieldList == nil {
+		return nil, nil, false
+	}
+
+	type param struct{ name, typeName string }
+	var params []param
+	for _, field := range fieldList.List {
+		typeNameBuffer := &bytes.Buffer{}
+		if err := printer.Fprint(typeNameBuffer, fileset, field.Type); err != nil {
+			panic(err)
+		}
+		typeName := typeNameBuffer.String()
+
+		switch {
+		case typeName == "error" || typeName == "*model.AppError":
+			hasError = true
+			continue
+		case typeName == "io.Writer":
+			for _, name := range field.Names {
+				writers = append(writers, protoFieldName(name.Name))
+			}
+			continue
+		}
+
+		if len(field.Names) == 0 {
+			params = append(params, param{"", typeName})
+		}
+		for _, name := range field.Names {
+			params = append(params, param{protoFieldName(name.Name), typeName})
+		}
+	}
+
+	for i, p := range params {
+		name := p.name
+		if name == "" || (isResult && name == "err") {
+			name = "result"
+			if len(params) > 1 {
+				name = fmt.Sprintf("result_%d", i+1)
+			}
+		}
+		protoType := protoFieldType(strings.TrimPrefix(p.typeName, "..."))
+		if protoType == "" {
+			protoType = "string"
+			name += "_json"
+		}
+		fields = append(fields, fmt.Sprintf("%s %s = %d;", protoType, name, i+1))
+	}
+
+	return fields, writers, hasError
+}
+
+func protoMethod(entry IHookEntry, fileset *token.FileSet) ProtoMethod {
+	method := ProtoMethod{Name: entry.FuncName}
+
+	var writers []string
+	method.Request, writers, _ = protoFields(entry.Args, fileset, false)
+
+	results, _, hasError := protoFields(entry.Results, fileset, true)
+	method.Response = results
+	// Data written by the plugin to an io.Writer argument is returned after the results.
+	for _, name := range writers {
+		method.Response = append(method.Response, fmt.Sprintf("bytes %s = %d;", name, len(method.Response)+1))
+	}
+	if hasError {
+		method.Response = append(method.Response, fmt.Sprintf("Error error = %d;", len(method.Response)+1))
+	}
+
+	return method
+}
+
+func generateProto(info *PluginInterfaceInfo) {
+	templateParams := ProtoTemplateParams{}
+	for _, hook := range info.Hooks {
+		if !slices.Contains(protoExcludedHooks, hook.FuncName) {
+			templateParams.Hooks = append(templateParams.Hooks, protoMethod(hook, info.FileSet))
+		}
+	}
+	for _, api := range info.API {
+		if !slices.Contains(protoExcludedAPI, api.FuncName) {
+			templateParams.API = append(templateParams.API, protoMethod(api, info.FileSet))
+		}
+	}
+
+	parsedTemplate, err := template.New("proto").Parse(protoTemplate)
+	if err != nil {
+		panic(err)
+	}
+
+	templateResult := &bytes.Buffer{}
+	if err := parsedTemplate.Execute(templateResult, &templateParams); err != nil {
+		panic(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(getPluginPackageDir(), "grpcproto", "mattermost_plugin.proto"), templateResult.Bytes(), 0664); err != nil {
+		panic(err)
+	}
+}
+
 func getPluginPackageDir() string {
 	dirs, err := goList("github.com/mattermost/mattermost/server/public/plugin")
 	if err != nil {
@@ -652,4 +976,7 @@ func main() {
 		fmt.Println("Unable to get plugin info: " + err.Error())
 	}
 	generatePluginTimerLayer(forPlugins)
+
+	log.Println("Generating plugin gRPC protocol")
+	generateProto(forPlugins)
 }
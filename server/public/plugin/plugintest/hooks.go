@@ -22,11 +22,61 @@ type Hooks struct {
 	mock.Mock
 }
 
+// ChannelHasBeenArchived provides a mock function with given fields: c, channel, actor
+func (_m *Hooks) ChannelHasBeenArchived(c *plugin.Context, channel *model.Channel, actor *model.User) {
+	_m.Called(c, channel, actor)
+}
+
 // ChannelHasBeenCreated provides a mock function with given fields: c, channel
 func (_m *Hooks) ChannelHasBeenCreated(c *plugin.Context, channel *model.Channel) {
 	_m.Called(c, channel)
 }
 
+// ChannelHasBeenDeleted provides a mock function with given fields: c, channel
+func (_m *Hooks) ChannelHasBeenDeleted(c *plugin.Context, channel *model.Channel) {
+	_m.Called(c, channel)
+}
+
+// ChannelHasBeenRestored provides a mock function with given fields: c, channel, actor
+func (_m *Hooks) ChannelHasBeenRestored(c *plugin.Context, channel *model.Channel, actor *model.User) {
+	_m.Called(c, channel, actor)
+}
+
+// ChannelHasBeenUpdated provides a mock function with given fields: c, newChannel, oldChannel
+func (_m *Hooks) ChannelHasBeenUpdated(c *plugin.Context, newChannel *model.Channel, oldChannel *model.Channel) {
+	_m.Called(c, newChannel, oldChannel)
+}
+
+// ChannelWillBeCreated provides a mock function with given fields: c, channel
+func (_m *Hooks) ChannelWillBeCreated(c *plugin.Context, channel *model.Channel) (*model.Channel, string) {
+	ret := _m.Called(c, channel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChannelWillBeCreated")
+	}
+
+	var r0 *model.Channel
+	var r1 string
+	if rf, ok := ret.Get(0).(func(*plugin.Context, *model.Channel) (*model.Channel, string)); ok {
+		return rf(c, channel)
+	}
+	if rf, ok := ret.Get(0).(func(*plugin.Context, *model.Channel) *model.Channel); ok {
+		r0 = rf(c, channel)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Channel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*plugin.Context, *model.Channel) string); ok {
+		r1 = rf(c, channel)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	return r0, r1
+}
+
 // ConfigurationWillBeSaved provides a mock function with given fields: newCfg
 func (_m *Hooks) ConfigurationWillBeSaved(newCfg *model.Config) (*model.Config, error) {
 	ret := _m.Called(newCfg)
@@ -584,6 +634,16 @@ func (_m *Hooks) ServeMetrics(c *plugin.Context, w http.ResponseWriter, r *http.
 	_m.Called(c, w, r)
 }
 
+// TeamHasBeenCreated provides a mock function with given fields: c, team
+func (_m *Hooks) TeamHasBeenCreated(c *plugin.Context, team *model.Team) {
+	_m.Called(c, team)
+}
+
+// TeamHasBeenUpdated provides a mock function with given fields: c, newTeam, oldTeam
+func (_m *Hooks) TeamHasBeenUpdated(c *plugin.Context, newTeam *model.Team, oldTeam *model.Team) {
+	_m.Called(c, newTeam, oldTeam)
+}
+
 // UserHasBeenCreated provides a mock function with given fields: c, user
 func (_m *Hooks) UserHasBeenCreated(c *plugin.Context, user *model.User) {
 	_m.Called(c, user)
@@ -599,6 +659,11 @@ func (_m *Hooks) UserHasJoinedChannel(c *plugin.Context, channelMember *model.Ch
 	_m.Called(c, channelMember, actor)
 }
 
+// UserHasJoinedGroup provides a mock function with given fields: c, groupMember, actor
+func (_m *Hooks) UserHasJoinedGroup(c *plugin.Context, groupMember *model.GroupMember, actor *model.User) {
+	_m.Called(c, groupMember, actor)
+}
+
 // UserHasJoinedTeam provides a mock function with given fields: c, teamMember, actor
 func (_m *Hooks) UserHasJoinedTeam(c *plugin.Context, teamMember *model.TeamMember, actor *model.User) {
 	_m.Called(c, teamMember, actor)
@@ -609,6 +674,11 @@ func (_m *Hooks) UserHasLeftChannel(c *plugin.Context, channelMember *model.Chan
 	_m.Called(c, channelMember, actor)
 }
 
+// UserHasLeftGroup provides a mock function with given fields: c, groupMember, actor
+func (_m *Hooks) UserHasLeftGroup(c *plugin.Context, groupMember *model.GroupMember, actor *model.User) {
+	_m.Called(c, groupMember, actor)
+}
+
 // UserHasLeftTeam provides a mock function with given fields: c, teamMember, actor
 func (_m *Hooks) UserHasLeftTeam(c *plugin.Context, teamMember *model.TeamMember, actor *model.User) {
 	_m.Called(c, teamMember, actor)
@@ -30,6 +30,7 @@ type supervisor struct {
 	hooks        Hooks
 	implemented  [TotalHooksID]bool
 	hooksClient  *hooksRPCClient
+	grpcClient   *hooksGRPCClient
 	isReattached bool
 }
 
@@ -42,20 +43,28 @@ func (d *driverForPlugin) Conn(isMaster bool) (string, error) {
 	return d.AppDriver.ConnWithPluginID(isMaster, d.pluginID)
 }
 
+// getExecutablePath returns the path of the backend executable of the plugin for this platform.
+func getExecutablePath(pluginInfo *model.BundleInfo) (string, error) {
+	executable := pluginInfo.Manifest.GetExecutableForRuntime(runtime.GOOS, runtime.GOARCH)
+	if executable == "" {
+		return "", fmt.Errorf("backend executable not found for environment: %s/%s", runtime.GOOS, runtime.GOARCH)
+	}
+
+	executable = filepath.Clean(filepath.Join(".", executable))
+	if strings.HasPrefix(executable, "..") {
+		return "", fmt.Errorf("invalid backend executable: %s", executable)
+	}
+
+	return filepath.Join(pluginInfo.Path, executable), nil
+}
+
 func WithExecutableFromManifest(pluginInfo *model.BundleInfo) func(*supervisor, *plugin.ClientConfig) error {
 	return func(_ *supervisor, clientConfig *plugin.ClientConfig) error {
-		executable := pluginInfo.Manifest.GetExecutableForRuntime(runtime.GOOS, runtime.GOARCH)
-		if executable == "" {
-			return fmt.Errorf("backend executable not found for environment: %s/%s", runtime.GOOS, runtime.GOARCH)
-		}
-
-		executable = filepath.Clean(filepath.Join(".", executable))
-		if strings.HasPrefix(executable, "..") {
-			return fmt.Errorf("invalid backend executable: %s", executable)
+		executable, err := getExecutablePath(pluginInfo)
+		if err != nil {
+			return err
 		}
 
-		executable = filepath.Join(pluginInfo.Path, executable)
-
 		cmd := exec.Command(executable)
 
 		// This doesn't add more security than before
@@ -101,6 +110,20 @@ func newSupervisor(pluginInfo *model.BundleInfo, apiImpl API, driver AppDriver,
 
 	wrappedLogger := pluginInfo.WrapLogger(parentLogger)
 
+	// WebAssembly plugins run inside the server, in the sandbox declared by their manifest.
+	if pluginInfo.Manifest.Server.GetProtocol() == model.PluginProtocolWASM {
+		wasmClient, err := newWASMHooksClient(pluginInfo, &apiTimerLayer{pluginInfo.Manifest.Id, apiImpl, metrics}, wrappedLogger)
+		if err != nil {
+			return nil, err
+		}
+		sup.grpcClient = wasmClient
+		sup.hooks = &hooksTimerLayer{pluginInfo.Manifest.Id, wasmClient, metrics}
+		if err := sup.initImplemented(); err != nil {
+			return nil, err
+		}
+		return &sup, nil
+	}
+
 	hclogAdaptedLogger := &hclogAdapter{
 		wrappedLogger: wrappedLogger,
 		extrasKey:     "wrapped_extras",
@@ -113,14 +136,24 @@ func newSupervisor(pluginInfo *model.BundleInfo, apiImpl API, driver AppDriver,
 			apiImpl:    &apiTimerLayer{pluginInfo.Manifest.Id, apiImpl, metrics},
 		},
 	}
+	allowedProtocols := []plugin.Protocol{plugin.ProtocolNetRPC}
+
+	if pluginInfo.Manifest.Server.GetProtocol() == model.PluginProtocolGRPC {
+		pluginMap["hooks"] = &hooksGRPCPlugin{
+			log:     wrappedLogger,
+			apiImpl: &apiTimerLayer{pluginInfo.Manifest.Id, apiImpl, metrics},
+		}
+		allowedProtocols = []plugin.Protocol{plugin.ProtocolGRPC}
+	}
 
 	clientConfig := &plugin.ClientConfig{
-		HandshakeConfig: handshake,
-		Plugins:         pluginMap,
-		SyncStdout:      wrappedLogger.With(mlog.String("source", "plugin_stdout")).StdLogWriter(),
-		SyncStderr:      wrappedLogger.With(mlog.String("source", "plugin_stderr")).StdLogWriter(),
-		Logger:          hclogAdaptedLogger,
-		StartTimeout:    time.Second * 3,
+		HandshakeConfig:  handshake,
+		Plugins:          pluginMap,
+		AllowedProtocols: allowedProtocols,
+		SyncStdout:       wrappedLogger.With(mlog.String("source", "plugin_stdout")).StdLogWriter(),
+		SyncStderr:       wrappedLogger.With(mlog.String("source", "plugin_stderr")).StdLogWriter(),
+		Logger:           hclogAdaptedLogger,
+		StartTimeout:     time.Second * 3,
 	}
 	for _, opt := range opts {
 		err := opt(&sup, clientConfig)
@@ -141,24 +174,33 @@ func newSupervisor(pluginInfo *model.BundleInfo, apiImpl API, driver AppDriver,
 		return nil, err
 	}
 
-	c, ok := raw.(*hooksRPCClient)
-	if ok {
+	switch c := raw.(type) {
+	case *hooksRPCClient:
 		sup.hooksClient = c
+	case *hooksGRPCClient:
+		sup.grpcClient = c
 	}
 
 	sup.hooks = &hooksTimerLayer{pluginInfo.Manifest.Id, raw.(Hooks), metrics}
 
+	if err := sup.initImplemented(); err != nil {
+		return nil, err
+	}
+
+	return &sup, nil
+}
+
+func (sup *supervisor) initImplemented() error {
 	impl, err := sup.hooks.Implemented()
 	if err != nil {
-		return nil, err
+		return err
 	}
 	for _, hookName := range impl {
 		if hookId, ok := hookNameToId[hookName]; ok {
 			sup.implemented[hookId] = true
 		}
 	}
-
-	return &sup, nil
+	return nil
 }
 
 func (sup *supervisor) Shutdown() {
@@ -182,6 +224,12 @@ func (sup *supervisor) Shutdown() {
 		sup.client.Kill()
 	}
 
+	// Stop serving the API to gRPC and WebAssembly plugins, which have no database connections.
+	if sup.grpcClient != nil {
+		sup.grpcClient.shutdown()
+		sup.grpcClient.doneWg.Wait()
+	}
+
 	// Wait for API RPC server and DB RPC server to exit.
 	// And then shutdown conns.
 	if sup.hooksClient != nil {
@@ -220,6 +268,10 @@ func (sup *supervisor) PerformHealthCheck() error {
 func (sup *supervisor) Ping() error {
 	sup.lock.RLock()
 	defer sup.lock.RUnlock()
+	// WebAssembly plugins have no connection, a failing instance is replaced on the next hook.
+	if sup.client == nil {
+		return nil
+	}
 	client, err := sup.client.Client()
 	if err != nil {
 		return err
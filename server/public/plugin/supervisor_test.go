@@ -21,6 +21,7 @@ func TestSupervisor(t *testing.T) {
 		"Supervisor_InvalidExecutablePath":     testSupervisorInvalidExecutablePath,
 		"Supervisor_NonExistentExecutablePath": testSupervisorNonExistentExecutablePath,
 		"Supervisor_StartTimeout":              testSupervisorStartTimeout,
+		"Supervisor_GRPCProtocol":              testSupervisorGRPCProtocol,
 	} {
 		t.Run(name, f)
 	}
@@ -78,3 +79,87 @@ func testSupervisorStartTimeout(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, supervisor)
 }
+
+func testSupervisorGRPCProtocol(t *testing.T) {
+	dir, err := os.MkdirTemp("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	backend := filepath.Join(dir, "backend.exe")
+	utils.CompileGo(t, `
+		package main
+
+		import (
+			"context"
+			"fmt"
+			"net"
+
+			"google.golang.org/grpc"
+			"google.golang.org/grpc/credentials/insecure"
+			"google.golang.org/grpc/health"
+			"google.golang.org/grpc/health/grpc_health_v1"
+
+			"github.com/mattermost/mattermost/server/public/plugin/grpcproto"
+		)
+
+		type hooks struct {
+			grpcproto.UnimplementedHooksServer
+			api grpcproto.APIClient
+		}
+
+		func (h *hooks) Implemented(context.Context, *grpcproto.ImplementedRequest) (*grpcproto.ImplementedResponse, error) {
+			return &grpcproto.ImplementedResponse{Hooks: []string{"OnActivate", "MessageWillBePosted"}}, nil
+		}
+
+		func (h *hooks) OnActivate(_ context.Context, req *grpcproto.OnActivateRequest) (*grpcproto.OnActivateResponse, error) {
+			conn, err := grpc.NewClient(req.ApiTarget, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				return nil, err
+			}
+			h.api = grpcproto.NewAPIClient(conn)
+			return &grpcproto.OnActivateResponse{}, nil
+		}
+
+		func (h *hooks) MessageWillBePosted(ctx context.Context, _ *grpcproto.MessageWillBePostedRequest) (*grpcproto.MessageWillBePostedResponse, error) {
+			resp, err := h.api.KVSet(ctx, &grpcproto.KVSetRequest{Key: "key", Value: []byte("value")})
+			if err != nil {
+				return nil, err
+			}
+			if resp.Error != nil {
+				return &grpcproto.MessageWillBePostedResponse{Result_2: resp.Error.Message}, nil
+			}
+			return &grpcproto.MessageWillBePostedResponse{Result_2: "OK"}, nil
+		}
+
+		func main() {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				panic(err)
+			}
+			server := grpc.NewServer()
+			grpcproto.RegisterHooksServer(server, &hooks{})
+			healthServer := health.NewServer()
+			healthServer.SetServingStatus("plugin", grpc_health_v1.HealthCheckResponse_SERVING)
+			grpc_health_v1.RegisterHealthServer(server, healthServer)
+			fmt.Printf("1|1|tcp|%s|grpc\n", listener.Addr())
+			server.Serve(listener)
+		}
+	`, backend)
+
+	os.WriteFile(filepath.Join(dir, "plugin.json"), []byte(`{"id": "foo", "server": {"executable": "backend.exe", "protocol": "grpc"}}`), 0600)
+
+	api := &testGRPCAPI{kv: map[string][]byte{}}
+	bundle := model.BundleInfoForPath(dir)
+	logger := mlog.CreateConsoleTestLogger(t)
+	supervisor, err := newSupervisor(bundle, api, nil, logger, nil, WithExecutableFromManifest(bundle))
+	require.NoError(t, err)
+	require.NotNil(t, supervisor)
+	defer supervisor.Shutdown()
+
+	require.True(t, supervisor.Implements(MessageWillBePostedID))
+	require.NoError(t, supervisor.Hooks().OnActivate())
+
+	_, rejectionReason := supervisor.Hooks().MessageWillBePosted(&Context{}, &model.Post{})
+	assert.Equal(t, "OK", rejectionReason)
+	assert.Equal(t, []byte("value"), api.kv["key"])
+}
@@ -0,0 +1,472 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/grpcproto"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// WebAssembly plugins are WASI modules run inside the server by a WebAssembly runtime. They
+// exchange the messages of the gRPC protocol, in their protobuf JSON mapping with the original
+// field names, through the following functions:
+//
+//   - The module exports mm_malloc(size i32) i32 and mm_free(ptr i32, size i32) to let the
+//     server allocate and release buffers in its memory.
+//   - The module exports mm_call(ptr i32, size i32) i64. The input is a JSON object such as
+//     {"hook": "MessageWillBePosted", "request": {...}} and the output, whose address and
+//     size are packed in the high and low 32 bits of the result, is a JSON object such as
+//     {"response": {...}}, or {"error": {...}} when the hook can't be run.
+//   - The module imports api_call(ptr i32, size i32) i64 from the "mattermost" module to
+//     call the API with {"method": "KVSet", "request": {...}}, and http_do(ptr i32, size i32)
+//     i64 to send {"request": {...}}, an HTTPRequest, to one of the network hosts granted by
+//     the sandbox. Both return a JSON object like mm_call.
+//
+// Buffers passed to the module must be released by the module. Several instances of a plugin
+// may run at the same time, so plugins must keep their state in the KV store.
+
+// newWASMEngine creates the runtime of a WebAssembly plugin, wazero unless replaced by tests.
+var newWASMEngine func(ctx context.Context, config *wasmEngineConfig) (wasmEngine, error)
+
+type wasmEngineConfig struct {
+	Module        []byte
+	MemoryLimitMB int
+	// DataDir, when set, is mounted at /data.
+	DataDir string
+	Stdout  io.Writer
+	Stderr  io.Writer
+	// Host handles the calls of the module to the functions of the "mattermost" module.
+	Host func(ctx context.Context, function string, input []byte) []byte
+}
+
+type wasmEngine interface {
+	instantiate(ctx context.Context) (wasmInstance, error)
+	close(ctx context.Context) error
+}
+
+type wasmInstance interface {
+	// call calls mm_call with the input, returning its output. The instance must not be
+	// used again after an error.
+	call(ctx context.Context, input []byte) ([]byte, error)
+	close(ctx context.Context) error
+}
+
+const wasmMaxIdleInstances = 4
+
+type wasmCall struct {
+	Hook    string          `json:"hook,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Request json.RawMessage `json:"request,omitempty"`
+}
+
+type wasmResult struct {
+	Response json.RawMessage  `json:"response,omitempty"`
+	Error    *grpcproto.Error `json:"error,omitempty"`
+}
+
+var (
+	wasmMarshalOptions   = protojson.MarshalOptions{UseProtoNames: true}
+	wasmUnmarshalOptions = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+// wasmHooksClient implements the connection to the Hooks service of the gRPC protocol by calling
+// a WebAssembly module, so that hooksGRPCClient can serve WebAssembly plugins.
+type wasmHooksClient struct {
+	engine       wasmEngine
+	api          *apiGRPCServer
+	sandboxedAPI *wasmAPI
+	timeLimit    time.Duration
+	httpClient   *http.Client
+	log          *mlog.Logger
+
+	lock      sync.Mutex
+	instances []wasmInstance
+}
+
+var (
+	_ grpc.ClientConnInterface = &wasmHooksClient{}
+	_ io.Closer                = &wasmHooksClient{}
+)
+
+func newWASMHooksClient(pluginInfo *model.BundleInfo, apiImpl API, logger *mlog.Logger) (*hooksGRPCClient, error) {
+	if newWASMEngine == nil {
+		return nil, errors.New("WebAssembly plugins are not supported by this build of the server")
+	}
+
+	executable, err := getExecutablePath(pluginInfo)
+	if err != nil {
+		return nil, err
+	}
+	module, err := os.ReadFile(executable)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read WebAssembly module")
+	}
+
+	sandbox := pluginInfo.Manifest.Server.Sandbox
+	config := &wasmEngineConfig{
+		Module:        module,
+		MemoryLimitMB: sandbox.GetMemoryLimitMB(),
+		Stdout:        logger.With(mlog.String("source", "plugin_stdout")).StdLogWriter(),
+		Stderr:        logger.With(mlog.String("source", "plugin_stderr")).StdLogWriter(),
+	}
+	if sandbox != nil && sandbox.Filesystem {
+		config.DataDir = filepath.Join(pluginInfo.Path, "data")
+		if err := os.MkdirAll(config.DataDir, 0700); err != nil {
+			return nil, errors.Wrap(err, "failed to create data directory")
+		}
+	}
+
+	sandboxedAPI := &wasmAPI{API: apiImpl, sandbox: sandbox}
+	client := &wasmHooksClient{
+		api:          &apiGRPCServer{impl: sandboxedAPI, allowed: sandboxedAPI.allowed},
+		sandboxedAPI: sandboxedAPI,
+		timeLimit:    sandbox.GetTimeLimit(),
+		log:          logger,
+	}
+	client.httpClient = &http.Client{
+		Timeout: client.timeLimit,
+		CheckRedirect: func(req *http.Request, _ []*http.Request) error {
+			return sandboxedAPI.checkHost(req.URL)
+		},
+	}
+	config.Host = client.host
+
+	client.engine, err = newWASMEngine(context.Background(), config)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load WebAssembly module")
+	}
+
+	hooksClient := newHooksGRPCClient(client, logger, nil)
+	hooksClient.localAPI = true
+	return hooksClient, nil
+}
+
+// acquire returns an idle instance of the module, or a new one when all are busy, such as when
+// a hook calls the API which in turn runs a hook of the same plugin.
+func (c *wasmHooksClient) acquire(ctx context.Context) (wasmInstance, error) {
+	c.lock.Lock()
+	if n := len(c.instances); n > 0 {
+		instance := c.instances[n-1]
+		c.instances = c.instances[:n-1]
+		c.lock.Unlock()
+		return instance, nil
+	}
+	c.lock.Unlock()
+
+	return c.engine.instantiate(ctx)
+}
+
+func (c *wasmHooksClient) release(instance wasmInstance) {
+	c.lock.Lock()
+	if len(c.instances) < wasmMaxIdleInstances {
+		c.instances = append(c.instances, instance)
+		instance = nil
+	}
+	c.lock.Unlock()
+
+	if instance != nil {
+		instance.close(context.Background())
+	}
+}
+
+func (c *wasmHooksClient) call(ctx context.Context, hook string, request []byte) (*wasmResult, error) {
+	input, err := json.Marshal(&wasmCall{Hook: hook, Request: request})
+	if err != nil {
+		return nil, err
+	}
+
+	instance, err := c.acquire(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to instantiate WebAssembly module")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeLimit)
+	defer cancel()
+	output, err := instance.call(ctx, input)
+	if err != nil {
+		// The instance may be left in any state by a trap or by reaching the time limit.
+		instance.close(context.Background())
+		return nil, errors.Wrapf(err, "hook %s failed", hook)
+	}
+	c.release(instance)
+
+	var result wasmResult
+	if err := json.Unmarshal(output, &result); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode output of hook %s", hook)
+	}
+	return &result, nil
+}
+
+// Invoke runs the hook of the method, such as /mattermost.plugin.v1.Hooks/MessageWillBePosted.
+func (c *wasmHooksClient) Invoke(ctx context.Context, method string, args, reply any, _ ...grpc.CallOption) error {
+	hook := path.Base(method)
+	request, err := wasmMarshalOptions.Marshal(args.(proto.Message))
+	if err != nil {
+		return errors.Wrapf(err, "failed to encode request of hook %s", hook)
+	}
+
+	result, err := c.call(ctx, hook, request)
+	if err != nil {
+		return err
+	}
+	if result.Error != nil {
+		return fromGRPCError(result.Error)
+	}
+	if len(result.Response) == 0 {
+		return nil
+	}
+	if err := wasmUnmarshalOptions.Unmarshal(result.Response, reply.(proto.Message)); err != nil {
+		return errors.Wrapf(err, "failed to decode response of hook %s", hook)
+	}
+	return nil
+}
+
+func (c *wasmHooksClient) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("streams are not supported by WebAssembly plugins")
+}
+
+// Close releases the instances and the runtime of the module.
+func (c *wasmHooksClient) Close() error {
+	c.lock.Lock()
+	instances := c.instances
+	c.instances = nil
+	c.lock.Unlock()
+
+	ctx := context.Background()
+	for _, instance := range instances {
+		instance.close(ctx)
+	}
+	return c.engine.close(ctx)
+}
+
+// host handles the calls of the module to the server.
+func (c *wasmHooksClient) host(ctx context.Context, function string, input []byte) []byte {
+	var result *wasmResult
+	switch function {
+	case "api_call":
+		result = c.callAPI(ctx, input)
+	case "http_do":
+		result = c.doHTTP(ctx, input)
+	default:
+		result = &wasmResult{Error: &grpcproto.Error{Message: "unknown function " + function}}
+	}
+
+	output, err := json.Marshal(result)
+	if err != nil {
+		c.log.Error("Failed to encode WebAssembly host function output.", mlog.String("function", function), mlog.Err(err))
+		return []byte(`{"error": {"message": "internal error"}}`)
+	}
+	return output
+}
+
+func (c *wasmHooksClient) callAPI(ctx context.Context, input []byte) *wasmResult {
+	var call wasmCall
+	if err := json.Unmarshal(input, &call); err != nil {
+		return &wasmResult{Error: &grpcproto.Error{Message: "invalid API call: " + err.Error()}}
+	}
+
+	resp, err := c.api.call(ctx, call.Method, func(req proto.Message) error {
+		if len(call.Request) == 0 {
+			return nil
+		}
+		return wasmUnmarshalOptions.Unmarshal(call.Request, req)
+	})
+	if err != nil {
+		return &wasmResult{Error: &grpcproto.Error{Message: status.Convert(err).Message()}}
+	}
+	return wasmResponse(resp)
+}
+
+func wasmResponse(resp proto.Message) *wasmResult {
+	b, err := wasmMarshalOptions.Marshal(resp)
+	if err != nil {
+		return &wasmResult{Error: &grpcproto.Error{Message: err.Error()}}
+	}
+	return &wasmResult{Response: b}
+}
+
+func (c *wasmHooksClient) doHTTP(ctx context.Context, input []byte) *wasmResult {
+	var call wasmCall
+	httpReq := &grpcproto.HTTPRequest{}
+	if err := json.Unmarshal(input, &call); err != nil || wasmUnmarshalOptions.Unmarshal(call.Request, httpReq) != nil {
+		return &wasmResult{Error: &grpcproto.Error{Message: "invalid HTTP request"}}
+	}
+
+	u, err := url.Parse(httpReq.Url)
+	if err != nil {
+		return &wasmResult{Error: &grpcproto.Error{Message: "invalid URL: " + err.Error()}}
+	}
+	if err = c.sandboxedAPI.checkHost(u); err != nil {
+		return &wasmResult{Error: &grpcproto.Error{Message: err.Error()}}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, httpReq.Method, u.String(), bytes.NewReader(httpReq.Body))
+	if err != nil {
+		return &wasmResult{Error: &grpcproto.Error{Message: err.Error()}}
+	}
+	for _, header := range httpReq.Headers {
+		req.Header[http.CanonicalHeaderKey(header.Name)] = header.Values
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return &wasmResult{Error: &grpcproto.Error{Message: err.Error()}}
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return &wasmResult{Error: &grpcproto.Error{Message: err.Error()}}
+	}
+	httpResp := &grpcproto.HTTPResponse{StatusCode: int32(resp.StatusCode), Body: body}
+	for name, values := range resp.Header {
+		httpResp.Headers = append(httpResp.Headers, &grpcproto.HTTPHeader{Name: name, Values: values})
+	}
+	return wasmResponse(httpResp)
+}
+
+// wasmCapabilityMethods lists the API methods granted by each capability of the sandbox.
+var wasmCapabilityMethods = map[string][]string{
+	"": {
+		"LogDebug", "LogInfo", "LogWarn", "LogError",
+		"GetPluginConfig", "LoadPluginConfiguration", "GetServerVersion",
+	},
+	model.PluginCapabilityKV: {
+		"KVSet", "KVSetWithOptions", "KVSetWithExpiry", "KVCompareAndSet", "KVCompareAndDelete",
+		"KVGet", "KVDelete", "KVDeleteAll", "KVList",
+	},
+	model.PluginCapabilityUsersRead: {
+		"GetUser", "GetUserByEmail", "GetUserByUsername", "GetUsersByUsernames", "GetUserStatus",
+	},
+	model.PluginCapabilityTeamsRead: {
+		"GetTeam", "GetTeamByName", "GetTeamMember",
+	},
+	model.PluginCapabilityChannelsRead: {
+		"GetChannel", "GetChannelByName", "GetChannelMember", "GetChannelMembers",
+	},
+	model.PluginCapabilityPostsRead: {
+		"GetPost", "GetPostThread", "GetPostsForChannel",
+	},
+	model.PluginCapabilityPostsWrite: {
+		"CreatePost", "UpdatePost", "DeletePost", "SendEphemeralPost", "AddReaction", "RemoveReaction",
+	},
+	model.PluginCapabilityCommands: {
+		"RegisterCommand", "UnregisterCommand",
+	},
+	model.PluginCapabilityWebSocket: {
+		"PublishWebSocketEvent",
+	},
+	model.PluginCapabilityConfig: {
+		"SavePluginConfig",
+	},
+}
+
+// wasmAPI restricts the API to the capabilities granted by the sandbox of a WebAssembly plugin.
+type wasmAPI struct {
+	API
+	sandbox *model.ManifestSandbox
+}
+
+func (api *wasmAPI) allowed(method string) bool {
+	if slices.Contains(wasmCapabilityMethods[""], method) {
+		return true
+	}
+	for capability, methods := range wasmCapabilityMethods {
+		if capability != "" && api.sandbox.HasCapability(capability) && slices.Contains(methods, method) {
+			return true
+		}
+	}
+	return false
+}
+
+func (api *wasmAPI) checkHost(u *url.URL) error {
+	if api.sandbox == nil || !slices.Contains(api.sandbox.NetworkHosts, u.Hostname()) {
+		return errors.Errorf("network access to %s is not granted", u.Hostname())
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.Errorf("unsupported scheme %s", u.Scheme)
+	}
+	return nil
+}
+
+// checkChannel checks that the plugin may post to the channel.
+func (api *wasmAPI) checkChannel(channelID string) *model.AppError {
+	if api.sandbox == nil || len(api.sandbox.Channels) == 0 || slices.Contains(api.sandbox.Channels, channelID) {
+		return nil
+	}
+	return model.NewAppError("checkChannel", "plugin.wasm.channel_not_allowed.app_error", nil, "channel_id="+channelID, http.StatusForbidden)
+}
+
+func (api *wasmAPI) checkPostChannel(postID string) *model.AppError {
+	post, appErr := api.API.GetPost(postID)
+	if appErr != nil {
+		return appErr
+	}
+	return api.checkChannel(post.ChannelId)
+}
+
+func (api *wasmAPI) CreatePost(post *model.Post) (*model.Post, *model.AppError) {
+	if appErr := api.checkChannel(post.ChannelId); appErr != nil {
+		return nil, appErr
+	}
+	return api.API.CreatePost(post)
+}
+
+func (api *wasmAPI) UpdatePost(post *model.Post) (*model.Post, *model.AppError) {
+	if appErr := api.checkPostChannel(post.Id); appErr != nil {
+		return nil, appErr
+	}
+	if appErr := api.checkChannel(post.ChannelId); appErr != nil {
+		return nil, appErr
+	}
+	return api.API.UpdatePost(post)
+}
+
+func (api *wasmAPI) DeletePost(postID string) *model.AppError {
+	if appErr := api.checkPostChannel(postID); appErr != nil {
+		return appErr
+	}
+	return api.API.DeletePost(postID)
+}
+
+func (api *wasmAPI) SendEphemeralPost(userID string, post *model.Post) *model.Post {
+	if appErr := api.checkChannel(post.ChannelId); appErr != nil {
+		return nil
+	}
+	return api.API.SendEphemeralPost(userID, post)
+}
+
+func (api *wasmAPI) AddReaction(reaction *model.Reaction) (*model.Reaction, *model.AppError) {
+	if appErr := api.checkPostChannel(reaction.PostId); appErr != nil {
+		return nil, appErr
+	}
+	return api.API.AddReaction(reaction)
+}
+
+func (api *wasmAPI) RemoveReaction(reaction *model.Reaction) *model.AppError {
+	if appErr := api.checkPostChannel(reaction.PostId); appErr != nil {
+		return appErr
+	}
+	return api.API.RemoveReaction(reaction)
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/grpcproto"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// fakeWASMEngine runs a Go function in place of a WebAssembly module.
+type fakeWASMEngine struct {
+	config  *wasmEngineConfig
+	guest   func(ctx context.Context, host func(function string, method string, req proto.Message) *wasmResult, call *wasmCall) (*wasmResult, error)
+	created atomic.Int32
+	closed  atomic.Int32
+}
+
+type fakeWASMInstance struct {
+	engine *fakeWASMEngine
+}
+
+func (e *fakeWASMEngine) instantiate(context.Context) (wasmInstance, error) {
+	e.created.Add(1)
+	return &fakeWASMInstance{engine: e}, nil
+}
+
+func (e *fakeWASMEngine) close(context.Context) error {
+	return nil
+}
+
+func (i *fakeWASMInstance) call(ctx context.Context, input []byte) ([]byte, error) {
+	var call wasmCall
+	if err := json.Unmarshal(input, &call); err != nil {
+		return nil, err
+	}
+
+	host := func(function string, method string, req proto.Message) *wasmResult {
+		request, _ := wasmMarshalOptions.Marshal(req)
+		input, _ := json.Marshal(&wasmCall{Method: method, Request: request})
+		var result wasmResult
+		_ = json.Unmarshal(i.engine.config.Host(ctx, function, input), &result)
+		return &result
+	}
+
+	result, err := i.engine.guest(ctx, host, &call)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(result)
+}
+
+func (i *fakeWASMInstance) close(context.Context) error {
+	i.engine.closed.Add(1)
+	return nil
+}
+
+type testWASMAPI struct {
+	testGRPCAPI
+	onCreatePost func(post *model.Post)
+}
+
+func (api *testWASMAPI) CreatePost(post *model.Post) (*model.Post, *model.AppError) {
+	if api.onCreatePost != nil {
+		api.onCreatePost(post)
+	}
+	return post, nil
+}
+
+func (api *testWASMAPI) GetUser(userID string) (*model.User, *model.AppError) {
+	return &model.User{Id: userID}, nil
+}
+
+func TestWASMSupervisor(t *testing.T) {
+	allowedChannelID := model.NewId()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "plugin.wasm"), []byte("\x00asm"), 0600))
+	manifest := &model.Manifest{
+		Id: "foo",
+		Server: &model.ManifestServer{
+			Executable: "plugin.wasm",
+			Protocol:   model.PluginProtocolWASM,
+			Sandbox: &model.ManifestSandbox{
+				Capabilities: []string{model.PluginCapabilityKV, model.PluginCapabilityPostsWrite},
+				Channels:     []string{allowedChannelID},
+				TimeLimitMs:  100,
+			},
+		},
+	}
+	b, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "plugin.json"), b, 0600))
+	bundle := model.BundleInfoForPath(dir)
+	logger := mlog.CreateConsoleTestLogger(t)
+
+	defaultWASMEngine := newWASMEngine
+	defer func() { newWASMEngine = defaultWASMEngine }()
+
+	t.Run("no WebAssembly runtime", func(t *testing.T) {
+		newWASMEngine = nil
+		supervisor, err := newSupervisor(bundle, nil, nil, logger, nil)
+		require.EqualError(t, err, "WebAssembly plugins are not supported by this build of the server")
+		require.Nil(t, supervisor)
+	})
+
+	engine := &fakeWASMEngine{}
+	engine.guest = func(ctx context.Context, host func(string, string, proto.Message) *wasmResult, call *wasmCall) (*wasmResult, error) {
+		switch call.Hook {
+		case "Implemented":
+			return wasmResponse(&grpcproto.ImplementedResponse{Hooks: []string{"OnActivate", "MessageWillBePosted", "MessageHasBeenPosted", "ExecuteCommand", "ServeHTTP"}}), nil
+		case "OnActivate":
+			if result := host("api_call", "KVSet", &grpcproto.KVSetRequest{Key: "activated", Value: []byte("true")}); result.Error != nil {
+				return &wasmResult{Error: result.Error}, nil
+			}
+			return wasmResponse(&grpcproto.OnActivateResponse{}), nil
+		case "MessageWillBePosted":
+			var req grpcproto.MessageWillBePostedRequest
+			require.NoError(t, wasmUnmarshalOptions.Unmarshal(call.Request, &req))
+			var post model.Post
+			require.NoError(t, json.Unmarshal([]byte(req.PostJson), &post))
+
+			result := host("api_call", "CreatePost", &grpcproto.CreatePostRequest{PostJson: req.PostJson})
+			var createResp grpcproto.CreatePostResponse
+			require.NoError(t, wasmUnmarshalOptions.Unmarshal(result.Response, &createResp))
+			if createResp.Error != nil {
+				return wasmResponse(&grpcproto.MessageWillBePostedResponse{Result_2: createResp.Error.Id}), nil
+			}
+			if result := host("api_call", "GetUser", &grpcproto.GetUserRequest{UserId: post.UserId}); result.Error != nil {
+				return wasmResponse(&grpcproto.MessageWillBePostedResponse{Result_2: result.Error.Message}), nil
+			}
+			return wasmResponse(&grpcproto.MessageWillBePostedResponse{}), nil
+		case "MessageHasBeenPosted":
+			host("api_call", "KVSet", &grpcproto.KVSetRequest{Key: "posted", Value: []byte("true")})
+			return wasmResponse(&grpcproto.MessageHasBeenPostedResponse{}), nil
+		case "ExecuteCommand":
+			<-ctx.Done()
+			return nil, ctx.Err()
+		case "ServeHTTP":
+			result := host("http_do", "", &grpcproto.HTTPRequest{Method: "GET", Url: "https://example.com"})
+			if result.Error != nil {
+				return wasmResponse(&grpcproto.HTTPResponse{StatusCode: http.StatusForbidden, Body: []byte(result.Error.Message)}), nil
+			}
+			return wasmResponse(&grpcproto.HTTPResponse{StatusCode: http.StatusOK}), nil
+		}
+		return &wasmResult{Error: &grpcproto.Error{Message: "unexpected hook " + call.Hook}}, nil
+	}
+
+	newWASMEngine = func(_ context.Context, config *wasmEngineConfig) (wasmEngine, error) {
+		engine.config = config
+		return engine, nil
+	}
+
+	var supervisor *supervisor
+	api := &testWASMAPI{testGRPCAPI: testGRPCAPI{kv: map[string][]byte{}}}
+	api.onCreatePost = func(post *model.Post) {
+		// Plugins are notified of their own posts while their hook is running.
+		supervisor.Hooks().MessageHasBeenPosted(&Context{}, post)
+	}
+
+	supervisor, err = newSupervisor(bundle, api, nil, logger, nil)
+	require.NoError(t, err)
+	defer supervisor.Shutdown()
+	require.NoError(t, supervisor.Ping())
+
+	t.Run("OnActivate", func(t *testing.T) {
+		require.True(t, supervisor.Implements(MessageWillBePostedID))
+		require.NoError(t, supervisor.Hooks().OnActivate())
+		assert.Equal(t, []byte("true"), api.kv["activated"])
+	})
+
+	t.Run("capabilities", func(t *testing.T) {
+		_, rejectionReason := supervisor.Hooks().MessageWillBePosted(&Context{}, &model.Post{ChannelId: model.NewId()})
+		assert.Equal(t, "plugin.wasm.channel_not_allowed.app_error", rejectionReason)
+
+		_, rejectionReason = supervisor.Hooks().MessageWillBePosted(&Context{}, &model.Post{ChannelId: allowedChannelID, UserId: model.NewId()})
+		assert.Equal(t, "API GetUser not allowed", rejectionReason)
+		assert.Equal(t, []byte("true"), api.kv["posted"])
+		assert.Equal(t, int32(2), engine.created.Load(), "the nested hook should run in a second instance")
+	})
+
+	t.Run("network", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		supervisor.Hooks().ServeHTTP(&Context{}, w, httptest.NewRequest(http.MethodGet, "/plugins/foo", nil))
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Equal(t, "network access to example.com is not granted", w.Body.String())
+	})
+
+	t.Run("time limit", func(t *testing.T) {
+		closed := engine.closed.Load()
+		resp, appErr := supervisor.Hooks().ExecuteCommand(&Context{}, &model.CommandArgs{})
+		assert.Nil(t, resp)
+		assert.Nil(t, appErr)
+		assert.Equal(t, closed+1, engine.closed.Load(), "the interrupted instance should be closed")
+	})
+}
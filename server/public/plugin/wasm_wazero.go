@@ -0,0 +1,148 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+)
+
+// The wazero runtime is a pure Go WebAssembly runtime. The CPU time of a hook is bounded by
+// closing its instance when the context of the call is done.
+
+func init() {
+	newWASMEngine = newWazeroEngine
+}
+
+// wasmPageSize is the size of a page of WebAssembly memory.
+const wasmPageSize = 64 * 1024
+
+type wazeroEngine struct {
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+	config   *wasmEngineConfig
+}
+
+type wazeroInstance struct {
+	module api.Module
+}
+
+func newWazeroEngine(ctx context.Context, config *wasmEngineConfig) (wasmEngine, error) {
+	runtimeConfig := wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(uint32(config.MemoryLimitMB * 1024 * 1024 / wasmPageSize)).
+		WithCloseOnContextDone(true)
+	runtime := wazero.NewRuntimeWithConfig(ctx, runtimeConfig)
+
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, runtime); err != nil {
+		runtime.Close(ctx)
+		return nil, errors.Wrap(err, "failed to instantiate WASI")
+	}
+
+	host := runtime.NewHostModuleBuilder("mattermost")
+	for _, function := range []string{"api_call", "http_do"} {
+		host.NewFunctionBuilder().
+			WithFunc(func(ctx context.Context, module api.Module, ptr, size uint32) uint64 {
+				input, ok := module.Memory().Read(ptr, size)
+				if !ok {
+					panic(fmt.Sprintf("%s: input out of memory bounds", function))
+				}
+				packed, err := writeWazeroBuffer(ctx, module, config.Host(ctx, function, bytes.Clone(input)))
+				if err != nil {
+					panic(fmt.Sprintf("%s: %s", function, err.Error()))
+				}
+				return packed
+			}).
+			Export(function)
+	}
+	if _, err := host.Instantiate(ctx); err != nil {
+		runtime.Close(ctx)
+		return nil, errors.Wrap(err, "failed to instantiate host module")
+	}
+
+	compiled, err := runtime.CompileModule(ctx, config.Module)
+	if err != nil {
+		runtime.Close(ctx)
+		return nil, errors.Wrap(err, "failed to compile module")
+	}
+
+	return &wazeroEngine{runtime: runtime, compiled: compiled, config: config}, nil
+}
+
+func (e *wazeroEngine) instantiate(ctx context.Context) (wasmInstance, error) {
+	// Instances are anonymous so that several of them can run at the same time. Reactor
+	// modules are initialized by _initialize, command modules are not started.
+	moduleConfig := wazero.NewModuleConfig().
+		WithName("").
+		WithStdout(e.config.Stdout).
+		WithStderr(e.config.Stderr).
+		WithStartFunctions("_initialize")
+	if e.config.DataDir != "" {
+		moduleConfig = moduleConfig.WithFSConfig(wazero.NewFSConfig().WithDirMount(e.config.DataDir, "/data"))
+	}
+
+	module, err := e.runtime.InstantiateModule(ctx, e.compiled, moduleConfig)
+	if err != nil {
+		return nil, err
+	}
+	for _, export := range []string{"mm_malloc", "mm_free", "mm_call"} {
+		if module.ExportedFunction(export) == nil {
+			module.Close(ctx)
+			return nil, errors.Errorf("module does not export %s", export)
+		}
+	}
+
+	return &wazeroInstance{module: module}, nil
+}
+
+func (e *wazeroEngine) close(ctx context.Context) error {
+	return e.runtime.Close(ctx)
+}
+
+// writeWazeroBuffer copies data to a buffer allocated by the module, returning its packed
+// address and size.
+func writeWazeroBuffer(ctx context.Context, module api.Module, data []byte) (uint64, error) {
+	results, err := module.ExportedFunction("mm_malloc").Call(ctx, uint64(len(data)))
+	if err != nil {
+		return 0, errors.Wrap(err, "mm_malloc failed")
+	}
+	ptr := uint32(results[0])
+	if !module.Memory().Write(ptr, data) {
+		return 0, errors.New("buffer out of memory bounds")
+	}
+	return uint64(ptr)<<32 | uint64(len(data)), nil
+}
+
+func (i *wazeroInstance) call(ctx context.Context, input []byte) ([]byte, error) {
+	packed, err := writeWazeroBuffer(ctx, i.module, input)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := i.module.ExportedFunction("mm_call").Call(ctx, packed>>32, packed&0xffffffff)
+	if err != nil {
+		return nil, err
+	}
+
+	ptr, size := uint32(results[0]>>32), uint32(results[0])
+	output, ok := i.module.Memory().Read(ptr, size)
+	if !ok {
+		return nil, errors.New("output out of memory bounds")
+	}
+	output = bytes.Clone(output)
+
+	if _, err := i.module.ExportedFunction("mm_free").Call(ctx, uint64(ptr), uint64(size)); err != nil {
+		return nil, errors.Wrap(err, "mm_free failed")
+	}
+	return output, nil
+}
+
+func (i *wazeroInstance) close(ctx context.Context) error {
+	return i.module.Close(ctx)
+}
@@ -543,6 +543,7 @@ export type FileSettings = {
     EnablePublicLink: boolean;
     ExtractContent: boolean;
     ArchiveRecursion: boolean;
+    EnableWebPPreviews: boolean;
     PublicLinkSalt: string;
     InitialFont: string;
     AmazonS3AccessKeyId: string;